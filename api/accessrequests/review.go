@@ -0,0 +1,92 @@
+package accessrequests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// Approve approves the pending access request, granting its user access to
+// the target for the requested duration. The comment is optional.
+func (c *Client) Approve(ctx context.Context, accessRequestId string, version uint32, comment string, opt ...Option) (*AccessRequestUpdateResult, error) {
+	return c.review(ctx, "approve", accessRequestId, version, comment, opt...)
+}
+
+// Deny denies the pending access request. The comment is optional.
+func (c *Client) Deny(ctx context.Context, accessRequestId string, version uint32, comment string, opt ...Option) (*AccessRequestUpdateResult, error) {
+	return c.review(ctx, "deny", accessRequestId, version, comment, opt...)
+}
+
+// Cancel cancels the pending access request.
+func (c *Client) Cancel(ctx context.Context, accessRequestId string, version uint32, opt ...Option) (*AccessRequestUpdateResult, error) {
+	return c.review(ctx, "cancel", accessRequestId, version, "", opt...)
+}
+
+func (c *Client) review(ctx context.Context, action, accessRequestId string, version uint32, comment string, opt ...Option) (*AccessRequestUpdateResult, error) {
+	if accessRequestId == "" {
+		return nil, fmt.Errorf("empty accessRequestId value passed into %s request", action)
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	if version == 0 {
+		if !opts.withAutomaticVersioning {
+			return nil, fmt.Errorf("zero version number passed into %s request", action)
+		}
+		existingAccessRequest, existingErr := c.Read(ctx, accessRequestId, opt...)
+		if existingErr != nil {
+			if api.AsServerError(existingErr) != nil {
+				return nil, fmt.Errorf("error from controller when performing initial check-and-set read: %w", existingErr)
+			}
+			return nil, fmt.Errorf("error performing initial check-and-set read: %w", existingErr)
+		}
+		if existingAccessRequest == nil {
+			return nil, errors.New("nil resource response found when performing initial check-and-set read")
+		}
+		if existingAccessRequest.Item == nil {
+			return nil, errors.New("nil resource found when performing initial check-and-set read")
+		}
+		version = existingAccessRequest.Item.Version
+	}
+
+	opts.postMap["version"] = version
+	if comment != "" {
+		opts.postMap["comment"] = comment
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("access-requests/%s:%s", accessRequestId, action), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", action, err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", action, err)
+	}
+
+	target := new(AccessRequestUpdateResult)
+	target.Item = new(AccessRequest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", action, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
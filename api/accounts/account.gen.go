@@ -24,6 +24,7 @@ type Account struct {
 	AuthMethodId      string                 `json:"auth_method_id,omitempty"`
 	Attributes        map[string]interface{} `json:"attributes,omitempty"`
 	ManagedGroupIds   []string               `json:"managed_group_ids,omitempty"`
+	Disabled          bool                   `json:"disabled,omitempty"`
 	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
 
 	response *api.Response
@@ -292,32 +293,49 @@ func (c *Client) List(ctx context.Context, authMethodId string, opt ...Option) (
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["auth_method_id"] = authMethodId
 
-	req, err := c.client.NewRequest(ctx, "GET", "accounts", nil, apiOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating List request: %w", err)
-	}
+	// The items are listed in pages; each response carries the token to
+	// request the next page until all the items have been listed.
+	target := new(AccountListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "accounts", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
 
-	if len(opts.queryMap) > 0 {
-		q := url.Values{}
-		for k, v := range opts.queryMap {
-			q.Add(k, v)
+		if len(opts.queryMap) > 0 {
+			q := url.Values{}
+			for k, v := range opts.queryMap {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
 		}
-		req.URL.RawQuery = q.Encode()
-	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error performing client request during List call: %w", err)
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
-	target := new(AccountListResult)
-	apiErr, err := resp.Decode(target)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding List response: %w", err)
-	}
-	if apiErr != nil {
-		return nil, apiErr
+		page := new(struct {
+			Items     []*Account
+			ListToken string `json:"list_token"`
+		})
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		if target.response == nil {
+			target.response = resp
+		} else if err := target.response.AppendItems(resp); err != nil {
+			return nil, fmt.Errorf("error merging List responses: %w", err)
+		}
+		if page.ListToken == "" {
+			break
+		}
+		opts.queryMap["list_token"] = page.ListToken
 	}
-	target.response = resp
 	return target, nil
 }
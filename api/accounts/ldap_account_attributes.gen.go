@@ -0,0 +1,10 @@
+// Code generated by "make api"; DO NOT EDIT.
+package accounts
+
+type LdapAccountAttributes struct {
+	LoginName      string   `json:"login_name,omitempty"`
+	FullName       string   `json:"full_name,omitempty"`
+	Email          string   `json:"email,omitempty"`
+	Dn             string   `json:"dn,omitempty"`
+	MemberOfGroups []string `json:"member_of_groups,omitempty"`
+}
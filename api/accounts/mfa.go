@@ -0,0 +1,215 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// The types of second factors which can be removed with RemoveMfa.
+const (
+	MfaTypeTotp     = "totp"
+	MfaTypeWebAuthn = "webauthn"
+)
+
+// EnrollTotpResult is the result of EnrollTotp. Secret is the base32 encoded
+// TOTP seed and Url its otpauth:// URL, which can be rendered as a QR code.
+type EnrollTotpResult struct {
+	Item   *Account `json:"item,omitempty"`
+	Secret string   `json:"secret,omitempty"`
+	Url    string   `json:"url,omitempty"`
+
+	response *api.Response
+}
+
+func (n EnrollTotpResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n EnrollTotpResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnrollWebAuthnResult is the result of EnrollWebAuthn. Its values are the
+// options of the WebAuthn credential creation; binary values are base64url
+// encoded.
+type EnrollWebAuthnResult struct {
+	ChallengeId          string    `json:"challenge_id,omitempty"`
+	Challenge            string    `json:"challenge,omitempty"`
+	RpId                 string    `json:"rp_id,omitempty"`
+	UserId               string    `json:"user_id,omitempty"`
+	UserName             string    `json:"user_name,omitempty"`
+	ExcludeCredentialIds []string  `json:"exclude_credential_ids,omitempty"`
+	ExpirationTime       time.Time `json:"expiration_time,omitempty"`
+
+	response *api.Response
+}
+
+func (n EnrollWebAuthnResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// WebAuthnAttestation is the public key credential created by an
+// authenticator for ConfirmWebAuthn. Binary values are base64url encoded.
+type WebAuthnAttestation struct {
+	Name               string `json:"name,omitempty"`
+	CredentialId       string `json:"credential_id,omitempty"`
+	ClientDataJson     string `json:"client_data_json,omitempty"`
+	AuthenticatorData  string `json:"authenticator_data,omitempty"`
+	PublicKey          string `json:"public_key,omitempty"`
+	PublicKeyAlgorithm int32  `json:"public_key_algorithm,omitempty"`
+}
+
+// MfaResult is the result of the second factor calls other than EnrollTotp
+// and EnrollWebAuthn. RecoveryCodes is only set when new recovery codes were
+// generated; they are not returned again.
+type MfaResult struct {
+	Item          *Account `json:"item,omitempty"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+
+	response *api.Response
+}
+
+func (n MfaResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n MfaResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// EnrollTotp starts the enrollment of a TOTP authenticator for a password
+// account. The enrollment is completed with ConfirmTotp.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*EnrollTotpResult, error) {
+	target := new(EnrollTotpResult)
+	resp, err := c.actionRequest(ctx, "EnrollTotp", accountId, "enroll-totp", nil, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ConfirmTotp confirms the TOTP authenticator of a password account with a
+// code it generated. The recovery codes of the account are returned if it had
+// no second factor before.
+func (c *Client) ConfirmTotp(ctx context.Context, accountId, code string, opt ...Option) (*MfaResult, error) {
+	if code == "" {
+		return nil, fmt.Errorf("empty code value passed into ConfirmTotp request")
+	}
+	target := new(MfaResult)
+	resp, err := c.actionRequest(ctx, "ConfirmTotp", accountId, "confirm-totp", map[string]interface{}{"code": code}, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
+
+// EnrollWebAuthn starts the registration of a WebAuthn credential for a
+// password account. The registration is completed with ConfirmWebAuthn.
+func (c *Client) EnrollWebAuthn(ctx context.Context, accountId string, opt ...Option) (*EnrollWebAuthnResult, error) {
+	target := new(EnrollWebAuthnResult)
+	resp, err := c.actionRequest(ctx, "EnrollWebAuthn", accountId, "enroll-webauthn", nil, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
+
+// ConfirmWebAuthn registers the WebAuthn credential created for the challenge
+// returned by EnrollWebAuthn. The recovery codes of the account are returned
+// if it had no second factor before.
+func (c *Client) ConfirmWebAuthn(ctx context.Context, accountId, challengeId string, attestation *WebAuthnAttestation, opt ...Option) (*MfaResult, error) {
+	if challengeId == "" {
+		return nil, fmt.Errorf("empty challengeId value passed into ConfirmWebAuthn request")
+	}
+	if attestation == nil {
+		return nil, fmt.Errorf("nil attestation passed into ConfirmWebAuthn request")
+	}
+	reqBody := map[string]interface{}{
+		"challenge_id":         challengeId,
+		"name":                 attestation.Name,
+		"credential_id":        attestation.CredentialId,
+		"client_data_json":     attestation.ClientDataJson,
+		"authenticator_data":   attestation.AuthenticatorData,
+		"public_key":           attestation.PublicKey,
+		"public_key_algorithm": attestation.PublicKeyAlgorithm,
+	}
+	target := new(MfaResult)
+	resp, err := c.actionRequest(ctx, "ConfirmWebAuthn", accountId, "confirm-webauthn", reqBody, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
+
+// RemoveMfa removes a second factor of a password account. mfaType is
+// MfaTypeTotp or MfaTypeWebAuthn; credentialId is the ID of the WebAuthn
+// credential to remove and must be empty for MfaTypeTotp.
+func (c *Client) RemoveMfa(ctx context.Context, accountId, mfaType, credentialId string, opt ...Option) (*MfaResult, error) {
+	if mfaType == "" {
+		return nil, fmt.Errorf("empty mfaType value passed into RemoveMfa request")
+	}
+	reqBody := map[string]interface{}{
+		"type": mfaType,
+	}
+	if credentialId != "" {
+		reqBody["credential_id"] = credentialId
+	}
+	target := new(MfaResult)
+	resp, err := c.actionRequest(ctx, "RemoveMfa", accountId, "remove-mfa", reqBody, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
+
+// GenerateRecoveryCodes replaces the recovery codes of a password account.
+func (c *Client) GenerateRecoveryCodes(ctx context.Context, accountId string, opt ...Option) (*MfaResult, error) {
+	target := new(MfaResult)
+	resp, err := c.actionRequest(ctx, "GenerateRecoveryCodes", accountId, "generate-recovery-codes", nil, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
+
+func (c *Client) actionRequest(ctx context.Context, name, accountId, action string, reqBody map[string]interface{}, target interface{}, opt ...Option) (*api.Response, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into %s request", name)
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in %s request", name)
+	}
+
+	_, apiOpts := getOpts(opt...)
+	if reqBody == nil {
+		reqBody = map[string]interface{}{}
+	}
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:%s", accountId, action), reqBody, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", name, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", name, err)
+	}
+
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", name, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return resp, nil
+}
@@ -98,6 +98,18 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithDisabled(inDisabled bool) Option {
+	return func(o *options) {
+		o.postMap["disabled"] = inDisabled
+	}
+}
+
+func DefaultDisabled() Option {
+	return func(o *options) {
+		o.postMap["disabled"] = nil
+	}
+}
+
 func WithOidcAccountIssuer(inIssuer string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -122,6 +134,30 @@ func DefaultOidcAccountIssuer() Option {
 	}
 }
 
+func WithLdapAccountLoginName(inLoginName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["login_name"] = inLoginName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAccountLoginName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["login_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAccountLoginName(inLoginName string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -1,7 +1,15 @@
 // Code generated by "make api"; DO NOT EDIT.
 package accounts
 
+import (
+	"time"
+)
+
 type PasswordAccountAttributes struct {
-	LoginName string `json:"login_name,omitempty"`
-	Password  string `json:"password,omitempty"`
+	LoginName              string                `json:"login_name,omitempty"`
+	Password               string                `json:"password,omitempty"`
+	TotpEnabled            bool                  `json:"totp_enabled,omitempty"`
+	WebauthnCredentials    []*WebAuthnCredential `json:"webauthn_credentials,omitempty"`
+	RecoveryCodesRemaining uint32                `json:"recovery_codes_remaining,omitempty"`
+	LockedUntil            time.Time             `json:"locked_until,omitempty"`
 }
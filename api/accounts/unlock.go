@@ -0,0 +1,15 @@
+package accounts
+
+import "context"
+
+// Unlock ends the lockout of a password account and resets its failed
+// authentication attempts.
+func (c *Client) Unlock(ctx context.Context, accountId string, opt ...Option) (*AccountUpdateResult, error) {
+	target := new(AccountUpdateResult)
+	resp, err := c.actionRequest(ctx, "Unlock", accountId, "unlock", nil, target, opt...)
+	if err != nil {
+		return nil, err
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,22 @@
+// Code generated by "make api"; DO NOT EDIT.
+package authmethods
+
+type LdapAuthMethodAttributes struct {
+	Urls             []string `json:"urls,omitempty"`
+	StartTls         bool     `json:"start_tls,omitempty"`
+	InsecureTls      bool     `json:"insecure_tls,omitempty"`
+	DiscoverDn       bool     `json:"discover_dn,omitempty"`
+	AnonGroupSearch  bool     `json:"anon_group_search,omitempty"`
+	UpnDomain        string   `json:"upn_domain,omitempty"`
+	UserDn           string   `json:"user_dn,omitempty"`
+	UserAttr         string   `json:"user_attr,omitempty"`
+	UserFilter       string   `json:"user_filter,omitempty"`
+	EnableGroups     bool     `json:"enable_groups,omitempty"`
+	GroupDn          string   `json:"group_dn,omitempty"`
+	GroupAttr        string   `json:"group_attr,omitempty"`
+	GroupFilter      string   `json:"group_filter,omitempty"`
+	Certificates     []string `json:"certificates,omitempty"`
+	BindDn           string   `json:"bind_dn,omitempty"`
+	BindPassword     string   `json:"bind_password,omitempty"`
+	BindPasswordHmac string   `json:"bind_password_hmac,omitempty"`
+}
@@ -135,6 +135,30 @@ func DefaultOidcAuthMethodAllowedAudiences() Option {
 	}
 }
 
+func WithLdapAuthMethodAnonGroupSearch(inAnonGroupSearch bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["anon_group_search"] = inAnonGroupSearch
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodAnonGroupSearch() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["anon_group_search"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodApiUrlPrefix(inApiUrlPrefix string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -171,6 +195,78 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithLdapAuthMethodBindDn(inBindDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_dn"] = inBindDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodBindDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodBindPassword(inBindPassword string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_password"] = inBindPassword
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodBindPassword() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["bind_password"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodCertificates(inCertificates []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificates"] = inCertificates
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodCertificates() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["certificates"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodClaimsScopes(inClaimsScopes []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -279,6 +375,30 @@ func DefaultOidcAuthMethodDisableDiscoveredConfigValidation() Option {
 	}
 }
 
+func WithLdapAuthMethodDiscoverDn(inDiscoverDn bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["discover_dn"] = inDiscoverDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodDiscoverDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["discover_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodDryRun(inDryRun bool) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -303,6 +423,102 @@ func DefaultOidcAuthMethodDryRun() Option {
 	}
 }
 
+func WithLdapAuthMethodEnableGroups(inEnableGroups bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["enable_groups"] = inEnableGroups
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodEnableGroups() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["enable_groups"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodGroupAttr(inGroupAttr string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_attr"] = inGroupAttr
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodGroupAttr() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_attr"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodGroupDn(inGroupDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_dn"] = inGroupDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodGroupDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodGroupFilter(inGroupFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_filter"] = inGroupFilter
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodGroupFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["group_filter"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodIdpCaCerts(inIdpCaCerts []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -327,6 +543,30 @@ func DefaultOidcAuthMethodIdpCaCerts() Option {
 	}
 }
 
+func WithLdapAuthMethodInsecureTls(inInsecureTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["insecure_tls"] = inInsecureTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodInsecureTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["insecure_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodIssuer(inIssuer string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -351,6 +591,78 @@ func DefaultOidcAuthMethodIssuer() Option {
 	}
 }
 
+func WithPasswordAuthMethodLockoutDurationSeconds(inLockoutDurationSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration_seconds"] = inLockoutDurationSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutDurationSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_duration_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodLockoutMaxFailedAttempts(inLockoutMaxFailedAttempts uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_max_failed_attempts"] = inLockoutMaxFailedAttempts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutMaxFailedAttempts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_max_failed_attempts"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodLockoutWindowSeconds(inLockoutWindowSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_window_seconds"] = inLockoutWindowSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodLockoutWindowSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["lockout_window_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithOidcAuthMethodMaxAge(inMaxAge uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -375,6 +687,30 @@ func DefaultOidcAuthMethodMaxAge() Option {
 	}
 }
 
+func WithPasswordAuthMethodMfaRequired(inMfaRequired bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["mfa_required"] = inMfaRequired
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodMfaRequired() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["mfa_required"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithPasswordAuthMethodMinLoginNameLength(inMinLoginNameLength uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -458,3 +794,171 @@ func DefaultOidcAuthMethodSigningAlgorithms() Option {
 		o.postMap["attributes"] = val
 	}
 }
+
+func WithLdapAuthMethodStartTls(inStartTls bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["start_tls"] = inStartTls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodStartTls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["start_tls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUpnDomain(inUpnDomain string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["upn_domain"] = inUpnDomain
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUpnDomain() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["upn_domain"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUrls(inUrls []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["urls"] = inUrls
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUrls() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["urls"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserAttr(inUserAttr string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_attr"] = inUserAttr
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserAttr() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_attr"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserDn(inUserDn string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_dn"] = inUserDn
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserDn() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_dn"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithLdapAuthMethodUserFilter(inUserFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_filter"] = inUserFilter
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultLdapAuthMethodUserFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["user_filter"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPasswordAuthMethodWebauthnRpId(inWebauthnRpId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["webauthn_rp_id"] = inWebauthnRpId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodWebauthnRpId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["webauthn_rp_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
@@ -2,6 +2,11 @@
 package authmethods
 
 type PasswordAuthMethodAttributes struct {
-	MinLoginNameLength uint32 `json:"min_login_name_length,omitempty"`
-	MinPasswordLength  uint32 `json:"min_password_length,omitempty"`
+	MinLoginNameLength       uint32 `json:"min_login_name_length,omitempty"`
+	MinPasswordLength        uint32 `json:"min_password_length,omitempty"`
+	MfaRequired              bool   `json:"mfa_required,omitempty"`
+	WebauthnRpId             string `json:"webauthn_rp_id,omitempty"`
+	LockoutMaxFailedAttempts uint32 `json:"lockout_max_failed_attempts,omitempty"`
+	LockoutWindowSeconds     uint32 `json:"lockout_window_seconds,omitempty"`
+	LockoutDurationSeconds   uint32 `json:"lockout_duration_seconds,omitempty"`
 }
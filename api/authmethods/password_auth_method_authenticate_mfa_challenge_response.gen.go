@@ -0,0 +1,15 @@
+// Code generated by "make api"; DO NOT EDIT.
+package authmethods
+
+import (
+	"time"
+)
+
+type PasswordAuthMethodAuthenticateMfaChallengeResponse struct {
+	ChallengeId           string    `json:"challenge_id,omitempty"`
+	ExpirationTime        time.Time `json:"expiration_time,omitempty"`
+	Factors               []string  `json:"factors,omitempty"`
+	WebauthnChallenge     string    `json:"webauthn_challenge,omitempty"`
+	WebauthnRpId          string    `json:"webauthn_rp_id,omitempty"`
+	WebauthnCredentialIds []string  `json:"webauthn_credential_ids,omitempty"`
+}
@@ -293,32 +293,49 @@ func (c *Client) List(ctx context.Context, credentialStoreId string, opt ...Opti
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["credential_store_id"] = credentialStoreId
 
-	req, err := c.client.NewRequest(ctx, "GET", "credential-libraries", nil, apiOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating List request: %w", err)
-	}
+	// The items are listed in pages; each response carries the token to
+	// request the next page until all the items have been listed.
+	target := new(CredentialLibraryListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "credential-libraries", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
 
-	if len(opts.queryMap) > 0 {
-		q := url.Values{}
-		for k, v := range opts.queryMap {
-			q.Add(k, v)
+		if len(opts.queryMap) > 0 {
+			q := url.Values{}
+			for k, v := range opts.queryMap {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
 		}
-		req.URL.RawQuery = q.Encode()
-	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error performing client request during List call: %w", err)
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
-	target := new(CredentialLibraryListResult)
-	apiErr, err := resp.Decode(target)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding List response: %w", err)
-	}
-	if apiErr != nil {
-		return nil, apiErr
+		page := new(struct {
+			Items     []*CredentialLibrary
+			ListToken string `json:"list_token"`
+		})
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		if target.response == nil {
+			target.response = resp
+		} else if err := target.response.AppendItems(resp); err != nil {
+			return nil, fmt.Errorf("error merging List responses: %w", err)
+		}
+		if page.ListToken == "" {
+			break
+		}
+		opts.queryMap["list_token"] = page.ListToken
 	}
-	target.response = resp
 	return target, nil
 }
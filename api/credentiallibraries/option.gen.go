@@ -193,3 +193,147 @@ func WithVaultCredentialLibraryPath(inPath string) Option {
 		o.postMap["attributes"] = val
 	}
 }
+
+func WithVaultCredentialLibrarySshExtensions(inSshExtensions map[string]interface{}) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_extensions"] = inSshExtensions
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySshExtensions() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_extensions"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibrarySshKeyBits(inSshKeyBits uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_key_bits"] = inSshKeyBits
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySshKeyBits() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_key_bits"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibrarySshKeyType(inSshKeyType string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_key_type"] = inSshKeyType
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySshKeyType() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_key_type"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibrarySshTtl(inSshTtl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_ttl"] = inSshTtl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySshTtl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_ttl"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibrarySshUsername(inSshUsername string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_username"] = inSshUsername
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySshUsername() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_username"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithVaultCredentialLibrarySshValidPrincipals(inSshValidPrincipals []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_valid_principals"] = inSshValidPrincipals
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySshValidPrincipals() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["ssh_valid_principals"] = nil
+		o.postMap["attributes"] = val
+	}
+}
@@ -2,7 +2,13 @@
 package credentiallibraries
 
 type VaultCredentialLibraryAttributes struct {
-	Path            string `json:"path,omitempty"`
-	HttpMethod      string `json:"http_method,omitempty"`
-	HttpRequestBody string `json:"http_request_body,omitempty"`
+	Path               string                 `json:"path,omitempty"`
+	HttpMethod         string                 `json:"http_method,omitempty"`
+	HttpRequestBody    string                 `json:"http_request_body,omitempty"`
+	SshUsername        string                 `json:"ssh_username,omitempty"`
+	SshKeyType         string                 `json:"ssh_key_type,omitempty"`
+	SshKeyBits         uint32                 `json:"ssh_key_bits,omitempty"`
+	SshTtl             string                 `json:"ssh_ttl,omitempty"`
+	SshValidPrincipals []string               `json:"ssh_valid_principals,omitempty"`
+	SshExtensions      map[string]interface{} `json:"ssh_extensions,omitempty"`
 }
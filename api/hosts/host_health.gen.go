@@ -0,0 +1,14 @@
+// Code generated by "make api"; DO NOT EDIT.
+package hosts
+
+import (
+	"time"
+)
+
+type HostHealth struct {
+	HostSetId   string    `json:"host_set_id,omitempty"`
+	Status      string    `json:"status,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	WorkerId    string    `json:"worker_id,omitempty"`
+	CheckedTime time.Time `json:"checked_time,omitempty"`
+}
@@ -133,3 +133,27 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithStaticHostTags(inTags map[string][]string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tags"] = inTags
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticHostTags() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["tags"] = nil
+		o.postMap["attributes"] = val
+	}
+}
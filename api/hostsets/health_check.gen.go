@@ -0,0 +1,11 @@
+// Code generated by "make api"; DO NOT EDIT.
+package hostsets
+
+type HealthCheck struct {
+	Type            string `json:"type,omitempty"`
+	Port            uint32 `json:"port,omitempty"`
+	Path            string `json:"path,omitempty"`
+	IntervalSeconds uint32 `json:"interval_seconds,omitempty"`
+	TimeoutSeconds  uint32 `json:"timeout_seconds,omitempty"`
+	WorkerFilter    string `json:"worker_filter,omitempty"`
+}
@@ -98,6 +98,42 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithStaticHostSetFilter(inFilter string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = inFilter
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultStaticHostSetFilter() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["filter"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHealthCheck(inHealthCheck *HealthCheck) Option {
+	return func(o *options) {
+		o.postMap["health_check"] = inHealthCheck
+	}
+}
+
+func DefaultHealthCheck() Option {
+	return func(o *options) {
+		o.postMap["health_check"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -79,3 +79,29 @@ func (r *Response) Decode(inStruct interface{}) (*Error, error) {
 
 	return nil, nil
 }
+
+// AppendItems appends the items of page, the response for the next page of a
+// list, to the items of r, so that r holds the items of every page read so
+// far. The list token of r is removed as it is only meaningful for a single
+// page.
+func (r *Response) AppendItems(page *Response) error {
+	if r == nil || page == nil {
+		return fmt.Errorf("nil response, cannot append items")
+	}
+	if r.Map == nil {
+		r.Map = make(map[string]interface{})
+	}
+	items, _ := r.Map["items"].([]interface{})
+	if pageItems, ok := page.Map["items"].([]interface{}); ok {
+		items = append(items, pageItems...)
+	}
+	r.Map["items"] = items
+	delete(r.Map, "list_token")
+
+	body, err := json.Marshal(r.Map)
+	if err != nil {
+		return fmt.Errorf("error encoding merged response: %w", err)
+	}
+	r.Body = bytes.NewBuffer(body)
+	return nil
+}
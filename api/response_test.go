@@ -0,0 +1,32 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseAppendItems(t *testing.T) {
+	first := &Response{Map: map[string]interface{}{
+		"items":      []interface{}{map[string]interface{}{"id": "a"}},
+		"list_token": "token",
+	}}
+	second := &Response{Map: map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"id": "b"}},
+	}}
+	require.NoError(t, first.AppendItems(second))
+	assert.Equal(t, map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"id": "a"},
+			map[string]interface{}{"id": "b"},
+		},
+	}, first.Map)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &body))
+	assert.Equal(t, first.Map, body)
+
+	assert.Error(t, first.AppendItems(nil))
+}
@@ -1,8 +1,16 @@
 // Code generated by "make api"; DO NOT EDIT.
 package roles
 
+import (
+	"time"
+)
+
 type GrantJson struct {
-	Id      string   `json:"id,omitempty"`
-	Type    string   `json:"type,omitempty"`
-	Actions []string `json:"actions,omitempty"`
+	Id        string    `json:"id,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Actions   []string  `json:"actions,omitempty"`
+	NotBefore time.Time `json:"not_before,omitempty"`
+	NotAfter  time.Time `json:"not_after,omitempty"`
+	Condition string    `json:"condition,omitempty"`
+	Effect    string    `json:"effect,omitempty"`
 }
@@ -3,6 +3,7 @@ package roles
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/api"
 )
@@ -87,6 +88,12 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithCondition(inCondition string) Option {
+	return func(o *options) {
+		o.postMap["condition"] = inCondition
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -122,3 +129,15 @@ func DefaultName() Option {
 		o.postMap["name"] = nil
 	}
 }
+
+func WithNotAfter(inNotAfter time.Time) Option {
+	return func(o *options) {
+		o.postMap["not_after"] = inNotAfter
+	}
+}
+
+func WithNotBefore(inNotBefore time.Time) Option {
+	return func(o *options) {
+		o.postMap["not_before"] = inNotBefore
+	}
+}
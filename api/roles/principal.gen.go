@@ -1,8 +1,15 @@
 // Code generated by "make api"; DO NOT EDIT.
 package roles
 
+import (
+	"time"
+)
+
 type Principal struct {
-	Id      string `json:"id,omitempty"`
-	Type    string `json:"type,omitempty"`
-	ScopeId string `json:"scope_id,omitempty"`
+	Id        string    `json:"id,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	ScopeId   string    `json:"scope_id,omitempty"`
+	NotBefore time.Time `json:"not_before,omitempty"`
+	NotAfter  time.Time `json:"not_after,omitempty"`
+	Condition string    `json:"condition,omitempty"`
 }
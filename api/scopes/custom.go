@@ -0,0 +1,75 @@
+package scopes
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+type PermissionCheckResult struct {
+	Item     *PermissionCheck
+	response *api.Response
+}
+
+func (n PermissionCheckResult) GetItem() interface{} {
+	return n.Item
+}
+
+func (n PermissionCheckResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// CheckPermissions checks whether the user is authorized to perform the action
+// on a resource within the scope, and returns the grants determining the
+// decision. The resource is specified using the WithResourceId and/or
+// WithResourceType options.
+func (c *Client) CheckPermissions(ctx context.Context, scopeId, userId, action string, opt ...Option) (*PermissionCheckResult, error) {
+	if scopeId == "" {
+		return nil, fmt.Errorf("empty scopeId value passed into CheckPermissions request")
+	}
+	if userId == "" {
+		return nil, fmt.Errorf("empty userId value passed into CheckPermissions request")
+	}
+	if action == "" {
+		return nil, fmt.Errorf("empty action value passed into CheckPermissions request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.postMap["user_id"] = userId
+	opts.postMap["action"] = action
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("scopes/%s:check-permissions", scopeId), opts.postMap, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CheckPermissions request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during CheckPermissions call: %w", err)
+	}
+
+	target := new(PermissionCheckResult)
+	target.Item = new(PermissionCheck)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CheckPermissions response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
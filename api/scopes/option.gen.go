@@ -88,6 +88,12 @@ func WithRecursive(recurse bool) Option {
 	}
 }
 
+func WithClientIp(inClientIp string) Option {
+	return func(o *options) {
+		o.postMap["client_ip"] = inClientIp
+	}
+}
+
 func WithDescription(inDescription string) Option {
 	return func(o *options) {
 		o.postMap["description"] = inDescription
@@ -112,6 +118,12 @@ func DefaultName() Option {
 	}
 }
 
+func WithParentId(inParentId string) Option {
+	return func(o *options) {
+		o.postMap["parent_id"] = inParentId
+	}
+}
+
 func WithPrimaryAuthMethodId(inPrimaryAuthMethodId string) Option {
 	return func(o *options) {
 		o.postMap["primary_auth_method_id"] = inPrimaryAuthMethodId
@@ -124,6 +136,18 @@ func DefaultPrimaryAuthMethodId() Option {
 	}
 }
 
+func WithResourceId(inResourceId string) Option {
+	return func(o *options) {
+		o.postMap["resource_id"] = inResourceId
+	}
+}
+
+func WithResourceType(inResourceType string) Option {
+	return func(o *options) {
+		o.postMap["resource_type"] = inResourceType
+	}
+}
+
 func WithSkipAdminRoleCreation(inSkipAdminRoleCreation bool) Option {
 	return func(o *options) {
 		o.queryMap["skip_admin_role_creation"] = fmt.Sprintf("%v", inSkipAdminRoleCreation)
@@ -0,0 +1,73 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// RecordingDownloadResult is the result of DownloadRecording. Content is the
+// recording file, which the controller verified against the size and checksum
+// of the Recording.
+type RecordingDownloadResult struct {
+	Recording *Recording `json:"recording,omitempty"`
+	Content   []byte     `json:"content,omitempty"`
+
+	response *api.Response
+}
+
+func (n RecordingDownloadResult) GetItem() interface{} {
+	return n.Recording
+}
+
+func (n RecordingDownloadResult) GetResponse() *api.Response {
+	return n.response
+}
+
+// DownloadRecording returns the recording of the connection connectionId of
+// the session sessionId, which the controller fetches from the worker holding
+// it.
+func (c *Client) DownloadRecording(ctx context.Context, sessionId, connectionId string, opt ...Option) (*RecordingDownloadResult, error) {
+	if sessionId == "" {
+		return nil, fmt.Errorf("empty sessionId value passed into DownloadRecording request")
+	}
+	if connectionId == "" {
+		return nil, fmt.Errorf("empty connectionId value passed into DownloadRecording request")
+	}
+	if c.client == nil {
+		return nil, errors.New("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["connection_id"] = connectionId
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("sessions/%s:download-recording", sessionId), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DownloadRecording request: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during DownloadRecording call: %w", err)
+	}
+
+	target := new(RecordingDownloadResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding DownloadRecording response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.response = resp
+	return target, nil
+}
@@ -0,0 +1,15 @@
+// Code generated by "make api"; DO NOT EDIT.
+package sessions
+
+import (
+	"time"
+)
+
+type Recording struct {
+	WorkerId    string    `json:"worker_id,omitempty"`
+	StoragePath string    `json:"storage_path,omitempty"`
+	SizeBytes   uint64    `json:"size_bytes,omitempty"`
+	Checksum    string    `json:"checksum,omitempty"`
+	StartTime   time.Time `json:"start_time,omitempty"`
+	EndTime     time.Time `json:"end_time,omitempty"`
+}
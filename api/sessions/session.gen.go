@@ -12,27 +12,28 @@ import (
 )
 
 type Session struct {
-	Id                string            `json:"id,omitempty"`
-	TargetId          string            `json:"target_id,omitempty"`
-	Scope             *scopes.ScopeInfo `json:"scope,omitempty"`
-	CreatedTime       time.Time         `json:"created_time,omitempty"`
-	UpdatedTime       time.Time         `json:"updated_time,omitempty"`
-	Version           uint32            `json:"version,omitempty"`
-	Type              string            `json:"type,omitempty"`
-	ExpirationTime    time.Time         `json:"expiration_time,omitempty"`
-	AuthTokenId       string            `json:"auth_token_id,omitempty"`
-	UserId            string            `json:"user_id,omitempty"`
-	HostSetId         string            `json:"host_set_id,omitempty"`
-	HostId            string            `json:"host_id,omitempty"`
-	ScopeId           string            `json:"scope_id,omitempty"`
-	Endpoint          string            `json:"endpoint,omitempty"`
-	States            []*SessionState   `json:"states,omitempty"`
-	Status            string            `json:"status,omitempty"`
-	WorkerInfo        []*WorkerInfo     `json:"worker_info,omitempty"`
-	Certificate       []byte            `json:"certificate,omitempty"`
-	TerminationReason string            `json:"termination_reason,omitempty"`
-	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
-	Connections       []*Connection     `json:"connections,omitempty"`
+	Id                    string            `json:"id,omitempty"`
+	TargetId              string            `json:"target_id,omitempty"`
+	Scope                 *scopes.ScopeInfo `json:"scope,omitempty"`
+	CreatedTime           time.Time         `json:"created_time,omitempty"`
+	UpdatedTime           time.Time         `json:"updated_time,omitempty"`
+	Version               uint32            `json:"version,omitempty"`
+	Type                  string            `json:"type,omitempty"`
+	ExpirationTime        time.Time         `json:"expiration_time,omitempty"`
+	AuthTokenId           string            `json:"auth_token_id,omitempty"`
+	UserId                string            `json:"user_id,omitempty"`
+	HostSetId             string            `json:"host_set_id,omitempty"`
+	HostId                string            `json:"host_id,omitempty"`
+	ScopeId               string            `json:"scope_id,omitempty"`
+	HostSelectionStrategy string            `json:"host_selection_strategy,omitempty"`
+	Endpoint              string            `json:"endpoint,omitempty"`
+	States                []*SessionState   `json:"states,omitempty"`
+	Status                string            `json:"status,omitempty"`
+	WorkerInfo            []*WorkerInfo     `json:"worker_info,omitempty"`
+	Certificate           []byte            `json:"certificate,omitempty"`
+	TerminationReason     string            `json:"termination_reason,omitempty"`
+	AuthorizedActions     []string          `json:"authorized_actions,omitempty"`
+	Connections           []*Connection     `json:"connections,omitempty"`
 
 	response *api.Response
 }
@@ -151,32 +152,49 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Sess
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
 
-	req, err := c.client.NewRequest(ctx, "GET", "sessions", nil, apiOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating List request: %w", err)
-	}
+	// The items are listed in pages; each response carries the token to
+	// request the next page until all the items have been listed.
+	target := new(SessionListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "sessions", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
 
-	if len(opts.queryMap) > 0 {
-		q := url.Values{}
-		for k, v := range opts.queryMap {
-			q.Add(k, v)
+		if len(opts.queryMap) > 0 {
+			q := url.Values{}
+			for k, v := range opts.queryMap {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
 		}
-		req.URL.RawQuery = q.Encode()
-	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error performing client request during List call: %w", err)
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
-	target := new(SessionListResult)
-	apiErr, err := resp.Decode(target)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding List response: %w", err)
-	}
-	if apiErr != nil {
-		return nil, apiErr
+		page := new(struct {
+			Items     []*Session
+			ListToken string `json:"list_token"`
+		})
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		if target.response == nil {
+			target.response = resp
+		} else if err := target.response.AppendItems(resp); err != nil {
+			return nil, fmt.Errorf("error merging List responses: %w", err)
+		}
+		if page.ListToken == "" {
+			break
+		}
+		opts.queryMap["list_token"] = page.ListToken
 	}
-	target.response = resp
 	return target, nil
 }
@@ -123,6 +123,66 @@ func DefaultAttributes() Option {
 	}
 }
 
+func WithConnectionIdleTimeoutSeconds(inConnectionIdleTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["connection_idle_timeout_seconds"] = inConnectionIdleTimeoutSeconds
+	}
+}
+
+func DefaultConnectionIdleTimeoutSeconds() Option {
+	return func(o *options) {
+		o.postMap["connection_idle_timeout_seconds"] = nil
+	}
+}
+
+func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSshTargetKnownHosts(inKnownHosts string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["known_hosts"] = inKnownHosts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetKnownHosts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = interface{}(map[string]interface{}{})
+		}
+		val := raw.(map[string]interface{})
+		val["known_hosts"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithTcpTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -177,6 +237,18 @@ func WithHostId(inHostId string) Option {
 	}
 }
 
+func WithHostSelectionStrategy(inHostSelectionStrategy string) Option {
+	return func(o *options) {
+		o.postMap["host_selection_strategy"] = inHostSelectionStrategy
+	}
+}
+
+func DefaultHostSelectionStrategy() Option {
+	return func(o *options) {
+		o.postMap["host_selection_strategy"] = nil
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -213,6 +285,30 @@ func DefaultSessionConnectionLimit() Option {
 	}
 }
 
+func WithSessionMaxBytesDown(inSessionMaxBytesDown int64) Option {
+	return func(o *options) {
+		o.postMap["session_max_bytes_down"] = inSessionMaxBytesDown
+	}
+}
+
+func DefaultSessionMaxBytesDown() Option {
+	return func(o *options) {
+		o.postMap["session_max_bytes_down"] = nil
+	}
+}
+
+func WithSessionMaxBytesUp(inSessionMaxBytesUp int64) Option {
+	return func(o *options) {
+		o.postMap["session_max_bytes_up"] = inSessionMaxBytesUp
+	}
+}
+
+func DefaultSessionMaxBytesUp() Option {
+	return func(o *options) {
+		o.postMap["session_max_bytes_up"] = nil
+	}
+}
+
 func WithSessionMaxSeconds(inSessionMaxSeconds uint32) Option {
 	return func(o *options) {
 		o.postMap["session_max_seconds"] = inSessionMaxSeconds
@@ -225,6 +321,18 @@ func DefaultSessionMaxSeconds() Option {
 	}
 }
 
+func WithUserSessionLimit(inUserSessionLimit int32) Option {
+	return func(o *options) {
+		o.postMap["user_session_limit"] = inUserSessionLimit
+	}
+}
+
+func DefaultUserSessionLimit() Option {
+	return func(o *options) {
+		o.postMap["user_session_limit"] = nil
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
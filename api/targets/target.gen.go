@@ -29,6 +29,11 @@ type Target struct {
 	SessionMaxSeconds               uint32                 `json:"session_max_seconds,omitempty"`
 	SessionConnectionLimit          int32                  `json:"session_connection_limit,omitempty"`
 	WorkerFilter                    string                 `json:"worker_filter,omitempty"`
+	UserSessionLimit                int32                  `json:"user_session_limit,omitempty"`
+	SessionMaxBytesUp               int64                  `json:"session_max_bytes_up,string,omitempty"`
+	SessionMaxBytesDown             int64                  `json:"session_max_bytes_down,string,omitempty"`
+	ConnectionIdleTimeoutSeconds    uint32                 `json:"connection_idle_timeout_seconds,omitempty"`
+	HostSelectionStrategy           string                 `json:"host_selection_strategy,omitempty"`
 	ApplicationCredentialLibraryIds []string               `json:"application_credential_library_ids,omitempty"`
 	ApplicationCredentialLibraries  []*CredentialLibrary   `json:"application_credential_libraries,omitempty"`
 	ApplicationCredentialSourceIds  []string               `json:"application_credential_source_ids,omitempty"`
@@ -309,33 +314,50 @@ func (c *Client) List(ctx context.Context, scopeId string, opt ...Option) (*Targ
 	opts, apiOpts := getOpts(opt...)
 	opts.queryMap["scope_id"] = scopeId
 
-	req, err := c.client.NewRequest(ctx, "GET", "targets", nil, apiOpts...)
-	if err != nil {
-		return nil, fmt.Errorf("error creating List request: %w", err)
-	}
+	// The items are listed in pages; each response carries the token to
+	// request the next page until all the items have been listed.
+	target := new(TargetListResult)
+	for {
+		req, err := c.client.NewRequest(ctx, "GET", "targets", nil, apiOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error creating List request: %w", err)
+		}
 
-	if len(opts.queryMap) > 0 {
-		q := url.Values{}
-		for k, v := range opts.queryMap {
-			q.Add(k, v)
+		if len(opts.queryMap) > 0 {
+			q := url.Values{}
+			for k, v := range opts.queryMap {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
 		}
-		req.URL.RawQuery = q.Encode()
-	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error performing client request during List call: %w", err)
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error performing client request during List call: %w", err)
+		}
 
-	target := new(TargetListResult)
-	apiErr, err := resp.Decode(target)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding List response: %w", err)
-	}
-	if apiErr != nil {
-		return nil, apiErr
+		page := new(struct {
+			Items     []*Target
+			ListToken string `json:"list_token"`
+		})
+		apiErr, err := resp.Decode(page)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding List response: %w", err)
+		}
+		if apiErr != nil {
+			return nil, apiErr
+		}
+		target.Items = append(target.Items, page.Items...)
+		if target.response == nil {
+			target.response = resp
+		} else if err := target.response.AppendItems(resp); err != nil {
+			return nil, fmt.Errorf("error merging List responses: %w", err)
+		}
+		if page.ListToken == "" {
+			break
+		}
+		opts.queryMap["list_token"] = page.ListToken
 	}
-	target.response = resp
 	return target, nil
 }
 
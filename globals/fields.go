@@ -45,6 +45,11 @@ const (
 	SessionConnectionLimitField          = "session_connection_limit"
 	SessionMaxSecondsField               = "session_max_seconds"
 	WorkerFilterField                    = "worker_filter"
+	UserSessionLimitField                = "user_session_limit"
+	SessionMaxBytesUpField               = "session_max_bytes_up"
+	SessionMaxBytesDownField             = "session_max_bytes_down"
+	ConnectionIdleTimeoutSecondsField    = "connection_idle_timeout_seconds"
+	HostSelectionStrategyField           = "host_selection_strategy"
 	AccountIdsField                      = "account_ids"
 	AccountsField                        = "accounts"
 	LoginNameField                       = "login_name"
@@ -72,4 +77,12 @@ const (
 	ConnectionsField                     = "connections"
 	CredentialTypeField                  = "credential_type"
 	CredentialMappingOverridesField      = "credential_mapping_overrides"
+	JustificationField                   = "justification"
+	DurationSecondsField                 = "duration_seconds"
+	ReviewerIdField                      = "reviewer_id"
+	ReviewCommentField                   = "review_comment"
+	RoleIdField                          = "role_id"
+	DisabledField                        = "disabled"
+	HealthField                          = "health"
+	HealthCheckField                     = "health_check"
 )
@@ -0,0 +1,91 @@
+package accessrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/accessrequest/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// An AccessRequest is a request of a user for access to a target for a
+// duration.
+type AccessRequest struct {
+	*store.AccessRequest
+	tableName string `gorm:"-"`
+}
+
+// NewAccessRequest creates a new in memory pending AccessRequest of userId
+// for access to targetId, in scopeId the scope of the target, for
+// duration. All options are ignored.
+func NewAccessRequest(ctx context.Context, scopeId, targetId, userId, justification string, duration time.Duration, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.NewAccessRequest"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case targetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	case userId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case justification == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing justification")
+	case duration < time.Second:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "duration must be at least one second")
+	}
+	return &AccessRequest{
+		AccessRequest: &store.AccessRequest{
+			ScopeId:         scopeId,
+			TargetId:        targetId,
+			UserId:          userId,
+			Justification:   justification,
+			DurationSeconds: uint32(duration / time.Second),
+			Status:          StatusPending.String(),
+		},
+	}, nil
+}
+
+func allocAccessRequest() *AccessRequest {
+	return &AccessRequest{
+		AccessRequest: &store.AccessRequest{},
+	}
+}
+
+func (ar *AccessRequest) clone() *AccessRequest {
+	cp := proto.Clone(ar.AccessRequest)
+	return &AccessRequest{
+		AccessRequest: cp.(*store.AccessRequest),
+	}
+}
+
+// Duration returns how long access is granted for once the request is
+// approved.
+func (ar *AccessRequest) Duration() time.Duration {
+	return time.Duration(ar.DurationSeconds) * time.Second
+}
+
+// TableName returns the table name.
+func (ar *AccessRequest) TableName() string {
+	if ar.tableName != "" {
+		return ar.tableName
+	}
+	return "access_request"
+}
+
+// SetTableName sets the table name.
+func (ar *AccessRequest) SetTableName(n string) {
+	ar.tableName = n
+}
+
+func (ar *AccessRequest) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{ar.PublicId},
+		"resource-type":      []string{"access-request"},
+		"op-type":            []string{op.String()},
+	}
+	if ar.ScopeId != "" {
+		metadata["scope-id"] = []string{ar.ScopeId}
+	}
+	return metadata
+}
@@ -0,0 +1,9 @@
+// Package accessrequest provides just-in-time access to targets. A user
+// requests access to a target for a duration, with a justification. A
+// reviewer, any user granted the approve or deny action on the request,
+// then approves or denies it.
+//
+// Approving a request creates a role in the scope of the target which
+// grants the user access to the target. Once the duration has elapsed, the
+// ExpirationJob deletes the role and marks the request as expired.
+package accessrequest
@@ -0,0 +1,123 @@
+package accessrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	expirationJobName = "access_request_expiration"
+
+	defaultExpirationNextRunIn = time.Minute
+)
+
+// RegisterJobs registers the access request jobs with the scheduler.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+	const op = "accessrequest.RegisterJobs"
+	expiration, err := newExpirationJob(r, w, kms)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, expiration); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("access request expiration job"))
+	}
+	return nil
+}
+
+// ExpirationJob is the recurring job that expires approved access requests
+// once their duration has elapsed, deleting the roles granting access to
+// their targets. Access does not depend on the job: the principal of the
+// role stops applying at the expiration time. The ExpirationJob is not thread safe, an attempt to Run the
+// job concurrently will result in an JobAlreadyRunning error.
+type ExpirationJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+
+	limit      int
+	running    ua.Bool
+	numExpired int
+}
+
+// newExpirationJob creates a new in-memory ExpirationJob.
+//
+// WithLimit is the only supported option.
+func newExpirationJob(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*ExpirationJob, error) {
+	const op = "accessrequest.newExpirationJob"
+	switch {
+	case r == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &ExpirationJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		limit:  opts.withLimit,
+	}, nil
+}
+
+// Status returns the current status of the access request expiration job.
+func (j *ExpirationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numExpired,
+		Total:     j.numExpired,
+	}
+}
+
+// Run expires the approved access requests whose duration has elapsed. Can
+// not be run in parallel, if Run is invoked while already running an error
+// with code JobAlreadyRunning will be returned.
+func (j *ExpirationJob) Run(ctx context.Context) error {
+	const op = "accessrequest.(ExpirationJob).Run"
+	if !j.running.CAS(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	repo, err := NewRepository(j.reader, j.writer, j.kms, WithLimit(j.limit))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.numExpired, err = repo.ExpireAccessRequests(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn determines when the next access request expiration job should
+// run.
+func (j *ExpirationJob) NextRunIn() (time.Duration, error) {
+	return defaultExpirationNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (j *ExpirationJob) Name() string {
+	return expirationJobName
+}
+
+// Description is the human readable description of the job.
+func (j *ExpirationJob) Description() string {
+	return "Periodically expires approved access requests whose duration has elapsed and deletes the roles granting access to their targets."
+}
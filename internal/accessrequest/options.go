@@ -0,0 +1,69 @@
+package accessrequest
+
+import "github.com/hashicorp/boundary/internal/filter"
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withLimit            int
+	withStartPageAfterId string
+	withUserId           string
+	withFilterConditions []filter.Condition
+	withReviewComment    string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
+// WithUserId provides an option to only list the access requests of a
+// user.
+func WithUserId(userId string) Option {
+	return func(o *options) {
+		o.withUserId = userId
+	}
+}
+
+// WithFilterConditions provides an option to only list the items matching
+// the conditions of a list filter which can be evaluated by the database.
+func WithFilterConditions(c []filter.Condition) Option {
+	return func(o *options) {
+		o.withFilterConditions = c
+	}
+}
+
+// WithReviewComment provides an optional comment when approving or denying
+// an access request.
+func WithReviewComment(comment string) Option {
+	return func(o *options) {
+		o.withReviewComment = comment
+	}
+}
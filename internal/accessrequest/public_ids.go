@@ -0,0 +1,20 @@
+package accessrequest
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// AccessRequestPrefix is the PublicId prefix of access requests.
+const AccessRequestPrefix = "areq"
+
+func newAccessRequestId(ctx context.Context) (string, error) {
+	const op = "accessrequest.newAccessRequestId"
+	id, err := db.NewPublicId(AccessRequestPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,57 @@
+package accessrequest
+
+import (
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+// filterColumns are the columns of the fields of an access request which can
+// be used in the filter conditions of a list.
+var filterColumns = map[string]string{
+	"scope_id":    "scope_id",
+	"target_id":   "target_id",
+	"user_id":     "user_id",
+	"status":      "status",
+	"reviewer_id": "reviewer_id",
+}
+
+// A Repository stores and retrieves the persistent types in the
+// accessrequest package. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it. WithLimit option is used as a repo wide default
+// limit applied to all ListX methods.
+func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
+	const op = "accessrequest.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "db.Reader")
+	case w == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "db.Writer")
+	case kms == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "kms")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
@@ -0,0 +1,416 @@
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/action"
+)
+
+// CreateAccessRequest inserts ar into the repository and returns a new
+// pending AccessRequest containing the access request's PublicId. ar is not
+// changed. ar must contain a valid ScopeId, TargetId and UserId, and the
+// ScopeId must be the scope of the target. ar must not contain a PublicId.
+// The PublicId is generated and assigned by this method.
+//
+// All options are ignored.
+func (r *Repository) CreateAccessRequest(ctx context.Context, ar *AccessRequest, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).CreateAccessRequest"
+	switch {
+	case ar == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil AccessRequest")
+	case ar.AccessRequest == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded AccessRequest")
+	case ar.ScopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	case ar.TargetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no target id")
+	case ar.UserId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no user id")
+	case ar.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	ar = ar.clone()
+	ar.Status = StatusPending.String()
+
+	id, err := newAccessRequestId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ar.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, ar.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newAccessRequest *AccessRequest
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newAccessRequest = ar.clone()
+			if err := w.Create(ctx, newAccessRequest, db.WithOplog(oplogWrapper, ar.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for target %s", ar.TargetId)))
+	}
+	return newAccessRequest, nil
+}
+
+// LookupAccessRequest returns the AccessRequest for publicId. Returns nil,
+// nil if no AccessRequest is found for publicId.
+func (r *Repository) LookupAccessRequest(ctx context.Context, publicId string, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).LookupAccessRequest"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	ar := allocAccessRequest()
+	ar.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, ar); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return ar, nil
+}
+
+// ListAccessRequests returns a slice of AccessRequests for the scopeIds,
+// ordered by public id. WithLimit, WithStartPageAfterId, WithUserId and
+// WithFilterConditions are the only options supported.
+func (r *Repository) ListAccessRequests(ctx context.Context, scopeIds []string, opt ...Option) ([]*AccessRequest, error) {
+	const op = "accessrequest.(Repository).ListAccessRequests"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scopeIds")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	where, args := "scope_id in (?)", []interface{}{scopeIds}
+	if opts.withUserId != "" {
+		where, args = where+" and user_id = ?", append(args, opts.withUserId)
+	}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	if len(opts.withFilterConditions) > 0 {
+		filterWhere, filterArgs, err := filter.Where(opts.withFilterConditions, filterColumns)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		where, args = where+" and "+filterWhere, append(args, filterArgs...)
+	}
+	var accessRequests []*AccessRequest
+	err := r.reader.SearchWhere(ctx, &accessRequests, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return accessRequests, nil
+}
+
+// ApproveAccessRequest approves the pending access request publicId on
+// behalf of reviewerId. It creates a role in the scope of the target which
+// grants the user of the request access to the target until the duration
+// of the request has elapsed, and returns the approved AccessRequest.
+// Users cannot approve their own requests.
+//
+// WithReviewComment is the only option supported.
+func (r *Repository) ApproveAccessRequest(ctx context.Context, publicId string, version uint32, reviewerId string, opt ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).ApproveAccessRequest"
+	return r.review(ctx, op, publicId, version, reviewerId, StatusApproved, opt...)
+}
+
+// DenyAccessRequest denies the pending access request publicId on behalf of
+// reviewerId and returns the denied AccessRequest. Users cannot deny their
+// own requests, they can cancel them instead.
+//
+// WithReviewComment is the only option supported.
+func (r *Repository) DenyAccessRequest(ctx context.Context, publicId string, version uint32, reviewerId string, opt ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).DenyAccessRequest"
+	return r.review(ctx, op, publicId, version, reviewerId, StatusDenied, opt...)
+}
+
+// CancelAccessRequest cancels the pending access request publicId and
+// returns the canceled AccessRequest. All options are ignored.
+func (r *Repository) CancelAccessRequest(ctx context.Context, publicId string, version uint32, _ ...Option) (*AccessRequest, error) {
+	const op = "accessrequest.(Repository).CancelAccessRequest"
+	switch {
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case version == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	}
+	ar, err := r.lookupPending(ctx, op, publicId)
+	if err != nil {
+		return nil, err
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, ar.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	ar.Status = StatusCanceled.String()
+	var returnedAccessRequest *AccessRequest
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedAccessRequest = ar.clone()
+			rowsUpdated, err := w.Update(ctx, returnedAccessRequest, []string{"Status"}, nil,
+				db.WithOplog(oplogWrapper, ar.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated access request and %d rows updated", rowsUpdated))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", publicId)))
+	}
+	return returnedAccessRequest, nil
+}
+
+// review sets the status of the pending access request publicId to approved
+// or denied. Approving the request creates the role granting access to the
+// target in the same transaction.
+func (r *Repository) review(ctx context.Context, op errors.Op, publicId string, version uint32, reviewerId string, status Status, opt ...Option) (*AccessRequest, error) {
+	switch {
+	case publicId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case version == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	case reviewerId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no reviewer id")
+	}
+	opts := getOpts(opt...)
+
+	ar, err := r.lookupPending(ctx, op, publicId)
+	if err != nil {
+		return nil, err
+	}
+	if ar.UserId == reviewerId {
+		return nil, errors.New(ctx, errors.Forbidden, op, "users cannot review their own access requests")
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, ar.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	ar.Status = status.String()
+	ar.ReviewerId = reviewerId
+	ar.ReviewComment = opts.withReviewComment
+	dbMask := []string{"Status", "ReviewerId"}
+	var nullFields []string
+	if ar.ReviewComment != "" {
+		dbMask = append(dbMask, "ReviewComment")
+	} else {
+		nullFields = append(nullFields, "ReviewComment")
+	}
+
+	var role *iam.Role
+	var roleGrant *iam.RoleGrant
+	var userRole *iam.UserRole
+	if status == StatusApproved {
+		ar.ExpirationTime = timestamp.New(time.Now().Add(ar.Duration()))
+		dbMask = append(dbMask, "ExpirationTime")
+		if role, roleGrant, userRole, err = accessRole(ctx, ar); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ar.RoleId = role.PublicId
+		dbMask = append(dbMask, "RoleId")
+	}
+
+	var returnedAccessRequest *AccessRequest
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 4)
+			ticket, err := w.GetTicket(ar)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			if role != nil {
+				// The role must exist before the access request references it.
+				var roleMsg, roleGrantMsg, userRoleMsg oplog.Message
+				if err := w.Create(ctx, role.Clone(), db.NewOplogMsg(&roleMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create role"))
+				}
+				if err := w.Create(ctx, roleGrant.Clone(), db.NewOplogMsg(&roleGrantMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add role grant"))
+				}
+				if err := w.Create(ctx, userRole.Clone(), db.NewOplogMsg(&userRoleMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add role principal"))
+				}
+				msgs = append(msgs, &roleMsg, &roleGrantMsg, &userRoleMsg)
+			}
+
+			returnedAccessRequest = ar.clone()
+			var arMsg oplog.Message
+			rowsUpdated, err := w.Update(ctx, returnedAccessRequest, dbMask, nullFields, db.NewOplogMsg(&arMsg), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated access request and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &arMsg)
+
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, ar.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", publicId)))
+	}
+	return returnedAccessRequest, nil
+}
+
+// lookupPending returns the access request publicId, returning an error if
+// it does not exist or is not pending.
+func (r *Repository) lookupPending(ctx context.Context, op errors.Op, publicId string) (*AccessRequest, error) {
+	ar, err := r.LookupAccessRequest(ctx, publicId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if ar == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("access request %s not found", publicId))
+	}
+	if ar.Status != StatusPending.String() {
+		return nil, errors.New(ctx, errors.InvalidAccessRequestState, op, fmt.Sprintf("access request %s is %s", publicId, ar.Status))
+	}
+	return ar, nil
+}
+
+// accessRole returns the role, its grant and its principal granting the
+// user of the approved access request ar access to its target. The principal
+// role does not apply after the expiration time of ar, so access ends on time
+// even if the ExpirationJob, which cleans up the role, runs late.
+func accessRole(ctx context.Context, ar *AccessRequest) (*iam.Role, *iam.RoleGrant, *iam.UserRole, error) {
+	const op = "accessrequest.accessRole"
+	role, err := iam.NewRole(ar.ScopeId,
+		iam.WithName(fmt.Sprintf("access request %s", ar.PublicId)),
+		iam.WithDescription(fmt.Sprintf("Grants access to target %s until %s, approved by access request %s.",
+			ar.TargetId, ar.ExpirationTime.AsTime().UTC().Format(time.RFC3339), ar.PublicId)))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if role.PublicId, err = db.NewPublicId(iam.RolePrefix); err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	grant := fmt.Sprintf("id=%s;actions=%s,%s", ar.TargetId, action.Read.String(), action.AuthorizeSession.String())
+	roleGrant, err := iam.NewRoleGrant(role.PublicId, grant)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	userRole, err := iam.NewUserRole(role.PublicId, ar.UserId, iam.WithNotAfter(ar.ExpirationTime.AsTime()))
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return role, roleGrant, userRole, nil
+}
+
+// ExpireAccessRequests marks the approved access requests whose access has
+// expired as expired and deletes their roles. It returns the number of
+// access requests expired.
+//
+// WithLimit is the only option supported.
+func (r *Repository) ExpireAccessRequests(ctx context.Context, opt ...Option) (int, error) {
+	const op = "accessrequest.(Repository).ExpireAccessRequests"
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+
+	var expired []*AccessRequest
+	err := r.reader.SearchWhere(ctx, &expired, "status = ? and expiration_time <= now()", []interface{}{StatusApproved.String()}, db.WithLimit(limit), db.WithOrder("expiration_time"))
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	var count int
+	for _, ar := range expired {
+		if err := r.expire(ctx, ar); err != nil {
+			return count, errors.Wrap(ctx, err, op)
+		}
+		count++
+	}
+	return count, nil
+}
+
+// expire marks the access request ar as expired and deletes its role.
+func (r *Repository) expire(ctx context.Context, ar *AccessRequest) error {
+	const op = "accessrequest.(Repository).expire"
+	oplogWrapper, err := r.kms.GetWrapper(ctx, ar.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	version := ar.Version
+	roleId := ar.RoleId
+	ar = ar.clone()
+	ar.Status = StatusExpired.String()
+	ar.RoleId = ""
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 2)
+			ticket, err := w.GetTicket(ar)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			var arMsg oplog.Message
+			rowsUpdated, err := w.Update(ctx, ar.clone(), []string{"Status"}, []string{"RoleId"}, db.NewOplogMsg(&arMsg), db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated access request and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &arMsg)
+
+			// The role may have already been deleted, in which case role_id
+			// was set to null.
+			if roleId != "" {
+				role, err := iam.NewRole(ar.ScopeId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				role.PublicId = roleId
+				var roleMsg oplog.Message
+				if _, err := w.Delete(ctx, role, db.NewOplogMsg(&roleMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete role"))
+				}
+				msgs = append(msgs, &roleMsg)
+			}
+
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, ar.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", ar.PublicId)))
+	}
+	return nil
+}
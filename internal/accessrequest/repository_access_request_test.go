@@ -0,0 +1,247 @@
+package accessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateAccessRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iamRepo)
+	_, otherPrj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test")
+	user := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	newRequest := func(scopeId string) *AccessRequest {
+		ar, err := NewAccessRequest(ctx, scopeId, tar.GetPublicId(), user.GetPublicId(), "incident 42", time.Hour)
+		require.NoError(t, err)
+		return ar
+	}
+
+	tests := []struct {
+		name     string
+		in       *AccessRequest
+		wantCode errors.Code
+	}{
+		{
+			name:     "nil-request",
+			wantCode: errors.InvalidParameter,
+		},
+		{
+			name:     "no-scope",
+			in:       allocAccessRequest(),
+			wantCode: errors.InvalidParameter,
+		},
+		{
+			name: "public-id-set",
+			in: func() *AccessRequest {
+				ar := newRequest(prj.GetPublicId())
+				ar.PublicId = "areq_1234567890"
+				return ar
+			}(),
+			wantCode: errors.InvalidParameter,
+		},
+		{
+			name:     "scope-not-target-scope",
+			in:       newRequest(otherPrj.GetPublicId()),
+			wantCode: errors.Exception,
+		},
+		{
+			name: "valid",
+			in:   newRequest(prj.GetPublicId()),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateAccessRequest(ctx, tt.in)
+			if tt.wantCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantCode), err), "want err: %q got: %q", tt.wantCode, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.PublicId)
+			assert.Equal(StatusPending.String(), got.Status)
+			assert.Equal(uint32(3600), got.DurationSeconds)
+			assert.NoError(db.TestVerifyOplog(t, rw, got.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+			found, err := repo.LookupAccessRequest(ctx, got.PublicId)
+			require.NoError(err)
+			assert.Equal(got.Justification, found.Justification)
+		})
+	}
+}
+
+func TestRepository_ReviewAccessRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test")
+	user := iam.TestUser(t, iamRepo, org.GetPublicId())
+	reviewer := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := TestAccessRequest(t, conn, prj.GetPublicId(), tar.GetPublicId(), user.GetPublicId())
+		got, err := repo.ApproveAccessRequest(ctx, ar.PublicId, ar.Version, reviewer.GetPublicId(), WithReviewComment("ok"))
+		require.NoError(err)
+		assert.Equal(StatusApproved.String(), got.Status)
+		assert.Equal(reviewer.GetPublicId(), got.ReviewerId)
+		assert.Equal("ok", got.ReviewComment)
+		require.NotEmpty(got.RoleId)
+		assert.WithinDuration(time.Now().Add(time.Hour), got.ExpirationTime.AsTime(), time.Minute)
+
+		role, principals, grants, err := iamRepo.LookupRole(ctx, got.RoleId)
+		require.NoError(err)
+		assert.Equal(prj.GetPublicId(), role.ScopeId)
+		require.Len(principals, 1)
+		assert.Equal(user.GetPublicId(), principals[0].PrincipalId)
+		assert.True(got.ExpirationTime.AsTime().Equal(principals[0].NotAfter.AsTime()))
+		require.Len(grants, 1)
+		assert.Equal("id="+tar.GetPublicId()+";actions=read,authorize-session", grants[0].CanonicalGrant)
+
+		// Only pending requests can be reviewed.
+		_, err = repo.DenyAccessRequest(ctx, got.PublicId, got.Version, reviewer.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.InvalidAccessRequestState), err), "unexpected error: %v", err)
+	})
+
+	t.Run("access-ends-at-expiration", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar, err := NewAccessRequest(ctx, prj.GetPublicId(), tar.GetPublicId(), user.GetPublicId(), "testing", time.Second)
+		require.NoError(err)
+		ar.PublicId, err = newAccessRequestId(ctx)
+		require.NoError(err)
+		require.NoError(rw.Create(ctx, ar))
+
+		got, err := repo.ApproveAccessRequest(ctx, ar.PublicId, ar.Version, reviewer.GetPublicId())
+		require.NoError(err)
+		hasAccess := func() bool {
+			tuples, err := iamRepo.GrantsForUser(ctx, user.GetPublicId())
+			require.NoError(err)
+			for _, tuple := range tuples {
+				if tuple.RoleId == got.RoleId {
+					return true
+				}
+			}
+			return false
+		}
+		assert.True(hasAccess())
+
+		// The expiration job does not run: the access request stays approved
+		// but no longer grants access.
+		time.Sleep(time.Until(got.ExpirationTime.AsTime()) + 100*time.Millisecond)
+		assert.False(hasAccess())
+		got, err = repo.LookupAccessRequest(ctx, got.PublicId)
+		require.NoError(err)
+		assert.Equal(StatusApproved.String(), got.Status)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := TestAccessRequest(t, conn, prj.GetPublicId(), tar.GetPublicId(), user.GetPublicId())
+		got, err := repo.DenyAccessRequest(ctx, ar.PublicId, ar.Version, reviewer.GetPublicId())
+		require.NoError(err)
+		assert.Equal(StatusDenied.String(), got.Status)
+		assert.Empty(got.RoleId)
+		assert.Nil(got.ExpirationTime)
+	})
+
+	t.Run("self-review", func(t *testing.T) {
+		assert := assert.New(t)
+		ar := TestAccessRequest(t, conn, prj.GetPublicId(), tar.GetPublicId(), user.GetPublicId())
+		_, err := repo.ApproveAccessRequest(ctx, ar.PublicId, ar.Version, user.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.Forbidden), err), "unexpected error: %v", err)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := TestAccessRequest(t, conn, prj.GetPublicId(), tar.GetPublicId(), user.GetPublicId())
+		got, err := repo.CancelAccessRequest(ctx, ar.PublicId, ar.Version)
+		require.NoError(err)
+		assert.Equal(StatusCanceled.String(), got.Status)
+
+		_, err = repo.ApproveAccessRequest(ctx, got.PublicId, got.Version, reviewer.GetPublicId())
+		assert.Truef(errors.Match(errors.T(errors.InvalidAccessRequestState), err), "unexpected error: %v", err)
+	})
+
+	t.Run("not-found", func(t *testing.T) {
+		_, err := repo.ApproveAccessRequest(ctx, "areq_1234567890", 1, reviewer.GetPublicId())
+		assert.Truef(t, errors.IsNotFoundError(err), "unexpected error: %v", err)
+	})
+}
+
+func TestRepository_ExpireAccessRequests(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iamRepo)
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test")
+	user := iam.TestUser(t, iamRepo, org.GetPublicId())
+	reviewer := iam.TestUser(t, iamRepo, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	approve := func() *AccessRequest {
+		ar := TestAccessRequest(t, conn, prj.GetPublicId(), tar.GetPublicId(), user.GetPublicId())
+		ar, err := repo.ApproveAccessRequest(ctx, ar.PublicId, ar.Version, reviewer.GetPublicId())
+		require.NoError(err)
+		return ar
+	}
+	current, expired := approve(), approve()
+	expired.ExpirationTime = timestamp.New(time.Now().Add(-time.Minute))
+	_, err = rw.Update(ctx, expired, []string{"ExpirationTime"}, nil)
+	require.NoError(err)
+
+	count, err := repo.ExpireAccessRequests(ctx)
+	require.NoError(err)
+	assert.Equal(1, count)
+
+	got, err := repo.LookupAccessRequest(ctx, expired.PublicId)
+	require.NoError(err)
+	assert.Equal(StatusExpired.String(), got.Status)
+	assert.Empty(got.RoleId)
+	role, _, _, err := iamRepo.LookupRole(ctx, expired.RoleId)
+	require.NoError(err)
+	assert.Nil(role)
+
+	got, err = repo.LookupAccessRequest(ctx, current.PublicId)
+	require.NoError(err)
+	assert.Equal(StatusApproved.String(), got.Status)
+	assert.Equal(current.RoleId, got.RoleId)
+}
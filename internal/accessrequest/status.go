@@ -0,0 +1,17 @@
+package accessrequest
+
+// Status of an access request.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusDenied   Status = "denied"
+	StatusCanceled Status = "canceled"
+	StatusExpired  Status = "expired"
+)
+
+// String returns a string representation of the status.
+func (s Status) String() string {
+	return string(s)
+}
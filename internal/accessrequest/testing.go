@@ -0,0 +1,29 @@
+package accessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccessRequest creates a pending access request of userId for access
+// to targetId, in scopeId the scope of the target, for an hour. If any
+// errors are encountered during the creation of the access request, the
+// test will fail.
+func TestAccessRequest(t *testing.T, conn *db.DB, scopeId, targetId, userId string) *AccessRequest {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	ar, err := NewAccessRequest(ctx, scopeId, targetId, userId, "testing", time.Hour)
+	require.NoError(t, err)
+	id, err := newAccessRequestId(ctx)
+	require.NoError(t, err)
+	ar.PublicId = id
+
+	require.NoError(t, w.Create(ctx, ar))
+	return ar
+}
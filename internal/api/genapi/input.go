@@ -4,10 +4,12 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/boundary/internal/gen/controller/api"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accessrequests"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentiallibraries"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/groups"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
@@ -144,6 +146,16 @@ var inputStructs = []*structInfo{
 		outFile:     "plugins/plugin_info.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &scopes.PermissionGrant{},
+		outFile:     "scopes/permission_grant.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &scopes.PermissionCheck{},
+		outFile:     "scopes/permission_check.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &scopes.Scope{},
 		outFile: "scopes/scope.gen.go",
@@ -169,6 +181,30 @@ var inputStructs = []*structInfo{
 				FieldType: "bool",
 				Query:     true,
 			},
+			{
+				Name:        "ResourceId",
+				ProtoName:   "resource_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ResourceType",
+				ProtoName:   "resource_type",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ParentId",
+				ProtoName:   "parent_id",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+			{
+				Name:        "ClientIp",
+				ProtoName:   "client_ip",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
 		},
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -267,6 +303,26 @@ var inputStructs = []*structInfo{
 				VarName:   "grantStrings",
 			},
 		},
+		extraFields: []fieldInfo{
+			{
+				Name:        "NotBefore",
+				ProtoName:   "not_before",
+				FieldType:   "time.Time",
+				SkipDefault: true,
+			},
+			{
+				Name:        "NotAfter",
+				ProtoName:   "not_after",
+				FieldType:   "time.Time",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Condition",
+				ProtoName:   "condition",
+				FieldType:   "string",
+				SkipDefault: true,
+			},
+		},
 		pluralResourceName:  "roles",
 		versionEnabled:      true,
 		createResponseTypes: true,
@@ -288,6 +344,16 @@ var inputStructs = []*structInfo{
 		outFile:     "authmethods/oidc_auth_method_authenticate_start_response.gen.go",
 		subtypeName: "OidcAuthMethod",
 	},
+	{
+		inProto:     &authmethods.PasswordAuthMethodAuthenticateMfaChallengeResponse{},
+		outFile:     "authmethods/password_auth_method_authenticate_mfa_challenge_response.gen.go",
+		subtypeName: "PasswordAuthMethod",
+	},
+	{
+		inProto:     &authmethods.LdapAuthMethodAttributes{},
+		outFile:     "authmethods/ldap_auth_method_attributes.gen.go",
+		subtypeName: "LdapAuthMethod",
+	},
 	{
 		inProto: &authmethods.AuthMethod{},
 		outFile: "authmethods/authmethods.gen.go",
@@ -311,11 +377,20 @@ var inputStructs = []*structInfo{
 		outFile:     "accounts/password_account_attributes.gen.go",
 		subtypeName: "PasswordAccount",
 	},
+	{
+		inProto: &accounts.WebAuthnCredential{},
+		outFile: "accounts/webauthn_credential.gen.go",
+	},
 	{
 		inProto:     &accounts.OidcAccountAttributes{},
 		outFile:     "accounts/oidc_account_attributes.gen.go",
 		subtypeName: "OidcAccount",
 	},
+	{
+		inProto:     &accounts.LdapAccountAttributes{},
+		outFile:     "accounts/ldap_account_attributes.gen.go",
+		subtypeName: "LdapAccount",
+	},
 	{
 		inProto: &accounts.Account{},
 		outFile: "accounts/account.gen.go",
@@ -344,6 +419,17 @@ var inputStructs = []*structInfo{
 			},
 		},
 	},
+	{
+		inProto:     &managedgroups.LdapManagedGroupAttributes{},
+		outFile:     "managedgroups/ldap_managed_group_attributes.gen.go",
+		subtypeName: "LdapManagedGroup",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "GroupNames",
+				SkipDefault: true,
+			},
+		},
+	},
 	{
 		inProto: &managedgroups.ManagedGroup{},
 		outFile: "managedgroups/managedgroups.gen.go",
@@ -496,6 +582,64 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto:     &credentials.UsernamePasswordAttributes{},
+		outFile:     "credentials/username_password_attributes.gen.go",
+		subtypeName: "UsernamePasswordCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Username",
+				SkipDefault: true,
+			},
+			{
+				Name:        "Password",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto:     &credentials.SshPrivateKeyAttributes{},
+		outFile:     "credentials/ssh_private_key_attributes.gen.go",
+		subtypeName: "SshPrivateKeyCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Username",
+				SkipDefault: true,
+			},
+			{
+				Name:        "PrivateKey",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto:     &credentials.JsonAttributes{},
+		outFile:     "credentials/json_attributes.gen.go",
+		subtypeName: "JsonCredential",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Object",
+				SkipDefault: true,
+			},
+		},
+	},
+	{
+		inProto: &credentials.Credential{},
+		outFile: "credentials/credential.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			createTemplate,
+			readTemplate,
+			updateTemplate,
+			deleteTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "credentials",
+		parentTypeName:      "credential-store",
+		typeOnCreate:        true,
+		versionEnabled:      true,
+		createResponseTypes: true,
+	},
 	// Host related resources
 	{
 		inProto: &hostcatalogs.HostCatalog{},
@@ -545,11 +689,24 @@ var inputStructs = []*structInfo{
 		versionEnabled:      true,
 		createResponseTypes: true,
 	},
+	{
+		inProto: &hosts.HostHealth{},
+		outFile: "hosts/host_health.gen.go",
+	},
 	{
 		inProto:     &hosts.StaticHostAttributes{},
 		outFile:     "hosts/static_host_attributes.gen.go",
 		subtypeName: "StaticHost",
 	},
+	{
+		inProto:     &hostsets.StaticHostSetAttributes{},
+		outFile:     "hostsets/static_host_set_attributes.gen.go",
+		subtypeName: "StaticHostSet",
+	},
+	{
+		inProto: &hostsets.HealthCheck{},
+		outFile: "hostsets/health_check.gen.go",
+	},
 	{
 		inProto: &hostsets.HostSet{},
 		outFile: "hostsets/host_set.gen.go",
@@ -617,6 +774,11 @@ var inputStructs = []*structInfo{
 		outFile:     "targets/tcp_target_attributes.gen.go",
 		subtypeName: "TcpTarget",
 	},
+	{
+		inProto:     &targets.SshTargetAttributes{},
+		outFile:     "targets/ssh_target_attributes.gen.go",
+		subtypeName: "SshTarget",
+	},
 	{
 		inProto: &targets.Target{},
 		outFile: "targets/target.gen.go",
@@ -689,6 +851,10 @@ var inputStructs = []*structInfo{
 		inProto: &sessions.WorkerInfo{},
 		outFile: "sessions/workers.gen.go",
 	},
+	{
+		inProto: &sessions.Recording{},
+		outFile: "sessions/recording.gen.go",
+	},
 	{
 		inProto: &sessions.Connection{},
 		outFile: "sessions/connection.gen.go",
@@ -706,4 +872,17 @@ var inputStructs = []*structInfo{
 		fieldFilter:         []string{"private_key"},
 		recursiveListing:    true,
 	},
+	{
+		inProto: &accessrequests.AccessRequest{},
+		outFile: "accessrequests/access_request.gen.go",
+		templates: []*template.Template{
+			clientTemplate,
+			createTemplate,
+			readTemplate,
+			listTemplate,
+		},
+		pluralResourceName:  "access-requests",
+		createResponseTypes: true,
+		recursiveListing:    true,
+	},
 }
@@ -66,7 +66,7 @@ func parsePBs() {
 					name = fmt.Sprintf("%s.%s", pkg, name)
 				}
 				switch name {
-				case "v1.AuthorizedCollectionActionsEntry":
+				case "v1.AuthorizedCollectionActionsEntry", "v1.TagsEntry":
 					fi.FieldType = "map[string][]string"
 				default:
 					fi.FieldType = sliceText + ptr + name
@@ -97,6 +97,7 @@ var (
 	boolValueName   = (&wrapperspb.BoolValue{}).ProtoReflect().Descriptor().FullName()
 	uInt32ValueName = (&wrapperspb.UInt32Value{}).ProtoReflect().Descriptor().FullName()
 	int32ValueName  = (&wrapperspb.Int32Value{}).ProtoReflect().Descriptor().FullName()
+	int64ValueName  = (&wrapperspb.Int64Value{}).ProtoReflect().Descriptor().FullName()
 	structValueName = (&_struct.Struct{}).ProtoReflect().Descriptor().FullName()
 	timestampName   = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
 	valueName       = (&_struct.Value{}).ProtoReflect().Descriptor().FullName()
@@ -112,6 +113,8 @@ func messageKind(fd protoreflect.FieldDescriptor) (ptr, pkg, name string) {
 		return "", "", "uint32"
 	case int32ValueName:
 		return "", "", "int32"
+	case int64ValueName:
+		return "", "", "int64"
 	case structValueName:
 		return "", "", "map[string]interface{}"
 	case valueName:
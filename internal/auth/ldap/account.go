@@ -0,0 +1,145 @@
+package ldap
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAccountTableName defines the default table name for an Account
+const defaultAccountTableName = "auth_ldap_account"
+
+// Account contains an LDAP auth account. It is assigned to an LDAP AuthMethod
+// and updates/deletes to that AuthMethod are cascaded to its Accounts.
+type Account struct {
+	*store.Account
+	tableName string
+}
+
+// NewAccount creates a new in memory Account assigned to an LDAP AuthMethod.
+// WithName, WithDescription, WithFullName, WithEmail, WithDn and
+// WithMemberOfGroups are the only valid options. All other options are
+// ignored.
+//
+// LoginName equals the username used to authenticate with the LDAP server. It
+// is stored in lower case since LDAP servers compare usernames case
+// insensitively.
+func NewAccount(ctx context.Context, authMethodId string, loginName string, opt ...Option) (*Account, error) {
+	const op = "ldap.NewAccount"
+	opts := getOpts(opt...)
+	a := &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethodId,
+			LoginName:    strings.ToLower(strings.TrimSpace(loginName)),
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			FullName:     opts.withFullName,
+			Email:        opts.withEmail,
+			Dn:           opts.withDn,
+		},
+	}
+	if len(opts.withMemberOfGroups) > 0 {
+		if err := a.setMemberOfGroups(ctx, opts.withMemberOfGroups); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the Account.  On success, it will return nil.
+func (a *Account) validate(ctx context.Context, caller errors.Op) error {
+	if a.AuthMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing auth method id")
+	}
+	if a.LoginName == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing login name")
+	}
+	if a.Email != "" && len(a.Email) > 320 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "email address is too long")
+	}
+	if a.FullName != "" && len(a.FullName) > 512 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "full name is too long")
+	}
+	return nil
+}
+
+// setMemberOfGroups encodes groups as the json array stored in
+// MemberOfGroups.
+func (a *Account) setMemberOfGroups(ctx context.Context, groups []string) error {
+	const op = "ldap.(Account).setMemberOfGroups"
+	if len(groups) == 0 {
+		a.MemberOfGroups = ""
+		return nil
+	}
+	enc, err := json.Marshal(groups)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "unable to encode member of groups", errors.WithWrap(err))
+	}
+	a.MemberOfGroups = string(enc)
+	return nil
+}
+
+// GetMemberOfGroupNames returns the decoded LDAP groups of the Account.
+func (a *Account) GetMemberOfGroupNames(ctx context.Context) ([]string, error) {
+	const op = "ldap.(Account).GetMemberOfGroupNames"
+	if a.MemberOfGroups == "" {
+		return nil, nil
+	}
+	var groups []string
+	if err := json.Unmarshal([]byte(a.MemberOfGroups), &groups); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to decode member of groups", errors.WithWrap(err))
+	}
+	return groups, nil
+}
+
+// AllocAccount makes an empty one in memory
+func AllocAccount() *Account {
+	return &Account{
+		Account: &store.Account{},
+	}
+}
+
+// Clone an Account.
+func (a *Account) Clone() *Account {
+	cp := proto.Clone(a.Account)
+	return &Account{
+		Account: cp.(*store.Account),
+	}
+}
+
+// TableName returns the table name.
+func (a *Account) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAccountTableName
+}
+
+// SetTableName sets the table name.
+func (a *Account) SetTableName(n string) {
+	a.tableName = n
+}
+
+// oplog will create oplog metadata for the Account.
+func (a *Account) oplog(op oplog.OpType, authMethodScopeId string) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap account"},
+		"op-type":            []string{op.String()},
+	}
+	if a.AuthMethodId != "" {
+		metadata["auth-method-id"] = []string{a.AuthMethodId}
+	}
+	if authMethodScopeId != "" {
+		metadata["scope-id"] = []string{authMethodScopeId}
+	}
+	return metadata
+}
@@ -0,0 +1,274 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultAuthMethodTableName defines the default table name for an AuthMethod
+const defaultAuthMethodTableName = "auth_ldap_method"
+
+// AuthMethod contains an LDAP auth method configuration. It is owned by a
+// scope.  AuthMethods can have Accounts, ManagedGroups, Urls and
+// Certificates.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string
+}
+
+// NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
+// WithUrls is required and must contain at least one LDAP URL. The URLs are
+// attempted in the order provided when authenticating.
+//
+// If WithBindCredential is provided, the bind password will be encrypted when
+// stored in the database and an hmac representation will also be stored when
+// ever the password changes.  The password is not returned via the API, the
+// hmac is returned so callers can determine if it's been updated.
+//
+// Supports the options of WithName, WithDescription, WithUrls,
+// WithCertificates, WithStartTls, WithInsecureTls, WithDiscoverDn,
+// WithAnonGroupSearch, WithUpnDomain, WithUserDn, WithUserAttr,
+// WithUserFilter, WithEnableGroups, WithGroupDn, WithGroupAttr,
+// WithGroupFilter and WithBindCredential.  All other options are ignored.
+func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.NewAuthMethod"
+	opts := getOpts(opt...)
+	a := &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			ScopeId:         scopeId,
+			Name:            opts.withName,
+			Description:     opts.withDescription,
+			StartTls:        opts.withStartTls,
+			InsecureTls:     opts.withInsecureTls,
+			DiscoverDn:      opts.withDiscoverDn,
+			AnonGroupSearch: opts.withAnonGroupSearch,
+			UpnDomain:       opts.withUpnDomain,
+			UserDn:          opts.withUserDn,
+			UserAttr:        opts.withUserAttr,
+			UserFilter:      opts.withUserFilter,
+			EnableGroups:    opts.withEnableGroups,
+			GroupDn:         opts.withGroupDn,
+			GroupAttr:       opts.withGroupAttr,
+			GroupFilter:     opts.withGroupFilter,
+			BindDn:          opts.withBindDn,
+			BindPassword:    opts.withBindPassword,
+		},
+	}
+	if len(opts.withUrls) > 0 {
+		a.Urls = make([]string, 0, len(opts.withUrls))
+		for _, u := range opts.withUrls {
+			a.Urls = append(a.Urls, u.String())
+		}
+	}
+	if len(opts.withCertificates) > 0 {
+		pem, err := EncodeCertificates(ctx, opts.withCertificates...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		a.Certificates = pem
+	}
+	if err := a.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	return a, nil
+}
+
+// validate the AuthMethod.  On success, it will return nil.
+func (a *AuthMethod) validate(ctx context.Context, caller errors.Op) error {
+	if a.ScopeId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing scope id")
+	}
+	if len(a.Urls) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing urls (there must be at least one)")
+	}
+	for _, u := range a.Urls {
+		if err := validateUrl(ctx, u, caller); err != nil {
+			return err
+		}
+	}
+	if a.BindPassword != "" && a.BindDn == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "bind password requires a bind dn")
+	}
+	return nil
+}
+
+// validateUrl ensures u is a valid ldap:// or ldaps:// url.
+func validateUrl(ctx context.Context, u string, caller errors.Op) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("%q is not a valid url", u), errors.WithWrap(err))
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "ldap", "ldaps":
+	default:
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("%q scheme must be ldap or ldaps", u))
+	}
+	if parsed.Host == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("%q is missing a host", u))
+	}
+	return nil
+}
+
+// AllocAuthMethod makes an empty one in memory
+func AllocAuthMethod() AuthMethod {
+	return AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+// Clone an AuthMethod.
+func (a *AuthMethod) Clone() *AuthMethod {
+	cp := proto.Clone(a.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (a *AuthMethod) TableName() string {
+	if a.tableName != "" {
+		return a.tableName
+	}
+	return defaultAuthMethodTableName
+}
+
+// SetTableName sets the table name.
+func (a *AuthMethod) SetTableName(n string) {
+	a.tableName = n
+}
+
+// oplog will create oplog metadata for the AuthMethod.
+func (a *AuthMethod) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{a.GetPublicId()},
+		"resource-type":      []string{"ldap auth method"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{a.ScopeId},
+	}
+	return metadata
+}
+
+// encrypt the auth method's bind password before writing it to the db. It is
+// a no-op if the auth method does not have a bind password.
+func (a *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).encrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if a.BindPassword == "" {
+		return nil
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	a.KeyId = cipher.KeyID()
+	if err := a.hmacBindPassword(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// decrypt the auth method's bind password after reading it from the db. It is
+// a no-op if the auth method does not have a bind password.
+func (a *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).decrypt"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	if len(a.CtBindPassword) == 0 {
+		return nil
+	}
+	if err := structwrapping.UnwrapStruct(ctx, cipher, a.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+// hmacBindPassword before writing it to the db
+func (a *AuthMethod) hmacBindPassword(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "ldap.(AuthMethod).hmacBindPassword"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, []byte(a.BindPassword), cipher, []byte(a.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Code(errors.Encryption)))
+	}
+	a.BindPasswordHmac = []byte(hm)
+	return nil
+}
+
+type convertedValues struct {
+	Urls  []interface{}
+	Certs []interface{}
+}
+
+// convertValueObjects converts the embedded value objects. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertValueObjects(ctx context.Context) (*convertedValues, error) {
+	const op = "ldap.(AuthMethod).convertValueObjects"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	var err error
+	var addUrls, addCerts []interface{}
+	if addUrls, err = a.convertUrls(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if addCerts, err = a.convertCertificates(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &convertedValues{
+		Urls:  addUrls,
+		Certs: addCerts,
+	}, nil
+}
+
+// convertUrls converts the embedded urls from []string to []interface{} where
+// each slice element is a *Url. The connection priority of each Url is its
+// position in the slice, starting at 1. It will return an error if the
+// AuthMethod's public id is not set.
+func (a *AuthMethod) convertUrls(ctx context.Context) ([]interface{}, error) {
+	const op = "ldap.(AuthMethod).convertUrls"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newInterfaces := make([]interface{}, 0, len(a.Urls))
+	for i, u := range a.Urls {
+		obj, err := NewUrl(ctx, a.PublicId, i+1, u)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
+
+// convertCertificates converts the embedded certificates from []string
+// to []interface{} where each slice element is a *Certificate. It will return an
+// error if the AuthMethod's public id is not set.
+func (a *AuthMethod) convertCertificates(ctx context.Context) ([]interface{}, error) {
+	const op = "ldap.(AuthMethod).convertCertificates"
+	if a.PublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	newInterfaces := make([]interface{}, 0, len(a.Certificates))
+	for _, cert := range a.Certificates {
+		obj, err := NewCertificate(ctx, a.PublicId, cert)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		newInterfaces = append(newInterfaces, obj)
+	}
+	return newInterfaces, nil
+}
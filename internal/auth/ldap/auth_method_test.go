@@ -0,0 +1,166 @@
+package ldap
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAuthMethod_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	urls := TestConvertToUrls(t, "ldaps://alice.com", "ldap://bob.com:389")
+	httpsUrl, err := url.Parse("https://alice.com")
+	require.NoError(t, err)
+
+	type args struct {
+		scopeId string
+		opt     []Option
+	}
+	tests := []struct {
+		name            string
+		args            args
+		want            *AuthMethod
+		wantErr         bool
+		wantIsErr       errors.Code
+		wantErrContains string
+	}{
+		{
+			name: "valid",
+			args: args{
+				scopeId: "global",
+				opt: []Option{
+					WithName("alice's ldap"),
+					WithDescription("alice's description"),
+					WithUrls(urls...),
+					WithStartTls(),
+					WithDiscoverDn(),
+					WithUserDn("ou=people,dc=alice,dc=com"),
+					WithUserAttr("uid"),
+					WithEnableGroups(),
+					WithGroupDn("ou=groups,dc=alice,dc=com"),
+					WithBindCredential("cn=admin,dc=alice,dc=com", "secret"),
+				},
+			},
+			want: &AuthMethod{
+				AuthMethod: &store.AuthMethod{
+					ScopeId:      "global",
+					Name:         "alice's ldap",
+					Description:  "alice's description",
+					Urls:         []string{"ldaps://alice.com", "ldap://bob.com:389"},
+					StartTls:     true,
+					DiscoverDn:   true,
+					UserDn:       "ou=people,dc=alice,dc=com",
+					UserAttr:     "uid",
+					EnableGroups: true,
+					GroupDn:      "ou=groups,dc=alice,dc=com",
+					BindDn:       "cn=admin,dc=alice,dc=com",
+					BindPassword: "secret",
+				},
+			},
+		},
+		{
+			name: "missing-scope-id",
+			args: args{
+				opt: []Option{WithUrls(urls...)},
+			},
+			wantErr:         true,
+			wantIsErr:       errors.InvalidParameter,
+			wantErrContains: "missing scope id",
+		},
+		{
+			name: "missing-urls",
+			args: args{
+				scopeId: "global",
+			},
+			wantErr:         true,
+			wantIsErr:       errors.InvalidParameter,
+			wantErrContains: "missing urls",
+		},
+		{
+			name: "invalid-url-scheme",
+			args: args{
+				scopeId: "global",
+				opt:     []Option{WithUrls(urls[0], httpsUrl)},
+			},
+			wantErr:         true,
+			wantIsErr:       errors.InvalidParameter,
+			wantErrContains: "scheme must be ldap or ldaps",
+		},
+		{
+			name: "bind-password-without-dn",
+			args: args{
+				scopeId: "global",
+				opt:     []Option{WithUrls(urls...), WithBindCredential("", "secret")},
+			},
+			wantErr:         true,
+			wantIsErr:       errors.InvalidParameter,
+			wantErrContains: "bind password requires a bind dn",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAuthMethod(ctx, tt.args.scopeId, tt.args.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err code: %q got: %q", tt.wantIsErr, err)
+				assert.Contains(err.Error(), tt.wantErrContains)
+				return
+			}
+			require.NoError(err)
+			assert.True(proto.Equal(tt.want.AuthMethod, got.AuthMethod))
+
+			cp := got.Clone()
+			assert.True(proto.Equal(got.AuthMethod, cp.AuthMethod))
+		})
+	}
+}
+
+func TestAuthMethod_convertUrls(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	am, err := NewAuthMethod(ctx, "global", WithUrls(TestConvertToUrls(t, "ldaps://alice.com", "ldap://bob.com")...))
+	require.NoError(err)
+
+	_, err = am.convertUrls(ctx)
+	require.Error(err)
+	assert.True(errors.Match(errors.T(errors.InvalidPublicId), err))
+
+	am.PublicId = "amldap_1234567890"
+	converted, err := am.convertUrls(ctx)
+	require.NoError(err)
+	require.Len(converted, 2)
+	for i, c := range converted {
+		u, ok := c.(*Url)
+		require.True(ok)
+		assert.Equal(am.PublicId, u.LdapMethodId)
+		assert.Equal(uint32(i+1), u.ConnectionPriority)
+		assert.Equal(am.Urls[i], u.ServerUrl)
+	}
+}
+
+func Test_parseAggregatedUrls(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	got, err := parseAggregatedUrls(ctx, []string{"2=ldap://bob.com", "10=ldap://eve.com", "1=ldaps://alice.com"})
+	require.NoError(err)
+	assert.Equal([]string{"ldaps://alice.com", "ldap://bob.com", "ldap://eve.com"}, got)
+
+	_, err = parseAggregatedUrls(ctx, []string{"ldap://bob.com"})
+	require.Error(err)
+	_, err = parseAggregatedUrls(ctx, []string{"one=ldap://bob.com"})
+	require.Error(err)
+}
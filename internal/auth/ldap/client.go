@@ -0,0 +1,381 @@
+package ldap
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// DefaultUserAttr is the attribute used to match the login name when an
+	// auth method does not specify one.
+	DefaultUserAttr = "cn"
+
+	// DefaultGroupAttr is the attribute of a group entry that is used as the
+	// group's name when an auth method does not specify one.
+	DefaultGroupAttr = "cn"
+
+	// DefaultUserFilter is the template used to search for the user's entry
+	// when an auth method does not specify one.
+	DefaultUserFilter = "({{.UserAttr}}={{.Username}})"
+
+	// DefaultGroupFilter is the template used to search for the groups a
+	// user is a member of when an auth method does not specify one.
+	DefaultGroupFilter = "(|(memberUid={{.Username}})(member={{.UserDN}})(uniqueMember={{.UserDN}}))"
+
+	// defaultTimeout is the timeout applied to connecting to, and every
+	// request sent to, the LDAP server.
+	defaultTimeout = 30 * time.Second
+)
+
+// userAttributes are the attributes of a user's entry which are read when the
+// user authenticates.
+var userAttributes = []string{"displayName", "cn", "mail"}
+
+// AuthResult contains the information about a user returned by the directory
+// after they've successfully authenticated.
+type AuthResult struct {
+	// Dn is the distinguished name of the user's entry.
+	Dn string
+	// FullName is the user's displayName or cn.
+	FullName string
+	// Email is the user's mail.
+	Email string
+	// Groups are the names of the groups the user is a member of. It is
+	// only populated when the auth method has groups enabled.
+	Groups []string
+}
+
+// client authenticates users against the directory described by an
+// AuthMethod.
+type client struct {
+	am   *AuthMethod
+	conn *ldap.Conn
+}
+
+// newClient creates a client for the AuthMethod. The AuthMethod's bind
+// password must already be decrypted.
+func newClient(ctx context.Context, am *AuthMethod) (*client, error) {
+	const op = "ldap.newClient"
+	if am == nil || am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if len(am.Urls) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing urls (there must be at least one)")
+	}
+	return &client{am: am}, nil
+}
+
+// connect dials the auth method's urls in order and returns after the first
+// successful connection.
+func (c *client) connect(ctx context.Context) error {
+	const op = "ldap.(client).connect"
+	var certPool *x509.CertPool
+	if len(c.am.Certificates) > 0 {
+		certs, err := ParseCertificates(ctx, c.am.Certificates...)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		certPool = x509.NewCertPool()
+		for _, cert := range certs {
+			certPool.AddCert(cert)
+		}
+	}
+
+	var connErrs *multierror.Error
+	for _, rawUrl := range c.am.Urls {
+		u, err := url.Parse(rawUrl)
+		if err != nil {
+			connErrs = multierror.Append(connErrs, fmt.Errorf("%q: %w", rawUrl, err))
+			continue
+		}
+		host := u.Hostname()
+		tlsConfig := &tls.Config{
+			ServerName:         host,
+			RootCAs:            certPool,
+			InsecureSkipVerify: c.am.InsecureTls,
+			MinVersion:         tls.VersionTLS12,
+		}
+		dialer := &net.Dialer{Timeout: defaultTimeout}
+		var conn *ldap.Conn
+		switch strings.ToLower(u.Scheme) {
+		case "ldaps":
+			conn, err = ldap.DialURL(rawUrl, ldap.DialWithDialer(dialer), ldap.DialWithTLSConfig(tlsConfig))
+		default:
+			conn, err = ldap.DialURL(rawUrl, ldap.DialWithDialer(dialer))
+			if err == nil && c.am.StartTls {
+				if err = conn.StartTLS(tlsConfig); err != nil {
+					conn.Close()
+				}
+			}
+		}
+		if err != nil {
+			connErrs = multierror.Append(connErrs, fmt.Errorf("%q: %w", rawUrl, err))
+			continue
+		}
+		conn.SetTimeout(defaultTimeout)
+		c.conn = conn
+		return nil
+	}
+	return errors.New(ctx, errors.Unavailable, op, "unable to connect to any ldap url", errors.WithWrap(connErrs.ErrorOrNil()))
+}
+
+// close the client's connection, if it has one.
+func (c *client) close() {
+	if c.conn != nil {
+		c.conn.Close()
+		c.conn = nil
+	}
+}
+
+// authenticate the loginName and password against the directory, returning
+// the user's attributes and, if enabled, their group names. An error with the
+// code errors.Unauthorized is returned when the directory rejects the
+// credentials.
+func (c *client) authenticate(ctx context.Context, loginName, password string) (*AuthResult, error) {
+	const op = "ldap.(client).authenticate"
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name")
+	}
+	if password == "" {
+		// an empty password would result in an unauthenticated bind which
+		// most servers treat as a success.
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+	}
+	if err := c.connect(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer c.close()
+
+	userDn, err := c.userDn(ctx, loginName)
+	switch {
+	case errors.Match(errors.T(errors.RecordNotFound), err):
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unauthorized), errors.WithMsg("unknown user"))
+	case err != nil:
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := c.conn.Bind(userDn, password); err != nil {
+		return nil, errors.New(ctx, errors.Unauthorized, op, "unable to authenticate user", errors.WithWrap(err))
+	}
+
+	var entry *ldap.Entry
+	switch {
+	case c.am.UpnDomain != "" && c.am.BindDn == "" && !c.am.DiscoverDn:
+		// the user bound with their userPrincipalName, so their entry has to
+		// be found to determine their dn.
+		filter := fmt.Sprintf("(userPrincipalName=%s)", ldap.EscapeFilter(userDn))
+		entry, err = c.search(ctx, c.am.UserDn, ldap.ScopeWholeSubtree, filter, userAttributes)
+	default:
+		entry, err = c.search(ctx, userDn, ldap.ScopeBaseObject, "(objectClass=*)", userAttributes)
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read user entry"))
+	}
+	result := &AuthResult{
+		Dn:    entry.DN,
+		Email: entry.GetEqualFoldAttributeValue("mail"),
+	}
+	if result.Dn == "" {
+		result.Dn = userDn
+	}
+	result.FullName = entry.GetEqualFoldAttributeValue("displayName")
+	if result.FullName == "" {
+		result.FullName = entry.GetEqualFoldAttributeValue("cn")
+	}
+
+	if c.am.EnableGroups {
+		groups, err := c.groups(ctx, loginName, result.Dn)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		result.Groups = groups
+	}
+	return result, nil
+}
+
+// userDn returns the dn of the user with the loginName. When either a bind dn
+// or discover dn is configured, the dn is discovered by searching for the
+// user's entry. Otherwise it's derived from the upn domain or the user attr
+// and user dn.
+func (c *client) userDn(ctx context.Context, loginName string) (string, error) {
+	const op = "ldap.(client).userDn"
+	switch {
+	case c.am.BindDn != "" || c.am.DiscoverDn:
+		if err := c.serviceBind(ctx); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		filter, err := c.userFilter(ctx, loginName)
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+		entry, err := c.search(ctx, c.am.UserDn, ldap.ScopeWholeSubtree, filter, []string{"dn"})
+		if err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to find user"))
+		}
+		return entry.DN, nil
+	case c.am.UpnDomain != "":
+		upn := fmt.Sprintf("%s@%s", loginName, c.am.UpnDomain)
+		return upn, nil
+	default:
+		if c.am.UserDn == "" {
+			return "", errors.New(ctx, errors.InvalidParameter, op, "missing user dn")
+		}
+		return fmt.Sprintf("%s=%s,%s", c.userAttr(), escapeDnValue(loginName), c.am.UserDn), nil
+	}
+}
+
+// groups returns the names of the groups the user is a member of.
+func (c *client) groups(ctx context.Context, loginName, userDn string) ([]string, error) {
+	const op = "ldap.(client).groups"
+	if c.am.GroupDn == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing group dn")
+	}
+	switch {
+	case c.am.AnonGroupSearch:
+		if err := c.conn.UnauthenticatedBind(""); err != nil {
+			return nil, errors.New(ctx, errors.Unknown, op, "unable to bind anonymously", errors.WithWrap(err))
+		}
+	case c.am.BindDn != "":
+		if err := c.serviceBind(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	filterTemplate := c.am.GroupFilter
+	if filterTemplate == "" {
+		filterTemplate = DefaultGroupFilter
+	}
+	filter, err := renderFilter(ctx, filterTemplate, map[string]string{
+		"UserDN":   ldap.EscapeFilter(userDn),
+		"Username": ldap.EscapeFilter(loginName),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	groupAttr := c.am.GroupAttr
+	if groupAttr == "" {
+		groupAttr = DefaultGroupAttr
+	}
+	res, err := c.conn.Search(ldap.NewSearchRequest(c.am.GroupDn, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false, filter, []string{groupAttr}, nil))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "unable to search for groups", errors.WithWrap(err))
+	}
+	groups := make([]string, 0, len(res.Entries))
+	for _, e := range res.Entries {
+		if name := e.GetEqualFoldAttributeValue(groupAttr); name != "" {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// serviceBind binds using the auth method's bind credentials, or anonymously
+// if it doesn't have any.
+func (c *client) serviceBind(ctx context.Context) error {
+	const op = "ldap.(client).serviceBind"
+	var err error
+	switch {
+	case c.am.BindDn != "" && c.am.BindPassword != "":
+		err = c.conn.Bind(c.am.BindDn, c.am.BindPassword)
+	default:
+		err = c.conn.UnauthenticatedBind(c.am.BindDn)
+	}
+	if err != nil {
+		return errors.New(ctx, errors.Unknown, op, "unable to bind", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// search returns the only entry matching the filter.  It's an error if zero
+// or more than one entry is found.
+func (c *client) search(ctx context.Context, baseDn string, scope int, filter string, attrs []string) (*ldap.Entry, error) {
+	const op = "ldap.(client).search"
+	res, err := c.conn.Search(ldap.NewSearchRequest(baseDn, scope, ldap.NeverDerefAliases, 0, 0, false, filter, attrs, nil))
+	if err != nil {
+		return nil, errors.New(ctx, errors.Unknown, op, "search failed", errors.WithWrap(err))
+	}
+	switch len(res.Entries) {
+	case 0:
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "no entries found")
+	case 1:
+		return res.Entries[0], nil
+	default:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%d entries found", len(res.Entries)))
+	}
+}
+
+// userFilter renders the auth method's user filter for the loginName.
+func (c *client) userFilter(ctx context.Context, loginName string) (string, error) {
+	const op = "ldap.(client).userFilter"
+	filterTemplate := c.am.UserFilter
+	if filterTemplate == "" {
+		filterTemplate = DefaultUserFilter
+	}
+	username := loginName
+	if c.am.UpnDomain != "" {
+		username = fmt.Sprintf("%s@%s", loginName, c.am.UpnDomain)
+	}
+	filter, err := renderFilter(ctx, filterTemplate, map[string]string{
+		"UserAttr": c.userAttr(),
+		"Username": ldap.EscapeFilter(username),
+	})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return filter, nil
+}
+
+func (c *client) userAttr() string {
+	if c.am.UserAttr != "" {
+		return c.am.UserAttr
+	}
+	return DefaultUserAttr
+}
+
+// renderFilter executes the filter template with the data and ensures the
+// result is a valid ldap filter.
+func renderFilter(ctx context.Context, filterTemplate string, data map[string]string) (string, error) {
+	const op = "ldap.renderFilter"
+	t, err := template.New("filter").Parse(filterTemplate)
+	if err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid filter template %q", filterTemplate), errors.WithWrap(err))
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to render filter template %q", filterTemplate), errors.WithWrap(err))
+	}
+	filter := buf.String()
+	if _, err := ldap.CompileFilter(filter); err != nil {
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid filter %q", filter), errors.WithWrap(err))
+	}
+	return filter, nil
+}
+
+// escapeDnValue escapes the special characters of an attribute value used in
+// a dn as described in RFC 4514.
+func escapeDnValue(v string) string {
+	var sb strings.Builder
+	for i, r := range v {
+		switch {
+		case strings.ContainsRune(`,+"\<>;=`, r):
+			sb.WriteRune('\\')
+		case r == '#' && i == 0:
+			sb.WriteRune('\\')
+		case r == ' ' && (i == 0 || i == len(v)-1):
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,326 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDirectoryEntries() []*TestEntry {
+	return []*TestEntry{
+		{
+			Dn:       "cn=admin,dc=example,dc=com",
+			Password: "admin-password",
+		},
+		{
+			Dn:       "uid=alice,ou=people,dc=example,dc=com",
+			Password: "alice-password",
+			Attributes: map[string][]string{
+				"uid":               {"alice"},
+				"cn":                {"alice"},
+				"displayName":       {"Alice Eve"},
+				"mail":              {"alice@example.com"},
+				"userPrincipalName": {"alice@example.com"},
+			},
+		},
+		{
+			Dn:       "uid=bob,ou=people,dc=example,dc=com",
+			Password: "bob-password",
+			Attributes: map[string][]string{
+				"uid": {"bob"},
+				"cn":  {"Bob"},
+			},
+		},
+		{
+			Dn: "cn=admins,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":     {"admins"},
+				"member": {"uid=alice,ou=people,dc=example,dc=com"},
+			},
+		},
+		{
+			Dn: "cn=developers,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":        {"developers"},
+				"memberUid": {"alice", "bob"},
+			},
+		},
+	}
+}
+
+func Test_clientAuthenticate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	plain := NewTestDirectory(t, false)
+	plain.SetEntries(testDirectoryEntries()...)
+	tlsDir := NewTestDirectory(t, true)
+	tlsDir.SetEntries(testDirectoryEntries()...)
+
+	tests := []struct {
+		name      string
+		am        *store.AuthMethod
+		loginName string
+		password  string
+		want      *AuthResult
+		wantCode  errors.Code
+	}{
+		{
+			name: "user-attr",
+			am: &store.AuthMethod{
+				Urls:     []string{plain.Url()},
+				UserDn:   "ou=people,dc=example,dc=com",
+				UserAttr: "uid",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want: &AuthResult{
+				Dn:       "uid=alice,ou=people,dc=example,dc=com",
+				FullName: "Alice Eve",
+				Email:    "alice@example.com",
+			},
+		},
+		{
+			name: "bind-dn-with-groups",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				UserAttr:     "uid",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+				EnableGroups: true,
+				GroupDn:      "ou=groups,dc=example,dc=com",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want: &AuthResult{
+				Dn:       "uid=alice,ou=people,dc=example,dc=com",
+				FullName: "Alice Eve",
+				Email:    "alice@example.com",
+				Groups:   []string{"admins", "developers"},
+			},
+		},
+		{
+			name: "discover-dn-with-user-filter",
+			am: &store.AuthMethod{
+				Urls:            []string{plain.Url()},
+				UserDn:          "dc=example,dc=com",
+				DiscoverDn:      true,
+				UserFilter:      "(&(uid={{.Username}})(cn=*))",
+				EnableGroups:    true,
+				AnonGroupSearch: true,
+				GroupDn:         "ou=groups,dc=example,dc=com",
+				GroupFilter:     "(memberUid={{.Username}})",
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			want: &AuthResult{
+				Dn:       "uid=bob,ou=people,dc=example,dc=com",
+				FullName: "Bob",
+				Groups:   []string{"developers"},
+			},
+		},
+		{
+			name: "upn-domain",
+			am: &store.AuthMethod{
+				Urls:      []string{plain.Url()},
+				UserDn:    "ou=people,dc=example,dc=com",
+				UpnDomain: "example.com",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			want: &AuthResult{
+				Dn:       "uid=alice,ou=people,dc=example,dc=com",
+				FullName: "Alice Eve",
+				Email:    "alice@example.com",
+			},
+		},
+		{
+			name: "start-tls",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				StartTls:     true,
+				Certificates: []string{plain.Cert()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				UserAttr:     "uid",
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			want: &AuthResult{
+				Dn:       "uid=bob,ou=people,dc=example,dc=com",
+				FullName: "Bob",
+			},
+		},
+		{
+			name: "ldaps-with-failover",
+			am: &store.AuthMethod{
+				Urls:         []string{"ldap://127.0.0.1:1", tlsDir.Url()},
+				Certificates: []string{tlsDir.Cert()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				UserAttr:     "uid",
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			want: &AuthResult{
+				Dn:       "uid=bob,ou=people,dc=example,dc=com",
+				FullName: "Bob",
+			},
+		},
+		{
+			name: "ldaps-insecure",
+			am: &store.AuthMethod{
+				Urls:        []string{tlsDir.Url()},
+				InsecureTls: true,
+				UserDn:      "ou=people,dc=example,dc=com",
+				UserAttr:    "uid",
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			want: &AuthResult{
+				Dn:       "uid=bob,ou=people,dc=example,dc=com",
+				FullName: "Bob",
+			},
+		},
+		{
+			name: "ldaps-unknown-ca",
+			am: &store.AuthMethod{
+				Urls:     []string{tlsDir.Url()},
+				UserDn:   "ou=people,dc=example,dc=com",
+				UserAttr: "uid",
+			},
+			loginName: "bob",
+			password:  "bob-password",
+			wantCode:  errors.Unavailable,
+		},
+		{
+			name: "bad-password",
+			am: &store.AuthMethod{
+				Urls:     []string{plain.Url()},
+				UserDn:   "ou=people,dc=example,dc=com",
+				UserAttr: "uid",
+			},
+			loginName: "alice",
+			password:  "bob-password",
+			wantCode:  errors.Unauthorized,
+		},
+		{
+			name: "unknown-user",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				UserAttr:     "uid",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "admin-password",
+			},
+			loginName: "eve",
+			password:  "eve-password",
+			wantCode:  errors.Unauthorized,
+		},
+		{
+			name: "bad-bind-password",
+			am: &store.AuthMethod{
+				Urls:         []string{plain.Url()},
+				UserDn:       "ou=people,dc=example,dc=com",
+				BindDn:       "cn=admin,dc=example,dc=com",
+				BindPassword: "wrong",
+			},
+			loginName: "alice",
+			password:  "alice-password",
+			wantCode:  errors.Unknown,
+		},
+		{
+			name: "missing-password",
+			am: &store.AuthMethod{
+				Urls:   []string{plain.Url()},
+				UserDn: "ou=people,dc=example,dc=com",
+			},
+			loginName: "alice",
+			wantCode:  errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			c, err := newClient(ctx, &AuthMethod{AuthMethod: tt.am})
+			require.NoError(err)
+			got, err := c.authenticate(ctx, tt.loginName, tt.password)
+			if tt.want == nil {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantCode), err), "want err code: %q got: %q", tt.wantCode, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want.Dn, got.Dn)
+			assert.Equal(tt.want.FullName, got.FullName)
+			assert.Equal(tt.want.Email, got.Email)
+			assert.ElementsMatch(tt.want.Groups, got.Groups)
+		})
+	}
+}
+
+func Test_renderFilter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		template string
+		data     map[string]string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "default-user-filter",
+			template: DefaultUserFilter,
+			data:     map[string]string{"UserAttr": "cn", "Username": "alice"},
+			want:     "(cn=alice)",
+		},
+		{
+			name:     "escaped",
+			template: "(uid={{.Username}})",
+			data:     map[string]string{"Username": `\28alice\29`},
+			want:     `(uid=\28alice\29)`,
+		},
+		{
+			name:     "invalid-template",
+			template: "(uid={{.Username)",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid-filter",
+			template: "uid={{.Username}}",
+			data:     map[string]string{"Username": "alice"},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := renderFilter(ctx, tt.template, tt.data)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
+func Test_escapeDnValue(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.Equal("alice", escapeDnValue("alice"))
+	assert.Equal(`alice\,bob`, escapeDnValue("alice,bob"))
+	assert.Equal(`\#alice`, escapeDnValue("#alice"))
+	assert.Equal(`\ alice\ `, escapeDnValue(" alice "))
+	assert.Equal(`a\=b\+c`, escapeDnValue("a=b+c"))
+}
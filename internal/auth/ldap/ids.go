@@ -0,0 +1,53 @@
+package ldap
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/intglobals"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := auth.Register(Subtype, AuthMethodPrefix, AccountPrefix, intglobals.LdapManagedGroupPrefix); err != nil {
+		panic(err)
+	}
+}
+
+const (
+	// AuthMethodPrefix defines the prefix for AuthMethod public ids.
+	AuthMethodPrefix = "amldap"
+	// AccountPrefix defines the prefix for Account public ids.
+	AccountPrefix = "acctldap"
+
+	Subtype = subtypes.Subtype("ldap")
+)
+
+func newAuthMethodId(ctx context.Context) (string, error) {
+	const op = "ldap.newAuthMethodId"
+	id, err := db.NewPublicId(AuthMethodPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newAccountId(ctx context.Context) (string, error) {
+	const op = "ldap.newAccountId"
+	id, err := db.NewPublicId(AccountPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newManagedGroupId(ctx context.Context) (string, error) {
+	const op = "ldap.newManagedGroupId"
+	id, err := db.NewPublicId(intglobals.LdapManagedGroupPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
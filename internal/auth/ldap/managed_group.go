@@ -0,0 +1,137 @@
+package ldap
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultManagedGroupTableName defines the default table name for a Managed Group
+const defaultManagedGroupTableName = "auth_ldap_managed_group"
+
+// ManagedGroup contains an LDAP managed group. It is assigned to an LDAP
+// AuthMethod and updates/deletes to that AuthMethod are cascaded to its Managed
+// Groups. An Account is a member of the ManagedGroup if the account is a
+// member of any of the LDAP groups in GroupNames.
+type ManagedGroup struct {
+	*store.ManagedGroup
+	tableName string
+}
+
+// NewManagedGroup creates a new in memory ManagedGroup assigned to LDAP
+// AuthMethod. Supported options are withName and withDescription.
+func NewManagedGroup(ctx context.Context, authMethodId string, groupNames []string, opt ...Option) (*ManagedGroup, error) {
+	const op = "ldap.NewManagedGroup"
+	opts := getOpts(opt...)
+	mg := &ManagedGroup{
+		ManagedGroup: &store.ManagedGroup{
+			AuthMethodId: authMethodId,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+		},
+	}
+	if err := mg.SetGroupNames(ctx, groupNames); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := mg.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+
+	return mg, nil
+}
+
+// validate the Managed Group.  On success, it will return nil.
+func (mg *ManagedGroup) validate(ctx context.Context, caller errors.Op) error {
+	if mg.AuthMethodId == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing auth method id")
+	}
+	if mg.GroupNames == "" {
+		return errors.New(ctx, errors.InvalidParameter, caller, "missing group names")
+	}
+	if _, err := mg.GetGroupNameList(ctx); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "invalid group names", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// SetGroupNames encodes names as the json array stored in GroupNames. Empty
+// names are not allowed.
+func (mg *ManagedGroup) SetGroupNames(ctx context.Context, names []string) error {
+	const op = "ldap.(ManagedGroup).SetGroupNames"
+	if len(names) == 0 {
+		mg.GroupNames = ""
+		return nil
+	}
+	for _, n := range names {
+		if n == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "empty group name")
+		}
+	}
+	enc, err := json.Marshal(names)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "unable to encode group names", errors.WithWrap(err))
+	}
+	mg.GroupNames = string(enc)
+	return nil
+}
+
+// GetGroupNameList returns the decoded LDAP group names of the ManagedGroup.
+func (mg *ManagedGroup) GetGroupNameList(ctx context.Context) ([]string, error) {
+	const op = "ldap.(ManagedGroup).GetGroupNameList"
+	if mg.GroupNames == "" {
+		return nil, nil
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(mg.GroupNames), &names); err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unable to decode group names", errors.WithWrap(err))
+	}
+	return names, nil
+}
+
+// AllocManagedGroup makes an empty one in memory
+func AllocManagedGroup() *ManagedGroup {
+	return &ManagedGroup{
+		ManagedGroup: &store.ManagedGroup{},
+	}
+}
+
+// Clone a ManagedGroup.
+func (mg *ManagedGroup) Clone() *ManagedGroup {
+	cp := proto.Clone(mg.ManagedGroup)
+	return &ManagedGroup{
+		ManagedGroup: cp.(*store.ManagedGroup),
+	}
+}
+
+// TableName returns the table name.
+func (mg *ManagedGroup) TableName() string {
+	if mg.tableName != "" {
+		return mg.tableName
+	}
+	return defaultManagedGroupTableName
+}
+
+// SetTableName sets the table name.
+func (mg *ManagedGroup) SetTableName(n string) {
+	mg.tableName = n
+}
+
+// oplog will create oplog metadata for the ManagedGroup.
+func (mg *ManagedGroup) oplog(op oplog.OpType, authMethodScopeId string) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{mg.GetPublicId()},
+		"resource-type":      []string{"ldap managed group"},
+		"op-type":            []string{op.String()},
+	}
+	if mg.AuthMethodId != "" {
+		metadata["auth-method-id"] = []string{mg.AuthMethodId}
+	}
+	if authMethodScopeId != "" {
+		metadata["scope-id"] = []string{authMethodScopeId}
+	}
+	return metadata
+}
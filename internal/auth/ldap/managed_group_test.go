@@ -0,0 +1,92 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagedGroup_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		authMethodId string
+		groupNames   []string
+		opt          []Option
+		wantNames    string
+		wantErrMatch *errors.Template
+	}{
+		{
+			name:         "valid",
+			authMethodId: "amldap_1234567890",
+			groupNames:   []string{"admins", "developers"},
+			opt:          []Option{WithName("name"), WithDescription("desc")},
+			wantNames:    `["admins","developers"]`,
+		},
+		{
+			name:         "missing-auth-method-id",
+			groupNames:   []string{"admins"},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "missing-group-names",
+			authMethodId: "amldap_1234567890",
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+		{
+			name:         "empty-group-name",
+			authMethodId: "amldap_1234567890",
+			groupNames:   []string{"admins", ""},
+			wantErrMatch: errors.T(errors.InvalidParameter),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewManagedGroup(ctx, tt.authMethodId, tt.groupNames, tt.opt...)
+			if tt.wantErrMatch != nil {
+				require.Error(err)
+				assert.Truef(errors.Match(tt.wantErrMatch, err), "want err: %q got: %q", tt.wantErrMatch, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantNames, got.GroupNames)
+			names, err := got.GetGroupNameList(ctx)
+			require.NoError(err)
+			assert.Equal(tt.groupNames, names)
+			assert.Equal("name", got.Name)
+			assert.Equal("desc", got.Description)
+		})
+	}
+}
+
+func TestAccount_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	got, err := NewAccount(ctx, "amldap_1234567890", " Alice ",
+		WithFullName("Alice Eve"),
+		WithEmail("alice@example.com"),
+		WithDn("uid=alice,dc=example,dc=com"),
+		WithMemberOfGroups("admins"),
+	)
+	require.NoError(err)
+	assert.Equal("alice", got.LoginName)
+	assert.Equal(`["admins"]`, got.MemberOfGroups)
+	groups, err := got.GetMemberOfGroupNames(ctx)
+	require.NoError(err)
+	assert.Equal([]string{"admins"}, groups)
+
+	_, err = NewAccount(ctx, "", "alice")
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	_, err = NewAccount(ctx, "amldap_1234567890", " ")
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+}
@@ -0,0 +1,262 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName              string
+	withDescription       string
+	withLimit             int
+	withPublicId          string
+	withReader            db.Reader
+	withOrderByCreateTime bool
+	ascending             bool
+	withStartPageAfterId  string
+	withUrls              []*url.URL
+	withCertificates      []*x509.Certificate
+	withStartTls          bool
+	withInsecureTls       bool
+	withDiscoverDn        bool
+	withAnonGroupSearch   bool
+	withUpnDomain         string
+	withUserDn            string
+	withUserAttr          string
+	withUserFilter        string
+	withEnableGroups      bool
+	withGroupDn           string
+	withGroupAttr         string
+	withGroupFilter       string
+	withBindDn            string
+	withBindPassword      string
+	withFullName          string
+	withEmail             string
+	withDn                string
+	withMemberOfGroups    []string
+	withAccessRevoker     auth.AccessRevoker
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit.  Intentionally allowing
+// negative integers.   If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithPublicId provides an option for passing a public id to the operation
+func WithPublicId(publicId string) Option {
+	return func(o *options) {
+		o.withPublicId = publicId
+	}
+}
+
+// WithReader provides an option for specifying a reader to use for the
+// operation.
+func WithReader(reader db.Reader) Option {
+	return func(o *options) {
+		o.withReader = reader
+	}
+}
+
+// WithOrderByCreateTime provides an option to specify ordering by the
+// CreateTime field.
+func WithOrderByCreateTime(ascending bool) Option {
+	return func(o *options) {
+		o.withOrderByCreateTime = true
+		o.ascending = ascending
+	}
+}
+
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
+// WithUrls provides the LDAP URLs of the servers for an auth method, in the
+// order they will be attempted.
+func WithUrls(urls ...*url.URL) Option {
+	return func(o *options) {
+		o.withUrls = urls
+	}
+}
+
+// WithCertificates provides optional certificates.
+func WithCertificates(certs ...*x509.Certificate) Option {
+	return func(o *options) {
+		o.withCertificates = certs
+	}
+}
+
+// WithStartTls provides an option to issue a StartTLS command after
+// establishing an unencrypted connection.
+func WithStartTls() Option {
+	return func(o *options) {
+		o.withStartTls = true
+	}
+}
+
+// WithInsecureTls provides an option to skip verification of the LDAP
+// server's certificate chain.
+func WithInsecureTls() Option {
+	return func(o *options) {
+		o.withInsecureTls = true
+	}
+}
+
+// WithDiscoverDn provides an option to use an anonymous bind to discover the
+// bind DN of a user.
+func WithDiscoverDn() Option {
+	return func(o *options) {
+		o.withDiscoverDn = true
+	}
+}
+
+// WithAnonGroupSearch provides an option to use an anonymous bind when
+// performing group searches.
+func WithAnonGroupSearch() Option {
+	return func(o *options) {
+		o.withAnonGroupSearch = true
+	}
+}
+
+// WithUpnDomain provides an optional userPrincipalDomain.
+func WithUpnDomain(domain string) Option {
+	return func(o *options) {
+		o.withUpnDomain = domain
+	}
+}
+
+// WithUserDn provides an optional base DN for user searches.
+func WithUserDn(dn string) Option {
+	return func(o *options) {
+		o.withUserDn = dn
+	}
+}
+
+// WithUserAttr provides an optional user attribute.
+func WithUserAttr(attr string) Option {
+	return func(o *options) {
+		o.withUserAttr = attr
+	}
+}
+
+// WithUserFilter provides an optional user search filter template.
+func WithUserFilter(filter string) Option {
+	return func(o *options) {
+		o.withUserFilter = filter
+	}
+}
+
+// WithEnableGroups provides an option to find the LDAP groups a user is a
+// member of when authenticating.
+func WithEnableGroups() Option {
+	return func(o *options) {
+		o.withEnableGroups = true
+	}
+}
+
+// WithGroupDn provides an optional base DN for group searches.
+func WithGroupDn(dn string) Option {
+	return func(o *options) {
+		o.withGroupDn = dn
+	}
+}
+
+// WithGroupAttr provides an optional group attribute.
+func WithGroupAttr(attr string) Option {
+	return func(o *options) {
+		o.withGroupAttr = attr
+	}
+}
+
+// WithGroupFilter provides an optional group search filter template.
+func WithGroupFilter(filter string) Option {
+	return func(o *options) {
+		o.withGroupFilter = filter
+	}
+}
+
+// WithBindCredential provides an optional DN and password to bind with when
+// performing user and group searches.
+func WithBindCredential(dn, password string) Option {
+	return func(o *options) {
+		o.withBindDn = dn
+		o.withBindPassword = password
+	}
+}
+
+// WithFullName provides an optional full name for the account.
+func WithFullName(n string) Option {
+	return func(o *options) {
+		o.withFullName = n
+	}
+}
+
+// WithEmail provides an optional email address for the account.
+func WithEmail(email string) Option {
+	return func(o *options) {
+		o.withEmail = email
+	}
+}
+
+// WithDn provides an optional distinguished name for the account.
+func WithDn(dn string) Option {
+	return func(o *options) {
+		o.withDn = dn
+	}
+}
+
+// WithMemberOfGroups provides optional LDAP groups for the account.
+func WithMemberOfGroups(groups ...string) Option {
+	return func(o *options) {
+		o.withMemberOfGroups = groups
+	}
+}
+
+// WithAccessRevoker provides an option to specify the AccessRevoker used to
+// revoke the access of the user of an account when the account is deleted.
+func WithAccessRevoker(r auth.AccessRevoker) Option {
+	return func(o *options) {
+		o.withAccessRevoker = r
+	}
+}
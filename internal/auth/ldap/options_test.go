@@ -0,0 +1,131 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_GetOpts provides unit tests for GetOpts and all the options
+func Test_GetOpts(t *testing.T) {
+	t.Parallel()
+	t.Run("WithName", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithName("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withName = "test"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithDescription", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithDescription("test desc"))
+		testOpts := getDefaultOptions()
+		testOpts.withDescription = "test desc"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithLimit(-1))
+		testOpts := getDefaultOptions()
+		testOpts.withLimit = -1
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithPublicId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithPublicId("amldap_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withPublicId = "amldap_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithReader", func(t *testing.T) {
+		assert := assert.New(t)
+		reader := &db.Db{}
+		opts := getOpts(WithReader(reader))
+		testOpts := getDefaultOptions()
+		testOpts.withReader = reader
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOrderByCreateTime", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithOrderByCreateTime(true))
+		testOpts := getDefaultOptions()
+		testOpts.withOrderByCreateTime = true
+		testOpts.ascending = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("acctldap_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "acctldap_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUrls", func(t *testing.T) {
+		assert := assert.New(t)
+		urls := TestConvertToUrls(t, "ldaps://alice.com", "ldap://bob.com")
+		opts := getOpts(WithUrls(urls...))
+		testOpts := getDefaultOptions()
+		testOpts.withUrls = urls
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithCertificates", func(t *testing.T) {
+		assert := assert.New(t)
+		cert := &x509.Certificate{}
+		opts := getOpts(WithCertificates(cert))
+		testOpts := getDefaultOptions()
+		testOpts.withCertificates = []*x509.Certificate{cert}
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("bools", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartTls(), WithInsecureTls(), WithDiscoverDn(), WithAnonGroupSearch(), WithEnableGroups())
+		testOpts := getDefaultOptions()
+		testOpts.withStartTls = true
+		testOpts.withInsecureTls = true
+		testOpts.withDiscoverDn = true
+		testOpts.withAnonGroupSearch = true
+		testOpts.withEnableGroups = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("search", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(
+			WithUpnDomain("alice.com"),
+			WithUserDn("ou=people,dc=alice,dc=com"),
+			WithUserAttr("uid"),
+			WithUserFilter("(uid={{.Username}})"),
+			WithGroupDn("ou=groups,dc=alice,dc=com"),
+			WithGroupAttr("cn"),
+			WithGroupFilter("(member={{.UserDN}})"),
+		)
+		testOpts := getDefaultOptions()
+		testOpts.withUpnDomain = "alice.com"
+		testOpts.withUserDn = "ou=people,dc=alice,dc=com"
+		testOpts.withUserAttr = "uid"
+		testOpts.withUserFilter = "(uid={{.Username}})"
+		testOpts.withGroupDn = "ou=groups,dc=alice,dc=com"
+		testOpts.withGroupAttr = "cn"
+		testOpts.withGroupFilter = "(member={{.UserDN}})"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithBindCredential", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithBindCredential("cn=admin", "secret"))
+		testOpts := getDefaultOptions()
+		testOpts.withBindDn = "cn=admin"
+		testOpts.withBindPassword = "secret"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("account", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithFullName("Alice Eve"), WithEmail("alice@alice.com"), WithDn("cn=alice"), WithMemberOfGroups("admin", "dev"))
+		testOpts := getDefaultOptions()
+		testOpts.withFullName = "Alice Eve"
+		testOpts.withEmail = "alice@alice.com"
+		testOpts.withDn = "cn=alice"
+		testOpts.withMemberOfGroups = []string{"admin", "dev"}
+		assert.Equal(opts, testOpts)
+	})
+}
@@ -0,0 +1,668 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	dbcommon "github.com/hashicorp/boundary/internal/db/common"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+const (
+	VersionField          = "Version"
+	NameField             = "Name"
+	DescriptionField      = "Description"
+	StartTlsField         = "StartTls"
+	InsecureTlsField      = "InsecureTls"
+	DiscoverDnField       = "DiscoverDn"
+	AnonGroupSearchField  = "AnonGroupSearch"
+	UpnDomainField        = "UpnDomain"
+	UserDnField           = "UserDn"
+	UserAttrField         = "UserAttr"
+	UserFilterField       = "UserFilter"
+	EnableGroupsField     = "EnableGroups"
+	GroupDnField          = "GroupDn"
+	GroupAttrField        = "GroupAttr"
+	GroupFilterField      = "GroupFilter"
+	BindDnField           = "BindDn"
+	BindPasswordField     = "BindPassword"
+	CtBindPasswordField   = "CtBindPassword"
+	BindPasswordHmacField = "BindPasswordHmac"
+	KeyIdField            = "KeyId"
+	UrlsField             = "Urls"
+	CertificatesField     = "Certificates"
+	GroupNamesField       = "GroupNames"
+)
+
+// CreateAuthMethod creates am (*AuthMethod) in the repo along with its
+// associated embedded value objects of Urls and Certificates and returns the
+// newly created AuthMethod (with its PublicId set)
+//
+// The AuthMethod's public id and version must be empty (zero values).
+//
+// WithPublicId is the only supported option.
+func (r *Repository) CreateAuthMethod(ctx context.Context, am *AuthMethod, opt ...Option) (*AuthMethod, error) {
+	const op = "ldap.(Repository).CreateAuthMethod"
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id must be empty")
+	}
+	if am.Version != 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "version must be empty")
+	}
+	if err := am.validate(ctx, op); err != nil {
+		return nil, err // validate properly sets the op to the caller, the code and the msg, so just return it.
+	}
+
+	am = am.Clone()
+	opts := getOpts(opt...)
+	am.PublicId = opts.withPublicId
+	if am.PublicId == "" {
+		id, err := newAuthMethodId(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		am.PublicId = id
+	} else {
+		if !strings.HasPrefix(am.PublicId, AuthMethodPrefix+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "wrong auth method id prefix")
+		}
+	}
+
+	vo, err := am.convertValueObjects(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := am.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var returnedAuthMethod *AuthMethod
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 3)
+			ticket, err := w.GetTicket(am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			returnedAuthMethod = am.Clone()
+			var amOplogMsg oplog.Message
+			if err := w.Create(ctx, returnedAuthMethod, db.NewOplogMsg(&amOplogMsg)); err != nil {
+				return err
+			}
+			msgs = append(msgs, &amOplogMsg)
+
+			if len(vo.Urls) > 0 {
+				urlOplogMsgs := make([]*oplog.Message, 0, len(vo.Urls))
+				if err := w.CreateItems(ctx, vo.Urls, db.NewOplogMsgs(&urlOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, urlOplogMsgs...)
+			}
+			if len(vo.Certs) > 0 {
+				certOplogMsgs := make([]*oplog.Message, 0, len(vo.Certs))
+				if err := w.CreateItems(ctx, vo.Certs, db.NewOplogMsgs(&certOplogMsgs)); err != nil {
+					return err
+				}
+				msgs = append(msgs, certOplogMsgs...)
+			}
+			metadata := am.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("in scope: %s: name %s already exists", am.ScopeId, am.Name))
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return returnedAuthMethod, nil
+}
+
+// LookupAuthMethod will lookup an auth method in the repo, along with its
+// associated Value Objects of Urls and Certificates. If it's not found, it
+// will return nil, nil. All options are ignored.
+func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, _ ...Option) (*AuthMethod, error) {
+	const op = "ldap.(Repository).LookupAuthMethod"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	ams, err := r.getAuthMethods(ctx, publicId, nil)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case len(ams) == 0:
+		return nil, nil // not an error to return no rows for a "lookup"
+	case len(ams) > 1:
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%s matched more than 1 ", publicId))
+	default:
+		return ams[0], nil
+	}
+}
+
+// ListAuthMethods returns a slice of AuthMethods for the scopeIds. The
+// WithLimit, WithOrderByCreateTime and WithStartPageAfterId options are
+// supported and all other options are ignored. Auth methods are ordered by
+// their public id unless WithOrderByCreateTime is used.
+func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "ldap.(Repository).ListAuthMethods"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope IDs")
+	}
+	authMethods, err := r.getAuthMethods(ctx, "", scopeIds, opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return authMethods, nil
+}
+
+// getAuthMethods allows the caller to either lookup a specific AuthMethod via
+// its id or search for a set AuthMethods within a set of scopes.  Passing both
+// scopeIds and a authMethod is an error. The WithLimit,
+// WithOrderByCreateTime and WithStartPageAfterId options are supported and all
+// other options are ignored.
+//
+// The AuthMethod returned has its value objects populated (Urls and
+// Certificates), its bind password decrypted and its IsPrimaryAuthMethod bool
+// set.
+//
+// When no record is found it returns nil, nil
+func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
+	const op = "ldap.(Repository).getAuthMethods"
+	if authMethodId == "" && len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing search criteria: both auth method id and Scope IDs are empty")
+	}
+	if authMethodId != "" && len(scopeIds) > 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "searching for both an auth method id and Scope IDs is not supported")
+	}
+
+	const aggregateDelimiter = "|"
+
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	dbArgs := []db.Option{db.WithLimit(limit)}
+	if opts.withOrderByCreateTime {
+		if opts.ascending {
+			dbArgs = append(dbArgs, db.WithOrder("create_time asc"))
+		} else {
+			dbArgs = append(dbArgs, db.WithOrder("create_time"))
+		}
+	} else {
+		dbArgs = append(dbArgs, db.WithOrder("public_id"))
+	}
+
+	var where string
+	var args []interface{}
+	switch {
+	case authMethodId != "":
+		where, args = "public_id = ?", append(args, authMethodId)
+	default:
+		where, args = "scope_id in(?)", append(args, scopeIds)
+	}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+
+	var aggAuthMethods []*authMethodAgg
+	if err := r.reader.SearchWhere(ctx, &aggAuthMethods, where, args, dbArgs...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(aggAuthMethods) == 0 { // we're done if nothing is found.
+		return nil, nil
+	}
+
+	authMethods := make([]*AuthMethod, 0, len(aggAuthMethods))
+	for _, agg := range aggAuthMethods {
+		am := AllocAuthMethod()
+		am.PublicId = agg.PublicId
+		am.ScopeId = agg.ScopeId
+		am.IsPrimaryAuthMethod = agg.IsPrimaryAuthMethod
+		am.Name = agg.Name
+		am.Description = agg.Description
+		am.CreateTime = agg.CreateTime
+		am.UpdateTime = agg.UpdateTime
+		am.Version = agg.Version
+		am.StartTls = agg.StartTls
+		am.InsecureTls = agg.InsecureTls
+		am.DiscoverDn = agg.DiscoverDn
+		am.AnonGroupSearch = agg.AnonGroupSearch
+		am.UpnDomain = agg.UpnDomain
+		am.UserDn = agg.UserDn
+		am.UserAttr = agg.UserAttr
+		am.UserFilter = agg.UserFilter
+		am.EnableGroups = agg.EnableGroups
+		am.GroupDn = agg.GroupDn
+		am.GroupAttr = agg.GroupAttr
+		am.GroupFilter = agg.GroupFilter
+		am.BindDn = agg.BindDn
+		am.CtBindPassword = agg.BindPassword
+		am.BindPasswordHmac = agg.BindPasswordHmac
+		am.KeyId = agg.KeyId
+		if agg.Urls != "" {
+			urls, err := parseAggregatedUrls(ctx, strings.Split(agg.Urls, aggregateDelimiter))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			am.Urls = urls
+		}
+		if agg.Certs != "" {
+			am.Certificates = strings.Split(agg.Certs, aggregateDelimiter)
+		}
+		if len(am.CtBindPassword) > 0 {
+			databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(am.KeyId))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+			}
+			if err := am.decrypt(ctx, databaseWrapper); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+		authMethods = append(authMethods, &am)
+	}
+	return authMethods, nil
+}
+
+// parseAggregatedUrls converts urls in the "priority=url" form returned by the
+// ldap_auth_method_with_value_obj view into a slice of urls ordered by their
+// connection priority.
+func parseAggregatedUrls(ctx context.Context, aggUrls []string) ([]string, error) {
+	const op = "ldap.parseAggregatedUrls"
+	type prioritizedUrl struct {
+		priority int
+		url      string
+	}
+	parsed := make([]prioritizedUrl, 0, len(aggUrls))
+	for _, u := range aggUrls {
+		parts := strings.SplitN(u, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%q is not a valid prioritized url", u))
+		}
+		p, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, fmt.Sprintf("%q is not a valid prioritized url", u), errors.WithWrap(err))
+		}
+		parsed = append(parsed, prioritizedUrl{priority: p, url: parts[1]})
+	}
+	sort.Slice(parsed, func(i, j int) bool {
+		return parsed[i].priority < parsed[j].priority
+	})
+	urls := make([]string, 0, len(parsed))
+	for _, p := range parsed {
+		urls = append(urls, p.url)
+	}
+	return urls, nil
+}
+
+// authMethodAgg is a view that aggregates the auth method's value objects in to
+// string fields delimited with the aggregateDelimiter of "|"
+type authMethodAgg struct {
+	PublicId            string `gorm:"primary_key"`
+	ScopeId             string
+	IsPrimaryAuthMethod bool
+	Name                string
+	Description         string
+	CreateTime          *timestamp.Timestamp
+	UpdateTime          *timestamp.Timestamp
+	Version             uint32
+	StartTls            bool
+	InsecureTls         bool
+	DiscoverDn          bool
+	AnonGroupSearch     bool
+	UpnDomain           string
+	UserDn              string
+	UserAttr            string
+	UserFilter          string
+	EnableGroups        bool
+	GroupDn             string
+	GroupAttr           string
+	GroupFilter         string
+	BindDn              string
+	BindPassword        []byte
+	BindPasswordHmac    []byte
+	KeyId               string
+	Urls                string
+	Certs               string
+}
+
+// TableName returns the table name for gorm
+func (agg *authMethodAgg) TableName() string { return "ldap_auth_method_with_value_obj" }
+
+// DeleteAuthMethod will delete the auth method from the repository.  It is
+// idempotent so if the auth method was not found, return 0 (no rows affected)
+// and nil.  No options are currently supported.
+func (r *Repository) DeleteAuthMethod(ctx context.Context, publicId string, _ ...Option) (int, error) {
+	const op = "ldap.(Repository).DeleteAuthMethod"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	am, err := r.LookupAuthMethod(ctx, publicId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		// already deleted and this is not an error.
+		return db.NoRowsAffected, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	metadata := am.oplog(oplog.OpType_OP_TYPE_DELETE)
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			cp := am.Clone()
+			rowsDeleted, err = w.Delete(ctx, cp, db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return err
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to delete %s", publicId)))
+	}
+	return rowsDeleted, nil
+}
+
+// UpdateAuthMethod will update the auth method in the repository and return
+// the written auth method. fieldMaskPaths provides field_mask.proto paths for
+// fields that should be updated.  Fields will be set to NULL if the field is a
+// zero value and included in fieldMask. Name, Description, StartTls,
+// InsecureTls, DiscoverDn, AnonGroupSearch, UpnDomain, UserDn, UserAttr,
+// UserFilter, EnableGroups, GroupDn, GroupAttr, GroupFilter, BindDn,
+// BindPassword, Urls and Certificates are all updatable fields.  Urls and
+// Certificates are replaced as a complete set. If no updatable fields are
+// included in the fieldMaskPaths, then an error is returned.
+//
+// No options are currently supported.
+func (r *Repository) UpdateAuthMethod(ctx context.Context, am *AuthMethod, version uint32, fieldMaskPaths []string, _ ...Option) (*AuthMethod, int, error) {
+	const op = "ldap.(Repository).UpdateAuthMethod"
+	if am == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if am.AuthMethod == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing auth method store")
+	}
+	if am.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(NameField, f):
+		case strings.EqualFold(DescriptionField, f):
+		case strings.EqualFold(StartTlsField, f):
+		case strings.EqualFold(InsecureTlsField, f):
+		case strings.EqualFold(DiscoverDnField, f):
+		case strings.EqualFold(AnonGroupSearchField, f):
+		case strings.EqualFold(UpnDomainField, f):
+		case strings.EqualFold(UserDnField, f):
+		case strings.EqualFold(UserAttrField, f):
+		case strings.EqualFold(UserFilterField, f):
+		case strings.EqualFold(EnableGroupsField, f):
+		case strings.EqualFold(GroupDnField, f):
+		case strings.EqualFold(GroupAttrField, f):
+		case strings.EqualFold(GroupFilterField, f):
+		case strings.EqualFold(BindDnField, f):
+		case strings.EqualFold(BindPasswordField, f):
+		case strings.EqualFold(UrlsField, f):
+		case strings.EqualFold(CertificatesField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+
+	dbMask, nullFields := dbcommon.BuildUpdatePaths(
+		map[string]interface{}{
+			NameField:            am.Name,
+			DescriptionField:     am.Description,
+			StartTlsField:        am.StartTls,
+			InsecureTlsField:     am.InsecureTls,
+			DiscoverDnField:      am.DiscoverDn,
+			AnonGroupSearchField: am.AnonGroupSearch,
+			UpnDomainField:       am.UpnDomain,
+			UserDnField:          am.UserDn,
+			UserAttrField:        am.UserAttr,
+			UserFilterField:      am.UserFilter,
+			EnableGroupsField:    am.EnableGroups,
+			GroupDnField:         am.GroupDn,
+			GroupAttrField:       am.GroupAttr,
+			GroupFilterField:     am.GroupFilter,
+			BindDnField:          am.BindDn,
+			BindPasswordField:    am.BindPassword,
+			UrlsField:            am.Urls,
+			CertificatesField:    am.Certificates,
+		},
+		fieldMaskPaths,
+		[]string{StartTlsField, InsecureTlsField, DiscoverDnField, AnonGroupSearchField, EnableGroupsField},
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+	if strutil.StrListContains(nullFields, UrlsField) {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing urls (there must be at least one)")
+	}
+
+	origAm, err := r.LookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if origAm == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s", am.PublicId))
+	}
+	if origAm.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("update version %d doesn't match db version %d", version, origAm.Version))
+	}
+
+	am = am.Clone()
+	am.ScopeId = origAm.ScopeId
+	switch {
+	case strutil.StrListContains(dbMask, BindPasswordField):
+		bindDn := origAm.BindDn
+		if strutil.StrListContains(dbMask, BindDnField) || strutil.StrListContains(nullFields, BindDnField) {
+			bindDn = am.BindDn
+		}
+		if bindDn == "" {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "bind password requires a bind dn")
+		}
+	case strutil.StrListContains(nullFields, BindDnField) && origAm.BindPassword != "" && !strutil.StrListContains(nullFields, BindPasswordField):
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "bind password requires a bind dn")
+	}
+
+	var addUrls, deleteUrls, addCerts, deleteCerts []interface{}
+	if strutil.StrListContains(dbMask, UrlsField) {
+		for _, u := range am.Urls {
+			if err := validateUrl(ctx, u, op); err != nil {
+				return nil, db.NoRowsAffected, err
+			}
+		}
+		if addUrls, err = am.convertUrls(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if deleteUrls, err = origAm.convertUrls(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+	if strutil.StrListContains(dbMask, CertificatesField) || strutil.StrListContains(nullFields, CertificatesField) {
+		if addCerts, err = am.convertCertificates(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		if deleteCerts, err = origAm.convertCertificates(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	var filteredDbMask, filteredNullFields []string
+	for _, f := range dbMask {
+		switch f {
+		case UrlsField, CertificatesField:
+			continue
+		case BindPasswordField:
+			filteredDbMask = append(filteredDbMask, CtBindPasswordField, BindPasswordHmacField, KeyIdField)
+		default:
+			filteredDbMask = append(filteredDbMask, f)
+		}
+	}
+	for _, f := range nullFields {
+		switch f {
+		case UrlsField, CertificatesField:
+			continue
+		case BindPasswordField:
+			filteredNullFields = append(filteredNullFields, CtBindPasswordField, BindPasswordHmacField, KeyIdField)
+		default:
+			filteredNullFields = append(filteredNullFields, f)
+		}
+	}
+
+	if strutil.StrListContains(filteredDbMask, CtBindPasswordField) {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := am.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, origAm.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 5) // AuthMethod, Urls*2, Certs*2
+			ticket, err := w.GetTicket(am)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			var authMethodOplogMsg oplog.Message
+			updatedAm := am.Clone()
+			switch {
+			case len(filteredDbMask) == 0 && len(filteredNullFields) == 0:
+				// only value objects are being updated, so just bump the
+				// version of the root aggregate.
+				updatedAm.Version = version + 1
+				rowsUpdated, err = w.Update(ctx, updatedAm, []string{VersionField}, nil, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method version"))
+				}
+			default:
+				rowsUpdated, err = w.Update(ctx, updatedAm, filteredDbMask, filteredNullFields, db.NewOplogMsg(&authMethodOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update auth method"))
+				}
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated auth method and %d rows updated", rowsUpdated))
+			}
+			msgs = append(msgs, &authMethodOplogMsg)
+
+			if len(deleteUrls) > 0 {
+				deleteUrlOplogMsgs := make([]*oplog.Message, 0, len(deleteUrls))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteUrls, db.NewOplogMsgs(&deleteUrlOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete urls"))
+				}
+				if rowsDeleted != len(deleteUrls) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("urls deleted %d did not match request for %d", rowsDeleted, len(deleteUrls)))
+				}
+				msgs = append(msgs, deleteUrlOplogMsgs...)
+			}
+			if len(addUrls) > 0 {
+				addUrlOplogMsgs := make([]*oplog.Message, 0, len(addUrls))
+				if err := w.CreateItems(ctx, addUrls, db.NewOplogMsgs(&addUrlOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add urls"))
+				}
+				msgs = append(msgs, addUrlOplogMsgs...)
+			}
+			if len(deleteCerts) > 0 {
+				deleteCertOplogMsgs := make([]*oplog.Message, 0, len(deleteCerts))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteCerts, db.NewOplogMsgs(&deleteCertOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete certificates"))
+				}
+				if rowsDeleted != len(deleteCerts) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("certificates deleted %d did not match request for %d", rowsDeleted, len(deleteCerts)))
+				}
+				msgs = append(msgs, deleteCertOplogMsgs...)
+			}
+			if len(addCerts) > 0 {
+				addCertOplogMsgs := make([]*oplog.Message, 0, len(addCerts))
+				if err := w.CreateItems(ctx, addCerts, db.NewOplogMsgs(&addCertOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add certificates"))
+				}
+				msgs = append(msgs, addCertOplogMsgs...)
+			}
+
+			metadata := am.oplog(oplog.OpType_OP_TYPE_UPDATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("name %s already exists: %s", am.Name, am.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	updated, err := r.LookupAuthMethod(ctx, am.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup auth method after update"))
+	}
+	if updated == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, "unable to lookup auth method after update")
+	}
+	return updated, rowsUpdated, nil
+}
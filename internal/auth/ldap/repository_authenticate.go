@@ -0,0 +1,183 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+const (
+	FullNameField       = "FullName"
+	EmailField          = "Email"
+	DnField             = "Dn"
+	MemberOfGroupsField = "MemberOfGroups"
+)
+
+// Authenticate authenticates loginName and password against the LDAP
+// directory configured by the auth method authMethodId. If the credentials
+// are valid, the account for loginName is returned. The account is created
+// the first time a user authenticates and its full name, email, dn and group
+// memberships are refreshed from the directory every time after that.
+//
+// The account's membership in the auth method's managed groups is set to the
+// managed groups which contain at least one of the LDAP groups the user is a
+// member of.  Group names are compared case insensitively.
+//
+// Returns nil, nil if the credentials are rejected by the directory.
+func (r *Repository) Authenticate(ctx context.Context, authMethodId, loginName, password string, _ ...Option) (*Account, error) {
+	const op = "ldap.(Repository).Authenticate"
+	if authMethodId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method id")
+	}
+	if loginName == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing login name")
+	}
+	if password == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing password")
+	}
+
+	am, err := r.LookupAuthMethod(ctx, authMethodId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", authMethodId))
+	}
+
+	c, err := newClient(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	result, err := c.authenticate(ctx, loginName, password)
+	if err != nil {
+		if errors.Match(errors.T(errors.Unauthorized), err) {
+			// the directory rejected the credentials, which is not an error
+			// for the caller.
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	acct, err := r.upsertAccount(ctx, am, loginName, result)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// Get the set of all managed groups so we can filter
+	mgs, err := r.ListManagedGroups(ctx, am.GetPublicId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(mgs) > 0 {
+		userGroups := make(map[string]struct{}, len(result.Groups))
+		for _, g := range result.Groups {
+			userGroups[strings.ToLower(g)] = struct{}{}
+		}
+		matchedMgs := make([]*ManagedGroup, 0, len(mgs))
+		for _, mg := range mgs {
+			names, err := mg.GetGroupNameList(ctx)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+			for _, n := range names {
+				if _, ok := userGroups[strings.ToLower(n)]; ok {
+					matchedMgs = append(matchedMgs, mg)
+					break
+				}
+			}
+		}
+		// We always pass it in, even if none match, because in that case we
+		// need to remove any mappings that exist
+		if _, _, err := r.SetManagedGroupMemberships(ctx, am, acct, matchedMgs); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	return acct, nil
+}
+
+// upsertAccount creates the account for loginName if it doesn't exist yet.
+// Otherwise, it updates the account's full name, email, dn and group
+// memberships when they differ from the values returned by the directory.
+func (r *Repository) upsertAccount(ctx context.Context, am *AuthMethod, loginName string, result *AuthResult) (*Account, error) {
+	const op = "ldap.(Repository).upsertAccount"
+	if am == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth method")
+	}
+	if result == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing auth result")
+	}
+
+	opts := []Option{
+		WithFullName(result.FullName),
+		WithEmail(result.Email),
+		WithDn(result.Dn),
+		WithMemberOfGroups(result.Groups...),
+	}
+	want, err := NewAccount(ctx, am.PublicId, loginName, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	existing, err := r.lookupAccountByLoginName(ctx, am.PublicId, want.LoginName)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if existing == nil {
+		acct, err := r.CreateAccount(ctx, am.ScopeId, want)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return acct, nil
+	}
+
+	var dbMask, nullFields []string
+	for field, v := range map[string][2]string{
+		FullNameField:       {existing.FullName, want.FullName},
+		EmailField:          {existing.Email, want.Email},
+		DnField:             {existing.Dn, want.Dn},
+		MemberOfGroupsField: {existing.MemberOfGroups, want.MemberOfGroups},
+	} {
+		switch {
+		case v[0] == v[1]:
+		case v[1] == "":
+			nullFields = append(nullFields, field)
+		default:
+			dbMask = append(dbMask, field)
+		}
+	}
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return existing, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"), errors.WithCode(errors.Encrypt))
+	}
+	updated := existing.Clone()
+	updated.FullName = want.FullName
+	updated.Email = want.Email
+	updated.Dn = want.Dn
+	updated.MemberOfGroups = want.MemberOfGroups
+	metadata := updated.oplog(oplog.OpType_OP_TYPE_UPDATE, am.ScopeId)
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			rowsUpdated, err := w.Update(ctx, updated, dbMask, nullFields, db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 account to be updated and %d were updated", rowsUpdated))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(existing.PublicId))
+	}
+	return updated, nil
+}
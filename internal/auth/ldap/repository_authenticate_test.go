@@ -0,0 +1,129 @@
+package ldap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Authenticate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	ctx := context.Background()
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, org.PublicId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	dir := NewTestDirectory(t, false)
+	dir.SetEntries(testDirectoryEntries()...)
+
+	am := TestAuthMethod(t, conn, databaseWrapper, org.PublicId, []string{dir.Url()},
+		WithUserDn("ou=people,dc=example,dc=com"),
+		WithUserAttr("uid"),
+		WithBindCredential("cn=admin,dc=example,dc=com", "admin-password"),
+		WithEnableGroups(),
+		WithGroupDn("ou=groups,dc=example,dc=com"),
+	)
+	adminsMg := TestManagedGroup(t, conn, am, []string{"Admins"})
+	devsMg := TestManagedGroup(t, conn, am, []string{"developers", "testers"})
+	opsMg := TestManagedGroup(t, conn, am, []string{"operators"})
+
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := repo.Authenticate(ctx, "", "alice", "alice-password")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.Authenticate(ctx, am.PublicId, "", "alice-password")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.Authenticate(ctx, am.PublicId, "alice", "")
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+		_, err = repo.Authenticate(ctx, "amldap_notfound", "alice", "alice-password")
+		assert.True(errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+	t.Run("bad-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, "alice", "wrong")
+		require.NoError(err)
+		assert.Nil(acct)
+	})
+	t.Run("first-login-creates-account", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, "Alice", "alice-password")
+		require.NoError(err)
+		require.NotNil(acct)
+		assert.Equal("alice", acct.LoginName)
+		assert.Equal("Alice Eve", acct.FullName)
+		assert.Equal("alice@example.com", acct.Email)
+		assert.Equal("uid=alice,ou=people,dc=example,dc=com", acct.Dn)
+		groups, err := acct.GetMemberOfGroupNames(ctx)
+		require.NoError(err)
+		assert.ElementsMatch([]string{"admins", "developers"}, groups)
+
+		memberships, err := repo.ListManagedGroupMembershipsByMember(ctx, acct.PublicId)
+		require.NoError(err)
+		var mgIds []string
+		for _, m := range memberships {
+			mgIds = append(mgIds, m.ManagedGroupId)
+		}
+		assert.ElementsMatch([]string{adminsMg.PublicId, devsMg.PublicId}, mgIds)
+
+		again, err := repo.Authenticate(ctx, am.PublicId, "alice", "alice-password")
+		require.NoError(err)
+		assert.Equal(acct.PublicId, again.PublicId)
+	})
+	t.Run("subsequent-login-updates-account", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		acct, err := repo.Authenticate(ctx, am.PublicId, "bob", "bob-password")
+		require.NoError(err)
+		require.NotNil(acct)
+
+		entries := testDirectoryEntries()
+		for _, e := range entries {
+			switch e.Dn {
+			case "uid=bob,ou=people,dc=example,dc=com":
+				e.Attributes["mail"] = []string{"bob@example.com"}
+			case "cn=developers,ou=groups,dc=example,dc=com":
+				e.Attributes["memberUid"] = []string{"alice"}
+			}
+		}
+		entries = append(entries, &TestEntry{
+			Dn: "cn=operators,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"cn":        {"operators"},
+				"memberUid": {"bob"},
+			},
+		})
+		dir.SetEntries(entries...)
+
+		updated, err := repo.Authenticate(ctx, am.PublicId, "bob", "bob-password")
+		require.NoError(err)
+		require.NotNil(updated)
+		assert.Equal(acct.PublicId, updated.PublicId)
+		assert.Equal("bob@example.com", updated.Email)
+
+		found, err := repo.LookupAccount(ctx, acct.PublicId)
+		require.NoError(err)
+		assert.Equal("bob@example.com", found.Email)
+		groups, err := found.GetMemberOfGroupNames(ctx)
+		require.NoError(err)
+		assert.Equal([]string{"operators"}, groups)
+
+		memberships, err := repo.ListManagedGroupMembershipsByMember(ctx, acct.PublicId)
+		require.NoError(err)
+		require.Len(memberships, 1)
+		assert.Equal(opsMg.PublicId, memberships[0].ManagedGroupId)
+	})
+}
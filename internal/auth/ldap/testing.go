@@ -0,0 +1,495 @@
+package ldap
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/hashicorp/boundary/internal/db"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuthMethod creates a test ldap auth method in the repository.  The
+// auth method's urls, certificates and bind credentials are created from the
+// options provided.
+func TestAuthMethod(t *testing.T, conn *db.DB, databaseWrapper wrapping.Wrapper, scopeId string, urls []string, opt ...Option) *AuthMethod {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	opt = append(opt, WithUrls(TestConvertToUrls(t, urls...)...))
+	am, err := NewAuthMethod(ctx, scopeId, opt...)
+	require.NoError(err)
+	id, err := newAuthMethodId(ctx)
+	require.NoError(err)
+	am.PublicId = id
+	require.NoError(am.encrypt(ctx, databaseWrapper))
+	require.NoError(rw.Create(ctx, am))
+
+	vo, err := am.convertValueObjects(ctx)
+	require.NoError(err)
+	require.NoError(rw.CreateItems(ctx, vo.Urls))
+	if len(vo.Certs) > 0 {
+		require.NoError(rw.CreateItems(ctx, vo.Certs))
+	}
+	return am
+}
+
+// TestAccount creates a test ldap account for the auth method in the
+// repository.
+func TestAccount(t *testing.T, conn *db.DB, am *AuthMethod, loginName string, opt ...Option) *Account {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	a, err := NewAccount(ctx, am.PublicId, loginName, opt...)
+	require.NoError(err)
+
+	id, err := newAccountId(ctx)
+	require.NoError(err)
+	a.PublicId = id
+
+	require.NoError(rw.Create(ctx, a))
+	return a
+}
+
+// TestManagedGroup creates a test ldap managed group for the auth method in
+// the repository.
+func TestManagedGroup(t *testing.T, conn *db.DB, am *AuthMethod, groupNames []string, opt ...Option) *ManagedGroup {
+	t.Helper()
+	require := require.New(t)
+	rw := db.New(conn)
+	ctx := context.Background()
+
+	mg, err := NewManagedGroup(ctx, am.PublicId, groupNames, opt...)
+	require.NoError(err)
+
+	id, err := newManagedGroupId(ctx)
+	require.NoError(err)
+	mg.PublicId = id
+
+	require.NoError(rw.Create(ctx, mg))
+	return mg
+}
+
+// TestConvertToUrls will convert URL string representations to a slice of
+// *url.URL
+func TestConvertToUrls(t *testing.T, urls ...string) []*url.URL {
+	t.Helper()
+	require := require.New(t)
+	require.NotEmpty(urls)
+	var convertedUrls []*url.URL
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		require.NoError(err)
+		require.Contains([]string{"ldap", "ldaps"}, parsed.Scheme)
+		convertedUrls = append(convertedUrls, parsed)
+	}
+	return convertedUrls
+}
+
+// LDAP protocol operations and result codes used by the TestDirectory.  See
+// RFC 4511.
+const (
+	appBindRequest       = 0
+	appBindResponse      = 1
+	appUnbindRequest     = 2
+	appSearchRequest     = 3
+	appSearchResultEntry = 4
+	appSearchResultDone  = 5
+	appExtendedRequest   = 23
+	appExtendedResponse  = 24
+
+	resultSuccess            = 0
+	resultProtocolError      = 2
+	resultInvalidCredentials = 49
+	resultUnwillingToPerform = 53
+
+	filterAnd      = 0
+	filterOr       = 1
+	filterNot      = 2
+	filterEquality = 3
+	filterPresent  = 7
+
+	startTlsOid = "1.3.6.1.4.1.1466.20037"
+)
+
+// TestEntry is an entry in a TestDirectory.
+type TestEntry struct {
+	// Dn is the entry's distinguished name.
+	Dn string
+	// Attributes are the entry's attributes. Attribute names are compared
+	// case insensitively.
+	Attributes map[string][]string
+	// Password, if set, allows binding as the entry.
+	Password string
+}
+
+// TestDirectory is a minimal in-process LDAP server for tests.  It supports
+// simple binds, anonymous binds, searches using and, or, not, equality and
+// present filters, StartTLS and ldaps.
+type TestDirectory struct {
+	t         testing.TB
+	ln        net.Listener
+	useLdaps  bool
+	tlsConfig *tls.Config
+	certPem   string
+
+	mu      sync.Mutex
+	entries []*TestEntry
+	conns   map[net.Conn]struct{}
+	wg      sync.WaitGroup
+}
+
+// NewTestDirectory starts a TestDirectory listening on 127.0.0.1 which is
+// stopped when the test completes.  If useLdaps is true, the directory only
+// accepts TLS connections, otherwise it accepts plaintext connections which
+// can be upgraded with StartTLS.
+func NewTestDirectory(t testing.TB, useLdaps bool) *TestDirectory {
+	t.Helper()
+	require := require.New(t)
+
+	tlsConfig, certPem := testTlsConfig(t)
+	var ln net.Listener
+	var err error
+	if useLdaps {
+		ln, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	require.NoError(err)
+
+	d := &TestDirectory{
+		t:         t,
+		ln:        ln,
+		useLdaps:  useLdaps,
+		tlsConfig: tlsConfig,
+		certPem:   certPem,
+		conns:     map[net.Conn]struct{}{},
+	}
+	d.wg.Add(1)
+	go d.serve()
+	t.Cleanup(d.Stop)
+	return d
+}
+
+// Url returns the url of the directory.
+func (d *TestDirectory) Url() string {
+	scheme := "ldap"
+	if d.useLdaps {
+		scheme = "ldaps"
+	}
+	return fmt.Sprintf("%s://%s", scheme, d.ln.Addr().String())
+}
+
+// Cert returns the PEM encoded self-signed certificate used by the directory
+// for TLS connections.
+func (d *TestDirectory) Cert() string {
+	return d.certPem
+}
+
+// AddEntries adds the entries to the directory.
+func (d *TestDirectory) AddEntries(entries ...*TestEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = append(d.entries, entries...)
+}
+
+// SetEntries replaces all of the entries in the directory.
+func (d *TestDirectory) SetEntries(entries ...*TestEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.entries = entries
+}
+
+// Stop the directory and close all of its open connections.
+func (d *TestDirectory) Stop() {
+	_ = d.ln.Close()
+	d.mu.Lock()
+	for c := range d.conns {
+		_ = c.Close()
+	}
+	d.mu.Unlock()
+	d.wg.Wait()
+}
+
+func (d *TestDirectory) serve() {
+	defer d.wg.Done()
+	for {
+		conn, err := d.ln.Accept()
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		d.conns[conn] = struct{}{}
+		d.mu.Unlock()
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.handle(conn)
+			d.mu.Lock()
+			delete(d.conns, conn)
+			d.mu.Unlock()
+		}()
+	}
+}
+
+func (d *TestDirectory) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		if len(packet.Children) < 2 {
+			return
+		}
+		msgId, ok := packet.Children[0].Value.(int64)
+		if !ok {
+			return
+		}
+		req := packet.Children[1]
+		if req.ClassType != ber.ClassApplication {
+			return
+		}
+		switch req.Tag {
+		case appUnbindRequest:
+			return
+		case appBindRequest:
+			d.write(conn, msgId, ldapResult(appBindResponse, d.bind(req), ""))
+		case appSearchRequest:
+			for _, e := range d.search(req) {
+				d.write(conn, msgId, searchResultEntry(e, searchAttributes(req)))
+			}
+			d.write(conn, msgId, ldapResult(appSearchResultDone, resultSuccess, ""))
+		case appExtendedRequest:
+			if len(req.Children) == 0 || req.Children[0].Data.String() != startTlsOid || d.useLdaps {
+				d.write(conn, msgId, ldapResult(appExtendedResponse, resultUnwillingToPerform, "unsupported extended operation"))
+				continue
+			}
+			d.write(conn, msgId, ldapResult(appExtendedResponse, resultSuccess, ""))
+			tlsConn := tls.Server(conn, d.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+		default:
+			d.write(conn, msgId, ldapResult(appExtendedResponse, resultProtocolError, "unsupported operation"))
+		}
+	}
+}
+
+func (d *TestDirectory) write(w io.Writer, msgId int64, op *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgId, "MessageID"))
+	envelope.AppendChild(op)
+	_, _ = w.Write(envelope.Bytes())
+}
+
+// bind returns the result code of the bind request.
+func (d *TestDirectory) bind(req *ber.Packet) int64 {
+	if len(req.Children) < 3 {
+		return resultProtocolError
+	}
+	dn := req.Children[1].Data.String()
+	password := req.Children[2].Data.String()
+	if password == "" {
+		// anonymous or unauthenticated bind
+		return resultSuccess
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.entries {
+		if !strings.EqualFold(e.Dn, dn) && !entryHasValue(e, "userPrincipalName", dn) {
+			continue
+		}
+		if e.Password != "" && e.Password == password {
+			return resultSuccess
+		}
+		return resultInvalidCredentials
+	}
+	return resultInvalidCredentials
+}
+
+// search returns the entries matching the search request.
+func (d *TestDirectory) search(req *ber.Packet) []*TestEntry {
+	if len(req.Children) < 7 {
+		return nil
+	}
+	baseDn := strings.ToLower(req.Children[0].Data.String())
+	scope, _ := req.Children[1].Value.(int64)
+	filter := req.Children[6]
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var found []*TestEntry
+	for _, e := range d.entries {
+		dn := strings.ToLower(e.Dn)
+		switch scope {
+		case 0: // base object
+			if dn != baseDn {
+				continue
+			}
+		default:
+			if dn != baseDn && !strings.HasSuffix(dn, ","+baseDn) {
+				continue
+			}
+		}
+		if matchFilter(e, filter) {
+			found = append(found, e)
+		}
+	}
+	return found
+}
+
+func matchFilter(e *TestEntry, f *ber.Packet) bool {
+	switch f.Tag {
+	case filterAnd:
+		for _, c := range f.Children {
+			if !matchFilter(e, c) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, c := range f.Children {
+			if matchFilter(e, c) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return len(f.Children) == 1 && !matchFilter(e, f.Children[0])
+	case filterEquality:
+		if len(f.Children) != 2 {
+			return false
+		}
+		return entryHasValue(e, f.Children[0].Data.String(), f.Children[1].Data.String())
+	case filterPresent:
+		attr := f.Data.String()
+		if strings.EqualFold(attr, "objectClass") {
+			return true
+		}
+		for k := range e.Attributes {
+			if strings.EqualFold(k, attr) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+func entryHasValue(e *TestEntry, attr, value string) bool {
+	for k, vals := range e.Attributes {
+		if !strings.EqualFold(k, attr) {
+			continue
+		}
+		for _, v := range vals {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func searchAttributes(req *ber.Packet) []string {
+	if len(req.Children) < 8 {
+		return nil
+	}
+	attrs := make([]string, 0, len(req.Children[7].Children))
+	for _, a := range req.Children[7].Children {
+		attrs = append(attrs, a.Data.String())
+	}
+	return attrs
+}
+
+func searchResultEntry(e *TestEntry, attrs []string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, appSearchResultEntry, nil, "Search Result Entry")
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.Dn, "DN"))
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for k, vals := range e.Attributes {
+		if len(attrs) > 0 && !containsFold(attrs, k) {
+			continue
+		}
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, k, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, v := range vals {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+		attr.AppendChild(set)
+		attributes.AppendChild(attr)
+	}
+	p.AppendChild(attributes)
+	return p
+}
+
+func ldapResult(tag ber.Tag, code int64, msg string) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "ResultCode"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "MatchedDN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, msg, "DiagnosticMessage"))
+	return p
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// testTlsConfig returns a tls config with a self-signed certificate for
+// 127.0.0.1 and localhost, along with the certificate PEM encoded.
+func testTlsConfig(t testing.TB) (*tls.Config, string) {
+	t.Helper()
+	require := require.New(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	cert, err := tls.X509KeyPair(certPem, keyPem)
+	require.NoError(err)
+	return &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, string(certPem)
+}
@@ -4,6 +4,7 @@ import (
 	"crypto/x509"
 	"net/url"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 )
 
@@ -34,6 +35,7 @@ type options struct {
 	withFullName            string
 	withOrderByCreateTime   bool
 	ascending               bool
+	withStartPageAfterId    string
 	withUnauthenticatedUser bool
 	withForce               bool
 	withDryRun              bool
@@ -45,6 +47,7 @@ type options struct {
 	withOperationalState    AuthMethodState
 	withAccountClaimMap     map[string]AccountToClaim
 	withReader              db.Reader
+	withAccessRevoker       auth.AccessRevoker
 }
 
 func getDefaultOptions() options {
@@ -147,6 +150,14 @@ func WithOrderByCreateTime(ascending bool) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
 // WithUnauthenticatedUser provides an option for filtering results for
 // an unauthenticated users.
 func WithUnauthenticatedUser(enabled bool) Option {
@@ -229,3 +240,11 @@ func WithReader(reader db.Reader) Option {
 		o.withReader = reader
 	}
 }
+
+// WithAccessRevoker provides an option to specify the AccessRevoker used to
+// revoke the access of the user of an account when the account is deleted.
+func WithAccessRevoker(r auth.AccessRevoker) Option {
+	return func(o *options) {
+		o.withAccessRevoker = r
+	}
+}
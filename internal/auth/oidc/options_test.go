@@ -116,6 +116,13 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("acctoidc_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "acctoidc_1234567890"
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithUnauthenticatedUser", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithUnauthenticatedUser(true))
@@ -3,6 +3,7 @@ package oidc
 import (
 	"context"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -16,10 +17,16 @@ type Repository struct {
 
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+
+	// accessRevoker, if set, revokes the access of the user of an account
+	// when the account is deleted
+	accessRevoker auth.AccessRevoker
 }
 
 // NewRepository creates a new oidc Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
+// which sets a default limit on results returned by repo operations, and
+// WithAccessRevoker which revokes the access of the user of an account when
+// the account is deleted.
 func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "oidc.NewRepository"
 	if r == nil {
@@ -37,9 +44,10 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		opts.withLimit = db.DefaultLimit
 	}
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:        r,
+		writer:        w,
+		kms:           kms,
+		defaultLimit:  opts.withLimit,
+		accessRevoker: opts.withAccessRevoker,
 	}, nil
 }
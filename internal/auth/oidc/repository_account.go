@@ -129,7 +129,8 @@ func (r *Repository) LookupAccount(ctx context.Context, withPublicId string, opt
 	return a, nil
 }
 
-// ListAccounts in an auth method and supports WithLimit option.
+// ListAccounts in an auth method, ordered by public id. Supports the WithLimit
+// and WithStartPageAfterId options.
 func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*Account, error) {
 	const op = "oidc.(Repository).ListAccounts"
 	if withAuthMethodId == "" {
@@ -142,7 +143,11 @@ func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string,
 		limit = opts.withLimit
 	}
 	var accts []*Account
-	err := r.reader.SearchWhere(ctx, &accts, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit))
+	where, args := "auth_method_id = ?", []interface{}{withAuthMethodId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &accts, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -150,7 +155,8 @@ func (r *Repository) ListAccounts(ctx context.Context, withAuthMethodId string,
 }
 
 // DeleteAccount deletes the account for the provided id from the repository returning a count of the
-// number of records deleted.  All options are ignored.
+// number of records deleted. The access of the user of the account is revoked
+// within the same transaction. All options are ignored.
 func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId string, opt ...Option) (int, error) {
 	const op = "oidc.(Repository).DeleteAccount"
 	if withPublicId == "" {
@@ -173,6 +179,11 @@ func (r *Repository) DeleteAccount(ctx context.Context, scopeId, withPublicId st
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(_ db.Reader, w db.Writer) (err error) {
+			if r.accessRevoker != nil {
+				if err := r.accessRevoker.RevokeAccountAccess(ctx, w, withPublicId); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to revoke access of %s", withPublicId)))
+				}
+			}
 			metadata := ac.oplog(oplog.OpType_OP_TYPE_DELETE, scopeId)
 			dAc := ac.Clone()
 			rowsDeleted, err = w.Delete(ctx, dAc, db.WithOplog(oplogWrapper, metadata))
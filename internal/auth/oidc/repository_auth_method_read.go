@@ -27,8 +27,10 @@ func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, opt
 }
 
 // ListAuthMethods returns a slice of AuthMethods for the scopeId. The
-// WithUnauthenticatedUser, WithLimit and WithOrder options are supported and
-//  all other options are ignored.
+// WithUnauthenticatedUser, WithLimit, WithOrderByCreateTime and
+// WithStartPageAfterId options are supported and all other options are
+// ignored. Auth methods are ordered by their public id unless
+// WithOrderByCreateTime is used.
 func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
 	const op = "oidc.(Repository).ListAuthMethods"
 	if len(scopeIds) == 0 {
@@ -62,8 +64,8 @@ func (r *Repository) lookupAuthMethod(ctx context.Context, authMethodId string,
 // getAuthMethods allows the caller to either lookup a specific AuthMethod via
 // its id or search for a set AuthMethods within a set of scopes.  Passing both
 // scopeIds and a authMethod is an error. The WithUnauthenticatedUser,
-// WithLimit and WithOrder options are supported and all other options are
-// ignored.
+// WithLimit, WithOrderByCreateTime and WithStartPageAfterId options are
+// supported and all other options are ignored.
 //
 // The AuthMethod returned has its value objects populated (SigningAlgs,
 // CallbackUrls, AudClaims and Certificates).  The AuthMethod returned has its
@@ -96,6 +98,8 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		} else {
 			dbArgs = append(dbArgs, db.WithOrder("create_time"))
 		}
+	} else {
+		dbArgs = append(dbArgs, db.WithOrder("public_id"))
 	}
 
 	var args []interface{}
@@ -106,6 +110,9 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 	default:
 		where, args = append(where, "scope_id in(?)"), append(args, scopeIds)
 	}
+	if opts.withStartPageAfterId != "" {
+		where, args = append(where, "public_id > ?"), append(args, opts.withStartPageAfterId)
+	}
 
 	if opts.withUnauthenticatedUser {
 		where, args = append(where, "state = ?"), append(args, string(ActivePublicState))
@@ -93,7 +93,8 @@ func (r *Repository) LookupManagedGroup(ctx context.Context, withPublicId string
 	return a, nil
 }
 
-// ListManagedGroups in an auth method and supports WithLimit option.
+// ListManagedGroups in an auth method, ordered by public id. Supports the
+// WithLimit and WithStartPageAfterId options.
 func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId string, opt ...Option) ([]*ManagedGroup, error) {
 	const op = "oidc.(Repository).ListManagedGroups"
 	if withAuthMethodId == "" {
@@ -106,7 +107,11 @@ func (r *Repository) ListManagedGroups(ctx context.Context, withAuthMethodId str
 		limit = opts.withLimit
 	}
 	var mgs []*ManagedGroup
-	err := r.reader.SearchWhere(ctx, &mgs, "auth_method_id = ?", []interface{}{withAuthMethodId}, db.WithLimit(limit))
+	where, args := "auth_method_id = ?", []interface{}{withAuthMethodId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &mgs, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
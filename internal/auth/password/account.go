@@ -15,6 +15,14 @@ type Account struct {
 	// CredentialId is included when Authenticate or ChangePassword is
 	// called. A new CredentialId is generated when a password is changed.
 	CredentialId string `gorm:"->"`
+
+	// Mfa is the second factor status of the account. It is not stored and
+	// only set by callers which looked it up with LookupMfa.
+	Mfa *MfaStatus `gorm:"-"`
+
+	// Lockout is the lockout state of the account. It is not stored and
+	// only set by callers which looked it up with LookupLockout.
+	Lockout *AccountLockout `gorm:"-"`
 }
 
 func allocAccount() *Account {
@@ -21,9 +21,10 @@ func allocAuthMethod() AuthMethod {
 }
 
 // NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
-// Name and description are the only valid options. All other options are
-// ignored.  MinLoginNameLength and MinPasswordLength are pre-set to the
-// default values of 5 and 8 respectively.
+// Name, description, WithMfaRequired, WithWebAuthnRpId and WithLockout are the
+// only valid options. All other options are ignored.  MinLoginNameLength and MinPasswordLength are pre-set to the
+// default values of 5 and 8 respectively. The lockout is disabled, with a
+// window of 5 minutes and a duration of 15 minutes, unless WithLockout is set.
 func NewAuthMethod(scopeId string, opt ...Option) (*AuthMethod, error) {
 	const op = "password.NewAuthMethod"
 	if scopeId == "" {
@@ -38,8 +39,22 @@ func NewAuthMethod(scopeId string, opt ...Option) (*AuthMethod, error) {
 			Description:        opts.withDescription,
 			MinLoginNameLength: 3,
 			MinPasswordLength:  8,
+			MfaRequired:        opts.withMfaRequired,
+			WebauthnRpId:       opts.withWebAuthnRpId,
+
+			LockoutWindowSeconds:   defaultLockoutWindowSeconds,
+			LockoutDurationSeconds: defaultLockoutDurationSeconds,
 		},
 	}
+	if l := opts.withLockout; l != nil {
+		a.LockoutMaxFailedAttempts = l.MaxFailedAttempts
+		if l.WindowSeconds > 0 {
+			a.LockoutWindowSeconds = l.WindowSeconds
+		}
+		if l.DurationSeconds > 0 {
+			a.LockoutDurationSeconds = l.DurationSeconds
+		}
+	}
 	return a, nil
 }
 
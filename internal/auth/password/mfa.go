@@ -0,0 +1,277 @@
+package password
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// The types of second factors of an Account.
+const (
+	MfaTypeTotp         = "totp"
+	MfaTypeWebAuthn     = "webauthn"
+	MfaTypeRecoveryCode = "recovery_code"
+)
+
+// The purposes of an MfaChallenge.
+const (
+	mfaPurposeAuthenticate = "authenticate"
+	mfaPurposeRegister     = "register"
+)
+
+// The number of recovery codes generated for an Account.
+const recoveryCodeCount = 10
+
+// MfaStatus is the second factor status of an Account.
+type MfaStatus struct {
+	// TotpEnabled is true if a confirmed TOTP seed is enrolled.
+	TotpEnabled bool
+	// WebAuthnCredentials are the registered WebAuthn credentials.
+	WebAuthnCredentials []*WebAuthnCredential
+	// RecoveryCodesRemaining is the number of unused recovery codes.
+	RecoveryCodesRemaining int
+}
+
+// Enabled returns true if a second factor is enrolled.
+func (s *MfaStatus) Enabled() bool {
+	return s != nil && (s.TotpEnabled || len(s.WebAuthnCredentials) > 0)
+}
+
+// Factors returns the types of the enrolled second factors.
+func (s *MfaStatus) Factors() []string {
+	var f []string
+	if s == nil {
+		return f
+	}
+	if s.TotpEnabled {
+		f = append(f, MfaTypeTotp)
+	}
+	if len(s.WebAuthnCredentials) > 0 {
+		f = append(f, MfaTypeWebAuthn)
+	}
+	if s.RecoveryCodesRemaining > 0 {
+		f = append(f, MfaTypeRecoveryCode)
+	}
+	return f
+}
+
+// A Totp is the TOTP seed of an Account. The seed is encrypted in the
+// database. It is owned by an Account.
+type Totp struct {
+	*store.Totp
+	tableName string
+}
+
+func allocTotp() *Totp {
+	return &Totp{
+		Totp: &store.Totp{},
+	}
+}
+
+func (t *Totp) clone() *Totp {
+	cp := proto.Clone(t.Totp)
+	return &Totp{
+		Totp: cp.(*store.Totp),
+	}
+}
+
+// TableName returns the table name.
+func (t *Totp) TableName() string {
+	if t != nil && t.tableName != "" {
+		return t.tableName
+	}
+	return "auth_password_totp"
+}
+
+// SetTableName sets the table name.
+func (t *Totp) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *Totp) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	t.KeyId = cipher.KeyID()
+	return nil
+}
+
+func (t *Totp) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (t *Totp) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id":  []string{t.PasswordAccountId},
+		"resource-type":       []string{"password totp"},
+		"op-type":             []string{op.String()},
+		"password-account-id": []string{t.PasswordAccountId},
+	}
+}
+
+// A WebAuthnCredential is a WebAuthn public key credential of an Account.
+// It is owned by an Account.
+type WebAuthnCredential struct {
+	*store.WebAuthnCredential
+	tableName string
+}
+
+func allocWebAuthnCredential() *WebAuthnCredential {
+	return &WebAuthnCredential{
+		WebAuthnCredential: &store.WebAuthnCredential{},
+	}
+}
+
+func (c *WebAuthnCredential) clone() *WebAuthnCredential {
+	cp := proto.Clone(c.WebAuthnCredential)
+	return &WebAuthnCredential{
+		WebAuthnCredential: cp.(*store.WebAuthnCredential),
+	}
+}
+
+// TableName returns the table name.
+func (c *WebAuthnCredential) TableName() string {
+	if c != nil && c.tableName != "" {
+		return c.tableName
+	}
+	return "auth_password_webauthn_credential"
+}
+
+// SetTableName sets the table name.
+func (c *WebAuthnCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *WebAuthnCredential) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id":  []string{c.PrivateId},
+		"resource-type":       []string{"password webauthn credential"},
+		"op-type":             []string{op.String()},
+		"password-account-id": []string{c.PasswordAccountId},
+	}
+}
+
+// A RecoveryCode is the hash of a single use recovery code of an Account.
+// It is owned by an Account.
+type RecoveryCode struct {
+	*store.RecoveryCode
+	tableName string
+}
+
+func allocRecoveryCode() *RecoveryCode {
+	return &RecoveryCode{
+		RecoveryCode: &store.RecoveryCode{},
+	}
+}
+
+func newRecoveryCode(accountId, code string) (*RecoveryCode, error) {
+	const op = "password.newRecoveryCode"
+	id, err := newRecoveryCodeId()
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
+	return &RecoveryCode{
+		RecoveryCode: &store.RecoveryCode{
+			PrivateId:         id,
+			PasswordAccountId: accountId,
+			CodeHash:          hashRecoveryCode(code),
+		},
+	}, nil
+}
+
+func (c *RecoveryCode) clone() *RecoveryCode {
+	cp := proto.Clone(c.RecoveryCode)
+	return &RecoveryCode{
+		RecoveryCode: cp.(*store.RecoveryCode),
+	}
+}
+
+// TableName returns the table name.
+func (c *RecoveryCode) TableName() string {
+	if c != nil && c.tableName != "" {
+		return c.tableName
+	}
+	return "auth_password_recovery_code"
+}
+
+// SetTableName sets the table name.
+func (c *RecoveryCode) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *RecoveryCode) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id":  []string{c.PrivateId},
+		"resource-type":       []string{"password recovery code"},
+		"op-type":             []string{op.String()},
+		"password-account-id": []string{c.PasswordAccountId},
+	}
+}
+
+// An MfaChallenge is a pending second factor challenge of an Account.
+// Challenges are single use and expire. They are not replicated.
+type MfaChallenge struct {
+	*store.MfaChallenge
+	tableName string
+}
+
+func allocMfaChallenge() *MfaChallenge {
+	return &MfaChallenge{
+		MfaChallenge: &store.MfaChallenge{},
+	}
+}
+
+// TableName returns the table name.
+func (c *MfaChallenge) TableName() string {
+	if c != nil && c.tableName != "" {
+		return c.tableName
+	}
+	return "auth_password_mfa_challenge"
+}
+
+// SetTableName sets the table name.
+func (c *MfaChallenge) SetTableName(n string) {
+	c.tableName = n
+}
+
+// newRecoveryCodes returns recoveryCodeCount new random recovery codes,
+// formatted as four groups of four base32 characters.
+func newRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		c := strings.ToLower(totpEncoding.EncodeToString(b))
+		codes = append(codes, strings.Join([]string{c[0:4], c[4:8], c[8:12], c[12:16]}, "-"))
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode returns the SHA-256 hash of code, ignoring its case,
+// spaces and hyphens.
+func hashRecoveryCode(code string) []byte {
+	code = strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+	h := sha256.Sum256([]byte(code))
+	return h[:]
+}
@@ -1,5 +1,11 @@
 package password
 
+import (
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth"
+)
+
 // getOpts - iterate the inbound Options and return a struct.
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -24,6 +30,11 @@ type options struct {
 	withPassword          bool
 	withOrderByCreateTime bool
 	ascending             bool
+	withStartPageAfterId  string
+	withAccessRevoker     auth.AccessRevoker
+	withMfaRequired       bool
+	withWebAuthnRpId      string
+	withLockout           *lockoutPolicy
 }
 
 func getDefaultOptions() options {
@@ -92,3 +103,49 @@ func WithOrderByCreateTime(ascending bool) Option {
 		o.ascending = ascending
 	}
 }
+
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
+// WithAccessRevoker provides an option to specify the AccessRevoker used to
+// revoke the access of the user of an account when the account is deleted.
+func WithAccessRevoker(r auth.AccessRevoker) Option {
+	return func(o *options) {
+		o.withAccessRevoker = r
+	}
+}
+
+// WithMfaRequired provides an option to require accounts of an auth method to
+// authenticate with a second factor.
+func WithMfaRequired(required bool) Option {
+	return func(o *options) {
+		o.withMfaRequired = required
+	}
+}
+
+// WithWebAuthnRpId provides an optional WebAuthn relying party ID for an auth
+// method.
+func WithWebAuthnRpId(id string) Option {
+	return func(o *options) {
+		o.withWebAuthnRpId = id
+	}
+}
+
+// WithLockout provides an optional lockout policy for an auth method. An
+// account is locked out for duration once maxFailedAttempts authentication
+// attempts failed within window. A maxFailedAttempts of 0 disables the
+// lockout.
+func WithLockout(maxFailedAttempts uint32, window, duration time.Duration) Option {
+	return func(o *options) {
+		o.withLockout = &lockoutPolicy{
+			MaxFailedAttempts: maxFailedAttempts,
+			WindowSeconds:     uint32(window / time.Second),
+			DurationSeconds:   uint32(duration / time.Second),
+		}
+	}
+}
@@ -2,6 +2,7 @@ package password
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,4 +66,32 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.ascending = true
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("acctpw_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "acctpw_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithMfaRequired", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithMfaRequired(true))
+		testOpts := getDefaultOptions()
+		testOpts.withMfaRequired = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithWebAuthnRpId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithWebAuthnRpId("boundary.example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.withWebAuthnRpId = "boundary.example.com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithLockout", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithLockout(5, time.Minute, time.Hour))
+		testOpts := getDefaultOptions()
+		testOpts.withLockout = &lockoutPolicy{MaxFailedAttempts: 5, WindowSeconds: 60, DurationSeconds: 3600}
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -9,6 +9,9 @@ import (
 const (
 	argon2ConfigurationPrefix = "arg2conf"
 	argon2CredentialPrefix    = "arg2cred"
+	webAuthnCredentialPrefix  = "pwwacred"
+	recoveryCodePrefix        = "pwrcode"
+	mfaChallengePrefix        = "pwmfachl"
 )
 
 func newArgon2ConfigurationId() (string, error) {
@@ -28,3 +31,30 @@ func newArgon2CredentialId() (string, error) {
 	}
 	return id, nil
 }
+
+func newWebAuthnCredentialId() (string, error) {
+	const op = "password.newWebAuthnCredentialId"
+	id, err := db.NewPrivateId(webAuthnCredentialPrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
+
+func newRecoveryCodeId() (string, error) {
+	const op = "password.newRecoveryCodeId"
+	id, err := db.NewPrivateId(recoveryCodePrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
+
+func newMfaChallengeId() (string, error) {
+	const op = "password.newMfaChallengeId"
+	id, err := db.NewPrivateId(mfaChallengePrefix)
+	if err != nil {
+		return "", errors.WrapDeprecated(err, op)
+	}
+	return id, nil
+}
@@ -19,4 +19,19 @@ func Test_PrivateIds(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, argon2CredentialPrefix+"_"))
 	})
+	t.Run("webAuthnCred", func(t *testing.T) {
+		id, err := newWebAuthnCredentialId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, webAuthnCredentialPrefix+"_"))
+	})
+	t.Run("recoveryCode", func(t *testing.T) {
+		id, err := newRecoveryCodeId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, recoveryCodePrefix+"_"))
+	})
+	t.Run("mfaChallenge", func(t *testing.T) {
+		id, err := newMfaChallengeId()
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, mfaChallengePrefix+"_"))
+	})
 }
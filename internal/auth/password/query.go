@@ -41,4 +41,69 @@ select *
         where public_id = @public_id
     );
 `
+
+	deleteExpiredMfaChallengesQuery = `
+delete from auth_password_mfa_challenge
+ where expiration_time < current_timestamp;
+`
+
+	lockoutStateSelect = `
+select acct.public_id,
+       meth.lockout_max_failed_attempts,
+       meth.lockout_window_seconds,
+       meth.lockout_duration_seconds,
+       coalesce(lo.failed_attempts, 0),
+       lo.locked_until,
+       exists (
+         select 1
+           from auth_password_totp totp
+          where totp.password_account_id = acct.public_id
+            and totp.confirmed
+          union all
+         select 1
+           from auth_password_webauthn_credential cred
+          where cred.password_account_id = acct.public_id
+       ) as mfa_enabled
+  from auth_password_account acct
+  join auth_password_method meth
+    on acct.auth_method_id = meth.public_id
+  left join auth_password_account_lockout lo
+    on lo.password_account_id = acct.public_id
+`
+	lockoutStateByLoginNameQuery = lockoutStateSelect + `
+ where acct.auth_method_id = @auth_method_id
+   and acct.login_name = @login_name;
+`
+	lockoutStateByAccountIdQuery = lockoutStateSelect + `
+ where acct.public_id = @account_id;
+`
+
+	recordFailedAttemptQuery = `
+insert into auth_password_account_lockout
+       (password_account_id, failed_attempts, window_start_time)
+values (@account_id, 1, current_timestamp)
+    on conflict (password_account_id) do update
+   set failed_attempts = case
+         when auth_password_account_lockout.window_start_time < current_timestamp - make_interval(secs => @window_seconds)
+         then 1
+         else auth_password_account_lockout.failed_attempts + 1
+       end,
+       window_start_time = case
+         when auth_password_account_lockout.window_start_time < current_timestamp - make_interval(secs => @window_seconds)
+         then current_timestamp
+         else auth_password_account_lockout.window_start_time
+       end;
+`
+	lockAccountQuery = `
+update auth_password_account_lockout
+   set locked_until      = current_timestamp + make_interval(secs => @duration_seconds),
+       failed_attempts   = 0,
+       window_start_time = current_timestamp
+ where password_account_id = @account_id
+   and failed_attempts >= @max_failed_attempts;
+`
+	deleteLockoutQuery = `
+delete from auth_password_account_lockout
+ where password_account_id = @account_id;
+`
 )
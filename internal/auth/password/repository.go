@@ -3,6 +3,7 @@ package password
 import (
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -16,12 +17,17 @@ type Repository struct {
 	kms    *kms.Kms
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+
+	// accessRevoker, if set, revokes the access of the user of an account
+	// when the account is deleted
+	accessRevoker auth.AccessRevoker
 }
 
 // NewRepository creates a new Repository. The returned repository should
 // only be used for one transaction and it is not safe for concurrent go
 // routines to access it.  WithLimit option is used as a repo wide default
-// limit applied to all ListX methods.
+// limit applied to all ListX methods. WithAccessRevoker option revokes the
+// access of the user of an account when the account is deleted.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "password.NewRepository"
 	switch {
@@ -40,10 +46,11 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 	}
 
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:        r,
+		writer:        w,
+		kms:           kms,
+		defaultLimit:  opts.withLimit,
+		accessRevoker: opts.withAccessRevoker,
 	}, nil
 }
 
@@ -108,8 +108,10 @@ func (r *Repository) LookupAuthMethod(ctx context.Context, publicId string, _ ..
 	return r.lookupAuthMethod(ctx, publicId)
 }
 
-// ListAuthMethods returns a slice of AuthMethods for the scopeId. WithLimit and
-// WithOrder options are the only option supported.
+// ListAuthMethods returns a slice of AuthMethods for the scopeId. The
+// WithLimit, WithOrderByCreateTime and WithStartPageAfterId options are the
+// only options supported. Auth methods are ordered by their public id unless
+// WithOrderByCreateTime is used.
 func (r *Repository) ListAuthMethods(ctx context.Context, scopeIds []string, opt ...Option) ([]*AuthMethod, error) {
 	const op = "password.(Repository).ListAuthMethods"
 	if len(scopeIds) == 0 {
@@ -174,8 +176,10 @@ func (r *Repository) DeleteAuthMethod(ctx context.Context, scopeId, publicId str
 // NewAuthMethod.  fieldMaskPaths provides field_mask.proto paths for fields
 // that should be updated.  Fields will be set to NULL if the field is a zero
 // value and included in fieldMask. Name, Description, MinPasswordLength,
-// and MinLoginNameLength are the only updatable fields, If no updatable fields
-// are included in the fieldMaskPaths, then an error is returned.
+// MinLoginNameLength, MfaRequired, WebauthnRpId, LockoutMaxFailedAttempts,
+// LockoutWindowSeconds and LockoutDurationSeconds are the only updatable
+// fields, If no updatable fields are included in the fieldMaskPaths, then an
+// error is returned.
 func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMethod, version uint32, fieldMaskPaths []string, opt ...Option) (*AuthMethod, int, error) {
 	const op = "password.(Repository).UpdateAuthMethod"
 	if authMethod == nil {
@@ -193,6 +197,11 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 		case strings.EqualFold("Description", f):
 		case strings.EqualFold("MinLoginNameLength", f):
 		case strings.EqualFold("MinPasswordLength", f):
+		case strings.EqualFold("MfaRequired", f):
+		case strings.EqualFold("WebauthnRpId", f):
+		case strings.EqualFold("LockoutMaxFailedAttempts", f):
+		case strings.EqualFold("LockoutWindowSeconds", f):
+		case strings.EqualFold("LockoutDurationSeconds", f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -204,9 +213,15 @@ func (r *Repository) UpdateAuthMethod(ctx context.Context, authMethod *AuthMetho
 			"Description":        authMethod.Description,
 			"MinPasswordLength":  authMethod.MinPasswordLength,
 			"MinLoginNameLength": authMethod.MinLoginNameLength,
+			"MfaRequired":        authMethod.MfaRequired,
+			"WebauthnRpId":       authMethod.WebauthnRpId,
+
+			"LockoutMaxFailedAttempts": authMethod.LockoutMaxFailedAttempts,
+			"LockoutWindowSeconds":     authMethod.LockoutWindowSeconds,
+			"LockoutDurationSeconds":   authMethod.LockoutDurationSeconds,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"MfaRequired", "LockoutMaxFailedAttempts"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "field mask must not be empty")
@@ -290,9 +305,9 @@ func (r *Repository) lookupAuthMethod(ctx context.Context, authMethodId string,
 
 // getAuthMethods allows the caller to either lookup a specific AuthMethod via
 // its id or search for a set AuthMethods within a set of scopes.  Passing both
-// scopeIds and a authMethodId is an error. The WithLimit and
-// WithOrderByCreateTime options are supported and all other options are
-// ignored.
+// scopeIds and a authMethodId is an error. The WithLimit,
+// WithOrderByCreateTime and WithStartPageAfterId options are supported and all
+// other options are ignored.
 //
 // The AuthMethod returned has its IsPrimaryAuthMethod bool set.
 //
@@ -321,6 +336,8 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 		} else {
 			dbArgs = append(dbArgs, db.WithOrder("create_time"))
 		}
+	} else {
+		dbArgs = append(dbArgs, db.WithOrder("public_id"))
 	}
 
 	var args []interface{}
@@ -331,6 +348,9 @@ func (r *Repository) getAuthMethods(ctx context.Context, authMethodId string, sc
 	default:
 		where, args = append(where, "scope_id in(?)"), append(args, scopeIds)
 	}
+	if opts.withStartPageAfterId != "" {
+		where, args = append(where, "public_id > ?"), append(args, opts.withStartPageAfterId)
+	}
 
 	var views []*authMethodView
 	err := r.reader.SearchWhere(ctx, &views, strings.Join(where, " and "), args, dbArgs...)
@@ -0,0 +1,191 @@
+package password
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The default lockout window and duration of a new AuthMethod.
+const (
+	defaultLockoutWindowSeconds   = 300
+	defaultLockoutDurationSeconds = 900
+)
+
+// lockoutPolicy is the account lockout policy of an AuthMethod.
+type lockoutPolicy struct {
+	MaxFailedAttempts uint32
+	WindowSeconds     uint32
+	DurationSeconds   uint32
+}
+
+// AccountLockout is the lockout state of an Account.
+type AccountLockout struct {
+	// FailedAttempts is the number of failed authentication attempts in the
+	// current lockout window.
+	FailedAttempts int
+	// LockedUntil is when the lockout of the account ends. It is zero if the
+	// account was never locked out.
+	LockedUntil time.Time
+}
+
+// Locked reports whether the account is currently locked out.
+func (l *AccountLockout) Locked() bool {
+	return l != nil && time.Now().Before(l.LockedUntil)
+}
+
+// lockoutState is the lockout policy of the auth method of an account and
+// the lockout state of the account.
+type lockoutState struct {
+	accountId string
+	policy    lockoutPolicy
+	lockout   AccountLockout
+	// mfaEnabled is true if the account has a second factor, in which case
+	// its failed attempts are only reset once the second factor is verified.
+	mfaEnabled bool
+}
+
+func (s *lockoutState) enabled() bool {
+	return s.policy.MaxFailedAttempts > 0
+}
+
+// LookupLockout returns the lockout state of the account with accountId.
+// Returns an error with code RecordNotFound if the account does not exist.
+// All options are ignored.
+func (r *Repository) LookupLockout(ctx context.Context, accountId string, _ ...Option) (*AccountLockout, error) {
+	const op = "password.(Repository).LookupLockout"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	s, err := r.lookupLockoutState(ctx, lockoutStateByAccountIdQuery, sql.Named("account_id", accountId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("account %s not found", accountId), errors.WithoutEvent())
+	}
+	return &s.lockout, nil
+}
+
+// UnlockAccount ends the lockout of the account with accountId and resets
+// its failed authentication attempts. Returns an error with code
+// RecordNotFound if the account does not exist. All options are ignored.
+func (r *Repository) UnlockAccount(ctx context.Context, accountId string, _ ...Option) error {
+	const op = "password.(Repository).UnlockAccount"
+	if accountId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	s, err := r.lookupLockoutState(ctx, lockoutStateByAccountIdQuery, sql.Named("account_id", accountId))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if s == nil {
+		return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("account %s not found", accountId), errors.WithoutEvent())
+	}
+	if err := r.resetLockout(ctx, accountId); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if s.lockout.Locked() {
+		writeLockoutAudit(ctx, op, map[string]interface{}{
+			"account_id": accountId,
+		})
+	}
+	return nil
+}
+
+// lookupLockoutState returns the lockout state of the account selected by
+// query, or nil if the account does not exist.
+func (r *Repository) lookupLockoutState(ctx context.Context, query string, args ...interface{}) (*lockoutState, error) {
+	const op = "password.(Repository).lookupLockoutState"
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return nil, nil
+	}
+	var s lockoutState
+	var lockedUntil sql.NullTime
+	if err := rows.Scan(&s.accountId, &s.policy.MaxFailedAttempts, &s.policy.WindowSeconds, &s.policy.DurationSeconds,
+		&s.lockout.FailedAttempts, &lockedUntil, &s.mfaEnabled); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if lockedUntil.Valid {
+		s.lockout.LockedUntil = lockedUntil.Time
+	}
+	return &s, nil
+}
+
+// recordFailedAttempt records a failed authentication attempt of the account
+// of s and locks the account out if it reached the maximum number of failed
+// attempts of its auth method.
+func (r *Repository) recordFailedAttempt(ctx context.Context, s *lockoutState) error {
+	const op = "password.(Repository).recordFailedAttempt"
+	var locked bool
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			locked = false
+			if _, err := w.Exec(ctx, recordFailedAttemptQuery, []interface{}{
+				sql.Named("account_id", s.accountId),
+				sql.Named("window_seconds", s.policy.WindowSeconds),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to record failed attempt"))
+			}
+			rowsUpdated, err := w.Exec(ctx, lockAccountQuery, []interface{}{
+				sql.Named("account_id", s.accountId),
+				sql.Named("duration_seconds", s.policy.DurationSeconds),
+				sql.Named("max_failed_attempts", s.policy.MaxFailedAttempts),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock account"))
+			}
+			locked = rowsUpdated == 1
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if locked {
+		lockedUntil := time.Now().Add(time.Duration(s.policy.DurationSeconds) * time.Second)
+		event.WriteSysEvent(ctx, op, "account locked out after too many failed authentication attempts",
+			"account_id", s.accountId, "failed_attempts", s.policy.MaxFailedAttempts, "locked_until", lockedUntil.Format(time.RFC3339))
+		writeLockoutAudit(ctx, op, map[string]interface{}{
+			"account_id":      s.accountId,
+			"failed_attempts": float64(s.policy.MaxFailedAttempts),
+			"locked_until":    lockedUntil.Format(time.RFC3339),
+		})
+	}
+	return nil
+}
+
+// resetLockout deletes the failed authentication attempts and the lockout of
+// the account with accountId.
+func (r *Repository) resetLockout(ctx context.Context, accountId string) error {
+	const op = "password.(Repository).resetLockout"
+	if _, err := r.writer.Exec(ctx, deleteLockoutQuery, []interface{}{sql.Named("account_id", accountId)}); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func writeLockoutAudit(ctx context.Context, op event.Op, details map[string]interface{}) {
+	d, err := structpb.NewStruct(details)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to create audit event details"))
+		return
+	}
+	if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{Operation: string(op), Details: d})); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write audit event"))
+	}
+}
@@ -0,0 +1,118 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Lockout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	am := TestAuthMethod(t, conn, o.GetPublicId(), WithLockout(3, time.Minute, time.Hour))
+	assert.Equal(uint32(3), am.LockoutMaxFailedAttempts)
+	assert.Equal(uint32(60), am.LockoutWindowSeconds)
+	assert.Equal(uint32(3600), am.LockoutDurationSeconds)
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	passwd := "12345678"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: am.PublicId,
+			LoginName:    "alice",
+		},
+	}, WithPassword(passwd))
+	require.NoError(err)
+
+	_, err = repo.LookupLockout(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	_, err = repo.LookupLockout(ctx, "acctpw_unknown")
+	assert.Truef(errors.IsNotFoundError(err), "unexpected error %v", err)
+	err = repo.UnlockAccount(ctx, "acctpw_unknown")
+	assert.Truef(errors.IsNotFoundError(err), "unexpected error %v", err)
+
+	// a successful authentication resets the failed attempts
+	authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), am.PublicId, "alice", "wrong password")
+	require.NoError(err)
+	assert.Nil(authAcct)
+	lockout, err := repo.LookupLockout(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(1, lockout.FailedAttempts)
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), am.PublicId, "alice", passwd)
+	require.NoError(err)
+	assert.NotNil(authAcct)
+	lockout, err = repo.LookupLockout(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(0, lockout.FailedAttempts)
+
+	for i := 0; i < 3; i++ {
+		authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), am.PublicId, "alice", "wrong password")
+		require.NoError(err)
+		assert.Nil(authAcct)
+	}
+	lockout, err = repo.LookupLockout(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.True(lockout.Locked())
+	assert.WithinDuration(time.Now().Add(time.Hour), lockout.LockedUntil, time.Minute)
+
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), am.PublicId, "alice", passwd)
+	require.NoError(err)
+	assert.Nil(authAcct, "locked out account authenticated")
+
+	require.NoError(repo.UnlockAccount(ctx, acct.PublicId))
+	lockout, err = repo.LookupLockout(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.False(lockout.Locked())
+	authAcct, err = repo.Authenticate(ctx, o.GetPublicId(), am.PublicId, "alice", passwd)
+	require.NoError(err)
+	assert.NotNil(authAcct)
+}
+
+func TestRepository_Lockout_Disabled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+	assert.Zero(am.LockoutMaxFailedAttempts)
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	passwd := "12345678"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: am.PublicId,
+			LoginName:    "alice",
+		},
+	}, WithPassword(passwd))
+	require.NoError(err)
+
+	for i := 0; i < 10; i++ {
+		authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), am.PublicId, "alice", "wrong password")
+		require.NoError(err)
+		assert.Nil(authAcct)
+	}
+	lockout, err := repo.LookupLockout(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(0, lockout.FailedAttempts)
+	assert.False(lockout.Locked())
+}
@@ -0,0 +1,909 @@
+package password
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mfaChallengeTtl is how long a second factor challenge can be answered.
+const mfaChallengeTtl = 5 * time.Minute
+
+// The length of the random challenges of WebAuthn ceremonies.
+const webAuthnChallengeLength = 32
+
+// WebAuthnRegistration contains the parameters of the
+// navigator.credentials.create() call registering a WebAuthn credential for
+// an Account.
+type WebAuthnRegistration struct {
+	// ChallengeId identifies the challenge when the registration is
+	// confirmed.
+	ChallengeId    string
+	Challenge      []byte
+	ExpirationTime time.Time
+	RpId           string
+	// UserId is the user handle of the Account, which is its public id.
+	UserId   []byte
+	UserName string
+	// ExcludeCredentialIds are the ids of the credentials already registered
+	// for the Account.
+	ExcludeCredentialIds [][]byte
+}
+
+// MfaAuthentication contains the second factor challenge of an Account
+// which authenticated with its password.
+type MfaAuthentication struct {
+	// ChallengeId identifies the challenge when it is answered.
+	ChallengeId    string
+	ExpirationTime time.Time
+	// Factors are the types of the second factors the challenge can be
+	// answered with.
+	Factors []string
+	// WebAuthnChallenge, WebAuthnRpId and WebAuthnCredentialIds are the
+	// parameters of the navigator.credentials.get() call answering the
+	// challenge, if the Account has WebAuthn credentials.
+	WebAuthnChallenge     []byte
+	WebAuthnRpId          string
+	WebAuthnCredentialIds [][]byte
+}
+
+// MfaResponse answers an MfaAuthentication challenge. Exactly one of
+// TotpCode, RecoveryCode and WebAuthnAssertion must be set.
+type MfaResponse struct {
+	TotpCode          string
+	RecoveryCode      string
+	WebAuthnAssertion *WebAuthnAssertion
+}
+
+// LookupMfa returns the second factor status of the account with accountId.
+// All options are ignored.
+func (r *Repository) LookupMfa(ctx context.Context, accountId string, _ ...Option) (*MfaStatus, error) {
+	const op = "password.(Repository).LookupMfa"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	return lookupMfa(ctx, r.reader, accountId)
+}
+
+// EnrollTotp generates a new TOTP seed for the account with accountId,
+// replacing any unconfirmed seed, and returns the base32 encoded seed and its
+// otpauth URL. The seed cannot be used until it is confirmed with
+// ConfirmTotp. Returns an error if the account already has a confirmed seed.
+// All options are ignored.
+func (r *Repository) EnrollTotp(ctx context.Context, accountId string, _ ...Option) (string, string, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if accountId == "" {
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	existing, err := lookupTotp(ctx, r.reader, accountId)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	if existing != nil && existing.Confirmed {
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, "a totp authenticator is already enrolled", errors.WithoutEvent())
+	}
+
+	secret, err := newTotpSecret()
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	t := allocTotp()
+	t.PasswordAccountId = accountId
+	t.Secret = secret
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.encrypt(ctx, databaseWrapper); err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if existing != nil {
+				if _, err := w.Delete(ctx, existing.clone(), db.WithOplog(oplogWrapper, existing.oplog(oplog.OpType_OP_TYPE_DELETE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete unconfirmed totp"))
+				}
+			}
+			newT := t.clone()
+			// only the encrypted secret is written
+			newT.Secret = nil
+			if err := w.Create(ctx, newT, db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create totp"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return totpEncoding.EncodeToString(secret), totpUrl(acct.LoginName, secret), nil
+}
+
+// ConfirmTotp confirms the TOTP seed enrolled for the account with accountId
+// with code. If the account has no recovery codes, new ones are generated and
+// returned. Returns an error if code is not valid. All options are ignored.
+func (r *Repository) ConfirmTotp(ctx context.Context, accountId, code string, _ ...Option) ([]string, error) {
+	const op = "password.(Repository).ConfirmTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if code == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+	_, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	t, err := lookupTotp(ctx, r.reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case t == nil:
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "no totp authenticator enrolled", errors.WithoutEvent())
+	case t.Confirmed:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "the totp authenticator is already confirmed", errors.WithoutEvent())
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(t.KeyId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	step, ok := validateTotpCode(t.Secret, code, time.Now(), t.LastStep)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid code", errors.WithoutEvent())
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var codes []string
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			upT := t.clone()
+			upT.Secret = nil
+			upT.Confirmed = true
+			upT.LastStep = step
+			rowsUpdated, err := w.Update(ctx, upT, []string{"Confirmed", "LastStep"}, nil, db.WithOplog(oplogWrapper, upT.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated totp and %d rows updated", rowsUpdated))
+			}
+			codes, err = createInitialRecoveryCodes(ctx, reader, w, oplogWrapper, accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return codes, nil
+}
+
+// EnrollWebAuthn starts the registration of a WebAuthn credential for the
+// account with accountId and returns the parameters of the
+// navigator.credentials.create() call. The registration is completed with
+// ConfirmWebAuthn. The auth method of the account must have a WebAuthn
+// relying party id. All options are ignored.
+func (r *Repository) EnrollWebAuthn(ctx context.Context, accountId string, _ ...Option) (*WebAuthnRegistration, error) {
+	const op = "password.(Repository).EnrollWebAuthn"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rpId, err := webAuthnRpId(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	creds, err := listWebAuthnCredentials(ctx, r.reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c, err := r.createMfaChallenge(ctx, accountId, mfaPurposeRegister)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	reg := &WebAuthnRegistration{
+		ChallengeId:    c.PrivateId,
+		Challenge:      c.Challenge,
+		ExpirationTime: c.ExpirationTime.AsTime(),
+		RpId:           rpId,
+		UserId:         []byte(acct.PublicId),
+		UserName:       acct.LoginName,
+	}
+	for _, cred := range creds {
+		reg.ExcludeCredentialIds = append(reg.ExcludeCredentialIds, cred.CredentialId)
+	}
+	return reg, nil
+}
+
+// ConfirmWebAuthn registers the WebAuthn credential of att, created for the
+// registration challenge challengeId of the account with accountId. The
+// challenge is deleted, whether or not att is valid. If the account has no
+// recovery codes, new ones are generated and returned. All options are
+// ignored.
+func (r *Repository) ConfirmWebAuthn(ctx context.Context, accountId, challengeId string, att *WebAuthnAttestation, _ ...Option) ([]string, error) {
+	const op = "password.(Repository).ConfirmWebAuthn"
+	switch {
+	case accountId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	case challengeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing challenge id")
+	case att == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing attestation")
+	case len(att.CredentialId) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+	}
+	_, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c, err := r.consumeMfaChallenge(ctx, challengeId, mfaPurposeRegister)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if c.PasswordAccountId != accountId {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "challenge not found", errors.WithoutEvent())
+	}
+	rpId, err := webAuthnRpId(ctx, am)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := verifyClientData(ctx, att.ClientDataJSON, webAuthnCreate, c.Challenge, rpId); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	signCount, err := verifyAuthenticatorData(ctx, att.AuthenticatorData, rpId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if _, err := parseWebAuthnPublicKey(ctx, att.PublicKey, att.PublicKeyAlgorithm); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	id, err := newWebAuthnCredentialId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cred := allocWebAuthnCredential()
+	cred.PrivateId = id
+	cred.PasswordAccountId = accountId
+	cred.Name = att.Name
+	cred.CredentialId = att.CredentialId
+	cred.PublicKey = att.PublicKey
+	cred.PublicKeyAlgorithm = att.PublicKeyAlgorithm
+	cred.SignCount = signCount
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var codes []string
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if err := w.Create(ctx, cred.clone(), db.WithOplog(oplogWrapper, cred.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create webauthn credential"))
+			}
+			codes, err = createInitialRecoveryCodes(ctx, reader, w, oplogWrapper, accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("webauthn credential or name %q already registered", att.Name))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return codes, nil
+}
+
+// RemoveMfa removes a second factor of the account with accountId. mfaType
+// must be MfaTypeTotp or MfaTypeWebAuthn. credentialId is the id of the
+// WebAuthn credential to remove and must only be set for MfaTypeWebAuthn. The
+// recovery codes of the account are removed with its last second factor.
+// All options are ignored.
+func (r *Repository) RemoveMfa(ctx context.Context, accountId, mfaType string, credentialId []byte, _ ...Option) error {
+	const op = "password.(Repository).RemoveMfa"
+	if accountId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	switch mfaType {
+	case MfaTypeTotp:
+		if len(credentialId) != 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "credential id must not be set for totp")
+		}
+	case MfaTypeWebAuthn:
+		if len(credentialId) == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing credential id")
+		}
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown second factor type %q", mfaType))
+	}
+	_, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			var rowsDeleted int
+			var err error
+			switch mfaType {
+			case MfaTypeTotp:
+				t := allocTotp()
+				t.PasswordAccountId = accountId
+				rowsDeleted, err = w.Delete(ctx, t, db.WithOplog(oplogWrapper, t.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			case MfaTypeWebAuthn:
+				cred := allocWebAuthnCredential()
+				if err := reader.LookupWhere(ctx, cred, "password_account_id = ? and credential_id = ?", accountId, credentialId); err != nil {
+					if errors.IsNotFoundError(err) {
+						return errors.New(ctx, errors.RecordNotFound, op, "webauthn credential not found", errors.WithoutEvent())
+					}
+					return errors.Wrap(ctx, err, op)
+				}
+				rowsDeleted, err = w.Delete(ctx, cred, db.WithOplog(oplogWrapper, cred.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			}
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted != 1 {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("%s second factor not found", mfaType), errors.WithoutEvent())
+			}
+			status, err := lookupMfa(ctx, reader, accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if !status.Enabled() {
+				if err := deleteRecoveryCodes(ctx, reader, w, oplogWrapper, accountId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return nil
+}
+
+// GenerateRecoveryCodes replaces the recovery codes of the account with
+// accountId with new ones, which are returned. The account must have a
+// second factor enrolled. All options are ignored.
+func (r *Repository) GenerateRecoveryCodes(ctx context.Context, accountId string, _ ...Option) ([]string, error) {
+	const op = "password.(Repository).GenerateRecoveryCodes"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	_, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	status, err := lookupMfa(ctx, r.reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if !status.Enabled() {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no second factor enrolled", errors.WithoutEvent())
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var codes []string
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			if err := deleteRecoveryCodes(ctx, reader, w, oplogWrapper, accountId); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			var err error
+			codes, err = createRecoveryCodes(ctx, w, oplogWrapper, accountId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return codes, nil
+}
+
+// StartMfa starts the second step of the authentication of the account with
+// accountId, which authenticated with its password. Returns nil if the
+// account has no second factor enrolled and its auth method does not require
+// one. Returns an error with code Forbidden if the auth method requires a
+// second factor and the account has none. All options are ignored.
+func (r *Repository) StartMfa(ctx context.Context, accountId string, _ ...Option) (*MfaAuthentication, error) {
+	const op = "password.(Repository).StartMfa"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	_, am, err := r.lookupMfaAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	status, err := lookupMfa(ctx, r.reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case status.Enabled():
+	case am.MfaRequired:
+		return nil, errors.New(ctx, errors.Forbidden, op, "a second factor is required and none is enrolled", errors.WithoutEvent())
+	default:
+		return nil, nil
+	}
+
+	c, err := r.createMfaChallenge(ctx, accountId, mfaPurposeAuthenticate)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ma := &MfaAuthentication{
+		ChallengeId:    c.PrivateId,
+		ExpirationTime: c.ExpirationTime.AsTime(),
+		Factors:        status.Factors(),
+	}
+	if len(status.WebAuthnCredentials) > 0 && am.WebauthnRpId != "" {
+		ma.WebAuthnChallenge = c.Challenge
+		ma.WebAuthnRpId = am.WebauthnRpId
+		for _, cred := range status.WebAuthnCredentials {
+			ma.WebAuthnCredentialIds = append(ma.WebAuthnCredentialIds, cred.CredentialId)
+		}
+	}
+	return ma, nil
+}
+
+// VerifyMfa verifies resp answers the authentication challenge challengeId
+// returned by StartMfa. The challenge is deleted, whether or not resp is
+// valid. The account of the challenge is returned if resp is valid. Returns
+// nil if resp is not valid. Returns an error with code AuthAttemptExpired if
+// the challenge expired. An invalid resp counts as a failed authentication
+// attempt of the account, as in Authenticate. All options are ignored.
+func (r *Repository) VerifyMfa(ctx context.Context, challengeId string, resp *MfaResponse, _ ...Option) (*Account, error) {
+	const op = "password.(Repository).VerifyMfa"
+	if challengeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing challenge id", errors.WithoutEvent())
+	}
+	if resp == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing response", errors.WithoutEvent())
+	}
+	var set int
+	for _, b := range []bool{resp.TotpCode != "", resp.RecoveryCode != "", resp.WebAuthnAssertion != nil} {
+		if b {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "exactly one of totp code, recovery code and webauthn assertion must be set", errors.WithoutEvent())
+	}
+
+	c, err := r.consumeMfaChallenge(ctx, challengeId, mfaPurposeAuthenticate)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	acct, am, err := r.lookupMfaAccount(ctx, c.PasswordAccountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	lockout, err := r.lookupLockoutState(ctx, lockoutStateByAccountIdQuery, sql.Named("account_id", acct.PublicId))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if lockout != nil && lockout.enabled() && lockout.lockout.Locked() {
+		return nil, nil
+	}
+
+	var ok bool
+	switch {
+	case resp.TotpCode != "":
+		ok, err = r.verifyTotp(ctx, acct, am, resp.TotpCode)
+	case resp.RecoveryCode != "":
+		ok, err = r.useRecoveryCode(ctx, acct, am, resp.RecoveryCode)
+	default:
+		ok, err = r.verifyWebAuthn(ctx, acct, am, c, resp.WebAuthnAssertion)
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if lockout != nil && lockout.enabled() {
+		switch {
+		case !ok:
+			if err := r.recordFailedAttempt(ctx, lockout); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		case lockout.lockout.FailedAttempts > 0:
+			if err := r.resetLockout(ctx, lockout.accountId); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+	}
+	if !ok {
+		return nil, nil
+	}
+	return acct, nil
+}
+
+func (r *Repository) verifyTotp(ctx context.Context, acct *Account, am *AuthMethod, code string) (bool, error) {
+	const op = "password.(Repository).verifyTotp"
+	t, err := lookupTotp(ctx, r.reader, acct.PublicId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if t == nil || !t.Confirmed {
+		return false, nil
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeDatabase, kms.WithKeyId(t.KeyId))
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := t.decrypt(ctx, databaseWrapper); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	step, ok := validateTotpCode(t.Secret, code, time.Now(), t.LastStep)
+	if !ok {
+		return false, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var rowsUpdated int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			upT := t.clone()
+			upT.Secret = nil
+			upT.LastStep = step
+			// the version of the seed is its last step, which prevents a
+			// code from being accepted twice by concurrent requests
+			var err error
+			rowsUpdated, err = w.Update(ctx, upT, []string{"LastStep"}, nil,
+				db.WithOplog(oplogWrapper, upT.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithWhere("last_step = ?", t.LastStep))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return rowsUpdated == 1, nil
+}
+
+func (r *Repository) useRecoveryCode(ctx context.Context, acct *Account, am *AuthMethod, code string) (bool, error) {
+	const op = "password.(Repository).useRecoveryCode"
+	rc := allocRecoveryCode()
+	if err := r.reader.LookupWhere(ctx, rc, "password_account_id = ? and code_hash = ?", acct.PublicId, hashRecoveryCode(code)); err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, rc.clone(), db.WithOplog(oplogWrapper, rc.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return rowsDeleted == 1, nil
+}
+
+func (r *Repository) verifyWebAuthn(ctx context.Context, acct *Account, am *AuthMethod, c *MfaChallenge, a *WebAuthnAssertion) (bool, error) {
+	const op = "password.(Repository).verifyWebAuthn"
+	if len(a.CredentialId) == 0 {
+		return false, nil
+	}
+	if am.WebauthnRpId == "" {
+		return false, nil
+	}
+	cred := allocWebAuthnCredential()
+	if err := r.reader.LookupWhere(ctx, cred, "password_account_id = ? and credential_id = ?", acct.PublicId, a.CredentialId); err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if err := verifyClientData(ctx, a.ClientDataJSON, webAuthnGet, c.Challenge, am.WebauthnRpId); err != nil {
+		return false, nil
+	}
+	signCount, err := verifyAuthenticatorData(ctx, a.AuthenticatorData, am.WebauthnRpId)
+	if err != nil {
+		return false, nil
+	}
+	pub, err := parseWebAuthnPublicKey(ctx, cred.PublicKey, cred.PublicKeyAlgorithm)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if err := verifyWebAuthnSignature(ctx, pub, a.AuthenticatorData, a.ClientDataJSON, a.Signature); err != nil {
+		return false, nil
+	}
+	if signCount == 0 && cred.SignCount == 0 {
+		// the authenticator does not implement a signature counter
+		return true, nil
+	}
+	if signCount <= cred.SignCount {
+		// the credential may have been cloned
+		return false, nil
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, am.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var rowsUpdated int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			upCred := cred.clone()
+			upCred.SignCount = signCount
+			var err error
+			rowsUpdated, err = w.Update(ctx, upCred, []string{"SignCount"}, nil,
+				db.WithOplog(oplogWrapper, upCred.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithWhere("sign_count < ?", signCount))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return rowsUpdated == 1, nil
+}
+
+// lookupMfaAccount returns the account with accountId and its auth method.
+// Returns an error with code RecordNotFound if the account does not exist.
+func (r *Repository) lookupMfaAccount(ctx context.Context, accountId string) (*Account, *AuthMethod, error) {
+	const op = "password.(Repository).lookupMfaAccount"
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("account %s not found", accountId), errors.WithoutEvent())
+	}
+	am, err := r.lookupAuthMethod(ctx, acct.AuthMethodId)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	if am == nil {
+		return nil, nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("auth method %s not found", acct.AuthMethodId))
+	}
+	return acct, am, nil
+}
+
+// webAuthnRpId returns the WebAuthn relying party id of am or an error if it
+// has none.
+func webAuthnRpId(ctx context.Context, am *AuthMethod) (string, error) {
+	const op = "password.webAuthnRpId"
+	if am.WebauthnRpId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "the auth method has no webauthn relying party id", errors.WithoutEvent())
+	}
+	return am.WebauthnRpId, nil
+}
+
+// createMfaChallenge creates a new random challenge for accountId. Expired
+// challenges are deleted.
+func (r *Repository) createMfaChallenge(ctx context.Context, accountId, purpose string) (*MfaChallenge, error) {
+	const op = "password.(Repository).createMfaChallenge"
+	id, err := newMfaChallengeId()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	challenge := make([]byte, webAuthnChallengeLength)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	c := allocMfaChallenge()
+	c.PrivateId = id
+	c.PasswordAccountId = accountId
+	c.Purpose = purpose
+	c.Challenge = challenge
+	c.ExpirationTime = &timestamp.Timestamp{Timestamp: timestamppb.New(time.Now().Add(mfaChallengeTtl))}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if _, err := w.Exec(ctx, deleteExpiredMfaChallengesQuery, nil); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete expired challenges"))
+			}
+			if err := w.Create(ctx, c); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create challenge"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return c, nil
+}
+
+// consumeMfaChallenge deletes and returns the challenge with challengeId.
+// Returns an error with code RecordNotFound if the challenge does not exist
+// or is not for purpose, and an error with code AuthAttemptExpired if it
+// expired.
+func (r *Repository) consumeMfaChallenge(ctx context.Context, challengeId, purpose string) (*MfaChallenge, error) {
+	const op = "password.(Repository).consumeMfaChallenge"
+	c := allocMfaChallenge()
+	if err := r.reader.LookupWhere(ctx, c, "private_id = ?", challengeId); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.New(ctx, errors.RecordNotFound, op, "challenge not found", errors.WithoutEvent())
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rowsDeleted, err := r.writer.Delete(ctx, c)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case rowsDeleted != 1, c.Purpose != purpose:
+		// the challenge was answered concurrently or is not for purpose
+		return nil, errors.New(ctx, errors.RecordNotFound, op, "challenge not found", errors.WithoutEvent())
+	case time.Now().After(c.ExpirationTime.AsTime()):
+		return nil, errors.New(ctx, errors.AuthAttemptExpired, op, "challenge has expired", errors.WithoutEvent())
+	}
+	return c, nil
+}
+
+func lookupMfa(ctx context.Context, reader db.Reader, accountId string) (*MfaStatus, error) {
+	const op = "password.lookupMfa"
+	t, err := lookupTotp(ctx, reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	creds, err := listWebAuthnCredentials(ctx, reader, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var codes []*RecoveryCode
+	if err := reader.SearchWhere(ctx, &codes, "password_account_id = ?", []interface{}{accountId}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &MfaStatus{
+		TotpEnabled:            t != nil && t.Confirmed,
+		WebAuthnCredentials:    creds,
+		RecoveryCodesRemaining: len(codes),
+	}, nil
+}
+
+// lookupTotp returns the TOTP seed of accountId, undecrypted, or nil if the
+// account has none.
+func lookupTotp(ctx context.Context, reader db.Reader, accountId string) (*Totp, error) {
+	const op = "password.lookupTotp"
+	t := allocTotp()
+	if err := reader.LookupWhere(ctx, t, "password_account_id = ?", accountId); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return t, nil
+}
+
+func listWebAuthnCredentials(ctx context.Context, reader db.Reader, accountId string) ([]*WebAuthnCredential, error) {
+	const op = "password.listWebAuthnCredentials"
+	var creds []*WebAuthnCredential
+	if err := reader.SearchWhere(ctx, &creds, "password_account_id = ?", []interface{}{accountId}, db.WithLimit(-1), db.WithOrder("create_time")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return creds, nil
+}
+
+// createInitialRecoveryCodes creates and returns recovery codes for
+// accountId if it has none.
+func createInitialRecoveryCodes(ctx context.Context, reader db.Reader, w db.Writer, oplogWrapper wrapping.Wrapper, accountId string) ([]string, error) {
+	const op = "password.createInitialRecoveryCodes"
+	var existing []*RecoveryCode
+	if err := reader.SearchWhere(ctx, &existing, "password_account_id = ?", []interface{}{accountId}, db.WithLimit(1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(existing) > 0 {
+		return nil, nil
+	}
+	codes, err := createRecoveryCodes(ctx, w, oplogWrapper, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return codes, nil
+}
+
+func createRecoveryCodes(ctx context.Context, w db.Writer, oplogWrapper wrapping.Wrapper, accountId string) ([]string, error) {
+	const op = "password.createRecoveryCodes"
+	codes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	items := make([]interface{}, 0, len(codes))
+	for _, code := range codes {
+		rc, err := newRecoveryCode(accountId, code)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		items = append(items, rc)
+	}
+	if err := w.CreateItems(ctx, items, db.WithOplog(oplogWrapper, recoveryCodesOplog(accountId, oplog.OpType_OP_TYPE_CREATE))); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create recovery codes"))
+	}
+	return codes, nil
+}
+
+func deleteRecoveryCodes(ctx context.Context, reader db.Reader, w db.Writer, oplogWrapper wrapping.Wrapper, accountId string) error {
+	const op = "password.deleteRecoveryCodes"
+	var existing []*RecoveryCode
+	if err := reader.SearchWhere(ctx, &existing, "password_account_id = ?", []interface{}{accountId}, db.WithLimit(-1)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+	items := make([]interface{}, 0, len(existing))
+	for _, rc := range existing {
+		items = append(items, rc)
+	}
+	if _, err := w.DeleteItems(ctx, items, db.WithOplog(oplogWrapper, recoveryCodesOplog(accountId, oplog.OpType_OP_TYPE_DELETE))); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete recovery codes"))
+	}
+	return nil
+}
+
+func recoveryCodesOplog(accountId string, op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id":  []string{accountId},
+		"resource-type":       []string{"password recovery code"},
+		"op-type":             []string{op.String()},
+		"password-account-id": []string{accountId},
+	}
+}
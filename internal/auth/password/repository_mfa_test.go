@@ -0,0 +1,228 @@
+package password
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Totp(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	am := TestAuthMethod(t, conn, o.GetPublicId())
+	acct := TestAccount(t, conn, am.GetPublicId(), "alice")
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	ma, err := repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Nil(ma, "no second factor enrolled")
+
+	_, _, err = repo.EnrollTotp(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	// enrolling again replaces the unconfirmed seed
+	_, _, err = repo.EnrollTotp(ctx, acct.PublicId)
+	require.NoError(err)
+	secret, url, err := repo.EnrollTotp(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Contains(url, "secret="+secret)
+	seed, err := totpEncoding.DecodeString(secret)
+	require.NoError(err)
+
+	status, err := repo.LookupMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.False(status.Enabled(), "unconfirmed seed")
+
+	_, err = repo.ConfirmTotp(ctx, acct.PublicId, "000000")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	codes, err := repo.ConfirmTotp(ctx, acct.PublicId, totpCode(seed, totpStep(time.Now())-1))
+	require.NoError(err)
+	assert.Len(codes, recoveryCodeCount)
+
+	_, _, err = repo.EnrollTotp(ctx, acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	status, err = repo.LookupMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.True(status.TotpEnabled)
+	assert.Equal(recoveryCodeCount, status.RecoveryCodesRemaining)
+	assert.Equal([]string{MfaTypeTotp, MfaTypeRecoveryCode}, status.Factors())
+
+	// a valid code authenticates once
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	require.NotNil(ma)
+	assert.Equal([]string{MfaTypeTotp, MfaTypeRecoveryCode}, ma.Factors)
+	code := totpCode(seed, totpStep(time.Now()))
+	got, err := repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{TotpCode: code})
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(acct.PublicId, got.PublicId)
+
+	// the challenge is single use
+	_, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{TotpCode: code})
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+
+	// a code is single use
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	got, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{TotpCode: code})
+	require.NoError(err)
+	assert.Nil(got)
+
+	// a recovery code authenticates once
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	got, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{RecoveryCode: codes[0]})
+	require.NoError(err)
+	require.NotNil(got)
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	got, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{RecoveryCode: codes[0]})
+	require.NoError(err)
+	assert.Nil(got)
+
+	newCodes, err := repo.GenerateRecoveryCodes(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Len(newCodes, recoveryCodeCount)
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	got, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{RecoveryCode: codes[1]})
+	require.NoError(err)
+	assert.Nil(got, "recovery codes were replaced")
+
+	// removing the last second factor removes the recovery codes
+	require.NoError(repo.RemoveMfa(ctx, acct.PublicId, MfaTypeTotp, nil))
+	status, err = repo.LookupMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.False(status.Enabled())
+	assert.Zero(status.RecoveryCodesRemaining)
+	err = repo.RemoveMfa(ctx, acct.PublicId, MfaTypeTotp, nil)
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+	_, err = repo.GenerateRecoveryCodes(ctx, acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+}
+
+func TestRepository_WebAuthn(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	noRpId := TestAuthMethod(t, conn, o.GetPublicId())
+	acct := TestAccount(t, conn, noRpId.GetPublicId(), "bob")
+	_, err = repo.EnrollWebAuthn(ctx, acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+
+	const rpId = "example.com"
+	am := TestAuthMethod(t, conn, o.GetPublicId(), WithWebAuthnRpId(rpId))
+	acct = TestAccount(t, conn, am.GetPublicId(), "carol")
+	authenticator := newTestAuthenticator(t, "https://boundary.example.com")
+
+	reg, err := repo.EnrollWebAuthn(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal(rpId, reg.RpId)
+	assert.Equal("carol", reg.UserName)
+	assert.Empty(reg.ExcludeCredentialIds)
+
+	// the client data of an assertion is rejected
+	bad := authenticator.attest(reg.Challenge, rpId)
+	bad.ClientDataJSON = authenticator.clientData(webAuthnGet, reg.Challenge)
+	_, err = repo.ConfirmWebAuthn(ctx, acct.PublicId, reg.ChallengeId, bad)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	_, err = repo.ConfirmWebAuthn(ctx, acct.PublicId, reg.ChallengeId, authenticator.attest(reg.Challenge, rpId))
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "challenge is single use: %v", err)
+
+	reg, err = repo.EnrollWebAuthn(ctx, acct.PublicId)
+	require.NoError(err)
+	att := authenticator.attest(reg.Challenge, rpId)
+	att.Name = "key"
+	codes, err := repo.ConfirmWebAuthn(ctx, acct.PublicId, reg.ChallengeId, att)
+	require.NoError(err)
+	assert.Len(codes, recoveryCodeCount)
+
+	reg, err = repo.EnrollWebAuthn(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.Equal([][]byte{authenticator.credentialId}, reg.ExcludeCredentialIds)
+
+	status, err := repo.LookupMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	require.Len(status.WebAuthnCredentials, 1)
+	assert.Equal("key", status.WebAuthnCredentials[0].Name)
+
+	ma, err := repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	require.NotNil(ma)
+	assert.Equal(rpId, ma.WebAuthnRpId)
+	assert.Equal([][]byte{authenticator.credentialId}, ma.WebAuthnCredentialIds)
+	got, err := repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{WebAuthnAssertion: authenticator.assert(ma.WebAuthnChallenge, rpId)})
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(acct.PublicId, got.PublicId)
+
+	// an assertion for another challenge is rejected
+	stale := authenticator.assert(ma.WebAuthnChallenge, rpId)
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	got, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{WebAuthnAssertion: stale})
+	require.NoError(err)
+	assert.Nil(got)
+
+	// a signature counter which does not increase is rejected
+	authenticator.signCount = 0
+	ma, err = repo.StartMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	got, err = repo.VerifyMfa(ctx, ma.ChallengeId, &MfaResponse{WebAuthnAssertion: authenticator.assert(ma.WebAuthnChallenge, rpId)})
+	require.NoError(err)
+	assert.Nil(got)
+
+	require.NoError(repo.RemoveMfa(ctx, acct.PublicId, MfaTypeWebAuthn, authenticator.credentialId))
+	status, err = repo.LookupMfa(ctx, acct.PublicId)
+	require.NoError(err)
+	assert.False(status.Enabled())
+	assert.Zero(status.RecoveryCodesRemaining)
+}
+
+func TestRepository_StartMfa_Required(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	am := TestAuthMethod(t, conn, o.GetPublicId(), WithMfaRequired(true))
+	acct := TestAccount(t, conn, am.GetPublicId(), "dave")
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	_, err = repo.StartMfa(ctx, acct.PublicId)
+	assert.Truef(errors.Match(errors.T(errors.Forbidden), err), "unexpected error %v", err)
+
+	_, err = repo.VerifyMfa(ctx, "pwmfachl_1234567890", &MfaResponse{TotpCode: "123456"})
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %v", err)
+	_, err = repo.VerifyMfa(ctx, "pwmfachl_1234567890", &MfaResponse{TotpCode: "123456", RecoveryCode: "abcd"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+}
@@ -25,6 +25,11 @@ type authAccount struct {
 // authMethodId. The account for the loginName is returned if authentication
 // is successful. Returns nil if authentication fails.
 //
+// If the lockout of authMethodId is enabled, a failed authentication is
+// recorded for the account and the account is locked out once it reaches
+// the maximum number of failed attempts. Authentication of a locked out
+// account always fails.
+//
 // The CredentialId in the returned account represents a user's current
 // password. A new CredentialId is generated when a user's password is
 // changed and the old one is deleted.
@@ -52,10 +57,32 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
 	}
 
+	lockout, err := r.lookupLockoutState(ctx, lockoutStateByLoginNameQuery, sql.Named("auth_method_id", authMethodId), sql.Named("login_name", loginName))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if lockout != nil && lockout.enabled() && lockout.lockout.Locked() {
+		// do not check the password of a locked out account
+		return nil, nil
+	}
+
 	acct, err := r.authenticate(ctx, scopeId, authMethodId, loginName, password)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if lockout != nil && lockout.enabled() {
+		switch {
+		case acct == nil:
+			if err := r.recordFailedAttempt(ctx, lockout); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		case lockout.lockout.FailedAttempts > 0 && !lockout.mfaEnabled:
+			// accounts with a second factor are reset by VerifyMfa
+			if err := r.resetLockout(ctx, lockout.accountId); err != nil {
+				return nil, errors.Wrap(ctx, err, op)
+			}
+		}
+	}
 	if acct == nil {
 		return nil, nil
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/stretchr/testify/assert"
@@ -95,3 +96,13 @@ func TestAccount(t *testing.T, conn *db.DB, authMethodId, loginName string, opt
 	require.NoError(err2)
 	return cat
 }
+
+// TestTotpCode returns the current code of the TOTP authenticator with the
+// base32 encoded secret returned by Repository.EnrollTotp. If the secret
+// cannot be decoded, the test will fail.
+func TestTotpCode(t *testing.T, secret string) string {
+	t.Helper()
+	seed, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	return totpCode(seed, totpStep(time.Now()))
+}
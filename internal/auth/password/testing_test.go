@@ -2,6 +2,7 @@ package password
 
 import (
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -43,3 +44,14 @@ func Test_TestMultipleAccounts(t *testing.T) {
 		assert.NotEmpty(a.GetPublicId())
 	}
 }
+
+func Test_TestTotpCode(t *testing.T) {
+	assert := assert.New(t)
+	secret := totpEncoding.EncodeToString([]byte("12345678901234567890"))
+	code := TestTotpCode(t, secret)
+	assert.Len(code, totpDigits)
+	seed, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+	_, ok := validateTotpCode(seed, code, time.Now(), 0)
+	assert.True(ok)
+}
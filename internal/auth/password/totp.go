@@ -0,0 +1,89 @@
+package password
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters, see RFC 6238. These are the defaults of most
+// authenticator applications.
+const (
+	totpSecretLength = 20
+	totpDigits       = 6
+	totpPeriod       = 30
+	totpIssuer       = "Boundary"
+
+	// totpSkew is the number of time steps before and after the current time
+	// step a code is accepted for, to account for clock drift.
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func newTotpSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// totpStep returns the time step of t.
+func totpStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+// totpCode returns the code of secret for step, see RFC 4226.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, v%1000000)
+}
+
+// validateTotpCode returns the time step code is valid for at t. Codes are
+// only valid for time steps after lastStep, so a code cannot be used twice.
+// Returns false if code is not valid.
+func validateTotpCode(secret []byte, code string, t time.Time, lastStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := totpStep(t)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpUrl returns the otpauth URL of secret for loginName, which
+// authenticator applications can import, usually from a QR code.
+func totpUrl(loginName string, secret []byte) string {
+	u := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + totpIssuer + ":" + loginName,
+	}
+	q := url.Values{}
+	q.Set("secret", totpEncoding.EncodeToString(secret))
+	q.Set("issuer", totpIssuer)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
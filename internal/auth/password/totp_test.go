@@ -0,0 +1,74 @@
+package password
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_totpCode(t *testing.T) {
+	// The SHA1 test vectors of RFC 6238, truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{time: 59, want: "287082"},
+		{time: 1111111109, want: "081804"},
+		{time: 1111111111, want: "050471"},
+		{time: 1234567890, want: "005924"},
+		{time: 2000000000, want: "279037"},
+		{time: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, totpCode(secret, totpStep(time.Unix(tt.time, 0))), "time %d", tt.time)
+	}
+}
+
+func Test_validateTotpCode(t *testing.T) {
+	secret, err := newTotpSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, totpSecretLength)
+
+	now := time.Now()
+	step := totpStep(now)
+	tests := []struct {
+		name     string
+		code     string
+		lastStep int64
+		wantStep int64
+		wantOk   bool
+	}{
+		{name: "current", code: totpCode(secret, step), wantStep: step, wantOk: true},
+		{name: "previous", code: totpCode(secret, step-1), wantStep: step - 1, wantOk: true},
+		{name: "next", code: totpCode(secret, step+1), wantStep: step + 1, wantOk: true},
+		{name: "with-spaces", code: " " + totpCode(secret, step) + " ", wantStep: step, wantOk: true},
+		{name: "too-old", code: totpCode(secret, step-2)},
+		{name: "too-new", code: totpCode(secret, step+2)},
+		{name: "reused", code: totpCode(secret, step), lastStep: step},
+		{name: "short", code: "12345"},
+		{name: "empty", code: ""},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOk := validateTotpCode(secret, tt.code, now, tt.lastStep)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.wantStep, gotStep)
+		})
+	}
+}
+
+func Test_totpUrl(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	u, err := url.Parse(totpUrl("alice", secret))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Boundary:alice", u.Path)
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	assert.Equal(t, "Boundary", u.Query().Get("issuer"))
+}
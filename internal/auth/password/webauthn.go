@@ -0,0 +1,176 @@
+package password
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// COSE algorithm identifiers of the supported WebAuthn public keys, see
+// https://www.iana.org/assignments/cose/cose.xhtml#algorithms
+const (
+	coseAlgES256 = -7
+	coseAlgEdDSA = -8
+	coseAlgRS256 = -257
+)
+
+// Client data types of WebAuthn ceremonies.
+const (
+	webAuthnCreate = "webauthn.create"
+	webAuthnGet    = "webauthn.get"
+)
+
+// The user present flag of the authenticator data.
+const authDataUserPresent = 0x01
+
+// webAuthnEncoding is the encoding of the binary values exchanged with
+// WebAuthn clients.
+var webAuthnEncoding = base64.RawURLEncoding
+
+// WebAuthnAttestation is the response of an authenticator to a
+// navigator.credentials.create() call. ClientDataJSON and AuthenticatorData
+// are the values returned by the AuthenticatorAttestationResponse, PublicKey
+// is the DER encoded SubjectPublicKeyInfo returned by its getPublicKey()
+// method and PublicKeyAlgorithm the COSE algorithm identifier returned by
+// its getPublicKeyAlgorithm() method.
+type WebAuthnAttestation struct {
+	Name               string
+	CredentialId       []byte
+	ClientDataJSON     []byte
+	AuthenticatorData  []byte
+	PublicKey          []byte
+	PublicKeyAlgorithm int32
+}
+
+// WebAuthnAssertion is the response of an authenticator to a
+// navigator.credentials.get() call.
+type WebAuthnAssertion struct {
+	CredentialId      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// verifyClientData verifies the client data of a WebAuthn ceremony of typ
+// is for challenge and comes from an origin of rpId.
+func verifyClientData(ctx context.Context, clientDataJSON []byte, typ string, challenge []byte, rpId string) error {
+	const op = "password.verifyClientData"
+	var cd clientData
+	if err := json.Unmarshal(clientDataJSON, &cd); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse client data"), errors.WithoutEvent())
+	}
+	if cd.Type != typ {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("client data type %q, expected %q", cd.Type, typ), errors.WithoutEvent())
+	}
+	got, err := webAuthnEncoding.DecodeString(strings.TrimRight(cd.Challenge, "="))
+	if err != nil || subtle.ConstantTimeCompare(got, challenge) != 1 {
+		return errors.New(ctx, errors.InvalidParameter, op, "client data challenge does not match", errors.WithoutEvent())
+	}
+	origin, err := url.Parse(cd.Origin)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse client data origin"), errors.WithoutEvent())
+	}
+	host := origin.Hostname()
+	switch {
+	case origin.Scheme == "https":
+	case origin.Scheme == "http" && host == "localhost":
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("client data origin %q is not secure", cd.Origin), errors.WithoutEvent())
+	}
+	if host != rpId && !strings.HasSuffix(host, "."+rpId) {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("client data origin %q does not match relying party %q", cd.Origin, rpId), errors.WithoutEvent())
+	}
+	return nil
+}
+
+// verifyAuthenticatorData verifies the authenticator data of a WebAuthn
+// ceremony is for rpId and the user was present, and returns its signature
+// counter.
+func verifyAuthenticatorData(ctx context.Context, authData []byte, rpId string) (uint32, error) {
+	const op = "password.verifyAuthenticatorData"
+	// rpIdHash (32 bytes) | flags (1 byte) | signCount (4 bytes) | ...
+	if len(authData) < 37 {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "authenticator data is too short", errors.WithoutEvent())
+	}
+	rpIdHash := sha256.Sum256([]byte(rpId))
+	if subtle.ConstantTimeCompare(authData[:32], rpIdHash[:]) != 1 {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "authenticator data relying party does not match", errors.WithoutEvent())
+	}
+	if authData[32]&authDataUserPresent == 0 {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "user not present", errors.WithoutEvent())
+	}
+	return binary.BigEndian.Uint32(authData[33:37]), nil
+}
+
+// parseWebAuthnPublicKey parses the DER encoded SubjectPublicKeyInfo of a
+// WebAuthn credential and verifies it matches the COSE algorithm alg.
+func parseWebAuthnPublicKey(ctx context.Context, der []byte, alg int32) (crypto.PublicKey, error) {
+	const op = "password.parseWebAuthnPublicKey"
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("unable to parse public key"), errors.WithoutEvent())
+	}
+	var ok bool
+	switch alg {
+	case coseAlgES256:
+		var k *ecdsa.PublicKey
+		k, ok = pub.(*ecdsa.PublicKey)
+		ok = ok && k.Curve.Params().Name == "P-256"
+	case coseAlgEdDSA:
+		_, ok = pub.(ed25519.PublicKey)
+	case coseAlgRS256:
+		_, ok = pub.(*rsa.PublicKey)
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported public key algorithm %d", alg), errors.WithoutEvent())
+	}
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("public key does not match algorithm %d", alg), errors.WithoutEvent())
+	}
+	return pub, nil
+}
+
+// verifyWebAuthnSignature verifies sig is the signature of an assertion by
+// the credential with the public key pub.
+func verifyWebAuthnSignature(ctx context.Context, pub crypto.PublicKey, authData, clientDataJSON, sig []byte) error {
+	const op = "password.verifyWebAuthnSignature"
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := make([]byte, 0, len(authData)+len(clientDataHash))
+	signed = append(signed, authData...)
+	signed = append(signed, clientDataHash[:]...)
+	digest := sha256.Sum256(signed)
+
+	var ok bool
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(k, digest[:], sig)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(k, signed, sig)
+	case *rsa.PublicKey:
+		ok = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig) == nil
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported public key type %T", pub), errors.WithoutEvent())
+	}
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid signature", errors.WithoutEvent())
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package password
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testAuthenticator is a WebAuthn authenticator with an ES256 credential.
+type testAuthenticator struct {
+	t            *testing.T
+	key          *ecdsa.PrivateKey
+	credentialId []byte
+	signCount    uint32
+	origin       string
+}
+
+func newTestAuthenticator(t *testing.T, origin string) *testAuthenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	id := make([]byte, 16)
+	_, err = rand.Read(id)
+	require.NoError(t, err)
+	return &testAuthenticator{t: t, key: key, credentialId: id, origin: origin}
+}
+
+func (a *testAuthenticator) clientData(typ string, challenge []byte) []byte {
+	a.t.Helper()
+	b, err := json.Marshal(clientData{
+		Type:      typ,
+		Challenge: webAuthnEncoding.EncodeToString(challenge),
+		Origin:    a.origin,
+	})
+	require.NoError(a.t, err)
+	return b
+}
+
+func (a *testAuthenticator) authData(rpId string) []byte {
+	h := sha256.Sum256([]byte(rpId))
+	d := append(h[:], authDataUserPresent, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(d[33:], a.signCount)
+	return d
+}
+
+func (a *testAuthenticator) attest(challenge []byte, rpId string) *WebAuthnAttestation {
+	a.t.Helper()
+	pub, err := x509.MarshalPKIXPublicKey(&a.key.PublicKey)
+	require.NoError(a.t, err)
+	return &WebAuthnAttestation{
+		CredentialId:       a.credentialId,
+		ClientDataJSON:     a.clientData(webAuthnCreate, challenge),
+		AuthenticatorData:  a.authData(rpId),
+		PublicKey:          pub,
+		PublicKeyAlgorithm: coseAlgES256,
+	}
+}
+
+func (a *testAuthenticator) assert(challenge []byte, rpId string) *WebAuthnAssertion {
+	a.t.Helper()
+	a.signCount++
+	cd := a.clientData(webAuthnGet, challenge)
+	ad := a.authData(rpId)
+	cdHash := sha256.Sum256(cd)
+	digest := sha256.Sum256(append(append([]byte{}, ad...), cdHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(a.t, err)
+	return &WebAuthnAssertion{
+		CredentialId:      a.credentialId,
+		ClientDataJSON:    cd,
+		AuthenticatorData: ad,
+		Signature:         sig,
+	}
+}
+
+func Test_verifyClientData(t *testing.T) {
+	ctx := context.Background()
+	challenge := []byte("challenge-challenge-challenge-ch")
+	tests := []struct {
+		name      string
+		typ       string
+		challenge []byte
+		origin    string
+		rpId      string
+		json      []byte
+		wantErr   bool
+	}{
+		{name: "valid", typ: webAuthnGet, challenge: challenge, origin: "https://example.com", rpId: "example.com"},
+		{name: "valid-subdomain", typ: webAuthnGet, challenge: challenge, origin: "https://boundary.example.com:9200", rpId: "example.com"},
+		{name: "valid-localhost", typ: webAuthnGet, challenge: challenge, origin: "http://localhost:9200", rpId: "localhost"},
+		{name: "wrong-type", typ: webAuthnCreate, challenge: challenge, origin: "https://example.com", rpId: "example.com", wantErr: true},
+		{name: "wrong-challenge", typ: webAuthnGet, challenge: []byte("other"), origin: "https://example.com", rpId: "example.com", wantErr: true},
+		{name: "insecure-origin", typ: webAuthnGet, challenge: challenge, origin: "http://example.com", rpId: "example.com", wantErr: true},
+		{name: "other-origin", typ: webAuthnGet, challenge: challenge, origin: "https://example.org", rpId: "example.com", wantErr: true},
+		{name: "suffix-origin", typ: webAuthnGet, challenge: challenge, origin: "https://notexample.com", rpId: "example.com", wantErr: true},
+		{name: "invalid-json", json: []byte("{"), rpId: "example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cdJson := tt.json
+			if cdJson == nil {
+				cdJson = newTestAuthenticator(t, tt.origin).clientData(tt.typ, tt.challenge)
+			}
+			err := verifyClientData(ctx, cdJson, webAuthnGet, challenge, tt.rpId)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_verifyAuthenticatorData(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAuthenticator(t, "https://example.com")
+	a.signCount = 42
+
+	got, err := verifyAuthenticatorData(ctx, a.authData("example.com"), "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(42), got)
+
+	_, err = verifyAuthenticatorData(ctx, a.authData("example.org"), "example.com")
+	assert.Error(t, err)
+	_, err = verifyAuthenticatorData(ctx, a.authData("example.com")[:36], "example.com")
+	assert.Error(t, err)
+	notPresent := a.authData("example.com")
+	notPresent[32] = 0
+	_, err = verifyAuthenticatorData(ctx, notPresent, "example.com")
+	assert.Error(t, err)
+}
+
+func Test_verifyWebAuthnSignature(t *testing.T) {
+	ctx := context.Background()
+	authData := []byte("authenticator data")
+	clientDataJSON := []byte(`{"type":"webauthn.get"}`)
+	cdHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte{}, authData...), cdHash[:]...)
+	digest := sha256.Sum256(signed)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	require.NoError(t, err)
+
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edSig := ed25519.Sign(edKey, signed)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		pub  crypto.PublicKey
+		alg  int32
+		sig  []byte
+	}{
+		{name: "es256", pub: &ecKey.PublicKey, alg: coseAlgES256, sig: ecSig},
+		{name: "eddsa", pub: edPub, alg: coseAlgEdDSA, sig: edSig},
+		{name: "rs256", pub: &rsaKey.PublicKey, alg: coseAlgRS256, sig: rsaSig},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			der, err := x509.MarshalPKIXPublicKey(tt.pub)
+			require.NoError(err)
+			pub, err := parseWebAuthnPublicKey(ctx, der, tt.alg)
+			require.NoError(err)
+
+			assert.NoError(verifyWebAuthnSignature(ctx, pub, authData, clientDataJSON, tt.sig))
+			assert.Error(verifyWebAuthnSignature(ctx, pub, []byte("other data"), clientDataJSON, tt.sig))
+
+			// the key does not match any other algorithm
+			for _, alg := range []int32{coseAlgES256, coseAlgEdDSA, coseAlgRS256} {
+				if alg == tt.alg {
+					continue
+				}
+				_, err := parseWebAuthnPublicKey(ctx, der, alg)
+				assert.Error(err)
+			}
+		})
+	}
+
+	_, err = parseWebAuthnPublicKey(ctx, []byte("not a key"), coseAlgES256)
+	assert.Error(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	_, err = parseWebAuthnPublicKey(ctx, der, -35)
+	assert.Error(t, err)
+}
@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+)
+
+// AccessRevoker revokes the access of users when they or their accounts are
+// deleted or disabled. Repositories call it with the writer of the transaction
+// deleting or disabling the user or account, so that the revocation is rolled
+// back along with it.
+type AccessRevoker interface {
+	// RevokeUserAccess cancels the pending and active sessions of the user and
+	// deletes the auth tokens of all of its accounts.
+	RevokeUserAccess(ctx context.Context, w db.Writer, userId string) error
+
+	// RevokeAccountAccess cancels the pending and active sessions of the user
+	// associated with the account, if any, and deletes the auth tokens of the
+	// account.
+	RevokeAccountAccess(ctx context.Context, w db.Writer, accountId string) error
+}
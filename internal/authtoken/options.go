@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/filter"
 )
 
 var (
@@ -31,6 +32,8 @@ type options struct {
 	withLimit                    int
 	withStatus                   Status
 	withPublicId                 string
+	withStartPageAfterId         string
+	withFilterConditions         []filter.Condition
 }
 
 func getDefaultOptions() options {
@@ -91,3 +94,19 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
+// WithFilterConditions provides an option to only list the items matching
+// the conditions of a list filter which can be evaluated by the database.
+func WithFilterConditions(c []filter.Condition) Option {
+	return func(o *options) {
+		o.withFilterConditions = c
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,4 +51,20 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPublicId = "test-id"
 		assert.Equal(opts, testOpts)
 	})
+
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("at_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "at_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithFilterConditions", func(t *testing.T) {
+		assert := assert.New(t)
+		conds := []filter.Condition{{Field: "name", Operator: filter.EqualOperator, Value: "foo"}}
+		opts := getOpts(WithFilterConditions(conds))
+		testOpts := getDefaultOptions()
+		testOpts.withFilterConditions = conds
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 )
@@ -18,6 +19,15 @@ var (
 	timeSkew                   = time.Duration(0)
 )
 
+// filterColumns are the columns of the fields of an auth token which can be
+// used in the filter conditions of a list.
+var filterColumns = map[string]string{
+	"scope_id":       "scope_id",
+	"user_id":        "iam_user_id",
+	"auth_method_id": "auth_method_id",
+	"account_id":     "auth_account_id",
+}
+
 // A Repository stores and retrieves the persistent types in the authtoken
 // package. It is not safe to use a repository concurrently.
 type Repository struct {
@@ -287,8 +297,9 @@ func (r *Repository) ValidateToken(ctx context.Context, id, token string, opt ..
 	return retAT, nil
 }
 
-// ListAuthTokens lists auth tokens in the given scopes and supports the
-// WithLimit option.
+// ListAuthTokens lists auth tokens in the given scopes, ordered by public id,
+// and supports the WithLimit, WithStartPageAfterId and WithFilterConditions
+// options.
 func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string, opt ...Option) ([]*AuthToken, error) {
 	const op = "authtoken.(Repository).ListAuthTokens"
 	if len(withScopeIds) == 0 {
@@ -298,8 +309,20 @@ func (r *Repository) ListAuthTokens(ctx context.Context, withScopeIds []string,
 
 	// use the view, to bring in the required account columns. Just don't forget
 	// to convert them before returning them
+	where := "auth_account_id in (select public_id from auth_account where scope_id in (?))"
+	args := []interface{}{withScopeIds}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	if len(opts.withFilterConditions) > 0 {
+		filterWhere, filterArgs, err := filter.Where(opts.withFilterConditions, filterColumns)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		where, args = where+" and "+filterWhere, append(args, filterArgs...)
+	}
 	var atvs []*authTokenView
-	if err := r.reader.SearchWhere(ctx, &atvs, "auth_account_id in (select public_id from auth_account where scope_id in (?))", []interface{}{withScopeIds}, db.WithLimit(opts.withLimit)); err != nil {
+	if err := r.reader.SearchWhere(ctx, &atvs, where, args, db.WithLimit(opts.withLimit), db.WithOrder("public_id")); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	authTokens := make([]*AuthToken, 0, len(atvs))
@@ -357,6 +380,96 @@ func (r *Repository) DeleteAuthToken(ctx context.Context, id string, opt ...Opti
 	return rowsDeleted, nil
 }
 
+// DeleteAuthTokensForUser deletes the tokens of all the accounts of the user
+// from the repository, returning a count of the number of records deleted.
+// All options are ignored.
+func (r *Repository) DeleteAuthTokensForUser(ctx context.Context, userId string, _ ...Option) (int, error) {
+	const op = "authtoken.(Repository).DeleteAuthTokensForUser"
+	if userId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = DeleteAuthTokensForUserTx(ctx, w, userId)
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(userId))
+	}
+	return rowsDeleted, nil
+}
+
+// DeleteAuthTokensForAccount deletes the tokens of the account from the
+// repository, returning a count of the number of records deleted. All options
+// are ignored.
+func (r *Repository) DeleteAuthTokensForAccount(ctx context.Context, accountId string, _ ...Option) (int, error) {
+	const op = "authtoken.(Repository).DeleteAuthTokensForAccount"
+	if accountId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	var rowsDeleted int
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = DeleteAuthTokensForAccountTx(ctx, w, accountId)
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return rowsDeleted, nil
+}
+
+// DeleteAuthTokensForUserTx deletes the tokens of all the accounts of the user
+// using w, which is expected to be the writer of an ongoing transaction,
+// returning a count of the number of records deleted.
+func DeleteAuthTokensForUserTx(ctx context.Context, w db.Writer, userId string) (int, error) {
+	const op = "authtoken.DeleteAuthTokensForUserTx"
+	if userId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if w == nil {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+	const sql = `delete from auth_token where auth_account_id in (select public_id from auth_account where iam_user_id = ?)`
+	// tokens are not replicated, so they don't need oplog entries.
+	rowsDeleted, err := w.Exec(ctx, sql, []interface{}{userId})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(userId))
+	}
+	return rowsDeleted, nil
+}
+
+// DeleteAuthTokensForAccountTx deletes the tokens of the account using w,
+// which is expected to be the writer of an ongoing transaction, returning a
+// count of the number of records deleted.
+func DeleteAuthTokensForAccountTx(ctx context.Context, w db.Writer, accountId string) (int, error) {
+	const op = "authtoken.DeleteAuthTokensForAccountTx"
+	if accountId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if w == nil {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+	const sql = `delete from auth_token where auth_account_id = ?`
+	// tokens are not replicated, so they don't need oplog entries.
+	rowsDeleted, err := w.Exec(ctx, sql, []interface{}{accountId})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return rowsDeleted, nil
+}
+
 // IssueAuthToken will retrieve the "pending" token and update it's status to
 // "issued".  If the token has already been issued, an error is returned with a
 // nil token.  If no token is found for the tokenRequestId an error is returned
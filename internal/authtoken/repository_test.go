@@ -628,6 +628,68 @@ func TestRepository_DeleteAuthToken(t *testing.T) {
 	}
 }
 
+func TestRepository_DeleteAuthTokensForUser(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	at := TestAuthToken(t, conn, kms, org.GetPublicId())
+	other := TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = repo.DeleteAuthTokensForUser(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	got, err := repo.DeleteAuthTokensForUser(ctx, at.GetIamUserId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	found, err := repo.LookupAuthToken(ctx, at.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	found, err = repo.LookupAuthToken(ctx, other.GetPublicId())
+	require.NoError(t, err)
+	assert.NotNil(t, found)
+
+	got, err = repo.DeleteAuthTokensForUser(ctx, at.GetIamUserId())
+	require.NoError(t, err)
+	assert.Equal(t, 0, got)
+}
+
+func TestRepository_DeleteAuthTokensForAccount(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	at := TestAuthToken(t, conn, kms, org.GetPublicId())
+	other := TestAuthToken(t, conn, kms, org.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = repo.DeleteAuthTokensForAccount(ctx, "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "Unexpected error %s", err)
+
+	got, err := repo.DeleteAuthTokensForAccount(ctx, at.GetAuthAccountId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, got)
+
+	found, err := repo.LookupAuthToken(ctx, at.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	found, err = repo.LookupAuthToken(ctx, other.GetPublicId())
+	require.NoError(t, err)
+	assert.NotNil(t, found)
+}
+
 func TestRepository_ListAuthTokens(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -28,6 +28,8 @@ const (
 	EnabledPluginHostLoopback
 	EnabledPluginHostAws
 	EnabledPluginHostAzure
+	EnabledPluginHostInventory
+	EnabledPluginHostKubernetes
 )
 
 func (e EnabledPlugin) String() string {
@@ -38,6 +40,10 @@ func (e EnabledPlugin) String() string {
 		return "AWS"
 	case EnabledPluginHostAzure:
 		return "Azure"
+	case EnabledPluginHostInventory:
+		return "Inventory"
+	case EnabledPluginHostKubernetes:
+		return "Kubernetes"
 	default:
 		return ""
 	}
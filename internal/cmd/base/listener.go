@@ -1,8 +1,18 @@
 package base
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	mathrand "math/rand"
 	"net"
 	"net/http"
 	"strings"
@@ -12,12 +22,16 @@ import (
 	// certificates that use it can be parsed.
 	_ "crypto/sha512"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/reloadutil"
 	"github.com/mitchellh/cli"
 	"github.com/pires/go-proxyproto"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 )
 
 type ServerListener struct {
@@ -36,6 +50,166 @@ type WorkerAuthInfo struct {
 	ConnectionNonce string `json:"connection_nonce"`
 }
 
+// WorkerAuthProtoPrefix prefixes the ALPN protos in which a worker sends its
+// encrypted WorkerAuthInfo.
+const WorkerAuthProtoPrefix = "v1workerauth-"
+
+// WorkerRecordingProto is offered along with the worker auth protos by
+// controllers and workers fetching a recording from the proxy listener of a
+// worker, instead of relaying a connection through it.
+const WorkerRecordingProto = "v1workerrecording"
+
+// DecodeWorkerAuthInfo combines the WorkerAuthInfo sent by a worker across the
+// given ALPN protos and decrypts it with wrapper. It also returns the first
+// proto carrying the information, which is the one to negotiate.
+func DecodeWorkerAuthInfo(ctx context.Context, wrapper wrapping.Wrapper, protos []string) (*WorkerAuthInfo, string, error) {
+	var firstMatchProto string
+	var encString string
+	for _, p := range protos {
+		if strings.HasPrefix(p, WorkerAuthProtoPrefix) {
+			// Strip that and the number
+			encString += strings.TrimPrefix(p, WorkerAuthProtoPrefix)[3:]
+			if firstMatchProto == "" {
+				firstMatchProto = p
+			}
+		}
+	}
+	if firstMatchProto == "" {
+		return nil, "", errors.New("no matching proto found")
+	}
+	marshaledEncInfo, err := base64.RawStdEncoding.DecodeString(encString)
+	if err != nil {
+		return nil, "", err
+	}
+	encInfo := new(wrapping.EncryptedBlobInfo)
+	if err := proto.Unmarshal(marshaledEncInfo, encInfo); err != nil {
+		return nil, "", err
+	}
+	marshaledInfo, err := wrapper.Decrypt(ctx, encInfo, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	info := new(WorkerAuthInfo)
+	if err := json.Unmarshal(marshaledInfo, info); err != nil {
+		return nil, "", err
+	}
+	return info, firstMatchProto, nil
+}
+
+// ServerTLSConfig returns the TLS configuration accepting the connection of
+// the worker which sent info, negotiating proto.
+func (info *WorkerAuthInfo) ServerTLSConfig(proto string) (*tls.Config, error) {
+	rootCAs := x509.NewCertPool()
+	if ok := rootCAs.AppendCertsFromPEM(info.CertPEM); !ok {
+		return nil, errors.New("unable to add ca cert to cert pool")
+	}
+	tlsCert, err := tls.X509KeyPair(info.CertPEM, info.KeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{tlsCert},
+		ClientCAs:    rootCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		NextProtos:   []string{proto},
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// ClientTLSConfig generates a certificate and key for info and returns the TLS
+// configuration connecting with them to a controller or to the proxy listener
+// of a worker. The information is encrypted with wrapper and sent across the
+// ALPN protos of the configuration.
+func (info *WorkerAuthInfo) ClientTLSConfig(ctx context.Context, wrapper wrapping.Wrapper, randReader io.Reader) (*tls.Config, error) {
+	pubKey, privKey, err := ed25519.GenerateKey(randReader)
+	if err != nil {
+		return nil, err
+	}
+	host, err := base62.Random(20)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{
+			x509.ExtKeyUsageServerAuth,
+			x509.ExtKeyUsageClientAuth,
+		},
+		DNSNames:              []string{host},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement | x509.KeyUsageCertSign,
+		SerialNumber:          big.NewInt(mathrand.Int63()),
+		NotBefore:             time.Now().Add(-30 * time.Second),
+		NotAfter:              time.Now().Add(globals.WorkerAuthNonceValidityPeriod),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certBytes, err := x509.CreateCertificate(randReader, template, template, pubKey, privKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certPEMBlock := &pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certBytes,
+	}
+	info.CertPEM = pem.EncodeToMemory(certPEMBlock)
+
+	marshaledKey, err := x509.MarshalPKCS8PrivateKey(privKey)
+	if err != nil {
+		return nil, err
+	}
+	keyPEMBlock := &pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: marshaledKey,
+	}
+	info.KeyPEM = pem.EncodeToMemory(keyPEMBlock)
+
+	// Marshal and encrypt
+	marshaledInfo, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	encInfo, err := wrapper.Encrypt(ctx, marshaledInfo, nil)
+	if err != nil {
+		return nil, err
+	}
+	marshaledEncInfo, err := proto.Marshal(encInfo)
+	if err != nil {
+		return nil, err
+	}
+	b64alpn := base64.RawStdEncoding.EncodeToString(marshaledEncInfo)
+	var nextProtos []string
+	var count int
+	for i := 0; i < len(b64alpn); i += 230 {
+		end := i + 230
+		if end > len(b64alpn) {
+			end = len(b64alpn)
+		}
+		nextProtos = append(nextProtos, fmt.Sprintf("%s%02d-%s", WorkerAuthProtoPrefix, count, b64alpn[i:end]))
+		count++
+	}
+
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build local tls config
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(cert)
+	tlsCert, err := tls.X509KeyPair(info.CertPEM, info.KeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		ServerName:   host,
+		Certificates: []tls.Certificate{tlsCert},
+		RootCAs:      rootCAs,
+		NextProtos:   nextProtos,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
 // Factory is the factory function to create a listener.
 type ListenerFactory func(string, *listenerutil.ListenerConfig, cli.Ui) (string, net.Listener, error)
 
@@ -121,6 +295,49 @@ func NewListener(l *listenerutil.ListenerConfig, ui cli.Ui) (*alpnmux.ALPNMux, m
 	return alpnMux, props, reloadFunc, nil
 }
 
+// MetricsServer returns the HTTP server serving handler on the metrics
+// listener ln, along with the sub-listeners of ln it must serve.
+func MetricsServer(ln *ServerListener, handler http.Handler) (*http.Server, []net.Listener, error) {
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		IdleTimeout:       5 * time.Minute,
+	}
+	if ln.Config.HTTPReadHeaderTimeout > 0 {
+		server.ReadHeaderTimeout = ln.Config.HTTPReadHeaderTimeout
+	}
+	if ln.Config.HTTPReadTimeout > 0 {
+		server.ReadTimeout = ln.Config.HTTPReadTimeout
+	}
+	if ln.Config.HTTPWriteTimeout > 0 {
+		server.WriteTimeout = ln.Config.HTTPWriteTimeout
+	}
+	if ln.Config.HTTPIdleTimeout > 0 {
+		server.IdleTimeout = ln.Config.HTTPIdleTimeout
+	}
+
+	if ln.Config.TLSDisable {
+		l, err := ln.Mux.RegisterProto(alpnmux.NoProto, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting non-tls listener: %w", err)
+		}
+		if l == nil {
+			return nil, nil, errors.New("could not get non-tls listener")
+		}
+		return server, []net.Listener{l}, nil
+	}
+	var ls []net.Listener
+	for _, v := range []string{"", "http/1.1", "h2"} {
+		l := ln.Mux.GetListener(v)
+		if l == nil {
+			return nil, nil, fmt.Errorf("could not get tls proto %q listener", v)
+		}
+		ls = append(ls, l)
+	}
+	return server, ls, nil
+}
+
 func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.Ui) (string, net.Listener, error) {
 	if l.Address == "" {
 		switch purpose {
@@ -128,6 +345,8 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 			l.Address = "127.0.0.1:9201"
 		case "proxy":
 			l.Address = "127.0.0.1:9202"
+		case "metrics":
+			l.Address = "127.0.0.1:9203"
 		default:
 			l.Address = "127.0.0.1:9200"
 		}
@@ -141,6 +360,8 @@ func tcpListenerFactory(purpose string, l *listenerutil.ListenerConfig, ui cli.U
 				port = "9201"
 			case "proxy":
 				port = "9202"
+			case "metrics":
+				port = "9203"
 			default:
 				port = "9200"
 			}
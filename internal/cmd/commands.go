@@ -2,13 +2,16 @@ package cmd
 
 import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/commands/accessrequestscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/accountscmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/audit"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authenticate"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authmethodscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authtokenscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/config"
 	"github.com/hashicorp/boundary/internal/cmd/commands/connect"
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentiallibrariescmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/credentialscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/credentialstorescmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/database"
 	"github.com/hashicorp/boundary/internal/cmd/commands/dev"
@@ -69,6 +72,53 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui),
 			}, nil
 		},
+		"authenticate ldap": func() (cli.Command, error) {
+			return &authenticate.LdapCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+
+		"access-requests": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"access-requests create": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"access-requests read": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"access-requests list": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"access-requests approve": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "approve",
+			}, nil
+		},
+		"access-requests deny": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "deny",
+			}, nil
+		},
+		"access-requests cancel": func() (cli.Command, error) {
+			return &accessrequestscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "cancel",
+			}, nil
+		},
 
 		"accounts": func() (cli.Command, error) {
 			return &accountscmd.Command{
@@ -105,6 +155,36 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-password",
 			}, nil
 		},
+		"accounts enroll-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "enroll-totp",
+			}, nil
+		},
+		"accounts confirm-totp": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "confirm-totp",
+			}, nil
+		},
+		"accounts remove-mfa": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "remove-mfa",
+			}, nil
+		},
+		"accounts generate-recovery-codes": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "generate-recovery-codes",
+			}, nil
+		},
+		"accounts unlock": func() (cli.Command, error) {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "unlock",
+			}, nil
+		},
 		"accounts create": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -123,6 +203,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"accounts create ldap": func() (cli.Command, error) {
+			return &accountscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"accounts update": func() (cli.Command, error) {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui),
@@ -141,6 +227,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"accounts update ldap": func() (cli.Command, error) {
+			return &accountscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
@@ -183,6 +275,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"auth-methods create ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"auth-methods update": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
 				Command: base.NewCommand(ui),
@@ -201,6 +299,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"auth-methods update ldap": func() (cli.Command, error) {
+			return &authmethodscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"auth-methods change-state oidc": func() (cli.Command, error) {
 			return &authmethodscmd.OidcCommand{
 				Command: base.NewCommand(ui),
@@ -311,6 +415,17 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}, nil
 		},
 
+		"audit": func() (cli.Command, error) {
+			return &audit.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"audit verify": func() (cli.Command, error) {
+			return &audit.VerifyCommand{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+
 		"database": func() (cli.Command, error) {
 			return &database.Command{
 				Command: base.NewCommand(ui),
@@ -422,6 +537,90 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"credential-stores create static": func() (cli.Command, error) {
+			return &credentialstorescmd.StaticCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credential-stores update static": func() (cli.Command, error) {
+			return &credentialstorescmd.StaticCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+
+		"credentials": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+			}, nil
+		},
+		"credentials read": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "read",
+			}, nil
+		},
+		"credentials delete": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "delete",
+			}, nil
+		},
+		"credentials list": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "list",
+			}, nil
+		},
+		"credentials create": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials create user-password": func() (cli.Command, error) {
+			return &credentialscmd.UserPasswordCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials create ssh-private-key": func() (cli.Command, error) {
+			return &credentialscmd.SshPrivateKeyCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials create json": func() (cli.Command, error) {
+			return &credentialscmd.JsonCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
+		"credentials update": func() (cli.Command, error) {
+			return &credentialscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials update user-password": func() (cli.Command, error) {
+			return &credentialscmd.UserPasswordCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials update ssh-private-key": func() (cli.Command, error) {
+			return &credentialscmd.SshPrivateKeyCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
+		"credentials update json": func() (cli.Command, error) {
+			return &credentialscmd.JsonCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"groups": func() (cli.Command, error) {
 			return &groupscmd.Command{
@@ -704,6 +903,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"managed-groups create ldap": func() (cli.Command, error) {
+			return &managedgroupscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"managed-groups update": func() (cli.Command, error) {
 			return &managedgroupscmd.Command{
 				Command: base.NewCommand(ui),
@@ -716,6 +921,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"managed-groups update ldap": func() (cli.Command, error) {
+			return &managedgroupscmd.LdapCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 
 		"roles": func() (cli.Command, error) {
 			return &rolescmd.Command{
@@ -824,6 +1035,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "list",
 			}, nil
 		},
+		"scopes check-permissions": func() (cli.Command, error) {
+			return &scopescmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "check-permissions",
+			}, nil
+		},
 
 		"sessions": func() (cli.Command, error) {
 			return &sessionscmd.Command{
@@ -848,6 +1065,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}, nil
 		},
+		"sessions download-recording": func() (cli.Command, error) {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui),
+				Func:    "download-recording",
+			}, nil
+		},
 
 		"targets": func() (cli.Command, error) {
 			return &targetscmd.Command{
@@ -890,6 +1113,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}, nil
 		},
+		"targets create ssh": func() (cli.Command, error) {
+			return &targetscmd.SshCommand{
+				Command: base.NewCommand(ui),
+				Func:    "create",
+			}, nil
+		},
 		"targets update": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
@@ -902,6 +1131,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}, nil
 		},
+		"targets update ssh": func() (cli.Command, error) {
+			return &targetscmd.SshCommand{
+				Command: base.NewCommand(ui),
+				Func:    "update",
+			}, nil
+		},
 		"targets add-host-sets": func() (cli.Command, error) {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui),
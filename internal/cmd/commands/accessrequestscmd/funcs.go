@@ -0,0 +1,324 @@
+package accessrequestscmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/accessrequests"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/go-wordwrap"
+)
+
+func init() {
+	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+}
+
+type extraCmdVars struct {
+	flagTargetId      string
+	flagJustification string
+	flagDuration      time.Duration
+	flagComment       string
+}
+
+func extraActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create":  {"target-id", "justification", "duration"},
+		"approve": {"id", "version", "comment"},
+		"deny":    {"id", "version", "comment"},
+		"cancel":  {"id", "version"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "approve":
+		return wordwrap.WrapString("Approve a pending access request", base.TermWidth)
+	case "deny":
+		return wordwrap.WrapString("Deny a pending access request", base.TermWidth)
+	case "cancel":
+		return wordwrap.WrapString("Cancel a pending access request", base.TermWidth)
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "":
+		return base.WrapForHelpText([]string{
+			"Usage: boundary access-requests [sub command] [options] [args]",
+			"",
+			"  This command allows operations on Boundary access requests, which grant a user temporary access to a target once approved. Example:",
+			"",
+			"    Request access to a target:",
+			"",
+			`      $ boundary access-requests create -scope-id p_1234567890 -target-id ttcp_1234567890 -justification "incident 42" -duration 1h`,
+			"",
+			"  Please see the access-requests subcommand help for detailed usage information.",
+		})
+
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests create [options] [args]",
+			"",
+			"  Request access to a target for a duration. Once approved, the requesting user is granted access to the target until the duration elapses. Example:",
+			"",
+			`    $ boundary access-requests create -scope-id p_1234567890 -target-id ttcp_1234567890 -justification "incident 42" -duration 1h`,
+			"",
+			"",
+		})
+
+	case "approve":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests approve [options] [args]",
+			"",
+			"  Approve the pending access request specified by ID. Users cannot approve their own access requests. Example:",
+			"",
+			`    $ boundary access-requests approve -id areq_1234567890 -comment "approved for incident 42"`,
+			"",
+			"",
+		})
+
+	case "deny":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests deny [options] [args]",
+			"",
+			"  Deny the pending access request specified by ID. Users cannot deny their own access requests. Example:",
+			"",
+			`    $ boundary access-requests deny -id areq_1234567890 -comment "use the staging target"`,
+			"",
+			"",
+		})
+
+	case "cancel":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary access-requests cancel [options] [args]",
+			"",
+			"  Cancel the pending access request specified by ID. Example:",
+			"",
+			`    $ boundary access-requests cancel -id areq_1234567890`,
+			"",
+			"",
+		})
+
+	case "read", "list":
+		helpStr = helpMap[c.Func]()
+
+	default:
+		helpStr = helpMap["base"]()
+	}
+
+	return helpStr + c.Flags().Help()
+}
+
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "target-id":
+			f.StringVar(&base.StringVar{
+				Name:   "target-id",
+				Target: &c.flagTargetId,
+				Usage:  "The ID of the target to request access to.",
+			})
+		case "justification":
+			f.StringVar(&base.StringVar{
+				Name:   "justification",
+				Target: &c.flagJustification,
+				Usage:  "The reason access is requested.",
+			})
+		case "duration":
+			f.DurationVar(&base.DurationVar{
+				Name:   "duration",
+				Target: &c.flagDuration,
+				Usage:  "How long access is granted for once the request is approved, e.g. 30m or 1h.",
+			})
+		case "comment":
+			f.StringVar(&base.StringVar{
+				Name:   "comment",
+				Target: &c.flagComment,
+				Usage:  "An optional comment explaining the review.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accessrequests.Option) bool {
+	switch c.Func {
+	case "create":
+		if c.flagTargetId == "" {
+			c.UI.Error("Target ID must be passed in via -target-id")
+			return false
+		}
+		if c.flagJustification == "" {
+			c.UI.Error("Justification must be passed in via -justification")
+			return false
+		}
+		if c.flagDuration < time.Second {
+			c.UI.Error("A duration of at least one second must be passed in via -duration")
+			return false
+		}
+		*opts = append(*opts,
+			accessrequests.WithTargetId(c.flagTargetId),
+			accessrequests.WithJustification(c.flagJustification),
+			accessrequests.WithDurationSeconds(uint32(c.flagDuration/time.Second)),
+		)
+	}
+
+	return true
+}
+
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, accessRequestClient *accessrequests.Client, version uint32, opts []accessrequests.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "approve":
+		return accessRequestClient.Approve(c.Context, c.FlagId, version, c.flagComment, opts...)
+	case "deny":
+		return accessRequestClient.Deny(c.Context, c.FlagId, version, c.flagComment, opts...)
+	case "cancel":
+		return accessRequestClient.Cancel(c.Context, c.FlagId, version, opts...)
+	}
+	return origResult, origError
+}
+
+func (c *Command) printListTable(items []*accessrequests.AccessRequest) string {
+	if len(items) == 0 {
+		return "No access requests found"
+	}
+	var output []string
+	output = []string{
+		"",
+		"Access Request information:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		if item.Id != "" {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", item.Id),
+			)
+		} else {
+			output = append(output,
+				fmt.Sprintf("  ID:                    %s", "(not available)"),
+			)
+		}
+		if c.FlagRecursive && item.ScopeId != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:            %s", item.ScopeId),
+			)
+		}
+		if item.Status != "" {
+			output = append(output,
+				fmt.Sprintf("    Status:              %s", item.Status),
+			)
+		}
+		if item.UserId != "" {
+			output = append(output,
+				fmt.Sprintf("    User ID:             %s", item.UserId),
+			)
+		}
+		if item.TargetId != "" {
+			output = append(output,
+				fmt.Sprintf("    Target ID:           %s", item.TargetId),
+			)
+		}
+		if item.Justification != "" {
+			output = append(output,
+				fmt.Sprintf("    Justification:       %s", item.Justification),
+			)
+		}
+		if !item.CreatedTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Created Time:        %s", item.CreatedTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if !item.ExpirationTime.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Expiration Time:     %s", item.ExpirationTime.Local().Format(time.RFC1123)),
+			)
+		}
+		if len(item.AuthorizedActions) > 0 {
+			output = append(output,
+				"    Authorized Actions:",
+				base.WrapSlice(6, item.AuthorizedActions),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
+
+func printItemTable(result api.GenericResult) string {
+	item := result.GetItem().(*accessrequests.AccessRequest)
+	nonAttributeMap := map[string]interface{}{}
+	if item.Id != "" {
+		nonAttributeMap["ID"] = item.Id
+	}
+	if item.Version != 0 {
+		nonAttributeMap["Version"] = item.Version
+	}
+	if item.Status != "" {
+		nonAttributeMap["Status"] = item.Status
+	}
+	if item.UserId != "" {
+		nonAttributeMap["User ID"] = item.UserId
+	}
+	if item.TargetId != "" {
+		nonAttributeMap["Target ID"] = item.TargetId
+	}
+	if item.Justification != "" {
+		nonAttributeMap["Justification"] = item.Justification
+	}
+	if item.DurationSeconds != 0 {
+		nonAttributeMap["Duration Seconds"] = item.DurationSeconds
+	}
+	if item.ReviewerId != "" {
+		nonAttributeMap["Reviewer ID"] = item.ReviewerId
+	}
+	if item.ReviewComment != "" {
+		nonAttributeMap["Review Comment"] = item.ReviewComment
+	}
+	if item.RoleId != "" {
+		nonAttributeMap["Role ID"] = item.RoleId
+	}
+	if !item.CreatedTime.IsZero() {
+		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.UpdatedTime.IsZero() {
+		nonAttributeMap["Updated Time"] = item.UpdatedTime.Local().Format(time.RFC1123)
+	}
+	if !item.ExpirationTime.IsZero() {
+		nonAttributeMap["Expiration Time"] = item.ExpirationTime.Local().Format(time.RFC1123)
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Access Request information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if item.Scope != nil {
+		ret = append(ret,
+			"",
+			"  Scope:",
+			base.ScopeInfoForOutput(item.Scope, maxLength),
+		)
+	}
+
+	if len(item.AuthorizedActions) > 0 {
+		ret = append(ret,
+			"",
+			"  Authorized Actions:",
+			base.WrapSlice(4, item.AuthorizedActions),
+		)
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -3,6 +3,7 @@ package accountscmd
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,18 +20,31 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	flagCode            string
+	flagMfaType         string
+	flagCredentialId    string
+	totpResult          *accounts.EnrollTotpResult
+	mfaResult           *accounts.MfaResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+
+		"enroll-totp":             {"id"},
+		"confirm-totp":            {"id", "code"},
+		"remove-mfa":              {"id", "type", "credential-id"},
+		"generate-recovery-codes": {"id"},
+
+		"unlock": {"id"},
 	}
 }
 
@@ -42,6 +56,21 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "enroll-totp":
+		return "Start the enrollment of a TOTP authenticator for an account"
+
+	case "confirm-totp":
+		return "Confirm the TOTP authenticator of an account"
+
+	case "remove-mfa":
+		return "Remove a second factor from an account"
+
+	case "generate-recovery-codes":
+		return "Replace the recovery codes of an account"
+
+	case "unlock":
+		return "End the lockout of an account"
+
 	default:
 		return ""
 	}
@@ -86,6 +115,70 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command starts the enrollment of a TOTP authenticator for a password-type account. The returned secret or URL is added to the authenticator application, and the enrollment is completed with the confirm-totp command. Example:",
+			"",
+			"    Enroll a TOTP authenticator for a password-type account:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "confirm-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts confirm-totp [options] [args]",
+			"",
+			"  This command confirms the TOTP authenticator enrolled for a password-type account with a code it generated. If the account had no second factor before, its recovery codes are returned; they are only shown once. Example:",
+			"",
+			"    Confirm the TOTP authenticator of a password-type account:",
+			"",
+			`      $ boundary accounts confirm-totp -id acctpw_1234567890 -code 123456`,
+			"",
+			"",
+		})
+	case "remove-mfa":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts remove-mfa [options] [args]",
+			"",
+			"  This command removes a second factor from a password-type account. When the last second factor is removed, the recovery codes of the account are removed as well. Examples:",
+			"",
+			"    Remove the TOTP authenticator of a password-type account:",
+			"",
+			`      $ boundary accounts remove-mfa -id acctpw_1234567890 -type totp`,
+			"",
+			"    Remove a WebAuthn credential of a password-type account:",
+			"",
+			`      $ boundary accounts remove-mfa -id acctpw_1234567890 -type webauthn -credential-id AbCd...`,
+			"",
+			"",
+		})
+	case "generate-recovery-codes":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts generate-recovery-codes [options] [args]",
+			"",
+			"  This command replaces the recovery codes of a password-type account which has a second factor. The previous codes can no longer be used. Example:",
+			"",
+			"    Generate new recovery codes for a password-type account:",
+			"",
+			`      $ boundary accounts generate-recovery-codes -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "unlock":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts unlock [options] [args]",
+			"",
+			"  This command ends the lockout of a password-type account which was locked out after too many failed authentication attempts, and resets its failed attempts. Example:",
+			"",
+			"    Unlock a password-type account:",
+			"",
+			`      $ boundary accounts unlock -id acctpw_1234567890`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -111,6 +204,24 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagNewPassword,
 				Usage:  "The new password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case "code":
+			f.StringVar(&base.StringVar{
+				Name:   "code",
+				Target: &c.flagCode,
+				Usage:  "The code generated by the TOTP authenticator. If not specified, the command will prompt for the code.",
+			})
+		case "type":
+			f.StringVar(&base.StringVar{
+				Name:   "type",
+				Target: &c.flagMfaType,
+				Usage:  `The type of the second factor to remove, either "totp" or "webauthn".`,
+			})
+		case "credential-id":
+			f.StringVar(&base.StringVar{
+				Name:   "credential-id",
+				Target: &c.flagCredentialId,
+				Usage:  "The ID of the WebAuthn credential to remove, as shown in the attributes of the account.",
+			})
 		}
 	}
 }
@@ -171,6 +282,35 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]accounts.O
 		c.flagNewPassword = strings.TrimSpace(value)
 	}
 
+	if strutil.StrListContains(flagsMap[c.Func], "code") && c.flagCode == "" {
+		fmt.Print("Code is not set as flag, please enter it now (will be hidden): ")
+		value, err := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+			return false
+		}
+		c.flagCode = strings.TrimSpace(value)
+	}
+
+	if c.Func == "remove-mfa" {
+		switch c.flagMfaType {
+		case accounts.MfaTypeTotp:
+			if c.flagCredentialId != "" {
+				c.UI.Error("-credential-id cannot be set when removing a TOTP authenticator.")
+				return false
+			}
+		case accounts.MfaTypeWebAuthn:
+			if c.flagCredentialId == "" {
+				c.UI.Error("-credential-id must be set when removing a WebAuthn credential.")
+				return false
+			}
+		default:
+			c.UI.Error(`-type must be "totp" or "webauthn".`)
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -180,10 +320,79 @@ func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError
 		return accountClient.SetPassword(c.Context, c.FlagId, c.flagPassword, version, opts...)
 	case "change-password":
 		return accountClient.ChangePassword(c.Context, c.FlagId, c.flagCurrentPassword, c.flagNewPassword, version, opts...)
+	case "enroll-totp":
+		var err error
+		c.totpResult, err = accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		return c.totpResult, err
+	case "confirm-totp":
+		var err error
+		c.mfaResult, err = accountClient.ConfirmTotp(c.Context, c.FlagId, c.flagCode, opts...)
+		return c.mfaResult, err
+	case "remove-mfa":
+		var err error
+		c.mfaResult, err = accountClient.RemoveMfa(c.Context, c.FlagId, c.flagMfaType, c.flagCredentialId, opts...)
+		return c.mfaResult, err
+	case "generate-recovery-codes":
+		var err error
+		c.mfaResult, err = accountClient.GenerateRecoveryCodes(c.Context, c.FlagId, opts...)
+		return c.mfaResult, err
+	case "unlock":
+		return accountClient.Unlock(c.Context, c.FlagId, opts...)
 	}
 	return origResult, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printItemTable(c.totpResult))
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"TOTP enrollment:",
+				base.WrapMap(2, 6, map[string]interface{}{
+					"Secret": c.totpResult.Secret,
+					"URL":    c.totpResult.Url,
+				}),
+				"",
+				"Add the secret or URL to your authenticator application, then run \"boundary accounts confirm-totp\" with a code it generated.",
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.totpResult); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "confirm-totp", "remove-mfa", "generate-recovery-codes":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printItemTable(c.mfaResult))
+			if len(c.mfaResult.RecoveryCodes) > 0 {
+				c.UI.Output(base.WrapForHelpText([]string{
+					"",
+					"Recovery codes:",
+					base.WrapSlice(2, c.mfaResult.RecoveryCodes),
+					"",
+					"Store the recovery codes in a safe place; they will not be shown again. Each code can be used once instead of a second factor.",
+				}))
+			}
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.mfaResult); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
@@ -226,6 +435,11 @@ func (c *Command) printListTable(items []*accounts.Account) string {
 				fmt.Sprintf("    Description:         %s", item.Description),
 			)
 		}
+		if item.Disabled {
+			output = append(output,
+				fmt.Sprintf("    Disabled:            %t", item.Disabled),
+			)
+		}
 		if len(item.AuthorizedActions) > 0 {
 			output = append(output,
 				"    Authorized Actions:",
@@ -264,6 +478,9 @@ func printItemTable(result api.GenericResult) string {
 	if item.Description != "" {
 		nonAttributeMap["Description"] = item.Description
 	}
+	if item.Disabled {
+		nonAttributeMap["Disabled"] = item.Disabled
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
 
@@ -308,6 +525,38 @@ func printItemTable(result api.GenericResult) string {
 	return base.WrapForHelpText(ret)
 }
 
+// disabledFlagName is the name of the flag setting whether an account is
+// disabled, which is available when updating accounts of any type.
+const disabledFlagName = "disabled"
+
+func addDisabledFlag(f *base.FlagSet, target *string) {
+	f.StringVar(&base.StringVar{
+		Name:   disabledFlagName,
+		Target: target,
+		Usage:  "If true, the account cannot be used to authenticate, and the sessions and auth tokens of its user are revoked.",
+	})
+}
+
+func handleDisabledFlag(c *base.Command, flagDisabled string, opts *[]accounts.Option) bool {
+	switch flagDisabled {
+	case "":
+	case "null":
+		*opts = append(*opts, accounts.DefaultDisabled())
+	default:
+		disabled, err := strconv.ParseBool(flagDisabled)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q for -disabled: %s", flagDisabled, err))
+			return false
+		}
+		*opts = append(*opts, accounts.WithDisabled(disabled))
+	}
+	return true
+}
+
 var keySubstMap = map[string]string{
-	"login_name": "Login Name",
+	"login_name":               "Login Name",
+	"totp_enabled":             "TOTP Enabled",
+	"webauthn_credentials":     "WebAuthn Credentials",
+	"recovery_codes_remaining": "Recovery Codes Remaining",
+	"locked_until":             "Locked Until",
 }
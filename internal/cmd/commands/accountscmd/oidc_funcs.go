@@ -19,12 +19,14 @@ func init() {
 func extraOidcActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {subjectFlagName, issuerFlagName},
+		"update": {disabledFlagName},
 	}
 }
 
 type extraOidcCmdVars struct {
-	flagIssuer  string
-	flagSubject string
+	flagIssuer   string
+	flagSubject  string
+	flagDisabled string
 }
 
 func (c *OidcCommand) extraOidcHelpFunc(helpMap map[string]func() string) string {
@@ -72,6 +74,8 @@ func extraOidcFlagsFuncImpl(c *OidcCommand, set *base.FlagSets, f *base.FlagSet)
 				Target: &c.flagSubject,
 				Usage:  "The subject for this account on the OIDC provider.",
 			})
+		case disabledFlagName:
+			addDisabledFlag(f, &c.flagDisabled)
 		}
 	}
 }
@@ -101,5 +105,5 @@ func extraOidcFlagsHandlingFuncImpl(c *OidcCommand, _ *base.FlagSets, opts *[]ac
 		}
 		*opts = append(*opts, accounts.WithOidcAccountIssuer(c.flagIssuer))
 	}
-	return true
+	return handleDisabledFlag(c.Command, c.flagDisabled, opts)
 }
@@ -20,13 +20,14 @@ func init() {
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {"login-name", "password"},
-		"update": {"login-name"},
+		"update": {"login-name", disabledFlagName},
 	}
 }
 
 type extraPasswordCmdVars struct {
 	flagLoginName string
 	flagPassword  string
+	flagDisabled  string
 }
 
 func (c *PasswordCommand) extraPasswordHelpFunc(helpMap map[string]func() string) string {
@@ -74,6 +75,8 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagPassword,
 				Usage:  "The password for the account. If not specified, the command will prompt for the password to be entered in a non-echoing way.",
 			})
+		case disabledFlagName:
+			addDisabledFlag(f, &c.flagDisabled)
 		}
 	}
 }
@@ -92,6 +95,10 @@ func extraPasswordFlagsHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, op
 		*opts = append(*opts, accounts.WithPasswordAccountLoginName(c.flagLoginName))
 	}
 
+	if !handleDisabledFlag(c.Command, c.flagDisabled, opts) {
+		return false
+	}
+
 	if strutil.StrListContains(flagsPasswordMap[c.Func], "password") {
 		switch c.flagPassword {
 		case "":
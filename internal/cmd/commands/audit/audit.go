@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*Command)(nil)
+	_ cli.CommandAutocomplete = (*Command)(nil)
+)
+
+type Command struct {
+	*base.Command
+}
+
+func (c *Command) Synopsis() string {
+	return "Manage Boundary's audit events"
+}
+
+func (c *Command) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary audit [sub command] [options] [args]",
+		"",
+		"  This command allows operations on Boundary's audit events. Example:",
+		"",
+		"    Verify the integrity of the audit events written by a file sink:",
+		"",
+		`      $ boundary audit verify -config=/etc/boundary/controller.hcl -sink=audit`,
+		"",
+		"  Please see the audit subcommand help for detailed usage information.",
+	})
+}
+
+func (c *Command) Flags() *base.FlagSets {
+	return nil
+}
+
+func (c *Command) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *Command) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *Command) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,345 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/wrapper"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*VerifyCommand)(nil)
+	_ cli.CommandAutocomplete = (*VerifyCommand)(nil)
+)
+
+type VerifyCommand struct {
+	*base.Command
+	srv *base.Server
+
+	Config *config.Config
+
+	configWrapper wrapping.Wrapper
+
+	flagConfig    string
+	flagConfigKms string
+	flagSink      string
+	flagPath      string
+	flagFileName  string
+}
+
+func (c *VerifyCommand) Synopsis() string {
+	return "Verify the integrity of the audit events written by a file sink."
+}
+
+func (c *VerifyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary audit verify [options]",
+		"",
+		"  Verify the chain of the audit events written by a file sink with audit",
+		"  integrity enabled, including its rotated files, and report missing or",
+		"  modified events, as well as audit events which are not chained. The audit",
+		"  keys are read from the database using the root KMS of the controller",
+		"  configuration file:",
+		"",
+		"    $ boundary audit verify -config=/etc/boundary/controller.hcl -sink=audit",
+		"",
+		"  The files of a sink can also be given explicitly:",
+		"",
+		"    $ boundary audit verify -config=/etc/boundary/controller.hcl -path=/var/log/boundary -file-name=audit.log",
+		"",
+		"  As the last event of the chain isn't covered by a later event, events",
+		"  removed from the end of the chain, e.g. by truncating the last file, are",
+		"  not detected. Ship the audit events to a separate system to detect such",
+		"  a truncation.",
+		"",
+		"  For a full list of examples, please see the documentation.",
+	}) + c.Flags().Help()
+}
+
+func (c *VerifyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file of the controller which wrote the audit events.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file. If not set, will look for such a block in the main configuration file, which has some drawbacks; see the help output for "boundary config encrypt -h" for details.`,
+	})
+
+	f = set.NewFlagSet("Sink options")
+
+	f.StringVar(&base.StringVar{
+		Name:   "sink",
+		Target: &c.flagSink,
+		Usage:  "The name of the file sink of the configuration file whose audit events are verified. If not set and neither are -path and -file-name, the only file sink with audit integrity enabled is used.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "path",
+		Target:     &c.flagPath,
+		Completion: complete.PredictDirs("*"),
+		Usage:      "The path of the file sink whose audit events are verified, instead of the one of a sink of the configuration file.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "file-name",
+		Target:     &c.flagFileName,
+		Completion: complete.PredictFiles("*"),
+		Usage:      "The file name of the file sink whose audit events are verified, instead of the one of a sink of the configuration file.",
+	})
+
+	return set
+}
+
+func (c *VerifyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictNothing
+}
+
+func (c *VerifyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *VerifyCommand) Run(args []string) (retCode int) {
+	if result := c.ParseFlagsAndConfig(args); result > 0 {
+		return result
+	}
+
+	if c.configWrapper != nil {
+		defer func() {
+			if err := c.configWrapper.Finalize(c.Context); err != nil {
+				c.UI.Warn(fmt.Errorf("Error finalizing config kms: %w", err).Error())
+			}
+		}()
+	}
+
+	path, fileName, err := c.sinkFile()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+	files, err := event.AuditFiles(path, fileName)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error listing audit files: %w", err).Error())
+		return base.CommandUserError
+	}
+	if len(files) == 0 {
+		c.UI.Error(fmt.Sprintf("No audit files found for %q in %q", fileName, path))
+		return base.CommandUserError
+	}
+
+	c.srv = base.NewServer(&base.Command{UI: c.UI})
+	if err := c.srv.SetupLogging("", "", c.Config.LogLevel, c.Config.LogFormat); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+	if err := c.srv.SetupKMSes(c.UI, c.Config); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+	defer func() {
+		if err := c.srv.RunShutdownFuncs(); err != nil {
+			c.UI.Error(fmt.Errorf("Error running shutdown tasks: %w", err).Error())
+		}
+	}()
+	if c.srv.RootKms == nil {
+		c.UI.Error(`Root KMS not found in configuration file`)
+		return base.CommandUserError
+	}
+
+	if c.Config.Controller == nil || c.Config.Controller.Database == nil || c.Config.Controller.Database.Url == "" {
+		c.UI.Error(`"url" not specified in "controller.database" config block`)
+		return base.CommandUserError
+	}
+	c.srv.DatabaseUrl, err = parseutil.ParsePath(c.Config.Controller.Database.Url)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.UI.Error(fmt.Errorf("Error parsing database url: %w", err).Error())
+		return base.CommandUserError
+	}
+	if err := c.srv.ConnectToDatabase(c.Context, "postgres"); err != nil {
+		c.UI.Error(fmt.Errorf("Error connecting to database: %w", err).Error())
+		return base.CommandCliError
+	}
+	keyFn, err := c.auditKeyFunc()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	v, err := event.NewAuditChainVerifier(keyFn)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error creating audit verifier: %w", err).Error())
+		return base.CommandCliError
+	}
+	var issues []*event.AuditChainIssue
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error opening audit file: %w", err).Error())
+			return base.CommandCliError
+		}
+		fileIssues, err := v.Verify(c.Context, name, f)
+		f.Close()
+		issues = append(issues, fileIssues...)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error verifying audit file %s: %w", name, err).Error())
+			return base.CommandCliError
+		}
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if issues == nil {
+			issues = []*event.AuditChainIssue{}
+		}
+		b, err := json.Marshal(map[string]interface{}{
+			"files":   files,
+			"records": v.Records,
+			"issues":  issues,
+		})
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+	default:
+		for _, i := range issues {
+			c.UI.Warn(i.String())
+		}
+		c.UI.Output(fmt.Sprintf("Verified %d audit events in %d files, found %d issues.", v.Records, len(files), len(issues)))
+	}
+	if len(issues) > 0 {
+		return base.CommandApiError
+	}
+	return base.CommandSuccess
+}
+
+// sinkFile returns the path and file name of the sink to verify.
+func (c *VerifyCommand) sinkFile() (string, string, error) {
+	switch {
+	case c.flagFileName != "":
+		if c.flagSink != "" {
+			return "", "", fmt.Errorf("-sink cannot be used with -path and -file-name")
+		}
+		return c.flagPath, c.flagFileName, nil
+	case c.flagPath != "":
+		return "", "", fmt.Errorf("-file-name must be set when -path is set")
+	}
+
+	var found *event.SinkConfig
+	if c.Config.Eventing != nil {
+		for _, s := range c.Config.Eventing.Sinks {
+			if s.Type != event.FileSink || s.FileConfig == nil {
+				continue
+			}
+			switch {
+			case c.flagSink != "":
+				if s.Name != c.flagSink {
+					continue
+				}
+			case s.AuditConfig == nil || !s.AuditConfig.IntegrityEnabled:
+				continue
+			case found != nil:
+				return "", "", fmt.Errorf("Several file sinks have audit integrity enabled, use -sink to select one")
+			}
+			found = s
+		}
+	}
+	switch {
+	case found != nil:
+		return found.FileConfig.Path, found.FileConfig.FileName, nil
+	case c.flagSink != "":
+		return "", "", fmt.Errorf("File sink %q not found in configuration file", c.flagSink)
+	default:
+		return "", "", fmt.Errorf("No file sink with audit integrity enabled found in configuration file")
+	}
+}
+
+// auditKeyFunc returns a function reading the audit keys from the database.
+func (c *VerifyCommand) auditKeyFunc() (event.AuditKeyFunc, error) {
+	rw := db.New(c.srv.Database)
+	kmsRepo, err := kms.NewRepository(rw, rw)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating kms repository: %w", err)
+	}
+	kmsCache, err := kms.NewKms(kmsRepo)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating kms cache: %w", err)
+	}
+	if err := kmsCache.AddExternalWrappers(kms.WithRootWrapper(c.srv.RootKms)); err != nil {
+		return nil, fmt.Errorf("Error adding config keys to kms: %w", err)
+	}
+	return func(ctx context.Context, keyId string) (wrapping.Wrapper, error) {
+		return kmsCache.GetWrapper(ctx, scope.Global.String(), kms.KeyPurposeAudit, kms.WithKeyId(keyId))
+	}, nil
+}
+
+func (c *VerifyCommand) ParseFlagsAndConfig(args []string) int {
+	var err error
+
+	f := c.Flags()
+
+	if err = f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	// Validation
+	switch {
+	case len(c.flagConfig) == 0:
+		c.UI.Error("Must specify a config file using -config")
+		return base.CommandUserError
+	}
+
+	wrapperPath := c.flagConfig
+	if c.flagConfigKms != "" {
+		wrapperPath = c.flagConfigKms
+	}
+	wrapper, err := wrapper.GetWrapperFromPath(wrapperPath, "config")
+	if err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+	if wrapper != nil {
+		c.configWrapper = wrapper
+		if err := wrapper.Init(c.Context); err != nil {
+			c.UI.Error(fmt.Errorf("Could not initialize kms: %w", err).Error())
+			return base.CommandUserError
+		}
+	}
+
+	c.Config, err = config.LoadFile(c.flagConfig, wrapper)
+	if err != nil {
+		c.UI.Error("Error parsing config: " + err.Error())
+		return base.CommandUserError
+	}
+
+	return base.CommandSuccess
+}
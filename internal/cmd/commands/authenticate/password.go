@@ -1,6 +1,7 @@
 package authenticate
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/api/authmethods"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/password"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"github.com/mitchellh/cli"
 	"github.com/mitchellh/go-wordwrap"
 	"github.com/posener/complete"
@@ -23,13 +25,19 @@ var (
 var (
 	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
 	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envMfaCode   = "BOUNDARY_AUTHENTICATE_PASSWORD_MFA_CODE"
 )
 
+// totpCodeLength is the length of the codes of TOTP authenticators; longer
+// second factor codes are recovery codes.
+const totpCodeLength = 6
+
 type PasswordCommand struct {
 	*base.Command
 
 	flagLoginName string
 	flagPassword  string
+	flagMfaCode   string
 }
 
 func (c *PasswordCommand) Synopsis() string {
@@ -44,6 +52,8 @@ func (c *PasswordCommand) Help() string {
 		"",
 		`    $ boundary authenticate password -auth-method-id ampw_1234567890 -login-name foo -password "bar"`,
 		"",
+		"  If the account has a second factor, the command prompts for a code of its TOTP authenticator or one of its recovery codes, unless -mfa-code is set. WebAuthn credentials cannot be used from the CLI.",
+		"",
 		"",
 	}) + c.Flags().Help()
 }
@@ -66,6 +76,13 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "mfa-code",
+		Target: &c.flagMfaCode,
+		EnvVar: envMfaCode,
+		Usage:  "A code of the TOTP authenticator of the account, or one of its recovery codes, if the account has a second factor",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -133,11 +150,18 @@ func (c *PasswordCommand) Run(args []string) int {
 		return base.CommandCliError
 	}
 
-	result, err := authmethods.NewClient(client).Authenticate(c.Context, c.FlagAuthMethodId, "login",
+	amClient := authmethods.NewClient(client)
+	result, err := amClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
 		map[string]interface{}{
 			"login_name": c.flagLoginName,
 			"password":   c.flagPassword,
 		})
+	if err == nil && result.Command == "mfa" {
+		result, err = c.authenticateMfa(amClient, result)
+		if result == nil && err == nil {
+			return base.CommandUserError
+		}
+	}
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication")
@@ -149,3 +173,39 @@ func (c *PasswordCommand) Run(args []string) int {
 
 	return saveAndOrPrintToken(c.Command, result)
 }
+
+// authenticateMfa completes the authentication of an account with a second
+// factor. It returns a nil result and error if the second factor could not be
+// read; the error has then already been printed.
+func (c *PasswordCommand) authenticateMfa(amClient *authmethods.Client, challenge *authmethods.AuthenticateResult) (*authmethods.AuthenticateResult, error) {
+	mfa := new(authmethods.PasswordAuthMethodAuthenticateMfaChallengeResponse)
+	if err := json.Unmarshal(challenge.GetRawAttributes(), mfa); err != nil {
+		return nil, fmt.Errorf("Error decoding second factor challenge: %w", err)
+	}
+	if !strutil.StrListContains(mfa.Factors, "totp") && !strutil.StrListContains(mfa.Factors, "recovery_code") {
+		c.PrintCliError(errors.New("The account only has WebAuthn credentials as second factor, which cannot be used from the CLI"))
+		return nil, nil
+	}
+
+	code := c.flagMfaCode
+	if code == "" {
+		fmt.Print("A second factor is required, please enter a TOTP or recovery code now (will be hidden): ")
+		value, err := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", err.Error()))
+			return nil, nil
+		}
+		code = strings.TrimSpace(value)
+	}
+
+	attrs := map[string]interface{}{
+		"challenge_id": mfa.ChallengeId,
+	}
+	if len(code) == totpCodeLength {
+		attrs["totp_code"] = code
+	} else {
+		attrs["recovery_code"] = code
+	}
+	return amClient.Authenticate(c.Context, c.FlagAuthMethodId, "mfa", attrs)
+}
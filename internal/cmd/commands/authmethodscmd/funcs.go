@@ -219,4 +219,8 @@ func printItemTable(result api.GenericResult) string {
 var keySubstMap = map[string]string{
 	"min_login_name_length": "Minimum Login Name Length",
 	"min_password_length":   "Minimum Password Length",
+
+	"lockout_max_failed_attempts": "Lockout Max Failed Attempts",
+	"lockout_window_seconds":      "Lockout Window Seconds",
+	"lockout_duration_seconds":    "Lockout Duration Seconds",
 }
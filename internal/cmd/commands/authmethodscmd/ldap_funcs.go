@@ -0,0 +1,282 @@
+package authmethodscmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/authmethods"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func init() {
+	extraLdapActionsFlagsMapFunc = extraLdapActionsFlagsMapFuncImpl
+	extraLdapFlagsFunc = extraLdapFlagsFuncImpl
+	extraLdapFlagsHandlingFunc = extraLdapFlagHandlingFuncImpl
+}
+
+type extraLdapCmdVars struct {
+	flagUrls            []string
+	flagCertificates    []string
+	flagStartTls        string
+	flagInsecureTls     string
+	flagDiscoverDn      string
+	flagAnonGroupSearch string
+	flagUpnDomain       string
+	flagUserDn          string
+	flagUserAttr        string
+	flagUserFilter      string
+	flagEnableGroups    string
+	flagGroupDn         string
+	flagGroupAttr       string
+	flagGroupFilter     string
+	flagBindDn          string
+	flagBindPassword    string
+}
+
+const (
+	urlsFlagName            = "urls"
+	certificatesFlagName    = "certificates"
+	startTlsFlagName        = "start-tls"
+	insecureTlsFlagName     = "insecure-tls"
+	discoverDnFlagName      = "discover-dn"
+	anonGroupSearchFlagName = "anon-group-search"
+	upnDomainFlagName       = "upn-domain"
+	userDnFlagName          = "user-dn"
+	userAttrFlagName        = "user-attr"
+	userFilterFlagName      = "user-filter"
+	enableGroupsFlagName    = "enable-groups"
+	groupDnFlagName         = "group-dn"
+	groupAttrFlagName       = "group-attr"
+	groupFilterFlagName     = "group-filter"
+	bindDnFlagName          = "bind-dn"
+	bindPasswordFlagName    = "bind-password"
+)
+
+func extraLdapActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			urlsFlagName,
+			certificatesFlagName,
+			startTlsFlagName,
+			insecureTlsFlagName,
+			discoverDnFlagName,
+			anonGroupSearchFlagName,
+			upnDomainFlagName,
+			userDnFlagName,
+			userAttrFlagName,
+			userFilterFlagName,
+			enableGroupsFlagName,
+			groupDnFlagName,
+			groupAttrFlagName,
+			groupFilterFlagName,
+			bindDnFlagName,
+			bindPasswordFlagName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraLdapFlagsFuncImpl(c *LdapCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("LDAP Auth Method Options")
+
+	for _, name := range flagsLdapMap[c.Func] {
+		switch name {
+		case urlsFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   urlsFlagName,
+				Target: &c.flagUrls,
+				Usage:  "The ldap or ldaps URL of a directory server. May be specified multiple times; servers are tried in the order given.",
+			})
+		case certificatesFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   certificatesFlagName,
+				Target: &c.flagCertificates,
+				Usage:  "Optional PEM-encoded X.509 CA certificate that can be used as a trust anchor when connecting to a directory server. May be specified multiple times.",
+			})
+		case startTlsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   startTlsFlagName,
+				Target: &c.flagStartTls,
+				Usage:  "If true, issue a StartTLS command after connecting to an ldap URL.",
+			})
+		case insecureTlsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   insecureTlsFlagName,
+				Target: &c.flagInsecureTls,
+				Usage:  "If true, skip verification of the directory server's TLS certificate.",
+			})
+		case discoverDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   discoverDnFlagName,
+				Target: &c.flagDiscoverDn,
+				Usage:  "If true, search the directory to discover the user's DN before binding.",
+			})
+		case anonGroupSearchFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   anonGroupSearchFlagName,
+				Target: &c.flagAnonGroupSearch,
+				Usage:  "If true, search for groups anonymously instead of as the bound user.",
+			})
+		case upnDomainFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   upnDomainFlagName,
+				Target: &c.flagUpnDomain,
+				Usage:  "The userPrincipalName domain used to construct a UPN of the form user@domain when binding.",
+			})
+		case userDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userDnFlagName,
+				Target: &c.flagUserDn,
+				Usage:  "The base DN under which users are searched for.",
+			})
+		case userAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userAttrFlagName,
+				Target: &c.flagUserAttr,
+				Usage:  `The attribute on user entries matching the login name, for example "uid" or "sAMAccountName".`,
+			})
+		case userFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   userFilterFlagName,
+				Target: &c.flagUserFilter,
+				Usage:  "The go template used to construct the filter when searching for users.",
+			})
+		case enableGroupsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   enableGroupsFlagName,
+				Target: &c.flagEnableGroups,
+				Usage:  "If true, look up the user's group membership when authenticating so it can be matched against managed groups.",
+			})
+		case groupDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupDnFlagName,
+				Target: &c.flagGroupDn,
+				Usage:  "The base DN under which groups are searched for.",
+			})
+		case groupAttrFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupAttrFlagName,
+				Target: &c.flagGroupAttr,
+				Usage:  `The attribute on group entries that names the group, for example "cn".`,
+			})
+		case groupFilterFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   groupFilterFlagName,
+				Target: &c.flagGroupFilter,
+				Usage:  "The go template used to construct the filter when searching for groups.",
+			})
+		case bindDnFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindDnFlagName,
+				Target: &c.flagBindDn,
+				Usage:  "The DN used to bind to the directory when searching for users and groups.",
+			})
+		case bindPasswordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   bindPasswordFlagName,
+				Target: &c.flagBindPassword,
+				Usage:  "The password used with the bind DN.",
+			})
+		}
+	}
+}
+
+func (c *LdapCommand) extraLdapHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods create ldap [options] [args]",
+			"",
+			"  Create an ldap-type auth method. Example:",
+			"",
+			`    $ boundary auth-methods create ldap -urls "ldaps://ldap.example.com" -user-dn "ou=people,dc=example,dc=com" -user-attr uid`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary auth-methods update ldap [options] [args]",
+			"",
+			"  Update an ldap-type auth method given its ID. Example:",
+			"",
+			`    $ boundary auth-methods update ldap -id amldap_1234567890 -enable-groups true -group-dn "ou=groups,dc=example,dc=com"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraLdapFlagHandlingFuncImpl(c *LdapCommand, f *base.FlagSets, opts *[]authmethods.Option) bool {
+	switch {
+	case len(c.flagUrls) == 0:
+	case len(c.flagUrls) == 1 && c.flagUrls[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodUrls())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodUrls(c.flagUrls))
+	}
+	switch {
+	case len(c.flagCertificates) == 0:
+	case len(c.flagCertificates) == 1 && c.flagCertificates[0] == "null":
+		*opts = append(*opts, authmethods.DefaultLdapAuthMethodCertificates())
+	default:
+		*opts = append(*opts, authmethods.WithLdapAuthMethodCertificates(c.flagCertificates))
+	}
+
+	boolFlags := []struct {
+		name  string
+		val   string
+		with  func(bool) authmethods.Option
+		deflt func() authmethods.Option
+	}{
+		{startTlsFlagName, c.flagStartTls, authmethods.WithLdapAuthMethodStartTls, authmethods.DefaultLdapAuthMethodStartTls},
+		{insecureTlsFlagName, c.flagInsecureTls, authmethods.WithLdapAuthMethodInsecureTls, authmethods.DefaultLdapAuthMethodInsecureTls},
+		{discoverDnFlagName, c.flagDiscoverDn, authmethods.WithLdapAuthMethodDiscoverDn, authmethods.DefaultLdapAuthMethodDiscoverDn},
+		{anonGroupSearchFlagName, c.flagAnonGroupSearch, authmethods.WithLdapAuthMethodAnonGroupSearch, authmethods.DefaultLdapAuthMethodAnonGroupSearch},
+		{enableGroupsFlagName, c.flagEnableGroups, authmethods.WithLdapAuthMethodEnableGroups, authmethods.DefaultLdapAuthMethodEnableGroups},
+	}
+	for _, b := range boolFlags {
+		switch b.val {
+		case "":
+		case "null":
+			*opts = append(*opts, b.deflt())
+		default:
+			val, err := strconv.ParseBool(b.val)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q for -%s: %s", b.val, b.name, err))
+				return false
+			}
+			*opts = append(*opts, b.with(val))
+		}
+	}
+
+	stringFlags := []struct {
+		val   string
+		with  func(string) authmethods.Option
+		deflt func() authmethods.Option
+	}{
+		{c.flagUpnDomain, authmethods.WithLdapAuthMethodUpnDomain, authmethods.DefaultLdapAuthMethodUpnDomain},
+		{c.flagUserDn, authmethods.WithLdapAuthMethodUserDn, authmethods.DefaultLdapAuthMethodUserDn},
+		{c.flagUserAttr, authmethods.WithLdapAuthMethodUserAttr, authmethods.DefaultLdapAuthMethodUserAttr},
+		{c.flagUserFilter, authmethods.WithLdapAuthMethodUserFilter, authmethods.DefaultLdapAuthMethodUserFilter},
+		{c.flagGroupDn, authmethods.WithLdapAuthMethodGroupDn, authmethods.DefaultLdapAuthMethodGroupDn},
+		{c.flagGroupAttr, authmethods.WithLdapAuthMethodGroupAttr, authmethods.DefaultLdapAuthMethodGroupAttr},
+		{c.flagGroupFilter, authmethods.WithLdapAuthMethodGroupFilter, authmethods.DefaultLdapAuthMethodGroupFilter},
+		{c.flagBindDn, authmethods.WithLdapAuthMethodBindDn, authmethods.DefaultLdapAuthMethodBindDn},
+		{c.flagBindPassword, authmethods.WithLdapAuthMethodBindPassword, authmethods.DefaultLdapAuthMethodBindPassword},
+	}
+	for _, s := range stringFlags {
+		switch s.val {
+		case "":
+		case "null":
+			*opts = append(*opts, s.deflt())
+		default:
+			*opts = append(*opts, s.with(s.val))
+		}
+	}
+
+	return true
+}
@@ -17,12 +17,18 @@ func init() {
 type extraPasswordCmdVars struct {
 	flagMinLoginNameLength string
 	flagMinPasswordLength  string
+	flagMfaRequired        string
+	flagWebAuthnRpId       string
+
+	flagLockoutMaxFailedAttempts string
+	flagLockoutWindowSeconds     string
+	flagLockoutDurationSeconds   string
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": {"min-login-name-length", "min-password-length", "mfa-required", "webauthn-rp-id", "lockout-max-failed-attempts", "lockout-window-seconds", "lockout-duration-seconds"},
+		"update": {"min-login-name-length", "min-password-length", "mfa-required", "webauthn-rp-id", "lockout-max-failed-attempts", "lockout-window-seconds", "lockout-duration-seconds"},
 	}
 }
 
@@ -71,6 +77,36 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, f *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "mfa-required":
+			f.StringVar(&base.StringVar{
+				Name:   "mfa-required",
+				Target: &c.flagMfaRequired,
+				Usage:  "If true, accounts must authenticate with a second factor and accounts without one cannot authenticate",
+			})
+		case "webauthn-rp-id":
+			f.StringVar(&base.StringVar{
+				Name:   "webauthn-rp-id",
+				Target: &c.flagWebAuthnRpId,
+				Usage:  "The WebAuthn relying party ID, the domain of the clients registering and using WebAuthn credentials. WebAuthn is only available when this is set.",
+			})
+		case "lockout-max-failed-attempts":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-max-failed-attempts",
+				Target: &c.flagLockoutMaxFailedAttempts,
+				Usage:  "The number of failed authentication attempts within the lockout window after which an account is locked out. 0 disables the lockout.",
+			})
+		case "lockout-window-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-window-seconds",
+				Target: &c.flagLockoutWindowSeconds,
+				Usage:  "The window, in seconds, in which failed authentication attempts are counted",
+			})
+		case "lockout-duration-seconds":
+			f.StringVar(&base.StringVar{
+				Name:   "lockout-duration-seconds",
+				Target: &c.flagLockoutDurationSeconds,
+				Usage:  "How long, in seconds, an account stays locked out",
+			})
 		}
 	}
 }
@@ -109,6 +145,48 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	switch c.flagMfaRequired {
+	case "":
+	case "null":
+		addAttribute("mfa_required", nil)
+	default:
+		required, err := strconv.ParseBool(c.flagMfaRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagMfaRequired, err))
+			return false
+		}
+		addAttribute("mfa_required", required)
+	}
+
+	switch c.flagWebAuthnRpId {
+	case "":
+	case "null":
+		addAttribute("webauthn_rp_id", nil)
+	default:
+		addAttribute("webauthn_rp_id", c.flagWebAuthnRpId)
+	}
+
+	for _, v := range []struct {
+		name, value string
+	}{
+		{"lockout_max_failed_attempts", c.flagLockoutMaxFailedAttempts},
+		{"lockout_window_seconds", c.flagLockoutWindowSeconds},
+		{"lockout_duration_seconds", c.flagLockoutDurationSeconds},
+	} {
+		switch v.value {
+		case "":
+		case "null":
+			addAttribute(v.name, nil)
+		default:
+			n, err := strconv.ParseUint(v.value, 10, 32)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", v.value, err))
+				return false
+			}
+			addAttribute(v.name, uint32(n))
+		}
+	}
+
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
@@ -822,7 +822,7 @@ func (c *Command) handleExec(passthroughArgs []string) {
 
 	if len(creds) > 0 {
 		for _, cred := range creds {
-			if cred.CredentialLibrary.Type == credential.UserPasswordType {
+			if cred.CredentialSource != nil && cred.CredentialSource.CredentialType == string(credential.UserPasswordType) {
 				secret.password = cred.Secret.Decoded["password"].(string)
 				secret.username = cred.Secret.Decoded["username"].(string)
 				break
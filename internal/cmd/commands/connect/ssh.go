@@ -47,7 +47,14 @@ func (s *sshFlags) buildArgs(c *Command, port, ip, addr string) []string {
 	switch s.flagSshStyle {
 	case "ssh":
 		args = append(args, "-p", port, ip)
-		args = append(args, "-o", fmt.Sprintf("HostKeyAlias=%s", c.sessionAuthzData.HostId))
+		if c.sessionAuthzData.Type == "ssh" {
+			// The worker terminates SSH for ssh-type targets and presents a
+			// host key generated for each connection, so there is nothing to
+			// remember it by.
+			args = append(args, "-o", "NoHostAuthenticationForLocalhost=yes")
+		} else {
+			args = append(args, "-o", fmt.Sprintf("HostKeyAlias=%s", c.sessionAuthzData.HostId))
+		}
 	case "putty":
 		args = append(args, "-P", port, ip)
 	}
@@ -1,6 +1,10 @@
 package credentiallibrariescmd
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -18,6 +22,12 @@ const (
 	httpRequestBodyFlagName   = "vault-http-request-body"
 	credentialTypeFlagName    = "credential-type"
 	credentialMappingFlagName = "credential-mapping-override"
+	sshUsernameFlagName       = "ssh-username"
+	sshKeyTypeFlagName        = "ssh-key-type"
+	sshKeyBitsFlagName        = "ssh-key-bits"
+	sshTtlFlagName            = "ssh-ttl"
+	sshValidPrincipalFlagName = "ssh-valid-principal"
+	sshExtensionFlagName      = "ssh-extension"
 )
 
 type extraVaultCmdVars struct {
@@ -26,6 +36,12 @@ type extraVaultCmdVars struct {
 	flagHttpRequestBody   string
 	flagCredentialType    string
 	flagCredentialMapping []base.CombinedSliceFlagValue
+	flagSshUsername       string
+	flagSshKeyType        string
+	flagSshKeyBits        string
+	flagSshTtl            string
+	flagSshPrincipals     []string
+	flagSshExtensions     []string
 }
 
 func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
@@ -36,12 +52,24 @@ func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
 			httpRequestBodyFlagName,
 			credentialTypeFlagName,
 			credentialMappingFlagName,
+			sshUsernameFlagName,
+			sshKeyTypeFlagName,
+			sshKeyBitsFlagName,
+			sshTtlFlagName,
+			sshValidPrincipalFlagName,
+			sshExtensionFlagName,
 		},
 		"update": {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
 			credentialMappingFlagName,
+			sshUsernameFlagName,
+			sshKeyTypeFlagName,
+			sshKeyBitsFlagName,
+			sshTtlFlagName,
+			sshValidPrincipalFlagName,
+			sshExtensionFlagName,
 		},
 	}
 	return flags
@@ -83,6 +111,42 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				KvSplit: true,
 				Usage:   "The credential mapping override.",
 			})
+		case sshUsernameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   sshUsernameFlagName,
+				Target: &c.flagSshUsername,
+				Usage:  `The username the ssh certificates issued by the library are valid for. Required when the credential type is "ssh_certificate".`,
+			})
+		case sshKeyTypeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   sshKeyTypeFlagName,
+				Target: &c.flagSshKeyType,
+				Usage:  `The type of key generated for each ssh certificate: "ed25519" (the default), "ecdsa" or "rsa".`,
+			})
+		case sshKeyBitsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   sshKeyBitsFlagName,
+				Target: &c.flagSshKeyBits,
+				Usage:  "The size of the key generated for each ssh certificate. If not set the default size for the key type is used.",
+			})
+		case sshTtlFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   sshTtlFlagName,
+				Target: &c.flagSshTtl,
+				Usage:  "The TTL requested for each ssh certificate. If not set the TTL of the Vault role is used.",
+			})
+		case sshValidPrincipalFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   sshValidPrincipalFlagName,
+				Target: &c.flagSshPrincipals,
+				Usage:  "A principal the ssh certificates are valid for in addition to the ssh username. May be specified multiple times.",
+			})
+		case sshExtensionFlagName:
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   sshExtensionFlagName,
+				Target: &c.flagSshExtensions,
+				Usage:  `An extension requested for each ssh certificate, in the format 'name' or 'name=value', e.g. "permit-pty". May be specified multiple times.`,
+			})
 		}
 	}
 }
@@ -141,6 +205,74 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, _ *base.FlagSets, opts *[]c
 		}
 		*opts = append(*opts, credentiallibraries.WithCredentialMappingOverrides(mappings))
 	}
+	switch c.flagSshUsername {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySshUsername())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySshUsername(c.flagSshUsername))
+	}
+	switch c.flagSshKeyType {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySshKeyType())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySshKeyType(c.flagSshKeyType))
+	}
+	switch c.flagSshKeyBits {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySshKeyBits())
+	default:
+		bits, err := strconv.ParseUint(c.flagSshKeyBits, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSshKeyBits, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySshKeyBits(uint32(bits)))
+	}
+	switch c.flagSshTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySshTtl())
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySshTtl(c.flagSshTtl))
+	}
+	switch len(c.flagSshPrincipals) {
+	case 0:
+	case 1:
+		if c.flagSshPrincipals[0] == "null" {
+			*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySshValidPrincipals())
+			break
+		}
+		fallthrough
+	default:
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySshValidPrincipals(c.flagSshPrincipals))
+	}
+	switch len(c.flagSshExtensions) {
+	case 0:
+	case 1:
+		if c.flagSshExtensions[0] == "null" {
+			*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySshExtensions())
+			break
+		}
+		fallthrough
+	default:
+		extensions := make(map[string]interface{}, len(c.flagSshExtensions))
+		for _, ext := range c.flagSshExtensions {
+			kv := strings.SplitN(ext, "=", 2)
+			if strings.TrimSpace(kv[0]) == "" {
+				c.UI.Error("SSH extension must be in the format 'name', 'name=value' or 'null' to clear all.")
+				return false
+			}
+			var value string
+			if len(kv) == 2 {
+				value = kv[1]
+			}
+			extensions[strings.TrimSpace(kv[0])] = value
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySshExtensions(extensions))
+	}
 
 	return true
 }
@@ -156,6 +288,10 @@ func (c *VaultCommand) extraVaultHelpFunc(_ map[string]func() string) string {
 			"",
 			`    $ boundary credential-libraries create vault -credential-store-id csvlt_1234567890 -vault-path "/some/path"`,
 			"",
+			"  Create a vault-type credential library that issues ssh certificates signed by the Vault SSH secrets engine. Example:",
+			"",
+			`    $ boundary credential-libraries create vault -credential-store-id csvlt_1234567890 -vault-path "ssh/sign/dev" -credential-type ssh_certificate -ssh-username ubuntu -ssh-extension permit-pty`,
+			"",
 			"",
 		})
 
@@ -0,0 +1,92 @@
+package credentialscmd
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraJsonActionsFlagsMapFunc = extraJsonActionsFlagsMapFuncImpl
+	extraJsonFlagsFunc = extraJsonFlagsFuncImpl
+	extraJsonFlagsHandlingFunc = extraJsonFlagHandlingFuncImpl
+}
+
+const objectFlagName = "object"
+
+type extraJsonCmdVars struct {
+	flagObject string
+}
+
+func extraJsonActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {objectFlagName},
+		"update": {objectFlagName},
+	}
+}
+
+func extraJsonFlagsFuncImpl(c *JsonCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("JSON Credential Options")
+
+	for _, name := range flagsJsonMap[c.Func] {
+		switch name {
+		case objectFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   objectFlagName,
+				Target: &c.flagObject,
+				Usage:  "The JSON object stored as the credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraJsonFlagHandlingFuncImpl(c *JsonCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	switch c.flagObject {
+	case "":
+	default:
+		raw, err := parseutil.ParsePath(c.flagObject)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error("Error parsing object flag: " + err.Error())
+			return false
+		}
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &object); err != nil {
+			c.UI.Error("Error parsing object flag as a JSON object: " + err.Error())
+			return false
+		}
+		*opts = append(*opts, credentials.WithJsonCredentialObject(object))
+	}
+
+	return true
+}
+
+func (c *JsonCommand) extraJsonHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create json -credential-store-id [options] [args]",
+			"",
+			"  Create a json-type credential. Example:",
+			"",
+			`    $ boundary credentials create json -credential-store-id csst_1234567890 -object file:///path/to/secret.json`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update json [options] [args]",
+			"",
+			"  Update a json-type credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update json -id credjson_1234567890 -object '{"api_key": "s3cr3t"}'`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -0,0 +1,98 @@
+package credentialscmd
+
+import (
+	"errors"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSshPrivateKeyActionsFlagsMapFunc = extraSshPrivateKeyActionsFlagsMapFuncImpl
+	extraSshPrivateKeyFlagsFunc = extraSshPrivateKeyFlagsFuncImpl
+	extraSshPrivateKeyFlagsHandlingFunc = extraSshPrivateKeyFlagHandlingFuncImpl
+}
+
+const privateKeyFlagName = "private-key"
+
+type extraSshPrivateKeyCmdVars struct {
+	flagUsername   string
+	flagPrivateKey string
+}
+
+func extraSshPrivateKeyActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {usernameFlagName, privateKeyFlagName},
+		"update": {usernameFlagName, privateKeyFlagName},
+	}
+}
+
+func extraSshPrivateKeyFlagsFuncImpl(c *SshPrivateKeyCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SSH Private Key Credential Options")
+
+	for _, name := range flagsSshPrivateKeyMap[c.Func] {
+		switch name {
+		case usernameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   usernameFlagName,
+				Target: &c.flagUsername,
+				Usage:  "The username associated with the credential.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "The PEM encoded private key associated with the credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraSshPrivateKeyFlagHandlingFuncImpl(c *SshPrivateKeyCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	switch c.flagUsername {
+	case "":
+	default:
+		*opts = append(*opts, credentials.WithSshPrivateKeyCredentialUsername(c.flagUsername))
+	}
+	switch c.flagPrivateKey {
+	case "":
+	default:
+		pk, err := parseutil.ParsePath(c.flagPrivateKey)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error("Error parsing private key flag: " + err.Error())
+			return false
+		}
+		*opts = append(*opts, credentials.WithSshPrivateKeyCredentialPrivateKey(pk))
+	}
+
+	return true
+}
+
+func (c *SshPrivateKeyCommand) extraSshPrivateKeyHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create ssh-private-key -credential-store-id [options] [args]",
+			"",
+			"  Create a ssh_private_key-type credential. Example:",
+			"",
+			`    $ boundary credentials create ssh-private-key -credential-store-id csst_1234567890 -username admin -private-key file:///home/user/.ssh/id_ed25519`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update ssh-private-key [options] [args]",
+			"",
+			"  Update a ssh_private_key-type credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update ssh-private-key -id credspk_1234567890 -private-key file:///home/user/.ssh/id_ed25519`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
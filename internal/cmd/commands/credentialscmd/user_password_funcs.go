@@ -0,0 +1,101 @@
+package credentialscmd
+
+import (
+	"errors"
+
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraUserPasswordActionsFlagsMapFunc = extraUserPasswordActionsFlagsMapFuncImpl
+	extraUserPasswordFlagsFunc = extraUserPasswordFlagsFuncImpl
+	extraUserPasswordFlagsHandlingFunc = extraUserPasswordFlagHandlingFuncImpl
+}
+
+const (
+	usernameFlagName = "username"
+	passwordFlagName = "password"
+)
+
+type extraUserPasswordCmdVars struct {
+	flagUsername string
+	flagPassword string
+}
+
+func extraUserPasswordActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {usernameFlagName, passwordFlagName},
+		"update": {usernameFlagName, passwordFlagName},
+	}
+}
+
+func extraUserPasswordFlagsFuncImpl(c *UserPasswordCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("User Password Credential Options")
+
+	for _, name := range flagsUserPasswordMap[c.Func] {
+		switch name {
+		case usernameFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   usernameFlagName,
+				Target: &c.flagUsername,
+				Usage:  "The username associated with the credential.",
+			})
+		case passwordFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   passwordFlagName,
+				Target: &c.flagPassword,
+				Usage:  "The password associated with the credential. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraUserPasswordFlagHandlingFuncImpl(c *UserPasswordCommand, _ *base.FlagSets, opts *[]credentials.Option) bool {
+	switch c.flagUsername {
+	case "":
+	default:
+		*opts = append(*opts, credentials.WithUsernamePasswordCredentialUsername(c.flagUsername))
+	}
+	switch c.flagPassword {
+	case "":
+	default:
+		password, err := parseutil.ParsePath(c.flagPassword)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error("Error parsing password flag: " + err.Error())
+			return false
+		}
+		*opts = append(*opts, credentials.WithUsernamePasswordCredentialPassword(password))
+	}
+
+	return true
+}
+
+func (c *UserPasswordCommand) extraUserPasswordHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials create user-password -credential-store-id [options] [args]",
+			"",
+			"  Create a user_password-type credential. Example:",
+			"",
+			`    $ boundary credentials create user-password -credential-store-id csst_1234567890 -username admin -password env://ADMIN_PASSWORD`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credentials update user-password [options] [args]",
+			"",
+			"  Update a user_password-type credential given its ID. Example:",
+			"",
+			`    $ boundary credentials update user-password -id credup_1234567890 -password file:///path/to/password`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
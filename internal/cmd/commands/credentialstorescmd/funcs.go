@@ -35,6 +35,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores create vault -vault-address "http://localhost:8200" -vault-token "s.s0m3t0k3n"`,
 			"",
+			"    Create a static-type credential store:",
+			"",
+			`      $ boundary credential-stores create static -scope-id p_1234567890`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
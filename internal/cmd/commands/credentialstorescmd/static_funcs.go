@@ -0,0 +1,33 @@
+package credentialstorescmd
+
+import (
+	"github.com/hashicorp/boundary/internal/cmd/base"
+)
+
+func (c *StaticCommand) extraStaticHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create static -scope-id [options] [args]",
+			"",
+			"  Create a static-type credential store. Example:",
+			"",
+			`    $ boundary credential-stores create static -scope-id p_1234567890 -name prodops -description "Static credential store for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update static [options] [args]",
+			"",
+			"  Update a static-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update static -id csst_1234567890 -name devops -description "Static credential store for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
@@ -208,6 +208,32 @@ func printItemTable(result api.GenericResult) string {
 		)
 	}
 
+	if len(item.Health) > 0 {
+		ret = append(ret,
+			"",
+			"  Health:",
+		)
+		for i, hh := range item.Health {
+			if i > 0 {
+				ret = append(ret, "")
+			}
+			healthMap := map[string]interface{}{
+				"Host Set ID": hh.HostSetId,
+				"Status":      hh.Status,
+				"Worker ID":   hh.WorkerId,
+			}
+			if !hh.CheckedTime.IsZero() {
+				healthMap["Checked Time"] = hh.CheckedTime.Local().Format(time.RFC1123)
+			}
+			if hh.Message != "" {
+				healthMap["Message"] = hh.Message
+			}
+			ret = append(ret,
+				base.WrapMap(4, maxLength, healthMap),
+			)
+		}
+	}
+
 	return base.WrapForHelpText(ret)
 }
 
@@ -1,6 +1,9 @@
 package hostscmd
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/boundary/api/hosts"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
@@ -13,12 +16,13 @@ func init() {
 
 type extraStaticCmdVars struct {
 	flagAddress string
+	flagTags    []string
 }
 
 func extraStaticActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"address"},
-		"update": {"address"},
+		"create": {"address", "tag"},
+		"update": {"address", "tag"},
 	}
 }
 
@@ -31,7 +35,7 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 			"",
 			"  Create a static-type host. Example:",
 			"",
-			`    $ boundary hosts create static -name prodops -description "Static host for ProdOps" -address "127.0.0.1"`,
+			`    $ boundary hosts create static -name prodops -description "Static host for ProdOps" -address "127.0.0.1" -tag env=prod`,
 			"",
 			"",
 		})
@@ -64,6 +68,12 @@ func extraStaticFlagsFuncImpl(c *StaticCommand, set *base.FlagSets, _ *base.Flag
 				Target: &c.flagAddress,
 				Usage:  "The address of the host",
 			})
+		case "tag":
+			f.StringSliceVar(&base.StringSliceVar{
+				Name:   "tag",
+				Target: &c.flagTags,
+				Usage:  `A tag of the host, in the form "key=value". May be specified multiple times, including with the same key. On update, replaces all the tags of the host; use "null" to remove them.`,
+			})
 		}
 	}
 }
@@ -82,5 +92,22 @@ func extraStaticFlagsHandlingFuncImpl(c *StaticCommand, _ *base.FlagSets, opts *
 		*opts = append(*opts, hosts.WithStaticHostAddress(c.flagAddress))
 	}
 
+	switch {
+	case len(c.flagTags) == 0:
+	case len(c.flagTags) == 1 && c.flagTags[0] == "null":
+		*opts = append(*opts, hosts.DefaultStaticHostTags())
+	default:
+		tags := make(map[string][]string, len(c.flagTags))
+		for _, t := range c.flagTags {
+			kv := strings.SplitN(t, "=", 2)
+			if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+				c.UI.Error(fmt.Sprintf("Tag %q must be in the form \"key=value\"", t))
+				return false
+			}
+			tags[kv[0]] = append(tags[kv[0]], kv[1])
+		}
+		*opts = append(*opts, hosts.WithStaticHostTags(tags))
+	}
+
 	return true
 }
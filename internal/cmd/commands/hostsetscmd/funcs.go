@@ -297,6 +297,26 @@ func printItemTable(result api.GenericResult) string {
 		)
 	}
 
+	if hc := item.HealthCheck; hc != nil {
+		healthCheckMap := map[string]interface{}{
+			"Type":     hc.Type,
+			"Port":     hc.Port,
+			"Interval": fmt.Sprintf("%d seconds", hc.IntervalSeconds),
+			"Timeout":  fmt.Sprintf("%d seconds", hc.TimeoutSeconds),
+		}
+		if hc.Path != "" {
+			healthCheckMap["Path"] = hc.Path
+		}
+		if hc.WorkerFilter != "" {
+			healthCheckMap["Worker Filter"] = hc.WorkerFilter
+		}
+		ret = append(ret,
+			"",
+			"  Health Check:",
+			base.WrapMap(4, maxLength, healthCheckMap),
+		)
+	}
+
 	if len(item.AuthorizedActions) > 0 {
 		ret = append(ret,
 			"",
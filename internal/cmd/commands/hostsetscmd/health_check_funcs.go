@@ -0,0 +1,122 @@
+package hostsetscmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/hostsets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+)
+
+// healthCheckFlags are the flags setting the health check of a host set. They
+// are supported by the create and update commands of all host set types.
+var healthCheckFlags = []string{
+	"health-check-type",
+	"health-check-port",
+	"health-check-path",
+	"health-check-interval",
+	"health-check-timeout",
+	"health-check-worker-filter",
+}
+
+type healthCheckCmdVars struct {
+	flagHealthCheckType         string
+	flagHealthCheckPort         string
+	flagHealthCheckPath         string
+	flagHealthCheckInterval     string
+	flagHealthCheckTimeout      string
+	flagHealthCheckWorkerFilter string
+}
+
+func (c *healthCheckCmdVars) populateHealthCheckFlags(set *base.FlagSets, flags []string) {
+	if !strutil.StrListContains(flags, healthCheckFlags[0]) {
+		return
+	}
+	f := set.NewFlagSet("Health Check Options")
+	f.StringVar(&base.StringVar{
+		Name:   "health-check-type",
+		Target: &c.flagHealthCheckType,
+		Usage: `The type of the health check of the hosts of the set: "tcp" checks that a connection can be opened ` +
+			`to the port of a host, "http" checks that a GET request on the port and path of a host returns a status ` +
+			`code lower than 400. Setting to null removes the health check of the set.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "health-check-port",
+		Target: &c.flagHealthCheckPort,
+		Usage:  "The port of the hosts to check.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "health-check-path",
+		Target: &c.flagHealthCheckPath,
+		Usage:  `The path requested by "http" health checks. Defaults to "/".`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "health-check-interval",
+		Target: &c.flagHealthCheckInterval,
+		Usage:  `An integer number of seconds, or a string such as "30s" or "1m", between two checks of a host. Defaults to 30 seconds.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "health-check-timeout",
+		Target: &c.flagHealthCheckTimeout,
+		Usage:  `An integer number of seconds, or a string such as "5s", after which a check fails. Defaults to 5 seconds.`,
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "health-check-worker-filter",
+		Target: &c.flagHealthCheckWorkerFilter,
+		Usage:  "A boolean expression to filter which workers run the health check. By default all workers run it.",
+	})
+}
+
+// healthCheckOption adds the option setting the health check of the set
+// given by the health check flags to opts. On update, only the fields of
+// the check whose flag is set are changed.
+func (c *healthCheckCmdVars) healthCheckOption(opts *[]hostsets.Option) error {
+	if c.flagHealthCheckType == "null" {
+		if c.flagHealthCheckPort != "" || c.flagHealthCheckPath != "" || c.flagHealthCheckInterval != "" ||
+			c.flagHealthCheckTimeout != "" || c.flagHealthCheckWorkerFilter != "" {
+			return errors.New("No other health check flag can be set when removing the health check")
+		}
+		*opts = append(*opts, hostsets.DefaultHealthCheck())
+		return nil
+	}
+
+	var hc hostsets.HealthCheck
+	var set bool
+	if c.flagHealthCheckType != "" {
+		hc.Type, set = c.flagHealthCheckType, true
+	}
+	if c.flagHealthCheckPort != "" {
+		port, err := strconv.ParseUint(c.flagHealthCheckPort, 10, 16)
+		if err != nil || port == 0 {
+			return fmt.Errorf("Invalid health check port %q", c.flagHealthCheckPort)
+		}
+		hc.Port, set = uint32(port), true
+	}
+	if c.flagHealthCheckPath != "" {
+		hc.Path, set = c.flagHealthCheckPath, true
+	}
+	if c.flagHealthCheckInterval != "" {
+		interval, err := parseutil.ParseDurationSecond(c.flagHealthCheckInterval)
+		if err != nil {
+			return fmt.Errorf("Unable to parse health check interval: %w", err)
+		}
+		hc.IntervalSeconds, set = uint32(interval.Seconds()), true
+	}
+	if c.flagHealthCheckTimeout != "" {
+		timeout, err := parseutil.ParseDurationSecond(c.flagHealthCheckTimeout)
+		if err != nil {
+			return fmt.Errorf("Unable to parse health check timeout: %w", err)
+		}
+		hc.TimeoutSeconds, set = uint32(timeout.Seconds()), true
+	}
+	if c.flagHealthCheckWorkerFilter != "" {
+		hc.WorkerFilter, set = c.flagHealthCheckWorkerFilter, true
+	}
+	if set {
+		*opts = append(*opts, hostsets.WithHealthCheck(&hc))
+	}
+	return nil
+}
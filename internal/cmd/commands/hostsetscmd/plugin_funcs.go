@@ -18,12 +18,13 @@ func init() {
 type extraPluginCmdVars struct {
 	flagPreferredEndpoints []string
 	flagSyncInterval       string
+	healthCheckCmdVars
 }
 
 func extraPluginActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"preferred-endpoint", "sync-interval"},
-		"update": {"preferred-endpoint", "sync-interval"},
+		"create": append([]string{"preferred-endpoint", "sync-interval"}, healthCheckFlags...),
+		"update": append([]string{"preferred-endpoint", "sync-interval"}, healthCheckFlags...),
 	}
 }
 
@@ -86,6 +87,8 @@ func extraPluginFlagsFuncImpl(c *PluginCommand, set *base.FlagSets, f *base.Flag
 			})
 		}
 	}
+
+	c.populateHealthCheckFlags(set, flagsPluginMap[c.Func])
 }
 
 func extraPluginFlagsHandlingFuncImpl(c *PluginCommand, _ *base.FlagSets, opts *[]hostsets.Option) bool {
@@ -120,5 +123,10 @@ func extraPluginFlagsHandlingFuncImpl(c *PluginCommand, _ *base.FlagSets, opts *
 		*opts = append(*opts, hostsets.WithSyncIntervalSeconds(int32(interval.Seconds())))
 	}
 
+	if err := c.healthCheckOption(opts); err != nil {
+		c.UI.Error(err.Error())
+		return false
+	}
+
 	return true
 }
@@ -1,9 +1,28 @@
 package hostsetscmd
 
 import (
+	"github.com/hashicorp/boundary/api/hostsets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 )
 
+func init() {
+	extraStaticActionsFlagsMapFunc = extraStaticActionsFlagsMapFuncImpl
+	extraStaticFlagsFunc = extraStaticFlagsFuncImpl
+	extraStaticFlagsHandlingFunc = extraStaticFlagsHandlingFuncImpl
+}
+
+type extraStaticCmdVars struct {
+	flagFilter string
+	healthCheckCmdVars
+}
+
+func extraStaticActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": append([]string{"filter"}, healthCheckFlags...),
+		"update": append([]string{"filter"}, healthCheckFlags...),
+	}
+}
+
 func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) string {
 	var helpStr string
 	switch c.Func {
@@ -15,6 +34,14 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 			"",
 			`    $ boundary host-sets create static -name prodops -description "Static host-set for ProdOps"`,
 			"",
+			"  Create a static-type host set whose hosts are the hosts of the catalog tagged with env=prod. Example:",
+			"",
+			`    $ boundary host-sets create static -name prod -filter '"prod" in "/tags/env"'`,
+			"",
+			"  Create a static-type host set whose hosts are skipped at session authorization while they do not accept connections on port 22. Example:",
+			"",
+			`    $ boundary host-sets create static -name ssh -health-check-type tcp -health-check-port 22`,
+			"",
 			"",
 		})
 
@@ -33,3 +60,38 @@ func (c *StaticCommand) extraStaticHelpFunc(helpMap map[string]func() string) st
 	}
 	return helpStr + c.Flags().Help()
 }
+
+func extraStaticFlagsFuncImpl(c *StaticCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("Static Host-Set Options")
+
+	for _, name := range flagsStaticMap[c.Func] {
+		switch name {
+		case "filter":
+			f.StringVar(&base.StringVar{
+				Name:   "filter",
+				Target: &c.flagFilter,
+				Usage: `A boolean expression evaluated against the "id", "name", "address" and "tags" of the hosts of the catalog. ` +
+					`If set, the hosts matching the filter are the hosts of the set, and hosts cannot be added to the set.`,
+			})
+		}
+	}
+
+	c.populateHealthCheckFlags(set, flagsStaticMap[c.Func])
+}
+
+func extraStaticFlagsHandlingFuncImpl(c *StaticCommand, _ *base.FlagSets, opts *[]hostsets.Option) bool {
+	switch c.flagFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, hostsets.DefaultStaticHostSetFilter())
+	default:
+		*opts = append(*opts, hostsets.WithStaticHostSetFilter(c.flagFilter))
+	}
+
+	if err := c.healthCheckOption(opts); err != nil {
+		c.UI.Error(err.Error())
+		return false
+	}
+
+	return true
+}
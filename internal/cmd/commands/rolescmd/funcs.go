@@ -25,14 +25,17 @@ type extraCmdVars struct {
 	flagGrantScopeId string
 	flagPrincipals   []string
 	flagGrants       []string
+	flagNotBefore    string
+	flagNotAfter     string
+	flagCondition    string
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create":            {"grant-scope-id"},
 		"update":            {"grant-scope-id"},
-		"add-principals":    {"id", "principal", "version"},
-		"set-principals":    {"id", "principal", "version"},
+		"add-principals":    {"id", "principal", "version", "not-before", "not-after", "condition"},
+		"set-principals":    {"id", "principal", "version", "not-before", "not-after", "condition"},
 		"remove-principals": {"id", "principal", "version"},
 		"add-grants":        {"id", "grant", "version"},
 		"set-grants":        {"id", "grant", "version"},
@@ -79,6 +82,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890`,
 			"",
+			`  The "not-before", "not-after" and "condition" flags bound the assignment of the role to the added principals in time and to requests matching the condition. Example:`,
+			"",
+			`    $ boundary roles add-principals -id r_1234567890 -principal u_1234567890 -not-after 2030-01-01T00:00:00Z -condition '"10.0.0.0/8" in "/request/client_networks"'`,
+			"",
 			"",
 		})
 
@@ -90,6 +97,8 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles set-principals -id r_1234567890 -principal u_anon -principal sg_1234567890`,
 			"",
+			`  The "not-before", "not-after" and "condition" flags bound the assignment of the role to the principals which are added.`,
+			"",
 			"",
 		})
 
@@ -164,6 +173,24 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagGrants,
 				Usage:  "The grants to add, remove, or set. May be specified multiple times. Can be in compact string format or JSON (be sure to escape JSON properly).",
 			})
+		case "not-before":
+			f.StringVar(&base.StringVar{
+				Name:   "not-before",
+				Target: &c.flagNotBefore,
+				Usage:  "The time, in RFC 3339 format, from which the role applies to the added principals.",
+			})
+		case "not-after":
+			f.StringVar(&base.StringVar{
+				Name:   "not-after",
+				Target: &c.flagNotAfter,
+				Usage:  "The time, in RFC 3339 format, until which the role applies to the added principals.",
+			})
+		case "condition":
+			f.StringVar(&base.StringVar{
+				Name:   "condition",
+				Target: &c.flagCondition,
+				Usage:  `A boolean expression over the request which must be true for the role to apply to the added principals, e.g. '"10.0.0.0/8" in "/request/client_networks"'.`,
+			})
 		}
 	}
 }
@@ -213,6 +240,30 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]roles.Opti
 		}
 	}
 
+	if c.flagNotBefore != "" {
+		t, err := time.Parse(time.RFC3339, c.flagNotBefore)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Value of -not-before must be a time in RFC 3339 format: %s", err))
+			return false
+		}
+		*opts = append(*opts, roles.WithNotBefore(t))
+	}
+	if c.flagNotAfter != "" {
+		t, err := time.Parse(time.RFC3339, c.flagNotAfter)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Value of -not-after must be a time in RFC 3339 format: %s", err))
+			return false
+		}
+		*opts = append(*opts, roles.WithNotAfter(t))
+	}
+	if c.flagCondition != "" {
+		if err := perms.ValidateCondition(c.flagCondition); err != nil {
+			c.UI.Error(fmt.Errorf("Condition %q could not be parsed successfully: %w", c.flagCondition, err).Error())
+			return false
+		}
+		*opts = append(*opts, roles.WithCondition(c.flagCondition))
+	}
+
 	if len(c.flagGrants) > 0 {
 		for _, grant := range c.flagGrants {
 			_, err := perms.Parse(scope.Global.String(), grant)
@@ -359,6 +410,21 @@ func printItemTable(result api.GenericResult) string {
 			fmt.Sprintf("      Type:         %s", principal.Type),
 			fmt.Sprintf("      Scope ID:     %s", principal.ScopeId),
 		)
+		if !principal.NotBefore.IsZero() {
+			ret = append(ret,
+				fmt.Sprintf("      Not Before:   %s", principal.NotBefore.Local().Format(time.RFC1123)),
+			)
+		}
+		if !principal.NotAfter.IsZero() {
+			ret = append(ret,
+				fmt.Sprintf("      Not After:    %s", principal.NotAfter.Local().Format(time.RFC1123)),
+			)
+		}
+		if principal.Condition != "" {
+			ret = append(ret,
+				fmt.Sprintf("      Condition:    %s", principal.Condition),
+			)
+		}
 	}
 	if len(item.Grants) > 0 {
 		ret = append(ret,
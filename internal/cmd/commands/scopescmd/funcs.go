@@ -8,24 +8,43 @@ import (
 	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/scopes"
 	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/go-wordwrap"
 )
 
 const (
 	flagPrimaryAuthMethodIdName     = "primary-auth-method-id"
 	flagSkipAdminRoleCreationName   = "skip-admin-role-creation"
 	flagSkipDefaultRoleCreationName = "skip-default-role-creation"
+	flagUserIdName                  = "user-id"
+	flagResourceIdName              = "resource-id"
+	flagResourceTypeName            = "resource-type"
+	flagParentIdName                = "parent-id"
+	flagActionName                  = "action"
+	flagClientIpName                = "client-ip"
 )
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
+	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {flagSkipAdminRoleCreationName, flagSkipDefaultRoleCreationName},
 		"update": {flagPrimaryAuthMethodIdName},
+		"check-permissions": {
+			"id",
+			flagUserIdName,
+			flagResourceIdName,
+			flagResourceTypeName,
+			flagParentIdName,
+			flagActionName,
+			flagClientIpName,
+		},
 	}
 }
 
@@ -33,6 +52,47 @@ type extraCmdVars struct {
 	flagSkipAdminRoleCreation   bool
 	flagSkipDefaultRoleCreation bool
 	flagPrimaryAuthMethodId     string
+	flagUserId                  string
+	flagResourceId              string
+	flagResourceType            string
+	flagParentId                string
+	flagAction                  string
+	flagClientIp                string
+	pcr                         *scopes.PermissionCheckResult
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "check-permissions":
+		return wordwrap.WrapString("Check whether a user is authorized to perform an action on a resource", base.TermWidth)
+	default:
+		return ""
+	}
+}
+
+func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "check-permissions":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary scopes check-permissions [options] [args]",
+			"",
+			"  Check whether a user is authorized to perform an action on a resource within the scope specified by ID, without requiring the user's auth token. The user's grants are evaluated the same way as when the user performs the request, and the roles and grants determining the decision are returned. Example:",
+			"",
+			`    $ boundary scopes check-permissions -id p_1234567890 -user-id u_1234567890 -resource-id ttcp_1234567890 -action authorize-session`,
+			"",
+			"  For collection actions such as list or create, specify the type of the resources instead of an ID:",
+			"",
+			`    $ boundary scopes check-permissions -id p_1234567890 -user-id u_1234567890 -resource-type target -action list`,
+			"",
+			"",
+		})
+
+	default:
+		return helpMap["base"]()
+	}
+
+	return helpStr + c.Flags().Help()
 }
 
 func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
@@ -56,6 +116,42 @@ func extraFlagsFuncImpl(c *Command, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagPrimaryAuthMethodId,
 				Usage:  "If set, the primary auth method id for the scope.  A primary auth method is allowed to create users on first login and is also used as a source for account full name and email for a scope's users",
 			})
+		case flagUserIdName:
+			f.StringVar(&base.StringVar{
+				Name:   flagUserIdName,
+				Target: &c.flagUserId,
+				Usage:  "The ID of the user whose permissions are checked.",
+			})
+		case flagResourceIdName:
+			f.StringVar(&base.StringVar{
+				Name:   flagResourceIdName,
+				Target: &c.flagResourceId,
+				Usage:  "The ID of the resource. Omit when checking a collection action such as list or create.",
+			})
+		case flagResourceTypeName:
+			f.StringVar(&base.StringVar{
+				Name:   flagResourceTypeName,
+				Target: &c.flagResourceType,
+				Usage:  "The type of the resource, e.g. target. Required when -resource-id is omitted; otherwise derived from it and must match it.",
+			})
+		case flagParentIdName:
+			f.StringVar(&base.StringVar{
+				Name:   flagParentIdName,
+				Target: &c.flagParentId,
+				Usage:  "The ID of the parent of the resources when checking a collection action, for resources contained in another resource, e.g. the host catalog of hosts. Cannot be used with -resource-id, as the parent of a resource is derived from it.",
+			})
+		case flagActionName:
+			f.StringVar(&base.StringVar{
+				Name:   flagActionName,
+				Target: &c.flagAction,
+				Usage:  "The action to check, e.g. read or authorize-session.",
+			})
+		case flagClientIpName:
+			f.StringVar(&base.StringVar{
+				Name:   flagClientIpName,
+				Target: &c.flagClientIp,
+				Usage:  "An optional client IP address to evaluate grant conditions against.",
+			})
 		}
 	}
 }
@@ -71,9 +167,48 @@ func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]scopes.Opt
 		*opts = append(*opts, scopes.WithPrimaryAuthMethodId(c.flagPrimaryAuthMethodId))
 	}
 
+	switch c.Func {
+	case "check-permissions":
+		if c.flagUserId == "" {
+			c.UI.Error("User ID must be passed in via -user-id")
+			return false
+		}
+		if c.flagAction == "" {
+			c.UI.Error("Action must be passed in via -action")
+			return false
+		}
+		if c.flagResourceId == "" && c.flagResourceType == "" {
+			c.UI.Error("Resource ID or type must be passed in via -resource-id or -resource-type")
+			return false
+		}
+		if c.flagResourceId != "" {
+			*opts = append(*opts, scopes.WithResourceId(c.flagResourceId))
+		}
+		if c.flagResourceType != "" {
+			*opts = append(*opts, scopes.WithResourceType(c.flagResourceType))
+		}
+		if c.flagParentId != "" {
+			*opts = append(*opts, scopes.WithParentId(c.flagParentId))
+		}
+		if c.flagClientIp != "" {
+			*opts = append(*opts, scopes.WithClientIp(c.flagClientIp))
+		}
+	}
+
 	return true
 }
 
+func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, scopeClient *scopes.Client, version uint32, opts []scopes.Option) (api.GenericResult, error) {
+	switch c.Func {
+	case "check-permissions":
+		var err error
+		c.plural = "permissions of user"
+		c.pcr, err = scopeClient.CheckPermissions(c.Context, c.FlagId, c.flagUserId, c.flagAction, opts...)
+		return nil, err
+	}
+	return origResult, origError
+}
+
 func (c *Command) printListTable(items []*scopes.Scope) string {
 	if len(items) == 0 {
 		return "No child scopes found"
@@ -195,3 +330,76 @@ func printItemTable(result api.GenericResult) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "check-permissions":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output(printPermissionCheckTable(c.pcr.Item))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.pcr); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func printPermissionCheckTable(item *scopes.PermissionCheck) string {
+	nonAttributeMap := map[string]interface{}{
+		"Scope ID":      item.ScopeId,
+		"User ID":       item.UserId,
+		"Resource Type": item.ResourceType,
+		"Action":        item.Action,
+		"Authorized":    item.Authorized,
+		"Reason":        item.Reason,
+	}
+	if item.ResourceId != "" {
+		nonAttributeMap["Resource ID"] = item.ResourceId
+	}
+	if item.ParentId != "" {
+		nonAttributeMap["Parent ID"] = item.ParentId
+	}
+	if item.MissingGrant != "" {
+		nonAttributeMap["Missing Grant"] = item.MissingGrant
+	}
+
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Permission check information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	for _, grants := range []struct {
+		title  string
+		grants []*scopes.PermissionGrant
+	}{
+		{title: "Allowing Grants", grants: item.AllowingGrants},
+		{title: "Denying Grants", grants: item.DenyingGrants},
+		{title: "Inapplicable Grants", grants: item.InapplicableGrants},
+		{title: "Evaluated Grants", grants: item.EvaluatedGrants},
+	} {
+		if len(grants.grants) == 0 {
+			continue
+		}
+		ret = append(ret,
+			"",
+			fmt.Sprintf("  %s:", grants.title),
+		)
+		for _, g := range grants.grants {
+			ret = append(ret,
+				fmt.Sprintf("    Role ID:             %s", g.RoleId),
+				fmt.Sprintf("      Grant:             %s", g.Grant),
+			)
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
@@ -277,6 +277,10 @@ func (c *Command) Run(args []string) int {
 		c.InfoKeys = append(c.InfoKeys, "worker public proxy addr")
 		c.Info["worker public proxy addr"] = c.Config.Worker.PublicAddr
 
+		if c.Config.Controller != nil && len(c.Config.Worker.Upstreams) > 0 {
+			c.UI.Error(`When running a combined controller and worker, it's invalid to specify an "upstreams" key in the worker block`)
+			return base.CommandUserError
+		}
 		if c.Config.Controller != nil {
 			switch len(c.Config.Worker.Controllers) {
 			case 0:
@@ -337,7 +341,7 @@ func (c *Command) Run(args []string) int {
 			}
 		}
 	}
-	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy"}); err != nil {
+	if err := c.SetupListeners(c.UI, c.Config.SharedConfig, []string{"api", "cluster", "proxy", "metrics"}); err != nil {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
@@ -455,7 +459,7 @@ func (c *Command) Run(args []string) int {
 	c.ReleaseLogGate()
 
 	if c.Config.Controller != nil {
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginHostAws, base.EnabledPluginHostAzure, base.EnabledPluginHostInventory, base.EnabledPluginHostKubernetes)
 		if err := c.StartController(ctx); err != nil {
 			c.UI.Error(err.Error())
 			return base.CommandCliError
@@ -2,6 +2,7 @@ package sessionscmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -12,12 +13,33 @@ import (
 
 func init() {
 	extraActionsFlagsMapFunc = extraActionsFlagsMapFuncImpl
+	extraSynopsisFunc = extraSynopsisFuncImpl
+	extraFlagsFunc = extraFlagsFuncImpl
+	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
+}
+
+type extraCmdVars struct {
+	flagConnectionId string
+	flagOutput       string
+	recordingResult  *sessions.RecordingDownloadResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
+		"cancel":             {"id"},
+		"download-recording": {"id", "connection-id", "output"},
+	}
+}
+
+func extraSynopsisFuncImpl(c *Command) string {
+	switch c.Func {
+	case "download-recording":
+		return "Download the recording of a connection of a session"
+
+	default:
+		return ""
 	}
 }
 
@@ -48,6 +70,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "download-recording":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions download-recording [options] [args]",
+			"",
+			"  Download the recording of a connection of the session specified by ID to a local file. The controller fetches the recording from the worker holding it and verifies it against the size and checksum reported by the worker. Example:",
+			"",
+			`    $ boundary sessions download-recording -id s_1234567890 -connection-id sc_1234567890 -output sc_1234567890.rec`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -55,14 +88,98 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 	return helpStr + c.Flags().Help()
 }
 
+func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
+	for _, name := range flagsMap[c.Func] {
+		switch name {
+		case "connection-id":
+			f.StringVar(&base.StringVar{
+				Name:   "connection-id",
+				Target: &c.flagConnectionId,
+				Usage:  "The ID of the recorded connection of the session.",
+			})
+		case "output":
+			f.StringVar(&base.StringVar{
+				Name:   "output",
+				Target: &c.flagOutput,
+				Usage:  "The file to write the recording to. It must not exist yet.",
+			})
+		}
+	}
+}
+
+func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, _ *[]sessions.Option) bool {
+	switch c.Func {
+	case "download-recording":
+		if c.flagConnectionId == "" {
+			c.UI.Error("Connection ID must be passed in via -connection-id.")
+			return false
+		}
+		if c.flagOutput == "" {
+			c.UI.Error("Output file must be passed in via -output.")
+			return false
+		}
+	}
+	return true
+}
+
 func executeExtraActionsImpl(c *Command, origResult api.GenericResult, origError error, sessionClient *sessions.Client, version uint32, opts []sessions.Option) (api.GenericResult, error) {
 	switch c.Func {
 	case "cancel":
 		return sessionClient.Cancel(c.Context, c.FlagId, version, opts...)
+	case "download-recording":
+		var err error
+		c.recordingResult, err = sessionClient.DownloadRecording(c.Context, c.FlagId, c.flagConnectionId, opts...)
+		return c.recordingResult, err
 	}
 	return origResult, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "download-recording":
+		if err := writeRecording(c.flagOutput, c.recordingResult.Content); err != nil {
+			return false, fmt.Errorf("Error writing recording to %q: %w", c.flagOutput, err)
+		}
+		switch base.Format(c.UI) {
+		case "table":
+			rec := c.recordingResult.Recording
+			c.UI.Output(base.WrapForHelpText([]string{
+				"",
+				"Recording information:",
+				base.WrapMap(2, len("Worker ID")+2, map[string]interface{}{
+					"Worker ID": rec.WorkerId,
+					"Size":      rec.SizeBytes,
+					"Checksum":  rec.Checksum,
+				}),
+				"",
+				fmt.Sprintf("The recording was written to %s.", c.flagOutput),
+			}))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.recordingResult); !ok {
+				return false, fmt.Errorf("Error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// writeRecording writes content to the new file path.
+func writeRecording(path string, content []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 func (c *Command) printListTable(items []*sessions.Session) string {
 	if len(items) == 0 {
 		return "No sessions found"
@@ -170,6 +287,9 @@ func printItemTable(result api.GenericResult) string {
 	if item.Endpoint != "" {
 		nonAttributeMap["Endpoint"] = item.Endpoint
 	}
+	if item.HostSelectionStrategy != "" {
+		nonAttributeMap["Host Selection Strategy"] = item.HostSelectionStrategy
+	}
 	if item.Status != "" {
 		nonAttributeMap["Status"] = item.Status
 	}
@@ -209,6 +329,38 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	var connectionMaps []map[string]interface{}
+	if len(item.Connections) > 0 {
+		for _, conn := range item.Connections {
+			m := map[string]interface{}{
+				"Bytes Up":   conn.BytesUp,
+				"Bytes Down": conn.BytesDown,
+			}
+			if conn.Id != "" {
+				m["ID"] = conn.Id
+			}
+			if conn.ClientTcpAddress != "" {
+				m["Client Address"] = fmt.Sprintf("%s:%d", conn.ClientTcpAddress, conn.ClientTcpPort)
+			}
+			if conn.EndpointTcpAddress != "" {
+				m["Endpoint Address"] = fmt.Sprintf("%s:%d", conn.EndpointTcpAddress, conn.EndpointTcpPort)
+			}
+			if conn.ClosedReason != "" {
+				m["Closed Reason"] = conn.ClosedReason
+			}
+			if rec := conn.Recording; rec != nil {
+				m["Recording Worker ID"] = rec.WorkerId
+				m["Recording Path"] = rec.StoragePath
+				m["Recording Size"] = rec.SizeBytes
+				m["Recording Checksum"] = rec.Checksum
+			}
+			connectionMaps = append(connectionMaps, m)
+		}
+		if l := len("Recording Worker ID"); l > maxLength {
+			maxLength = l
+		}
+	}
+
 	ret := []string{
 		"",
 		"Session information:",
@@ -256,5 +408,17 @@ func printItemTable(result api.GenericResult) string {
 		}
 	}
 
+	if len(item.Connections) > 0 {
+		ret = append(ret,
+			"  Connections:",
+		)
+		for _, m := range connectionMaps {
+			ret = append(ret,
+				base.WrapMap(4, maxLength, m),
+				"",
+			)
+		}
+	}
+
 	return base.WrapForHelpText(ret)
 }
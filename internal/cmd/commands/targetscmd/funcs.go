@@ -671,6 +671,21 @@ func printItemTable(result api.GenericResult) string {
 		if result.GetResponse().Map[globals.SessionMaxSecondsField] != nil {
 			nonAttributeMap["Session Max Seconds"] = item.SessionMaxSeconds
 		}
+		if result.GetResponse().Map[globals.UserSessionLimitField] != nil {
+			nonAttributeMap["User Session Limit"] = item.UserSessionLimit
+		}
+		if result.GetResponse().Map[globals.SessionMaxBytesUpField] != nil {
+			nonAttributeMap["Session Max Bytes Up"] = item.SessionMaxBytesUp
+		}
+		if result.GetResponse().Map[globals.SessionMaxBytesDownField] != nil {
+			nonAttributeMap["Session Max Bytes Down"] = item.SessionMaxBytesDown
+		}
+		if result.GetResponse().Map[globals.ConnectionIdleTimeoutSecondsField] != nil {
+			nonAttributeMap["Connection Idle Timeout Seconds"] = item.ConnectionIdleTimeoutSeconds
+		}
+		if item.HostSelectionStrategy != "" {
+			nonAttributeMap["Host Selection Strategy"] = item.HostSelectionStrategy
+		}
 	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, item.Attributes, keySubstMap)
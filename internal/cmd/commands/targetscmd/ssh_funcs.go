@@ -0,0 +1,278 @@
+package targetscmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSshActionsFlagsMapFunc = extraSshActionsFlagsMapFuncImpl
+	extraSshFlagsFunc = extraSshFlagsFuncImpl
+	extraSshFlagsHandlingFunc = extraSshFlagsHandlingFuncImpl
+}
+
+func extraSshActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "user-session-limit", "session-max-bytes-up", "session-max-bytes-down", "connection-idle-timeout-seconds", "host-selection-strategy", "worker-filter", "known-hosts"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "user-session-limit", "session-max-bytes-up", "session-max-bytes-down", "connection-idle-timeout-seconds", "host-selection-strategy", "worker-filter", "known-hosts"},
+	}
+}
+
+type extraSshCmdVars struct {
+	flagDefaultPort                  string
+	flagSessionMaxSeconds            string
+	flagSessionConnectionLimit       string
+	flagUserSessionLimit             string
+	flagSessionMaxBytesUp            string
+	flagSessionMaxBytesDown          string
+	flagConnectionIdleTimeoutSeconds string
+	flagHostSelectionStrategy        string
+	flagWorkerFilter                 string
+	flagKnownHosts                   string
+}
+
+func (c *SshCommand) extraSshHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create ssh [options] [args]",
+			"",
+			"  Create an ssh-type target. Example:",
+			"",
+			`    $ boundary targets create ssh -name prodops -description "SSH target for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update ssh [options] [args]",
+			"",
+			"  Update an ssh-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update ssh -id tssh_1234567890 -name "devops" -description "SSH target for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("SSH Target Options")
+
+	for _, name := range flagsSshMap[c.Func] {
+		switch name {
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "The default port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "user-session-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "user-session-limit",
+				Target: &c.flagUserSessionLimit,
+				Usage:  "The maximum number of pending or active sessions a user can have at the same time for the target. -1 means unlimited.",
+			})
+		case "session-max-bytes-up":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-bytes-up",
+				Target: &c.flagSessionMaxBytesUp,
+				Usage:  "The maximum number of bytes sent from the client to the endpoint over all the connections of a session. -1 means unlimited.",
+			})
+		case "session-max-bytes-down":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-bytes-down",
+				Target: &c.flagSessionMaxBytesDown,
+				Usage:  "The maximum number of bytes sent from the endpoint to the client over all the connections of a session. -1 means unlimited.",
+			})
+		case "connection-idle-timeout-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "connection-idle-timeout-seconds",
+				Target: &c.flagConnectionIdleTimeoutSeconds,
+				Usage:  `The time after which a connection with no data sent in either direction is closed. Can be specified as an integer number of seconds or a duration string. 0 means connections never time out.`,
+			})
+		case "host-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-selection-strategy",
+				Target: &c.flagHostSelectionStrategy,
+				Usage:  `The strategy used to choose the host of a session when no host is requested: "random", "round_robin" to take turns between the hosts, "least_sessions" to choose the host with the fewest active sessions, or "sticky_per_user" to keep choosing the same host for a user. Defaults to "random".`,
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "A boolean expression to filter which workers can handle sessions for this target.",
+			})
+		case "known-hosts":
+			fs.StringVar(&base.StringVar{
+				Name:   "known-hosts",
+				Target: &c.flagKnownHosts,
+				Usage:  `The host keys, in the OpenSSH known_hosts format, used to verify the endpoints of the target. "@cert-authority" lines trust the host certificates signed by a CA. Without them, passwords are never sent to the endpoints. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.`,
+			})
+		}
+	}
+}
+
+func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagUserSessionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultUserSessionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagUserSessionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagUserSessionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithUserSessionLimit(int32(limit)))
+	}
+
+	switch c.flagSessionMaxBytesUp {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxBytesUp())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionMaxBytesUp, 10, 64)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxBytesUp, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionMaxBytesUp(limit))
+	}
+
+	switch c.flagSessionMaxBytesDown {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxBytesDown())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionMaxBytesDown, 10, 64)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxBytesDown, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionMaxBytesDown(limit))
+	}
+
+	switch c.flagConnectionIdleTimeoutSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultConnectionIdleTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagConnectionIdleTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagConnectionIdleTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagConnectionIdleTimeoutSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithConnectionIdleTimeoutSeconds(final))
+	}
+
+	switch c.flagHostSelectionStrategy {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHostSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithHostSelectionStrategy(c.flagHostSelectionStrategy))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagKnownHosts {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetKnownHosts())
+	default:
+		knownHosts, err := parseutil.ParsePath(c.flagKnownHosts)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKnownHosts, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetKnownHosts(knownHosts))
+	}
+
+	return true
+}
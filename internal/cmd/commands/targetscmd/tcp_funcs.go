@@ -18,16 +18,21 @@ func init() {
 
 func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
-		"update": {"default-port", "session-max-seconds", "session-connection-limit", "worker-filter"},
+		"create": {"default-port", "session-max-seconds", "session-connection-limit", "user-session-limit", "session-max-bytes-up", "session-max-bytes-down", "connection-idle-timeout-seconds", "host-selection-strategy", "worker-filter"},
+		"update": {"default-port", "session-max-seconds", "session-connection-limit", "user-session-limit", "session-max-bytes-up", "session-max-bytes-down", "connection-idle-timeout-seconds", "host-selection-strategy", "worker-filter"},
 	}
 }
 
 type extraTcpCmdVars struct {
-	flagDefaultPort            string
-	flagSessionMaxSeconds      string
-	flagSessionConnectionLimit string
-	flagWorkerFilter           string
+	flagDefaultPort                  string
+	flagSessionMaxSeconds            string
+	flagSessionConnectionLimit       string
+	flagUserSessionLimit             string
+	flagSessionMaxBytesUp            string
+	flagSessionMaxBytesDown          string
+	flagConnectionIdleTimeoutSeconds string
+	flagHostSelectionStrategy        string
+	flagWorkerFilter                 string
 }
 
 func (c *TcpCommand) extraTcpHelpFunc(helpMap map[string]func() string) string {
@@ -81,6 +86,36 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagSessionConnectionLimit,
 				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
 			})
+		case "user-session-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "user-session-limit",
+				Target: &c.flagUserSessionLimit,
+				Usage:  "The maximum number of pending or active sessions a user can have at the same time for the target. -1 means unlimited.",
+			})
+		case "session-max-bytes-up":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-bytes-up",
+				Target: &c.flagSessionMaxBytesUp,
+				Usage:  "The maximum number of bytes sent from the client to the endpoint over all the connections of a session. -1 means unlimited.",
+			})
+		case "session-max-bytes-down":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-bytes-down",
+				Target: &c.flagSessionMaxBytesDown,
+				Usage:  "The maximum number of bytes sent from the endpoint to the client over all the connections of a session. -1 means unlimited.",
+			})
+		case "connection-idle-timeout-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "connection-idle-timeout-seconds",
+				Target: &c.flagConnectionIdleTimeoutSeconds,
+				Usage:  `The time after which a connection with no data sent in either direction is closed. Can be specified as an integer number of seconds or a duration string. 0 means connections never time out.`,
+			})
+		case "host-selection-strategy":
+			fs.StringVar(&base.StringVar{
+				Name:   "host-selection-strategy",
+				Target: &c.flagHostSelectionStrategy,
+				Usage:  `The strategy used to choose the host of a session when no host is requested: "random", "round_robin" to take turns between the hosts, "least_sessions" to choose the host with the fewest active sessions, or "sticky_per_user" to keep choosing the same host for a user. Defaults to "random".`,
+			})
 		case "worker-filter":
 			fs.StringVar(&base.StringVar{
 				Name:   "worker-filter",
@@ -138,6 +173,73 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
 	}
 
+	switch c.flagUserSessionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultUserSessionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagUserSessionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagUserSessionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithUserSessionLimit(int32(limit)))
+	}
+
+	switch c.flagSessionMaxBytesUp {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxBytesUp())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionMaxBytesUp, 10, 64)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxBytesUp, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionMaxBytesUp(limit))
+	}
+
+	switch c.flagSessionMaxBytesDown {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxBytesDown())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionMaxBytesDown, 10, 64)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxBytesDown, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionMaxBytesDown(limit))
+	}
+
+	switch c.flagConnectionIdleTimeoutSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultConnectionIdleTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagConnectionIdleTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagConnectionIdleTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagConnectionIdleTimeoutSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithConnectionIdleTimeoutSeconds(final))
+	}
+
+	switch c.flagHostSelectionStrategy {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultHostSelectionStrategy())
+	default:
+		*opts = append(*opts, targets.WithHostSelectionStrategy(c.flagHostSelectionStrategy))
+	}
+
 	switch c.flagWorkerFilter {
 	case "":
 	case "null":
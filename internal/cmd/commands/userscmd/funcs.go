@@ -2,6 +2,7 @@ package userscmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,10 +22,13 @@ func init() {
 
 type extraCmdVars struct {
 	flagAccounts []string
+	flagDisabled string
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
+		"create":          {"disabled"},
+		"update":          {"disabled"},
 		"add-accounts":    {"id", "account", "version"},
 		"set-accounts":    {"id", "account", "version"},
 		"remove-accounts": {"id", "account", "version"},
@@ -100,11 +104,30 @@ func extraFlagsFuncImpl(c *Command, _ *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagAccounts,
 				Usage:  "The accounts to add, remove, or set. May be specified multiple times.",
 			})
+		case "disabled":
+			f.StringVar(&base.StringVar{
+				Name:   "disabled",
+				Target: &c.flagDisabled,
+				Usage:  "If true, the user cannot authenticate, and its sessions are canceled and auth tokens revoked.",
+			})
 		}
 	}
 }
 
 func extraFlagsHandlingFuncImpl(c *Command, _ *base.FlagSets, opts *[]users.Option) bool {
+	switch c.flagDisabled {
+	case "":
+	case "null":
+		*opts = append(*opts, users.DefaultDisabled())
+	default:
+		disabled, err := strconv.ParseBool(c.flagDisabled)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q for -disabled: %s", c.flagDisabled, err))
+			return false
+		}
+		*opts = append(*opts, users.WithDisabled(disabled))
+	}
+
 	switch c.Func {
 	case "add-accounts", "remove-accounts":
 		if len(c.flagAccounts) == 0 {
@@ -203,6 +226,11 @@ func (c *Command) printListTable(items []*users.User) string {
 				fmt.Sprintf("    Email:               %s", item.Email),
 			)
 		}
+		if item.Disabled {
+			output = append(output,
+				fmt.Sprintf("    Disabled:            %t", item.Disabled),
+			)
+		}
 
 		if len(item.AuthorizedActions) > 0 {
 			output = append(output,
@@ -248,6 +276,9 @@ func printItemTable(result api.GenericResult) string {
 	if item.Email != "" {
 		nonAttributeMap["Email"] = item.Email
 	}
+	if item.Disabled {
+		nonAttributeMap["Disabled"] = item.Disabled
+	}
 
 	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
 
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/types/scope"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/hashicorp/go-secure-stdlib/configutil"
@@ -140,6 +141,16 @@ type Controller struct {
 	//
 	// TODO: This field is currently internal.
 	SchedulerRunJobInterval time.Duration `hcl:"-"`
+
+	// MaxRecordingDownloadSize is the maximum size of the recordings of
+	// connections which can be downloaded, as a capacity string or a number
+	// of bytes.
+	MaxRecordingDownloadSize      interface{} `hcl:"max_recording_download_size"`
+	MaxRecordingDownloadSizeBytes uint64
+
+	// Scim configures SCIM 2.0 provisioning endpoints, each scoped to an org
+	// and authenticated with its own bearer token.
+	Scim []*Scim `hcl:"scim"`
 }
 
 func (c *Controller) InitNameIfEmpty() (string, error) {
@@ -163,6 +174,13 @@ type Worker struct {
 	Controllers    []string    `hcl:"-"`
 	ControllersRaw interface{} `hcl:"controllers"`
 
+	// Upstreams are the addresses of the proxy listeners of other workers,
+	// through which this worker connects to the controllers when it cannot
+	// reach them directly. It is parsed like Controllers, and only one of
+	// the two can be set.
+	Upstreams    []string    `hcl:"-"`
+	UpstreamsRaw interface{} `hcl:"upstreams"`
+
 	// We use a raw interface for parsing so that people can use JSON-like
 	// syntax that maps directly to the filter input or possibly more familiar
 	// key=value syntax, as well as accepting a string denoting an env or file
@@ -176,6 +194,11 @@ type Worker struct {
 	//
 	// TODO: This field is currently internal.
 	StatusGracePeriodDuration time.Duration `hcl:"-"`
+
+	// RecordingStoragePath is the directory in which the worker stores
+	// recordings of proxied connections. Connections are only recorded when
+	// this is set.
+	RecordingStoragePath string `hcl:"recording_storage_path"`
 }
 
 func (w *Worker) InitNameIfEmpty() (string, error) {
@@ -205,6 +228,17 @@ type Database struct {
 	MaxOpenConnectionsRaw interface{} `hcl:"max_open_connections"`
 }
 
+// Scim configures SCIM 2.0 provisioning of the users and groups of an org.
+type Scim struct {
+	// ScopeId is the ID of the org users and groups are provisioned in. It
+	// is the label of the block.
+	ScopeId string `hcl:",key"`
+
+	// BearerToken authenticates requests from the SCIM client. It can refer
+	// to an env var or file.
+	BearerToken string `hcl:"bearer_token"`
+}
+
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
 }
@@ -341,6 +375,14 @@ func Parse(d string) (*Config, error) {
 			result.Controller.AuthTokenTimeToStaleDuration = t
 		}
 
+		if result.Controller.MaxRecordingDownloadSize != nil {
+			size, err := parseutil.ParseCapacityString(result.Controller.MaxRecordingDownloadSize)
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing max recording download size: %w", err)
+			}
+			result.Controller.MaxRecordingDownloadSizeBytes = size
+		}
+
 		if result.Controller.Database != nil {
 			if result.Controller.Database.MaxOpenConnectionsRaw != nil {
 				switch t := result.Controller.Database.MaxOpenConnectionsRaw.(type) {
@@ -361,6 +403,24 @@ func Parse(d string) (*Config, error) {
 				}
 			}
 		}
+
+		tokens := make(map[string]bool, len(result.Controller.Scim))
+		for _, scim := range result.Controller.Scim {
+			if !strings.HasPrefix(scim.ScopeId, scope.Org.Prefix()+"_") {
+				return nil, fmt.Errorf("SCIM scope ID %q is not an org ID", scim.ScopeId)
+			}
+			scim.BearerToken, err = parseutil.ParsePath(scim.BearerToken)
+			if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+				return nil, fmt.Errorf("Error parsing SCIM bearer token: %w", err)
+			}
+			if scim.BearerToken == "" {
+				return nil, fmt.Errorf("SCIM bearer token for scope %q is empty", scim.ScopeId)
+			}
+			if tokens[scim.BearerToken] {
+				return nil, errors.New("SCIM bearer tokens must be unique")
+			}
+			tokens[scim.BearerToken] = true
+		}
 	}
 
 	// Parse worker tags
@@ -490,6 +550,14 @@ func Parse(d string) (*Config, error) {
 		if err != nil {
 			return nil, fmt.Errorf("Failed to parse worker controllers: %w", err)
 		}
+
+		result.Worker.Upstreams, err = parseWorkerUpstreams(result)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse worker upstreams: %w", err)
+		}
+		if len(result.Worker.Controllers) > 0 && len(result.Worker.Upstreams) > 0 {
+			return nil, errors.New("Worker controllers and upstreams cannot both be set")
+		}
 	}
 
 	sharedConfig, err := configutil.ParseConfig(d)
@@ -538,6 +606,16 @@ func Parse(d string) (*Config, error) {
 		return nil, fmt.Errorf(`too many "events" nodes (max 1, got %d)`, len(eventList.Items))
 	}
 
+	// Audit integrity chains the audit events with the audit key, which is
+	// only available to controllers.
+	if result.Worker != nil && result.Controller == nil {
+		for _, s := range result.Eventing.Sinks {
+			if s.AuditConfig != nil && s.AuditConfig.IntegrityEnabled {
+				return nil, fmt.Errorf(`error parsing "events": sink %q: audit integrity is only supported by controllers`, s.Name)
+			}
+		}
+	}
+
 	if result.Plugins.ExecutionDir != "" {
 		result.Plugins.ExecutionDir, err = parseutil.ParsePath(result.Plugins.ExecutionDir)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
@@ -552,27 +630,41 @@ func parseWorkerControllers(c *Config) ([]string, error) {
 	if c == nil || c.Worker == nil {
 		return nil, fmt.Errorf("config or worker field is nil")
 	}
-	if c.Worker.ControllersRaw == nil {
+	return parseWorkerAddresses("controllers", c.Worker.ControllersRaw)
+}
+
+func parseWorkerUpstreams(c *Config) ([]string, error) {
+	if c == nil || c.Worker == nil {
+		return nil, fmt.Errorf("config or worker field is nil")
+	}
+	return parseWorkerAddresses("upstreams", c.Worker.UpstreamsRaw)
+}
+
+// parseWorkerAddresses parses the addresses of the worker block named name,
+// given either directly as an array or as an env var or file pointer to a
+// JSON array.
+func parseWorkerAddresses(name string, raw interface{}) ([]string, error) {
+	if raw == nil {
 		return nil, nil
 	}
 
-	switch t := c.Worker.ControllersRaw.(type) {
+	switch t := raw.(type) {
 	case []interface{}: // An array was configured directly in Boundary's HCL Config file.
-		var controllers []string
-		err := mapstructure.WeakDecode(c.Worker.ControllersRaw, &controllers)
+		var addrs []string
+		err := mapstructure.WeakDecode(raw, &addrs)
 		if err != nil {
-			return nil, fmt.Errorf("failed to decode worker controllers block into config field: %w", err)
+			return nil, fmt.Errorf("failed to decode worker %s block into config field: %w", name, err)
 		}
-		return controllers, nil
+		return addrs, nil
 
 	case string:
-		controllersStr, err := parseutil.ParsePath(t)
+		addrsStr, err := parseutil.ParsePath(t)
 		if err != nil {
 			return nil, fmt.Errorf("bad env var or file pointer: %w", err)
 		}
 
 		var addrs []string
-		err = json.Unmarshal([]byte(controllersStr), &addrs)
+		err = json.Unmarshal([]byte(addrsStr), &addrs)
 		if err != nil {
 			return nil, fmt.Errorf("failed to unmarshal env/file contents: %w", err)
 		}
@@ -617,6 +709,10 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				s.Type = event.StderrSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
+			case s.WebhookConfig != nil:
+				s.Type = event.WebhookSink
+			case s.SyslogConfig != nil:
+				s.Type = event.SyslogSink
 			default:
 				return nil, fmt.Errorf("sink type could not be determined")
 			}
@@ -638,6 +734,23 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		// parse the duration strings specified in a webhook config into
+		// time.Durations
+		if s.WebhookConfig != nil && s.WebhookConfig.FlushIntervalHCL != "" {
+			var err error
+			s.WebhookConfig.FlushInterval, err = parseutil.ParseDurationSecond(s.WebhookConfig.FlushIntervalHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse flush interval %s", s.WebhookConfig.FlushIntervalHCL)
+			}
+		}
+		if s.WebhookConfig != nil && s.WebhookConfig.TimeoutHCL != "" {
+			var err error
+			s.WebhookConfig.Timeout, err = parseutil.ParseDurationSecond(s.WebhookConfig.TimeoutHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse webhook timeout %s", s.WebhookConfig.TimeoutHCL)
+			}
+		}
+
 		if err := s.Validate(); err != nil {
 			return nil, err
 		}
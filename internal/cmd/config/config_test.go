@@ -683,6 +683,30 @@ func TestController_EventingConfig(t *testing.T) {
 			},
 			wantErr: `error parsing "events": sink type could not be determined`,
 		},
+		{
+			name: "worker-audit-integrity",
+			config: []string{
+				`worker {
+				name = "w_1234567890"
+			}
+			events {
+				audit_enabled = true
+				sink {
+					type = "file"
+					format = "cloudevents-json"
+					name = "audit-sink"
+					event_types = [ "audit" ]
+					file {
+						file_name = "audit.log"
+					}
+					audit_config {
+						integrity_enabled = true
+					}
+				}
+			}`,
+			},
+			wantErr: `error parsing "events": sink "audit-sink": audit integrity is only supported by controllers`,
+		},
 		{
 			name: "sinks-configured",
 			config: []string{
@@ -793,6 +817,73 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "webhook-and-syslog-sinks-configured",
+			config: []string{
+				`events {
+				audit_enabled = true
+				sink "webhook" {
+					format = "cloudevents-json"
+					name = "webhook-sink"
+					event_types = [ "audit" ]
+					webhook {
+						url = "https://events.example.com/boundary"
+						headers = {
+							Authorization = "Bearer token"
+						}
+						batch_size = 50
+						flush_interval = "2s"
+						timeout = "30s"
+						max_retries = 0
+						delivery_guarantee = "enforced"
+					}
+				}
+				sink {
+					format = "cloudevents-text"
+					name = "syslog-sink"
+					event_types = [ "error" ]
+					syslog {
+						network = "tls"
+						address = "syslog.example.com:6514"
+						facility = "local3"
+					}
+				}
+			}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "webhook",
+						Name:       "webhook-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						WebhookConfig: &event.WebhookSinkTypeConfig{
+							Url:               "https://events.example.com/boundary",
+							Headers:           map[string]string{"Authorization": "Bearer token"},
+							BatchSize:         50,
+							FlushIntervalHCL:  "2s",
+							FlushInterval:     2 * time.Second,
+							TimeoutHCL:        "30s",
+							Timeout:           30 * time.Second,
+							MaxRetries:        func() *int { i := 0; return &i }(),
+							DeliveryGuarantee: event.Enforced,
+						},
+					},
+					{
+						Type:       "syslog",
+						Name:       "syslog-sink",
+						Format:     "cloudevents-text",
+						EventTypes: []event.Type{"error"},
+						SyslogConfig: &event.SyslogSinkTypeConfig{
+							Network:  "tls",
+							Address:  "syslog.example.com:6514",
+							Facility: "local3",
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -953,6 +1044,215 @@ func TestWorkerControllers(t *testing.T) {
 	}
 }
 
+func TestWorkerUpstreams(t *testing.T) {
+	tests := []struct {
+		name               string
+		in                 string
+		stateFn            func(t *testing.T)
+		expWorkerUpstreams []string
+		expErr             bool
+		expErrStr          string
+	}{
+		{
+			name: "No Upstreams",
+			in: `
+			worker {
+				name = "test"
+			}
+			`,
+			expWorkerUpstreams: nil,
+			expErr:             false,
+		},
+		{
+			name: "Multiple upstreams",
+			in: `
+			worker {
+				name = "test"
+				upstreams = ["127.0.0.1", "127.0.0.2:9203"]
+			}
+			`,
+			expWorkerUpstreams: []string{"127.0.0.1", "127.0.0.2:9203"},
+			expErr:             false,
+		},
+		{
+			name: "Using env var",
+			in: `
+			worker {
+				name = "test"
+				upstreams = "env://BOUNDARY_WORKER_UPSTREAMS"
+			}
+			`,
+			stateFn:            func(t *testing.T) { t.Setenv("BOUNDARY_WORKER_UPSTREAMS", `["127.0.0.1", "127.0.0.2"]`) },
+			expWorkerUpstreams: []string{"127.0.0.1", "127.0.0.2"},
+			expErr:             false,
+		},
+		{
+			name: "Using env var - invalid input",
+			in: `
+			worker {
+				name = "test"
+				upstreams = "env://BOUNDARY_WORKER_UPSTREAMS"
+			}
+			`,
+			stateFn:            func(t *testing.T) { t.Setenv("BOUNDARY_WORKER_UPSTREAMS", `upstreams = ["127.0.0.1"]`) },
+			expWorkerUpstreams: nil,
+			expErr:             true,
+			expErrStr:          "Failed to parse worker upstreams: failed to unmarshal env/file contents: invalid character 'u' looking for beginning of value",
+		},
+		{
+			name: "Controllers and upstreams",
+			in: `
+			worker {
+				name = "test"
+				controllers = ["127.0.0.1"]
+				upstreams = ["127.0.0.2"]
+			}
+			`,
+			expWorkerUpstreams: nil,
+			expErr:             true,
+			expErrStr:          "Worker controllers and upstreams cannot both be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.stateFn != nil {
+				tt.stateFn(t)
+			}
+
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Worker)
+			require.EqualValues(t, tt.expWorkerUpstreams, c.Worker.Upstreams)
+		})
+	}
+}
+
+func TestControllerScim(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		stateFn   func(t *testing.T)
+		expScim   []*Scim
+		expErr    bool
+		expErrStr string
+	}{
+		{
+			name: "No scim",
+			in: `
+			controller {
+				name = "test"
+			}
+			`,
+			expScim: nil,
+			expErr:  false,
+		},
+		{
+			name: "Multiple orgs",
+			in: `
+			controller {
+				name = "test"
+				scim "o_1234567890" {
+					bearer_token = "token1"
+				}
+				scim "o_0987654321" {
+					bearer_token = "token2"
+				}
+			}
+			`,
+			expScim: []*Scim{
+				{ScopeId: "o_1234567890", BearerToken: "token1"},
+				{ScopeId: "o_0987654321", BearerToken: "token2"},
+			},
+			expErr: false,
+		},
+		{
+			name: "Using env var",
+			in: `
+			controller {
+				name = "test"
+				scim "o_1234567890" {
+					bearer_token = "env://BOUNDARY_SCIM_TOKEN"
+				}
+			}
+			`,
+			stateFn: func(t *testing.T) { t.Setenv("BOUNDARY_SCIM_TOKEN", "token1") },
+			expScim: []*Scim{
+				{ScopeId: "o_1234567890", BearerToken: "token1"},
+			},
+			expErr: false,
+		},
+		{
+			name: "Not an org",
+			in: `
+			controller {
+				name = "test"
+				scim "global" {
+					bearer_token = "token1"
+				}
+			}
+			`,
+			expErr:    true,
+			expErrStr: `SCIM scope ID "global" is not an org ID`,
+		},
+		{
+			name: "Empty token",
+			in: `
+			controller {
+				name = "test"
+				scim "o_1234567890" {
+					}
+			}
+			`,
+			expErr:    true,
+			expErrStr: `SCIM bearer token for scope "o_1234567890" is empty`,
+		},
+		{
+			name: "Duplicate tokens",
+			in: `
+			controller {
+				name = "test"
+				scim "o_1234567890" {
+					bearer_token = "token1"
+				}
+				scim "o_0987654321" {
+					bearer_token = "token1"
+				}
+			}
+			`,
+			expErr:    true,
+			expErrStr: "SCIM bearer tokens must be unique",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.stateFn != nil {
+				tt.stateFn(t)
+			}
+
+			c, err := Parse(tt.in)
+			if tt.expErr {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.EqualValues(t, tt.expScim, c.Controller.Scim)
+		})
+	}
+}
+
 func TestControllerDescription(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -1200,3 +1500,64 @@ func TestDatabaseMaxConnections(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerMaxRecordingDownloadSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		expSize   uint64
+		expErrStr string
+	}{
+		{
+			name: "Not set",
+			in: `
+			controller {
+				name = "example-controller"
+			}`,
+			expSize: 0,
+		},
+		{
+			name: "Capacity string",
+			in: `
+			controller {
+				name = "example-controller"
+				max_recording_download_size = "16MiB"
+			}`,
+			expSize: 16 << 20,
+		},
+		{
+			name: "Number of bytes",
+			in: `
+			controller {
+				name = "example-controller"
+				max_recording_download_size = 1024
+			}`,
+			expSize: 1024,
+		},
+		{
+			name: "Invalid value",
+			in: `
+			controller {
+				name = "example-controller"
+				max_recording_download_size = "lots"
+			}`,
+			expErrStr: "Error parsing max recording download size",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expErrStr)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			require.NotNil(t, c.Controller)
+			require.Equal(t, tt.expSize, c.Controller.MaxRecordingDownloadSizeBytes)
+		})
+	}
+}
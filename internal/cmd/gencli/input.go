@@ -82,6 +82,19 @@ type cmdInfo struct {
 }
 
 var inputStructs = map[string][]*cmdInfo{
+	"accessrequests": {
+		{
+			ResourceType:        resource.AccessRequest.String(),
+			Pkg:                 "accessrequests",
+			StdActions:          []string{"create", "read", "list"},
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			Container:           "Scope",
+			VersionedActions:    []string{"approve", "deny", "cancel"},
+		},
+	},
 	"accounts": {
 		{
 			ResourceType:        resource.Account.String(),
@@ -124,6 +137,20 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
+		{
+			ResourceType:        resource.Account.String(),
+			Pkg:                 "accounts",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "ldap",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "AuthMethod",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
 	},
 	"authmethods": {
 		{
@@ -165,6 +192,21 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update", "change-state"},
 			NeedsSubtypeInCreate: true,
 		},
+		{
+			ResourceType:         resource.AuthMethod.String(),
+			Pkg:                  "authmethods",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "ldap",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
 	},
 	"authtokens": {
 		{
@@ -200,6 +242,20 @@ var inputStructs = map[string][]*cmdInfo{
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialStore.String(),
+			Pkg:                  "credentialstores",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "static",
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
 	},
 	"credentiallibraries": {
 		{
@@ -227,6 +283,65 @@ var inputStructs = map[string][]*cmdInfo{
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
 	},
+	"credentials": {
+		{
+			ResourceType:     resource.Credential.String(),
+			Pkg:              "credentials",
+			StdActions:       []string{"read", "delete", "list"},
+			IsAbstractType:   true,
+			HasExtraHelpFunc: true,
+			Container:        "CredentialStore",
+			HasId:            true,
+		},
+		{
+			ResourceType:         resource.Credential.String(),
+			Pkg:                  "credentials",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "user_password",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
+		{
+			ResourceType:         resource.Credential.String(),
+			Pkg:                  "credentials",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "ssh_private_key",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
+		{
+			ResourceType:         resource.Credential.String(),
+			Pkg:                  "credentials",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "json",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
+	},
 	"groups": {
 		{
 			ResourceType:        resource.Group.String(),
@@ -298,17 +413,18 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:    []string{"add-hosts", "set-hosts", "remove-hosts"},
 		},
 		{
-			ResourceType:     resource.HostSet.String(),
-			Pkg:              "hostsets",
-			StdActions:       []string{"create", "update"},
-			SubActionPrefix:  "static",
-			SkipNormalHelp:   true,
-			HasExtraHelpFunc: true,
-			HasId:            true,
-			HasName:          true,
-			Container:        "HostCatalog",
-			HasDescription:   true,
-			VersionedActions: []string{"update"},
+			ResourceType:        resource.HostSet.String(),
+			Pkg:                 "hostsets",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "static",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "HostCatalog",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
 		},
 		{
 			ResourceType:         resource.HostSet.String(),
@@ -378,6 +494,20 @@ var inputStructs = map[string][]*cmdInfo{
 			HasDescription:      true,
 			VersionedActions:    []string{"update"},
 		},
+		{
+			ResourceType:        resource.ManagedGroup.String(),
+			Pkg:                 "managedgroups",
+			StdActions:          []string{"create", "update"},
+			SubActionPrefix:     "ldap",
+			HasExtraCommandVars: true,
+			SkipNormalHelp:      true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			HasName:             true,
+			Container:           "AuthMethod",
+			HasDescription:      true,
+			VersionedActions:    []string{"update"},
+		},
 	},
 	"roles": {
 		{
@@ -399,6 +529,7 @@ var inputStructs = map[string][]*cmdInfo{
 			Pkg:                 "scopes",
 			StdActions:          []string{"create", "read", "update", "delete", "list"},
 			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
 			HasId:               true,
 			Container:           "Scope",
 			HasName:             true,
@@ -408,13 +539,14 @@ var inputStructs = map[string][]*cmdInfo{
 	},
 	"sessions": {
 		{
-			ResourceType:     resource.Session.String(),
-			Pkg:              "sessions",
-			StdActions:       []string{"read", "list"},
-			Container:        "Scope",
-			HasExtraHelpFunc: true,
-			HasId:            true,
-			VersionedActions: []string{"cancel"},
+			ResourceType:        resource.Session.String(),
+			Pkg:                 "sessions",
+			StdActions:          []string{"read", "list"},
+			Container:           "Scope",
+			HasExtraCommandVars: true,
+			HasExtraHelpFunc:    true,
+			HasId:               true,
+			VersionedActions:    []string{"cancel"},
 		},
 	},
 	"targets": {
@@ -446,6 +578,21 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update"},
 			NeedsSubtypeInCreate: true,
 		},
+		{
+			ResourceType:         resource.Target.String(),
+			Pkg:                  "targets",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "ssh",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			Container:            "Scope",
+			HasDescription:       true,
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+		},
 	},
 	"users": {
 		{
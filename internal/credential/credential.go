@@ -23,8 +23,11 @@ type Type string
 
 // Credential type values.
 const (
-	UnspecifiedType  Type = "unspecified"
-	UserPasswordType Type = "user_password"
+	UnspecifiedType    Type = "unspecified"
+	UserPasswordType   Type = "user_password"
+	SshPrivateKeyType  Type = "ssh_private_key"
+	JsonType           Type = "json"
+	SshCertificateType Type = "ssh_certificate"
 )
 
 // A Library is a resource that provides credentials that are of the same
@@ -83,6 +86,15 @@ type Dynamic interface {
 	Purpose() Purpose
 }
 
+// Static is a credential stored in a credential store. Unlike a Dynamic
+// credential, the same Static credential is used for every session.
+type Static interface {
+	Credential
+	boundary.Resource
+	GetStoreId() string
+	CredentialType() Type
+}
+
 // A Request represents a request for a credential from the SourceId for
 // the given purpose. For dynamic credentials, the SourceId is the PublicId
 // of a credential library.
@@ -129,6 +141,15 @@ type KeyPair interface {
 	Private() PrivateKey
 }
 
+// SshCertificate is a credential containing a username, a signed ssh
+// certificate and the private key for the certificate.
+type SshCertificate interface {
+	Credential
+	Username() string
+	Certificate() []byte
+	Private() PrivateKey
+}
+
 // Certificate is a credential containing a certificate and the private key
 // for the certificate.
 type Certificate interface {
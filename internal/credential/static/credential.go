@@ -0,0 +1,72 @@
+package static
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+// staticCredential is implemented by each of the credential types in the
+// static package. It allows the repository to handle the different
+// credential types uniformly.
+type staticCredential interface {
+	credential.Static
+	GetKeyId() string
+	TableName() string
+	SetTableName(string)
+
+	setId(string)
+	clone() staticCredential
+	validate(ctx context.Context, caller errors.Op) error
+	encrypt(ctx context.Context, cipher wrapping.Wrapper) error
+	decrypt(ctx context.Context, cipher wrapping.Wrapper) error
+	// redact removes the plain-text and cipher-text of the secret from the
+	// credential. The hmac of the secret is retained.
+	redact()
+	// updateMasks converts the fieldMask into the database field mask and
+	// null fields for an update of the credential. A change to the secret
+	// of the credential is reported in secretChanged.
+	updateMasks(ctx context.Context, caller errors.Op, fieldMask []string) (dbMask, nullFields []string, secretChanged bool, err error)
+	oplog(op oplog.OpType) oplog.Metadata
+}
+
+// allocCredential returns an empty credential of the type identified by
+// the prefix of publicId. It returns nil if publicId is not the id of a
+// static credential.
+func allocCredential(publicId string) staticCredential {
+	switch {
+	case hasPrefix(publicId, UsernamePasswordCredentialPrefix):
+		return allocUsernamePasswordCredential()
+	case hasPrefix(publicId, SshPrivateKeyCredentialPrefix):
+		return allocSshPrivateKeyCredential()
+	case hasPrefix(publicId, JsonCredentialPrefix):
+		return allocJsonCredential()
+	}
+	return nil
+}
+
+func hasPrefix(publicId, prefix string) bool {
+	return strings.HasPrefix(publicId, prefix+"_")
+}
+
+func credentialOplog(c staticCredential, resourceType string, op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.GetPublicId()},
+		"resource-type":      []string{resourceType},
+		"op-type":            []string{op.String()},
+	}
+	if c.GetStoreId() != "" {
+		metadata["store-id"] = []string{c.GetStoreId()}
+	}
+	return metadata
+}
+
+// secretMasks returns the database field mask for an update of the
+// encrypted secret stored in ctField.
+func secretMasks(ctField, hmacField string) []string {
+	return []string{ctField, hmacField, "KeyId"}
+}
@@ -0,0 +1,137 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocCredential(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	assert.IsType(&UsernamePasswordCredential{}, allocCredential(UsernamePasswordCredentialPrefix+"_1234567890"))
+	assert.IsType(&SshPrivateKeyCredential{}, allocCredential(SshPrivateKeyCredentialPrefix+"_1234567890"))
+	assert.IsType(&JsonCredential{}, allocCredential(JsonCredentialPrefix+"_1234567890"))
+	assert.Nil(allocCredential(CredentialStorePrefix + "_1234567890"))
+	assert.Nil(allocCredential(UsernamePasswordCredentialPrefix))
+}
+
+func TestCredential_Validate(t *testing.T) {
+	t.Parallel()
+	const op = errors.Op("static.TestCredential_Validate")
+	ctx := context.Background()
+	key := TestSshPrivateKey(t)
+
+	newUp := func(u, p string) staticCredential {
+		c, err := NewUsernamePasswordCredential("csst_1234567890", u, credential.Password(p))
+		require.NoError(t, err)
+		return c
+	}
+	newSpk := func(u string, k []byte) staticCredential {
+		c, err := NewSshPrivateKeyCredential("csst_1234567890", u, credential.PrivateKey(k))
+		require.NoError(t, err)
+		return c
+	}
+	newJson := func(o string) staticCredential {
+		c, err := NewJsonCredential("csst_1234567890", []byte(o))
+		require.NoError(t, err)
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		in      staticCredential
+		wantErr bool
+	}{
+		{name: "up-valid", in: newUp("user", "pass")},
+		{name: "up-no-username", in: newUp(" ", "pass"), wantErr: true},
+		{name: "up-no-password", in: newUp("user", ""), wantErr: true},
+		{name: "spk-valid", in: newSpk("user", key)},
+		{name: "spk-no-username", in: newSpk("", key), wantErr: true},
+		{name: "spk-no-key", in: newSpk("user", nil), wantErr: true},
+		{name: "spk-invalid-key", in: newSpk("user", []byte("not a key")), wantErr: true},
+		{name: "json-valid", in: newJson(`{"key":"value"}`)},
+		{name: "json-no-object", in: newJson(""), wantErr: true},
+		{name: "json-empty-object", in: newJson(`{}`), wantErr: true},
+		{name: "json-array", in: newJson(`["value"]`), wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.in.validate(ctx, op)
+			if tt.wantErr {
+				assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got err: %q", errors.InvalidParameter, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCredential_UpdateMasks(t *testing.T) {
+	t.Parallel()
+	const op = errors.Op("static.TestCredential_UpdateMasks")
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	up, err := NewUsernamePasswordCredential("csst_1234567890", "user", "pass", WithName("name"))
+	require.NoError(err)
+	dbMask, nullFields, secretChanged, err := up.updateMasks(ctx, op, []string{"name", "description", "username", "password"})
+	require.NoError(err)
+	assert.True(secretChanged)
+	assert.ElementsMatch([]string{nameField, usernameField, "CtPassword", "PasswordHmac", "KeyId"}, dbMask)
+	assert.ElementsMatch([]string{descriptionField}, nullFields)
+
+	_, _, secretChanged, err = up.updateMasks(ctx, op, []string{"name"})
+	require.NoError(err)
+	assert.False(secretChanged)
+
+	_, _, _, err = up.updateMasks(ctx, op, []string{"private_key"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "got err: %q", err)
+
+	spk, err := NewSshPrivateKeyCredential("csst_1234567890", "user", []byte("invalid"))
+	require.NoError(err)
+	_, _, _, err = spk.updateMasks(ctx, op, []string{"PrivateKey"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "got err: %q", err)
+
+	js, err := NewJsonCredential("csst_1234567890", []byte(`{"key":"value"}`))
+	require.NoError(err)
+	dbMask, _, secretChanged, err = js.updateMasks(ctx, op, []string{"Object"})
+	require.NoError(err)
+	assert.True(secretChanged)
+	assert.ElementsMatch([]string{"CtObject", "ObjectHmac", "KeyId"}, dbMask)
+	_, _, _, err = js.updateMasks(ctx, op, []string{"username"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "got err: %q", err)
+}
+
+func TestCredential_Secret(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	up, err := NewUsernamePasswordCredential("csst_1234567890", "user", "pass")
+	require.NoError(err)
+	assert.Equal(credential.UserPasswordType, up.CredentialType())
+	assert.Equal("user", up.Username())
+	assert.Equal(credential.Password("pass"), up.Password())
+	assert.Equal(map[string]interface{}{"username": "user", "password": "pass"}, up.Secret())
+	up.redact()
+	assert.Empty(up.Password())
+
+	key := TestSshPrivateKey(t)
+	spk, err := NewSshPrivateKeyCredential("csst_1234567890", "user", key)
+	require.NoError(err)
+	assert.Equal(credential.SshPrivateKeyType, spk.CredentialType())
+	assert.Equal(credential.PrivateKey(key), spk.Private())
+	assert.Equal(map[string]interface{}{"username": "user", "private_key": string(key)}, spk.Secret())
+
+	js, err := NewJsonCredential("csst_1234567890", []byte(`{"key":"value","num":1}`))
+	require.NoError(err)
+	assert.Equal(credential.JsonType, js.CredentialType())
+	assert.Equal(map[string]interface{}{"key": "value", "num": float64(1)}, js.Secret())
+	js.redact()
+	assert.Nil(js.Secret())
+}
@@ -0,0 +1,7 @@
+// Package static provides credentials which are stored, encrypted, in a
+// Boundary credential store. The secret of a static credential is
+// encrypted with the database key of the scope of its credential store.
+//
+// Static credentials do not change between sessions: every session which
+// requests a static credential receives the same secret.
+package static
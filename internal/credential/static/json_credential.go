@@ -0,0 +1,166 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// A JsonCredential contains an arbitrary JSON object as its secret. It is
+// owned by a static credential store.
+type JsonCredential struct {
+	*store.JsonCredential
+	tableName string `gorm:"-"`
+}
+
+// NewJsonCredential creates a new in memory JsonCredential assigned to
+// storeId. object must be a JSON encoded object. Name and description are
+// the only valid options. All other options are ignored.
+func NewJsonCredential(storeId string, object []byte, opt ...Option) (*JsonCredential, error) {
+	opts := getOpts(opt...)
+	c := &JsonCredential{
+		JsonCredential: &store.JsonCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Object:      object,
+		},
+	}
+	return c, nil
+}
+
+func allocJsonCredential() *JsonCredential {
+	return &JsonCredential{
+		JsonCredential: &store.JsonCredential{},
+	}
+}
+
+func (c *JsonCredential) clone() staticCredential {
+	cp := proto.Clone(c.JsonCredential)
+	return &JsonCredential{
+		JsonCredential: cp.(*store.JsonCredential),
+	}
+}
+
+func (c *JsonCredential) setId(i string) {
+	c.PublicId = i
+}
+
+// TableName returns the table name.
+func (c *JsonCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_json_credential"
+}
+
+// SetTableName sets the table name.
+func (c *JsonCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *JsonCredential) oplog(op oplog.OpType) oplog.Metadata {
+	return credentialOplog(c, "credential-static-json-credential", op)
+}
+
+func validJsonObject(object []byte) bool {
+	var m map[string]interface{}
+	if err := json.Unmarshal(object, &m); err != nil {
+		return false
+	}
+	return len(m) > 0
+}
+
+func (c *JsonCredential) validate(ctx context.Context, caller errors.Op) error {
+	switch {
+	case len(c.Object) == 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "no object")
+	case !validJsonObject(c.Object):
+		return errors.New(ctx, errors.InvalidParameter, caller, "object must be a non-empty json object")
+	}
+	return nil
+}
+
+func (c *JsonCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(JsonCredential).encrypt"
+	if len(c.Object) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no object defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c.JsonCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	hm, err := crypto.HmacSha256(ctx, c.Object, cipher, []byte(c.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.ObjectHmac = []byte(hm)
+	return nil
+}
+
+func (c *JsonCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(JsonCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.JsonCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *JsonCredential) redact() {
+	c.Object, c.CtObject = nil, nil
+}
+
+func (c *JsonCredential) updateMasks(ctx context.Context, caller errors.Op, fieldMask []string) ([]string, []string, bool, error) {
+	var dbMask, nullFields []string
+	var secretChanged bool
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold(nameField, f) && c.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && c.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && c.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && c.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		case strings.EqualFold(objectField, f) && !validJsonObject(c.Object):
+			return nil, nil, false, errors.New(ctx, errors.InvalidParameter, caller, "object must be a non-empty json object")
+		case strings.EqualFold(objectField, f):
+			dbMask = append(dbMask, secretMasks("CtObject", "ObjectHmac")...)
+			secretChanged = true
+		default:
+			return nil, nil, false, errors.New(ctx, errors.InvalidFieldMask, caller, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	return dbMask, nullFields, secretChanged, nil
+}
+
+// CredentialType returns the type of the credential.
+func (c *JsonCredential) CredentialType() credential.Type {
+	return credential.JsonType
+}
+
+// Secret returns the decoded JSON object of the credential. It is nil
+// unless the credential has been retrieved for a session.
+func (c *JsonCredential) Secret() credential.SecretData {
+	if len(c.GetObject()) == 0 {
+		return nil
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(c.GetObject(), &m); err != nil {
+		return nil
+	}
+	return m
+}
+
+var _ credential.Static = (*JsonCredential)(nil)
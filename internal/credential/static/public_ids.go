@@ -0,0 +1,54 @@
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+)
+
+func init() {
+	if err := credential.Register(Subtype, CredentialStorePrefix, UsernamePasswordCredentialPrefix, SshPrivateKeyCredentialPrefix, JsonCredentialPrefix); err != nil {
+		panic(err)
+	}
+}
+
+// PublicId prefixes for the resources in the static package.
+const (
+	CredentialStorePrefix            = "csst"
+	UsernamePasswordCredentialPrefix = "credup"
+	SshPrivateKeyCredentialPrefix    = "credspk"
+	JsonCredentialPrefix             = "credjson"
+
+	Subtype = subtypes.Subtype("static")
+)
+
+// CredentialPrefixes returns the PublicId prefixes of all the static
+// credential types.
+func CredentialPrefixes() []string {
+	return []string{
+		UsernamePasswordCredentialPrefix,
+		SshPrivateKeyCredentialPrefix,
+		JsonCredentialPrefix,
+	}
+}
+
+func newCredentialStoreId(ctx context.Context) (string, error) {
+	const op = "static.newCredentialStoreId"
+	id, err := db.NewPublicId(CredentialStorePrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
+
+func newCredentialId(ctx context.Context, prefix string) (string, error) {
+	const op = "static.newCredentialId"
+	id, err := db.NewPublicId(prefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,365 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+func toStaticCredential(ctx context.Context, op errors.Op, c credential.Static) (staticCredential, error) {
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil credential")
+	}
+	sc, ok := c.(staticCredential)
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
+	}
+	switch v := sc.(type) {
+	case *UsernamePasswordCredential:
+		ok = v != nil && v.UsernamePasswordCredential != nil
+	case *SshPrivateKeyCredential:
+		ok = v != nil && v.SshPrivateKeyCredential != nil
+	case *JsonCredential:
+		ok = v != nil && v.JsonCredential != nil
+	}
+	if !ok {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded credential")
+	}
+	return sc, nil
+}
+
+func credentialPrefix(c staticCredential) string {
+	switch c.(type) {
+	case *SshPrivateKeyCredential:
+		return SshPrivateKeyCredentialPrefix
+	case *JsonCredential:
+		return JsonCredentialPrefix
+	default:
+		return UsernamePasswordCredentialPrefix
+	}
+}
+
+// CreateCredential inserts c into the repository and returns a new
+// credential containing the credential's PublicId. c is not changed. c
+// must be one of the credential types in this package and must contain a
+// valid StoreId. c must not contain a PublicId. The PublicId is generated
+// and assigned by this method.
+//
+// The secret of c is encrypted with the database key of scopeId, which must
+// be the scope of the credential store. The returned credential does not
+// contain the secret.
+//
+// Both Name and Description are optional. If Name is set, it must be
+// unique within StoreId.
+func (r *Repository) CreateCredential(ctx context.Context, scopeId string, c credential.Static, _ ...Option) (credential.Static, error) {
+	const op = "static.(Repository).CreateCredential"
+	sc, err := toStaticCredential(ctx, op, c)
+	if err != nil {
+		return nil, err
+	}
+	if sc.GetStoreId() == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	if sc.GetPublicId() != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	if err := sc.validate(ctx, op); err != nil {
+		return nil, err // intentionally not wrapped.
+	}
+	sc = sc.clone()
+
+	id, err := newCredentialId(ctx, credentialPrefix(sc))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sc.setId(id)
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := sc.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCredential staticCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredential = sc.clone()
+			if err := w.Create(ctx, newCredential, db.WithOplog(oplogWrapper, sc.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s: name %s already exists", sc.GetStoreId(), sc.GetName())))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in store: %s", sc.GetStoreId())))
+	}
+	newCredential.redact()
+	return newCredential, nil
+}
+
+// LookupCredential returns the credential for publicId. The returned
+// credential does not contain its secret. Returns nil, nil if no
+// credential is found for publicId.
+func (r *Repository) LookupCredential(ctx context.Context, publicId string, _ ...Option) (credential.Static, error) {
+	const op = "static.(Repository).LookupCredential"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	c := allocCredential(publicId)
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("%s is not a static credential id", publicId))
+	}
+	c.setId(publicId)
+	if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	c.redact()
+	return c, nil
+}
+
+// UpdateCredential updates the repository entry for c's PublicId with the
+// values in c for the fields listed in fieldMaskPaths. It returns a new
+// credential containing the updated values, without its secret, and a
+// count of the number of records updated. c is not changed.
+//
+// Name, Description, and the username and secret fields of the credential
+// type can be updated. If the secret is updated it is encrypted with the
+// database key of scopeId. If Name is set to a non-empty string, it must
+// be unique within the credential's store.
+func (r *Repository) UpdateCredential(ctx context.Context, scopeId string, c credential.Static, version uint32, fieldMaskPaths []string, _ ...Option) (credential.Static, int, error) {
+	const op = "static.(Repository).UpdateCredential"
+	sc, err := toStaticCredential(ctx, op, c)
+	if err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+	if sc.GetPublicId() == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if scopeId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	if len(fieldMaskPaths) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+	sc = sc.clone()
+
+	dbMask, nullFields, secretChanged, err := sc.updateMasks(ctx, op, fieldMaskPaths)
+	if err != nil {
+		return nil, db.NoRowsAffected, err // intentionally not wrapped.
+	}
+
+	if secretChanged {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := sc.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedCredential staticCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(rr db.Reader, w db.Writer) error {
+			uc := sc.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, uc, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, sc.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch rowsUpdated {
+			case 1:
+			case 0:
+				return nil
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated credential and %d rows updated", rowsUpdated))
+			}
+
+			returnedCredential = allocCredential(sc.GetPublicId())
+			returnedCredential.setId(sc.GetPublicId())
+			if err := rr.LookupByPublicId(ctx, returnedCredential); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve updated credential"))
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", sc.GetName(), sc.GetPublicId()))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(sc.GetPublicId()))
+	}
+	if returnedCredential == nil {
+		return nil, rowsUpdated, nil
+	}
+	returnedCredential.redact()
+	return returnedCredential, rowsUpdated, nil
+}
+
+// ListCredentials returns a slice of the credentials, without their
+// secrets, in the store with storeId, ordered by public id. WithLimit and
+// WithStartPageAfterId are the only options supported.
+func (r *Repository) ListCredentials(ctx context.Context, storeId string, opt ...Option) ([]credential.Static, error) {
+	const op = "static.(Repository).ListCredentials"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no storeId")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	where, args := "store_id = ?", []interface{}{storeId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	dbOpts := []db.Option{db.WithLimit(limit), db.WithOrder("public_id")}
+
+	var creds []credential.Static
+	var ups []*UsernamePasswordCredential
+	if err := r.reader.SearchWhere(ctx, &ups, where, args, dbOpts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, c := range ups {
+		c.redact()
+		creds = append(creds, c)
+	}
+	var spks []*SshPrivateKeyCredential
+	if err := r.reader.SearchWhere(ctx, &spks, where, args, dbOpts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, c := range spks {
+		c.redact()
+		creds = append(creds, c)
+	}
+	var jsons []*JsonCredential
+	if err := r.reader.SearchWhere(ctx, &jsons, where, args, dbOpts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, c := range jsons {
+		c.redact()
+		creds = append(creds, c)
+	}
+
+	// Each kind of credential is stored in its own table so the results are
+	// merged to return the first credentials of the store by public id.
+	sort.Slice(creds, func(i, j int) bool {
+		return creds[i].GetPublicId() < creds[j].GetPublicId()
+	})
+	if limit > 0 && len(creds) > limit {
+		creds = creds[:limit]
+	}
+	return creds, nil
+}
+
+// DeleteCredential deletes publicId from the repository and returns the
+// number of records deleted.
+func (r *Repository) DeleteCredential(ctx context.Context, scopeId string, publicId string, _ ...Option) (int, error) {
+	const op = "static.(Repository).DeleteCredential"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	c := allocCredential(publicId)
+	if c == nil {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("%s is not a static credential id", publicId))
+	}
+	c.setId(publicId)
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dc := c.clone()
+			rowsDeleted, err = w.Delete(ctx, dc, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err == nil && rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 credential would have been deleted")
+			}
+			return err
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", publicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+// Retrieve returns the credentials for ids with their secrets decrypted
+// so they can be provided to a session. All ids must be the ids of static
+// credentials in a store in scopeId.
+func (r *Repository) Retrieve(ctx context.Context, scopeId string, ids []string, _ ...Option) ([]credential.Static, error) {
+	const op = "static.(Repository).Retrieve"
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	if len(ids) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no ids")
+	}
+
+	var creds []credential.Static
+	for _, id := range ids {
+		c := allocCredential(id)
+		if c == nil {
+			return nil, errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("%s is not a static credential id", id))
+		}
+		c.setId(id)
+		if err := r.reader.LookupByPublicId(ctx, c); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+		}
+		databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(c.GetKeyId()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		if err := c.decrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		creds = append(creds, c)
+	}
+	return creds, nil
+}
@@ -0,0 +1,244 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// CreateCredentialStore inserts cs into the repository and returns a new
+// CredentialStore containing the credential store's PublicId. cs is not
+// changed. cs must contain a valid ScopeId. cs must not contain a
+// PublicId. The PublicId is generated and assigned by this method.
+//
+// Both cs.Name and cs.Description are optional. If cs.Name is set, it must
+// be unique within cs.ScopeId.
+//
+// Both cs.CreateTime and cs.UpdateTime are ignored.
+func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, _ ...Option) (*CredentialStore, error) {
+	const op = "static.(Repository).CreateCredentialStore"
+	if cs == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CredentialStore")
+	}
+	if cs.ScopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	if cs.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	cs = cs.clone()
+
+	id, err := newCredentialStoreId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cs.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newCredentialStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newCredentialStore = cs.clone()
+			if err := w.Create(ctx, newCredentialStore, db.WithOplog(oplogWrapper, cs.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope: %s: name %s already exists", cs.ScopeId, cs.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope: %s", cs.ScopeId)))
+	}
+	return newCredentialStore, nil
+}
+
+// LookupCredentialStore returns the CredentialStore for publicId. Returns
+// nil, nil if no CredentialStore is found for publicId.
+func (r *Repository) LookupCredentialStore(ctx context.Context, publicId string, _ ...Option) (*CredentialStore, error) {
+	const op = "static.(Repository).LookupCredentialStore"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return cs, nil
+}
+
+// UpdateCredentialStore updates the repository entry for cs.PublicId with
+// the values in cs for the fields listed in fieldMaskPaths. It returns a
+// new CredentialStore containing the updated values and a count of the
+// number of records updated. cs is not changed.
+//
+// cs must contain a valid PublicId and ScopeId. Only cs.Name and
+// cs.Description can be updated. If cs.Name is set to a non-empty string,
+// it must be unique within cs.ScopeId.
+//
+// An attribute of cs will be set to NULL in the database if the attribute
+// in cs is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialStore, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialStore, int, error) {
+	const op = "static.(Repository).UpdateCredentialStore"
+	if cs == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil CredentialStore")
+	}
+	if cs.CredentialStore == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CredentialStore")
+	}
+	if cs.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if cs.ScopeId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	}
+	if len(fieldMaskPaths) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	var dbMask, nullFields []string
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f) && cs.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && cs.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && cs.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && cs.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	cs = cs.clone()
+
+	var rowsUpdated int
+	var returnedCredentialStore *CredentialStore
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCredentialStore = cs.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredentialStore, dbMask, nullFields,
+				db.WithOplog(oplogWrapper, cs.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: name %s already exists", cs.PublicId, cs.Name)))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", cs.PublicId)))
+	}
+
+	return returnedCredentialStore, rowsUpdated, nil
+}
+
+// ListCredentialStores returns a slice of CredentialStores for the
+// scopeIds, ordered by public id. WithLimit and WithStartPageAfterId are the
+// only options supported.
+func (r *Repository) ListCredentialStores(ctx context.Context, scopeIds []string, opt ...Option) ([]*CredentialStore, error) {
+	const op = "static.(Repository).ListCredentialStores"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scopeIds")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var credentialStores []*CredentialStore
+	where, args := "scope_id in (?)", []interface{}{scopeIds}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &credentialStores, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return credentialStores, nil
+}
+
+// DeleteCredentialStore deletes publicId from the repository and returns
+// the number of records deleted. All credentials in the store are also
+// deleted.
+func (r *Repository) DeleteCredentialStore(ctx context.Context, publicId string, _ ...Option) (int, error) {
+	const op = "static.(Repository).DeleteCredentialStore"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	cs := allocCredentialStore()
+	cs.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, cs); err != nil {
+		if errors.IsNotFoundError(err) {
+			return db.NoRowsAffected, nil
+		}
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
+	if cs.ScopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, cs.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			dcs := cs.clone()
+			var err error
+			rowsDeleted, err = w.Delete(ctx, dcs, db.WithOplog(oplogWrapper, cs.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", publicId)))
+	}
+
+	return rowsDeleted, nil
+}
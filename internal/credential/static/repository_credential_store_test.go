@@ -0,0 +1,118 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCredentialStore(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		in       *CredentialStore
+		wantCode errors.Code
+	}{
+		{
+			name:     "nil-store",
+			wantCode: errors.InvalidParameter,
+		},
+		{
+			name:     "no-scope",
+			in:       allocCredentialStore(),
+			wantCode: errors.InvalidParameter,
+		},
+		{
+			name: "valid",
+			in: func() *CredentialStore {
+				cs, err := NewCredentialStore(prj.GetPublicId(), WithName("name"), WithDescription("desc"))
+				require.NoError(t, err)
+				return cs
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateCredentialStore(ctx, tt.in)
+			if tt.wantCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantCode), err), "want err: %q got: %q", tt.wantCode, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.GetPublicId())
+			assert.Equal(tt.in.GetName(), got.GetName())
+			assert.Equal(tt.in.GetDescription(), got.GetDescription())
+
+			_, err = repo.CreateCredentialStore(ctx, tt.in)
+			assert.Truef(errors.Match(errors.T(errors.NotUnique), err), "want duplicate name err, got: %q", err)
+		})
+	}
+}
+
+func TestRepository_CredentialStore_LookupUpdateListDelete(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	ctx := context.Background()
+
+	css := TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 3)
+	cs := css[0]
+
+	got, err := repo.LookupCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(cs.GetPublicId(), got.GetPublicId())
+
+	got, err = repo.LookupCredentialStore(ctx, CredentialStorePrefix+"_1234567890")
+	require.NoError(err)
+	assert.Nil(got)
+
+	upd := cs.clone()
+	upd.Name = "updated"
+	updated, n, err := repo.UpdateCredentialStore(ctx, upd, cs.GetVersion(), []string{nameField})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("updated", updated.GetName())
+
+	_, _, err = repo.UpdateCredentialStore(ctx, upd, cs.GetVersion(), []string{"ScopeId"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "got err: %q", err)
+
+	list, err := repo.ListCredentialStores(ctx, []string{prj.GetPublicId()})
+	require.NoError(err)
+	assert.Len(list, 3)
+	list, err = repo.ListCredentialStores(ctx, []string{prj.GetPublicId()}, WithLimit(1))
+	require.NoError(err)
+	assert.Len(list, 1)
+
+	n, err = repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, n)
+	n, err = repo.DeleteCredentialStore(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Equal(0, n)
+}
@@ -0,0 +1,163 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCredential(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	up, err := NewUsernamePasswordCredential(cs.GetPublicId(), "user", "pass", WithName("up"))
+	require.NoError(t, err)
+	spk, err := NewSshPrivateKeyCredential(cs.GetPublicId(), "user", TestSshPrivateKey(t), WithName("spk"))
+	require.NoError(t, err)
+	js, err := NewJsonCredential(cs.GetPublicId(), []byte(`{"key":"value"}`), WithName("json"))
+	require.NoError(t, err)
+	noStore, err := NewUsernamePasswordCredential("", "user", "pass")
+	require.NoError(t, err)
+	invalid, err := NewUsernamePasswordCredential(cs.GetPublicId(), "user", "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		scopeId    string
+		in         credential.Static
+		wantPrefix string
+		wantCode   errors.Code
+	}{
+		{name: "nil", scopeId: prj.GetPublicId(), wantCode: errors.InvalidParameter},
+		{name: "no-scope", in: up, wantCode: errors.InvalidParameter},
+		{name: "no-store", scopeId: prj.GetPublicId(), in: noStore, wantCode: errors.InvalidParameter},
+		{name: "invalid", scopeId: prj.GetPublicId(), in: invalid, wantCode: errors.InvalidParameter},
+		{name: "username-password", scopeId: prj.GetPublicId(), in: up, wantPrefix: UsernamePasswordCredentialPrefix},
+		{name: "ssh-private-key", scopeId: prj.GetPublicId(), in: spk, wantPrefix: SshPrivateKeyCredentialPrefix},
+		{name: "json", scopeId: prj.GetPublicId(), in: js, wantPrefix: JsonCredentialPrefix},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.CreateCredential(ctx, tt.scopeId, tt.in)
+			if tt.wantCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantCode), err), "want err: %q got: %q", tt.wantCode, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.True(hasPrefix(got.GetPublicId(), tt.wantPrefix))
+			assert.Equal(tt.in.GetName(), got.GetName())
+			assert.Nil(got.Secret(), "created credential must not contain its secret")
+
+			looked, err := repo.LookupCredential(ctx, got.GetPublicId())
+			require.NoError(err)
+			assert.Equal(got.GetPublicId(), looked.GetPublicId())
+
+			retrieved, err := repo.Retrieve(ctx, tt.scopeId, []string{got.GetPublicId()})
+			require.NoError(err)
+			require.Len(retrieved, 1)
+			assert.Equal(tt.in.Secret(), retrieved[0].Secret())
+		})
+	}
+}
+
+func TestRepository_UpdateCredential(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	ctx := context.Background()
+
+	c := TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), cs.GetPublicId(), "user", "pass")
+	origHmac := c.GetPasswordHmac()
+
+	upd, err := NewUsernamePasswordCredential(cs.GetPublicId(), "new-user", "new-pass", WithName("name"))
+	require.NoError(err)
+	upd.PublicId = c.GetPublicId()
+
+	got, n, err := repo.UpdateCredential(ctx, prj.GetPublicId(), upd, 1, []string{nameField})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("name", got.GetName())
+	assert.Equal("user", got.(*UsernamePasswordCredential).Username())
+
+	got, n, err = repo.UpdateCredential(ctx, prj.GetPublicId(), upd, got.GetVersion(), []string{usernameField, passwordField})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal("new-user", got.(*UsernamePasswordCredential).Username())
+	assert.NotEqual(origHmac, got.(*UsernamePasswordCredential).GetPasswordHmac())
+
+	retrieved, err := repo.Retrieve(ctx, prj.GetPublicId(), []string{c.GetPublicId()})
+	require.NoError(err)
+	require.Len(retrieved, 1)
+	assert.Equal(credential.Password("new-pass"), retrieved[0].(credential.UserPassword).Password())
+
+	_, _, err = repo.UpdateCredential(ctx, prj.GetPublicId(), upd, got.GetVersion(), []string{"StoreId"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidFieldMask), err), "got err: %q", err)
+}
+
+func TestRepository_ListDeleteCredentials(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+	ctx := context.Background()
+
+	ups := TestUsernamePasswordCredentials(t, conn, wrapper, prj.GetPublicId(), cs.GetPublicId(), 2)
+	TestSshPrivateKeyCredential(t, conn, wrapper, prj.GetPublicId(), cs.GetPublicId(), "user", TestSshPrivateKey(t))
+	TestJsonCredential(t, conn, wrapper, prj.GetPublicId(), cs.GetPublicId(), []byte(`{"key":"value"}`))
+
+	got, err := repo.ListCredentials(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Len(got, 4)
+	for _, c := range got {
+		assert.Nil(c.Secret(), "listed credentials must not contain their secret")
+	}
+
+	got, err = repo.ListCredentials(ctx, cs.GetPublicId(), WithLimit(3))
+	require.NoError(err)
+	assert.Len(got, 3)
+
+	n, err := repo.DeleteCredential(ctx, prj.GetPublicId(), ups[0].GetPublicId())
+	require.NoError(err)
+	assert.Equal(1, n)
+
+	got, err = repo.ListCredentials(ctx, cs.GetPublicId())
+	require.NoError(err)
+	assert.Len(got, 3)
+
+	_, err = repo.DeleteCredential(ctx, prj.GetPublicId(), CredentialStorePrefix+"_1234567890")
+	assert.Truef(errors.Match(errors.T(errors.InvalidPublicId), err), "got err: %q", err)
+}
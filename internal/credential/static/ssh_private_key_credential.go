@@ -0,0 +1,183 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+// A SshPrivateKeyCredential contains a username and a PEM encoded SSH
+// private key. It is owned by a static credential store.
+type SshPrivateKeyCredential struct {
+	*store.SshPrivateKeyCredential
+	tableName string `gorm:"-"`
+}
+
+// NewSshPrivateKeyCredential creates a new in memory
+// SshPrivateKeyCredential assigned to storeId. Name and description are
+// the only valid options. All other options are ignored.
+func NewSshPrivateKeyCredential(storeId string, username string, privateKey credential.PrivateKey, opt ...Option) (*SshPrivateKeyCredential, error) {
+	opts := getOpts(opt...)
+	c := &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: &store.SshPrivateKeyCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Username:    username,
+			PrivateKey:  []byte(privateKey),
+		},
+	}
+	return c, nil
+}
+
+func allocSshPrivateKeyCredential() *SshPrivateKeyCredential {
+	return &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: &store.SshPrivateKeyCredential{},
+	}
+}
+
+func (c *SshPrivateKeyCredential) clone() staticCredential {
+	cp := proto.Clone(c.SshPrivateKeyCredential)
+	return &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: cp.(*store.SshPrivateKeyCredential),
+	}
+}
+
+func (c *SshPrivateKeyCredential) setId(i string) {
+	c.PublicId = i
+}
+
+// TableName returns the table name.
+func (c *SshPrivateKeyCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_ssh_private_key_credential"
+}
+
+// SetTableName sets the table name.
+func (c *SshPrivateKeyCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *SshPrivateKeyCredential) oplog(op oplog.OpType) oplog.Metadata {
+	return credentialOplog(c, "credential-static-ssh-private-key-credential", op)
+}
+
+func validSshPrivateKey(key []byte) bool {
+	_, err := ssh.ParseRawPrivateKey(key)
+	return err == nil
+}
+
+func (c *SshPrivateKeyCredential) validate(ctx context.Context, caller errors.Op) error {
+	switch {
+	case strings.TrimSpace(c.GetUsername()) == "":
+		return errors.New(ctx, errors.InvalidParameter, caller, "no username")
+	case len(c.PrivateKey) == 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "no private key")
+	case !validSshPrivateKey(c.PrivateKey):
+		return errors.New(ctx, errors.InvalidParameter, caller, "private key is not a valid unencrypted PEM encoded ssh private key")
+	}
+	return nil
+}
+
+func (c *SshPrivateKeyCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(SshPrivateKeyCredential).encrypt"
+	if len(c.PrivateKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no private key defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c.SshPrivateKeyCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	hm, err := crypto.HmacSha256(ctx, c.PrivateKey, cipher, []byte(c.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.PrivateKeyHmac = []byte(hm)
+	return nil
+}
+
+func (c *SshPrivateKeyCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(SshPrivateKeyCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.SshPrivateKeyCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *SshPrivateKeyCredential) redact() {
+	c.PrivateKey, c.CtPrivateKey = nil, nil
+}
+
+func (c *SshPrivateKeyCredential) updateMasks(ctx context.Context, caller errors.Op, fieldMask []string) ([]string, []string, bool, error) {
+	var dbMask, nullFields []string
+	var secretChanged bool
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold(nameField, f) && c.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && c.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && c.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && c.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		case strings.EqualFold(usernameField, f) && strings.TrimSpace(c.GetUsername()) == "":
+			return nil, nil, false, errors.New(ctx, errors.InvalidParameter, caller, "username cannot be empty")
+		case strings.EqualFold(usernameField, f):
+			dbMask = append(dbMask, usernameField)
+		case strings.EqualFold(privateKeyField, f) && !validSshPrivateKey(c.PrivateKey):
+			return nil, nil, false, errors.New(ctx, errors.InvalidParameter, caller, "private key is not a valid unencrypted PEM encoded ssh private key")
+		case strings.EqualFold(privateKeyField, f):
+			dbMask = append(dbMask, secretMasks("CtPrivateKey", "PrivateKeyHmac")...)
+			secretChanged = true
+		default:
+			return nil, nil, false, errors.New(ctx, errors.InvalidFieldMask, caller, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	return dbMask, nullFields, secretChanged, nil
+}
+
+// CredentialType returns the type of the credential.
+func (c *SshPrivateKeyCredential) CredentialType() credential.Type {
+	return credential.SshPrivateKeyType
+}
+
+// Username returns the username of the credential.
+func (c *SshPrivateKeyCredential) Username() string {
+	return c.GetUsername()
+}
+
+// Private returns the private key of the credential. It is empty unless
+// the credential has been retrieved for a session.
+func (c *SshPrivateKeyCredential) Private() credential.PrivateKey {
+	return credential.PrivateKey(c.GetPrivateKey())
+}
+
+// Secret returns the username and private key of the credential. It is nil
+// unless the credential has been retrieved for a session.
+func (c *SshPrivateKeyCredential) Secret() credential.SecretData {
+	if len(c.PrivateKey) == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"username":    c.GetUsername(),
+		"private_key": string(c.GetPrivateKey()),
+	}
+}
+
+var (
+	_ credential.Static  = (*SshPrivateKeyCredential)(nil)
+	_ credential.KeyPair = (*SshPrivateKeyCredential)(nil)
+)
@@ -0,0 +1,124 @@
+package static
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCredentialStore creates a static credential store in the provided
+// DB with the provided scope and any values passed in through the Options
+// vars. If any errors are encountered during the creation of the store,
+// the test will fail.
+func TestCredentialStore(t *testing.T, conn *db.DB, _ wrapping.Wrapper, scopeId string, opts ...Option) *CredentialStore {
+	t.Helper()
+	ctx := context.Background()
+	w := db.New(conn)
+
+	cs, err := NewCredentialStore(scopeId, opts...)
+	require.NoError(t, err)
+	id, err := newCredentialStoreId(ctx)
+	require.NoError(t, err)
+	cs.PublicId = id
+
+	require.NoError(t, w.Create(ctx, cs))
+	return cs
+}
+
+// TestCredentialStores creates count number of static credential stores
+// in the provided DB with the provided scope id. If any errors are
+// encountered during the creation of the stores, the test will fail.
+func TestCredentialStores(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId string, count int) []*CredentialStore {
+	t.Helper()
+	var css []*CredentialStore
+	for i := 0; i < count; i++ {
+		css = append(css, TestCredentialStore(t, conn, wrapper, scopeId))
+	}
+	return css
+}
+
+func testCreateCredential(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId string, c staticCredential) {
+	t.Helper()
+	ctx := context.Background()
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	w := db.New(conn)
+
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+
+	id, err := newCredentialId(ctx, credentialPrefix(c))
+	require.NoError(t, err)
+	c.setId(id)
+	require.NoError(t, c.encrypt(ctx, databaseWrapper))
+	require.NoError(t, w.Create(ctx, c))
+}
+
+// TestUsernamePasswordCredential creates a username password credential in
+// the provided DB in the store with storeId. scopeId must be the scope of
+// the store. If any errors are encountered during the creation of the
+// credential, the test will fail.
+func TestUsernamePasswordCredential(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId, storeId, username, password string, opts ...Option) *UsernamePasswordCredential {
+	t.Helper()
+	c, err := NewUsernamePasswordCredential(storeId, username, credential.Password(password), opts...)
+	require.NoError(t, err)
+	testCreateCredential(t, conn, wrapper, scopeId, c)
+	return c
+}
+
+// TestUsernamePasswordCredentials creates count number of username
+// password credentials in the provided DB in the store with storeId. If
+// any errors are encountered during the creation of the credentials, the
+// test will fail.
+func TestUsernamePasswordCredentials(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId, storeId string, count int) []*UsernamePasswordCredential {
+	t.Helper()
+	var creds []*UsernamePasswordCredential
+	for i := 0; i < count; i++ {
+		creds = append(creds, TestUsernamePasswordCredential(t, conn, wrapper, scopeId, storeId, fmt.Sprintf("user%d", i), fmt.Sprintf("password%d", i)))
+	}
+	return creds
+}
+
+// TestSshPrivateKeyCredential creates an ssh private key credential in the
+// provided DB in the store with storeId. scopeId must be the scope of the
+// store. If any errors are encountered during the creation of the
+// credential, the test will fail.
+func TestSshPrivateKeyCredential(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId, storeId, username string, privateKey []byte, opts ...Option) *SshPrivateKeyCredential {
+	t.Helper()
+	c, err := NewSshPrivateKeyCredential(storeId, username, credential.PrivateKey(privateKey), opts...)
+	require.NoError(t, err)
+	testCreateCredential(t, conn, wrapper, scopeId, c)
+	return c
+}
+
+// TestJsonCredential creates a json credential in the provided DB in the
+// store with storeId. scopeId must be the scope of the store. If any
+// errors are encountered during the creation of the credential, the test
+// will fail.
+func TestJsonCredential(t *testing.T, conn *db.DB, wrapper wrapping.Wrapper, scopeId, storeId string, object []byte, opts ...Option) *JsonCredential {
+	t.Helper()
+	c, err := NewJsonCredential(storeId, object, opts...)
+	require.NoError(t, err)
+	testCreateCredential(t, conn, wrapper, scopeId, c)
+	return c
+}
+
+// TestSshPrivateKey returns a new PEM encoded ed25519 private key. If any
+// errors are encountered while generating the key, the test will fail.
+func TestSshPrivateKey(t *testing.T) []byte {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
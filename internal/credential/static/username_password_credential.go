@@ -0,0 +1,175 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/hashicorp/go-kms-wrapping/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// A UsernamePasswordCredential contains a username and a password. It is
+// owned by a static credential store.
+type UsernamePasswordCredential struct {
+	*store.UsernamePasswordCredential
+	tableName string `gorm:"-"`
+}
+
+// NewUsernamePasswordCredential creates a new in memory
+// UsernamePasswordCredential assigned to storeId. Name and description
+// are the only valid options. All other options are ignored.
+func NewUsernamePasswordCredential(storeId string, username string, password credential.Password, opt ...Option) (*UsernamePasswordCredential, error) {
+	opts := getOpts(opt...)
+	c := &UsernamePasswordCredential{
+		UsernamePasswordCredential: &store.UsernamePasswordCredential{
+			StoreId:     storeId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			Username:    username,
+			Password:    []byte(password),
+		},
+	}
+	return c, nil
+}
+
+func allocUsernamePasswordCredential() *UsernamePasswordCredential {
+	return &UsernamePasswordCredential{
+		UsernamePasswordCredential: &store.UsernamePasswordCredential{},
+	}
+}
+
+func (c *UsernamePasswordCredential) clone() staticCredential {
+	cp := proto.Clone(c.UsernamePasswordCredential)
+	return &UsernamePasswordCredential{
+		UsernamePasswordCredential: cp.(*store.UsernamePasswordCredential),
+	}
+}
+
+func (c *UsernamePasswordCredential) setId(i string) {
+	c.PublicId = i
+}
+
+// TableName returns the table name.
+func (c *UsernamePasswordCredential) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "credential_static_username_password_credential"
+}
+
+// SetTableName sets the table name.
+func (c *UsernamePasswordCredential) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *UsernamePasswordCredential) oplog(op oplog.OpType) oplog.Metadata {
+	return credentialOplog(c, "credential-static-username-password-credential", op)
+}
+
+func (c *UsernamePasswordCredential) validate(ctx context.Context, caller errors.Op) error {
+	switch {
+	case strings.TrimSpace(c.GetUsername()) == "":
+		return errors.New(ctx, errors.InvalidParameter, caller, "no username")
+	case len(c.UsernamePasswordCredential.Password) == 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "no password")
+	}
+	return nil
+}
+
+func (c *UsernamePasswordCredential) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(UsernamePasswordCredential).encrypt"
+	if len(c.UsernamePasswordCredential.Password) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no password defined")
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, c.UsernamePasswordCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	c.KeyId = cipher.KeyID()
+	hm, err := crypto.HmacSha256(ctx, c.UsernamePasswordCredential.Password, cipher, []byte(c.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	c.PasswordHmac = []byte(hm)
+	return nil
+}
+
+func (c *UsernamePasswordCredential) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "static.(UsernamePasswordCredential).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.UsernamePasswordCredential, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *UsernamePasswordCredential) redact() {
+	c.UsernamePasswordCredential.Password, c.CtPassword = nil, nil
+}
+
+func (c *UsernamePasswordCredential) updateMasks(ctx context.Context, caller errors.Op, fieldMask []string) ([]string, []string, bool, error) {
+	var dbMask, nullFields []string
+	var secretChanged bool
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold(nameField, f) && c.Name == "":
+			nullFields = append(nullFields, nameField)
+		case strings.EqualFold(nameField, f) && c.Name != "":
+			dbMask = append(dbMask, nameField)
+		case strings.EqualFold(descriptionField, f) && c.Description == "":
+			nullFields = append(nullFields, descriptionField)
+		case strings.EqualFold(descriptionField, f) && c.Description != "":
+			dbMask = append(dbMask, descriptionField)
+		case strings.EqualFold(usernameField, f) && strings.TrimSpace(c.GetUsername()) == "":
+			return nil, nil, false, errors.New(ctx, errors.InvalidParameter, caller, "username cannot be empty")
+		case strings.EqualFold(usernameField, f):
+			dbMask = append(dbMask, usernameField)
+		case strings.EqualFold(passwordField, f) && len(c.UsernamePasswordCredential.Password) == 0:
+			return nil, nil, false, errors.New(ctx, errors.InvalidParameter, caller, "password cannot be empty")
+		case strings.EqualFold(passwordField, f):
+			dbMask = append(dbMask, secretMasks("CtPassword", "PasswordHmac")...)
+			secretChanged = true
+		default:
+			return nil, nil, false, errors.New(ctx, errors.InvalidFieldMask, caller, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	return dbMask, nullFields, secretChanged, nil
+}
+
+// CredentialType returns the type of the credential.
+func (c *UsernamePasswordCredential) CredentialType() credential.Type {
+	return credential.UserPasswordType
+}
+
+// Username returns the username of the credential.
+func (c *UsernamePasswordCredential) Username() string {
+	return c.GetUsername()
+}
+
+// Password returns the password of the credential. It is empty unless the
+// credential has been retrieved for a session.
+func (c *UsernamePasswordCredential) Password() credential.Password {
+	return credential.Password(c.GetPassword())
+}
+
+// Secret returns the username and password of the credential. It is nil
+// unless the credential has been retrieved for a session.
+func (c *UsernamePasswordCredential) Secret() credential.SecretData {
+	if len(c.UsernamePasswordCredential.Password) == 0 {
+		return nil
+	}
+	return map[string]interface{}{
+		"username": c.GetUsername(),
+		"password": string(c.GetPassword()),
+	}
+}
+
+var (
+	_ credential.Static       = (*UsernamePasswordCredential)(nil)
+	_ credential.UserPassword = (*UsernamePasswordCredential)(nil)
+)
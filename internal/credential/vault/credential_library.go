@@ -25,19 +25,22 @@ type CredentialLibrary struct {
 	*store.CredentialLibrary
 	tableName string `gorm:"-"`
 
-	MappingOverride MappingOverride `gorm:"-"`
+	MappingOverride        MappingOverride         `gorm:"-"`
+	SshCertificateSettings *SshCertificateSettings `gorm:"-"`
 }
 
 // NewCredentialLibrary creates a new in memory CredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId.
-// Name, description, method, request body, credential type, and mapping
-// override are the only valid options. All other options are ignored.
+// Name, description, method, request body, credential type, mapping
+// override, and ssh certificate settings are the only valid options. All
+// other options are ignored.
 func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.NewCredentialLibrary"
 	opts := getOpts(opt...)
 
 	l := &CredentialLibrary{
-		MappingOverride: opts.withMappingOverride,
+		MappingOverride:        opts.withMappingOverride,
+		SshCertificateSettings: opts.withSshCertificateSettings,
 		CredentialLibrary: &store.CredentialLibrary{
 			StoreId:         storeId,
 			Name:            opts.withName,
@@ -57,7 +60,29 @@ func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) erro
 	case !validMappingOverride(l.MappingOverride, l.CredentialType()):
 		return errors.New(ctx, errors.VaultInvalidMappingOverride, caller, "invalid credential type for mapping override")
 	}
-	return nil
+	return validSshCertificateLibrary(ctx, caller, l)
+}
+
+// validSshCertificateLibrary checks that ssh certificate settings are only
+// set on, and are required by, libraries with the ssh_certificate
+// credential type. The request sent to Vault is built from the settings, so
+// these libraries must use POST and cannot have a request body.
+func validSshCertificateLibrary(ctx context.Context, caller errors.Op, l *CredentialLibrary) error {
+	if l.CredentialType() != credential.SshCertificateType {
+		if l.SshCertificateSettings != nil {
+			return errors.New(ctx, errors.InvalidParameter, caller, "ssh certificate settings can only be set for the ssh_certificate credential type")
+		}
+		return nil
+	}
+	switch {
+	case l.SshCertificateSettings == nil:
+		return errors.New(ctx, errors.InvalidParameter, caller, "ssh_certificate credential type requires ssh certificate settings")
+	case Method(l.HttpMethod) != MethodPost:
+		return errors.New(ctx, errors.InvalidParameter, caller, "ssh_certificate credential type requires the POST http method")
+	case len(l.HttpRequestBody) > 0:
+		return errors.New(ctx, errors.InvalidParameter, caller, "ssh_certificate credential type does not support a request body")
+	}
+	return l.SshCertificateSettings.validate(ctx, caller)
 }
 
 func allocCredentialLibrary() *CredentialLibrary {
@@ -72,10 +97,16 @@ func (l *CredentialLibrary) clone() *CredentialLibrary {
 		m = l.MappingOverride.clone()
 	}
 
+	var sc *SshCertificateSettings
+	if l.SshCertificateSettings != nil {
+		sc = l.SshCertificateSettings.clone()
+	}
+
 	cp := proto.Clone(l.CredentialLibrary)
 	return &CredentialLibrary{
-		MappingOverride:   m,
-		CredentialLibrary: cp.(*store.CredentialLibrary),
+		MappingOverride:        m,
+		SshCertificateSettings: sc,
+		CredentialLibrary:      cp.(*store.CredentialLibrary),
 	}
 }
 
@@ -84,6 +115,9 @@ func (l *CredentialLibrary) setId(i string) {
 	if l.MappingOverride != nil {
 		l.MappingOverride.setLibraryId(i)
 	}
+	if l.SshCertificateSettings != nil {
+		l.SshCertificateSettings.setLibraryId(i)
+	}
 }
 
 // TableName returns the table name.
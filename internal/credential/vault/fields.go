@@ -21,4 +21,8 @@ const (
 	// MappingOverrideField represents the field mask indicating a mapping override
 	// update has been requested.
 	MappingOverrideField = "MappingOverride"
+
+	// SshCertificateSettingsField represents the field mask indicating an
+	// ssh certificate settings update has been requested.
+	SshCertificateSettingsField = "SshCertificateSettings"
 )
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
@@ -562,11 +563,29 @@ func (r *CredentialRenewalJob) renewCred(ctx context.Context, c *privateCredenti
 		return errors.Wrap(ctx, err, op)
 	}
 
+	cred := c.toCredential()
+	if credential.Type(c.CredentialType) == credential.SshCertificateType {
+		// ssh certificates signed by Vault do not have a lease that can be
+		// renewed. Their renewal time is their expiration time so they
+		// are only set to "expired" once they can no longer be used.
+		if c.ExpirationTime.AsTime().After(time.Now()) {
+			return nil
+		}
+		query, values := cred.updateStatusQuery(ExpiredCredential)
+		numRows, err := r.writer.Exec(ctx, query, values)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if numRows != 1 {
+			return errors.New(ctx, errors.Unknown, op, "credential expired but failed to update repo")
+		}
+		return nil
+	}
+
 	vc, err := c.client()
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
-	cred := c.toCredential()
 
 	var respErr *vault.ResponseError
 	// Subtract last renewal time from previous expiration time to get lease duration
@@ -721,21 +740,25 @@ func (r *CredentialRevocationJob) revokeCred(ctx context.Context, c *privateCred
 		return errors.Wrap(ctx, err, op)
 	}
 
-	vc, err := c.client()
-	if err != nil {
-		return errors.Wrap(ctx, err, op)
-	}
-
 	cred := c.toCredential()
-	var respErr *vault.ResponseError
-	err = vc.revokeLease(c.ExternalId)
-	if ok := errors.As(err, &respErr); ok && respErr.StatusCode == http.StatusBadRequest {
-		// Vault returned a 400 when attempting a revoke lease, the lease is already expired.
-		// Clobber error and set status to "revoked" below.
-		err = nil
-	}
-	if err != nil {
-		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke credential"))
+	// ssh certificates signed by Vault do not have a lease and cannot be
+	// revoked in Vault, they remain valid until they expire.
+	if credential.Type(c.CredentialType) != credential.SshCertificateType {
+		vc, err := c.client()
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+
+		var respErr *vault.ResponseError
+		err = vc.revokeLease(c.ExternalId)
+		if ok := errors.As(err, &respErr); ok && respErr.StatusCode == http.StatusBadRequest {
+			// Vault returned a 400 when attempting a revoke lease, the lease is already expired.
+			// Clobber error and set status to "revoked" below.
+			err = nil
+		}
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("unable to revoke credential"))
+		}
 	}
 
 	query, values := cred.updateStatusQuery(RevokedCredential)
@@ -16,21 +16,29 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName           string
-	withDescription    string
-	withLimit          int
-	withCACert         []byte
-	withNamespace      string
-	withTlsServerName  string
-	withTlsSkipVerify  bool
-	withClientCert     *ClientCertificate
-	withMethod         Method
-	withRequestBody    []byte
-	withCredentialType credential.Type
+	withName             string
+	withDescription      string
+	withLimit            int
+	withStartPageAfterId string
+	withCACert           []byte
+	withNamespace        string
+	withTlsServerName    string
+	withTlsSkipVerify    bool
+	withClientCert       *ClientCertificate
+	withMethod           Method
+	withRequestBody      []byte
+	withCredentialType   credential.Type
 
 	withOverrideUsernameAttribute string
 	withOverridePasswordAttribute string
 	withMappingOverride           MappingOverride
+
+	withSshKeyType             KeyType
+	withSshKeyBits             uint32
+	withSshTtl                 string
+	withSshValidPrincipals     []string
+	withSshExtensions          map[string]string
+	withSshCertificateSettings *SshCertificateSettings
 }
 
 func getDefaultOptions() options {
@@ -60,6 +68,14 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
 // WithCACert provides an optional PEM-encoded certificate
 // to verify the Vault server's SSL certificate.
 func WithCACert(cert []byte) Option {
@@ -148,3 +164,51 @@ func WithMappingOverride(m MappingOverride) Option {
 		o.withMappingOverride = m
 	}
 }
+
+// WithSshKeyType provides the type of key to generate for each ssh
+// certificate.
+func WithSshKeyType(t KeyType) Option {
+	return func(o *options) {
+		o.withSshKeyType = t
+	}
+}
+
+// WithSshKeyBits provides the size of the key to generate for each ssh
+// certificate.
+func WithSshKeyBits(b uint32) Option {
+	return func(o *options) {
+		o.withSshKeyBits = b
+	}
+}
+
+// WithSshTtl provides the time to live to request for each ssh
+// certificate, as a Vault duration string.
+func WithSshTtl(ttl string) Option {
+	return func(o *options) {
+		o.withSshTtl = ttl
+	}
+}
+
+// WithSshValidPrincipals provides additional principals to include in each
+// ssh certificate.
+func WithSshValidPrincipals(p []string) Option {
+	return func(o *options) {
+		o.withSshValidPrincipals = p
+	}
+}
+
+// WithSshExtensions provides the extensions to request for each ssh
+// certificate.
+func WithSshExtensions(e map[string]string) Option {
+	return func(o *options) {
+		o.withSshExtensions = e
+	}
+}
+
+// WithSshCertificateSettings provides the settings used by a credential
+// library with the ssh_certificate credential type.
+func WithSshCertificateSettings(s *SshCertificateSettings) Option {
+	return func(o *options) {
+		o.withSshCertificateSettings = s
+	}
+}
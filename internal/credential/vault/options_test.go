@@ -28,6 +28,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		opts := getOpts(WithStartPageAfterId("csvlt_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "csvlt_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithCACert", func(t *testing.T) {
 		opts := getOpts(WithCACert([]byte("test cert")))
 		testOpts := getDefaultOptions()
@@ -108,4 +114,41 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withMappingOverride = unknownMapper(1)
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithSshKeyType", func(t *testing.T) {
+		opts := getOpts(WithSshKeyType(KeyTypeRsa))
+		testOpts := getDefaultOptions()
+		testOpts.withSshKeyType = KeyTypeRsa
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSshKeyBits", func(t *testing.T) {
+		opts := getOpts(WithSshKeyBits(4096))
+		testOpts := getDefaultOptions()
+		testOpts.withSshKeyBits = 4096
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSshTtl", func(t *testing.T) {
+		opts := getOpts(WithSshTtl("5m"))
+		testOpts := getDefaultOptions()
+		testOpts.withSshTtl = "5m"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSshValidPrincipals", func(t *testing.T) {
+		opts := getOpts(WithSshValidPrincipals([]string{"web", "db"}))
+		testOpts := getDefaultOptions()
+		testOpts.withSshValidPrincipals = []string{"web", "db"}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSshExtensions", func(t *testing.T) {
+		opts := getOpts(WithSshExtensions(map[string]string{"permit-pty": ""}))
+		testOpts := getDefaultOptions()
+		testOpts.withSshExtensions = map[string]string{"permit-pty": ""}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithSshCertificateSettings", func(t *testing.T) {
+		s := NewSshCertificateSettings("alice")
+		opts := getOpts(WithSshCertificateSettings(s))
+		testOpts := getDefaultOptions()
+		testOpts.withSshCertificateSettings = s
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -24,6 +24,7 @@ type privateCredential struct {
 	IsRenewable          bool
 	Status               string
 	RenewalTime          *timestamp.Timestamp
+	CredentialType       string
 	TokenHmac            []byte
 	Token                TokenSecret
 	CtToken              []byte
@@ -29,6 +29,19 @@ type usrPassCred struct {
 func (c *usrPassCred) Username() string              { return c.username }
 func (c *usrPassCred) Password() credential.Password { return c.password }
 
+var _ credential.SshCertificate = (*sshCertCred)(nil)
+
+type sshCertCred struct {
+	*baseCred
+	username    string
+	privateKey  credential.PrivateKey
+	certificate []byte
+}
+
+func (c *sshCertCred) Username() string               { return c.username }
+func (c *sshCertCred) Private() credential.PrivateKey { return c.privateKey }
+func (c *sshCertCred) Certificate() []byte            { return c.certificate }
+
 var _ credential.Dynamic = (*baseCred)(nil)
 
 type baseCred struct {
@@ -49,6 +62,8 @@ func convert(ctx context.Context, bc *baseCred) (dynamicCred, error) {
 	switch bc.Library().CredentialType() {
 	case credential.UserPasswordType:
 		return baseToUsrPass(ctx, bc)
+	case credential.SshCertificateType:
+		return baseToSshCert(ctx, bc)
 	}
 	return bc, nil
 }
@@ -82,6 +97,34 @@ func baseToUsrPass(ctx context.Context, bc *baseCred) (*usrPassCred, error) {
 	}, nil
 }
 
+// baseToSshCert converts bc to an sshCertCred. The private key generated
+// for the certificate must have been added to the secret data under the
+// "private_key" attribute and the certificate signed by Vault must be in
+// the "signed_key" attribute.
+func baseToSshCert(ctx context.Context, bc *baseCred) (*sshCertCred, error) {
+	switch {
+	case bc == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred"))
+	case bc.lib == nil:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("nil baseCred.lib"))
+	case bc.Library().CredentialType() != credential.SshCertificateType:
+		return nil, errors.E(ctx, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid credential type"))
+	}
+
+	privateKey, _ := bc.secretData[sshPrivateKeyAttribute].(string)
+	certificate, _ := bc.secretData["signed_key"].(string)
+	if privateKey == "" || certificate == "" {
+		return nil, errors.E(ctx, errors.WithCode(errors.VaultInvalidCredentialMapping))
+	}
+
+	return &sshCertCred{
+		baseCred:    bc,
+		username:    bc.lib.SshUsername,
+		privateKey:  credential.PrivateKey(privateKey),
+		certificate: []byte(certificate),
+	}, nil
+}
+
 var _ credential.Library = (*privateLibrary)(nil)
 
 // A privateLibrary contains all the values needed to connect to Vault and
@@ -115,6 +158,13 @@ type privateLibrary struct {
 	CtClientKey       []byte
 	ClientKeyId       string
 	Purpose           credential.Purpose `gorm:"-"`
+
+	SshUsername        string
+	SshKeyType         string
+	SshKeyBits         uint32
+	SshTtl             string
+	SshValidPrincipals string
+	SshExtensions      []byte
 }
 
 func (pl *privateLibrary) clone() *privateLibrary {
@@ -149,6 +199,13 @@ func (pl *privateLibrary) clone() *privateLibrary {
 		CtClientKey:       append(pl.CtClientKey[:0:0], pl.CtClientKey...),
 		ClientKeyId:       pl.ClientKeyId,
 		Purpose:           pl.Purpose,
+
+		SshUsername:        pl.SshUsername,
+		SshKeyType:         pl.SshKeyType,
+		SshKeyBits:         pl.SshKeyBits,
+		SshTtl:             pl.SshTtl,
+		SshValidPrincipals: pl.SshValidPrincipals,
+		SshExtensions:      append(pl.SshExtensions[:0:0], pl.SshExtensions...),
 	}
 }
 
@@ -247,12 +304,27 @@ func (pl *privateLibrary) retrieveCredential(ctx context.Context, op errors.Op,
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
+	body := pl.HttpRequestBody
+	var sshPrivateKey []byte
+	if pl.CredentialType() == credential.SshCertificateType {
+		settings := pl.sshCertificateSettings()
+		var pub string
+		sshPrivateKey, pub, err = settings.generateKey()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate ssh key"))
+		}
+		body, err = settings.requestBody(pub)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build ssh certificate request"))
+		}
+	}
+
 	var secret *vault.Secret
 	switch Method(pl.HttpMethod) {
 	case MethodGet:
 		secret, err = client.get(pl.VaultPath)
 	case MethodPost:
-		secret, err = client.post(pl.VaultPath, pl.HttpRequestBody)
+		secret, err = client.post(pl.VaultPath, body)
 	default:
 		return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("unknown http method: library: %s", pl.PublicId))
 	}
@@ -266,8 +338,22 @@ func (pl *privateLibrary) retrieveCredential(ctx context.Context, op errors.Op,
 		return nil, errors.E(ctx, errors.WithCode(errors.VaultEmptySecret), errors.WithOp(op))
 	}
 
+	externalId := secret.LeaseID
 	leaseDuration := time.Duration(secret.LeaseDuration) * time.Second
-	cred, err := newCredential(pl.GetPublicId(), sessionId, secret.LeaseID, pl.TokenHmac, leaseDuration)
+	if sshPrivateKey != nil {
+		// Certificates signed by the Vault SSH secrets engine do not have a
+		// lease. The certificate's serial number and validity are used
+		// instead so the credential is tracked until it expires.
+		externalId, leaseDuration, err = sshCertificateLease(secret)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultInvalidCredentialMapping))
+		}
+		if secret.Data == nil {
+			secret.Data = make(map[string]interface{})
+		}
+		secret.Data[sshPrivateKeyAttribute] = string(sshPrivateKey)
+	}
+	cred, err := newCredential(pl.GetPublicId(), sessionId, externalId, pl.TokenHmac, leaseDuration)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -282,6 +368,19 @@ func (pl *privateLibrary) retrieveCredential(ctx context.Context, op errors.Op,
 	return convert(ctx, dCred)
 }
 
+// sshCertificateSettings returns the library's ssh certificate settings.
+func (pl *privateLibrary) sshCertificateSettings() *SshCertificateSettings {
+	s := allocSshCertificateSettings()
+	s.LibraryId = pl.PublicId
+	s.Username = pl.SshUsername
+	s.KeyType = pl.SshKeyType
+	s.KeyBits = pl.SshKeyBits
+	s.Ttl = pl.SshTtl
+	s.ValidPrincipals = pl.SshValidPrincipals
+	s.Extensions = pl.SshExtensions
+	return s
+}
+
 // TableName returns the table name for gorm.
 func (pl *privateLibrary) TableName() string {
 	return "credential_vault_library_private"
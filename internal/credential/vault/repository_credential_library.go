@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	dbcommon "github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -47,6 +48,9 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, scopeId string
 
 	if l.HttpMethod == "" {
 		l.HttpMethod = string(MethodGet)
+		if l.CredentialType() == credential.SshCertificateType {
+			l.HttpMethod = string(MethodPost)
+		}
 	}
 
 	if err := l.validate(ctx, op); err != nil {
@@ -91,6 +95,15 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, scopeId string
 				msgs = append(msgs, &msg)
 			}
 
+			// insert ssh certificate settings (if exists)
+			if l.SshCertificateSettings != nil {
+				var msg oplog.Message
+				if err := w.Create(ctx, newCredentialLibrary.SshCertificateSettings, db.NewOplogMsg(&msg)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, &msg)
+			}
+
 			metadata := l.oplog(oplog.OpType_OP_TYPE_CREATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -114,8 +127,10 @@ func (r *Repository) CreateCredentialLibrary(ctx context.Context, scopeId string
 // number of records updated. l is not changed.
 //
 // l must contain a valid PublicId. Only Name, Description, VaultPath,
-// HttpMethod, HttpRequestBody, and MappingOverride can be updated. If
-// l.Name is set to a non-empty string, it must be unique within l.StoreId.
+// HttpMethod, HttpRequestBody, MappingOverride, and SshCertificateSettings
+// can be updated. If l.Name is set to a non-empty string, it must be unique
+// within l.StoreId. SshCertificateSettings can only be replaced, not
+// removed, and only for a library with the ssh_certificate credential type.
 //
 // An attribute of l will be set to NULL in the database if the attribute
 // in l is the zero value and it is included in fieldMaskPaths except for
@@ -141,7 +156,7 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, scopeId string
 	}
 	l = l.clone()
 
-	var updateMappingOverride bool
+	var updateMappingOverride, updateSshCertificateSettings bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(nameField, f):
@@ -151,6 +166,8 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, scopeId string
 		case strings.EqualFold(httpRequestBodyField, f):
 		case strings.EqualFold(MappingOverrideField, f):
 			updateMappingOverride = true
+		case strings.EqualFold(SshCertificateSettingsField, f):
+			updateSshCertificateSettings = true
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
@@ -164,6 +181,8 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, scopeId string
 			httpMethodField:      l.HttpMethod,
 			httpRequestBodyField: l.HttpRequestBody,
 			MappingOverrideField: l.MappingOverride,
+
+			SshCertificateSettingsField: l.SshCertificateSettings,
 		},
 		fieldMaskPaths,
 		nil,
@@ -193,12 +212,32 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, scopeId string
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential library %s", l.PublicId))
 	case updateMappingOverride && !validMappingOverride(l.MappingOverride, origLib.CredentialType()):
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.VaultInvalidMappingOverride, op, "invalid mapping override for credential type")
+	case updateSshCertificateSettings && origLib.CredentialType() != credential.SshCertificateType:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "ssh certificate settings can only be set for the ssh_certificate credential type")
+	case updateSshCertificateSettings && l.SshCertificateSettings == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "ssh certificate settings cannot be removed")
+	case updateSshCertificateSettings:
+		if err := l.SshCertificateSettings.validate(ctx, op); err != nil {
+			return nil, db.NoRowsAffected, err // intentionally not wrapped.
+		}
+	}
+	if origLib.CredentialType() == credential.SshCertificateType {
+		// The request sent to Vault for an ssh certificate is built from
+		// the library's settings.
+		switch {
+		case strutil.StrListContains(dbMask, httpRequestBodyField):
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "ssh_certificate credential type does not support a request body")
+		case strutil.StrListContains(dbMask, httpMethodField) && Method(l.HttpMethod) != MethodPost,
+			strutil.StrListContains(nullFields, httpMethodField):
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "ssh_certificate credential type requires the POST http method")
+		}
 	}
 
 	var filteredDbMask, filteredNullFields []string
 	for _, f := range dbMask {
 		switch {
 		case strings.EqualFold(MappingOverrideField, f):
+		case strings.EqualFold(SshCertificateSettingsField, f):
 		default:
 			filteredDbMask = append(filteredDbMask, f)
 		}
@@ -206,6 +245,7 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, scopeId string
 	for _, f := range nullFields {
 		switch {
 		case strings.EqualFold(MappingOverrideField, f):
+		case strings.EqualFold(SshCertificateSettingsField, f):
 		default:
 			filteredNullFields = append(filteredNullFields, f)
 		}
@@ -296,6 +336,27 @@ func (r *Repository) UpdateCredentialLibrary(ctx context.Context, scopeId string
 				}
 			}
 
+			// Replace the ssh certificate settings if applicable
+			if updateSshCertificateSettings {
+				if origLib.SshCertificateSettings != nil {
+					var msg oplog.Message
+					rowsDeleted, err := w.Delete(ctx, origLib.SshCertificateSettings, db.NewOplogMsg(&msg))
+					switch {
+					case err != nil:
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete ssh certificate settings"))
+					case rowsDeleted > 1:
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("delete ssh certificate settings and %d rows deleted", rowsDeleted))
+					}
+					msgs = append(msgs, &msg)
+				}
+				var msg oplog.Message
+				l.SshCertificateSettings.setLibraryId(l.PublicId)
+				if err := w.Create(ctx, l.SshCertificateSettings, db.NewOplogMsg(&msg)); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, &msg)
+			}
+
 			metadata := l.oplog(oplog.OpType_OP_TYPE_UPDATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -357,6 +418,13 @@ type publicLibrary struct {
 	CredentialType    string
 	UsernameAttribute string
 	PasswordAttribute string
+
+	SshUsername        string
+	SshKeyType         string
+	SshKeyBits         uint32
+	SshTtl             string
+	SshValidPrincipals string
+	SshExtensions      []byte
 }
 
 func allocPublicLibrary() *publicLibrary {
@@ -385,6 +453,18 @@ func (pl *publicLibrary) toCredentialLibrary() *CredentialLibrary {
 		up.sanitize()
 		cl.MappingOverride = up
 	}
+
+	if pl.SshUsername != "" {
+		sc := allocSshCertificateSettings()
+		sc.LibraryId = pl.PublicId
+		sc.Username = pl.SshUsername
+		sc.KeyType = pl.SshKeyType
+		sc.KeyBits = pl.SshKeyBits
+		sc.Ttl = pl.SshTtl
+		sc.ValidPrincipals = pl.SshValidPrincipals
+		sc.Extensions = pl.SshExtensions
+		cl.SshCertificateSettings = sc
+	}
 	return cl
 }
 
@@ -434,7 +514,8 @@ func (r *Repository) DeleteCredentialLibrary(ctx context.Context, scopeId string
 }
 
 // ListCredentialLibraries returns a slice of CredentialLibraries for the
-// storeId. WithLimit is the only option supported.
+// storeId, ordered by public id. WithLimit and WithStartPageAfterId are the
+// only options supported.
 func (r *Repository) ListCredentialLibraries(ctx context.Context, storeId string, opt ...Option) ([]*CredentialLibrary, error) {
 	const op = "vault.(Repository).ListCredentialLibraries"
 	if storeId == "" {
@@ -447,7 +528,11 @@ func (r *Repository) ListCredentialLibraries(ctx context.Context, storeId string
 		limit = opts.withLimit
 	}
 	var libs []*CredentialLibrary
-	err := r.reader.SearchWhere(ctx, &libs, "store_id = ?", []interface{}{storeId}, db.WithLimit(limit))
+	where, args := "store_id = ?", []interface{}{storeId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &libs, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
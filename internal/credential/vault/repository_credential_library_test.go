@@ -183,6 +183,63 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid-ssh-certificate-credential-type",
+			in: &CredentialLibrary{
+				SshCertificateSettings: NewSshCertificateSettings("alice", WithSshTtl("5m"), WithSshExtensions(map[string]string{"permit-pty": ""})),
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:        cs.GetPublicId(),
+					VaultPath:      "ssh/sign/dev",
+					CredentialType: string(credential.SshCertificateType),
+				},
+			},
+			want: &CredentialLibrary{
+				SshCertificateSettings: NewSshCertificateSettings("alice", WithSshTtl("5m"), WithSshExtensions(map[string]string{"permit-pty": ""})),
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:        cs.GetPublicId(),
+					HttpMethod:     "POST",
+					VaultPath:      "ssh/sign/dev",
+					CredentialType: string(credential.SshCertificateType),
+				},
+			},
+		},
+		{
+			name: "ssh-certificate-credential-type-no-settings",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:        cs.GetPublicId(),
+					VaultPath:      "ssh/sign/dev",
+					CredentialType: string(credential.SshCertificateType),
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "ssh-certificate-credential-type-GET-method",
+			in: &CredentialLibrary{
+				SshCertificateSettings: NewSshCertificateSettings("alice"),
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:        cs.GetPublicId(),
+					HttpMethod:     "GET",
+					VaultPath:      "ssh/sign/dev",
+					CredentialType: string(credential.SshCertificateType),
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
+		{
+			name: "ssh-certificate-settings-wrong-credential-type",
+			in: &CredentialLibrary{
+				SshCertificateSettings: NewSshCertificateSettings("alice"),
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:        cs.GetPublicId(),
+					HttpMethod:     "POST",
+					VaultPath:      "ssh/sign/dev",
+					CredentialType: string(credential.UserPasswordType),
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
 		{
 			name: "unknown-mapping-override-type",
 			in: &CredentialLibrary{
@@ -332,6 +389,19 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 				override := allocUserPasswordOverride()
 				assert.NoError(rw.LookupWhere(ctx, &override, "library_id = ?", got.GetPublicId()))
 			}
+
+			if tt.want.SshCertificateSettings != nil {
+				assert.Equal(tt.want.HttpMethod, got.HttpMethod)
+				require.NotNil(got.SshCertificateSettings)
+				assert.Equal(tt.want.SshCertificateSettings.GetUsername(), got.SshCertificateSettings.GetUsername())
+				assert.Equal(tt.want.SshCertificateSettings.GetTtl(), got.SshCertificateSettings.GetTtl())
+				assert.Equal(tt.want.SshCertificateSettings.GetExtensions(), got.SshCertificateSettings.GetExtensions())
+
+				// verify it was persisted in the database
+				settings := allocSshCertificateSettings()
+				assert.NoError(rw.LookupWhere(ctx, &settings, "library_id = ?", got.GetPublicId()))
+				assert.Equal(tt.want.SshCertificateSettings.GetUsername(), settings.GetUsername())
+			}
 		})
 	}
 
@@ -638,7 +638,8 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 }
 
 // ListCredentialStores returns a slice of CredentialStores for the
-// scopeIds. WithLimit is the only option supported.
+// scopeIds, ordered by public id. WithLimit and WithStartPageAfterId are the
+// only options supported.
 func (r *Repository) ListCredentialStores(ctx context.Context, scopeIds []string, opt ...Option) ([]*CredentialStore, error) {
 	const op = "vault.(Repository).ListCredentialStores"
 	if len(scopeIds) == 0 {
@@ -651,7 +652,11 @@ func (r *Repository) ListCredentialStores(ctx context.Context, scopeIds []string
 		limit = opts.withLimit
 	}
 	var credentialStores []*publicStore
-	err := r.reader.SearchWhere(ctx, &credentialStores, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit))
+	where, args := "scope_id in (?)", []interface{}{scopeIds}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &credentialStores, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
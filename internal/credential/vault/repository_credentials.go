@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 )
 
 var _ credential.Issuer = (*Repository)(nil)
@@ -36,6 +37,7 @@ func (r *Repository) Issue(ctx context.Context, sessionId string, requests []cre
 	for _, lib := range libs {
 		cred, err := lib.retrieveCredential(ctx, op, sessionId)
 		if err != nil {
+			metrics.VaultCredentialIssueErrors.WithLabelValues(lib.GetPublicId()).Inc()
 			return nil, err
 		}
 		if minLease > cred.getExpiration() {
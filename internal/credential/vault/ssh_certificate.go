@@ -0,0 +1,269 @@
+package vault
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/strutil"
+	vault "github.com/hashicorp/vault/api"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+// A KeyType is the type of key generated for an ssh certificate.
+type KeyType string
+
+// Key types supported by SshCertificateSettings.
+const (
+	KeyTypeEd25519 KeyType = "ed25519"
+	KeyTypeEcdsa   KeyType = "ecdsa"
+	KeyTypeRsa     KeyType = "rsa"
+)
+
+// defaultRsaKeyBits is the size of rsa keys generated when
+// SshCertificateSettings.KeyBits is zero. ecdsa keys default to the P-256
+// curve.
+const defaultRsaKeyBits = 2048
+
+// sshPrivateKeyAttribute is the attribute the private key generated for an
+// ssh certificate is added to in the secret data returned by Vault.
+const sshPrivateKeyAttribute = "private_key"
+
+// SshCertificateSettings contains the values a credential library with the
+// ssh_certificate credential type uses to request a certificate from the
+// Vault SSH secrets engine. A new key pair is generated for every
+// certificate and only its public key is sent to Vault.
+type SshCertificateSettings struct {
+	*store.SshCertificateSettings
+	tableName string `gorm:"-"`
+}
+
+// NewSshCertificateSettings creates a new in memory SshCertificateSettings
+// for certificates used to log in as username. WithSshKeyType,
+// WithSshKeyBits, WithSshTtl, WithSshValidPrincipals and WithSshExtensions
+// are the only valid options. All other options are ignored.
+//
+// If WithSshExtensions is provided with a map that cannot be marshaled,
+// the extensions are left empty.
+func NewSshCertificateSettings(username string, opt ...Option) *SshCertificateSettings {
+	opts := getOpts(opt...)
+	s := &SshCertificateSettings{
+		SshCertificateSettings: &store.SshCertificateSettings{
+			Username:        username,
+			KeyType:         string(opts.withSshKeyType),
+			KeyBits:         opts.withSshKeyBits,
+			Ttl:             opts.withSshTtl,
+			ValidPrincipals: strings.Join(opts.withSshValidPrincipals, ","),
+		},
+	}
+	if len(opts.withSshExtensions) > 0 {
+		if b, err := json.Marshal(opts.withSshExtensions); err == nil {
+			s.Extensions = b
+		}
+	}
+	return s
+}
+
+func allocSshCertificateSettings() *SshCertificateSettings {
+	return &SshCertificateSettings{
+		SshCertificateSettings: &store.SshCertificateSettings{},
+	}
+}
+
+func (s *SshCertificateSettings) clone() *SshCertificateSettings {
+	cp := proto.Clone(s.SshCertificateSettings)
+	return &SshCertificateSettings{
+		SshCertificateSettings: cp.(*store.SshCertificateSettings),
+	}
+}
+
+func (s *SshCertificateSettings) setLibraryId(i string) {
+	s.LibraryId = i
+}
+
+// TableName returns the table name.
+func (s *SshCertificateSettings) TableName() string {
+	if s.tableName != "" {
+		return s.tableName
+	}
+	return "credential_vault_library_ssh_certificate"
+}
+
+// SetTableName sets the table name.
+func (s *SshCertificateSettings) SetTableName(n string) {
+	s.tableName = n
+}
+
+// keyType returns the type of key to generate, defaulting to
+// KeyTypeEd25519.
+func (s *SshCertificateSettings) keyType() KeyType {
+	if s.GetKeyType() == "" {
+		return KeyTypeEd25519
+	}
+	return KeyType(s.GetKeyType())
+}
+
+// Principals returns the principals requested for a certificate. The
+// username is always the first principal.
+func (s *SshCertificateSettings) Principals() []string {
+	principals := []string{s.GetUsername()}
+	for _, p := range strings.Split(s.GetValidPrincipals(), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			principals = append(principals, p)
+		}
+	}
+	return strutil.RemoveDuplicatesStable(principals, false)
+}
+
+// ExtensionsMap returns the extensions requested for a certificate.
+func (s *SshCertificateSettings) ExtensionsMap() (map[string]string, error) {
+	if len(s.GetExtensions()) == 0 {
+		return nil, nil
+	}
+	var m map[string]string
+	if err := json.Unmarshal(s.GetExtensions(), &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s *SshCertificateSettings) validate(ctx context.Context, caller errors.Op) error {
+	switch {
+	case strings.TrimSpace(s.GetUsername()) == "":
+		return errors.New(ctx, errors.InvalidParameter, caller, "ssh certificate settings: no username")
+	}
+	switch kt := s.keyType(); kt {
+	case KeyTypeEd25519:
+		if s.GetKeyBits() != 0 {
+			return errors.New(ctx, errors.InvalidParameter, caller, "ssh certificate settings: key bits cannot be set for ed25519 keys")
+		}
+	case KeyTypeEcdsa:
+		switch s.GetKeyBits() {
+		case 0, 256, 384, 521:
+		default:
+			return errors.New(ctx, errors.InvalidParameter, caller, "ssh certificate settings: invalid key bits for ecdsa keys")
+		}
+	case KeyTypeRsa:
+		switch s.GetKeyBits() {
+		case 0, 2048, 3072, 4096:
+		default:
+			return errors.New(ctx, errors.InvalidParameter, caller, "ssh certificate settings: invalid key bits for rsa keys")
+		}
+	default:
+		return errors.New(ctx, errors.InvalidParameter, caller, fmt.Sprintf("ssh certificate settings: unknown key type: %q", kt))
+	}
+	if _, err := s.ExtensionsMap(); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, caller, "ssh certificate settings: extensions must be a JSON object with string values")
+	}
+	return nil
+}
+
+// generateKey generates a new private key of the configured type and size.
+// It returns the PEM encoded private key and the public key in the
+// authorized_keys format.
+func (s *SshCertificateSettings) generateKey() (privPem []byte, pub string, err error) {
+	var priv crypto.Signer
+	switch s.keyType() {
+	case KeyTypeEd25519:
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+	case KeyTypeEcdsa:
+		var curve elliptic.Curve
+		switch s.GetKeyBits() {
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			curve = elliptic.P256()
+		}
+		priv, err = ecdsa.GenerateKey(curve, rand.Reader)
+	case KeyTypeRsa:
+		bits := int(s.GetKeyBits())
+		if bits == 0 {
+			bits = defaultRsaKeyBits
+		}
+		priv, err = rsa.GenerateKey(rand.Reader, bits)
+	default:
+		return nil, "", fmt.Errorf("unknown key type: %q", s.GetKeyType())
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, "", err
+	}
+	sshPub, err := ssh.NewPublicKey(priv.Public())
+	if err != nil {
+		return nil, "", err
+	}
+	privPem = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	return privPem, string(ssh.MarshalAuthorizedKey(sshPub)), nil
+}
+
+// requestBody returns the body of a request to the sign endpoint of the
+// Vault SSH secrets engine for the public key pub.
+//
+// See https://www.vaultproject.io/api-docs/secret/ssh#sign-ssh-key
+func (s *SshCertificateSettings) requestBody(pub string) ([]byte, error) {
+	body := map[string]interface{}{
+		"public_key":       pub,
+		"cert_type":        "user",
+		"valid_principals": strings.Join(s.Principals(), ","),
+	}
+	if s.GetTtl() != "" {
+		body["ttl"] = s.GetTtl()
+	}
+	ext, err := s.ExtensionsMap()
+	if err != nil {
+		return nil, err
+	}
+	if len(ext) > 0 {
+		body["extensions"] = ext
+	}
+	return json.Marshal(body)
+}
+
+// sshCertificateLease returns the serial number of the certificate in
+// secret and the time until the certificate expires. Certificates without
+// an expiration have a duration of zero.
+func sshCertificateLease(secret *vault.Secret) (serial string, expiresIn time.Duration, err error) {
+	signed, _ := secret.Data["signed_key"].(string)
+	if signed == "" {
+		return "", 0, fmt.Errorf("missing signed_key in secret")
+	}
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(signed))
+	if err != nil {
+		return "", 0, fmt.Errorf("unable to parse signed_key: %w", err)
+	}
+	cert, ok := pub.(*ssh.Certificate)
+	if !ok {
+		return "", 0, fmt.Errorf("signed_key is not an ssh certificate")
+	}
+	serial, _ = secret.Data["serial_number"].(string)
+	if serial == "" {
+		serial = fmt.Sprintf("%016x", cert.Serial)
+	}
+	if cert.ValidBefore == ssh.CertTimeInfinity {
+		return serial, 0, nil
+	}
+	expiresIn = time.Until(time.Unix(int64(cert.ValidBefore), 0))
+	if expiresIn <= 0 {
+		return "", 0, fmt.Errorf("signed_key has already expired")
+	}
+	return serial, expiresIn, nil
+}
@@ -54,6 +54,8 @@ type Options struct {
 
 	withOnConflict   *OnConflict
 	withRowsAffected *int64
+
+	withOffset int
 }
 
 type oplogOpts struct {
@@ -138,6 +140,14 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithOffset provides an option to skip the first offset results when
+// searching. It is used with WithOrder to read the pages of a list.
+func WithOffset(offset int) Option {
+	return func(o *Options) {
+		o.withOffset = offset
+	}
+}
+
 // WithVersion provides an option version number for update operations.
 func WithVersion(version *uint32) Option {
 	return func(o *Options) {
@@ -95,6 +95,13 @@ func Test_getOpts(t *testing.T) {
 		testOpts.WithLimit = 1
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithOffset", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithOffset(10))
+		testOpts := getDefaultOptions()
+		testOpts.withOffset = 10
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("NewOplogMsg", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts()
@@ -1179,7 +1179,7 @@ func (rw *Db) LookupWhere(ctx context.Context, resource interface{}, where strin
 //
 // Supports the WithLimit option.  If WithLimit < 0, then unlimited results are returned.
 // If WithLimit == 0, then default limits are used for results.
-// Supports the WithOrder and WithDebug options.
+// Supports the WithOrder, WithOffset and WithDebug options.
 func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	const op = "db.SearchWhere"
 	opts := GetOpts(opt...)
@@ -1208,6 +1208,9 @@ func (rw *Db) SearchWhere(ctx context.Context, resources interface{}, where stri
 	default:
 		db = db.Limit(opts.WithLimit)
 	}
+	if opts.withOffset > 0 {
+		db = db.Offset(opts.withOffset)
+	}
 
 	if where != "" {
 		db = db.Where(where, args...)
@@ -0,0 +1,94 @@
+begin;
+
+  -- A session connection recording holds the metadata of a recording a worker
+  -- made of both directions of a session connection. The recording itself is
+  -- stored on the worker at storage_path.
+  -- A session connection can have zero or one recording.
+  create table session_connection_recording (
+    connection_id wt_public_id primary key
+      constraint session_connection_fkey
+        references session_connection (public_id)
+        on delete cascade
+        on update cascade,
+    storage_path text not null
+      constraint storage_path_must_not_be_empty
+        check(length(trim(storage_path)) > 0),
+    size_bytes bigint not null
+      constraint size_bytes_must_be_a_non_negative_number
+        check(size_bytes >= 0),
+    checksum text not null
+      constraint checksum_must_not_be_empty
+        check(length(trim(checksum)) > 0),
+    start_time wt_timestamp,
+    end_time wt_timestamp,
+    create_time wt_timestamp,
+    constraint start_time_must_not_be_after_end_time
+      check(start_time <= end_time)
+  );
+  comment on table session_connection_recording is
+    'session_connection_recording is a table where each row contains the metadata '
+    'of the recording a worker made of a session connection.';
+
+  create trigger immutable_columns before update on session_connection_recording
+    for each row execute procedure immutable_columns('connection_id', 'storage_path', 'size_bytes', 'checksum', 'start_time', 'end_time', 'create_time');
+
+  create trigger default_create_time_column before insert on session_connection_recording
+    for each row execute procedure default_create_time();
+
+  -- Replaces the view created in 20/09 to include connection recordings
+  drop view session_list;
+  create view session_list as
+    select
+      s.public_id,
+      s.user_id,
+      s.host_id,
+      s.server_id,
+      s.server_type,
+      s.target_id,
+      s.host_set_id,
+      s.auth_token_id,
+      s.scope_id,
+      s.certificate,
+      s.expiration_time,
+      s.connection_limit,
+      s.tofu_token,
+      s.key_id,
+      s.termination_reason,
+      s.version,
+      s.create_time,
+      s.update_time,
+      s.endpoint,
+      s.worker_filter,
+      ss.state,
+      ss.previous_end_time,
+      ss.start_time,
+      ss.end_time,
+      sc.public_id as connection_id,
+      sc.client_tcp_address,
+      sc.client_tcp_port,
+      sc.endpoint_tcp_address,
+      sc.endpoint_tcp_port,
+      sc.bytes_up,
+      sc.bytes_down,
+      sc.closed_reason,
+      scr.storage_path as recording_storage_path,
+      scr.size_bytes   as recording_size_bytes,
+      scr.checksum     as recording_checksum,
+      scr.start_time   as recording_start_time,
+      scr.end_time     as recording_end_time
+    from
+      session s
+    join
+      session_state ss
+    on
+      s.public_id = ss.session_id
+    left join
+      session_connection sc
+    on
+      s.public_id = sc.session_id
+    left join
+      session_connection_recording scr
+    on
+      sc.public_id = scr.connection_id;
+
+commit;
@@ -0,0 +1,270 @@
+begin;
+
+  -- Add the credential types provided by static credentials to the
+  -- credential_type_enm table.
+  alter table credential_type_enm
+    drop constraint only_predefined_credential_types_allowed,
+    add constraint only_predefined_credential_types_allowed
+      check (
+        name in (
+          'unspecified',
+          'user_password',
+          'ssh_private_key',
+          'json'
+        )
+      )
+  ;
+
+  insert into credential_type_enm (name)
+  values
+    ('ssh_private_key'),
+    ('json');
+
+  create table credential_static_store (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null
+      constraint iam_scope_fkey
+        references iam_scope (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    constraint credential_store_fkey
+      foreign key (scope_id, public_id)
+      references credential_store (scope_id, public_id)
+      on delete cascade
+      on update cascade,
+    constraint credential_static_store_scope_id_name_uq
+      unique(scope_id, name)
+  );
+  comment on table credential_static_store is
+    'credential_static_store is a table where each row is a resource that represents a static credential store. '
+    'It is a credential_store subtype.';
+
+  create trigger update_version_column after update on credential_static_store
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on credential_static_store
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on credential_static_store
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on credential_static_store
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger insert_credential_store_subtype before insert on credential_static_store
+    for each row execute procedure insert_credential_store_subtype();
+
+  create trigger delete_credential_store_subtype after delete on credential_static_store
+    for each row execute procedure delete_credential_store_subtype();
+
+  -- The static credential subtype tables below are children of
+  -- credential_static. The secret of each credential is encrypted with the
+  -- database key of the store's scope and an hmac of the secret is kept so
+  -- the secret can be compared without being decrypted.
+
+  create table credential_static_username_password_credential (
+    public_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    store_id wt_public_id not null
+      constraint credential_static_store_fkey
+        references credential_static_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    username text not null
+      constraint username_must_not_be_empty
+        check(length(trim(username)) > 0),
+    password bytea not null -- encrypted value
+      constraint password_must_not_be_empty
+        check(length(password) > 0),
+    password_hmac bytea not null
+      constraint password_hmac_must_not_be_empty
+        check(length(password_hmac) > 0),
+    key_id text not null
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint credential_static_username_password_credential_store_id_name_uq
+      unique(store_id, name)
+  );
+  comment on table credential_static_username_password_credential is
+    'credential_static_username_password_credential is a table where each row is a resource that represents a static username and password credential. '
+    'It is a credential_static subtype and a child table of credential_static_store.';
+
+  create table credential_static_ssh_private_key_credential (
+    public_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    store_id wt_public_id not null
+      constraint credential_static_store_fkey
+        references credential_static_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    username text not null
+      constraint username_must_not_be_empty
+        check(length(trim(username)) > 0),
+    private_key bytea not null -- encrypted PEM encoded private key
+      constraint private_key_must_not_be_empty
+        check(length(private_key) > 0),
+    private_key_hmac bytea not null
+      constraint private_key_hmac_must_not_be_empty
+        check(length(private_key_hmac) > 0),
+    key_id text not null
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint credential_static_ssh_private_key_credential_store_id_name_uq
+      unique(store_id, name)
+  );
+  comment on table credential_static_ssh_private_key_credential is
+    'credential_static_ssh_private_key_credential is a table where each row is a resource that represents a static ssh private key credential. '
+    'It is a credential_static subtype and a child table of credential_static_store.';
+
+  create table credential_static_json_credential (
+    public_id wt_public_id primary key
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    store_id wt_public_id not null
+      constraint credential_static_store_fkey
+        references credential_static_store (public_id)
+        on delete cascade
+        on update cascade,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    object bytea not null -- encrypted json object
+      constraint object_must_not_be_empty
+        check(length(object) > 0),
+    object_hmac bytea not null
+      constraint object_hmac_must_not_be_empty
+        check(length(object_hmac) > 0),
+    key_id text not null
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint credential_static_json_credential_store_id_name_uq
+      unique(store_id, name)
+  );
+  comment on table credential_static_json_credential is
+    'credential_static_json_credential is a table where each row is a resource that represents a static json credential. '
+    'It is a credential_static subtype and a child table of credential_static_store.';
+
+  create trigger update_version_column after update on credential_static_username_password_credential
+    for each row execute procedure update_version_column();
+  create trigger update_time_column before update on credential_static_username_password_credential
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on credential_static_username_password_credential
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on credential_static_username_password_credential
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'create_time');
+  create trigger insert_credential_static_subtype before insert on credential_static_username_password_credential
+    for each row execute procedure insert_credential_static_subtype();
+  create trigger delete_credential_static_subtype after delete on credential_static_username_password_credential
+    for each row execute procedure delete_credential_static_subtype();
+
+  create trigger update_version_column after update on credential_static_ssh_private_key_credential
+    for each row execute procedure update_version_column();
+  create trigger update_time_column before update on credential_static_ssh_private_key_credential
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on credential_static_ssh_private_key_credential
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on credential_static_ssh_private_key_credential
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'create_time');
+  create trigger insert_credential_static_subtype before insert on credential_static_ssh_private_key_credential
+    for each row execute procedure insert_credential_static_subtype();
+  create trigger delete_credential_static_subtype after delete on credential_static_ssh_private_key_credential
+    for each row execute procedure delete_credential_static_subtype();
+
+  create trigger update_version_column after update on credential_static_json_credential
+    for each row execute procedure update_version_column();
+  create trigger update_time_column before update on credential_static_json_credential
+    for each row execute procedure update_time_column();
+  create trigger default_create_time_column before insert on credential_static_json_credential
+    for each row execute procedure default_create_time();
+  create trigger immutable_columns before update on credential_static_json_credential
+    for each row execute procedure immutable_columns('public_id', 'store_id', 'create_time');
+  create trigger insert_credential_static_subtype before insert on credential_static_json_credential
+    for each row execute procedure insert_credential_static_subtype();
+  create trigger delete_credential_static_subtype after delete on credential_static_json_credential
+    for each row execute procedure delete_credential_static_subtype();
+
+  insert into oplog_ticket (name, version)
+  values
+    ('credential_static_store', 1),
+    ('credential_static_username_password_credential', 1),
+    ('credential_static_ssh_private_key_credential', 1),
+    ('credential_static_json_credential', 1);
+
+  -- target_static_credential is the static credential equivalent of
+  -- target_credential_library.
+  create table target_static_credential (
+    target_id wt_public_id not null
+      constraint target_fkey
+        references target (public_id)
+        on delete cascade
+        on update cascade,
+    credential_static_id wt_public_id not null
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    credential_purpose text not null
+      constraint credential_purpose_enm_fkey
+        references credential_purpose_enm (name)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp,
+    primary key(target_id, credential_static_id, credential_purpose)
+  );
+  comment on table target_static_credential is
+    'target_static_credential is a join table between the target and credential_static tables. '
+    'It also contains the credential purpose the relationship represents.';
+
+  create trigger default_create_time_column before insert on target_static_credential
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on target_static_credential
+    for each row execute procedure immutable_columns('target_id', 'credential_static_id', 'credential_purpose', 'create_time');
+
+  -- target_static provides the store id along with the other data stored in
+  -- target_static_credential
+  create view target_static
+  as
+  select
+    tsc.target_id,
+    tsc.credential_static_id,
+    tsc.credential_purpose,
+    cs.store_id
+  from
+    target_static_credential tsc,
+    credential_static cs
+  where
+    cs.public_id = tsc.credential_static_id;
+
+commit;
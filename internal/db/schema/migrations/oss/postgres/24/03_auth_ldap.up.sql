@@ -0,0 +1,462 @@
+begin;
+
+  -- auth_ldap_method entries are the current ldap auth methods configured for
+  -- existing scopes.
+  create table auth_ldap_method (
+    public_id wt_public_id
+      primary key,
+    scope_id wt_scope_id
+      not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    start_tls bool not null default false, -- issue a StartTLS request after connecting to an ldap:// url
+    insecure_tls bool not null default false, -- skip verification of the ldap server's certificate chain
+    discover_dn bool not null default false, -- use anon bind to discover the bind dn of a user
+    anon_group_search bool not null default false, -- use anon bind when performing ldap group searches
+    upn_domain text -- the userPrincipalDomain used to construct the UPN string for the authenticating user.
+      constraint upn_domain_not_empty
+        check(length(trim(upn_domain)) > 0),
+    user_dn text -- the base dn under which to perform user search
+      constraint user_dn_not_empty
+        check(length(trim(user_dn)) > 0),
+    user_attr text -- the attribute on user entry matching the username passed when authenticating.
+      constraint user_attr_not_empty
+        check(length(trim(user_attr)) > 0),
+    user_filter text -- a go template used to construct a ldap user search filter.
+      constraint user_filter_not_empty
+        check(length(trim(user_filter)) > 0),
+    enable_groups bool not null default false, -- find the groups a user is a member of when authenticating
+    group_dn text -- the base dn under which to perform a group search.
+      constraint group_dn_not_empty
+        check(length(trim(group_dn)) > 0),
+    group_attr text -- the ldap attribute to follow on objects returned by group_filter in order to enumerate user group membership.
+      constraint group_attr_not_empty
+        check(length(trim(group_attr)) > 0),
+    group_filter text -- a go template used when constructing the group membership query.
+      constraint group_filter_not_empty
+        check(length(trim(group_filter)) > 0),
+    bind_dn text -- the distinguished name of entry to bind when performing user and group searches
+      constraint bind_dn_not_empty
+        check(length(trim(bind_dn)) > 0),
+    bind_password bytea, -- encrypted password to use along with bind_dn when performing user and group searches
+    bind_password_hmac bytea
+      constraint bind_password_hmac_not_empty
+        check(length(bind_password_hmac) > 0),
+    key_id text -- key used to encrypt the bind_password via wrapping wrapper.
+      constraint kms_database_key_version_fkey
+        references kms_database_key_version(private_id)
+        on delete restrict
+        on update cascade,
+    constraint bind_password_requires_bind_dn
+      check(bind_password is null or bind_dn is not null),
+    constraint bind_password_requires_key_id
+      check(
+        (bind_password is null and bind_password_hmac is null and key_id is null)
+        or
+        (bind_password is not null and bind_password_hmac is not null and key_id is not null)
+      ),
+    constraint auth_method_fkey
+      foreign key (scope_id, public_id)
+        references auth_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_ldap_method_scope_id_name_uq
+      unique(scope_id, name),
+    constraint auth_ldap_method_scope_id_public_id_uq
+      unique(scope_id, public_id)
+  );
+  comment on table auth_ldap_method is
+    'auth_ldap_method entries are the current ldap auth methods configured for existing scopes.';
+
+  -- auth_ldap_url entries are the ldap urls of the servers for an ldap auth
+  -- method.  There must be at least one url for each ldap auth method.  The
+  -- connection_priority determines the order in which the servers are tried
+  -- when authenticating.
+  create table auth_ldap_url (
+    create_time wt_timestamp,
+    ldap_method_id wt_public_id
+      constraint auth_ldap_method_fkey
+        references auth_ldap_method(public_id)
+        on delete cascade
+        on update cascade,
+    url text not null
+      constraint url_too_short
+        check (length(trim(url)) > 3)
+      constraint url_too_long
+        check (length(trim(url)) < 4000)
+      constraint url_invalid_protocol
+        check (url ~ 'ldaps?:\/\/*'),
+    connection_priority int not null
+      constraint connection_priority_must_be_greater_than_zero
+        check(connection_priority > 0),
+    primary key(ldap_method_id, connection_priority),
+    constraint auth_ldap_url_ldap_method_id_url_uq
+      unique(ldap_method_id, url)
+  );
+  comment on table auth_ldap_url is
+    'auth_ldap_url entries are the ldap urls of the servers for an ldap auth method. '
+    'There must be at least one url for each ldap auth method.';
+
+  -- auth_ldap_certificate entries are optional PEM encoded x509 certificates.
+  -- Each entry is a single certificate.  An ldap auth method may have 0 or more
+  -- of these optional x509s.  If an auth method has any cert entries, they are
+  -- used as trust anchors when connecting to the auth method's ldap servers
+  -- (instead of the host system's cert chain).
+  create table auth_ldap_certificate (
+    create_time wt_timestamp,
+    ldap_method_id wt_public_id
+      constraint auth_ldap_method_fkey
+        references auth_ldap_method(public_id)
+        on delete cascade
+        on update cascade,
+    certificate bytea not null,
+    primary key(ldap_method_id, certificate)
+  );
+  comment on table auth_ldap_certificate is
+    'auth_ldap_certificate entries are optional PEM encoded x509 certificates. Each entry is a single certificate. '
+    'If an auth method has any cert entries, they are used as trust anchors when connecting to the auth methods ldap servers.';
+
+  -- auth_ldap_account entries are subtypes of auth_account and represent an
+  -- ldap account.
+  create table auth_ldap_account (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    -- The scope_id type is not wt_scope_id because the domain check is
+    -- executed before the insert trigger which retrieves the scope_id causing
+    -- an insert to fail.
+    scope_id text not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    login_name text not null -- the username used to authenticate with the ldap server
+      constraint login_name_must_not_be_empty
+        check(length(trim(login_name)) > 0)
+      constraint login_name_must_be_lowercase
+        check(lower(trim(login_name)) = login_name),
+    full_name wt_full_name, -- may be null and is set from the ldap entry when authenticating
+    email wt_email, -- may be null and is set from the ldap entry when authenticating
+    dn text -- the distinguished name of the ldap entry, set when authenticating
+      constraint dn_must_not_be_empty
+        check(length(trim(dn)) > 0),
+    member_of_groups text -- json array of the ldap groups of the account, set when authenticating
+      constraint member_of_groups_must_not_be_empty
+        check(length(trim(member_of_groups)) > 0),
+    constraint auth_ldap_method_fkey
+      foreign key (scope_id, auth_method_id)
+        references auth_ldap_method (scope_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_account_fkey
+      foreign key (scope_id, auth_method_id, public_id)
+        references auth_account (scope_id, auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_ldap_account_auth_method_id_name_uq
+      unique(auth_method_id, name),
+    constraint auth_ldap_account_auth_method_id_login_name_uq
+      unique(auth_method_id, login_name),
+    constraint auth_ldap_account_auth_method_id_public_id_uq
+      unique(auth_method_id, public_id)
+  );
+  comment on table auth_ldap_account is
+    'auth_ldap_account entries are subtypes of auth_account and represent an ldap account.';
+
+  -- auth_ldap_method triggers
+  create trigger insert_auth_method_subtype before insert on auth_ldap_method
+    for each row execute procedure insert_auth_method_subtype();
+
+  create trigger update_auth_method_subtype before update on auth_ldap_method
+    for each row execute procedure update_auth_method_subtype();
+
+  create trigger delete_auth_method_subtype after delete on auth_ldap_method
+    for each row execute procedure delete_auth_method_subtype();
+
+  create trigger update_time_column before update on auth_ldap_method
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_method
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_method
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_ldap_method
+    for each row execute procedure update_version_column();
+
+  -- auth_ldap_url and auth_ldap_certificate triggers
+  create trigger default_create_time_column before insert on auth_ldap_url
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_url
+    for each row execute procedure immutable_columns('create_time', 'ldap_method_id', 'url', 'connection_priority');
+
+  create trigger default_create_time_column before insert on auth_ldap_certificate
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_ldap_certificate
+    for each row execute procedure immutable_columns('create_time', 'ldap_method_id', 'certificate');
+
+  -- auth_ldap_account triggers
+  create trigger insert_auth_account_subtype before insert on auth_ldap_account
+    for each row execute procedure insert_auth_account_subtype();
+
+  create trigger update_time_column before update on auth_ldap_account
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on auth_ldap_account
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'scope_id', 'create_time', 'login_name');
+
+  create trigger default_create_time_column before insert on auth_ldap_account
+    for each row execute procedure default_create_time();
+
+  create trigger update_version_column after update on auth_ldap_account
+    for each row execute procedure update_version_column();
+
+  -- auth_ldap_managed_group entries are subtypes of auth_managed_group. An
+  -- ldap account is a member of an ldap managed group if the account is a
+  -- member of any of the ldap groups named in group_names.
+  create table auth_ldap_managed_group (
+    public_id wt_public_id
+      primary key,
+    auth_method_id wt_public_id
+      not null,
+    name wt_name,
+    description wt_description,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    group_names text not null -- json array of ldap group names
+      constraint group_names_must_not_be_empty
+        check(length(trim(group_names)) > 0),
+    -- Ensure that this managed group relates to an ldap auth method, as
+    -- opposed to other types
+    constraint auth_ldap_method_fkey
+      foreign key (auth_method_id)
+        references auth_ldap_method (public_id)
+        on delete cascade
+        on update cascade,
+    -- Ensure it relates to an abstract managed group
+    constraint auth_managed_group_fkey
+      foreign key (auth_method_id, public_id)
+        references auth_managed_group (auth_method_id, public_id)
+        on delete cascade
+        on update cascade,
+    constraint auth_ldap_managed_group_auth_method_id_name_uq
+      unique(auth_method_id, name)
+  );
+  comment on table auth_ldap_managed_group is
+    'auth_ldap_managed_group entries are subtypes of auth_managed_group and represent an ldap managed group.';
+
+  create trigger immutable_columns before update on auth_ldap_managed_group
+    for each row execute procedure immutable_columns('public_id', 'auth_method_id', 'create_time');
+
+  create trigger default_create_time_column before insert on auth_ldap_managed_group
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on auth_ldap_managed_group
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on auth_ldap_managed_group
+    for each row execute procedure update_version_column();
+
+  create trigger insert_managed_group_subtype before insert on auth_ldap_managed_group
+    for each row execute procedure insert_managed_group_subtype();
+
+  create trigger delete_managed_group_subtype after delete on auth_ldap_managed_group
+    for each row execute procedure delete_managed_group_subtype();
+
+  -- Mappings of account to ldap managed groups. This is a non-abstract table
+  -- so that it is a natural aggregate for the oplog.
+  create table auth_ldap_managed_group_member_account (
+    create_time wt_timestamp,
+    managed_group_id wt_public_id
+      references auth_ldap_managed_group(public_id)
+      on delete cascade
+      on update cascade,
+    member_id wt_public_id
+      references auth_ldap_account(public_id)
+      on delete cascade
+      on update cascade,
+    primary key (managed_group_id, member_id)
+  );
+  comment on table auth_ldap_managed_group_member_account is
+    'auth_ldap_managed_group_member_account is the join table for managed ldap groups and accounts.';
+
+  create or replace function auth_immutable_managed_ldap_group_member_account()
+    returns trigger
+  as $$
+  begin
+    raise exception 'managed ldap group members are immutable';
+  end;
+  $$ language plpgsql;
+
+  create trigger default_create_time_column before insert on auth_ldap_managed_group_member_account
+    for each row execute procedure default_create_time();
+
+  create trigger auth_immutable_managed_ldap_group_member_account before update on auth_ldap_managed_group_member_account
+    for each row execute procedure auth_immutable_managed_ldap_group_member_account();
+
+  -- replaces view from 9/03_oidc_managed_group_member.up.sql
+  create or replace view auth_managed_group_member_account as
+  select
+    oidc.create_time,
+    oidc.managed_group_id,
+    oidc.member_id
+  from
+    auth_oidc_managed_group_member_account oidc
+  union
+  select
+    ldap.create_time,
+    ldap.managed_group_id,
+    ldap.member_id
+  from
+    auth_ldap_managed_group_member_account ldap;
+
+  -- ldap_auth_method_with_value_obj is useful for reading an ldap auth method
+  -- with its associated value objects (urls and certs) as columns with |
+  -- delimited values. Urls are prefixed with their connection priority.
+  create view ldap_auth_method_with_value_obj as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.start_tls,
+    am.insecure_tls,
+    am.discover_dn,
+    am.anon_group_search,
+    am.upn_domain,
+    am.user_dn,
+    am.user_attr,
+    am.user_filter,
+    am.enable_groups,
+    am.group_dn,
+    am.group_attr,
+    am.group_filter,
+    am.bind_dn,
+    am.bind_password,
+    am.bind_password_hmac,
+    am.key_id,
+    -- the string_agg(..) column will be null if there are no associated value objects
+    string_agg(distinct concat_ws('=', url.connection_priority, url.url), '|') as urls,
+    string_agg(distinct cert.certificate, '|') as certs
+  from
+    auth_ldap_method am
+    left outer join iam_scope             s     on am.public_id = s.primary_auth_method_id
+    left outer join auth_ldap_url         url   on am.public_id = url.ldap_method_id
+    left outer join auth_ldap_certificate cert  on am.public_id = cert.ldap_method_id
+  group by am.public_id, is_primary_auth_method; -- there can be only one public_id + is_primary_auth_method, so group by isn't a problem.
+  comment on view ldap_auth_method_with_value_obj is
+    'ldap auth method with its associated value objects (urls, certs) as columns with | delimited values';
+
+  -- replaces view from 14/01_wh_user_dimension_oidc.up.sql
+  create or replace view whx_user_dimension_source as
+       select -- id is the first column in the target view
+              u.public_id                       as user_id,
+              coalesce(u.name, 'None')          as user_name,
+              coalesce(u.description, 'None')   as user_description,
+              coalesce(aa.public_id, 'None')    as auth_account_id,
+              case
+                   when apa.public_id is not null then 'password auth account'
+                   when aoa.public_id is not null then 'oidc auth account'
+                   when ala.public_id is not null then 'ldap auth account'
+                   else 'None'
+                   end                          as auth_account_type,
+              case
+                   when apa.public_id is not null then coalesce(apa.name, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.name, 'None')
+                   when ala.public_id is not null then coalesce(ala.name, 'None')
+                   else 'None'
+                   end                          as auth_account_name,
+              case
+                   when apa.public_id is not null then coalesce(apa.description, 'None')
+                   when aoa.public_id is not null then coalesce(aoa.description, 'None')
+                   when ala.public_id is not null then coalesce(ala.description, 'None')
+                   else 'None'
+                   end                          as auth_account_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when aoa.public_id is not null then aoa.subject
+                  when ala.public_id is not null then coalesce(ala.dn, ala.login_name)
+                  else 'None'
+                  end                           as auth_account_external_id,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  aoa.public_id is not null
+                    and aoa.full_name is not null then aoa.full_name
+                  when  ala.public_id is not null
+                    and ala.full_name is not null then ala.full_name
+                  else 'None'
+                  end                           as auth_account_full_name,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when  aoa.public_id is not null
+                    and aoa.email is not null then aoa.email
+                  when  ala.public_id is not null
+                    and ala.email is not null then ala.email
+                  else 'None'
+                  end                           as auth_account_email,
+              coalesce(am.public_id, 'None')    as auth_method_id,
+              case
+                   when apa.public_id is not null then 'password auth method'
+                   when aoa.public_id is not null then 'oidc auth method'
+                   when ala.public_id is not null then 'ldap auth method'
+                   else 'None'
+                   end                          as auth_method_type,
+              case
+                   when apm.public_id is not null then coalesce(apm.name, 'None')
+                   when aom.public_id is not null then coalesce(aom.name, 'None')
+                   when alm.public_id is not null then coalesce(alm.name, 'None')
+                   else 'None'
+                   end                          as auth_method_name,
+              case
+                   when apm.public_id is not null then coalesce(apm.description, 'None')
+                   when aom.public_id is not null then coalesce(aom.description, 'None')
+                   when alm.public_id is not null then coalesce(alm.description, 'None')
+                   else 'None'
+                   end                          as auth_method_description,
+              case
+                  when apa.public_id is not null then 'Not Applicable'
+                  when aom.public_id is not null then aom.issuer
+                  when alm.public_id is not null then 'Not Applicable'
+                  else 'None'
+                  end                           as auth_method_external_id,
+              org.public_id                     as user_organization_id,
+              coalesce(org.name, 'None')        as user_organization_name,
+              coalesce(org.description, 'None') as user_organization_description
+         from iam_user as u
+    left join auth_account as aa on           u.public_id       = aa.iam_user_id
+    left join auth_method as am on            aa.auth_method_id = am.public_id
+    left join auth_password_account as apa on aa.public_id      = apa.public_id
+    left join auth_password_method as apm on  am.public_id      = apm.public_id
+    left join auth_oidc_account as aoa on     aa.public_id      = aoa.public_id
+    left join auth_oidc_method as aom on      am.public_id      = aom.public_id
+    left join auth_ldap_account as ala on     aa.public_id      = ala.public_id
+    left join auth_ldap_method as alm on      am.public_id      = alm.public_id
+         join iam_scope as org on             u.scope_id        = org.public_id
+  ;
+
+  -- The tickets for oplog are the subtypes not the base types because no
+  -- updates are done to any values in the base types.
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_ldap_method', 1), -- auth method is the root aggregate itself and all of its value objects.
+    ('auth_ldap_account', 1),
+    ('auth_ldap_managed_group', 1);
+
+commit;
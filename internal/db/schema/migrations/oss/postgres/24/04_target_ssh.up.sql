@@ -0,0 +1,234 @@
+begin;
+
+  -- target_ssh is a target subtype for ssh servers. Sessions to an ssh target
+  -- are terminated by the worker, which authenticates to the upstream host
+  -- with the target's egress credentials.
+  create table target_ssh (
+    public_id wt_public_id primary key
+      references target(public_id)
+      on delete cascade
+      on update cascade,
+    scope_id wt_scope_id not null
+      references iam_scope(public_id)
+      on delete cascade
+      on update cascade,
+    name text not null, -- name is not optional for a target subtype
+    description text,
+    default_port int, -- default_port can be null
+    -- max duration of the session in seconds.
+    -- default is 8 hours
+    session_max_seconds int not null default 28800
+      constraint session_max_seconds_must_be_greater_than_0
+      check(session_max_seconds > 0),
+    -- limit on number of session connections allowed. -1 equals no limit
+    session_connection_limit int not null default 1
+      constraint session_connection_limit_must_be_greater_than_0_or_negative_1
+      check(session_connection_limit > 0 or session_connection_limit = -1),
+    worker_filter wt_bexprfilter,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    version wt_version,
+    unique(scope_id, name) -- name must be unique within a scope
+  );
+  comment on table target_ssh is
+    'target_ssh is a subtype of target for ssh servers whose sessions have credentials injected by the worker.';
+
+  create trigger insert_target_subtype before insert on target_ssh
+    for each row execute procedure insert_target_subtype();
+
+  create trigger delete_target_subtype after delete on target_ssh
+    for each row execute procedure delete_target_subtype();
+
+  create trigger immutable_columns before update on target_ssh
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time');
+
+  create trigger update_version_column after update on target_ssh
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on target_ssh
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on target_ssh
+    for each row execute procedure default_create_time();
+
+  create trigger target_scope_valid before insert on target_ssh
+    for each row execute procedure target_scope_valid();
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('target_ssh', 1);
+
+  -- Replaces the view created in 1/01_server_tags_migrations to include
+  -- target_ssh.
+  drop view target_all_subtypes;
+  create view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type
+  from target_ssh;
+
+  -- Replaces the view created in 20/07_wh_session_dimensions to read targets
+  -- from target_all_subtypes so sessions for any target subtype can be added
+  -- to the warehouse.
+  drop view whx_host_dimension_source;
+  create view whx_host_dimension_source as
+  select -- id is the first column in the target view
+         h.public_id                     as host_id,
+         case when sh.public_id is not null then 'static host'
+              when ph.public_id is not null then 'plugin host'
+              else 'Unknown' end          as host_type,
+         case when sh.public_id is not null then coalesce(sh.name, 'None')
+              when ph.public_id is not null then coalesce(ph.name, 'None')
+              else 'Unknown' end          as host_name,
+         case when sh.public_id is not null then coalesce(sh.description, 'None')
+              when ph.public_id is not null then coalesce(ph.description, 'None')
+              else 'Unknown' end          as host_description,
+
+         coalesce(sh.address, 'Unsupported')  as host_address,
+
+         hs.public_id                     as host_set_id,
+         case when shs.public_id is not null then 'static host set'
+              when phs.public_id is not null then 'plugin host set'
+              else 'Unknown' end          as host_set_type,
+         case
+           when shs.public_id is not null then coalesce(shs.name, 'None')
+           when phs.public_id is not null then coalesce(phs.name, 'None')
+           else 'None'
+           end                            as host_set_name,
+         case
+           when shs.public_id is not null then coalesce(shs.description, 'None')
+           when phs.public_id is not null then coalesce(phs.description, 'None')
+           else 'None'
+           end                            as host_set_description,
+         hc.public_id                     as host_catalog_id,
+         case when shc.public_id is not null then 'static host catalog'
+              when phc.public_id is not null then 'plugin host catalog'
+              else 'Unknown' end          as host_catalog_type,
+         case
+           when shc.public_id is not null then coalesce(shc.name, 'None')
+           when phc.public_id is not null then coalesce(phc.name, 'None')
+           else 'None'
+           end                            as host_catalog_name,
+         case
+           when shc.public_id is not null then coalesce(shc.description, 'None')
+           when phc.public_id is not null then coalesce(phc.description, 'None')
+           else 'None'
+           end                            as host_catalog_description,
+         t.public_id                     as target_id,
+         t.type || ' target'             as target_type,
+         coalesce(t.name, 'None')        as target_name,
+         coalesce(t.description, 'None') as target_description,
+         coalesce(t.default_port, 0)     as target_default_port_number,
+         t.session_max_seconds           as target_session_max_seconds,
+         t.session_connection_limit      as target_session_connection_limit,
+         p.public_id                     as project_id,
+         coalesce(p.name, 'None')        as project_name,
+         coalesce(p.description, 'None') as project_description,
+         o.public_id                     as organization_id,
+         coalesce(o.name, 'None')        as organization_name,
+         coalesce(o.description, 'None') as organization_description
+    from host as h
+       join host_catalog as hc                on h.catalog_id = hc.public_id
+       join host_set as hs                    on h.catalog_id = hs.catalog_id
+       join target_host_set as ts             on hs.public_id = ts.host_set_id
+       join target_all_subtypes as t          on ts.target_id = t.public_id
+       join iam_scope as p                    on t.scope_id = p.public_id and p.type = 'project'
+       join iam_scope as o                    on p.parent_id = o.public_id and o.type = 'org'
+
+       left join static_host as sh            on sh.public_id = h.public_id
+       left join host_plugin_host as ph       on ph.public_id = h.public_id
+       left join static_host_catalog as shc   on shc.public_id = hc.public_id
+       left join host_plugin_catalog as phc   on phc.public_id = hc.public_id
+       left join static_host_set as shs       on shs.public_id = hs.public_id
+       left join host_plugin_set as phs       on phs.public_id = hs.public_id
+  ;
+
+  -- Replaces the view created in 16/02_wh_credential_dimension to read targets
+  -- from target_all_subtypes.
+  drop view whx_credential_dimension_source;
+  create view whx_credential_dimension_source as
+       select -- id is the first column in the target view
+              s.public_id                              as session_id,
+              coalesce(scd.credential_purpose, 'None') as credential_purpose,
+              cl.public_id                             as credential_library_id,
+              case
+                when vcl is null then 'None'
+                else 'vault credential library'
+                end                                    as credential_library_type,
+              coalesce(vcl.name, 'None')               as credential_library_name,
+              coalesce(vcl.description, 'None')        as credential_library_description,
+              coalesce(vcl.vault_path, 'None')         as credential_library_vault_path,
+              coalesce(vcl.http_method, 'None')        as credential_library_vault_http_method,
+              coalesce(vcl.http_request_body, 'None')  as credential_library_vault_http_request_body,
+              cs.public_id                             as credential_store_id,
+              case
+                when vcs is null then 'None'
+                else 'vault credential store'
+                end                                    as credential_store_type,
+              coalesce(vcs.name, 'None')               as credential_store_name,
+              coalesce(vcs.description, 'None')        as credential_store_description,
+              coalesce(vcs.namespace, 'None')          as credential_store_vault_namespace,
+              coalesce(vcs.vault_address, 'None')      as credential_store_vault_address,
+              t.public_id                              as target_id,
+              tt.type || ' target'                     as target_type,
+              coalesce(tt.name, 'None')                as target_name,
+              coalesce(tt.description, 'None')         as target_description,
+              coalesce(tt.default_port, 0)             as target_default_port_number,
+              tt.session_max_seconds                   as target_session_max_seconds,
+              tt.session_connection_limit              as target_session_connection_limit,
+              p.public_id                              as project_id,
+              coalesce(p.name, 'None')                 as project_name,
+              coalesce(p.description, 'None')          as project_description,
+              o.public_id                              as organization_id,
+              coalesce(o.name, 'None')                 as organization_name,
+              coalesce(o.description, 'None')          as organization_description
+       from session_credential_dynamic as scd,
+            session as s,
+            credential_library as cl,
+            credential_store as cs,
+            credential_vault_library as vcl,
+            credential_vault_store as vcs,
+            target as t,
+            target_all_subtypes as tt,
+            iam_scope as p,
+            iam_scope as o
+      where scd.library_id = cl.public_id
+        and cl.store_id = cs.public_id
+        and vcl.public_id = cl.public_id
+        and vcs.public_id = cs.public_id
+        and s.public_id = scd.session_id
+        and s.target_id = t.public_id
+        and t.public_id = tt.public_id
+        and p.public_id = t.scope_id
+        and p.type = 'project'
+        and o.public_id = p.parent_id
+        and o.type = 'org';
+
+commit;
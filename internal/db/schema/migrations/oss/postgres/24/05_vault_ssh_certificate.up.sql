@@ -0,0 +1,205 @@
+begin;
+
+  -- Add the credential type provided by vault libraries that have an ssh
+  -- certificate signed by the vault ssh secrets engine.
+  alter table credential_type_enm
+    drop constraint only_predefined_credential_types_allowed,
+    add constraint only_predefined_credential_types_allowed
+      check (
+        name in (
+          'unspecified',
+          'user_password',
+          'ssh_private_key',
+          'json',
+          'ssh_certificate'
+        )
+      )
+  ;
+
+  insert into credential_type_enm (name)
+  values
+    ('ssh_certificate');
+
+  alter table credential_vault_library
+    add constraint credential_vault_library_public_id_credential_type_uq
+      unique(public_id, credential_type)
+  ;
+
+  create table credential_vault_library_ssh_certificate (
+    library_id wt_public_id primary key,
+    credential_type text not null default 'ssh_certificate'
+      constraint credential_type_must_be_ssh_certificate
+        check(credential_type = 'ssh_certificate'),
+    username text not null
+      constraint username_must_not_be_empty
+        check(length(trim(username)) > 0),
+    key_type text not null default 'ed25519'
+      constraint key_type_must_be_valid
+        check(key_type in ('ed25519', 'ecdsa', 'rsa')),
+    -- key_bits of 0 means the default size for key_type is used.
+    key_bits int not null default 0
+      constraint key_bits_must_be_valid_for_key_type
+        check(
+          key_bits = 0
+          or (key_type = 'ecdsa' and key_bits in (256, 384, 521))
+          or (key_type = 'rsa' and key_bits in (2048, 3072, 4096))
+        ),
+    ttl text
+      constraint ttl_must_not_be_empty
+        check(length(trim(ttl)) > 0),
+    valid_principals text
+      constraint valid_principals_must_not_be_empty
+        check(length(trim(valid_principals)) > 0),
+    extensions bytea
+      constraint extensions_must_not_be_empty
+        check(length(extensions) > 0),
+    constraint credential_vault_library_fkey
+      foreign key (library_id, credential_type)
+        references credential_vault_library (public_id, credential_type)
+        on delete cascade
+        on update cascade
+  );
+  comment on table credential_vault_library_ssh_certificate is
+    'credential_vault_library_ssh_certificate is a table where each row contains the values '
+    'a vault credential library with the ssh_certificate credential type uses to request '
+    'a signed ssh certificate from the vault ssh secrets engine.';
+
+  -- Replaces view from 22/05_vault_private_library.up.sql
+     drop view credential_vault_library_public;
+     drop view credential_vault_library_private;
+
+     create view credential_vault_library_private as
+     with
+     password_override (library_id, username_attribute, password_attribute) as (
+       select library_id,
+              nullif(username_attribute, wt_to_sentinel('no override')),
+              nullif(password_attribute, wt_to_sentinel('no override'))
+         from credential_vault_library_user_password_mapping_override
+     )
+     select library.public_id         as public_id,
+            library.store_id          as store_id,
+            library.name              as name,
+            library.description       as description,
+            library.create_time       as create_time,
+            library.update_time       as update_time,
+            library.version           as version,
+            library.vault_path        as vault_path,
+            library.http_method       as http_method,
+            library.http_request_body as http_request_body,
+            library.credential_type   as credential_type,
+            store.scope_id            as scope_id,
+            store.vault_address       as vault_address,
+            store.namespace           as namespace,
+            store.ca_cert             as ca_cert,
+            store.tls_server_name     as tls_server_name,
+            store.tls_skip_verify     as tls_skip_verify,
+            store.token_hmac          as token_hmac,
+            store.ct_token            as ct_token, -- encrypted
+            store.token_key_id        as token_key_id,
+            store.client_cert         as client_cert,
+            store.ct_client_key       as ct_client_key, -- encrypted
+            store.client_key_id       as client_key_id,
+            upasso.username_attribute as username_attribute,
+            upasso.password_attribute as password_attribute,
+            sshcert.username          as ssh_username,
+            sshcert.key_type          as ssh_key_type,
+            sshcert.key_bits          as ssh_key_bits,
+            sshcert.ttl               as ssh_ttl,
+            sshcert.valid_principals  as ssh_valid_principals,
+            sshcert.extensions        as ssh_extensions
+       from credential_vault_library library
+       join credential_vault_store_private store
+         on library.store_id = store.public_id
+  left join password_override upasso
+         on library.public_id = upasso.library_id
+        and store.token_status = 'current'
+  left join credential_vault_library_ssh_certificate sshcert
+         on library.public_id = sshcert.library_id
+        and store.token_status = 'current';
+  comment on view credential_vault_library_private is
+    'credential_vault_library_private is a view where each row contains a credential library and the credential library''s data needed to connect to Vault. '
+    'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
+
+     create view credential_vault_library_public as
+     select public_id,
+            store_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            vault_path,
+            http_method,
+            http_request_body,
+            credential_type,
+            username_attribute,
+            password_attribute,
+            ssh_username,
+            ssh_key_type,
+            ssh_key_bits,
+            ssh_ttl,
+            ssh_valid_principals,
+            ssh_extensions
+       from credential_vault_library_private;
+  comment on view credential_vault_library_public is
+    'credential_vault_library_public is a view where each row contains a credential library, any of library''s credential mapping overrides, '
+    'and the library''s ssh certificate settings. '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+  -- Replaces view from 10/04_vault_credential.up.sql to add the credential
+  -- type of the library that issued the credential. ssh certificates signed
+  -- by vault do not have a lease so they cannot be renewed; their renewal
+  -- time is their expiration time.
+     drop view credential_vault_credential_private;
+
+     create view credential_vault_credential_private as
+     select credential.public_id         as public_id,
+            credential.library_id        as library_id,
+            credential.session_id        as session_id,
+            credential.create_time       as create_time,
+            credential.update_time       as update_time,
+            credential.version           as version,
+            credential.external_id       as external_id,
+            credential.last_renewal_time as last_renewal_time,
+            credential.expiration_time   as expiration_time,
+            credential.is_renewable      as is_renewable,
+            credential.status            as status,
+            case
+              when library.credential_type = 'ssh_certificate' then credential.expiration_time
+              else credential.last_renewal_time + (credential.expiration_time - credential.last_renewal_time) / 2
+            end                          as renewal_time,
+            coalesce(library.credential_type, 'unspecified') as credential_type,
+            token.token_hmac             as token_hmac,
+            token.token                  as ct_token, -- encrypted
+            token.create_time            as token_create_time,
+            token.update_time            as token_update_time,
+            token.last_renewal_time      as token_last_renewal_time,
+            token.expiration_time        as token_expiration_time,
+            token.key_id                 as token_key_id,
+            token.status                 as token_status,
+            store.scope_id               as scope_id,
+            store.vault_address          as vault_address,
+            store.namespace              as namespace,
+            store.ca_cert                as ca_cert,
+            store.tls_server_name        as tls_server_name,
+            store.tls_skip_verify        as tls_skip_verify,
+            cert.certificate             as client_cert,
+            cert.certificate_key         as ct_client_key, -- encrypted
+            cert.certificate_key_hmac    as client_cert_key_hmac,
+            cert.key_id                  as client_key_id
+       from credential_vault_credential credential
+       join credential_vault_token token
+         on credential.token_hmac = token.token_hmac
+       join credential_vault_store store
+         on token.store_id = store.public_id
+  left join credential_vault_library library
+         on credential.library_id = library.public_id
+  left join credential_vault_client_certificate cert
+         on store.public_id = cert.store_id
+      where credential.expiration_time != 'infinity'::date;
+  comment on view credential_vault_credential_private is
+    'credential_vault_credential_private is a view where each row contains a credential, '
+    'the vault token used to issue the credential, and the credential store data needed to connect to Vault. '
+    'Each row may contain encrypted data. This view should not be used to retrieve data which will be returned external to boundary.';
+
+commit;
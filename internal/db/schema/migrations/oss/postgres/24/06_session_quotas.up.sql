@@ -0,0 +1,155 @@
+begin;
+
+  -- Targets can limit the number of sessions a user has at once, the number of
+  -- bytes transferred by a session and how long a session connection can stay
+  -- idle.
+  --   user_session_limit: max number of pending or active sessions of a user
+  --     for the target. -1 equals no limit
+  --   session_max_bytes_up: max number of bytes sent by the clients of a
+  --     session. -1 equals no limit
+  --   session_max_bytes_down: max number of bytes received by the clients of a
+  --     session. -1 equals no limit
+  --   connection_idle_timeout_seconds: max number of seconds a session
+  --     connection can go without transferring any data. 0 equals no timeout
+  alter table target_tcp
+    add column user_session_limit int not null default -1
+      constraint user_session_limit_must_be_greater_than_0_or_negative_1
+        check(user_session_limit > 0 or user_session_limit = -1),
+    add column session_max_bytes_up bigint not null default -1
+      constraint session_max_bytes_up_must_be_greater_than_0_or_negative_1
+        check(session_max_bytes_up > 0 or session_max_bytes_up = -1),
+    add column session_max_bytes_down bigint not null default -1
+      constraint session_max_bytes_down_must_be_greater_than_0_or_negative_1
+        check(session_max_bytes_down > 0 or session_max_bytes_down = -1),
+    add column connection_idle_timeout_seconds int not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+        check(connection_idle_timeout_seconds >= 0)
+  ;
+
+  alter table target_ssh
+    add column user_session_limit int not null default -1
+      constraint user_session_limit_must_be_greater_than_0_or_negative_1
+        check(user_session_limit > 0 or user_session_limit = -1),
+    add column session_max_bytes_up bigint not null default -1
+      constraint session_max_bytes_up_must_be_greater_than_0_or_negative_1
+        check(session_max_bytes_up > 0 or session_max_bytes_up = -1),
+    add column session_max_bytes_down bigint not null default -1
+      constraint session_max_bytes_down_must_be_greater_than_0_or_negative_1
+        check(session_max_bytes_down > 0 or session_max_bytes_down = -1),
+    add column connection_idle_timeout_seconds int not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+        check(connection_idle_timeout_seconds >= 0)
+  ;
+
+  -- Replaces the view created in 24/04_target_ssh to include the session
+  -- quotas. The columns are appended so the views depending on
+  -- target_all_subtypes do not have to be recreated.
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type,
+    user_session_limit,
+    session_max_bytes_up,
+    session_max_bytes_down,
+    connection_idle_timeout_seconds
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type,
+    user_session_limit,
+    session_max_bytes_up,
+    session_max_bytes_down,
+    connection_idle_timeout_seconds
+  from target_ssh;
+
+  -- The quotas of a session are copied from its target when the session is
+  -- created, like the connection limit, and are enforced by the worker.
+  alter table session
+    add column max_bytes_up bigint not null default -1
+      constraint max_bytes_up_must_be_greater_than_0_or_negative_1
+        check(max_bytes_up > 0 or max_bytes_up = -1),
+    add column max_bytes_down bigint not null default -1
+      constraint max_bytes_down_must_be_greater_than_0_or_negative_1
+        check(max_bytes_down > 0 or max_bytes_down = -1),
+    add column connection_idle_timeout_seconds int not null default 0
+      constraint connection_idle_timeout_seconds_must_not_be_negative
+        check(connection_idle_timeout_seconds >= 0)
+  ;
+
+  -- Replaces the trigger created in 1/01_server_tags_migrations to make the
+  -- session quotas immutable.
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter', 'max_bytes_up', 'max_bytes_down', 'connection_idle_timeout_seconds');
+
+  -- A session whose transferred bytes reached one of its limits has its
+  -- connections closed with the 'bytes limit' reason, and is terminated with
+  -- the same reason. A connection idle for longer than the idle timeout of its
+  -- session is closed with the 'idle timeout' reason.
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed,
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled',
+          'bytes limit'
+        )
+      )
+  ;
+
+  insert into session_termination_reason_enm (name)
+  values
+    ('bytes limit');
+
+  alter table session_connection_closed_reason_enm
+    drop constraint only_predefined_session_connection_closed_reasons_allowed,
+    add constraint only_predefined_session_connection_closed_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'canceled',
+          'network error',
+          'system error',
+          'bytes limit',
+          'idle timeout'
+        )
+      )
+  ;
+
+  insert into session_connection_closed_reason_enm (name)
+  values
+    ('bytes limit'),
+    ('idle timeout');
+
+commit;
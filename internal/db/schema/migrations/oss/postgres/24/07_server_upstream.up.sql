@@ -0,0 +1,14 @@
+begin;
+
+  -- upstream is the name of the worker through which a worker connects to the
+  -- controllers. It is null for workers that connect to the controllers
+  -- directly. It is not a foreign key because a worker can report its
+  -- upstream before the upstream itself has reported its status.
+  alter table server
+    add column upstream text
+      constraint upstream_must_not_be_empty
+        check(length(trim(upstream)) > 0)
+      constraint upstream_must_not_be_the_server
+        check(upstream <> private_id);
+
+commit;
@@ -0,0 +1,133 @@
+begin;
+
+  create table access_request_status_enm (
+    name text primary key
+      constraint only_predefined_access_request_statuses_allowed
+      check (
+        name in (
+          'pending',
+          'approved',
+          'denied',
+          'canceled',
+          'expired'
+        )
+      )
+  );
+
+  insert into access_request_status_enm (name)
+  values
+    ('pending'),
+    ('approved'),
+    ('denied'),
+    ('canceled'),
+    ('expired');
+
+  -- An access request is a request of a user for access to a target, for
+  -- duration_seconds once approved. Approving a request creates the role
+  -- granting the user access to the target, which is deleted once the
+  -- request expires.
+  create table access_request (
+    public_id wt_public_id primary key,
+    -- the project of the target
+    scope_id wt_scope_id not null
+      references iam_scope_project (scope_id)
+      on delete cascade
+      on update cascade,
+    target_id wt_public_id not null
+      references target (public_id)
+      on delete cascade
+      on update cascade,
+    -- the user requesting access
+    user_id wt_user_id
+      references iam_user (public_id)
+      on delete cascade
+      on update cascade,
+    justification text not null
+      constraint justification_must_not_be_empty
+        check(length(trim(justification)) > 0),
+    duration_seconds int not null
+      constraint duration_seconds_must_be_greater_than_0
+        check(duration_seconds > 0),
+    status text not null default 'pending'
+      references access_request_status_enm (name)
+      on delete restrict
+      on update cascade,
+    -- the user who approved or denied the request
+    reviewer_id text
+      -- not using the wt_user_id domain type because it is marked 'not null'
+      references iam_user (public_id)
+      on delete set null
+      on update cascade,
+    review_comment text,
+    -- the role granting access to the target while the request is approved
+    role_id text
+      -- not using the wt_role_id domain type because it is marked 'not null'
+      references iam_role (public_id)
+      on delete set null
+      on update cascade,
+    expiration_time timestamp with time zone,
+    version wt_version,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    constraint approved_access_request_must_have_expiration_time
+      check(status != 'approved' or expiration_time is not null)
+  );
+
+  create trigger immutable_columns before update on access_request
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'target_id', 'user_id', 'justification', 'duration_seconds', 'create_time');
+
+  create trigger update_version_column after update of version, status, reviewer_id, review_comment, expiration_time on access_request
+    for each row execute procedure update_version_column();
+
+  create trigger update_time_column before update on access_request
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on access_request
+    for each row execute procedure default_create_time();
+
+  create function insert_access_request()
+    returns trigger
+  as $$
+  begin
+    if new.status != 'pending' then
+      raise exception 'access request must be pending when inserted';
+    end if;
+    perform from target
+     where public_id = new.target_id
+       and scope_id  = new.scope_id;
+    if not found then
+      raise exception 'access request scope % is not the scope of target %', new.scope_id, new.target_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  create trigger insert_access_request before insert on access_request
+    for each row execute procedure insert_access_request();
+
+  -- update_access_request_status only allows the status of a pending request
+  -- to change, or an approved request to expire.
+  create function update_access_request_status()
+    returns trigger
+  as $$
+  begin
+    if new.status = old.status then
+      return new;
+    end if;
+    if old.status = 'pending' and new.status in ('approved', 'denied', 'canceled') then
+      return new;
+    end if;
+    if old.status = 'approved' and new.status = 'expired' then
+      return new;
+    end if;
+    raise exception 'invalid access request status transition from % to %', old.status, new.status;
+  end;
+  $$ language plpgsql;
+
+  create trigger update_access_request_status before update of status on access_request
+    for each row execute procedure update_access_request_status();
+
+  create index access_request_status_expiration_time_ix
+    on access_request (status, expiration_time);
+
+commit;
@@ -0,0 +1,35 @@
+begin;
+
+-- Users and accounts can be disabled. Disabled users cannot authenticate with
+-- any of their accounts, and disabled accounts cannot be used to
+-- authenticate. The sessions and auth tokens of users are revoked when they or
+-- their accounts are disabled.
+alter table iam_user
+  add column disabled boolean not null default false;
+
+alter table auth_account
+  add column disabled boolean not null default false;
+
+-- Replaces the view created in 4/01_iam.up.sql to add the disabled state of
+-- users.
+drop view iam_user_acct_info;
+
+create view iam_user_acct_info as
+select
+    u.public_id,
+    u.scope_id,
+    u.name,
+    u.description,
+    u.create_time,
+    u.update_time,
+    u.version,
+    u.disabled,
+    i.primary_account_id,
+    i.login_name,
+    i.full_name,
+    i.email
+from
+	iam_user u
+left outer join iam_acct_info i on u.public_id = i.iam_user_id;
+
+commit;
@@ -0,0 +1,178 @@
+begin;
+
+  -- Password auth methods can require accounts to authenticate with a second
+  -- factor. WebAuthn credentials can only be registered and used once the
+  -- relying party ID of the auth method is set.
+  alter table auth_password_method
+    add column mfa_required boolean not null default false,
+    add column webauthn_rp_id text
+      constraint webauthn_rp_id_must_not_be_empty
+        check(length(trim(webauthn_rp_id)) > 0);
+
+  -- Replaces the view created in 2/20_pass.up.sql to add the mfa_required
+  -- and webauthn_rp_id columns.
+  drop view auth_password_method_with_is_primary;
+
+  create view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.mfa_required,
+    am.webauthn_rp_id
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  -- auth_password_totp is the TOTP seed of a password account. The seed is
+  -- encrypted with the database key of the scope. A seed is only used to
+  -- authenticate once it is confirmed with a valid code. last_step is the
+  -- last time step a code was accepted for, codes cannot be reused.
+  create table auth_password_totp (
+    password_account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    secret bytea not null
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    key_id text not null
+      constraint key_id_must_not_be_empty
+        check(length(trim(key_id)) > 0),
+    confirmed boolean not null default false,
+    last_step bigint not null default 0
+  );
+
+  create trigger immutable_columns before update on auth_password_totp
+    for each row execute procedure immutable_columns('password_account_id', 'create_time');
+
+  create trigger update_time_column before update on auth_password_totp
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on auth_password_totp
+    for each row execute procedure default_create_time();
+
+  -- auth_password_webauthn_credential is a WebAuthn public key credential of
+  -- a password account. public_key is the DER encoded SubjectPublicKeyInfo of
+  -- the credential and public_key_algorithm its COSE algorithm identifier.
+  create table auth_password_webauthn_credential (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    name text,
+    credential_id bytea not null
+      constraint credential_id_must_not_be_empty
+        check(length(credential_id) > 0),
+    public_key bytea not null
+      constraint public_key_must_not_be_empty
+        check(length(public_key) > 0),
+    public_key_algorithm int not null,
+    sign_count bigint not null default 0,
+    unique(password_account_id, credential_id),
+    unique(password_account_id, name)
+  );
+
+  create trigger immutable_columns before update on auth_password_webauthn_credential
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'credential_id', 'public_key', 'public_key_algorithm', 'create_time');
+
+  create trigger update_time_column before update on auth_password_webauthn_credential
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on auth_password_webauthn_credential
+    for each row execute procedure default_create_time();
+
+  -- auth_password_recovery_code is a single use recovery code of a password
+  -- account, which can be used instead of its other second factors. Only the
+  -- SHA-256 hash of the code is stored.
+  create table auth_password_recovery_code (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    create_time wt_timestamp,
+    code_hash bytea not null
+      constraint code_hash_must_not_be_empty
+        check(length(code_hash) > 0),
+    unique(password_account_id, code_hash)
+  );
+
+  create trigger immutable_columns before update on auth_password_recovery_code
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'create_time', 'code_hash');
+
+  create trigger default_create_time_column before insert on auth_password_recovery_code
+    for each row execute procedure default_create_time();
+
+  create table auth_password_mfa_challenge_purpose_enm (
+    name text primary key
+      constraint only_predefined_mfa_challenge_purposes_allowed
+      check (
+        name in (
+          'authenticate',
+          'register'
+        )
+      )
+  );
+
+  insert into auth_password_mfa_challenge_purpose_enm (name)
+  values
+    ('authenticate'),
+    ('register');
+
+  -- auth_password_mfa_challenge is a pending second factor challenge of a
+  -- password account. Challenges are created once an account authenticated
+  -- with its password, or when a WebAuthn credential is being registered,
+  -- and deleted once they are answered. Like auth tokens, challenges are not
+  -- replicated.
+  create table auth_password_mfa_challenge (
+    private_id wt_private_id primary key,
+    password_account_id wt_public_id not null
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    purpose text not null
+      references auth_password_mfa_challenge_purpose_enm (name)
+      on delete restrict
+      on update cascade,
+    challenge bytea not null
+      constraint challenge_must_not_be_empty
+        check(length(challenge) > 0),
+    create_time wt_timestamp,
+    expiration_time wt_timestamp
+  );
+
+  create trigger immutable_columns before update on auth_password_mfa_challenge
+    for each row execute procedure immutable_columns('private_id', 'password_account_id', 'purpose', 'challenge', 'create_time', 'expiration_time');
+
+  create trigger default_create_time_column before insert on auth_password_mfa_challenge
+    for each row execute procedure default_create_time();
+
+  create index auth_password_mfa_challenge_expiration_time_ix
+    on auth_password_mfa_challenge (expiration_time);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('auth_password_totp', 1),
+    ('auth_password_webauthn_credential', 1),
+    ('auth_password_recovery_code', 1);
+
+commit;
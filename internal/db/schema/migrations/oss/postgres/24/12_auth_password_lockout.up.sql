@@ -0,0 +1,74 @@
+begin;
+
+  -- Password auth methods can lock out accounts after repeated failed
+  -- authentication attempts. An account is locked out for
+  -- lockout_duration_seconds once lockout_max_failed_attempts attempts failed
+  -- within lockout_window_seconds. A lockout_max_failed_attempts of 0
+  -- disables the lockout.
+  alter table auth_password_method
+    add column lockout_max_failed_attempts int not null default 0
+      constraint lockout_max_failed_attempts_must_not_be_negative
+        check(lockout_max_failed_attempts >= 0),
+    add column lockout_window_seconds int not null default 300
+      constraint lockout_window_seconds_must_be_positive
+        check(lockout_window_seconds > 0),
+    add column lockout_duration_seconds int not null default 900
+      constraint lockout_duration_seconds_must_be_positive
+        check(lockout_duration_seconds > 0);
+
+  -- Replaces the view created in 24/11_auth_password_mfa.up.sql to add the
+  -- lockout columns.
+  drop view auth_password_method_with_is_primary;
+
+  create view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.mfa_required,
+    am.webauthn_rp_id,
+    am.lockout_max_failed_attempts,
+    am.lockout_window_seconds,
+    am.lockout_duration_seconds
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  -- auth_password_account_lockout tracks the failed authentication attempts
+  -- of a password account, so they are counted across controllers.
+  -- failed_attempts is the number of attempts which failed since
+  -- window_start_time. locked_until is set when the account is locked out.
+  -- The rows are not replicated.
+  create table auth_password_account_lockout (
+    password_account_id wt_public_id primary key
+      references auth_password_account (public_id)
+      on delete cascade
+      on update cascade,
+    failed_attempts int not null default 0
+      constraint failed_attempts_must_not_be_negative
+        check(failed_attempts >= 0),
+    window_start_time wt_timestamp,
+    locked_until timestamp with time zone,
+    update_time wt_timestamp
+  );
+
+  create trigger immutable_columns before update on auth_password_account_lockout
+    for each row execute procedure immutable_columns('password_account_id');
+
+  create trigger update_time_column before update on auth_password_account_lockout
+    for each row execute procedure update_time_column();
+
+commit;
@@ -0,0 +1,39 @@
+begin;
+
+  -- Static hosts can carry key/value tags. A key can have several values.
+  create table static_host_tag (
+    host_id wt_public_id not null
+      constraint static_host_fkey
+        references static_host (public_id)
+        on delete cascade
+        on update cascade,
+    key text not null
+      constraint key_must_not_be_empty
+        check(length(trim(key)) > 0)
+      constraint key_must_not_be_too_long
+        check(length(key) <= 512),
+    value text not null
+      constraint value_must_not_be_empty
+        check(length(trim(value)) > 0)
+      constraint value_must_not_be_too_long
+        check(length(value) <= 512),
+    primary key(host_id, key, value)
+  );
+  comment on table static_host_tag is
+    'static_host_tag is a table where each row is a key/value tag of a static host.';
+
+  create trigger immutable_columns before update on static_host_tag
+    for each row execute procedure immutable_columns('host_id', 'key', 'value');
+
+  -- A static host set with a filter has no static_host_set_member rows: its
+  -- members are the hosts of its catalog whose tags match the filter.
+  alter table static_host_set
+    add column filter text
+      constraint filter_must_not_be_empty
+        check(length(trim(filter)) > 0);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('static_host_tag', 1);
+
+commit;
@@ -0,0 +1,79 @@
+begin;
+
+  -- host_set_health_check is the health check of the hosts of a host set.
+  -- The hosts are checked by the workers matching worker_filter, or by all
+  -- workers if it is null.
+  create table host_set_health_check (
+    set_id wt_public_id primary key
+      constraint host_set_fkey
+        references host_set (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    type text not null
+      constraint type_must_be_tcp_or_http
+        check(type in ('tcp', 'http')),
+    port int not null
+      constraint port_must_be_valid
+        check(port > 0 and port <= 65535),
+    path text
+      constraint path_must_be_absolute
+        check(left(path, 1) = '/'),
+    interval_seconds int not null
+      constraint interval_seconds_must_be_at_least_5
+        check(interval_seconds >= 5),
+    timeout_seconds int not null
+      constraint timeout_seconds_must_be_positive
+        check(timeout_seconds > 0),
+    worker_filter text
+      constraint worker_filter_must_not_be_empty
+        check(length(trim(worker_filter)) > 0),
+    constraint timeout_seconds_must_be_less_than_interval_seconds
+      check(timeout_seconds < interval_seconds),
+    constraint path_must_only_be_set_for_http
+      check(path is null or type = 'http')
+  );
+  comment on table host_set_health_check is
+    'host_set_health_check is a table where each row is the health check of the hosts of a host set.';
+
+  create trigger immutable_columns before update on host_set_health_check
+    for each row execute procedure immutable_columns('set_id', 'create_time');
+
+  create trigger update_time_column before update on host_set_health_check
+    for each row execute procedure update_time_column();
+
+  create trigger default_create_time_column before insert on host_set_health_check
+    for each row execute procedure default_create_time();
+
+  -- host_health is the last result of the health check of a host of a set,
+  -- reported by the worker worker_id. The rows are deleted with the health
+  -- check of the set, and are not replicated. worker_id is not a foreign key
+  -- since the row of a worker is replaced when it reports its status.
+  create table host_health (
+    host_id wt_public_id not null
+      constraint host_fkey
+        references host (public_id)
+        on delete cascade
+        on update cascade,
+    set_id wt_public_id not null
+      constraint host_set_health_check_fkey
+        references host_set_health_check (set_id)
+        on delete cascade
+        on update cascade,
+    healthy boolean not null,
+    message text,
+    worker_id text not null
+      constraint worker_id_must_not_be_empty
+        check(length(trim(worker_id)) > 0),
+    checked_time wt_timestamp,
+    primary key(host_id, set_id)
+  );
+
+  create index host_health_set_id_ix on host_health (set_id);
+
+  insert into oplog_ticket (name, version)
+  values
+    ('host_set_health_check', 1);
+
+commit;
@@ -0,0 +1,164 @@
+begin;
+
+  create table target_host_selection_strategy_enm (
+    name text primary key
+      constraint only_predefined_host_selection_strategies_allowed
+      check (
+        name in (
+          'random',
+          'round_robin',
+          'least_sessions',
+          'sticky_per_user'
+        )
+      )
+  );
+
+  insert into target_host_selection_strategy_enm (name)
+  values
+    ('random'),
+    ('round_robin'),
+    ('least_sessions'),
+    ('sticky_per_user');
+
+  -- The host selection strategy of a target is used to choose the host of a
+  -- new session among the hosts of the host sources of the target, when the
+  -- user does not request a specific host.
+  alter table target_tcp
+    add column host_selection_strategy text not null default 'random'
+      references target_host_selection_strategy_enm (name)
+      on delete restrict
+      on update cascade
+  ;
+
+  alter table target_ssh
+    add column host_selection_strategy text not null default 'random'
+      references target_host_selection_strategy_enm (name)
+      on delete restrict
+      on update cascade
+  ;
+
+  -- Replaces the view created in 24/06_session_quotas to include the host
+  -- selection strategy.
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type,
+    user_session_limit,
+    session_max_bytes_up,
+    session_max_bytes_down,
+    connection_idle_timeout_seconds,
+    host_selection_strategy
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type,
+    user_session_limit,
+    session_max_bytes_up,
+    session_max_bytes_down,
+    connection_idle_timeout_seconds,
+    host_selection_strategy
+  from target_ssh;
+
+  -- The strategy which chose the host of a session is recorded with the
+  -- session. It is null when the host was requested by the user.
+  alter table session
+    add column host_selection_strategy text
+      references target_host_selection_strategy_enm (name)
+      on delete restrict
+      on update cascade
+  ;
+
+  -- Replaces the trigger created in 24/06_session_quotas to make the host
+  -- selection strategy immutable.
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter', 'max_bytes_up', 'max_bytes_down', 'connection_idle_timeout_seconds', 'host_selection_strategy');
+
+  -- Used by the round_robin strategy to find the time of the last session of
+  -- each host of a target, and by the least_sessions strategy to count the
+  -- sessions of each host.
+  create index session_target_id_host_id_create_time_ix
+    on session (target_id, host_id, create_time);
+  create index session_host_id_ix
+    on session (host_id);
+
+  -- Replaces the view created in 24/01_session_connection_recording to include
+  -- the host selection strategy.
+  create or replace view session_list as
+    select
+      s.public_id,
+      s.user_id,
+      s.host_id,
+      s.server_id,
+      s.server_type,
+      s.target_id,
+      s.host_set_id,
+      s.auth_token_id,
+      s.scope_id,
+      s.certificate,
+      s.expiration_time,
+      s.connection_limit,
+      s.tofu_token,
+      s.key_id,
+      s.termination_reason,
+      s.version,
+      s.create_time,
+      s.update_time,
+      s.endpoint,
+      s.worker_filter,
+      ss.state,
+      ss.previous_end_time,
+      ss.start_time,
+      ss.end_time,
+      sc.public_id as connection_id,
+      sc.client_tcp_address,
+      sc.client_tcp_port,
+      sc.endpoint_tcp_address,
+      sc.endpoint_tcp_port,
+      sc.bytes_up,
+      sc.bytes_down,
+      sc.closed_reason,
+      scr.storage_path as recording_storage_path,
+      scr.size_bytes   as recording_size_bytes,
+      scr.checksum     as recording_checksum,
+      scr.start_time   as recording_start_time,
+      scr.end_time     as recording_end_time,
+      s.host_selection_strategy
+    from
+      session s
+    join
+      session_state ss
+    on
+      s.public_id = ss.session_id
+    left join
+      session_connection sc
+    on
+      s.public_id = sc.session_id
+    left join
+      session_connection_recording scr
+    on
+      sc.public_id = scr.connection_id;
+
+commit;
@@ -0,0 +1,30 @@
+begin;
+
+  -- Replaces the constraint updated in 24/06_session_quotas. A session whose
+  -- connection limit is reached is terminated with the 'idle timeout' reason
+  -- instead of 'connection limit' when its last connection was closed because
+  -- it was idle for longer than the idle timeout of the session.
+  alter table session_termination_reason_enm
+    drop constraint only_predefined_session_termination_reasons_allowed,
+    add constraint only_predefined_session_termination_reasons_allowed
+      check (
+        name in (
+          'unknown',
+          'timed out',
+          'closed by end-user',
+          'terminated',
+          'network error',
+          'system error',
+          'connection limit',
+          'canceled',
+          'bytes limit',
+          'idle timeout'
+        )
+      )
+  ;
+
+  insert into session_termination_reason_enm (name)
+  values
+    ('idle timeout');
+
+commit;
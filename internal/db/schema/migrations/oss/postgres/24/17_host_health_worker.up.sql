@@ -0,0 +1,13 @@
+begin;
+
+  -- Replaces the primary key added in 24/14_host_health. Each worker running
+  -- the health check of a set has its own result for a host of the set, so
+  -- the results of a worker no longer replace the results of other workers.
+  alter table host_health
+    drop constraint host_health_pkey,
+    add primary key(host_id, set_id, worker_id);
+
+  comment on table host_health is
+    'host_health is a table where each row is the last result of the health check of a host of a set reported by a worker.';
+
+commit;
@@ -0,0 +1,71 @@
+begin;
+
+  -- known_hosts are the host keys and host certificate authorities trusted
+  -- for the hosts of an ssh target, in the OpenSSH known_hosts format. The
+  -- worker refuses to send a password to a host whose key cannot be verified
+  -- with them.
+  alter table target_ssh
+    add column known_hosts text
+      constraint known_hosts_must_not_be_empty
+        check(length(trim(known_hosts)) > 0)
+  ;
+
+  -- Replaces the view created in 24/15_target_host_selection to include the
+  -- known hosts of ssh targets.
+  create or replace view target_all_subtypes
+  as
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'tcp' as type,
+    user_session_limit,
+    session_max_bytes_up,
+    session_max_bytes_down,
+    connection_idle_timeout_seconds,
+    host_selection_strategy,
+    null as known_hosts
+  from target_tcp
+  union
+  select
+    public_id,
+    scope_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    'ssh' as type,
+    user_session_limit,
+    session_max_bytes_up,
+    session_max_bytes_down,
+    connection_idle_timeout_seconds,
+    host_selection_strategy,
+    known_hosts
+  from target_ssh;
+
+  -- The known hosts of the target are round tripped to the worker with the
+  -- session, like its endpoint.
+  alter table session
+    add column endpoint_known_hosts text
+  ;
+
+  -- Replaces the trigger created in 24/15_target_host_selection to make the
+  -- endpoint known hosts immutable.
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit', 'create_time', 'endpoint', 'worker_filter', 'max_bytes_up', 'max_bytes_down', 'connection_idle_timeout_seconds', 'host_selection_strategy', 'endpoint_known_hosts');
+
+commit;
@@ -122,6 +122,16 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    InvalidDynamicCredential,
 			want: InvalidDynamicCredential,
 		},
+		{
+			name: "SessionLimitExceeded",
+			c:    SessionLimitExceeded,
+			want: SessionLimitExceeded,
+		},
+		{
+			name: "InvalidAccessRequestState",
+			c:    InvalidAccessRequestState,
+			want: InvalidAccessRequestState,
+		},
 		{
 			name: "InternalError",
 			c:    Internal,
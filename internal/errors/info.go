@@ -100,6 +100,14 @@ var errorCodeInfo = map[Code]Info{
 		Message: "dynamic credential for session is in an invalid state",
 		Kind:    Integrity,
 	},
+	SessionLimitExceeded: {
+		Message: "session limit exceeded",
+		Kind:    State,
+	},
+	InvalidAccessRequestState: {
+		Message: "access request is in an invalid state",
+		Kind:    State,
+	},
 	PasswordTooShort: {
 		Message: "too short",
 		Kind:    Password,
@@ -0,0 +1,179 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-bexpr/grammar"
+)
+
+// Operator is a comparison of a Condition.
+type Operator int
+
+const (
+	UnknownOperator Operator = iota
+	// EqualOperator matches the items whose field is equal to the value.
+	EqualOperator
+	// NotEqualOperator matches the items whose field is not equal to the
+	// value.
+	NotEqualOperator
+	// ContainsOperator matches the items whose field contains the value. It is
+	// the translation of the "in" operator on a string field.
+	ContainsOperator
+	// MatchesOperator matches the items whose field matches the regular
+	// expression value.
+	MatchesOperator
+	// EqualFoldOperator matches the items whose field is equal to the value,
+	// ignoring case. It has no filter expression equivalent.
+	EqualFoldOperator
+)
+
+// Condition is a comparison of a top level field of an item, taken from a
+// filter expression, which can be evaluated by the database before the
+// items are read.
+type Condition struct {
+	Field    string
+	Operator Operator
+	Value    string
+}
+
+// Sql returns the SQL condition comparing the SQL expression column to the
+// value of c, which must be passed as the argument of placeholder.
+//
+// A filter evaluates an unset field as "", while a NULL column never matches
+// a comparison. column is therefore compared as "" when it is NULL, unless
+// the comparison cannot match "" and the column can be compared as is.
+func (c Condition) Sql(column, placeholder string) (string, error) {
+	switch {
+	case c.Operator == NotEqualOperator, c.Operator == MatchesOperator, c.Value == "":
+		column = fmt.Sprintf("coalesce(%s, '')", column)
+	}
+	switch c.Operator {
+	case EqualOperator:
+		return fmt.Sprintf("%s = %s", column, placeholder), nil
+	case NotEqualOperator:
+		return fmt.Sprintf("%s <> %s", column, placeholder), nil
+	case ContainsOperator:
+		return fmt.Sprintf("strpos(%s, %s) > 0", column, placeholder), nil
+	case MatchesOperator:
+		return fmt.Sprintf("%s ~ %s", column, placeholder), nil
+	case EqualFoldOperator:
+		return fmt.Sprintf("lower(%s) = lower(%s)", column, placeholder), nil
+	default:
+		return "", fmt.Errorf("unknown operator %d for field %q", c.Operator, c.Field)
+	}
+}
+
+// Conditions returns the conditions of the filter expression f which can be
+// evaluated by the database. They are the comparisons of the top level
+// conjunction of f which compare one of the given fields of the item with
+// "==", "!=", "in" or "matches". Every item matching f matches the
+// conditions, but the reverse is not true: the conditions select the items
+// f must be evaluated against rather than replace it.
+func Conditions(f string, fields ...string) ([]Condition, error) {
+	if f == "" || len(fields) == 0 {
+		return nil, nil
+	}
+	ast, err := grammar.Parse("", []byte(f))
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		allowed[field] = true
+	}
+	var conds []Condition
+	var walk func(e grammar.Expression)
+	walk = func(e grammar.Expression) {
+		switch e := e.(type) {
+		case *grammar.BinaryExpression:
+			if e.Operator == grammar.BinaryOpAnd {
+				walk(e.Left)
+				walk(e.Right)
+			}
+		case *grammar.MatchExpression:
+			if c, ok := condition(e, allowed); ok {
+				conds = append(conds, c)
+			}
+		}
+	}
+	walk(ast.(grammar.Expression))
+	return conds, nil
+}
+
+func condition(e *grammar.MatchExpression, fields map[string]bool) (Condition, bool) {
+	p := e.Selector.Path
+	if len(p) != 2 || p[0] != "item" || !fields[p[1]] || e.Value == nil {
+		return Condition{}, false
+	}
+	c := Condition{Field: p[1], Value: e.Value.Raw}
+	switch e.Operator {
+	case grammar.MatchEqual:
+		c.Operator = EqualOperator
+	case grammar.MatchNotEqual:
+		c.Operator = NotEqualOperator
+	case grammar.MatchIn:
+		c.Operator = ContainsOperator
+	case grammar.MatchMatches:
+		if !portableRegexp(c.Value) {
+			return Condition{}, false
+		}
+		c.Operator = MatchesOperator
+	default:
+		return Condition{}, false
+	}
+	return c, true
+}
+
+// portableRegexp reports whether the Go regular expression re only uses
+// syntax which PostgreSQL evaluates the same way or more loosely, so the
+// database never excludes an item the filter would match.
+func portableRegexp(re string) bool {
+	if _, err := regexp.Compile(re); err != nil {
+		return false
+	}
+	if strings.Count(re, "(?") != strings.Count(re, "(?:") {
+		// flags and named groups are written differently in PostgreSQL
+		return false
+	}
+	for i := 0; i < len(re); i++ {
+		if re[i] != '\\' {
+			continue
+		}
+		i++
+		switch c := re[i]; {
+		case c == 'd' || c == 's' || c == 'w':
+		case c < 0x80 && !isAlphaNumeric(c):
+			// an escaped punctuation character
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphaNumeric(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// Where returns the SQL where clause and its arguments evaluating the
+// conditions, joined with "and" and using "?" placeholders. columns maps the
+// field of each condition to the SQL expression holding its value. An error
+// is returned if the field of a condition is not in columns.
+func Where(conds []Condition, columns map[string]string) (string, []interface{}, error) {
+	var where []string
+	var args []interface{}
+	for _, c := range conds {
+		col, ok := columns[c.Field]
+		if !ok {
+			return "", nil, fmt.Errorf("field %q cannot be filtered in the database", c.Field)
+		}
+		w, err := c.Sql(col, "?")
+		if err != nil {
+			return "", nil, err
+		}
+		where, args = append(where, w), append(args, c.Value)
+	}
+	return strings.Join(where, " and "), args, nil
+}
@@ -0,0 +1,129 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditions(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		fields []string
+		want   []Condition
+	}{
+		{
+			name:   "empty",
+			filter: "",
+			fields: []string{"name"},
+		},
+		{
+			name:   "no-fields",
+			filter: `"/item/name" == "foo"`,
+		},
+		{
+			name:   "equal",
+			filter: `"/item/name" == "foo"`,
+			fields: []string{"name"},
+			want:   []Condition{{Field: "name", Operator: EqualOperator, Value: "foo"}},
+		},
+		{
+			name:   "bexpr-selector",
+			filter: `item.name != "foo"`,
+			fields: []string{"name"},
+			want:   []Condition{{Field: "name", Operator: NotEqualOperator, Value: "foo"}},
+		},
+		{
+			name:   "in",
+			filter: `"foo" in "/item/name"`,
+			fields: []string{"name"},
+			want:   []Condition{{Field: "name", Operator: ContainsOperator, Value: "foo"}},
+		},
+		{
+			name:   "matches",
+			filter: `"/item/name" matches ` + "`^dev-\\d+(?:\\.web)?$`",
+			fields: []string{"name"},
+			want:   []Condition{{Field: "name", Operator: MatchesOperator, Value: `^dev-\d+(?:\.web)?$`}},
+		},
+		{
+			name:   "matches-not-portable",
+			filter: `"/item/name" matches "(?i)foo" and "/item/name" matches ` + "`\\bfoo`",
+			fields: []string{"name"},
+		},
+		{
+			name:   "conjunction",
+			filter: `"/item/status" == "active" and ("/item/user_id" == "u_1234567890" and "/item/description" == "foo")`,
+			fields: []string{"status", "user_id"},
+			want: []Condition{
+				{Field: "status", Operator: EqualOperator, Value: "active"},
+				{Field: "user_id", Operator: EqualOperator, Value: "u_1234567890"},
+			},
+		},
+		{
+			name:   "disjunction-and-negation",
+			filter: `("/item/status" == "active" or "/item/status" == "pending") and not "/item/user_id" == "u_1234567890"`,
+			fields: []string{"status", "user_id"},
+		},
+		{
+			name:   "nested-field",
+			filter: `"/item/attributes/name" == "foo" and "/item/scope/id" == "global"`,
+			fields: []string{"name", "scope", "id"},
+		},
+		{
+			name:   "other-operators",
+			filter: `"/item/name" is empty and "foo" not in "/item/name" and "/item/name" not matches "foo"`,
+			fields: []string{"name"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Conditions(tt.filter, tt.fields...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Conditions(`"/item/name" ==`, "name")
+		assert.Error(t, err)
+	})
+}
+
+func TestWhere(t *testing.T) {
+	columns := map[string]string{
+		"name":   "name",
+		"status": "(select state from session_state where session_id = public_id)",
+	}
+	where, args, err := Where([]Condition{
+		{Field: "name", Operator: EqualOperator, Value: "a"},
+		{Field: "name", Operator: NotEqualOperator, Value: "b"},
+		{Field: "name", Operator: ContainsOperator, Value: "c"},
+		{Field: "status", Operator: MatchesOperator, Value: "^d"},
+		{Field: "name", Operator: EqualFoldOperator, Value: "E"},
+	}, columns)
+	require.NoError(t, err)
+	assert.Equal(t, "name = ? and coalesce(name, '') <> ? and strpos(name, ?) > 0 and coalesce((select state from session_state where session_id = public_id), '') ~ ? and lower(name) = lower(?)", where)
+	assert.Equal(t, []interface{}{"a", "b", "c", "^d", "E"}, args)
+
+	// Comparisons which match "" also match NULL columns.
+	where, args, err = Where([]Condition{
+		{Field: "name", Operator: EqualOperator, Value: ""},
+		{Field: "name", Operator: ContainsOperator, Value: ""},
+	}, columns)
+	require.NoError(t, err)
+	assert.Equal(t, "coalesce(name, '') = ? and strpos(coalesce(name, ''), ?) > 0", where)
+	assert.Equal(t, []interface{}{"", ""}, args)
+
+	where, args, err = Where(nil, columns)
+	require.NoError(t, err)
+	assert.Empty(t, where)
+	assert.Empty(t, args)
+
+	_, _, err = Where([]Condition{{Field: "type", Operator: EqualOperator, Value: "tcp"}}, columns)
+	assert.Error(t, err)
+
+	_, _, err = Where([]Condition{{Field: "name", Value: "a"}}, columns)
+	assert.Error(t, err)
+}
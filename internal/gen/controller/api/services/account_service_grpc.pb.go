@@ -54,6 +54,35 @@ type AccountServiceClient interface {
 	// request. This method is intended for end users and requires the existing
 	// password to be provided for authentication purposes.
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// EnrollTotp generates a new TOTP seed for the password Account, replacing
+	// any unconfirmed seed. The seed cannot be used to authenticate until it is
+	// confirmed with ConfirmTotp. An error is returned if a confirmed seed is
+	// already enrolled.
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error)
+	// ConfirmTotp confirms the enrolled TOTP seed of the password Account with a
+	// code of the authenticator. Recovery codes are generated and returned if
+	// the Account has none.
+	ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error)
+	// EnrollWebAuthn starts the registration of a WebAuthn credential for the
+	// password Account, returning the parameters for
+	// navigator.credentials.create(). The registration is completed with
+	// ConfirmWebAuthn.
+	EnrollWebAuthn(ctx context.Context, in *EnrollWebAuthnRequest, opts ...grpc.CallOption) (*EnrollWebAuthnResponse, error)
+	// ConfirmWebAuthn registers the WebAuthn credential created for the
+	// challenge returned by EnrollWebAuthn. Recovery codes are generated and
+	// returned if the Account has none.
+	ConfirmWebAuthn(ctx context.Context, in *ConfirmWebAuthnRequest, opts ...grpc.CallOption) (*ConfirmWebAuthnResponse, error)
+	// RemoveMfa removes the TOTP seed or a WebAuthn credential of the password
+	// Account. The recovery codes of the Account are removed with its last
+	// second factor.
+	RemoveMfa(ctx context.Context, in *RemoveMfaRequest, opts ...grpc.CallOption) (*RemoveMfaResponse, error)
+	// GenerateRecoveryCodes replaces the recovery codes of the password Account
+	// with new ones, which are returned. The Account must have a second factor
+	// enrolled.
+	GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error)
+	// Unlock clears the lockout and the failed authentication attempts of the
+	// password Account.
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
 }
 
 type accountServiceClient struct {
@@ -127,6 +156,69 @@ func (c *accountServiceClient) ChangePassword(ctx context.Context, in *ChangePas
 	return out, nil
 }
 
+func (c *accountServiceClient) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...grpc.CallOption) (*EnrollTotpResponse, error) {
+	out := new(EnrollTotpResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/EnrollTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ConfirmTotp(ctx context.Context, in *ConfirmTotpRequest, opts ...grpc.CallOption) (*ConfirmTotpResponse, error) {
+	out := new(ConfirmTotpResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/ConfirmTotp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) EnrollWebAuthn(ctx context.Context, in *EnrollWebAuthnRequest, opts ...grpc.CallOption) (*EnrollWebAuthnResponse, error) {
+	out := new(EnrollWebAuthnResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/EnrollWebAuthn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ConfirmWebAuthn(ctx context.Context, in *ConfirmWebAuthnRequest, opts ...grpc.CallOption) (*ConfirmWebAuthnResponse, error) {
+	out := new(ConfirmWebAuthnResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/ConfirmWebAuthn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RemoveMfa(ctx context.Context, in *RemoveMfaRequest, opts ...grpc.CallOption) (*RemoveMfaResponse, error) {
+	out := new(RemoveMfaResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/RemoveMfa", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GenerateRecoveryCodes(ctx context.Context, in *GenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*GenerateRecoveryCodesResponse, error) {
+	out := new(GenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/GenerateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.AccountService/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility
@@ -167,6 +259,35 @@ type AccountServiceServer interface {
 	// request. This method is intended for end users and requires the existing
 	// password to be provided for authentication purposes.
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// EnrollTotp generates a new TOTP seed for the password Account, replacing
+	// any unconfirmed seed. The seed cannot be used to authenticate until it is
+	// confirmed with ConfirmTotp. An error is returned if a confirmed seed is
+	// already enrolled.
+	EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error)
+	// ConfirmTotp confirms the enrolled TOTP seed of the password Account with a
+	// code of the authenticator. Recovery codes are generated and returned if
+	// the Account has none.
+	ConfirmTotp(context.Context, *ConfirmTotpRequest) (*ConfirmTotpResponse, error)
+	// EnrollWebAuthn starts the registration of a WebAuthn credential for the
+	// password Account, returning the parameters for
+	// navigator.credentials.create(). The registration is completed with
+	// ConfirmWebAuthn.
+	EnrollWebAuthn(context.Context, *EnrollWebAuthnRequest) (*EnrollWebAuthnResponse, error)
+	// ConfirmWebAuthn registers the WebAuthn credential created for the
+	// challenge returned by EnrollWebAuthn. Recovery codes are generated and
+	// returned if the Account has none.
+	ConfirmWebAuthn(context.Context, *ConfirmWebAuthnRequest) (*ConfirmWebAuthnResponse, error)
+	// RemoveMfa removes the TOTP seed or a WebAuthn credential of the password
+	// Account. The recovery codes of the Account are removed with its last
+	// second factor.
+	RemoveMfa(context.Context, *RemoveMfaRequest) (*RemoveMfaResponse, error)
+	// GenerateRecoveryCodes replaces the recovery codes of the password Account
+	// with new ones, which are returned. The Account must have a second factor
+	// enrolled.
+	GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error)
+	// Unlock clears the lockout and the failed authentication attempts of the
+	// password Account.
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -195,6 +316,27 @@ func (UnimplementedAccountServiceServer) SetPassword(context.Context, *SetPasswo
 func (UnimplementedAccountServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedAccountServiceServer) EnrollTotp(context.Context, *EnrollTotpRequest) (*EnrollTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedAccountServiceServer) ConfirmTotp(context.Context, *ConfirmTotpRequest) (*ConfirmTotpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (UnimplementedAccountServiceServer) EnrollWebAuthn(context.Context, *EnrollWebAuthnRequest) (*EnrollWebAuthnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollWebAuthn not implemented")
+}
+func (UnimplementedAccountServiceServer) ConfirmWebAuthn(context.Context, *ConfirmWebAuthnRequest) (*ConfirmWebAuthnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmWebAuthn not implemented")
+}
+func (UnimplementedAccountServiceServer) RemoveMfa(context.Context, *RemoveMfaRequest) (*RemoveMfaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMfa not implemented")
+}
+func (UnimplementedAccountServiceServer) GenerateRecoveryCodes(context.Context, *GenerateRecoveryCodesRequest) (*GenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAccountServiceServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 
 // UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -334,6 +476,132 @@ func _AccountService_ChangePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EnrollTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/EnrollTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EnrollTotp(ctx, req.(*EnrollTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ConfirmTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/ConfirmTotp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ConfirmTotp(ctx, req.(*ConfirmTotpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_EnrollWebAuthn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollWebAuthnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EnrollWebAuthn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/EnrollWebAuthn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EnrollWebAuthn(ctx, req.(*EnrollWebAuthnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ConfirmWebAuthn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmWebAuthnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ConfirmWebAuthn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/ConfirmWebAuthn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ConfirmWebAuthn(ctx, req.(*ConfirmWebAuthnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RemoveMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RemoveMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/RemoveMfa",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RemoveMfa(ctx, req.(*RemoveMfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/GenerateRecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GenerateRecoveryCodes(ctx, req.(*GenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.AccountService/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -369,6 +637,34 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _AccountService_ChangePassword_Handler,
 		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _AccountService_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "ConfirmTotp",
+			Handler:    _AccountService_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "EnrollWebAuthn",
+			Handler:    _AccountService_EnrollWebAuthn_Handler,
+		},
+		{
+			MethodName: "ConfirmWebAuthn",
+			Handler:    _AccountService_ConfirmWebAuthn_Handler,
+		},
+		{
+			MethodName: "RemoveMfa",
+			Handler:    _AccountService_RemoveMfa_Handler,
+		},
+		{
+			MethodName: "GenerateRecoveryCodes",
+			Handler:    _AccountService_GenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _AccountService_Unlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/account_service.proto",
@@ -44,6 +44,12 @@ type ScopeServiceClient interface {
 	// DeleteScope remotes a Scope and all child resources from Boundary. If the
 	// provided Scope IDs are malformed or not provided an error is returned.
 	DeleteScope(ctx context.Context, in *DeleteScopeRequest, opts ...grpc.CallOption) (*DeleteScopeResponse, error)
+	// CheckPermissions evaluates whether a User is authorized to perform an
+	// action on a resource in this Scope, the same way as when the User performs
+	// the request, and returns the grants determining the decision. The User's
+	// auth token is not required. If the Scope ID is missing, malformed, or
+	// referencing a non-existing Scope, an error is returned.
+	CheckPermissions(ctx context.Context, in *CheckPermissionsRequest, opts ...grpc.CallOption) (*CheckPermissionsResponse, error)
 }
 
 type scopeServiceClient struct {
@@ -99,6 +105,15 @@ func (c *scopeServiceClient) DeleteScope(ctx context.Context, in *DeleteScopeReq
 	return out, nil
 }
 
+func (c *scopeServiceClient) CheckPermissions(ctx context.Context, in *CheckPermissionsRequest, opts ...grpc.CallOption) (*CheckPermissionsResponse, error) {
+	out := new(CheckPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.ScopeService/CheckPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScopeServiceServer is the server API for ScopeService service.
 // All implementations must embed UnimplementedScopeServiceServer
 // for forward compatibility
@@ -129,6 +144,12 @@ type ScopeServiceServer interface {
 	// DeleteScope remotes a Scope and all child resources from Boundary. If the
 	// provided Scope IDs are malformed or not provided an error is returned.
 	DeleteScope(context.Context, *DeleteScopeRequest) (*DeleteScopeResponse, error)
+	// CheckPermissions evaluates whether a User is authorized to perform an
+	// action on a resource in this Scope, the same way as when the User performs
+	// the request, and returns the grants determining the decision. The User's
+	// auth token is not required. If the Scope ID is missing, malformed, or
+	// referencing a non-existing Scope, an error is returned.
+	CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error)
 	mustEmbedUnimplementedScopeServiceServer()
 }
 
@@ -151,6 +172,9 @@ func (UnimplementedScopeServiceServer) UpdateScope(context.Context, *UpdateScope
 func (UnimplementedScopeServiceServer) DeleteScope(context.Context, *DeleteScopeRequest) (*DeleteScopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScope not implemented")
 }
+func (UnimplementedScopeServiceServer) CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermissions not implemented")
+}
 func (UnimplementedScopeServiceServer) mustEmbedUnimplementedScopeServiceServer() {}
 
 // UnsafeScopeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +278,24 @@ func _ScopeService_DeleteScope_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScopeService_CheckPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScopeServiceServer).CheckPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.ScopeService/CheckPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScopeServiceServer).CheckPermissions(ctx, req.(*CheckPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScopeService_ServiceDesc is the grpc.ServiceDesc for ScopeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +323,10 @@ var ScopeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScope",
 			Handler:    _ScopeService_DeleteScope_Handler,
 		},
+		{
+			MethodName: "CheckPermissions",
+			Handler:    _ScopeService_CheckPermissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/scope_service.proto",
@@ -32,6 +32,12 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// DownloadSessionRecording returns the recording of a connection of a
+	// Session, which the controller fetches from the worker holding it. An
+	// error is returned if the connection does not belong to the Session, was
+	// not recorded, or if the recording is larger than the maximum download
+	// size configured on the controller.
+	DownloadSessionRecording(ctx context.Context, in *DownloadSessionRecordingRequest, opts ...grpc.CallOption) (*DownloadSessionRecordingResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -69,6 +75,15 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) DownloadSessionRecording(ctx context.Context, in *DownloadSessionRecordingRequest, opts ...grpc.CallOption) (*DownloadSessionRecordingResponse, error) {
+	out := new(DownloadSessionRecordingResponse)
+	err := c.cc.Invoke(ctx, "/controller.api.services.v1.SessionService/DownloadSessionRecording", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -87,6 +102,12 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// DownloadSessionRecording returns the recording of a connection of a
+	// Session, which the controller fetches from the worker holding it. An
+	// error is returned if the connection does not belong to the Session, was
+	// not recorded, or if the recording is larger than the maximum download
+	// size configured on the controller.
+	DownloadSessionRecording(context.Context, *DownloadSessionRecordingRequest) (*DownloadSessionRecordingResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -103,6 +124,9 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) DownloadSessionRecording(context.Context, *DownloadSessionRecordingRequest) (*DownloadSessionRecordingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadSessionRecording not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -170,6 +194,24 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_DownloadSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadSessionRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).DownloadSessionRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/controller.api.services.v1.SessionService/DownloadSessionRecording",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).DownloadSessionRecording(ctx, req.(*DownloadSessionRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -189,6 +231,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "DownloadSessionRecording",
+			Handler:    _SessionService_DownloadSessionRecording_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
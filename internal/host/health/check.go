@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/health/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-bexpr"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CheckType is the type of a health check.
+type CheckType string
+
+const (
+	// TcpCheck checks that a TCP connection can be opened to the port of a
+	// host.
+	TcpCheck CheckType = "tcp"
+
+	// HttpCheck checks that an HTTP GET request on the port and path of a
+	// host returns a status code lower than 400.
+	HttpCheck CheckType = "http"
+)
+
+func (t CheckType) String() string {
+	return string(t)
+}
+
+const (
+	// DefaultIntervalSeconds is the default number of seconds between two
+	// checks of a host.
+	DefaultIntervalSeconds = 30
+
+	// MinIntervalSeconds is the minimum number of seconds between two
+	// checks of a host.
+	MinIntervalSeconds = 5
+
+	// DefaultTimeoutSeconds is the default number of seconds after which a
+	// check fails.
+	DefaultTimeoutSeconds = 5
+)
+
+// A Check is the health check of the hosts of a host set.
+type Check struct {
+	*store.Check
+	tableName string `gorm:"-"`
+}
+
+// NewCheck creates a new in memory Check of the hosts of setId. The path,
+// interval seconds, timeout seconds and worker filter options are
+// supported. All other options are ignored.
+func NewCheck(ctx context.Context, setId string, checkType CheckType, port uint32, opt ...Option) (*Check, error) {
+	const op = "health.NewCheck"
+	if setId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no set id")
+	}
+	opts := getOpts(opt...)
+	c := &Check{
+		Check: &store.Check{
+			SetId:           setId,
+			Type:            checkType.String(),
+			Port:            port,
+			Path:            opts.withPath,
+			IntervalSeconds: opts.withIntervalSeconds,
+			TimeoutSeconds:  opts.withTimeoutSeconds,
+			WorkerFilter:    opts.withWorkerFilter,
+		},
+	}
+	if err := c.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return c, nil
+}
+
+func (c *Check) validate(ctx context.Context) error {
+	const op = "health.(Check).validate"
+	switch CheckType(c.GetType()) {
+	case TcpCheck:
+		if c.GetPath() != "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "a path can only be set for http checks")
+		}
+	case HttpCheck:
+		if c.GetPath() != "" && !strings.HasPrefix(c.GetPath(), "/") {
+			return errors.New(ctx, errors.InvalidParameter, op, "path must start with /")
+		}
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown check type %q", c.GetType()))
+	}
+	if c.GetPort() == 0 || c.GetPort() > 65535 {
+		return errors.New(ctx, errors.InvalidParameter, op, "port must be between 1 and 65535")
+	}
+	if c.GetIntervalSeconds() < MinIntervalSeconds {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("interval must be at least %d seconds", MinIntervalSeconds))
+	}
+	if c.GetTimeoutSeconds() == 0 || c.GetTimeoutSeconds() >= c.GetIntervalSeconds() {
+		return errors.New(ctx, errors.InvalidParameter, op, "timeout must be positive and less than the interval")
+	}
+	if c.GetWorkerFilter() != "" {
+		if _, err := bexpr.CreateEvaluator(c.GetWorkerFilter()); err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, "invalid worker filter", errors.WithWrap(err))
+		}
+	}
+	return nil
+}
+
+// TableName returns the table name for the check.
+func (c *Check) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "host_set_health_check"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (c *Check) SetTableName(n string) {
+	c.tableName = n
+}
+
+func allocCheck() *Check {
+	return &Check{
+		Check: &store.Check{},
+	}
+}
+
+func (c *Check) clone() *Check {
+	cp := proto.Clone(c.Check)
+	return &Check{
+		Check: cp.(*store.Check),
+	}
+}
+
+func (c *Check) oplog(op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{c.SetId},
+		"resource-type":      []string{"host-set-health-check"},
+		"op-type":            []string{op.String()},
+	}
+}
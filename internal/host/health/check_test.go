@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/health/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheck_New(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		setId     string
+		checkType CheckType
+		port      uint32
+		opts      []Option
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    *store.Check
+		wantErr bool
+	}{
+		{
+			name: "blank-setId",
+			args: args{
+				checkType: TcpCheck,
+				port:      22,
+			},
+			wantErr: true,
+		},
+		{
+			name: "tcp-defaults",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+				port:      22,
+			},
+			want: &store.Check{
+				SetId:           "hsst_1234567890",
+				Type:            "tcp",
+				Port:            22,
+				IntervalSeconds: DefaultIntervalSeconds,
+				TimeoutSeconds:  DefaultTimeoutSeconds,
+			},
+		},
+		{
+			name: "http-all-options",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: HttpCheck,
+				port:      8080,
+				opts: []Option{
+					WithPath("/healthz"),
+					WithIntervalSeconds(60),
+					WithTimeoutSeconds(10),
+					WithWorkerFilter(`"east" in "/tags/region"`),
+				},
+			},
+			want: &store.Check{
+				SetId:           "hsst_1234567890",
+				Type:            "http",
+				Port:            8080,
+				Path:            "/healthz",
+				IntervalSeconds: 60,
+				TimeoutSeconds:  10,
+				WorkerFilter:    `"east" in "/tags/region"`,
+			},
+		},
+		{
+			name: "unknown-type",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: "udp",
+				port:      53,
+			},
+			wantErr: true,
+		},
+		{
+			name: "tcp-with-path",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+				port:      22,
+				opts:      []Option{WithPath("/")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "relative-path",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: HttpCheck,
+				port:      80,
+				opts:      []Option{WithPath("healthz")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero-port",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+			},
+			wantErr: true,
+		},
+		{
+			name: "port-too-large",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+				port:      65536,
+			},
+			wantErr: true,
+		},
+		{
+			name: "interval-too-short",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+				port:      22,
+				opts:      []Option{WithIntervalSeconds(MinIntervalSeconds - 1), WithTimeoutSeconds(1)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "timeout-not-less-than-interval",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+				port:      22,
+				opts:      []Option{WithIntervalSeconds(10), WithTimeoutSeconds(10)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid-worker-filter",
+			args: args{
+				setId:     "hsst_1234567890",
+				checkType: TcpCheck,
+				port:      22,
+				opts:      []Option{WithWorkerFilter(`"east" in`)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewCheck(context.Background(), tt.args.setId, tt.args.checkType, tt.args.port, tt.args.opts...)
+			if tt.wantErr {
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			assert.Truef(proto.Equal(tt.want, got.Check), "got %v, want %v", got.Check, tt.want)
+		})
+	}
+}
+
+func TestCheck_SetTableName(t *testing.T) {
+	t.Parallel()
+	defaultTableName := "host_set_health_check"
+	c := allocCheck()
+	assert.Equal(t, defaultTableName, c.TableName())
+	c.SetTableName("tbl")
+	assert.Equal(t, "tbl", c.TableName())
+	c.SetTableName("")
+	assert.Equal(t, defaultTableName, c.TableName())
+}
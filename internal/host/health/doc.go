@@ -0,0 +1,12 @@
+// Package health provides the health checks of the hosts of host sets.
+//
+// A host set can have one health check. The hosts of the set are checked by
+// the workers, which open a TCP connection to, or make an HTTP GET request
+// on, a port of each host at the interval of the check. The workers report
+// the results of the checks to the controllers in their status, and the
+// controllers skip the hosts reported unhealthy when authorizing a session.
+//
+// A health check can have a worker filter to select the workers which can
+// reach the hosts. Without one, all workers check the hosts, and the most
+// recent result reported by any of them is the health of a host.
+package health
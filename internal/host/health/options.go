@@ -0,0 +1,72 @@
+package health
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withPath            string
+	withIntervalSeconds uint32
+	withTimeoutSeconds  uint32
+	withWorkerFilter    string
+	withSetIds          []string
+}
+
+func getDefaultOptions() options {
+	return options{
+		withIntervalSeconds: DefaultIntervalSeconds,
+		withTimeoutSeconds:  DefaultTimeoutSeconds,
+	}
+}
+
+// WithPath provides an optional path requested by http checks.
+func WithPath(path string) Option {
+	return func(o *options) {
+		o.withPath = path
+	}
+}
+
+// WithIntervalSeconds provides an optional number of seconds between two
+// checks of a host. Zero means DefaultIntervalSeconds.
+func WithIntervalSeconds(s uint32) Option {
+	return func(o *options) {
+		if s != 0 {
+			o.withIntervalSeconds = s
+		}
+	}
+}
+
+// WithTimeoutSeconds provides an optional number of seconds after which a
+// check fails. Zero means DefaultTimeoutSeconds.
+func WithTimeoutSeconds(s uint32) Option {
+	return func(o *options) {
+		if s != 0 {
+			o.withTimeoutSeconds = s
+		}
+	}
+}
+
+// WithWorkerFilter provides an optional filter selecting the workers which
+// run a check.
+func WithWorkerFilter(filter string) Option {
+	return func(o *options) {
+		o.withWorkerFilter = filter
+	}
+}
+
+// WithSetIds provides an optional list of host set ids to restrict the
+// checks returned by ListChecks to.
+func WithSetIds(ids []string) Option {
+	return func(o *options) {
+		o.withSetIds = ids
+	}
+}
@@ -0,0 +1,65 @@
+package health
+
+const (
+	upsertHostHealthQuery = `
+insert into host_health
+       (host_id, set_id, healthy, message, worker_id, checked_time)
+select @host_id, @set_id, @healthy, nullif(@message, ''), @worker_id, current_timestamp
+ where exists (select 1 from host_set_health_check where set_id = @set_id)
+   and exists (select 1 from host where public_id = @host_id)
+    on conflict (host_id, set_id, worker_id) do update
+   set healthy      = excluded.healthy,
+       message      = excluded.message,
+       checked_time = excluded.checked_time;
+`
+	deleteSetHostHealthQuery = `
+delete from host_health
+ where set_id = @set_id;
+`
+	// A result is stale once the host has not been checked for three
+	// intervals of its check.
+	hostHealthSelect = `
+select hh.host_id,
+       hh.set_id,
+       hh.healthy,
+       coalesce(hh.message, ''),
+       hh.worker_id,
+       hh.checked_time,
+       hh.checked_time < current_timestamp - make_interval(secs => 3 * hc.interval_seconds) as stale
+  from host_health hh
+  join host_set_health_check hc
+    on hc.set_id = hh.set_id
+`
+	hostHealthByHostIdQuery = hostHealthSelect + `
+ where hh.host_id = ?
+ order by hh.set_id, hh.worker_id;
+`
+	// A host is unhealthy when a worker reported a recent failed check and no
+	// worker reported a recent passed check. The most recent failure is
+	// returned for each host.
+	unhealthyBySetIdsQuery = `
+select distinct on (hh.host_id, hh.set_id)
+       hh.host_id,
+       hh.set_id,
+       hh.healthy,
+       coalesce(hh.message, ''),
+       hh.worker_id,
+       hh.checked_time,
+       false as stale
+  from host_health hh
+  join host_set_health_check hc
+    on hc.set_id = hh.set_id
+ where hh.set_id in (?)
+   and not hh.healthy
+   and hh.checked_time >= current_timestamp - make_interval(secs => 3 * hc.interval_seconds)
+   and not exists (
+         select 1
+           from host_health ok
+          where ok.host_id = hh.host_id
+            and ok.set_id = hh.set_id
+            and ok.healthy
+            and ok.checked_time >= current_timestamp - make_interval(secs => 3 * hc.interval_seconds)
+       )
+ order by hh.host_id, hh.set_id, hh.checked_time desc;
+`
+)
@@ -0,0 +1,37 @@
+package health
+
+import (
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+)
+
+const unlimited = -1
+
+// A Repository stores and retrieves the health checks of host sets and the
+// health of their hosts. It is not safe to use a repository concurrently.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+}
+
+// NewRepository creates a new Repository. The returned repository should
+// only be used for one transaction and it is not safe for concurrent go
+// routines to access it.
+func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms) (*Repository, error) {
+	const op = "health.NewRepository"
+	switch {
+	case r == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "db.Reader")
+	case w == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "db.Writer")
+	case kms == nil:
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "kms")
+	}
+	return &Repository{
+		reader: r,
+		writer: w,
+		kms:    kms,
+	}, nil
+}
@@ -0,0 +1,160 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// SetCheck sets c as the health check of c.SetId, replacing the current
+// check of the set if it has one. The results of the replaced check are
+// deleted. It returns a new Check containing the stored values. c is not
+// changed.
+func (r *Repository) SetCheck(ctx context.Context, scopeId string, c *Check) (*Check, error) {
+	const op = "health.(Repository).SetCheck"
+	switch {
+	case c == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil Check")
+	case c.Check == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Check")
+	case c.SetId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no set id")
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	if err := c.validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c = c.clone()
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var returnedCheck *Check
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			current := allocCheck()
+			err := reader.LookupWhere(ctx, current, "set_id = ?", c.SetId)
+			switch {
+			case err == nil:
+				fields := []string{"Type", "Port", "IntervalSeconds", "TimeoutSeconds"}
+				var nullFields []string
+				if c.Path == "" {
+					nullFields = append(nullFields, "Path")
+				} else {
+					fields = append(fields, "Path")
+				}
+				if c.WorkerFilter == "" {
+					nullFields = append(nullFields, "WorkerFilter")
+				} else {
+					fields = append(fields, "WorkerFilter")
+				}
+				returnedCheck = c.clone()
+				rowsUpdated, err := w.Update(ctx, returnedCheck, fields, nullFields, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated health check and %d rows updated", rowsUpdated))
+				}
+				if _, err := w.Exec(ctx, deleteSetHostHealthQuery, []interface{}{sql.Named("set_id", c.SetId)}); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			case errors.IsNotFoundError(err):
+				returnedCheck = c.clone()
+				if err := w.Create(ctx, returnedCheck, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			default:
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for set %s", c.SetId)))
+	}
+	return returnedCheck, nil
+}
+
+// LookupCheck returns the health check of setId. If the set has no health
+// check, it returns nil, nil.
+func (r *Repository) LookupCheck(ctx context.Context, setId string) (*Check, error) {
+	const op = "health.(Repository).LookupCheck"
+	if setId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no set id")
+	}
+	c := allocCheck()
+	if err := r.reader.LookupWhere(ctx, c, "set_id = ?", setId); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", setId)))
+	}
+	return c, nil
+}
+
+// ListChecks returns the health checks of all host sets. The WithSetIds
+// option restricts the checks returned to the ones of the given sets. All
+// other options are ignored.
+func (r *Repository) ListChecks(ctx context.Context, opt ...Option) ([]*Check, error) {
+	const op = "health.(Repository).ListChecks"
+	opts := getOpts(opt...)
+	where, args := "true", []interface{}(nil)
+	if opts.withSetIds != nil {
+		if len(opts.withSetIds) == 0 {
+			return nil, nil
+		}
+		where, args = "set_id in (?)", []interface{}{opts.withSetIds}
+	}
+	var checks []*Check
+	if err := r.reader.SearchWhere(ctx, &checks, where, args, db.WithLimit(unlimited)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return checks, nil
+}
+
+// DeleteCheck deletes the health check of setId and the results of the
+// check. It returns the number of checks deleted.
+func (r *Repository) DeleteCheck(ctx context.Context, scopeId, setId string) (int, error) {
+	const op = "health.(Repository).DeleteCheck"
+	switch {
+	case setId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no set id")
+	case scopeId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	}
+	c := allocCheck()
+	c.SetId = setId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dc := c.clone()
+			rowsDeleted, err = w.Delete(ctx, dc, db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", setId)))
+	}
+	return rowsDeleted, nil
+}
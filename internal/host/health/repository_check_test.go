@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SetCheck(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := static.TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	sets := static.TestSets(t, conn, catalog.PublicId, 2)
+	host := static.TestHosts(t, conn, catalog.PublicId, 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	in, err := NewCheck(ctx, sets[0].PublicId, HttpCheck, 8080, WithPath("/healthz"), WithWorkerFilter(`"east" in "/tags/region"`))
+	require.NoError(err)
+	created, err := repo.SetCheck(ctx, prj.PublicId, in)
+	require.NoError(err)
+	assert.NotSame(in, created)
+	assert.NotNil(created.CreateTime)
+	assert.Equal("/healthz", created.Path)
+	assert.NoError(db.TestVerifyOplog(t, rw, sets[0].PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE)))
+
+	got, err := repo.LookupCheck(ctx, sets[0].PublicId)
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal("http", got.Type)
+	assert.Equal(uint32(8080), got.Port)
+	assert.Equal(`"east" in "/tags/region"`, got.WorkerFilter)
+
+	// Results of a replaced check are deleted.
+	require.NoError(repo.ReportResults(ctx, "w_1", []*Result{{HostId: host.PublicId, SetId: sets[0].PublicId}}))
+
+	upd, err := NewCheck(ctx, sets[0].PublicId, TcpCheck, 22)
+	require.NoError(err)
+	updated, err := repo.SetCheck(ctx, prj.PublicId, upd)
+	require.NoError(err)
+	assert.Equal("tcp", updated.Type)
+	assert.NoError(db.TestVerifyOplog(t, rw, sets[0].PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE)))
+
+	got, err = repo.LookupCheck(ctx, sets[0].PublicId)
+	require.NoError(err)
+	assert.Empty(got.Path)
+	assert.Empty(got.WorkerFilter)
+	hh, err := repo.ListHostHealth(ctx, host.PublicId)
+	require.NoError(err)
+	assert.Empty(hh)
+
+	got, err = repo.LookupCheck(ctx, sets[1].PublicId)
+	require.NoError(err)
+	assert.Nil(got)
+
+	_, err = repo.SetCheck(ctx, "", upd)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = repo.SetCheck(ctx, prj.PublicId, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	bad := upd.clone()
+	bad.Port = 0
+	_, err = repo.SetCheck(ctx, prj.PublicId, bad)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
+
+func TestRepository_ListChecks(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := static.TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	sets := static.TestSets(t, conn, catalog.PublicId, 3)
+	TestCheck(t, conn, sets[0].PublicId, TcpCheck, 22)
+	TestCheck(t, conn, sets[1].PublicId, HttpCheck, 80)
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	got, err := repo.ListChecks(ctx)
+	require.NoError(err)
+	assert.Len(got, 2)
+
+	got, err = repo.ListChecks(ctx, WithSetIds([]string{sets[1].PublicId, sets[2].PublicId}))
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(sets[1].PublicId, got[0].SetId)
+
+	got, err = repo.ListChecks(ctx, WithSetIds([]string{}))
+	require.NoError(err)
+	assert.Empty(got)
+}
+
+func TestRepository_DeleteCheck(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := static.TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	set := static.TestSets(t, conn, catalog.PublicId, 1)[0]
+	TestCheck(t, conn, set.PublicId, TcpCheck, 22)
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	n, err := repo.DeleteCheck(ctx, prj.PublicId, set.PublicId)
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.NoError(db.TestVerifyOplog(t, rw, set.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_DELETE)))
+
+	n, err = repo.DeleteCheck(ctx, prj.PublicId, set.PublicId)
+	require.NoError(err)
+	assert.Equal(0, n)
+
+	_, err = repo.DeleteCheck(ctx, prj.PublicId, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
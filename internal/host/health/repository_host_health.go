@@ -0,0 +1,147 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// A Status is the health status of a host.
+type Status string
+
+const (
+	// StatusUnknown is the status of a host which has not been checked
+	// recently.
+	StatusUnknown Status = "unknown"
+
+	// StatusHealthy is the status of a host which passed its last check.
+	StatusHealthy Status = "healthy"
+
+	// StatusUnhealthy is the status of a host which failed its last check.
+	StatusUnhealthy Status = "unhealthy"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// A Result is the result of a health check of a host reported by a worker.
+type Result struct {
+	HostId  string
+	SetId   string
+	Healthy bool
+	// Message describes the failure of an unhealthy check.
+	Message string
+}
+
+// HostHealth is the health of a host of a set, from the last result of the
+// health check of the set reported for the host by a worker.
+type HostHealth struct {
+	HostId      string
+	SetId       string
+	Status      Status
+	Message     string
+	WorkerId    string
+	CheckedTime time.Time
+}
+
+// ReportResults stores the results of health checks reported by the worker
+// workerId, replacing the previous results of the worker for the same hosts
+// and sets. The results of other workers are kept.
+// Results for hosts or sets which no longer exist, or for sets which no
+// longer have a health check, are ignored.
+func (r *Repository) ReportResults(ctx context.Context, workerId string, results []*Result) error {
+	const op = "health.(Repository).ReportResults"
+	if workerId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no worker id")
+	}
+	if len(results) == 0 {
+		return nil
+	}
+	_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			for _, res := range results {
+				if _, err := w.Exec(ctx, upsertHostHealthQuery, []interface{}{
+					sql.Named("host_id", res.HostId),
+					sql.Named("set_id", res.SetId),
+					sql.Named("healthy", res.Healthy),
+					sql.Named("message", res.Message),
+					sql.Named("worker_id", workerId),
+				}); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for host %s of set %s", res.HostId, res.SetId)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// ListHostHealth returns the health of hostId reported by each worker in each
+// of the sets with a health check for which a result was reported for the
+// host, ordered by set id and worker id. The status of a host which has not been checked for three intervals of
+// a check is StatusUnknown.
+func (r *Repository) ListHostHealth(ctx context.Context, hostId string) ([]*HostHealth, error) {
+	const op = "health.(Repository).ListHostHealth"
+	if hostId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no host id")
+	}
+	hh, err := r.queryHostHealth(ctx, hostHealthByHostIdQuery, hostId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return hh, nil
+}
+
+// ListUnhealthy returns the hosts of setIds which failed the last health
+// check of their set run by a worker and passed it on no worker, once for
+// each host and set. Results which have not been updated for three intervals
+// of the check are ignored.
+func (r *Repository) ListUnhealthy(ctx context.Context, setIds []string) ([]*HostHealth, error) {
+	const op = "health.(Repository).ListUnhealthy"
+	if len(setIds) == 0 {
+		return nil, nil
+	}
+	hh, err := r.queryHostHealth(ctx, unhealthyBySetIdsQuery, setIds)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return hh, nil
+}
+
+func (r *Repository) queryHostHealth(ctx context.Context, query string, args ...interface{}) ([]*HostHealth, error) {
+	const op = "health.(Repository).queryHostHealth"
+	rows, err := r.reader.Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ret []*HostHealth
+	for rows.Next() {
+		var h HostHealth
+		var healthy, stale bool
+		if err := rows.Scan(&h.HostId, &h.SetId, &healthy, &h.Message, &h.WorkerId, &h.CheckedTime, &stale); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		switch {
+		case stale:
+			h.Status = StatusUnknown
+		case healthy:
+			h.Status = StatusHealthy
+		default:
+			h.Status = StatusUnhealthy
+		}
+		ret = append(ret, &h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
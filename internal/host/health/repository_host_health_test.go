@@ -0,0 +1,126 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_HostHealth(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := static.TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	sets := static.TestSets(t, conn, catalog.PublicId, 3)
+	hosts := static.TestHosts(t, conn, catalog.PublicId, 2)
+	TestCheck(t, conn, sets[0].PublicId, TcpCheck, 22)
+	TestCheck(t, conn, sets[1].PublicId, HttpCheck, 80)
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	require.NoError(repo.ReportResults(ctx, "w_1", []*Result{
+		{HostId: hosts[0].PublicId, SetId: sets[0].PublicId, Healthy: true},
+		{HostId: hosts[0].PublicId, SetId: sets[1].PublicId, Message: "unhealthy status code 503"},
+		{HostId: hosts[1].PublicId, SetId: sets[0].PublicId, Message: "connection refused"},
+		// The set has no health check, the result is ignored.
+		{HostId: hosts[1].PublicId, SetId: sets[2].PublicId},
+	}))
+
+	got, err := repo.ListHostHealth(ctx, hosts[0].PublicId)
+	require.NoError(err)
+	require.Len(got, 2)
+	bySet := map[string]*HostHealth{}
+	for _, hh := range got {
+		bySet[hh.SetId] = hh
+	}
+	assert.Equal(StatusHealthy, bySet[sets[0].PublicId].Status)
+	assert.Empty(bySet[sets[0].PublicId].Message)
+	assert.Equal(StatusUnhealthy, bySet[sets[1].PublicId].Status)
+	assert.Equal("unhealthy status code 503", bySet[sets[1].PublicId].Message)
+	assert.Equal("w_1", bySet[sets[1].PublicId].WorkerId)
+	assert.False(bySet[sets[1].PublicId].CheckedTime.IsZero())
+
+	unhealthy, err := repo.ListUnhealthy(ctx, []string{sets[0].PublicId, sets[1].PublicId})
+	require.NoError(err)
+	assert.Len(unhealthy, 2)
+	unhealthy, err = repo.ListUnhealthy(ctx, []string{sets[0].PublicId})
+	require.NoError(err)
+	require.Len(unhealthy, 1)
+	assert.Equal(hosts[1].PublicId, unhealthy[0].HostId)
+
+	// The results of a worker do not replace the results of other workers,
+	// and a host is healthy as long as a worker reports it healthy.
+	require.NoError(repo.ReportResults(ctx, "w_2", []*Result{
+		{HostId: hosts[1].PublicId, SetId: sets[0].PublicId, Healthy: true},
+		{HostId: hosts[0].PublicId, SetId: sets[1].PublicId, Message: "connection refused"},
+	}))
+	unhealthy, err = repo.ListUnhealthy(ctx, []string{sets[0].PublicId})
+	require.NoError(err)
+	assert.Empty(unhealthy)
+	got, err = repo.ListHostHealth(ctx, hosts[1].PublicId)
+	require.NoError(err)
+	require.Len(got, 2)
+	assert.Equal("w_1", got[0].WorkerId)
+	assert.Equal(StatusUnhealthy, got[0].Status)
+	assert.Equal("w_2", got[1].WorkerId)
+	assert.Equal(StatusHealthy, got[1].Status)
+
+	// A host reported unhealthy by several workers is listed once, with the
+	// most recent failure.
+	unhealthy, err = repo.ListUnhealthy(ctx, []string{sets[1].PublicId})
+	require.NoError(err)
+	require.Len(unhealthy, 1)
+	assert.Equal(hosts[0].PublicId, unhealthy[0].HostId)
+	assert.Equal("w_2", unhealthy[0].WorkerId)
+	assert.Equal("connection refused", unhealthy[0].Message)
+
+	// A worker replaces its own result.
+	require.NoError(repo.ReportResults(ctx, "w_1", []*Result{
+		{HostId: hosts[1].PublicId, SetId: sets[0].PublicId, Message: "connection reset"},
+	}))
+	got, err = repo.ListHostHealth(ctx, hosts[1].PublicId)
+	require.NoError(err)
+	require.Len(got, 2)
+	assert.Equal("connection reset", got[0].Message)
+
+	// A stale healthy result does not hide a recent failure.
+	_, err = rw.Exec(ctx, "update host_health set checked_time = now() - interval '1 hour' where host_id = ? and worker_id = ?", []interface{}{hosts[1].PublicId, "w_2"})
+	require.NoError(err)
+	unhealthy, err = repo.ListUnhealthy(ctx, []string{sets[0].PublicId})
+	require.NoError(err)
+	require.Len(unhealthy, 1)
+	assert.Equal(hosts[1].PublicId, unhealthy[0].HostId)
+	assert.Equal("w_1", unhealthy[0].WorkerId)
+
+	// A result which is older than three intervals of the check is unknown.
+	_, err = rw.Exec(ctx, "update host_health set checked_time = now() - interval '1 hour' where host_id = ?", []interface{}{hosts[0].PublicId})
+	require.NoError(err)
+	got, err = repo.ListHostHealth(ctx, hosts[0].PublicId)
+	require.NoError(err)
+	require.Len(got, 3)
+	for _, hh := range got {
+		assert.Equal(StatusUnknown, hh.Status)
+	}
+	unhealthy, err = repo.ListUnhealthy(ctx, []string{sets[1].PublicId})
+	require.NoError(err)
+	assert.Empty(unhealthy)
+
+	err = repo.ReportResults(ctx, "", []*Result{{HostId: hosts[0].PublicId, SetId: sets[0].PublicId}})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = repo.ListHostHealth(ctx, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
@@ -0,0 +1,25 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheck creates a health check of checkType on port for the host set
+// setId in the provided DB. The set must have been created previously. The
+// options supported by NewCheck are supported. If any errors are encountered
+// during the creation of the check, the test will fail.
+func TestCheck(t *testing.T, conn *db.DB, setId string, checkType CheckType, port uint32, opt ...Option) *Check {
+	t.Helper()
+	require := require.New(t)
+	c, err := NewCheck(context.Background(), setId, checkType, port, opt...)
+	require.NoError(err)
+	require.NotNil(c)
+
+	w := db.New(conn)
+	require.NoError(w.Create(context.Background(), c))
+	return c
+}
@@ -19,6 +19,7 @@ type options struct {
 	WithLimit             int
 	WithOrderByCreateTime bool
 	Ascending             bool
+	WithStartPageAfterId  string
 }
 
 func getDefaultOptions() options {
@@ -44,3 +45,12 @@ func WithOrderByCreateTime(ascending bool) Option {
 		return nil
 	}
 }
+
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) error {
+		o.WithStartPageAfterId = id
+		return nil
+	}
+}
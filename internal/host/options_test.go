@@ -31,4 +31,11 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.Ascending = true
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		opts, err := GetOpts(WithStartPageAfterId("hcplg_1234567890"))
+		require.NoError(t, err)
+		testOpts := getDefaultOptions()
+		testOpts.WithStartPageAfterId = "hcplg_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -0,0 +1,526 @@
+package inventory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// The supported inventory formats.
+const (
+	FormatIni  = "ini"
+	FormatYaml = "yaml"
+	FormatCsv  = "csv"
+	FormatJson = "json"
+)
+
+// ansibleHostVar is the host variable of Ansible inventories containing the
+// address of a host, if it differs from its name.
+const ansibleHostVar = "ansible_host"
+
+// ungroupedGroup is the group of the hosts of an Ansible inventory which are
+// in no other group.
+const ungroupedGroup = "ungrouped"
+
+// inventoryHost is a host of an inventory.
+type inventoryHost struct {
+	Name    string
+	Address string
+	// Groups are the groups the host is in, including the parent groups of
+	// its groups, sorted.
+	Groups []string
+	Labels map[string]string
+}
+
+// addresses returns the ip addresses and dns names of h.
+func (h *inventoryHost) addresses() (ipAddresses, dnsNames []string) {
+	addr := h.Address
+	if addr == "" {
+		addr = h.Name
+	}
+	if net.ParseIP(addr) != nil {
+		return []string{addr}, nil
+	}
+	return nil, []string{addr}
+}
+
+// evalData returns the data the filter of a host set is evaluated against.
+func (h *inventoryHost) evalData() map[string]interface{} {
+	return map[string]interface{}{
+		"name":    h.Name,
+		"address": h.Address,
+		"groups":  h.Groups,
+		"labels":  h.Labels,
+	}
+}
+
+// parseInventory parses the inventory doc in format. The hosts are returned
+// sorted by name.
+func parseInventory(format string, doc []byte) ([]*inventoryHost, error) {
+	var b *builder
+	var err error
+	switch format {
+	case FormatIni:
+		b, err = parseIni(doc)
+	case FormatYaml:
+		b, err = parseYaml(doc)
+	case FormatCsv:
+		b, err = parseCsv(doc)
+	case FormatJson:
+		b, err = parseJson(doc)
+	default:
+		return nil, fmt.Errorf("unknown inventory format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s inventory: %w", format, err)
+	}
+	return b.hosts(), nil
+}
+
+// builder collects the hosts, groups and variables of an inventory. Host
+// variables override the variables of its groups, which override the
+// variables of their parent groups.
+type builder struct {
+	hostVars  map[string]map[string]string
+	hostAddrs map[string]string
+	// hostGroups are the groups hosts are directly in.
+	hostGroups map[string]map[string]bool
+	groupVars  map[string]map[string]string
+	// groupParents are the parent groups of groups.
+	groupParents map[string]map[string]bool
+}
+
+func newBuilder() *builder {
+	return &builder{
+		hostVars:     make(map[string]map[string]string),
+		hostAddrs:    make(map[string]string),
+		hostGroups:   make(map[string]map[string]bool),
+		groupVars:    make(map[string]map[string]string),
+		groupParents: make(map[string]map[string]bool),
+	}
+}
+
+// addHost adds the host name to group, if it is not empty, with vars.
+func (b *builder) addHost(name, group string, vars map[string]string) {
+	if _, ok := b.hostVars[name]; !ok {
+		b.hostVars[name] = make(map[string]string)
+		b.hostGroups[name] = make(map[string]bool)
+	}
+	if group != "" {
+		b.hostGroups[name][group] = true
+	}
+	for k, v := range vars {
+		b.hostVars[name][k] = v
+	}
+}
+
+func (b *builder) addGroupVars(group string, vars map[string]string) {
+	if _, ok := b.groupVars[group]; !ok {
+		b.groupVars[group] = make(map[string]string)
+	}
+	for k, v := range vars {
+		b.groupVars[group][k] = v
+	}
+}
+
+func (b *builder) addChild(parent, child string) {
+	if _, ok := b.groupParents[child]; !ok {
+		b.groupParents[child] = make(map[string]bool)
+	}
+	b.groupParents[child][parent] = true
+}
+
+// ancestors returns group and its parent groups, parents first.
+func (b *builder) ancestors(group string) []string {
+	var ret []string
+	seen := make(map[string]bool)
+	var walk func(g string)
+	walk = func(g string) {
+		if seen[g] {
+			return
+		}
+		seen[g] = true
+		parents := make([]string, 0, len(b.groupParents[g]))
+		for p := range b.groupParents[g] {
+			parents = append(parents, p)
+		}
+		sort.Strings(parents)
+		for _, p := range parents {
+			walk(p)
+		}
+		ret = append(ret, g)
+	}
+	walk(group)
+	return ret
+}
+
+func (b *builder) hosts() []*inventoryHost {
+	names := make([]string, 0, len(b.hostVars))
+	for n := range b.hostVars {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	ret := make([]*inventoryHost, 0, len(names))
+	for _, n := range names {
+		h := &inventoryHost{
+			Name:   n,
+			Labels: make(map[string]string),
+		}
+		direct := make([]string, 0, len(b.hostGroups[n]))
+		for g := range b.hostGroups[n] {
+			direct = append(direct, g)
+		}
+		sort.Strings(direct)
+		groups := make(map[string]bool)
+		for _, g := range direct {
+			for _, a := range b.ancestors(g) {
+				groups[a] = true
+				for k, v := range b.groupVars[a] {
+					h.Labels[k] = v
+				}
+			}
+		}
+		for g := range groups {
+			h.Groups = append(h.Groups, g)
+		}
+		sort.Strings(h.Groups)
+		for k, v := range b.hostVars[n] {
+			h.Labels[k] = v
+		}
+		h.Address = h.Labels[ansibleHostVar]
+		if a, ok := b.hostAddrs[n]; ok {
+			h.Address = a
+		}
+		ret = append(ret, h)
+	}
+	return ret
+}
+
+// parseIni parses an Ansible INI inventory.
+func parseIni(doc []byte) (*builder, error) {
+	b := newBuilder()
+	const (
+		sectionHosts = iota
+		sectionVars
+		sectionChildren
+	)
+	group, section := ungroupedGroup, sectionHosts
+	s := bufio.NewScanner(bytes.NewReader(doc))
+	for lineNum := 1; s.Scan(); lineNum++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid section %q", lineNum, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			section = sectionHosts
+			switch {
+			case strings.HasSuffix(name, ":vars"):
+				name, section = strings.TrimSuffix(name, ":vars"), sectionVars
+			case strings.HasSuffix(name, ":children"):
+				name, section = strings.TrimSuffix(name, ":children"), sectionChildren
+			}
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty group name", lineNum)
+			}
+			group = name
+			continue
+		}
+
+		switch section {
+		case sectionVars:
+			vars, err := parseIniVars([]string{line})
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			b.addGroupVars(group, vars)
+		case sectionChildren:
+			b.addChild(group, line)
+		default:
+			fields := strings.Fields(line)
+			vars, err := parseIniVars(fields[1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			names, err := expandHostPattern(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			for _, n := range names {
+				b.addHost(n, group, vars)
+			}
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// parseIniVars parses key=value pairs. Quoted values are unquoted.
+func parseIniVars(fields []string) (map[string]string, error) {
+	vars := make(map[string]string, len(fields))
+	for _, f := range fields {
+		i := strings.Index(f, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid variable %q", f)
+		}
+		k, v := strings.TrimSpace(f[:i]), strings.TrimSpace(f[i+1:])
+		if uv, err := strconv.Unquote(v); err == nil {
+			v = uv
+		} else if len(v) >= 2 && v[0] == '\'' && v[len(v)-1] == '\'' {
+			v = v[1 : len(v)-1]
+		}
+		vars[k] = v
+	}
+	return vars, nil
+}
+
+// expandHostPattern expands a numeric or alphabetic range of an Ansible host
+// pattern, like web[01:10].example.com or db-[a:c]. Only one range is
+// supported.
+func expandHostPattern(pattern string) ([]string, error) {
+	start := strings.Index(pattern, "[")
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+	end := strings.Index(pattern[start:], "]")
+	if end < 0 {
+		return nil, fmt.Errorf("invalid host pattern %q", pattern)
+	}
+	end += start
+	prefix, rng, suffix := pattern[:start], pattern[start+1:end], pattern[end+1:]
+	bounds := strings.Split(rng, ":")
+	if len(bounds) != 2 || bounds[0] == "" || bounds[1] == "" {
+		return nil, fmt.Errorf("invalid host pattern %q", pattern)
+	}
+
+	var ret []string
+	if from, err := strconv.Atoi(bounds[0]); err == nil {
+		to, err := strconv.Atoi(bounds[1])
+		if err != nil || to < from {
+			return nil, fmt.Errorf("invalid host pattern %q", pattern)
+		}
+		width := 0
+		if len(bounds[0]) > 1 && bounds[0][0] == '0' {
+			width = len(bounds[0])
+		}
+		for i := from; i <= to; i++ {
+			ret = append(ret, fmt.Sprintf("%s%0*d%s", prefix, width, i, suffix))
+		}
+		return ret, nil
+	}
+	if len(bounds[0]) != 1 || len(bounds[1]) != 1 || bounds[1][0] < bounds[0][0] {
+		return nil, fmt.Errorf("invalid host pattern %q", pattern)
+	}
+	for c := bounds[0][0]; c <= bounds[1][0]; c++ {
+		ret = append(ret, prefix+string(c)+suffix)
+	}
+	return ret, nil
+}
+
+// yamlGroup is a group of an Ansible YAML inventory.
+type yamlGroup struct {
+	Hosts    map[string]map[string]interface{} `json:"hosts"`
+	Vars     map[string]interface{}            `json:"vars"`
+	Children map[string]*yamlGroup             `json:"children"`
+}
+
+// parseYaml parses an Ansible YAML inventory.
+func parseYaml(doc []byte) (*builder, error) {
+	var groups map[string]*yamlGroup
+	if err := yaml.Unmarshal(doc, &groups); err != nil {
+		return nil, err
+	}
+	b := newBuilder()
+	var add func(name string, g *yamlGroup)
+	add = func(name string, g *yamlGroup) {
+		if g == nil {
+			return
+		}
+		b.addGroupVars(name, stringVars(g.Vars))
+		for h, vars := range g.Hosts {
+			b.addHost(h, name, stringVars(vars))
+		}
+		for c, cg := range g.Children {
+			b.addChild(name, c)
+			add(c, cg)
+		}
+	}
+	for name, g := range groups {
+		add(name, g)
+	}
+	return b, nil
+}
+
+// jsonGroup is a group of an Ansible JSON inventory, as printed by
+// ansible-inventory --list.
+type jsonGroup struct {
+	Hosts    []string               `json:"hosts"`
+	Vars     map[string]interface{} `json:"vars"`
+	Children []string               `json:"children"`
+}
+
+// jsonHost is a host of a JSON inventory which is a list of hosts.
+type jsonHost struct {
+	Name    string                 `json:"name"`
+	Address string                 `json:"address"`
+	Groups  []string               `json:"groups"`
+	Labels  map[string]interface{} `json:"labels"`
+}
+
+// parseJson parses a JSON list of hosts or an Ansible JSON inventory.
+func parseJson(doc []byte) (*builder, error) {
+	b := newBuilder()
+	if d := bytes.TrimSpace(doc); len(d) > 0 && d[0] == '[' {
+		var hosts []*jsonHost
+		if err := json.Unmarshal(d, &hosts); err != nil {
+			return nil, err
+		}
+		for i, h := range hosts {
+			if h == nil || h.Name == "" {
+				return nil, fmt.Errorf("host %d: missing name", i)
+			}
+			b.addHost(h.Name, "", stringVars(h.Labels))
+			for _, g := range h.Groups {
+				b.addHost(h.Name, g, nil)
+			}
+			if h.Address != "" {
+				b.hostAddrs[h.Name] = h.Address
+			}
+		}
+		return b, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(doc, &raw); err != nil {
+		return nil, err
+	}
+	for name, r := range raw {
+		if name == "_meta" {
+			var meta struct {
+				HostVars map[string]map[string]interface{} `json:"hostvars"`
+			}
+			if err := json.Unmarshal(r, &meta); err != nil {
+				return nil, fmt.Errorf("_meta: %w", err)
+			}
+			for h, vars := range meta.HostVars {
+				b.addHost(h, "", stringVars(vars))
+			}
+			continue
+		}
+		var g jsonGroup
+		if err := json.Unmarshal(r, &g); err != nil {
+			return nil, fmt.Errorf("group %s: %w", name, err)
+		}
+		b.addGroupVars(name, stringVars(g.Vars))
+		for _, h := range g.Hosts {
+			b.addHost(h, name, nil)
+		}
+		for _, c := range g.Children {
+			b.addChild(name, c)
+		}
+	}
+	return b, nil
+}
+
+// csvGroupsSeparator separates the groups in the groups column of a CSV
+// inventory.
+const csvGroupsSeparator = ";"
+
+// parseCsv parses a CSV inventory. The first row is the header; the name
+// column is required, the address and groups columns are optional, and the
+// other columns are labels.
+func parseCsv(doc []byte) (*builder, error) {
+	r := csv.NewReader(bytes.NewReader(doc))
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+	header, err := r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("missing header")
+		}
+		return nil, err
+	}
+	nameCol := -1
+	for i, c := range header {
+		header[i] = strings.TrimSpace(c)
+		if header[i] == "name" {
+			nameCol = i
+		}
+	}
+	if nameCol < 0 {
+		return nil, fmt.Errorf("missing name column")
+	}
+
+	b := newBuilder()
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		name := strings.TrimSpace(rec[nameCol])
+		if name == "" {
+			line, _ := r.FieldPos(nameCol)
+			return nil, fmt.Errorf("line %d: missing name", line)
+		}
+		b.addHost(name, "", nil)
+		for i, v := range rec {
+			v = strings.TrimSpace(v)
+			switch {
+			case i == nameCol, v == "":
+			case header[i] == "address":
+				b.hostAddrs[name] = v
+			case header[i] == "groups":
+				for _, g := range strings.Split(v, csvGroupsSeparator) {
+					if g = strings.TrimSpace(g); g != "" {
+						b.addHost(name, g, nil)
+					}
+				}
+			default:
+				b.addHost(name, "", map[string]string{header[i]: v})
+			}
+		}
+	}
+	return b, nil
+}
+
+// stringVars converts the values of vars to strings. Values which are not
+// strings, numbers or bools are encoded as JSON.
+func stringVars(vars map[string]interface{}) map[string]string {
+	ret := make(map[string]string, len(vars))
+	for k, v := range vars {
+		switch t := v.(type) {
+		case nil:
+			ret[k] = ""
+		case string:
+			ret[k] = t
+		case bool, float64, int, int64:
+			ret[k] = fmt.Sprint(t)
+		default:
+			j, err := json.Marshal(t)
+			if err != nil {
+				ret[k] = fmt.Sprint(t)
+				continue
+			}
+			ret[k] = string(j)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,201 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInventory(t *testing.T) {
+	t.Parallel()
+	want := []*inventoryHost{
+		{
+			Name:    "db1.example.com",
+			Address: "",
+			Groups:  []string{"db", "prod"},
+			Labels:  map[string]string{"env": "prod", "port": "5432"},
+		},
+		{
+			Name:    "web01",
+			Address: "10.0.0.1",
+			Groups:  []string{"prod", "web"},
+			Labels:  map[string]string{"ansible_host": "10.0.0.1", "env": "prod", "tier": "frontend"},
+		},
+		{
+			Name:    "web02",
+			Address: "10.0.0.2",
+			Groups:  []string{"prod", "web"},
+			Labels:  map[string]string{"ansible_host": "10.0.0.2", "env": "staging", "tier": "frontend"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		doc    string
+		want   []*inventoryHost
+	}{
+		{
+			name:   "ini",
+			format: FormatIni,
+			doc: `
+# web servers
+[web]
+web01 ansible_host=10.0.0.1
+web02 ansible_host=10.0.0.2 env=staging
+
+[web:vars]
+tier=frontend
+
+[db]
+db1.example.com port=5432
+
+[prod:children]
+web
+db
+
+[prod:vars]
+env="prod"
+`,
+			want: want,
+		},
+		{
+			name:   "yaml",
+			format: FormatYaml,
+			doc: `
+all:
+  children:
+    prod:
+      vars:
+        env: prod
+      children:
+        web:
+          vars:
+            tier: frontend
+          hosts:
+            web01:
+              ansible_host: 10.0.0.1
+            web02:
+              ansible_host: 10.0.0.2
+              env: staging
+        db:
+          hosts:
+            db1.example.com:
+              port: 5432
+`,
+			want: func() []*inventoryHost {
+				var ret []*inventoryHost
+				for _, h := range want {
+					c := *h
+					c.Groups = append([]string{"all"}, h.Groups...)
+					ret = append(ret, &c)
+				}
+				return ret
+			}(),
+		},
+		{
+			name:   "ansible-json",
+			format: FormatJson,
+			doc: `{
+  "_meta": {"hostvars": {
+    "web01": {"ansible_host": "10.0.0.1"},
+    "web02": {"ansible_host": "10.0.0.2", "env": "staging"},
+    "db1.example.com": {"port": 5432}
+  }},
+  "prod": {"children": ["web", "db"], "vars": {"env": "prod"}},
+  "web": {"hosts": ["web01", "web02"], "vars": {"tier": "frontend"}},
+  "db": {"hosts": ["db1.example.com"]}
+}`,
+			want: want,
+		},
+		{
+			name:   "json-list",
+			format: FormatJson,
+			doc: `[
+  {"name": "db1.example.com", "groups": ["db", "prod"], "labels": {"env": "prod", "port": 5432}},
+  {"name": "web01", "address": "10.0.0.1", "groups": ["prod", "web"], "labels": {"ansible_host": "10.0.0.1", "env": "prod", "tier": "frontend"}},
+  {"name": "web02", "address": "10.0.0.2", "groups": ["prod", "web"], "labels": {"ansible_host": "10.0.0.2", "env": "staging", "tier": "frontend"}}
+]`,
+			want: want,
+		},
+		{
+			name:   "csv",
+			format: FormatCsv,
+			doc: `name,address,groups,env,tier,port,ansible_host
+# comment
+web01,10.0.0.1,prod;web,prod,frontend,,10.0.0.1
+web02,10.0.0.2,prod;web,staging,frontend,,10.0.0.2
+db1.example.com,,db;prod,prod,,5432,
+`,
+			want: want,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseInventory(tt.format, []byte(tt.doc))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseInventory_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		format string
+		doc    string
+	}{
+		{name: "unknown-format", format: "toml", doc: "a = 1"},
+		{name: "ini-bad-section", format: FormatIni, doc: "[web"},
+		{name: "ini-bad-var", format: FormatIni, doc: "[web]\nweb01 novalue"},
+		{name: "ini-bad-pattern", format: FormatIni, doc: "[web]\nweb[3:1]"},
+		{name: "yaml-invalid", format: FormatYaml, doc: "all: [1"},
+		{name: "json-invalid", format: FormatJson, doc: "{"},
+		{name: "json-missing-name", format: FormatJson, doc: `[{"address": "10.0.0.1"}]`},
+		{name: "csv-missing-header", format: FormatCsv, doc: ""},
+		{name: "csv-missing-name-column", format: FormatCsv, doc: "address\n10.0.0.1\n"},
+		{name: "csv-missing-name", format: FormatCsv, doc: "name,address\n,10.0.0.1\n"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parseInventory(tt.format, []byte(tt.doc))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExpandHostPattern(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		want    []string
+		wantErr bool
+	}{
+		{pattern: "web", want: []string{"web"}},
+		{pattern: "web[1:3]", want: []string{"web1", "web2", "web3"}},
+		{pattern: "web[08:10].example.com", want: []string{"web08.example.com", "web09.example.com", "web10.example.com"}},
+		{pattern: "db-[a:c]", want: []string{"db-a", "db-b", "db-c"}},
+		{pattern: "web[1:", wantErr: true},
+		{pattern: "web[1]", wantErr: true},
+		{pattern: "web[a:1]", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.pattern, func(t *testing.T) {
+			t.Parallel()
+			got, err := expandHostPattern(tt.pattern)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,426 @@
+// Package inventory provides a host plugin syncing hosts from a static
+// inventory: an Ansible INI or YAML inventory, a CSV document or a JSON
+// document. The inventory is set inline in the attributes of a host catalog
+// or fetched from an HTTP URL. Host sets select hosts by inventory group or
+// with a filter on their labels.
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/mitchellh/pointerstructure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The attribute and secret fields of host catalogs and host sets.
+const (
+	catalogFormatField    = "format"
+	catalogInventoryField = "inventory"
+	catalogUrlField       = "url"
+	secretHeadersField    = "headers"
+	setGroupsField        = "groups"
+	setFilterField        = "filter"
+)
+
+const (
+	// maxInventorySize is the maximum size of an inventory fetched from a
+	// URL.
+	maxInventorySize = 10 << 20
+	// fetchTimeout is the timeout of fetching an inventory from a URL.
+	fetchTimeout = 30 * time.Second
+)
+
+var _ plgpb.HostPluginServiceServer = (*Plugin)(nil)
+
+// Plugin is the inventory host plugin. It is stateless; the inventory is
+// read each time the hosts of sets are listed.
+type Plugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+
+	client *http.Client
+}
+
+// NewPlugin returns a new inventory host plugin.
+func NewPlugin() *Plugin {
+	c := cleanhttp.DefaultPooledClient()
+	c.Timeout = fetchTimeout
+	return &Plugin{client: c}
+}
+
+// catalogAttributes are the attributes and secrets of a host catalog.
+type catalogAttributes struct {
+	format    string
+	inventory string
+	url       string
+	headers   map[string]string
+}
+
+// setAttributes are the attributes of a host set.
+type setAttributes struct {
+	groups []string
+	filter *bexpr.Evaluator
+}
+
+// OnCreateCatalog validates the attributes and secrets of the catalog. The
+// secrets are persisted.
+func (p *Plugin) OnCreateCatalog(ctx context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	const op = "inventory.(Plugin).OnCreateCatalog"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	cat := req.GetCatalog()
+	if _, err := getCatalogAttributes(cat, cat.GetSecrets()); err != nil {
+		return nil, err
+	}
+	resp := &plgpb.OnCreateCatalogResponse{}
+	if secrets := cat.GetSecrets(); secrets != nil {
+		resp.Persisted = &plgpb.HostCatalogPersisted{Secrets: secrets}
+	}
+	return resp, nil
+}
+
+// OnUpdateCatalog validates the attributes and secrets of the catalog. The
+// secrets are persisted if they were updated.
+func (p *Plugin) OnUpdateCatalog(ctx context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	const op = "inventory.(Plugin).OnUpdateCatalog"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	cat := req.GetNewCatalog()
+	secrets := cat.GetSecrets()
+	if secrets == nil {
+		secrets = req.GetPersisted().GetSecrets()
+	}
+	if _, err := getCatalogAttributes(cat, secrets); err != nil {
+		return nil, err
+	}
+	resp := &plgpb.OnUpdateCatalogResponse{}
+	if s := cat.GetSecrets(); s != nil {
+		resp.Persisted = &plgpb.HostCatalogPersisted{Secrets: s}
+	}
+	return resp, nil
+}
+
+// OnDeleteCatalog is a no-op.
+func (p *Plugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+// OnCreateSet validates the attributes of the set.
+func (p *Plugin) OnCreateSet(ctx context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	const op = "inventory.(Plugin).OnCreateSet"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if _, err := getSetAttributes(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet validates the attributes of the set.
+func (p *Plugin) OnUpdateSet(ctx context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	const op = "inventory.(Plugin).OnUpdateSet"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if _, err := getSetAttributes(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op.
+func (p *Plugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts reads the inventory of the catalog and returns its hosts which
+// are selected by the sets.
+func (p *Plugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	const op = "inventory.(Plugin).ListHosts"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	catAttrs, err := getCatalogAttributes(req.GetCatalog(), req.GetPersisted().GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	sets := make([]*setAttributes, 0, len(req.GetSets()))
+	for _, s := range req.GetSets() {
+		a, err := getSetAttributes(s)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, a)
+	}
+
+	doc := []byte(catAttrs.inventory)
+	if catAttrs.url != "" {
+		if doc, err = p.fetch(ctx, catAttrs); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "unable to fetch inventory: %s", err)
+		}
+	}
+	hosts, err := parseInventory(catAttrs.format, doc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &plgpb.ListHostsResponse{}
+	for _, h := range hosts {
+		var setIds []string
+		for i, s := range sets {
+			ok, err := s.matches(h)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "unable to evaluate filter of host set %s: %s", req.GetSets()[i].GetId(), err)
+			}
+			if ok {
+				setIds = append(setIds, req.GetSets()[i].GetId())
+			}
+		}
+		if len(setIds) == 0 {
+			continue
+		}
+		ipAddresses, dnsNames := h.addresses()
+		resp.Hosts = append(resp.Hosts, &plgpb.ListHostsResponseHost{
+			ExternalId:  h.Name,
+			Name:        h.Name,
+			IpAddresses: ipAddresses,
+			DnsNames:    dnsNames,
+			SetIds:      setIds,
+		})
+	}
+	return resp, nil
+}
+
+// fetch returns the inventory at the url of a.
+func (p *Plugin) fetch(ctx context.Context, a *catalogAttributes) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range a.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	doc, err := ioutil.ReadAll(&limitedReader{r: resp.Body, n: maxInventorySize})
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// limitedReader returns an error once more than n bytes are read from r.
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(b []byte) (int, error) {
+	n, err := l.r.Read(b)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return n, fmt.Errorf("inventory is larger than %d bytes", maxInventorySize)
+	}
+	return n, err
+}
+
+// matches reports whether h is in one of the groups of s, if it has any, and
+// matches the filter of s, if it has one.
+func (s *setAttributes) matches(h *inventoryHost) (bool, error) {
+	if len(s.groups) > 0 {
+		var in bool
+		for _, g := range s.groups {
+			for _, hg := range h.Groups {
+				if g == hg {
+					in = true
+				}
+			}
+		}
+		if !in {
+			return false, nil
+		}
+	}
+	if s.filter == nil {
+		return true, nil
+	}
+	ok, err := s.filter.Evaluate(h.evalData())
+	if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+		return false, err
+	}
+	return ok, nil
+}
+
+func getCatalogAttributes(cat *hostcatalogs.HostCatalog, secrets *structpb.Struct) (*catalogAttributes, error) {
+	if cat == nil {
+		return nil, status.Error(codes.InvalidArgument, "catalog is nil")
+	}
+	a := &catalogAttributes{}
+	badFields := make(map[string]string)
+	for k, v := range cat.GetAttributes().AsMap() {
+		s, ok := v.(string)
+		if !ok {
+			badFields["attributes."+k] = "must be a string"
+			continue
+		}
+		switch k {
+		case catalogFormatField:
+			a.format = strings.ToLower(s)
+		case catalogInventoryField:
+			a.inventory = s
+		case catalogUrlField:
+			a.url = s
+		default:
+			badFields["attributes."+k] = "unrecognized field"
+		}
+	}
+	for k, v := range secrets.AsMap() {
+		switch k {
+		case secretHeadersField:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				badFields["secrets."+k] = "must be a map of header names to values"
+				continue
+			}
+			a.headers = make(map[string]string, len(m))
+			for hk, hv := range m {
+				s, ok := hv.(string)
+				if !ok {
+					badFields["secrets."+k] = "must be a map of header names to values"
+					continue
+				}
+				a.headers[hk] = s
+			}
+		default:
+			badFields["secrets."+k] = "unrecognized field"
+		}
+	}
+
+	switch {
+	case a.inventory == "" && a.url == "":
+		badFields["attributes"] = fmt.Sprintf("one of %s or %s must be set", catalogInventoryField, catalogUrlField)
+	case a.inventory != "" && a.url != "":
+		badFields["attributes"] = fmt.Sprintf("only one of %s or %s can be set", catalogInventoryField, catalogUrlField)
+	case a.url != "":
+		u, err := url.Parse(a.url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			badFields["attributes."+catalogUrlField] = "must be an http or https URL"
+			break
+		}
+		if a.format == "" {
+			a.format = formatFromExtension(u.Path)
+		}
+	}
+	switch a.format {
+	case FormatIni, FormatYaml, FormatCsv, FormatJson:
+		if a.inventory != "" {
+			if _, err := parseInventory(a.format, []byte(a.inventory)); err != nil {
+				badFields["attributes."+catalogInventoryField] = err.Error()
+			}
+		}
+	case "":
+		badFields["attributes."+catalogFormatField] = fmt.Sprintf("must be set to one of %s, %s, %s or %s", FormatIni, FormatYaml, FormatCsv, FormatJson)
+	default:
+		badFields["attributes."+catalogFormatField] = fmt.Sprintf("must be one of %s, %s, %s or %s", FormatIni, FormatYaml, FormatCsv, FormatJson)
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("invalid catalog", badFields)
+	}
+	return a, nil
+}
+
+func getSetAttributes(set *hostsets.HostSet) (*setAttributes, error) {
+	if set == nil {
+		return nil, status.Error(codes.InvalidArgument, "set is nil")
+	}
+	a := &setAttributes{}
+	badFields := make(map[string]string)
+	for k, v := range set.GetAttributes().AsMap() {
+		switch k {
+		case setGroupsField:
+			groups, ok := v.([]interface{})
+			if !ok {
+				badFields["attributes."+k] = "must be a list of group names"
+				continue
+			}
+			for _, g := range groups {
+				s, ok := g.(string)
+				if !ok || s == "" {
+					badFields["attributes."+k] = "must be a list of group names"
+					break
+				}
+				a.groups = append(a.groups, s)
+			}
+		case setFilterField:
+			s, ok := v.(string)
+			if !ok {
+				badFields["attributes."+k] = "must be a string"
+				continue
+			}
+			if s == "" {
+				continue
+			}
+			eval, err := bexpr.CreateEvaluator(s)
+			if err != nil {
+				badFields["attributes."+k] = fmt.Sprintf("invalid filter: %s", err)
+				continue
+			}
+			a.filter = eval
+		default:
+			badFields["attributes."+k] = "unrecognized field"
+		}
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("invalid host set", badFields)
+	}
+	return a, nil
+}
+
+// formatFromExtension returns the inventory format of the file extension of
+// p, or an empty string if it is unknown.
+func formatFromExtension(p string) string {
+	switch strings.ToLower(path.Ext(p)) {
+	case ".ini", ".cfg":
+		return FormatIni
+	case ".yaml", ".yml":
+		return FormatYaml
+	case ".csv":
+		return FormatCsv
+	case ".json":
+		return FormatJson
+	}
+	return ""
+}
+
+func invalidArgumentError(msg string, badFields map[string]string) error {
+	fields := make([]string, 0, len(badFields))
+	for k, v := range badFields {
+		fields = append(fields, fmt.Sprintf("%s: %s", k, v))
+	}
+	sort.Strings(fields)
+	return status.Errorf(codes.InvalidArgument, "%s: %s", msg, strings.Join(fields, ", "))
+}
@@ -0,0 +1,196 @@
+package inventory
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const testIniInventory = `
+[web]
+web01 ansible_host=10.0.0.1 env=prod
+web02 ansible_host=web02.example.com env=staging
+
+[db]
+db01 ansible_host=10.0.1.1 env=prod
+`
+
+func testStruct(t *testing.T, m map[string]interface{}) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+func TestPlugin_OnCreateCatalog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		secrets map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:  "inline",
+			attrs: map[string]interface{}{"format": "ini", "inventory": testIniInventory},
+		},
+		{
+			name:    "url",
+			attrs:   map[string]interface{}{"url": "https://example.com/inventory.yaml"},
+			secrets: map[string]interface{}{"headers": map[string]interface{}{"Authorization": "Bearer token"}},
+		},
+		{
+			name:    "missing-source",
+			attrs:   map[string]interface{}{"format": "ini"},
+			wantErr: true,
+		},
+		{
+			name:    "both-sources",
+			attrs:   map[string]interface{}{"format": "ini", "inventory": testIniInventory, "url": "https://example.com/inventory"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown-url-format",
+			attrs:   map[string]interface{}{"url": "https://example.com/inventory"},
+			wantErr: true,
+		},
+		{
+			name:    "bad-url",
+			attrs:   map[string]interface{}{"format": "json", "url": "file:///etc/hosts"},
+			wantErr: true,
+		},
+		{
+			name:    "bad-inventory",
+			attrs:   map[string]interface{}{"format": "json", "inventory": "{"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown-attribute",
+			attrs:   map[string]interface{}{"format": "ini", "inventory": testIniInventory, "foo": "bar"},
+			wantErr: true,
+		},
+		{
+			name:    "bad-headers",
+			attrs:   map[string]interface{}{"url": "https://example.com/inventory.csv"},
+			secrets: map[string]interface{}{"headers": "Authorization: Bearer token"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cat := &hostcatalogs.HostCatalog{Attributes: testStruct(t, tt.attrs)}
+			if tt.secrets != nil {
+				cat.Secrets = testStruct(t, tt.secrets)
+			}
+			resp, err := p.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: cat})
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.secrets != nil {
+				assert.Equal(t, cat.Secrets, resp.GetPersisted().GetSecrets())
+			} else {
+				assert.Nil(t, resp.GetPersisted())
+			}
+		})
+	}
+}
+
+func TestPlugin_OnCreateSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr bool
+	}{
+		{name: "empty", attrs: map[string]interface{}{}},
+		{name: "groups", attrs: map[string]interface{}{"groups": []interface{}{"web", "db"}}},
+		{name: "filter", attrs: map[string]interface{}{"filter": `"/labels/env" == "prod"`}},
+		{name: "bad-groups", attrs: map[string]interface{}{"groups": "web"}, wantErr: true},
+		{name: "bad-filter", attrs: map[string]interface{}{"filter": `"/labels/env" ==`}, wantErr: true},
+		{name: "unknown-attribute", attrs: map[string]interface{}{"foo": "bar"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := p.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Set: &hostsets.HostSet{Attributes: testStruct(t, tt.attrs)}})
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPlugin_ListHosts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(testIniInventory))
+	}))
+	defer srv.Close()
+
+	sets := []*hostsets.HostSet{
+		{Id: "web", Attributes: testStruct(t, map[string]interface{}{"groups": []interface{}{"web"}})},
+		{Id: "prod", Attributes: testStruct(t, map[string]interface{}{"filter": `"/labels/env" == "prod"`})},
+		{Id: "prod-web", Attributes: testStruct(t, map[string]interface{}{"groups": []interface{}{"web"}, "filter": `"/labels/env" == "prod"`})},
+		{Id: "missing-label", Attributes: testStruct(t, map[string]interface{}{"filter": `"/labels/missing" == "x"`})},
+	}
+	want := []*plgpb.ListHostsResponseHost{
+		{ExternalId: "db01", Name: "db01", IpAddresses: []string{"10.0.1.1"}, SetIds: []string{"prod"}},
+		{ExternalId: "web01", Name: "web01", IpAddresses: []string{"10.0.0.1"}, SetIds: []string{"web", "prod", "prod-web"}},
+		{ExternalId: "web02", Name: "web02", DnsNames: []string{"web02.example.com"}, SetIds: []string{"web"}},
+	}
+
+	t.Run("inline", func(t *testing.T) {
+		resp, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog: &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"format": "ini", "inventory": testIniInventory})},
+			Sets:    sets,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.GetHosts())
+	})
+	t.Run("url", func(t *testing.T) {
+		resp, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"format": "ini", "url": srv.URL})},
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]interface{}{"headers": map[string]interface{}{"Authorization": "Bearer token"}})},
+			Sets:      sets,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.GetHosts())
+	})
+	t.Run("url-unauthorized", func(t *testing.T) {
+		_, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog: &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"format": "ini", "url": srv.URL})},
+			Sets:    sets,
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err), "unexpected error %v", err)
+	})
+}
@@ -0,0 +1,343 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+const (
+	// requestTimeout is the timeout of requests to the API server.
+	requestTimeout = 30 * time.Second
+	// listPageSize is the number of objects requested per page when listing
+	// objects.
+	listPageSize = 500
+	// maxResponseSize is the maximum size of a response of the API server.
+	maxResponseSize = 32 << 20
+)
+
+// clusterConfig contains the API server and credentials used to connect to a
+// cluster.
+type clusterConfig struct {
+	server string
+	// caCert is the PEM encoded CA certificate of the API server. The system
+	// roots are used if it is empty.
+	caCert                []byte
+	insecureSkipTlsVerify bool
+	token                 string
+	// clientCert and clientKey are the PEM encoded client certificate and
+	// key.
+	clientCert []byte
+	clientKey  []byte
+	username   string
+	password   string
+	// namespace is the namespace of the kubeconfig context, if any.
+	namespace string
+}
+
+// kubeconfig is the subset of a kubeconfig file which is supported.
+type kubeconfig struct {
+	CurrentContext string `json:"current-context"`
+	Clusters       []struct {
+		Name    string `json:"name"`
+		Cluster struct {
+			Server                   string `json:"server"`
+			CertificateAuthority     string `json:"certificate-authority"`
+			CertificateAuthorityData string `json:"certificate-authority-data"`
+			InsecureSkipTlsVerify    bool   `json:"insecure-skip-tls-verify"`
+		} `json:"cluster"`
+	} `json:"clusters"`
+	Contexts []struct {
+		Name    string `json:"name"`
+		Context struct {
+			Cluster   string `json:"cluster"`
+			User      string `json:"user"`
+			Namespace string `json:"namespace"`
+		} `json:"context"`
+	} `json:"contexts"`
+	Users []struct {
+		Name string `json:"name"`
+		User struct {
+			Token                 string          `json:"token"`
+			TokenFile             string          `json:"tokenFile"`
+			ClientCertificate     string          `json:"client-certificate"`
+			ClientCertificateData string          `json:"client-certificate-data"`
+			ClientKey             string          `json:"client-key"`
+			ClientKeyData         string          `json:"client-key-data"`
+			Username              string          `json:"username"`
+			Password              string          `json:"password"`
+			Exec                  json.RawMessage `json:"exec"`
+			AuthProvider          json.RawMessage `json:"auth-provider"`
+		} `json:"user"`
+	} `json:"users"`
+}
+
+// parseKubeconfig returns the cluster config of the context contextName of
+// the kubeconfig doc, or of its current context if contextName is empty.
+// Credentials must be embedded in the kubeconfig: file references, exec
+// plugins and auth providers are not supported.
+func parseKubeconfig(doc []byte, contextName string) (*clusterConfig, error) {
+	var kc kubeconfig
+	if err := yaml.Unmarshal(doc, &kc); err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+	if contextName == "" {
+		contextName = kc.CurrentContext
+	}
+	if contextName == "" {
+		return nil, fmt.Errorf("kubeconfig has no current context")
+	}
+
+	cfg := &clusterConfig{}
+	var clusterName, userName string
+	var found bool
+	for _, c := range kc.Contexts {
+		if c.Name == contextName {
+			clusterName, userName, cfg.namespace = c.Context.Cluster, c.Context.User, c.Context.Namespace
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+
+	found = false
+	for _, c := range kc.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		found = true
+		if c.Cluster.CertificateAuthority != "" {
+			return nil, fmt.Errorf("cluster %q: certificate-authority files are not supported, use certificate-authority-data", clusterName)
+		}
+		cfg.server = c.Cluster.Server
+		cfg.insecureSkipTlsVerify = c.Cluster.InsecureSkipTlsVerify
+		if c.Cluster.CertificateAuthorityData != "" {
+			ca, err := base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData)
+			if err != nil {
+				return nil, fmt.Errorf("cluster %q: invalid certificate-authority-data: %w", clusterName, err)
+			}
+			cfg.caCert = ca
+		}
+		break
+	}
+	if !found {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig", clusterName)
+	}
+
+	for _, u := range kc.Users {
+		if u.Name != userName {
+			continue
+		}
+		switch {
+		case u.User.TokenFile != "", u.User.ClientCertificate != "", u.User.ClientKey != "":
+			return nil, fmt.Errorf("user %q: credential files are not supported, embed the credentials", userName)
+		case len(u.User.Exec) > 0 && string(u.User.Exec) != "null", len(u.User.AuthProvider) > 0 && string(u.User.AuthProvider) != "null":
+			return nil, fmt.Errorf("user %q: exec plugins and auth providers are not supported", userName)
+		}
+		cfg.token = u.User.Token
+		cfg.username, cfg.password = u.User.Username, u.User.Password
+		if u.User.ClientCertificateData != "" || u.User.ClientKeyData != "" {
+			var err error
+			if cfg.clientCert, err = base64.StdEncoding.DecodeString(u.User.ClientCertificateData); err != nil {
+				return nil, fmt.Errorf("user %q: invalid client-certificate-data: %w", userName, err)
+			}
+			if cfg.clientKey, err = base64.StdEncoding.DecodeString(u.User.ClientKeyData); err != nil {
+				return nil, fmt.Errorf("user %q: invalid client-key-data: %w", userName, err)
+			}
+		}
+		break
+	}
+	return cfg, nil
+}
+
+// client is a client of the Kubernetes API server which lists objects.
+type client struct {
+	server     *url.URL
+	httpClient *http.Client
+	token      string
+	username   string
+	password   string
+}
+
+func newClient(cfg *clusterConfig) (*client, error) {
+	u, err := url.Parse(cfg.server)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("invalid api server url %q", cfg.server)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.insecureSkipTlsVerify,
+	}
+	if len(cfg.caCert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.caCert) {
+			return nil, fmt.Errorf("invalid ca certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(cfg.clientCert) > 0 || len(cfg.clientKey) > 0 {
+		cert, err := tls.X509KeyPair(cfg.clientCert, cfg.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	httpClient := cleanhttp.DefaultClient()
+	httpClient.Timeout = requestTimeout
+	httpClient.Transport.(*http.Transport).TLSClientConfig = tlsConfig
+	return &client{
+		server:     u,
+		httpClient: httpClient,
+		token:      cfg.token,
+		username:   cfg.username,
+		password:   cfg.password,
+	}, nil
+}
+
+// objectMeta is the metadata of Kubernetes objects.
+type objectMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Uid       string            `json:"uid"`
+	Labels    map[string]string `json:"labels"`
+}
+
+type node struct {
+	Metadata objectMeta `json:"metadata"`
+	Status   struct {
+		Addresses []struct {
+			Type    string `json:"type"`
+			Address string `json:"address"`
+		} `json:"addresses"`
+	} `json:"status"`
+}
+
+type service struct {
+	Metadata objectMeta `json:"metadata"`
+	Spec     struct {
+		Type         string   `json:"type"`
+		ClusterIP    string   `json:"clusterIP"`
+		ClusterIPs   []string `json:"clusterIPs"`
+		ExternalIPs  []string `json:"externalIPs"`
+		ExternalName string   `json:"externalName"`
+	} `json:"spec"`
+	Status struct {
+		LoadBalancer struct {
+			Ingress []struct {
+				Ip       string `json:"ip"`
+				Hostname string `json:"hostname"`
+			} `json:"ingress"`
+		} `json:"loadBalancer"`
+	} `json:"status"`
+}
+
+type pod struct {
+	Metadata objectMeta `json:"metadata"`
+	Status   struct {
+		Phase  string `json:"phase"`
+		PodIP  string `json:"podIP"`
+		PodIPs []struct {
+			Ip string `json:"ip"`
+		} `json:"podIPs"`
+	} `json:"status"`
+}
+
+// list lists the objects of the core resource in namespace, or in all
+// namespaces if namespace is empty, matching labelSelector. The items of all
+// pages are unmarshaled into items, which must be a pointer to a slice.
+func (c *client) list(ctx context.Context, resource, namespace, labelSelector string, items interface{}) error {
+	p := path.Join("/api/v1", resource)
+	if namespace != "" {
+		p = path.Join("/api/v1/namespaces", namespace, resource)
+	}
+
+	var all []json.RawMessage
+	var cont string
+	for {
+		q := url.Values{}
+		q.Set("limit", fmt.Sprint(listPageSize))
+		if labelSelector != "" {
+			q.Set("labelSelector", labelSelector)
+		}
+		if cont != "" {
+			q.Set("continue", cont)
+		}
+		u := *c.server
+		u.Path = strings.TrimSuffix(u.Path, "/") + p
+		u.RawQuery = q.Encode()
+
+		var page struct {
+			Metadata struct {
+				Continue string `json:"continue"`
+			} `json:"metadata"`
+			Items []json.RawMessage `json:"items"`
+		}
+		if err := c.get(ctx, u.String(), &page); err != nil {
+			return fmt.Errorf("unable to list %s: %w", resource, err)
+		}
+		all = append(all, page.Items...)
+		if page.Metadata.Continue == "" {
+			break
+		}
+		cont = page.Metadata.Continue
+	}
+
+	b, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, items)
+}
+
+func (c *client) get(ctx context.Context, u string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseSize+1))
+	if err != nil {
+		return err
+	}
+	if len(body) > maxResponseSize {
+		return fmt.Errorf("response is larger than %d bytes", maxResponseSize)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var st struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &st) == nil && st.Message != "" {
+			return fmt.Errorf("%s: %s", resp.Status, st.Message)
+		}
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,141 @@
+package kubernetes
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKubeconfig(t *testing.T) {
+	t.Parallel()
+	ca := base64.StdEncoding.EncodeToString([]byte("ca"))
+	doc := `
+apiVersion: v1
+kind: Config
+current-context: dev
+clusters:
+- name: dev-cluster
+  cluster:
+    server: https://dev.example.com:6443
+    certificate-authority-data: ` + ca + `
+- name: prod-cluster
+  cluster:
+    server: https://prod.example.com:6443
+    insecure-skip-tls-verify: true
+contexts:
+- name: dev
+  context:
+    cluster: dev-cluster
+    user: dev-user
+    namespace: apps
+- name: prod
+  context:
+    cluster: prod-cluster
+    user: prod-user
+users:
+- name: dev-user
+  user:
+    token: dev-token
+- name: prod-user
+  user:
+    username: admin
+    password: secret
+`
+
+	t.Run("current-context", func(t *testing.T) {
+		got, err := parseKubeconfig([]byte(doc), "")
+		require.NoError(t, err)
+		assert.Equal(t, &clusterConfig{
+			server:    "https://dev.example.com:6443",
+			caCert:    []byte("ca"),
+			token:     "dev-token",
+			namespace: "apps",
+		}, got)
+	})
+	t.Run("context", func(t *testing.T) {
+		got, err := parseKubeconfig([]byte(doc), "prod")
+		require.NoError(t, err)
+		assert.Equal(t, &clusterConfig{
+			server:                "https://prod.example.com:6443",
+			insecureSkipTlsVerify: true,
+			username:              "admin",
+			password:              "secret",
+		}, got)
+	})
+}
+
+func TestParseKubeconfig_Errors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doc     string
+		context string
+	}{
+		{name: "invalid", doc: "clusters: [1"},
+		{name: "no-current-context", doc: "clusters: []"},
+		{name: "missing-context", doc: "current-context: dev", context: "prod"},
+		{
+			name: "missing-cluster",
+			doc: `
+current-context: dev
+contexts:
+- name: dev
+  context: {cluster: dev, user: dev}
+`,
+		},
+		{
+			name: "ca-file",
+			doc: `
+current-context: dev
+clusters:
+- name: dev
+  cluster: {server: "https://dev.example.com", certificate-authority: /etc/ca.pem}
+contexts:
+- name: dev
+  context: {cluster: dev, user: dev}
+`,
+		},
+		{
+			name: "token-file",
+			doc: `
+current-context: dev
+clusters:
+- name: dev
+  cluster: {server: "https://dev.example.com"}
+contexts:
+- name: dev
+  context: {cluster: dev, user: dev}
+users:
+- name: dev
+  user: {tokenFile: /var/run/token}
+`,
+		},
+		{
+			name: "exec",
+			doc: `
+current-context: dev
+clusters:
+- name: dev
+  cluster: {server: "https://dev.example.com"}
+contexts:
+- name: dev
+  context: {cluster: dev, user: dev}
+users:
+- name: dev
+  user:
+    exec:
+      command: aws
+`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parseKubeconfig([]byte(tt.doc), tt.context)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,437 @@
+// Package kubernetes provides a host plugin syncing the Nodes, Services or
+// Pods of a Kubernetes cluster matching label selectors. The plugin connects
+// to the API server with a kubeconfig or service account credentials stored
+// in the secrets of a host catalog.
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The attribute and secret fields of host catalogs and host sets.
+const (
+	catalogApiServerUrlField = "api_server_url"
+	catalogContextField      = "context"
+	catalogNamespaceField    = "namespace"
+	secretKubeconfigField    = "kubeconfig"
+	secretTokenField         = "token"
+	secretCaCertField        = "ca_cert"
+	secretClientCertField    = "client_cert"
+	secretClientKeyField     = "client_key"
+	setResourceField         = "resource"
+	setLabelSelectorField    = "label_selector"
+	setNamespaceField        = "namespace"
+)
+
+// The resources host sets can select.
+const (
+	ResourceNodes    = "nodes"
+	ResourceServices = "services"
+	ResourcePods     = "pods"
+)
+
+// The node address types which are ip addresses and dns names.
+var (
+	nodeIpAddressTypes = map[string]bool{"InternalIP": true, "ExternalIP": true}
+	nodeDnsNameTypes   = map[string]bool{"Hostname": true, "InternalDNS": true, "ExternalDNS": true}
+)
+
+var _ plgpb.HostPluginServiceServer = (*Plugin)(nil)
+
+// Plugin is the Kubernetes host plugin. It is stateless; the API server is
+// queried each time the hosts of sets are listed.
+type Plugin struct {
+	plgpb.UnimplementedHostPluginServiceServer
+}
+
+// NewPlugin returns a new Kubernetes host plugin.
+func NewPlugin() *Plugin {
+	return &Plugin{}
+}
+
+// setAttributes are the attributes of a host set.
+type setAttributes struct {
+	resource      string
+	labelSelector string
+	namespace     string
+}
+
+// OnCreateCatalog validates the attributes and secrets of the catalog. The
+// secrets are persisted.
+func (p *Plugin) OnCreateCatalog(ctx context.Context, req *plgpb.OnCreateCatalogRequest) (*plgpb.OnCreateCatalogResponse, error) {
+	const op = "kubernetes.(Plugin).OnCreateCatalog"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	cat := req.GetCatalog()
+	if _, err := getClusterConfig(cat, cat.GetSecrets()); err != nil {
+		return nil, err
+	}
+	resp := &plgpb.OnCreateCatalogResponse{}
+	if secrets := cat.GetSecrets(); secrets != nil {
+		resp.Persisted = &plgpb.HostCatalogPersisted{Secrets: secrets}
+	}
+	return resp, nil
+}
+
+// OnUpdateCatalog validates the attributes and secrets of the catalog. The
+// secrets are persisted if they were updated.
+func (p *Plugin) OnUpdateCatalog(ctx context.Context, req *plgpb.OnUpdateCatalogRequest) (*plgpb.OnUpdateCatalogResponse, error) {
+	const op = "kubernetes.(Plugin).OnUpdateCatalog"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	cat := req.GetNewCatalog()
+	secrets := cat.GetSecrets()
+	if secrets == nil {
+		secrets = req.GetPersisted().GetSecrets()
+	}
+	if _, err := getClusterConfig(cat, secrets); err != nil {
+		return nil, err
+	}
+	resp := &plgpb.OnUpdateCatalogResponse{}
+	if s := cat.GetSecrets(); s != nil {
+		resp.Persisted = &plgpb.HostCatalogPersisted{Secrets: s}
+	}
+	return resp, nil
+}
+
+// OnDeleteCatalog is a no-op.
+func (p *Plugin) OnDeleteCatalog(context.Context, *plgpb.OnDeleteCatalogRequest) (*plgpb.OnDeleteCatalogResponse, error) {
+	return &plgpb.OnDeleteCatalogResponse{}, nil
+}
+
+// OnCreateSet validates the attributes of the set.
+func (p *Plugin) OnCreateSet(ctx context.Context, req *plgpb.OnCreateSetRequest) (*plgpb.OnCreateSetResponse, error) {
+	const op = "kubernetes.(Plugin).OnCreateSet"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if _, err := getSetAttributes(req.GetSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnCreateSetResponse{}, nil
+}
+
+// OnUpdateSet validates the attributes of the set.
+func (p *Plugin) OnUpdateSet(ctx context.Context, req *plgpb.OnUpdateSetRequest) (*plgpb.OnUpdateSetResponse, error) {
+	const op = "kubernetes.(Plugin).OnUpdateSet"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	if _, err := getSetAttributes(req.GetNewSet()); err != nil {
+		return nil, err
+	}
+	return &plgpb.OnUpdateSetResponse{}, nil
+}
+
+// OnDeleteSet is a no-op.
+func (p *Plugin) OnDeleteSet(context.Context, *plgpb.OnDeleteSetRequest) (*plgpb.OnDeleteSetResponse, error) {
+	return &plgpb.OnDeleteSetResponse{}, nil
+}
+
+// ListHosts lists the objects selected by the sets and returns them as hosts.
+// Objects selected by several sets are listed once, with the ids of all the
+// sets.
+func (p *Plugin) ListHosts(ctx context.Context, req *plgpb.ListHostsRequest) (*plgpb.ListHostsResponse, error) {
+	const op = "kubernetes.(Plugin).ListHosts"
+	if req == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "req is nil")
+	}
+	cfg, err := getClusterConfig(req.GetCatalog(), req.GetPersisted().GetSecrets())
+	if err != nil {
+		return nil, err
+	}
+	c, err := newClient(cfg)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	type query struct {
+		resource, namespace, labelSelector string
+	}
+	results := make(map[query][]*plgpb.ListHostsResponseHost)
+	hosts := make(map[string]*plgpb.ListHostsResponseHost)
+	for _, set := range req.GetSets() {
+		a, err := getSetAttributes(set)
+		if err != nil {
+			return nil, err
+		}
+		q := query{resource: a.resource, labelSelector: a.labelSelector}
+		if a.resource != ResourceNodes {
+			q.namespace = a.namespace
+			if q.namespace == "" {
+				q.namespace = cfg.namespace
+			}
+		}
+		found, ok := results[q]
+		if !ok {
+			if found, err = listHosts(ctx, c, q.resource, q.namespace, q.labelSelector); err != nil {
+				return nil, status.Errorf(codes.Unavailable, "unable to list hosts of set %s: %s", set.GetId(), err)
+			}
+			results[q] = found
+		}
+		for _, h := range found {
+			if _, ok := hosts[h.ExternalId]; !ok {
+				hosts[h.ExternalId] = h
+			}
+			hosts[h.ExternalId].SetIds = append(hosts[h.ExternalId].SetIds, set.GetId())
+		}
+	}
+
+	resp := &plgpb.ListHostsResponse{}
+	for _, h := range hosts {
+		resp.Hosts = append(resp.Hosts, h)
+	}
+	sort.Slice(resp.Hosts, func(i, j int) bool {
+		return resp.Hosts[i].ExternalId < resp.Hosts[j].ExternalId
+	})
+	return resp, nil
+}
+
+// listHosts lists the objects of resource in namespace matching
+// labelSelector and returns them as hosts without set ids. The external id
+// of a host is the uid of its object.
+func listHosts(ctx context.Context, c *client, resource, namespace, labelSelector string) ([]*plgpb.ListHostsResponseHost, error) {
+	var ret []*plgpb.ListHostsResponseHost
+	switch resource {
+	case ResourceNodes:
+		var nodes []*node
+		if err := c.list(ctx, resource, "", labelSelector, &nodes); err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			h := &plgpb.ListHostsResponseHost{
+				ExternalId:  n.Metadata.Uid,
+				Name:        n.Metadata.Name,
+				Description: fmt.Sprintf("Kubernetes node %s", n.Metadata.Name),
+			}
+			for _, a := range n.Status.Addresses {
+				switch {
+				case nodeIpAddressTypes[a.Type]:
+					h.IpAddresses = appendUnique(h.IpAddresses, a.Address)
+				case nodeDnsNameTypes[a.Type]:
+					h.DnsNames = appendUnique(h.DnsNames, a.Address)
+				}
+			}
+			ret = append(ret, h)
+		}
+
+	case ResourceServices:
+		var services []*service
+		if err := c.list(ctx, resource, namespace, labelSelector, &services); err != nil {
+			return nil, err
+		}
+		for _, s := range services {
+			h := &plgpb.ListHostsResponseHost{
+				ExternalId:  s.Metadata.Uid,
+				Name:        s.Metadata.Name,
+				Description: fmt.Sprintf("Kubernetes service %s/%s", s.Metadata.Namespace, s.Metadata.Name),
+			}
+			if s.Spec.Type == "ExternalName" {
+				h.DnsNames = appendUnique(h.DnsNames, s.Spec.ExternalName)
+				ret = append(ret, h)
+				continue
+			}
+			ips := append([]string{s.Spec.ClusterIP}, s.Spec.ClusterIPs...)
+			ips = append(ips, s.Spec.ExternalIPs...)
+			for _, i := range s.Status.LoadBalancer.Ingress {
+				ips = append(ips, i.Ip)
+				if i.Hostname != "" {
+					h.DnsNames = appendUnique(h.DnsNames, i.Hostname)
+				}
+			}
+			for _, ip := range ips {
+				if net.ParseIP(ip) != nil {
+					h.IpAddresses = appendUnique(h.IpAddresses, ip)
+				}
+			}
+			h.DnsNames = appendUnique(h.DnsNames, fmt.Sprintf("%s.%s.svc", s.Metadata.Name, s.Metadata.Namespace))
+			ret = append(ret, h)
+		}
+
+	case ResourcePods:
+		var pods []*pod
+		if err := c.list(ctx, resource, namespace, labelSelector, &pods); err != nil {
+			return nil, err
+		}
+		for _, p := range pods {
+			if p.Status.Phase != "Running" {
+				continue
+			}
+			h := &plgpb.ListHostsResponseHost{
+				ExternalId:  p.Metadata.Uid,
+				Name:        p.Metadata.Name,
+				Description: fmt.Sprintf("Kubernetes pod %s/%s", p.Metadata.Namespace, p.Metadata.Name),
+			}
+			if p.Status.PodIP != "" {
+				h.IpAddresses = appendUnique(h.IpAddresses, p.Status.PodIP)
+			}
+			for _, i := range p.Status.PodIPs {
+				h.IpAddresses = appendUnique(h.IpAddresses, i.Ip)
+			}
+			if len(h.IpAddresses) == 0 {
+				continue
+			}
+			ret = append(ret, h)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown resource %q", resource)
+	}
+	return ret, nil
+}
+
+func appendUnique(s []string, v string) []string {
+	if v == "" {
+		return s
+	}
+	for _, e := range s {
+		if e == v {
+			return s
+		}
+	}
+	return append(s, v)
+}
+
+// getClusterConfig returns the cluster config of the attributes and secrets
+// of cat. The namespace of the catalog overrides the namespace of the
+// kubeconfig context.
+func getClusterConfig(cat *hostcatalogs.HostCatalog, secrets *structpb.Struct) (*clusterConfig, error) {
+	if cat == nil {
+		return nil, status.Error(codes.InvalidArgument, "catalog is nil")
+	}
+	badFields := make(map[string]string)
+	attrs := make(map[string]string)
+	for k, v := range cat.GetAttributes().AsMap() {
+		switch k {
+		case catalogApiServerUrlField, catalogContextField, catalogNamespaceField:
+			s, ok := v.(string)
+			if !ok {
+				badFields["attributes."+k] = "must be a string"
+				continue
+			}
+			attrs[k] = s
+		default:
+			badFields["attributes."+k] = "unrecognized field"
+		}
+	}
+	sec := make(map[string]string)
+	for k, v := range secrets.AsMap() {
+		switch k {
+		case secretKubeconfigField, secretTokenField, secretCaCertField, secretClientCertField, secretClientKeyField:
+			s, ok := v.(string)
+			if !ok {
+				badFields["secrets."+k] = "must be a string"
+				continue
+			}
+			sec[k] = s
+		default:
+			badFields["secrets."+k] = "unrecognized field"
+		}
+	}
+
+	cfg := &clusterConfig{}
+	switch {
+	case sec[secretKubeconfigField] != "":
+		for _, f := range []string{secretTokenField, secretCaCertField, secretClientCertField, secretClientKeyField} {
+			if sec[f] != "" {
+				badFields["secrets."+f] = fmt.Sprintf("cannot be set with %s", secretKubeconfigField)
+			}
+		}
+		kc, err := parseKubeconfig([]byte(sec[secretKubeconfigField]), attrs[catalogContextField])
+		if err != nil {
+			badFields["secrets."+secretKubeconfigField] = err.Error()
+			break
+		}
+		cfg = kc
+	case sec[secretTokenField] != "" || sec[secretClientCertField] != "":
+		if attrs[catalogContextField] != "" {
+			badFields["attributes."+catalogContextField] = fmt.Sprintf("can only be set with %s", secretKubeconfigField)
+		}
+		if attrs[catalogApiServerUrlField] == "" {
+			badFields["attributes."+catalogApiServerUrlField] = "must be set when not using a kubeconfig"
+		}
+		cfg.token = sec[secretTokenField]
+		cfg.caCert = []byte(sec[secretCaCertField])
+		cfg.clientCert = []byte(sec[secretClientCertField])
+		cfg.clientKey = []byte(sec[secretClientKeyField])
+	default:
+		badFields["secrets"] = fmt.Sprintf("one of %s, %s or %s must be set", secretKubeconfigField, secretTokenField, secretClientCertField)
+	}
+	if u := attrs[catalogApiServerUrlField]; u != "" {
+		cfg.server = u
+	}
+	if ns := attrs[catalogNamespaceField]; ns != "" {
+		cfg.namespace = ns
+	}
+	if len(badFields) == 0 {
+		// newClient validates the server url and the certificates
+		if _, err := newClient(cfg); err != nil {
+			badFields["attributes"] = err.Error()
+		}
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("invalid catalog", badFields)
+	}
+	return cfg, nil
+}
+
+func getSetAttributes(set *hostsets.HostSet) (*setAttributes, error) {
+	if set == nil {
+		return nil, status.Error(codes.InvalidArgument, "set is nil")
+	}
+	a := &setAttributes{}
+	badFields := make(map[string]string)
+	for k, v := range set.GetAttributes().AsMap() {
+		s, ok := v.(string)
+		if !ok {
+			badFields["attributes."+k] = "must be a string"
+			continue
+		}
+		switch k {
+		case setResourceField:
+			a.resource = strings.ToLower(s)
+		case setLabelSelectorField:
+			a.labelSelector = s
+		case setNamespaceField:
+			a.namespace = s
+		default:
+			badFields["attributes."+k] = "unrecognized field"
+		}
+	}
+	switch a.resource {
+	case ResourceNodes:
+		if a.namespace != "" {
+			badFields["attributes."+setNamespaceField] = "cannot be set for nodes"
+		}
+	case ResourceServices, ResourcePods:
+	default:
+		badFields["attributes."+setResourceField] = fmt.Sprintf("must be one of %s, %s or %s", ResourceNodes, ResourceServices, ResourcePods)
+	}
+	if len(badFields) > 0 {
+		return nil, invalidArgumentError("invalid host set", badFields)
+	}
+	return a, nil
+}
+
+func invalidArgumentError(msg string, badFields map[string]string) error {
+	fields := make([]string, 0, len(badFields))
+	for k, v := range badFields {
+		fields = append(fields, fmt.Sprintf("%s: %s", k, v))
+	}
+	sort.Strings(fields)
+	return status.Errorf(codes.InvalidArgument, "%s: %s", msg, strings.Join(fields, ", "))
+}
@@ -0,0 +1,402 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostcatalogs"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testStruct(t *testing.T, m map[string]interface{}) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	require.NoError(t, err)
+	return s
+}
+
+// testApiServer is a fake Kubernetes API server serving the objects of
+// responses by request path. Lists are served one object per page.
+type testApiServer struct {
+	*httptest.Server
+	caCert string
+
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func newTestApiServer(t *testing.T, token string, responses map[string][]interface{}) *testApiServer {
+	t.Helper()
+	s := &testApiServer{}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		s.mu.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"kind":"Status","message":"Unauthorized"}`))
+			return
+		}
+		items, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var page int
+		if c := r.URL.Query().Get("continue"); c != "" {
+			_, _ = fmt.Sscan(c, &page)
+		}
+		resp := map[string]interface{}{"metadata": map[string]interface{}{}, "items": []interface{}{}}
+		if page < len(items) {
+			resp["items"] = []interface{}{items[page]}
+		}
+		if page+1 < len(items) {
+			resp["metadata"] = map[string]interface{}{"continue": fmt.Sprint(page + 1)}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+	s.caCert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: s.Certificate().Raw}))
+	return s
+}
+
+func (s *testApiServer) kubeconfig(token string) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test
+  cluster:
+    server: %s
+    certificate-authority-data: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+    namespace: default
+users:
+- name: test
+  user:
+    token: %s
+`, s.URL, base64.StdEncoding.EncodeToString([]byte(s.caCert)), token)
+}
+
+func TestPlugin_OnCreateCatalog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newTestApiServer(t, "token", nil)
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		secrets map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "kubeconfig",
+			secrets: map[string]interface{}{"kubeconfig": srv.kubeconfig("token")},
+		},
+		{
+			name:    "kubeconfig-context",
+			attrs:   map[string]interface{}{"context": "test", "namespace": "apps"},
+			secrets: map[string]interface{}{"kubeconfig": srv.kubeconfig("token")},
+		},
+		{
+			name:    "token",
+			attrs:   map[string]interface{}{"api_server_url": srv.URL},
+			secrets: map[string]interface{}{"token": "token", "ca_cert": srv.caCert},
+		},
+		{
+			name:    "missing-secrets",
+			attrs:   map[string]interface{}{"api_server_url": srv.URL},
+			wantErr: true,
+		},
+		{
+			name:    "token-missing-url",
+			secrets: map[string]interface{}{"token": "token"},
+			wantErr: true,
+		},
+		{
+			name:    "token-with-context",
+			attrs:   map[string]interface{}{"api_server_url": srv.URL, "context": "test"},
+			secrets: map[string]interface{}{"token": "token"},
+			wantErr: true,
+		},
+		{
+			name:    "kubeconfig-with-token",
+			secrets: map[string]interface{}{"kubeconfig": srv.kubeconfig("token"), "token": "token"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown-context",
+			attrs:   map[string]interface{}{"context": "prod"},
+			secrets: map[string]interface{}{"kubeconfig": srv.kubeconfig("token")},
+			wantErr: true,
+		},
+		{
+			name:    "bad-ca-cert",
+			attrs:   map[string]interface{}{"api_server_url": srv.URL},
+			secrets: map[string]interface{}{"token": "token", "ca_cert": "not a cert"},
+			wantErr: true,
+		},
+		{
+			name:    "bad-url",
+			attrs:   map[string]interface{}{"api_server_url": "ftp://example.com"},
+			secrets: map[string]interface{}{"token": "token"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown-attribute",
+			attrs:   map[string]interface{}{"foo": "bar"},
+			secrets: map[string]interface{}{"kubeconfig": srv.kubeconfig("token")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cat := &hostcatalogs.HostCatalog{Attributes: testStruct(t, tt.attrs)}
+			if tt.secrets != nil {
+				cat.Secrets = testStruct(t, tt.secrets)
+			}
+			resp, err := p.OnCreateCatalog(ctx, &plgpb.OnCreateCatalogRequest{Catalog: cat})
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cat.Secrets, resp.GetPersisted().GetSecrets())
+		})
+	}
+}
+
+func TestPlugin_OnUpdateCatalog(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+	srv := newTestApiServer(t, "token", nil)
+
+	persisted := &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]interface{}{"kubeconfig": srv.kubeconfig("token")})}
+	resp, err := p.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{
+		NewCatalog: &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"namespace": "apps"})},
+		Persisted:  persisted,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, resp.GetPersisted())
+
+	_, err = p.OnUpdateCatalog(ctx, &plgpb.OnUpdateCatalogRequest{
+		NewCatalog: &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"context": "prod"})},
+		Persisted:  persisted,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+}
+
+func TestPlugin_OnCreateSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+
+	tests := []struct {
+		name    string
+		attrs   map[string]interface{}
+		wantErr bool
+	}{
+		{name: "nodes", attrs: map[string]interface{}{"resource": "nodes", "label_selector": "role=worker"}},
+		{name: "services", attrs: map[string]interface{}{"resource": "services", "namespace": "apps"}},
+		{name: "pods", attrs: map[string]interface{}{"resource": "Pods", "label_selector": "app in (web, api)"}},
+		{name: "missing-resource", attrs: map[string]interface{}{"label_selector": "app=web"}, wantErr: true},
+		{name: "unknown-resource", attrs: map[string]interface{}{"resource": "deployments"}, wantErr: true},
+		{name: "nodes-namespace", attrs: map[string]interface{}{"resource": "nodes", "namespace": "apps"}, wantErr: true},
+		{name: "bad-label-selector", attrs: map[string]interface{}{"resource": "pods", "label_selector": 1}, wantErr: true},
+		{name: "unknown-attribute", attrs: map[string]interface{}{"resource": "pods", "foo": "bar"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := p.OnCreateSet(ctx, &plgpb.OnCreateSetRequest{Set: &hostsets.HostSet{Attributes: testStruct(t, tt.attrs)}})
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPlugin_ListHosts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	p := NewPlugin()
+
+	srv := newTestApiServer(t, "token", map[string][]interface{}{
+		"/api/v1/nodes": {
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "node-1", "uid": "uid-node-1"},
+				"status": map[string]interface{}{"addresses": []interface{}{
+					map[string]interface{}{"type": "InternalIP", "address": "10.0.0.1"},
+					map[string]interface{}{"type": "ExternalIP", "address": "203.0.113.1"},
+					map[string]interface{}{"type": "Hostname", "address": "node-1"},
+				}},
+			},
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "node-2", "uid": "uid-node-2"},
+				"status": map[string]interface{}{"addresses": []interface{}{
+					map[string]interface{}{"type": "InternalIP", "address": "10.0.0.2"},
+					map[string]interface{}{"type": "InternalDNS", "address": "node-2.internal"},
+				}},
+			},
+		},
+		"/api/v1/namespaces/default/services": {
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web", "namespace": "default", "uid": "uid-svc-web"},
+				"spec":     map[string]interface{}{"type": "LoadBalancer", "clusterIP": "10.96.0.10", "clusterIPs": []interface{}{"10.96.0.10"}},
+				"status": map[string]interface{}{"loadBalancer": map[string]interface{}{"ingress": []interface{}{
+					map[string]interface{}{"ip": "198.51.100.1"},
+					map[string]interface{}{"hostname": "web.lb.example.com"},
+				}}},
+			},
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "headless", "namespace": "default", "uid": "uid-svc-headless"},
+				"spec":     map[string]interface{}{"type": "ClusterIP", "clusterIP": "None"},
+			},
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "db", "namespace": "default", "uid": "uid-svc-db"},
+				"spec":     map[string]interface{}{"type": "ExternalName", "externalName": "db.example.com"},
+			},
+		},
+		"/api/v1/namespaces/apps/pods": {
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web-1", "namespace": "apps", "uid": "uid-pod-web-1"},
+				"status":   map[string]interface{}{"phase": "Running", "podIP": "10.244.0.5", "podIPs": []interface{}{map[string]interface{}{"ip": "10.244.0.5"}, map[string]interface{}{"ip": "fd00::5"}}},
+			},
+			map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web-2", "namespace": "apps", "uid": "uid-pod-web-2"},
+				"status":   map[string]interface{}{"phase": "Pending"},
+			},
+		},
+	})
+
+	sets := []*hostsets.HostSet{
+		{Id: "nodes", Attributes: testStruct(t, map[string]interface{}{"resource": "nodes", "label_selector": "role=worker"})},
+		{Id: "services", Attributes: testStruct(t, map[string]interface{}{"resource": "services"})},
+		{Id: "pods", Attributes: testStruct(t, map[string]interface{}{"resource": "pods", "namespace": "apps", "label_selector": "app=web"})},
+		{Id: "workers", Attributes: testStruct(t, map[string]interface{}{"resource": "nodes", "label_selector": "role=worker"})},
+	}
+	want := []*plgpb.ListHostsResponseHost{
+		{
+			ExternalId:  "uid-node-1",
+			Name:        "node-1",
+			Description: "Kubernetes node node-1",
+			IpAddresses: []string{"10.0.0.1", "203.0.113.1"},
+			DnsNames:    []string{"node-1"},
+			SetIds:      []string{"nodes", "workers"},
+		},
+		{
+			ExternalId:  "uid-node-2",
+			Name:        "node-2",
+			Description: "Kubernetes node node-2",
+			IpAddresses: []string{"10.0.0.2"},
+			DnsNames:    []string{"node-2.internal"},
+			SetIds:      []string{"nodes", "workers"},
+		},
+		{
+			ExternalId:  "uid-pod-web-1",
+			Name:        "web-1",
+			Description: "Kubernetes pod apps/web-1",
+			IpAddresses: []string{"10.244.0.5", "fd00::5"},
+			SetIds:      []string{"pods"},
+		},
+		{
+			ExternalId:  "uid-svc-db",
+			Name:        "db",
+			Description: "Kubernetes service default/db",
+			DnsNames:    []string{"db.example.com"},
+			SetIds:      []string{"services"},
+		},
+		{
+			ExternalId:  "uid-svc-headless",
+			Name:        "headless",
+			Description: "Kubernetes service default/headless",
+			DnsNames:    []string{"headless.default.svc"},
+			SetIds:      []string{"services"},
+		},
+		{
+			ExternalId:  "uid-svc-web",
+			Name:        "web",
+			Description: "Kubernetes service default/web",
+			IpAddresses: []string{"10.96.0.10", "198.51.100.1"},
+			DnsNames:    []string{"web.lb.example.com", "web.default.svc"},
+			SetIds:      []string{"services"},
+		},
+	}
+
+	t.Run("kubeconfig", func(t *testing.T) {
+		resp, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{})},
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]interface{}{"kubeconfig": srv.kubeconfig("token")})},
+			Sets:      sets,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want, resp.GetHosts())
+
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		var selectors []string
+		for _, r := range srv.requests {
+			if r.URL.Path == "/api/v1/nodes" {
+				selectors = append(selectors, r.URL.Query().Get("labelSelector"))
+			}
+		}
+		// Both node sets share the same query, which is paginated over two
+		// pages.
+		assert.Equal(t, []string{"role=worker", "role=worker"}, selectors)
+	})
+	t.Run("token", func(t *testing.T) {
+		resp, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog: &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"api_server_url": srv.URL, "namespace": "default"})},
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]interface{}{
+				"token":   "token",
+				"ca_cert": srv.caCert,
+			})},
+			Sets: sets[1:2],
+		})
+		require.NoError(t, err)
+		assert.Equal(t, want[3:], resp.GetHosts())
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		_, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{})},
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]interface{}{"kubeconfig": srv.kubeconfig("wrong")})},
+			Sets:      sets,
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err), "unexpected error %v", err)
+		assert.Contains(t, err.Error(), "Unauthorized")
+	})
+	t.Run("untrusted-ca", func(t *testing.T) {
+		_, err := p.ListHosts(ctx, &plgpb.ListHostsRequest{
+			Catalog:   &hostcatalogs.HostCatalog{Attributes: testStruct(t, map[string]interface{}{"api_server_url": srv.URL})},
+			Persisted: &plgpb.HostCatalogPersisted{Secrets: testStruct(t, map[string]interface{}{"token": "token"})},
+			Sets:      sets,
+		})
+		assert.Equal(t, codes.Unavailable, status.Code(err), "unexpected error %v", err)
+	})
+}
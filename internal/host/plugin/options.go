@@ -29,6 +29,7 @@ type options struct {
 	withLimit               int
 	withSetIds              []string
 	withSecretsHmac         []byte
+	withStartPageAfterId    string
 }
 
 func getDefaultOptions() options {
@@ -116,6 +117,14 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
 // WithSetIds provides the ability to restrict lookups to particular matching
 // sets.
 func WithSetIds(with []string) Option {
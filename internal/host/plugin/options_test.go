@@ -44,6 +44,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		opts := getOpts(WithStartPageAfterId("hplg_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "hplg_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithPreferredEndpoints", func(t *testing.T) {
 		opts := getOpts(WithPreferredEndpoints([]string{"foo"}))
 		testOpts := getDefaultOptions()
@@ -32,8 +32,8 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 	return ha.toHost(), plg, nil
 }
 
-// ListHostsByCatalogId returns a slice of Hosts for the catalogId.
-// WithLimit is the only option supported.
+// ListHostsByCatalogId returns a slice of Hosts for the catalogId, ordered by
+// public id. WithLimit and WithStartPageAfterId are the only options supported.
 func (r *Repository) ListHostsByCatalogId(ctx context.Context, catalogId string, opt ...Option) ([]*Host, *hostplugin.Plugin, error) {
 	const op = "plugin.(Repository).ListHostsByCatalogId"
 	if catalogId == "" {
@@ -46,7 +46,11 @@ func (r *Repository) ListHostsByCatalogId(ctx context.Context, catalogId string,
 		limit = opts.withLimit
 	}
 	var hostAggs []*hostAgg
-	err := r.reader.SearchWhere(ctx, &hostAggs, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	where, args := "catalog_id = ?", []interface{}{catalogId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &hostAggs, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 
 	switch {
 	case err != nil:
@@ -535,7 +535,8 @@ func (r *Repository) LookupCatalog(ctx context.Context, id string, _ ...Option)
 	return c, plg, nil
 }
 
-// ListCatalogs returns a slice of HostCatalogs for the scope IDs. WithLimit is the only option supported.
+// ListCatalogs returns a slice of HostCatalogs for the scope IDs, ordered by public id. WithLimit and
+// WithStartPageAfterId are the only options supported.
 func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ...host.Option) ([]*HostCatalog, []*hostplugin.Plugin, error) {
 	const op = "plugin.(Repository).ListCatalogs"
 	if len(scopeIds) == 0 {
@@ -550,8 +551,12 @@ func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ..
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.WithLimit
 	}
+	where, args := "scope_id in (?)", []interface{}{scopeIds}
+	if opts.WithStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.WithStartPageAfterId)
+	}
 	var hostCatalogs []*HostCatalog
-	if err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit)); err != nil {
+	if err := r.reader.SearchWhere(ctx, &hostCatalogs, where, args, db.WithLimit(limit), db.WithOrder("public_id")); err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	plgIds := make([]string, 0, len(hostCatalogs))
@@ -558,8 +558,9 @@ func (r *Repository) LookupSet(ctx context.Context, publicId string, _ ...host.O
 	return sets[0], plg, nil
 }
 
-// ListSets returns a slice of HostSets for the catalogId. WithLimit is the
-// only option supported.
+// ListSets returns a slice of HostSets for the catalogId, ordered by public id
+// unless WithOrderByCreateTime is used. WithLimit, WithOrderByCreateTime and
+// WithStartPageAfterId are the only options supported.
 func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...host.Option) ([]*HostSet, *hostplugin.Plugin, error) {
 	const op = "plugin.(Repository).ListSets"
 	if catalogId == "" {
@@ -677,6 +678,9 @@ func (r *Repository) getSets(ctx context.Context, publicId string, catalogId str
 	default:
 		where, args = "catalog_id = ?", append(args, catalogId)
 	}
+	if opts.WithStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.WithStartPageAfterId)
+	}
 
 	dbArgs := []db.Option{db.WithLimit(limit)}
 
@@ -686,6 +690,8 @@ func (r *Repository) getSets(ctx context.Context, publicId string, catalogId str
 		} else {
 			dbArgs = append(dbArgs, db.WithOrder("create_time"))
 		}
+	} else {
+		dbArgs = append(dbArgs, db.WithOrder("public_id"))
 	}
 
 	var aggHostSets []*hostSetAgg
@@ -19,8 +19,8 @@ type Host struct {
 }
 
 // NewHost creates a new in memory Host for address assigned to catalogId.
-// Name and description are the only valid options. All other options are
-// ignored.
+// Name, description, address and tags are the only valid options. All other
+// options are ignored.
 func NewHost(catalogId string, opt ...Option) (*Host, error) {
 	if catalogId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, "static.NewHost", "no catalog id")
@@ -33,11 +33,25 @@ func NewHost(catalogId string, opt ...Option) (*Host, error) {
 			Address:     opts.withAddress,
 			Name:        opts.withName,
 			Description: opts.withDescription,
+			Tags:        tagsFromMap(opts.withTags),
 		},
 	}
 	return host, nil
 }
 
+// TagMap returns the tags of h as a map of tag keys to the values of the
+// key.
+func (h *Host) TagMap() map[string][]string {
+	if len(h.GetTags()) == 0 {
+		return nil
+	}
+	m := make(map[string][]string)
+	for _, t := range h.GetTags() {
+		m[t.Key] = append(m[t.Key], t.Value)
+	}
+	return m
+}
+
 // For compatibility with the general Host type
 func (h *Host) GetIpAddresses() []string {
 	return nil
@@ -1,9 +1,12 @@
 package static
 
 import (
+	"context"
+
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/host/static/store"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-bexpr"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -14,8 +17,8 @@ type HostSet struct {
 }
 
 // NewHostSet creates a new in memory HostSet assigned to catalogId.
-// Name and description are the only valid options. All other options are
-// ignored.
+// Name, description and filter are the only valid options. All other options
+// are ignored.
 func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
 	if catalogId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, "static.NewHostSet", "no catalog id")
@@ -27,6 +30,7 @@ func NewHostSet(catalogId string, opt ...Option) (*HostSet, error) {
 			CatalogId:   catalogId,
 			Name:        opts.withName,
 			Description: opts.withDescription,
+			Filter:      opts.withFilter,
 		},
 	}
 	return set, nil
@@ -79,3 +83,28 @@ func newHostSetForMembers(setId string, version uint32) *HostSet {
 		},
 	}
 }
+
+// validateFilter returns an error if filter is not a valid boolean
+// expression.
+func validateFilter(ctx context.Context, filter string) error {
+	const op = "static.validateFilter"
+	if _, err := bexpr.CreateEvaluator(filter); err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid filter", errors.WithWrap(err))
+	}
+	return nil
+}
+
+// filterData returns the data of h the filters of host sets are evaluated
+// against.
+func filterData(h *Host) map[string]interface{} {
+	tags := h.TagMap()
+	if tags == nil {
+		tags = make(map[string][]string)
+	}
+	return map[string]interface{}{
+		"id":      h.GetPublicId(),
+		"name":    h.GetName(),
+		"address": h.GetAddress(),
+		"tags":    tags,
+	}
+}
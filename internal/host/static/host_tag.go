@@ -0,0 +1,87 @@
+package static
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+)
+
+// MaxHostTagLength is the maximum length of the key and of the value of a
+// host tag.
+const MaxHostTagLength = 512
+
+// A HostTag represents a key/value tag of a host.
+type HostTag struct {
+	*store.HostTag
+	tableName string `gorm:"-"`
+}
+
+// NewHostTag creates a new in memory HostTag representing the tag key=value
+// of hostId.
+func NewHostTag(hostId, key, value string, opt ...Option) (*HostTag, error) {
+	const op = "static.NewHostTag"
+	if hostId == "" {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "no host id")
+	}
+	if !validTagPart(key) {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "invalid tag key")
+	}
+	if !validTagPart(value) {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "invalid tag value")
+	}
+	tag := &HostTag{
+		HostTag: &store.HostTag{
+			HostId: hostId,
+			Key:    key,
+			Value:  value,
+		},
+	}
+	return tag, nil
+}
+
+// TableName returns the table name for the host tag.
+func (t *HostTag) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return "static_host_tag"
+}
+
+// SetTableName sets the table name. If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (t *HostTag) SetTableName(n string) {
+	t.tableName = n
+}
+
+func validTagPart(s string) bool {
+	return strings.TrimSpace(s) != "" && len(s) <= MaxHostTagLength
+}
+
+// tagsFromMap returns the tags of m sorted by key and value. Duplicate
+// values of a key are removed.
+func tagsFromMap(m map[string][]string) []*store.HostTag {
+	var tags []*store.HostTag
+	for k, vs := range m {
+		seen := make(map[string]bool, len(vs))
+		for _, v := range vs {
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			tags = append(tags, &store.HostTag{Key: k, Value: v})
+		}
+	}
+	sortTags(tags)
+	return tags
+}
+
+func sortTags(tags []*store.HostTag) {
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Key != tags[j].Key {
+			return tags[i].Key < tags[j].Key
+		}
+		return tags[i].Value < tags[j].Value
+	})
+}
@@ -0,0 +1,146 @@
+package static
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostTag_New(t *testing.T) {
+	tests := []struct {
+		name      string
+		hostId    string
+		key       string
+		value     string
+		wantIsErr errors.Code
+	}{
+		{
+			name:   "valid",
+			hostId: "hst_1234567890",
+			key:    "env",
+			value:  "prod",
+		},
+		{
+			name:      "no-host-id",
+			key:       "env",
+			value:     "prod",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "empty-key",
+			hostId:    "hst_1234567890",
+			key:       " ",
+			value:     "prod",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "empty-value",
+			hostId:    "hst_1234567890",
+			key:       "env",
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "value-too-long",
+			hostId:    "hst_1234567890",
+			key:       "env",
+			value:     strings.Repeat("a", MaxHostTagLength+1),
+			wantIsErr: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewHostTag(tt.hostId, tt.key, tt.value)
+			if tt.wantIsErr != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantIsErr), err), "want err: %q got: %q", tt.wantIsErr, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(&store.HostTag{HostId: tt.hostId, Key: tt.key, Value: tt.value}, got.HostTag)
+		})
+	}
+}
+
+func TestHostTag_Insert(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cat := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+	host := TestHosts(t, conn, cat.GetPublicId(), 1)[0]
+
+	assert, require := assert.New(t), require.New(t)
+	w := db.New(conn)
+	tag, err := NewHostTag(host.GetPublicId(), "env", "prod")
+	require.NoError(err)
+	require.NoError(w.Create(context.Background(), tag))
+
+	dup, err := NewHostTag(host.GetPublicId(), "env", "prod")
+	require.NoError(err)
+	assert.Error(w.Create(context.Background(), dup))
+
+	unknown, err := NewHostTag("hst_1234567890", "env", "prod")
+	require.NoError(err)
+	assert.Error(w.Create(context.Background(), unknown))
+}
+
+func TestHostTag_SetTableName(t *testing.T) {
+	defaultTableName := "static_host_tag"
+	tests := []struct {
+		name        string
+		initialName string
+		setNameTo   string
+		want        string
+	}{
+		{
+			name:        "new-name",
+			initialName: "",
+			setNameTo:   "new-name",
+			want:        "new-name",
+		},
+		{
+			name:        "reset to default",
+			initialName: "initial",
+			setNameTo:   "",
+			want:        defaultTableName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			def := &HostTag{
+				HostTag: &store.HostTag{},
+			}
+			require.Equal(defaultTableName, def.TableName())
+			s := &HostTag{
+				HostTag:   &store.HostTag{},
+				tableName: tt.initialName,
+			}
+			s.SetTableName(tt.setNameTo)
+			assert.Equal(tt.want, s.TableName())
+		})
+	}
+}
+
+func TestTagsFromMap(t *testing.T) {
+	got := tagsFromMap(map[string][]string{
+		"role": {"web", "db", "web"},
+		"env":  {"prod"},
+	})
+	want := []*store.HostTag{
+		{Key: "env", Value: "prod"},
+		{Key: "role", Value: "db"},
+		{Key: "role", Value: "web"},
+	}
+	assert.Equal(t, want, got)
+	assert.Empty(t, tagsFromMap(nil))
+}
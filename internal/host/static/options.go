@@ -14,11 +14,14 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	withName        string
-	withDescription string
-	withLimit       int
-	withAddress     string
-	withPublicId    string
+	withName             string
+	withDescription      string
+	withLimit            int
+	withAddress          string
+	withPublicId         string
+	withStartPageAfterId string
+	withTags             map[string][]string
+	withFilter           string
 }
 
 func getDefaultOptions() options {
@@ -52,6 +55,14 @@ func WithLimit(l int) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
 // WithAddress provides an optional address.
 func WithAddress(address string) Option {
 	return func(o *options) {
@@ -65,3 +76,19 @@ func WithPublicId(id string) Option {
 		o.withPublicId = id
 	}
 }
+
+// WithTags provides optional key/value tags for a host.
+func WithTags(tags map[string][]string) Option {
+	return func(o *options) {
+		o.withTags = tags
+	}
+}
+
+// WithFilter provides an optional filter for a host set. The members of a
+// host set with a filter are the hosts of its catalog whose tags match the
+// filter.
+func WithFilter(filter string) Option {
+	return func(o *options) {
+		o.withFilter = filter
+	}
+}
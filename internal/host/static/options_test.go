@@ -26,6 +26,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 5
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		opts := getOpts(WithStartPageAfterId("hst_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "hst_1234567890"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithAddress", func(t *testing.T) {
 		opts := getOpts(WithAddress("test"))
 		testOpts := getDefaultOptions()
@@ -38,4 +44,16 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPublicId = "test"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithTags", func(t *testing.T) {
+		opts := getOpts(WithTags(map[string][]string{"env": {"prod"}}))
+		testOpts := getDefaultOptions()
+		testOpts.withTags = map[string][]string{"env": {"prod"}}
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithFilter", func(t *testing.T) {
+		opts := getOpts(WithFilter(`"prod" in "/tags/env"`))
+		testOpts := getDefaultOptions()
+		testOpts.withFilter = `"prod" in "/tags/env"`
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -19,7 +19,7 @@ import (
 //
 // h must contain a valid Address.
 //
-// Both h.Name and h.Description are optional. If h.Name is set, it must be
+// h.Name, h.Description and h.Tags are optional. If h.Name is set, it must be
 // unique within h.CatalogId.
 func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, opt ...Option) (*Host, error) {
 	const op = "static.(Repository).CreateHost"
@@ -42,6 +42,9 @@ func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, op
 	if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
 		return nil, errors.New(ctx, errors.InvalidAddress, op, "invalid address")
 	}
+	if err := validateTags(ctx, h.GetTags()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	h = h.clone()
 
 	opts := getOpts(opt...)
@@ -72,10 +75,25 @@ func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, op
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(_ db.Reader, w db.Writer) error {
 			newHost = h.clone()
-			err := w.Create(ctx, newHost, db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_CREATE)))
+			hostMsg := new(oplog.Message)
+			err := w.Create(ctx, newHost, db.NewOplogMsg(hostMsg))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
+			tagMsgs, err := createTags(ctx, w, h)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			// Write oplog
+			ticket, err := w.GetTicket(h)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			msgs := append([]*oplog.Message{hostMsg}, tagMsgs...)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, h.oplog(oplog.OpType_OP_TYPE_CREATE), msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
 			return nil
 		},
 	)
@@ -102,10 +120,11 @@ func (r *Repository) CreateHost(ctx context.Context, scopeId string, h *Host, op
 // containing the updated values and a count of the number of records
 // updated. h is not changed.
 //
-// h must contain a valid PublicId. Only h.Name, h.Description, and
-// h.Address can be updated. If h.Name is set to a non-empty string, it
+// h must contain a valid PublicId. Only h.Name, h.Description, h.Address
+// and h.Tags can be updated. If h.Name is set to a non-empty string, it
 // must be unique within h.CatalogId. If h.Address is set, it must contain
-// a valid address.
+// a valid address. The tags of the host are replaced with h.Tags if Tags is
+// included in fieldMaskPaths.
 //
 // An attribute of h will be set to NULL in the database if the attribute
 // in h is the zero value and it is included in fieldMaskPaths.
@@ -127,6 +146,7 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
 	}
 
+	var updateTags bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("Name", f):
@@ -136,6 +156,11 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 			if len(h.Address) < MinHostAddressLength || len(h.Address) > MaxHostAddressLength {
 				return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidAddress, op, "invalid address")
 			}
+		case strings.EqualFold("Tags", f):
+			if err := validateTags(ctx, h.GetTags()); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
+			updateTags = true
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -151,7 +176,14 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 		nil,
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
-		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+		if !updateTags {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+		}
+		// Only the tags are updated: bump the version of the host so the
+		// update is serialized with other updates of the host.
+		h = h.clone()
+		h.Version = version + 1
+		dbMask = []string{"Version"}
 	}
 
 	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
@@ -162,11 +194,12 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 	var rowsUpdated int
 	var returnedHost *Host
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
-		func(_ db.Reader, w db.Writer) error {
+		func(reader db.Reader, w db.Writer) error {
 			returnedHost = h.clone()
+			hostMsg := new(oplog.Message)
 			var err error
 			rowsUpdated, err = w.Update(ctx, returnedHost, dbMask, nullFields,
-				db.WithOplog(oplogWrapper, h.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.NewOplogMsg(hostMsg),
 				db.WithVersion(&version))
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
@@ -174,6 +207,29 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if rowsUpdated == 0 {
+				return nil
+			}
+			msgs := []*oplog.Message{hostMsg}
+			if updateTags {
+				tagMsgs, err := replaceTags(ctx, reader, w, h)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				msgs = append(msgs, tagMsgs...)
+			}
+			if err := setHostTags(ctx, reader, returnedHost); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			// Write oplog
+			ticket, err := w.GetTicket(h)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, h.oplog(oplog.OpType_OP_TYPE_UPDATE), msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
 			return nil
 		},
 	)
@@ -196,8 +252,8 @@ func (r *Repository) UpdateHost(ctx context.Context, scopeId string, h *Host, ve
 	return returnedHost, rowsUpdated, nil
 }
 
-// LookupHost will look up a host in the repository. If the host is not
-// found, it will return nil, nil. All options are ignored.
+// LookupHost will look up a host in the repository, with its tags. If the
+// host is not found, it will return nil, nil. All options are ignored.
 func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Option) (*Host, error) {
 	const op = "static.(Repository).LookupHost"
 	if publicId == "" {
@@ -211,11 +267,15 @@ func (r *Repository) LookupHost(ctx context.Context, publicId string, opt ...Opt
 		}
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
 	}
+	if err := setHostTags(ctx, r.reader, h); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", publicId)))
+	}
 	return h, nil
 }
 
-// ListHosts returns a slice of Hosts for the catalogId.
-// WithLimit is the only option supported.
+// ListHosts returns a slice of Hosts for the catalogId, with their tags,
+// ordered by public id. WithLimit and WithStartPageAfterId are the only
+// options supported.
 func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Option) ([]*Host, error) {
 	const op = "static.(Repository).ListHosts"
 	if catalogId == "" {
@@ -228,10 +288,17 @@ func (r *Repository) ListHosts(ctx context.Context, catalogId string, opt ...Opt
 		limit = opts.withLimit
 	}
 	var hosts []*Host
-	err := r.reader.SearchWhere(ctx, &hosts, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	where, args := "catalog_id = ?", []interface{}{catalogId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &hosts, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if err := setHostTags(ctx, r.reader, hosts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
 	return hosts, nil
 }
 
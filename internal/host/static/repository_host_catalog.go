@@ -200,7 +200,8 @@ func (r *Repository) LookupCatalog(ctx context.Context, id string, opt ...Option
 	return c, nil
 }
 
-// ListCatalogs returns a slice of HostCatalogs for the scope IDs. WithLimit is the only option supported.
+// ListCatalogs returns a slice of HostCatalogs for the scope IDs, ordered by public id. WithLimit and
+// WithStartPageAfterId are the only options supported.
 func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ...Option) ([]*HostCatalog, error) {
 	const op = "static.(Repository).ListCatalogs"
 	if len(scopeIds) == 0 {
@@ -213,7 +214,11 @@ func (r *Repository) ListCatalogs(ctx context.Context, scopeIds []string, opt ..
 		limit = opts.withLimit
 	}
 	var hostCatalogs []*HostCatalog
-	err := r.reader.SearchWhere(ctx, &hostCatalogs, "scope_id in (?)", []interface{}{scopeIds}, db.WithLimit(limit))
+	where, args := "scope_id in (?)", []interface{}{scopeIds}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &hostCatalogs, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
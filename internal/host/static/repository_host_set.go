@@ -18,8 +18,9 @@ import (
 // valid CatalogId. s must not contain a PublicId. The PublicId is
 // generated and assigned by this method. opt is ignored.
 //
-// Both s.Name and s.Description are optional. If s.Name is set, it must be
-// unique within s.CatalogId.
+// s.Name, s.Description and s.Filter are optional. If s.Name is set, it
+// must be unique within s.CatalogId. If s.Filter is set, it must be a valid
+// boolean expression.
 func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet, opt ...Option) (*HostSet, error) {
 	const op = "static.(Repository).CreateSet"
 	if s == nil {
@@ -37,6 +38,11 @@ func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet,
 	if scopeId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
 	}
+	if s.Filter != "" {
+		if err := validateFilter(ctx, s.Filter); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 	s = s.clone()
 
 	opts := getOpts(opt...)
@@ -89,9 +95,10 @@ func (r *Repository) CreateSet(ctx context.Context, scopeId string, s *HostSet,
 // containing the updated values, the hosts assigned to the host set, and a
 // count of the number of records updated. s is not changed.
 //
-// s must contain a valid PublicId. Only s.Name and s.Description can be
-// updated. If s.Name is set to a non-empty string, it must be unique
-// within s.CatalogId.
+// s must contain a valid PublicId. Only s.Name, s.Description and s.Filter
+// can be updated. If s.Name is set to a non-empty string, it must be unique
+// within s.CatalogId. If s.Filter is set to a non-empty string, it must be
+// a valid boolean expression and the set must not have members.
 //
 // An attribute of s will be set to NULL in the database if the attribute
 // in s is the zero value and it is included in fieldMaskPaths.
@@ -120,6 +127,12 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 		switch {
 		case strings.EqualFold("Name", f):
 		case strings.EqualFold("Description", f):
+		case strings.EqualFold("Filter", f):
+			if s.Filter != "" {
+				if err := validateFilter(ctx, s.Filter); err != nil {
+					return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+				}
+			}
 		default:
 			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -129,6 +142,7 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 		map[string]interface{}{
 			"Name":        s.Name,
 			"Description": s.Description,
+			"Filter":      s.Filter,
 		},
 		fieldMaskPaths,
 		nil,
@@ -165,7 +179,16 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 			if rowsUpdated > 1 {
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
-			hosts, err = getHosts(ctx, reader, s.PublicId, limit)
+			if returnedHostSet.Filter != "" {
+				members, err := getHosts(ctx, reader, s.PublicId, 1)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if len(members) > 0 {
+					return errors.New(ctx, errors.InvalidParameter, op, "a filter cannot be set on a host set with members")
+				}
+			}
+			hosts, err = getSetHosts(ctx, reader, returnedHostSet, limit)
 			if err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -185,7 +208,8 @@ func (r *Repository) UpdateSet(ctx context.Context, scopeId string, s *HostSet,
 
 // Endpoints returns a slice of host.Endpoint for the provided set id.
 // If there are no hosts in the provided set id the slice is empty.
-// If the set does not exist an error is returned.
+// If the set does not exist an error is returned. The hosts of a set with
+// a filter are evaluated when Endpoints is called.
 func (r *Repository) Endpoints(ctx context.Context, setId string) ([]*host.Endpoint, error) {
 	const op = "static.(Repository).Endpoints"
 	_, hs, err := r.lookupSet(ctx, setId)
@@ -204,9 +228,10 @@ func (r *Repository) Endpoints(ctx context.Context, setId string) ([]*host.Endpo
 }
 
 // LookupSet will look up a host set in the repository and return the host
-// set and the hosts assigned to the host set. If the host set is not
-// found, it will return nil, nil, nil. The WithLimit option can be used to
-// limit the number of hosts returned. All other options are ignored.
+// set and the hosts assigned to the host set, or the hosts matching its
+// filter. If the host set is not found, it will return nil, nil, nil. The
+// WithLimit option can be used to limit the number of hosts returned. All
+// other options are ignored.
 func (r *Repository) LookupSet(ctx context.Context, publicId string, opt ...Option) (*HostSet, []*Host, error) {
 	const op = "static.(Repository).LookupSet"
 	if publicId == "" {
@@ -235,7 +260,7 @@ func (r *Repository) lookupSet(ctx context.Context, publicId string, opt ...Opti
 	}
 	var hosts []*Host
 	var err error
-	if hosts, err = getHosts(ctx, r.reader, s.PublicId, limit); err != nil {
+	if hosts, err = getSetHosts(ctx, r.reader, s, limit); err != nil {
 		return nil, nil, errors.Wrap(ctx, err, op)
 	}
 	if err != nil {
@@ -244,8 +269,8 @@ func (r *Repository) lookupSet(ctx context.Context, publicId string, opt ...Opti
 	return s, hosts, nil
 }
 
-// ListSets returns a slice of HostSets for the catalogId. WithLimit is the
-// only option supported.
+// ListSets returns a slice of HostSets for the catalogId, ordered by public
+// id. WithLimit and WithStartPageAfterId are the only options supported.
 func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Option) ([]*HostSet, error) {
 	const op = "static.(Repository).ListSets"
 	if catalogId == "" {
@@ -258,7 +283,11 @@ func (r *Repository) ListSets(ctx context.Context, catalogId string, opt ...Opti
 		limit = opts.withLimit
 	}
 	var sets []*HostSet
-	err := r.reader.SearchWhere(ctx, &sets, "catalog_id = ?", []interface{}{catalogId}, db.WithLimit(limit))
+	where, args := "catalog_id = ?", []interface{}{catalogId}
+	if opts.withStartPageAfterId != "" {
+		where, args = where+" and public_id > ?", append(args, opts.withStartPageAfterId)
+	}
+	err := r.reader.SearchWhere(ctx, &sets, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -3,10 +3,13 @@ package static
 import (
 	"context"
 	"database/sql"
+	stderrors "errors"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/go-bexpr"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/mitchellh/pointerstructure"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -17,7 +20,7 @@ import (
 // AddSetMembers adds hostIds to setId in the repository. It returns a
 // slice of all hosts in setId. A host must belong to the same catalog as
 // the set to be added. The version must match the current version of the
-// setId in the repository.
+// setId in the repository. Hosts cannot be added to a set with a filter.
 func (r *Repository) AddSetMembers(ctx context.Context, scopeId string, setId string, version uint32, hostIds []string, opt ...Option) ([]*Host, error) {
 	const op = "static.(Repository).AddSetMembers"
 	if scopeId == "" {
@@ -46,6 +49,9 @@ func (r *Repository) AddSetMembers(ctx context.Context, scopeId string, setId st
 
 	var hosts []*Host
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+		if err := checkNoFilter(ctx, reader, setId); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
 		set := newHostSetForMembers(setId, version)
 		metadata := set.oplog(oplog.OpType_OP_TYPE_CREATE)
 
@@ -159,6 +165,60 @@ func getHosts(ctx context.Context, reader db.Reader, setId string, limit int) ([
 	return hosts, nil
 }
 
+// getSetHosts returns the hosts of s. The hosts of a set with a filter are
+// the hosts of its catalog whose tags match the filter; the hosts of other
+// sets are their members.
+func getSetHosts(ctx context.Context, reader db.Reader, s *HostSet, limit int) ([]*Host, error) {
+	const op = "static.getSetHosts"
+	if s.GetFilter() == "" {
+		return getHosts(ctx, reader, s.GetPublicId(), limit)
+	}
+	eval, err := bexpr.CreateEvaluator(s.GetFilter())
+	if err != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid filter", errors.WithWrap(err))
+	}
+	var hosts []*Host
+	if err := reader.SearchWhere(ctx, &hosts,
+		"catalog_id = ?",
+		[]interface{}{s.GetCatalogId()},
+		db.WithLimit(unlimited),
+		db.WithOrder("public_id"),
+	); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := setHostTags(ctx, reader, hosts...); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var matched []*Host
+	for _, h := range hosts {
+		if limit > 0 && len(matched) >= limit {
+			break
+		}
+		ok, err := eval.Evaluate(filterData(h))
+		if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("evaluating filter of %s", s.GetPublicId())))
+		}
+		if ok {
+			matched = append(matched, h)
+		}
+	}
+	return matched, nil
+}
+
+// checkNoFilter returns an error if the host set setId has a filter.
+func checkNoFilter(ctx context.Context, reader db.Reader, setId string) error {
+	const op = "static.checkNoFilter"
+	s := allocHostSet()
+	s.PublicId = setId
+	if err := reader.LookupByPublicId(ctx, s); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", setId)))
+	}
+	if s.GetFilter() != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("the members of %s are defined by its filter", setId))
+	}
+	return nil
+}
+
 // DeleteSetMembers deletes hostIds from setId in the repository. It
 // returns the number of hosts deleted from the set. The version must match
 // the current version of the setId in the repository.
@@ -230,6 +290,7 @@ func deleteMembers(ctx context.Context, w db.Writer, members []interface{}) ([]*
 // hosts added or deleted. A host must belong to the same catalog as the
 // set to be added. The version must match the current version of the setId
 // in the repository. If hostIds is empty, all hosts will be removed setId.
+// Hosts cannot be added to a set with a filter.
 func (r *Repository) SetSetMembers(ctx context.Context, scopeId string, setId string, version uint32, hostIds []string, opt ...Option) ([]*Host, int, error) {
 	const op = "static.(Repository).SetSetMembers"
 	if scopeId == "" {
@@ -281,6 +342,11 @@ func (r *Repository) SetSetMembers(ctx context.Context, scopeId string, setId st
 		}
 
 		_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(reader db.Reader, w db.Writer) error {
+			if len(additions) > 0 {
+				if err := checkNoFilter(ctx, reader, setId); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			set := newHostSetForMembers(setId, version)
 			metadata := set.oplog(oplog.OpType_OP_TYPE_UPDATE)
 			var msgs []*oplog.Message
@@ -0,0 +1,111 @@
+package static
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static/store"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+func validateTags(ctx context.Context, tags []*store.HostTag) error {
+	const op = "static.validateTags"
+	for _, t := range tags {
+		if !validTagPart(t.GetKey()) || !validTagPart(t.GetValue()) {
+			return errors.New(ctx, errors.InvalidParameter, op, "tag keys and values must not be empty or longer than 512 characters")
+		}
+	}
+	return nil
+}
+
+// createTags inserts the tags of h and returns the oplog messages of the
+// insertions.
+func createTags(ctx context.Context, w db.Writer, h *Host) ([]*oplog.Message, error) {
+	const op = "static.createTags"
+	if len(h.GetTags()) == 0 {
+		return nil, nil
+	}
+	items := make([]interface{}, 0, len(h.GetTags()))
+	for _, t := range h.GetTags() {
+		tag, err := NewHostTag(h.GetPublicId(), t.GetKey(), t.GetValue())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		items = append(items, tag)
+	}
+	var msgs []*oplog.Message
+	if err := w.CreateItems(ctx, items, db.NewOplogMsgs(&msgs)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return msgs, nil
+}
+
+// replaceTags replaces the tags of h.PublicId in the repository with the
+// tags of h and returns the oplog messages of the changes.
+func replaceTags(ctx context.Context, reader db.Reader, w db.Writer, h *Host) ([]*oplog.Message, error) {
+	const op = "static.replaceTags"
+	current, err := getTags(ctx, reader, []string{h.GetPublicId()})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var msgs []*oplog.Message
+	if tags := current[h.GetPublicId()]; len(tags) > 0 {
+		items := make([]interface{}, 0, len(tags))
+		for _, t := range tags {
+			items = append(items, &HostTag{HostTag: t})
+		}
+		var deleteMsgs []*oplog.Message
+		rowsDeleted, err := w.DeleteItems(ctx, items, db.NewOplogMsgs(&deleteMsgs))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if rowsDeleted != len(items) {
+			return nil, errors.New(ctx, errors.MultipleRecords, op, "tags deleted did not match the current tags")
+		}
+		msgs = append(msgs, deleteMsgs...)
+	}
+	createMsgs, err := createTags(ctx, w, h)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return append(msgs, createMsgs...), nil
+}
+
+// getTags returns the tags of hostIds by host id. The tags of each host are
+// sorted by key and value.
+func getTags(ctx context.Context, reader db.Reader, hostIds []string) (map[string][]*store.HostTag, error) {
+	const op = "static.getTags"
+	if len(hostIds) == 0 {
+		return nil, nil
+	}
+	var tags []*HostTag
+	if err := reader.SearchWhere(ctx, &tags, "host_id in (?)", []interface{}{hostIds}, db.WithLimit(unlimited)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ret := make(map[string][]*store.HostTag, len(hostIds))
+	for _, t := range tags {
+		ret[t.GetHostId()] = append(ret[t.GetHostId()], t.HostTag)
+	}
+	for _, t := range ret {
+		sortTags(t)
+	}
+	return ret, nil
+}
+
+// setHostTags sets the tags of hosts from the repository.
+func setHostTags(ctx context.Context, reader db.Reader, hosts ...*Host) error {
+	const op = "static.setHostTags"
+	ids := make([]string, 0, len(hosts))
+	for _, h := range hosts {
+		ids = append(ids, h.GetPublicId())
+	}
+	tags, err := getTags(ctx, reader, ids)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, h := range hosts {
+		h.Tags = tags[h.GetPublicId()]
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package static
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_HostTags(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	in, err := NewHost(catalog.PublicId, WithAddress("127.0.0.1"), WithTags(map[string][]string{
+		"env":  {"prod"},
+		"role": {"web", "db"},
+	}))
+	require.NoError(err)
+	created, err := repo.CreateHost(ctx, prj.PublicId, in)
+	require.NoError(err)
+	want := map[string][]string{"env": {"prod"}, "role": {"db", "web"}}
+	assert.Equal(want, created.TagMap())
+
+	got, err := repo.LookupHost(ctx, created.PublicId)
+	require.NoError(err)
+	assert.Equal(want, got.TagMap())
+
+	// A tags only update bumps the version of the host.
+	upd, err := NewHost(catalog.PublicId, WithTags(map[string][]string{"env": {"dev"}}))
+	require.NoError(err)
+	upd.PublicId = created.PublicId
+	updated, n, err := repo.UpdateHost(ctx, prj.PublicId, upd, created.Version, []string{"Tags"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(created.Version+1, updated.Version)
+	assert.Equal(map[string][]string{"env": {"dev"}}, updated.TagMap())
+
+	hosts, err := repo.ListHosts(ctx, catalog.PublicId)
+	require.NoError(err)
+	require.Len(hosts, 1)
+	assert.Equal(map[string][]string{"env": {"dev"}}, hosts[0].TagMap())
+
+	// Clearing the tags.
+	upd.Tags = nil
+	updated, n, err = repo.UpdateHost(ctx, prj.PublicId, upd, updated.Version, []string{"Tags"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Empty(updated.TagMap())
+
+	bad, err := NewHost(catalog.PublicId, WithAddress("127.0.0.1"), WithTags(map[string][]string{"env": {" "}}))
+	require.NoError(err)
+	_, err = repo.CreateHost(ctx, prj.PublicId, bad)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
+
+func TestRepository_FilterSet(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	catalog := TestCatalogs(t, conn, prj.PublicId, 1)[0]
+
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(err)
+
+	newHost := func(tags map[string][]string) *Host {
+		h, err := NewHost(catalog.PublicId, WithAddress("127.0.0.1"), WithTags(tags))
+		require.NoError(err)
+		h, err = repo.CreateHost(ctx, prj.PublicId, h)
+		require.NoError(err)
+		return h
+	}
+	prod := newHost(map[string][]string{"env": {"prod"}})
+	both := newHost(map[string][]string{"env": {"dev", "prod"}})
+	newHost(map[string][]string{"env": {"dev"}})
+	newHost(nil)
+
+	in, err := NewHostSet(catalog.PublicId, WithFilter(`"prod" in "/tags/env"`))
+	require.NoError(err)
+	set, err := repo.CreateSet(ctx, prj.PublicId, in)
+	require.NoError(err)
+	assert.Equal(`"prod" in "/tags/env"`, set.Filter)
+
+	_, hosts, err := repo.LookupSet(ctx, set.PublicId)
+	require.NoError(err)
+	require.Len(hosts, 2)
+	wantIds := []string{prod.PublicId, both.PublicId}
+	assert.ElementsMatch(wantIds, []string{hosts[0].PublicId, hosts[1].PublicId})
+
+	_, hosts, err = repo.LookupSet(ctx, set.PublicId, WithLimit(1))
+	require.NoError(err)
+	assert.Len(hosts, 1)
+
+	eps, err := repo.Endpoints(ctx, set.PublicId)
+	require.NoError(err)
+	require.Len(eps, 2)
+	assert.ElementsMatch(wantIds, []string{eps[0].HostId, eps[1].HostId})
+
+	// The members of a set with a filter cannot be managed.
+	_, err = repo.AddSetMembers(ctx, prj.PublicId, set.PublicId, set.Version, []string{prod.PublicId})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, _, err = repo.SetSetMembers(ctx, prj.PublicId, set.PublicId, set.Version, []string{prod.PublicId})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	// Updating the filter changes the members of the set.
+	set.Filter = `"dev" in "/tags/env"`
+	updated, hosts, n, err := repo.UpdateSet(ctx, prj.PublicId, set, set.Version, []string{"Filter"})
+	require.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(`"dev" in "/tags/env"`, updated.Filter)
+	assert.Len(hosts, 2)
+
+	// A filter cannot be set on a set with members.
+	withMembers := TestSets(t, conn, catalog.PublicId, 1)[0]
+	TestSetMembers(t, conn, withMembers.PublicId, []*Host{prod})
+	withMembers.Filter = `"prod" in "/tags/env"`
+	_, _, _, err = repo.UpdateSet(ctx, prj.PublicId, withMembers, withMembers.Version, []string{"Filter"})
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+
+	invalid, err := NewHostSet(catalog.PublicId, WithFilter(`"prod" in`))
+	require.NoError(err)
+	_, err = repo.CreateSet(ctx, prj.PublicId, invalid)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+}
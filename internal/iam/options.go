@@ -1,6 +1,12 @@
 package iam
 
-import "io"
+import (
+	"io"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/filter"
+)
 
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
@@ -29,6 +35,13 @@ type options struct {
 	withRandomReader            io.Reader
 	withAccountIds              []string
 	withPrimaryAuthMethodId     string
+	withStartPageAfterId        string
+	withOffset                  int
+	withFilterConditions        []filter.Condition
+	withNotBefore               time.Time
+	withNotAfter                time.Time
+	withCondition               string
+	withAccessRevoker           auth.AccessRevoker
 }
 
 func getDefaultOptions() options {
@@ -72,6 +85,30 @@ func WithLimit(limit int) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
+// WithOffset provides an option to skip the first offset items of a list. It
+// is used to read the pages of a list by their position.
+func WithOffset(offset int) Option {
+	return func(o *options) {
+		o.withOffset = offset
+	}
+}
+
+// WithFilterConditions provides an option to only list the items matching
+// the conditions of a list filter which can be evaluated by the database.
+func WithFilterConditions(c []filter.Condition) Option {
+	return func(o *options) {
+		o.withFilterConditions = c
+	}
+}
+
 // WithGrantScopeId provides an option to specify the scope ID for grants in
 // roles.
 func WithGrantScopeId(id string) Option {
@@ -141,3 +178,36 @@ func WithPrimaryAuthMethodId(id string) Option {
 		o.withPrimaryAuthMethodId = id
 	}
 }
+
+// WithNotBefore provides an option to specify the time from which a principal
+// role applies.
+func WithNotBefore(t time.Time) Option {
+	return func(o *options) {
+		o.withNotBefore = t
+	}
+}
+
+// WithNotAfter provides an option to specify the time until which a principal
+// role applies.
+func WithNotAfter(t time.Time) Option {
+	return func(o *options) {
+		o.withNotAfter = t
+	}
+}
+
+// WithCondition provides an option to specify the condition, evaluated against
+// the request, which must be true for a principal role to apply.
+func WithCondition(condition string) Option {
+	return func(o *options) {
+		o.withCondition = condition
+	}
+}
+
+// WithAccessRevoker provides an option to specify the AccessRevoker used to
+// revoke the access of users when they or their accounts are deleted or
+// disabled.
+func WithAccessRevoker(r auth.AccessRevoker) Option {
+	return func(o *options) {
+		o.withAccessRevoker = r
+	}
+}
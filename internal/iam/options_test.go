@@ -1,8 +1,12 @@
 package iam
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +45,28 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withLimit = 1
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("u_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "u_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithOffset", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithOffset(10))
+		testOpts := getDefaultOptions()
+		testOpts.withOffset = 10
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithFilterConditions", func(t *testing.T) {
+		assert := assert.New(t)
+		conds := []filter.Condition{{Field: "name", Operator: filter.EqualOperator, Value: "foo"}}
+		opts := getOpts(WithFilterConditions(conds))
+		testOpts := getDefaultOptions()
+		testOpts.withFilterConditions = conds
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithGrantScopeId", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithGrantScopeId("o_1234"))
@@ -79,4 +105,41 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrimaryAuthMethodId = "test"
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithNotBefore", func(t *testing.T) {
+		assert := assert.New(t)
+		now := time.Now()
+		opts := getOpts(WithNotBefore(now))
+		testOpts := getDefaultOptions()
+		testOpts.withNotBefore = now
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithNotAfter", func(t *testing.T) {
+		assert := assert.New(t)
+		now := time.Now()
+		opts := getOpts(WithNotAfter(now))
+		testOpts := getDefaultOptions()
+		testOpts.withNotAfter = now
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithCondition", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithCondition(`"10.0.0.0/8" in "/request/client_networks"`))
+		testOpts := getDefaultOptions()
+		testOpts.withCondition = `"10.0.0.0/8" in "/request/client_networks"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAccessRevoker", func(t *testing.T) {
+		assert := assert.New(t)
+		revoker := &testAccessRevoker{}
+		opts := getOpts(WithAccessRevoker(revoker))
+		testOpts := getDefaultOptions()
+		testOpts.withAccessRevoker = revoker
+		assert.Equal(opts, testOpts)
+	})
 }
+
+// testAccessRevoker is a no-op auth.AccessRevoker.
+type testAccessRevoker struct{}
+
+func (*testAccessRevoker) RevokeUserAccess(context.Context, db.Writer, string) error    { return nil }
+func (*testAccessRevoker) RevokeAccountAccess(context.Context, db.Writer, string) error { return nil }
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/perms"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -62,6 +64,28 @@ func (v *PrincipalRole) SetTableName(n string) {
 	}
 }
 
+// principalRoleConstraints returns the time bounds and condition of a
+// principal role from the options, validating them.
+func principalRoleConstraints(opt ...Option) (notBefore, notAfter *timestamp.Timestamp, condition string, err error) {
+	const op = "iam.principalRoleConstraints"
+	opts := getOpts(opt...)
+	if !opts.withNotBefore.IsZero() && !opts.withNotAfter.IsZero() && !opts.withNotBefore.Before(opts.withNotAfter) {
+		return nil, nil, "", errors.NewDeprecated(errors.InvalidParameter, op, "not before must be before not after")
+	}
+	if !opts.withNotBefore.IsZero() {
+		notBefore = timestamp.New(opts.withNotBefore)
+	}
+	if !opts.withNotAfter.IsZero() {
+		notAfter = timestamp.New(opts.withNotAfter)
+	}
+	if opts.withCondition != "" {
+		if err := perms.ValidateCondition(opts.withCondition); err != nil {
+			return nil, nil, "", errors.WrapDeprecated(err, op)
+		}
+	}
+	return notBefore, notAfter, opts.withCondition, nil
+}
+
 // UserRole is a user assigned to a role
 type UserRole struct {
 	*store.UserRole
@@ -75,9 +99,9 @@ var (
 	_ db.VetForWriter = (*UserRole)(nil)
 )
 
-// NewUserRole creates a new user role in memory. No options are supported
-// currently.
-func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
+// NewUserRole creates a new user role in memory. WithNotBefore, WithNotAfter
+// and WithCondition are the only valid options.
+func NewUserRole(roleId, userId string, opt ...Option) (*UserRole, error) {
 	const op = "iam.NewUserRole"
 	if roleId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing role id")
@@ -85,10 +109,17 @@ func NewUserRole(roleId, userId string, _ ...Option) (*UserRole, error) {
 	if userId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing user id")
 	}
+	notBefore, notAfter, condition, err := principalRoleConstraints(opt...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
 	return &UserRole{
 		UserRole: &store.UserRole{
 			PrincipalId: userId,
 			RoleId:      roleId,
+			NotBefore:   notBefore,
+			NotAfter:    notAfter,
+			Condition:   condition,
 		},
 	}, nil
 }
@@ -152,8 +183,8 @@ var (
 	_ db.VetForWriter = (*GroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewGroupRole creates a new group role in memory. WithNotBefore,
+// WithNotAfter and WithCondition are the only valid options.
 func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	const op = "iam.NewGroupRole"
 	if roleId == "" {
@@ -162,10 +193,17 @@ func NewGroupRole(roleId, groupId string, opt ...Option) (*GroupRole, error) {
 	if groupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing group id")
 	}
+	notBefore, notAfter, condition, err := principalRoleConstraints(opt...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
 	return &GroupRole{
 		GroupRole: &store.GroupRole{
 			PrincipalId: groupId,
 			RoleId:      roleId,
+			NotBefore:   notBefore,
+			NotAfter:    notAfter,
+			Condition:   condition,
 		},
 	}, nil
 }
@@ -229,8 +267,8 @@ var (
 	_ db.VetForWriter = (*ManagedGroupRole)(nil)
 )
 
-// NewGroupRole creates a new group role in memory. No options are supported
-// currently.
+// NewManagedGroupRole creates a new managed group role in memory.
+// WithNotBefore, WithNotAfter and WithCondition are the only valid options.
 func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*ManagedGroupRole, error) {
 	const op = "iam.NewManagedGroupRole"
 	if roleId == "" {
@@ -239,10 +277,17 @@ func NewManagedGroupRole(roleId, managedGroupId string, opt ...Option) (*Managed
 	if managedGroupId == "" {
 		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing managed group id")
 	}
+	notBefore, notAfter, condition, err := principalRoleConstraints(opt...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
 	return &ManagedGroupRole{
 		ManagedGroupRole: &store.ManagedGroupRole{
 			PrincipalId: managedGroupId,
 			RoleId:      roleId,
+			NotBefore:   notBefore,
+			NotAfter:    notAfter,
+			Condition:   condition,
 		},
 	}, nil
 }
@@ -3,8 +3,10 @@ package iam
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +23,8 @@ func TestNewUserRole(t *testing.T) {
 	orgRole := TestRole(t, conn, org.PublicId)
 	projRole := TestRole(t, conn, proj.PublicId)
 	user := TestUser(t, repo, org.PublicId)
+	notBefore := time.Now().Truncate(time.Second)
+	notAfter := notBefore.Add(time.Hour)
 
 	type args struct {
 		roleId string
@@ -80,6 +84,49 @@ func TestNewUserRole(t *testing.T) {
 			wantErr:   true,
 			wantIsErr: errors.InvalidParameter,
 		},
+		{
+			name: "valid-with-constraints",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt: []Option{
+					WithNotBefore(notBefore),
+					WithNotAfter(notAfter),
+					WithCondition(`"/request/time/weekday" != "sunday"`),
+				},
+			},
+			want: func() *UserRole {
+				r := allocUserRole()
+				r.RoleId = orgRole.PublicId
+				r.PrincipalId = user.PublicId
+				r.NotBefore = timestamp.New(notBefore)
+				r.NotAfter = timestamp.New(notAfter)
+				r.Condition = `"/request/time/weekday" != "sunday"`
+				return &r
+			}(),
+		},
+		{
+			name: "not-before-after-not-after",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt:    []Option{WithNotBefore(notAfter), WithNotAfter(notBefore)},
+			},
+			want:      nil,
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name: "invalid-condition",
+			args: args{
+				roleId: orgRole.PublicId,
+				userId: user.PublicId,
+				opt:    []Option{WithCondition("weekday is")},
+			},
+			want:      nil,
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/boundary/internal/auth"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -22,10 +24,16 @@ type Repository struct {
 
 	// defaultLimit provides a default for limiting the number of results returned from the repo
 	defaultLimit int
+
+	// accessRevoker, if set, revokes the access of users when they or their
+	// accounts are deleted or disabled
+	accessRevoker auth.AccessRevoker
 }
 
 // NewRepository creates a new iam Repository. Supports the options: WithLimit
-// which sets a default limit on results returned by repo operations.
+// which sets a default limit on results returned by repo operations, and
+// WithAccessRevoker which revokes the access of users when they or their
+// accounts are deleted or disabled.
 func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "iam.NewRepository"
 	if r == nil {
@@ -43,10 +51,11 @@ func NewRepository(r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repo
 		opts.withLimit = db.DefaultLimit
 	}
 	return &Repository{
-		reader:       r,
-		writer:       w,
-		kms:          kms,
-		defaultLimit: opts.withLimit,
+		reader:        r,
+		writer:        w,
+		kms:           kms,
+		defaultLimit:  opts.withLimit,
+		accessRevoker: opts.withAccessRevoker,
 	}, nil
 }
 
@@ -62,6 +71,39 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(limit))
 }
 
+// listByPublicId is like list for resources which have a public id. The
+// resources are ordered by public id and the WithStartPageAfterId and
+// WithFilterConditions options are supported. columns maps the fields which
+// can be used in filter conditions to their column.
+func (r *Repository) listByPublicId(ctx context.Context, resources interface{}, where string, args []interface{}, columns map[string]string, opt ...Option) error {
+	const op = "iam.(Repository).listByPublicId"
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	if opts.withStartPageAfterId != "" {
+		where, args = andWhere(where, "public_id > ?"), append(args, opts.withStartPageAfterId)
+	}
+	if len(opts.withFilterConditions) > 0 {
+		filterWhere, filterArgs, err := filter.Where(opts.withFilterConditions, columns)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		where, args = andWhere(where, filterWhere), append(args, filterArgs...)
+	}
+	return r.reader.SearchWhere(ctx, resources, where, args, db.WithLimit(limit), db.WithOrder("public_id"))
+}
+
+// andWhere returns the where clause matching both where and cond.
+func andWhere(where, cond string) string {
+	if where == "" {
+		return cond
+	}
+	return fmt.Sprintf("(%s) and %s", where, cond)
+}
+
 // create will create a new iam resource in the db repository with an oplog entry
 func (r *Repository) create(ctx context.Context, resource Resource, _ ...Option) (Resource, error) {
 	const op = "iam.(Repository).create"
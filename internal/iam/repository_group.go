@@ -12,6 +12,13 @@ import (
 	"github.com/hashicorp/boundary/internal/oplog"
 )
 
+// groupFilterColumns are the columns of the fields of a group which can be
+// used in the filter conditions of a list.
+var groupFilterColumns = map[string]string{
+	"name":     "name",
+	"scope_id": "scope_id",
+}
+
 // CreateGroup will create a group in the repository and return the written
 // group.  No options are currently supported.
 func (r *Repository) CreateGroup(ctx context.Context, group *Group, _ ...Option) (*Group, error) {
@@ -170,14 +177,16 @@ func (r *Repository) DeleteGroup(ctx context.Context, withPublicId string, _ ...
 	return rowsDeleted, nil
 }
 
-// ListGroups lists groups in the given scopes and supports WithLimit option.
+// ListGroups lists groups in the given scopes, ordered by public id, and
+// supports the WithLimit, WithStartPageAfterId and WithFilterConditions
+// options.
 func (r *Repository) ListGroups(ctx context.Context, withScopeIds []string, opt ...Option) ([]*Group, error) {
 	const op = "iam.(Repository).ListGroups"
 	if len(withScopeIds) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
 	}
 	var grps []*Group
-	err := r.list(ctx, &grps, "scope_id in (?)", []interface{}{withScopeIds}, opt...)
+	err := r.listByPublicId(ctx, &grps, "scope_id in (?)", []interface{}{withScopeIds}, groupFilterColumns, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -16,8 +16,9 @@ import (
 // groupIds) to a role (roleId).  The role's current db version must match the
 // roleVersion or an error will be returned.  The list of current PrincipalRoles
 // after the adds will be returned on success. Zero is not a valid value for
-// the WithVersion option and will return an error.
-func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]PrincipalRole, error) {
+// the WithVersion option and will return an error. The WithNotBefore,
+// WithNotAfter and WithCondition options bound the added principal roles.
+func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]PrincipalRole, error) {
 	const op = "iam.(Repository).AddPrincipalRoles"
 	if roleId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -35,7 +36,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 
 	newUserRoles := make([]interface{}, 0, len(userIds))
 	for _, id := range userIds {
-		usrRole, err := NewUserRole(roleId, id)
+		usrRole, err := NewUserRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role"))
 		}
@@ -43,7 +44,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newGrpRoles := make([]interface{}, 0, len(groupIds))
 	for _, id := range groupIds {
-		grpRole, err := NewGroupRole(roleId, id)
+		grpRole, err := NewGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role"))
 		}
@@ -51,7 +52,7 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 	}
 	newManagedGrpRoles := make([]interface{}, 0, len(managedGroupIds))
 	for _, id := range managedGroupIds {
-		managedGrpRole, err := NewManagedGroupRole(roleId, id)
+		managedGrpRole, err := NewManagedGroupRole(roleId, id, opt...)
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role"))
 		}
@@ -148,8 +149,9 @@ func (r *Repository) AddPrincipalRoles(ctx context.Context, roleId string, roleV
 // principals as need to reconcile the existing principals with the principals
 // requested. If both userIds and groupIds are empty, the principal roles will
 // be cleared. Zero is not a valid value for the WithVersion option and will
-// return an error.
-func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, _ ...Option) ([]PrincipalRole, int, error) {
+// return an error. The WithNotBefore, WithNotAfter and WithCondition options
+// bound the principal roles which are added.
+func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleVersion uint32, principalIds []string, opt ...Option) ([]PrincipalRole, int, error) {
 	const op = "iam.(Repository).SetPrincipalRoles"
 	if roleId == "" {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
@@ -167,7 +169,7 @@ func (r *Repository) SetPrincipalRoles(ctx context.Context, roleId string, roleV
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
-	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds)
+	toSet, err := r.PrincipalsToSet(ctx, &role, userIds, groupIds, managedGroupIds, opt...)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 	}
@@ -461,6 +463,45 @@ func (r *Repository) ListPrincipalRoles(ctx context.Context, roleId string, opt
 	return principals, nil
 }
 
+// DeleteExpiredPrincipalRoles deletes the principal roles whose not after
+// time has passed, returning the number of principal roles deleted. It
+// supports the WithLimit option.
+func (r *Repository) DeleteExpiredPrincipalRoles(ctx context.Context, opt ...Option) (int, error) {
+	const op = "iam.(Repository).DeleteExpiredPrincipalRoles"
+	var expired []PrincipalRole
+	if err := r.list(ctx, &expired, "not_after <= now()", nil, opt...); err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup expired principal roles"))
+	}
+
+	var roleIds []string
+	principalIds := make(map[string][]string)
+	for _, pr := range expired {
+		if _, ok := principalIds[pr.GetRoleId()]; !ok {
+			roleIds = append(roleIds, pr.GetRoleId())
+		}
+		principalIds[pr.GetRoleId()] = append(principalIds[pr.GetRoleId()], pr.GetPrincipalId())
+	}
+
+	var totalRowsDeleted int
+	for _, roleId := range roleIds {
+		role := allocRole()
+		role.PublicId = roleId
+		if err := r.reader.LookupByPublicId(ctx, &role); err != nil {
+			if errors.IsNotFoundError(err) {
+				// The role was deleted along with its principal roles
+				continue
+			}
+			return totalRowsDeleted, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup role %s", roleId)))
+		}
+		rowsDeleted, err := r.DeletePrincipalRoles(ctx, roleId, role.Version, principalIds[roleId])
+		if err != nil {
+			return totalRowsDeleted, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for role %s", roleId)))
+		}
+		totalRowsDeleted += rowsDeleted
+	}
+	return totalRowsDeleted, nil
+}
+
 type PrincipalSet struct {
 	AddUserRoles            []interface{}
 	AddGroupRoles           []interface{}
@@ -474,8 +515,10 @@ type PrincipalSet struct {
 }
 
 // TODO: Should this be moved inside the transaction, at this point?
-// PrincipalsToSet sets principals on a role from the given lists.
-func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string) (*PrincipalSet, error) {
+// PrincipalsToSet sets principals on a role from the given lists. The
+// WithNotBefore, WithNotAfter and WithCondition options bound the principal
+// roles to add.
+func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, groupIds, managedGroupIds []string, opt ...Option) (*PrincipalSet, error) {
 	const op = "iam.(Repository).PrincipalsToSet"
 	// TODO(mgaffney) 08/2020: Use SQL to calculate changes.
 	if role == nil {
@@ -505,7 +548,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	for _, id := range userIds {
 		userIdsMap[id] = struct{}{}
 		if _, ok := existingUsers[id]; !ok {
-			usrRole, err := NewUserRole(role.PublicId, id)
+			usrRole, err := NewUserRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role for add"))
 			}
@@ -517,7 +560,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	for _, id := range groupIds {
 		groupIdsMap[id] = struct{}{}
 		if _, ok := existingGroups[id]; !ok {
-			grpRole, err := NewGroupRole(role.PublicId, id)
+			grpRole, err := NewGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory group role for add"))
 			}
@@ -529,7 +572,7 @@ func (r *Repository) PrincipalsToSet(ctx context.Context, role *Role, userIds, g
 	for _, id := range managedGroupIds {
 		managedGroupIdsMap[id] = struct{}{}
 		if _, ok := existingManagedGroups[id]; !ok {
-			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id)
+			managedGrpRole, err := NewManagedGroupRole(role.PublicId, id, opt...)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory managed group role for add"))
 			}
@@ -602,7 +645,8 @@ func splitPrincipals(ctx context.Context, principals []string) (users, groups, m
 				groups = make([]string, 0, len(principals))
 			}
 			groups = append(groups, principal)
-		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix):
+		case strings.HasPrefix(principal, intglobals.OidcManagedGroupPrefix),
+			strings.HasPrefix(principal, intglobals.LdapManagedGroupPrefix):
 			if managedGroups == nil {
 				managedGroups = make([]string, 0, len(principals))
 			}
@@ -509,6 +509,48 @@ func TestRepository_DeletePrincipalRoles(t *testing.T) {
 	}
 }
 
+func TestRepository_DeleteExpiredPrincipalRoles(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+	user := TestUser(t, repo, org.PublicId)
+	group := TestGroup(t, conn, org.PublicId)
+	now := time.Now()
+
+	expiredRole := TestRole(t, conn, org.PublicId)
+	TestUserRole(t, conn, expiredRole.PublicId, user.PublicId, WithNotAfter(now.Add(-time.Minute)))
+	TestGroupRole(t, conn, expiredRole.PublicId, group.PublicId, WithNotBefore(now.Add(-time.Hour)), WithNotAfter(now.Add(-time.Minute)))
+
+	currentRole := TestRole(t, conn, org.PublicId)
+	TestUserRole(t, conn, currentRole.PublicId, user.PublicId, WithNotAfter(now.Add(time.Hour)))
+	TestGroupRole(t, conn, currentRole.PublicId, group.PublicId)
+
+	deleted, err := repo.DeleteExpiredPrincipalRoles(ctx)
+	require.NoError(err)
+	assert.Equal(2, deleted)
+
+	principals, err := repo.ListPrincipalRoles(ctx, expiredRole.PublicId)
+	require.NoError(err)
+	assert.Empty(principals)
+	principals, err = repo.ListPrincipalRoles(ctx, currentRole.PublicId)
+	require.NoError(err)
+	assert.Len(principals, 2)
+
+	// The version of the role is incremented like for any other change of its
+	// principals
+	role, _, _, err := repo.LookupRole(ctx, expiredRole.PublicId)
+	require.NoError(err)
+	assert.Equal(expiredRole.Version+1, role.Version)
+
+	deleted, err = repo.DeleteExpiredPrincipalRoles(ctx)
+	require.NoError(err)
+	assert.Zero(deleted)
+}
+
 func TestRepository_SetPrincipalRoles(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -0,0 +1,134 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// resourceScopeQueries contains, for each resource type, the query returning
+// the scope and the parent of a resource, in the way grants are matched
+// against them: the scope of a scope is its parent scope, and resources
+// contained in another resource, such as hosts in a host catalog, have the
+// scope of their parent.
+var resourceScopeQueries = map[resource.Type]string{
+	resource.Scope: `
+select coalesce(parent_id, public_id) as scope_id, '' as parent_id
+  from iam_scope
+ where public_id = ?`,
+	resource.User: `
+select scope_id, '' as parent_id
+  from iam_user
+ where public_id = ?`,
+	resource.Group: `
+select scope_id, '' as parent_id
+  from iam_group
+ where public_id = ?`,
+	resource.Role: `
+select scope_id, '' as parent_id
+  from iam_role
+ where public_id = ?`,
+	resource.AuthMethod: `
+select scope_id, '' as parent_id
+  from auth_method
+ where public_id = ?`,
+	resource.Account: `
+select scope_id, auth_method_id as parent_id
+  from auth_account
+ where public_id = ?`,
+	resource.ManagedGroup: `
+select auth_method.scope_id, auth_managed_group.auth_method_id as parent_id
+  from auth_managed_group
+  join auth_method
+    on auth_managed_group.auth_method_id = auth_method.public_id
+ where auth_managed_group.public_id = ?`,
+	resource.AuthToken: `
+select auth_account.scope_id, '' as parent_id
+  from auth_token
+  join auth_account
+    on auth_token.auth_account_id = auth_account.public_id
+ where auth_token.public_id = ?`,
+	resource.HostCatalog: `
+select scope_id, '' as parent_id
+  from host_catalog
+ where public_id = ?`,
+	resource.HostSet: `
+select host_catalog.scope_id, host_set.catalog_id as parent_id
+  from host_set
+  join host_catalog
+    on host_set.catalog_id = host_catalog.public_id
+ where host_set.public_id = ?`,
+	resource.Host: `
+select host_catalog.scope_id, host.catalog_id as parent_id
+  from host
+  join host_catalog
+    on host.catalog_id = host_catalog.public_id
+ where host.public_id = ?`,
+	resource.Target: `
+select scope_id, '' as parent_id
+  from target
+ where public_id = ?`,
+	resource.Session: `
+select scope_id, '' as parent_id
+  from session
+ where public_id = ?
+   and scope_id is not null`,
+	resource.CredentialStore: `
+select scope_id, '' as parent_id
+  from credential_store
+ where public_id = ?`,
+	resource.CredentialLibrary: `
+select credential_store.scope_id, credential_library.store_id as parent_id
+  from credential_library
+  join credential_store
+    on credential_library.store_id = credential_store.public_id
+ where credential_library.public_id = ?`,
+	resource.Credential: `
+select credential_store.scope_id, credential_static.store_id as parent_id
+  from credential_static
+  join credential_store
+    on credential_static.store_id = credential_store.public_id
+ where credential_static.public_id = ?`,
+	resource.AccessRequest: `
+select scope_id, '' as parent_id
+  from access_request
+ where public_id = ?`,
+}
+
+// LookupResourceScope returns the ID of the scope of the resource resourceId
+// of type typ, and the ID of its parent for resources contained in another
+// resource, e.g. the host catalog of a host. The scope of a scope is its
+// parent scope, except for the global scope. It returns a RecordNotFound
+// error if the resource does not exist.
+func (r *Repository) LookupResourceScope(ctx context.Context, resourceId string, typ resource.Type) (scopeId string, parentId string, err error) {
+	const op = "iam.(Repository).LookupResourceScope"
+	if resourceId == "" {
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, "missing resource id")
+	}
+	query, ok := resourceScopeQueries[typ]
+	if !ok {
+		return "", "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported resource type %q", typ.String()))
+	}
+	if typ == resource.Scope && resourceId == scope.Global.String() {
+		return scope.Global.String(), "", nil
+	}
+
+	rows, err := r.reader.Query(ctx, query, []interface{}{resourceId})
+	if err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", "", errors.Wrap(ctx, err, op)
+		}
+		return "", "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("%s %s not found", typ.String(), resourceId))
+	}
+	if err := rows.Scan(&scopeId, &parentId); err != nil {
+		return "", "", errors.Wrap(ctx, err, op)
+	}
+	return scopeId, parentId, nil
+}
@@ -0,0 +1,79 @@
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/auth/password"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_LookupResourceScope(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+
+	org, proj := iam.TestScopes(t, repo)
+	user := iam.TestUser(t, repo, org.GetPublicId())
+	role := iam.TestRole(t, conn, proj.GetPublicId())
+	authMethod := password.TestAuthMethod(t, conn, org.GetPublicId())
+	account := password.TestAccount(t, conn, authMethod.GetPublicId(), "alice")
+	catalog := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	host := static.TestHosts(t, conn, catalog.GetPublicId(), 1)[0]
+	set := static.TestSets(t, conn, catalog.GetPublicId(), 1)[0]
+	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "test")
+	store := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
+	cred := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, proj.GetPublicId(), store.GetPublicId(), "alice", "secret")
+
+	tests := []struct {
+		name         string
+		resourceId   string
+		typ          resource.Type
+		wantScopeId  string
+		wantParentId string
+		wantErrCode  errors.Code
+	}{
+		{name: "global", resourceId: scope.Global.String(), typ: resource.Scope, wantScopeId: scope.Global.String()},
+		{name: "org", resourceId: org.GetPublicId(), typ: resource.Scope, wantScopeId: scope.Global.String()},
+		{name: "project", resourceId: proj.GetPublicId(), typ: resource.Scope, wantScopeId: org.GetPublicId()},
+		{name: "user", resourceId: user.GetPublicId(), typ: resource.User, wantScopeId: org.GetPublicId()},
+		{name: "role", resourceId: role.GetPublicId(), typ: resource.Role, wantScopeId: proj.GetPublicId()},
+		{name: "auth-method", resourceId: authMethod.GetPublicId(), typ: resource.AuthMethod, wantScopeId: org.GetPublicId()},
+		{name: "account", resourceId: account.GetPublicId(), typ: resource.Account, wantScopeId: org.GetPublicId(), wantParentId: authMethod.GetPublicId()},
+		{name: "host-catalog", resourceId: catalog.GetPublicId(), typ: resource.HostCatalog, wantScopeId: proj.GetPublicId()},
+		{name: "host", resourceId: host.GetPublicId(), typ: resource.Host, wantScopeId: proj.GetPublicId(), wantParentId: catalog.GetPublicId()},
+		{name: "host-set", resourceId: set.GetPublicId(), typ: resource.HostSet, wantScopeId: proj.GetPublicId(), wantParentId: catalog.GetPublicId()},
+		{name: "target", resourceId: tar.GetPublicId(), typ: resource.Target, wantScopeId: proj.GetPublicId()},
+		{name: "credential-store", resourceId: store.GetPublicId(), typ: resource.CredentialStore, wantScopeId: proj.GetPublicId()},
+		{name: "credential", resourceId: cred.GetPublicId(), typ: resource.Credential, wantScopeId: proj.GetPublicId(), wantParentId: store.GetPublicId()},
+		{name: "wrong-type", resourceId: tar.GetPublicId(), typ: resource.Host, wantErrCode: errors.RecordNotFound},
+		{name: "not-found", resourceId: "ttcp_1234567890", typ: resource.Target, wantErrCode: errors.RecordNotFound},
+		{name: "unsupported-type", resourceId: "w_1234567890", typ: resource.Worker, wantErrCode: errors.InvalidParameter},
+		{name: "missing-id", typ: resource.Target, wantErrCode: errors.InvalidParameter},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			scopeId, parentId, err := repo.LookupResourceScope(ctx, tt.resourceId, tt.typ)
+			if tt.wantErrCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantScopeId, scopeId)
+			assert.Equal(tt.wantParentId, parentId)
+		})
+	}
+}
@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 )
 
+// roleFilterColumns are the columns of the fields of a role which can be
+// used in the filter conditions of a list.
+var roleFilterColumns = map[string]string{
+	"name":     "name",
+	"scope_id": "scope_id",
+}
+
 // CreateRole will create a role in the repository and return the written
 // role.  No options are currently supported.
 func (r *Repository) CreateRole(ctx context.Context, role *Role, _ ...Option) (*Role, error) {
@@ -181,14 +188,16 @@ func (r *Repository) DeleteRole(ctx context.Context, withPublicId string, _ ...O
 	return rowsDeleted, nil
 }
 
-// ListRoles lists roles in the given scopes and supports WithLimit option.
+// ListRoles lists roles in the given scopes, ordered by public id, and
+// supports the WithLimit, WithStartPageAfterId and WithFilterConditions
+// options.
 func (r *Repository) ListRoles(ctx context.Context, withScopeIds []string, opt ...Option) ([]*Role, error) {
 	const op = "iam.(Repository).ListRoles"
 	if len(withScopeIds) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids")
 	}
 	var roles []*Role
-	err := r.list(ctx, &roles, "scope_id in (?)", []interface{}{withScopeIds}, opt...)
+	err := r.listByPublicId(ctx, &roles, "scope_id in (?)", []interface{}{withScopeIds}, roleFilterColumns, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -385,6 +385,9 @@ func (r *Repository) ListRoleGrants(ctx context.Context, roleId string, opt ...O
 	return roleGrants, nil
 }
 
+// GrantsForUser returns the grants of the roles assigned to the user, directly
+// or through groups and managed groups. Principal roles outside of their time
+// bounds are ignored; their conditions are returned with the grants.
 func (r *Repository) GrantsForUser(ctx context.Context, userId string, _ ...Option) ([]perms.GrantTuple, error) {
 	const op = "iam.(Repository).GrantsForUser"
 	if userId == "" {
@@ -419,51 +422,65 @@ user_managed_groups (id) as (
          user_accounts
    where member_id in (user_accounts.id)
 ),
-managed_group_roles (role_id) as (
-  select role_id
+managed_group_roles (role_id, condition) as (
+  select role_id,
+         condition
     from iam_managed_group_role,
          user_managed_groups
    where principal_id in (user_managed_groups.id)
+     and (not_before is null or not_before <= now())
+     and (not_after is null or not_after > now())
 ),
-group_roles (role_id) as (
-  select role_id
+group_roles (role_id, condition) as (
+  select role_id,
+         condition
     from iam_group_role,
          user_groups
    where principal_id in (user_groups.id)
+     and (not_before is null or not_before <= now())
+     and (not_after is null or not_after > now())
 ),
-user_roles (role_id) as (
-  select role_id
+user_roles (role_id, condition) as (
+  select role_id,
+         condition
     from iam_user_role,
          users
    where principal_id in (users.id)
+     and (not_before is null or not_before <= now())
+     and (not_after is null or not_after > now())
 ),
-user_group_roles (role_id) as (
-  select role_id
+user_group_roles (role_id, condition) as (
+  select role_id,
+         condition
     from group_roles
    union
-  select role_id
+  select role_id,
+         condition
     from user_roles
    union
-  select role_id
+  select role_id,
+         condition
     from managed_group_roles
 ),
-roles (role_id, grant_scope_id) as (
+roles (role_id, grant_scope_id, condition) as (
   select iam_role.public_id,
-         iam_role.grant_scope_id
+         iam_role.grant_scope_id,
+         user_group_roles.condition
     from iam_role,
          user_group_roles
    where public_id in (user_group_roles.role_id)
 ),
-final (role_id, role_scope, role_grant) as (
+final (role_id, role_scope, role_grant, condition) as (
   select roles.role_id,
          roles.grant_scope_id,
-         iam_role_grant.canonical_grant
+         iam_role_grant.canonical_grant,
+         coalesce(roles.condition, '')
     from roles
    inner
     join iam_role_grant
       on roles.role_id = iam_role_grant.role_id
 )
-select role_id as role_id, role_scope as scope_id, role_grant as grant from final;
+select role_id as role_id, role_scope as scope_id, role_grant as grant, condition as condition from final;
 	`
 	)
 
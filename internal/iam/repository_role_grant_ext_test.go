@@ -5,6 +5,7 @@ import (
 	"fmt"
 	mathrand "math/rand"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/db"
@@ -250,3 +251,51 @@ func TestGrantsForUser(t *testing.T) {
 		t.Log("finished user", user.PublicId, "total roles", len(expectedRoleIds), "roles from users", rolesFromUsers, "roles from groups", rolesFromGroups, "roles from managed groups", rolesFromManagedGroups)
 	}
 }
+
+func TestGrantsForUser_PrincipalRoleConstraints(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	o, _ := iam.TestScopes(
+		t,
+		iamRepo,
+		iam.WithSkipAdminRoleCreation(true),
+		iam.WithSkipDefaultRoleCreation(true),
+	)
+	user := iam.TestUser(t, iamRepo, o.GetPublicId())
+	group := iam.TestGroup(t, conn, o.GetPublicId())
+	iam.TestGroupMember(t, conn, group.PublicId, user.PublicId)
+
+	const condition = `"10.0.0.0/8" in "/request/client_networks"`
+	now := time.Now()
+
+	current := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, current.PublicId, "id=*;type=*;actions=read")
+	iam.TestUserRole(t, conn, current.PublicId, user.PublicId,
+		iam.WithNotBefore(now.Add(-time.Hour)),
+		iam.WithNotAfter(now.Add(time.Hour)),
+		iam.WithCondition(condition))
+
+	expired := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, expired.PublicId, "id=*;type=*;actions=update")
+	iam.TestGroupRole(t, conn, expired.PublicId, group.PublicId, iam.WithNotAfter(now.Add(-time.Minute)))
+
+	future := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, future.PublicId, "id=*;type=*;actions=delete")
+	iam.TestUserRole(t, conn, future.PublicId, user.PublicId, iam.WithNotBefore(now.Add(time.Hour)))
+
+	tuples, err := iamRepo.GrantsForUser(ctx, user.PublicId)
+	require.NoError(t, err)
+	var found bool
+	for _, tuple := range tuples {
+		assert.NotEqual(t, expired.PublicId, tuple.RoleId)
+		assert.NotEqual(t, future.PublicId, tuple.RoleId)
+		if tuple.RoleId == current.PublicId {
+			found = true
+			assert.Equal(t, condition, tuple.Condition)
+		}
+	}
+	assert.True(t, found)
+}
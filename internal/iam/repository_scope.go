@@ -16,6 +16,15 @@ import (
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 )
 
+// scopeFilterColumns are the columns of the fields of a scope which can be
+// used in the filter conditions of a list. The scope id of a scope is the id
+// of its parent.
+var scopeFilterColumns = map[string]string{
+	"name":     "name",
+	"type":     "type",
+	"scope_id": "parent_id",
+}
+
 // CreateScope will create a scope in the repository and return the written
 // scope. Supported options include: WithPublicId and WithRandomReader.
 func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, opt ...Option) (*Scope, error) {
@@ -452,14 +461,15 @@ func (r *Repository) DeleteScope(ctx context.Context, withPublicId string, _ ...
 	return rowsDeleted, nil
 }
 
-// ListScopes with the parent IDs, supports the WithLimit option.
+// ListScopes with the parent IDs, ordered by public id. Supports the
+// WithLimit, WithStartPageAfterId and WithFilterConditions options.
 func (r *Repository) ListScopes(ctx context.Context, withParentIds []string, opt ...Option) ([]*Scope, error) {
 	const op = "iam.(Repository).ListScopes"
 	if len(withParentIds) == 0 {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing parent id")
 	}
 	var items []*Scope
-	err := r.list(ctx, &items, "parent_id in (?)", []interface{}{withParentIds}, opt...)
+	err := r.listByPublicId(ctx, &items, "parent_id in (?)", []interface{}{withParentIds}, scopeFilterColumns, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -467,7 +477,9 @@ func (r *Repository) ListScopes(ctx context.Context, withParentIds []string, opt
 }
 
 // ListScopesRecursively allows for recursive listing of scopes based on a root scope
-// ID. It returns the root scope ID as a part of the set.
+// ID. It returns the root scope ID as a part of the set. The scopes are ordered
+// by public id and the WithLimit, WithStartPageAfterId and WithFilterConditions
+// options are supported.
 func (r *Repository) ListScopesRecursively(ctx context.Context, rootScopeId string, opt ...Option) ([]*Scope, error) {
 	const op = "iam.(Repository).ListRecursively"
 	var scopes []*Scope
@@ -489,7 +501,7 @@ func (r *Repository) ListScopesRecursively(ctx context.Context, rootScopeId stri
 		// We have no idea what scope type this is so bail
 		return nil, errors.New(ctx, errors.InvalidPublicId, op+":TypeSwitch", "invalid scope ID")
 	}
-	err := r.list(ctx, &scopes, where, args, opt...)
+	err := r.listByPublicId(ctx, &scopes, where, args, scopeFilterColumns, opt...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op+":ListQuery")
 	}
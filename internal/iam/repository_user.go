@@ -8,11 +8,19 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	dbcommon "github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
+// userFilterColumns are the columns of the fields of a user which can be
+// used in the filter conditions of a list.
+var userFilterColumns = map[string]string{
+	"name":     "name",
+	"scope_id": "scope_id",
+}
+
 // CreateUser will create a user in the repository and return the written user
 func (r *Repository) CreateUser(ctx context.Context, user *User, opt ...Option) (*User, error) {
 	const op = "iam.(Repository).CreateUser"
@@ -62,9 +70,10 @@ func (r *Repository) CreateUser(ctx context.Context, user *User, opt ...Option)
 // UpdateUser will update a user in the repository and return the written user
 // plus its associated account ids. fieldMaskPaths provides field_mask.proto
 // paths for fields that should be updated.  Fields will be set to NULL if the
-// field is a zero value and included in fieldMask. Name and Description are the
-// only updatable fields, if no updatable fields are included in the
-// fieldMaskPaths, then an error is returned.
+// field is a zero value and included in fieldMask. Name, Description and
+// Disabled are the only updatable fields, if no updatable fields are included
+// in the fieldMaskPaths, then an error is returned. The access of the user is
+// revoked when it is disabled.
 func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32, fieldMaskPaths []string, opt ...Option) (*User, []string, int, error) {
 	const op = "iam.(Repository).UpdateUser"
 	if user == nil {
@@ -73,10 +82,13 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 	if user.PublicId == "" {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
 	}
+	var disabling bool
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("name", f):
 		case strings.EqualFold("description", f):
+		case strings.EqualFold("disabled", f):
+			disabling = user.Disabled
 		default:
 			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -86,9 +98,10 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 		map[string]interface{}{
 			"name":        user.Name,
 			"description": user.Description,
+			"disabled":    user.Disabled,
 		},
 		fieldMaskPaths,
-		nil,
+		[]string{"disabled"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, db.NoRowsAffected, errors.E(ctx, errors.WithCode(errors.EmptyFieldMask), errors.WithOp(op))
@@ -143,6 +156,11 @@ func (r *Repository) UpdateUser(ctx context.Context, user *User, version uint32,
 				// return err, which will result in a rollback of the update
 				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
 			}
+			if disabling && r.accessRevoker != nil {
+				if err := r.accessRevoker.RevokeUserAccess(ctx, w, user.PublicId); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to revoke access of %s", user.PublicId)))
+				}
+			}
 			txRepo := &Repository{
 				reader: reader,
 				writer: w,
@@ -187,7 +205,8 @@ func (r *Repository) LookupUser(ctx context.Context, userId string, _ ...Option)
 	return user, currentAccountIds, nil
 }
 
-// DeleteUser will delete a user from the repository
+// DeleteUser will delete a user from the repository. The access of the user
+// is revoked within the same transaction.
 func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, _ ...Option) (int, error) {
 	const op = "iam.(Repository).DeleteUser"
 	if withPublicId == "" {
@@ -198,14 +217,46 @@ func (r *Repository) DeleteUser(ctx context.Context, withPublicId string, _ ...O
 	if err := r.reader.LookupByPublicId(ctx, &user); err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", withPublicId)))
 	}
-	rowsDeleted, err := r.delete(ctx, &user)
+	metadata, err := r.stdMetadata(ctx, &user)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("error getting metadata"))
+	}
+	metadata["op-type"] = []string{oplog.OpType_OP_TYPE_DELETE.String()}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, user.GetScopeId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if r.accessRevoker != nil {
+				if err := r.accessRevoker.RevokeUserAccess(ctx, w, withPublicId); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to revoke access of %s", withPublicId)))
+				}
+			}
+			rowsDeleted, err = w.Delete(ctx, user.Clone(), db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				// return err, which will result in a rollback of the delete
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
 	if err != nil {
 		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", withPublicId)))
 	}
 	return rowsDeleted, nil
 }
 
-// ListUsers lists users in the given scopes and supports the WithLimit option.
+// ListUsers lists users in the given scopes, ordered by public id, and
+// supports the WithLimit, WithStartPageAfterId, WithOffset and
+// WithFilterConditions options.
 func (r *Repository) ListUsers(ctx context.Context, withScopeIds []string, opt ...Option) ([]*User, error) {
 	const op = "iam.(Repository).ListUsers"
 	if len(withScopeIds) == 0 {
@@ -218,33 +269,69 @@ func (r *Repository) ListUsers(ctx context.Context, withScopeIds []string, opt .
 	return users, nil
 }
 
+// CountUsers returns the number of users in the given scopes, and supports
+// the WithFilterConditions option. It is used with the WithOffset option of
+// ListUsers to read the pages of a list by their position.
+func (r *Repository) CountUsers(ctx context.Context, withScopeIds []string, opt ...Option) (int, error) {
+	const op = "iam.(Repository).CountUsers"
+	if len(withScopeIds) == 0 {
+		return 0, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+	where, args, err := usersWhere(ctx, "", withScopeIds, opts)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	rows, err := r.reader.Query(ctx, "select count(*) from iam_user where "+where, args)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count int
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op)
+	}
+	return count, nil
+}
+
 // LookupUserWithLogin will attempt to lookup the user with a matching
 // account id and return the user if found. If a user is not found and the
 // account's scope is not the PrimaryAuthMethod, then an error is returned.
 // If the account's scope is the PrimaryAuthMethod, then a new iam User will be
 // created (autovivified) in the scope of the account, and associated with the
 // account. If a new user is auto vivified, then the WithName and
-// WithDescription options are supported as well.
+// WithDescription options are supported as well. An error is returned if the
+// account or its user is disabled.
 func (r *Repository) LookupUserWithLogin(ctx context.Context, accountId string, opt ...Option) (*User, error) {
 	const op = "iam.(Repository).LookupUserWithLogin"
 	if accountId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
 	}
+	acct := allocAccount()
+	acct.PublicId = accountId
+	err := r.reader.LookupByPublicId(ctx, &acct)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup account %s", accountId)))
+	}
+	if acct.Disabled {
+		return nil, errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("account %s is disabled", accountId))
+	}
 	u, err := r.getUserWithAccount(ctx, accountId)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	if u != nil {
+		if u.Disabled {
+			return nil, errors.New(ctx, errors.Forbidden, op, fmt.Sprintf("user %s is disabled", u.PublicId))
+		}
 		return u, nil
 	}
 
-	acct := allocAccount()
-	acct.PublicId = accountId
-	err = r.reader.LookupByPublicId(context.Background(), &acct)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup account %s", accountId)))
-	}
-
 	allowed, err := r.allowUserAutoVivify(ctx, &acct)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -342,6 +429,17 @@ func (r *Repository) allowUserAutoVivify(ctx context.Context, acct *authAccount)
 	return acct.AuthMethodId == acctScope.PrimaryAuthMethodId, nil
 }
 
+// LookupUserWithAccount returns the user associated with the account, or nil
+// if the account is not associated with a user. All options are ignored.
+func (r *Repository) LookupUserWithAccount(ctx context.Context, accountId string, _ ...Option) (*User, error) {
+	const op = "iam.(Repository).LookupUserWithAccount"
+	u, err := r.getUserWithAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return u, nil
+}
+
 func (r *Repository) getUserWithAccount(ctx context.Context, withAccountId string, _ ...Option) (*User, error) {
 	const op = "iam.(Repository).getUserWithAccount"
 	if withAccountId == "" {
@@ -652,6 +750,77 @@ func (r *Repository) SetUserAccounts(ctx context.Context, userId string, userVer
 	return currentAccountIds, nil
 }
 
+// SetAccountDisabled sets whether the account is disabled. Disabled accounts
+// cannot be used to authenticate, and the access of the user of the account
+// is revoked when it is disabled.
+func (r *Repository) SetAccountDisabled(ctx context.Context, accountId string, disabled bool, _ ...Option) error {
+	const op = "iam.(Repository).SetAccountDisabled"
+	if accountId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	acct := allocAccount()
+	acct.PublicId = accountId
+	if err := r.reader.LookupByPublicId(ctx, &acct); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to lookup account %s", accountId)))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, acct.GetScopeId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	metadata := oplog.Metadata{
+		"op-type":            []string{oplog.OpType_OP_TYPE_UPDATE.String()},
+		"resource-public-id": []string{accountId},
+		"scope-id":           []string{acct.ScopeId},
+		"scope-type":         []string{scope.Org.String()},
+		"resource-type":      []string{"auth-account"},
+	}
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			updateAcct := acct.Clone().(*authAccount)
+			updateAcct.Disabled = disabled
+			updatedRows, err := w.Update(ctx, updateAcct, []string{"Disabled"}, nil, db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if updatedRows != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("account update affected %d rows", updatedRows))
+			}
+			if disabled && r.accessRevoker != nil {
+				if err := r.accessRevoker.RevokeAccountAccess(ctx, w, accountId); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to revoke access of %s", accountId)))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("for %s", accountId)))
+	}
+	return nil
+}
+
+// ListDisabledAccounts returns the ids of the disabled accounts among
+// accountIds.
+func (r *Repository) ListDisabledAccounts(ctx context.Context, accountIds []string, _ ...Option) ([]string, error) {
+	const op = "iam.(Repository).ListDisabledAccounts"
+	if len(accountIds) == 0 {
+		return nil, nil
+	}
+	var accts []*authAccount
+	if err := r.reader.SearchWhere(ctx, &accts, "public_id in (?) and disabled", []interface{}{accountIds}, db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ids := make([]string, 0, len(accts))
+	for _, a := range accts {
+		ids = append(ids, a.GetPublicId())
+	}
+	return ids, nil
+}
+
 // associateUserWithAccounts will associate the accounts (accountIds) with
 // the user (userId) within the writer's database
 func associateUserWithAccounts(ctx context.Context, repoKms *kms.Kms, reader db.Reader, writer db.Writer, userId string, accountIds []string, _ ...Option) error {
@@ -875,18 +1044,14 @@ func (r *Repository) getUsers(ctx context.Context, userId string, scopeIds []str
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.withLimit
 	}
-	dbArgs = append(dbArgs, db.WithLimit(limit))
+	dbArgs = append(dbArgs, db.WithLimit(limit), db.WithOrder("public_id"), db.WithOffset(opts.withOffset))
 
-	var args []interface{}
-	var where []string
-	switch {
-	case userId != "":
-		where, args = append(where, "public_id = ?"), append(args, userId)
-	default:
-		where, args = append(where, "scope_id in(?)"), append(args, scopeIds)
+	where, args, err := usersWhere(ctx, userId, scopeIds, opts)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
 	}
 	var usersAcctInfo []*userAccountInfo
-	err := r.reader.SearchWhere(ctx, &usersAcctInfo, strings.Join(where, " and "), args, dbArgs...)
+	err = r.reader.SearchWhere(ctx, &usersAcctInfo, where, args, dbArgs...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -899,3 +1064,29 @@ func (r *Repository) getUsers(ctx context.Context, userId string, scopeIds []str
 	}
 	return users, nil
 }
+
+// usersWhere returns the where clause and its arguments selecting the user
+// userId or the users of scopeIds, which match the WithStartPageAfterId and
+// WithFilterConditions options.
+func usersWhere(ctx context.Context, userId string, scopeIds []string, opts options) (string, []interface{}, error) {
+	const op = "iam.usersWhere"
+	var args []interface{}
+	var where []string
+	switch {
+	case userId != "":
+		where, args = append(where, "public_id = ?"), append(args, userId)
+	default:
+		where, args = append(where, "scope_id in(?)"), append(args, scopeIds)
+	}
+	if opts.withStartPageAfterId != "" {
+		where, args = append(where, "public_id > ?"), append(args, opts.withStartPageAfterId)
+	}
+	if len(opts.withFilterConditions) > 0 {
+		filterWhere, filterArgs, err := filter.Where(opts.withFilterConditions, userFilterColumns)
+		if err != nil {
+			return "", nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		where, args = append(where, filterWhere), append(args, filterArgs...)
+	}
+	return strings.Join(where, " and "), args, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	dbassert "github.com/hashicorp/boundary/internal/db/assert"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
@@ -709,6 +710,92 @@ func TestRepository_ListUsers_Multiple_Scopes(t *testing.T) {
 	assert.Equal(t, total, len(got))
 }
 
+func TestRepository_ListUsers_FilterConditions(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, repo)
+
+	foo := iam.TestUser(t, repo, org.GetPublicId(), iam.WithName("foo"))
+	devFoo := iam.TestUser(t, repo, org.GetPublicId(), iam.WithName("dev-foo"))
+	// A filter evaluates the name of an unnamed user as "".
+	unnamed := iam.TestUser(t, repo, org.GetPublicId())
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `"/item/name" == "foo"`, want: []string{foo.PublicId}},
+		{filter: `"/item/name" == ""`, want: []string{unnamed.PublicId}},
+		{filter: `"/item/name" != "foo"`, want: []string{devFoo.PublicId, unnamed.PublicId}},
+		{filter: `"/item/name" != ""`, want: []string{foo.PublicId, devFoo.PublicId}},
+		{filter: `"foo" in "/item/name"`, want: []string{foo.PublicId, devFoo.PublicId}},
+		{filter: `"" in "/item/name"`, want: []string{foo.PublicId, devFoo.PublicId, unnamed.PublicId}},
+		{filter: `"/item/name" matches "^dev-"`, want: []string{devFoo.PublicId}},
+		{filter: `"/item/name" matches "^(dev-)?$"`, want: []string{unnamed.PublicId}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filter, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			conds, err := filter.Conditions(tt.filter, "name")
+			require.NoError(err)
+			require.Len(conds, 1)
+			got, err := repo.ListUsers(context.Background(), []string{org.GetPublicId()}, iam.WithFilterConditions(conds))
+			require.NoError(err)
+			var gotIds []string
+			for _, u := range got {
+				gotIds = append(gotIds, u.PublicId)
+			}
+			assert.ElementsMatch(tt.want, gotIds)
+		})
+	}
+}
+
+func TestRepository_ListUsers_Offset(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, repo)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ids = append(ids, iam.TestUser(t, repo, org.GetPublicId(), iam.WithName(fmt.Sprintf("user-%d", i))).PublicId)
+	}
+	sort.Strings(ids)
+
+	got, err := repo.ListUsers(ctx, []string{org.GetPublicId()}, iam.WithOffset(1), iam.WithLimit(3))
+	require.NoError(t, err)
+	var gotIds []string
+	for _, u := range got {
+		gotIds = append(gotIds, u.PublicId)
+	}
+	assert.Equal(t, ids[1:4], gotIds)
+
+	got, err = repo.ListUsers(ctx, []string{org.GetPublicId()}, iam.WithOffset(5))
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	count, err := repo.CountUsers(ctx, []string{org.GetPublicId()})
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	conds := []filter.Condition{{Field: "name", Operator: filter.EqualFoldOperator, Value: "USER-2"}}
+	count, err = repo.CountUsers(ctx, []string{org.GetPublicId()}, iam.WithFilterConditions(conds))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	got, err = repo.ListUsers(ctx, []string{org.GetPublicId()}, iam.WithFilterConditions(conds))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "user-2", got[0].Name)
+
+	_, err = repo.CountUsers(ctx, nil)
+	assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+}
+
 func TestRepository_LookupUserWithLogin(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -888,6 +975,117 @@ func TestRepository_LookupUserWithLogin(t *testing.T) {
 	}
 }
 
+// testAccessRevoker records the users and accounts whose access is revoked,
+// or fails the revocations with err if it is set.
+type testAccessRevoker struct {
+	users    []string
+	accounts []string
+	err      error
+}
+
+func (r *testAccessRevoker) RevokeUserAccess(_ context.Context, _ db.Writer, userId string) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.users = append(r.users, userId)
+	return nil
+}
+
+func (r *testAccessRevoker) RevokeAccountAccess(_ context.Context, _ db.Writer, accountId string) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.accounts = append(r.accounts, accountId)
+	return nil
+}
+
+func TestRepository_DisableUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	revoker := &testAccessRevoker{}
+	repo := iam.TestRepo(t, conn, wrapper, iam.WithAccessRevoker(revoker))
+	org, _ := iam.TestScopes(t, repo)
+	pwAms := password.TestAuthMethods(t, conn, org.PublicId, 1)
+	pwAcct := password.TestAccount(t, conn, pwAms[0].PublicId, "disabled")
+	u := iam.TestUser(t, repo, org.PublicId, iam.WithAccountIds(pwAcct.PublicId))
+
+	found, err := repo.LookupUserWithLogin(ctx, pwAcct.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, u.PublicId, found.PublicId)
+
+	// Updates without disabling the user do not revoke its access.
+	updated := u.Clone().(*iam.User)
+	updated.Name = "disabled"
+	updated, _, _, err = repo.UpdateUser(ctx, updated, u.Version, []string{"Name"})
+	require.NoError(t, err)
+	assert.False(t, updated.Disabled)
+	assert.Empty(t, revoker.users)
+
+	updated.Disabled = true
+	updated, _, _, err = repo.UpdateUser(ctx, updated, updated.Version, []string{"Disabled"})
+	require.NoError(t, err)
+	assert.True(t, updated.Disabled)
+	assert.Equal(t, []string{u.PublicId}, revoker.users)
+
+	_, err = repo.LookupUserWithLogin(ctx, pwAcct.PublicId)
+	assert.Truef(t, errors.Match(errors.T(errors.Forbidden), err), "unexpected error %v", err)
+
+	updated.Disabled = false
+	updated, _, _, err = repo.UpdateUser(ctx, updated, updated.Version, []string{"Disabled"})
+	require.NoError(t, err)
+	assert.False(t, updated.Disabled)
+	assert.Equal(t, []string{u.PublicId}, revoker.users)
+	_, err = repo.LookupUserWithLogin(ctx, pwAcct.PublicId)
+	require.NoError(t, err)
+
+	// Disabled accounts cannot be used to authenticate.
+	err = repo.SetAccountDisabled(ctx, "", true)
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+	require.NoError(t, repo.SetAccountDisabled(ctx, pwAcct.PublicId, true))
+	assert.Equal(t, []string{pwAcct.PublicId}, revoker.accounts)
+	_, err = repo.LookupUserWithLogin(ctx, pwAcct.PublicId)
+	assert.Truef(t, errors.Match(errors.T(errors.Forbidden), err), "unexpected error %v", err)
+	other := password.TestAccount(t, conn, pwAms[0].PublicId, "enabled")
+	disabledIds, err := repo.ListDisabledAccounts(ctx, []string{pwAcct.PublicId, other.PublicId})
+	require.NoError(t, err)
+	assert.Equal(t, []string{pwAcct.PublicId}, disabledIds)
+
+	require.NoError(t, repo.SetAccountDisabled(ctx, pwAcct.PublicId, false))
+	assert.Equal(t, []string{pwAcct.PublicId}, revoker.accounts)
+	disabledIds, err = repo.ListDisabledAccounts(ctx, []string{pwAcct.PublicId, other.PublicId})
+	require.NoError(t, err)
+	assert.Empty(t, disabledIds)
+	_, err = repo.LookupUserWithLogin(ctx, pwAcct.PublicId)
+	require.NoError(t, err)
+
+	found, err = repo.LookupUserWithAccount(ctx, pwAcct.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, u.PublicId, found.PublicId)
+
+	// Failing to revoke the access of the user or account rolls back the
+	// disabling.
+	revoker.err = errors.New(ctx, errors.Internal, "test", "revocation failed")
+	failed := updated.Clone().(*iam.User)
+	failed.Disabled = true
+	_, _, _, err = repo.UpdateUser(ctx, failed, updated.Version, []string{"Disabled"})
+	require.Error(t, err)
+	err = repo.SetAccountDisabled(ctx, pwAcct.PublicId, true)
+	require.Error(t, err)
+	found, err = repo.LookupUserWithLogin(ctx, pwAcct.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, u.PublicId, found.PublicId)
+	_, err = repo.DeleteUser(ctx, u.PublicId)
+	require.Error(t, err)
+	revoker.err = nil
+
+	// The access of deleted users is revoked.
+	_, err = repo.DeleteUser(ctx, u.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, []string{u.PublicId, u.PublicId}, revoker.users)
+}
+
 func TestRepository_AssociateAccounts(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -0,0 +1,471 @@
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/eventlogger"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+)
+
+// Sinks with audit integrity enabled chain the audit events they write: each
+// record carries its sequence number in the chain, the id of the audit key
+// and an hmac-sha256 keyed by the audit key over the chain value of the
+// previous record and the record itself. Removing, reordering or modifying a
+// record breaks the chain, which AuditChainVerifier detects.
+
+const (
+	AuditSeqField   = "auditseq"   // AuditSeqField is the sequence number of an audit event in its chain.
+	AuditKeyIdField = "auditkeyid" // AuditKeyIdField is the id of the audit key used to compute the chain value.
+	AuditChainField = "auditchain" // AuditChainField is the chain value of an audit event.
+
+	// auditChainInfo is the info used to derive the hmac key of the chain
+	// from the audit key.
+	auditChainInfo = "boundary-audit-chain"
+
+	// maxAuditRecordSize is the maximum size of a record read when verifying
+	// an audit chain.
+	maxAuditRecordSize = 16 * 1024 * 1024
+
+	// maxPendingAuditEvents is the maximum number of audit events a sink
+	// holds while waiting for its audit wrapper.
+	maxPendingAuditEvents = 10000
+)
+
+// auditChainSink is an eventlogger sink node which wraps the sink of a sink
+// config with audit integrity enabled. It chains the audit events before
+// passing them to the wrapped sink, and passes the other events as is.
+// Events are chained and written under a lock so the records are written in
+// the order of the chain.
+//
+// The audit wrapper of the controller is only known once its kms has been
+// reconciled, so the audit events processed before the sink has a wrapper are
+// held and chained when the wrapper is first set by Rotate.
+type auditChainSink struct {
+	sink   eventlogger.Node
+	format string
+
+	l       sync.Mutex
+	wrapper wrapping.Wrapper
+	seq     uint64
+	prev    string
+	pending []*eventlogger.Event
+}
+
+var _ eventlogger.Node = (*auditChainSink)(nil)
+
+func newAuditChainSink(sink eventlogger.Node, format SinkFormat, w wrapping.Wrapper) (*auditChainSink, error) {
+	const op = "event.newAuditChainSink"
+	if sink == nil {
+		return nil, fmt.Errorf("%s: missing sink: %w", op, ErrInvalidParameter)
+	}
+	switch format {
+	case JSONSinkFormat, JSONHclogSinkFormat:
+	default:
+		return nil, fmt.Errorf("%s: audit integrity is not supported with the %q format: %w", op, format, ErrInvalidParameter)
+	}
+	return &auditChainSink{
+		sink:    sink,
+		format:  string(format),
+		wrapper: w,
+	}, nil
+}
+
+// Process chains the event if it is an audit event and passes it to the
+// wrapped sink.
+func (s *auditChainSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(auditChainSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	if Type(e.Type) != AuditType {
+		return s.sink.Process(ctx, e)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not formatted as %s: %w", op, s.format, ErrInvalidParameter)
+	}
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.wrapper == nil {
+		if len(s.pending) >= maxPendingAuditEvents {
+			return nil, fmt.Errorf("%s: missing audit wrapper and too many pending audit events: %w", op, ErrInvalidParameter)
+		}
+		// The event may be shared with other pipelines, so a copy of it is
+		// held.
+		pending := &eventlogger.Event{
+			Type:      e.Type,
+			CreatedAt: e.CreatedAt,
+			Payload:   e.Payload,
+		}
+		pending.FormattedAs(s.format, val)
+		s.pending = append(s.pending, pending)
+		return nil, nil
+	}
+	return s.chain(ctx, e, val)
+}
+
+// chain writes the chained record of the formatted event val to the wrapped
+// sink. s.l must be held.
+func (s *auditChainSink) chain(ctx context.Context, e *eventlogger.Event, val []byte) (*eventlogger.Event, error) {
+	const op = "event.(auditChainSink).chain"
+	record, chain, err := chainAuditRecord(ctx, s.wrapper, s.seq+1, s.prev, val)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// The event may be shared with other pipelines, so the chained record
+	// is set on a copy of it.
+	chained := &eventlogger.Event{
+		Type:      e.Type,
+		CreatedAt: e.CreatedAt,
+		Payload:   e.Payload,
+	}
+	chained.FormattedAs(s.format, record)
+	ret, err := s.sink.Process(ctx, chained)
+	if err != nil {
+		return nil, err
+	}
+	s.seq++
+	s.prev = chain
+	return ret, nil
+}
+
+// Reopen reopens the wrapped sink.
+func (s *auditChainSink) Reopen() error {
+	return s.sink.Reopen()
+}
+
+// Type describes the type of the node as a Sink.
+func (s *auditChainSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// Rotate the wrapper used to compute the chain values. The audit events held
+// while the sink had no wrapper are chained and written.
+func (s *auditChainSink) Rotate(ctx context.Context, w wrapping.Wrapper) error {
+	const op = "event.(auditChainSink).Rotate"
+	if w == nil {
+		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
+	}
+	s.l.Lock()
+	defer s.l.Unlock()
+	s.wrapper = w
+	for len(s.pending) > 0 {
+		e := s.pending[0]
+		val, _ := e.Format(s.format)
+		if _, err := s.chain(ctx, e, val); err != nil {
+			return fmt.Errorf("%s: unable to write pending audit event: %w", op, err)
+		}
+		s.pending = s.pending[1:]
+	}
+	s.pending = nil
+	return nil
+}
+
+// resume continues the chain of the last chained record of the files, so
+// restarting doesn't start a new chain. The files are expected in the order
+// they have been written.
+func (s *auditChainSink) resume(files []string) error {
+	const op = "event.(auditChainSink).resume"
+	s.l.Lock()
+	defer s.l.Unlock()
+	for i := len(files) - 1; i >= 0; i-- {
+		found, err := s.resumeFile(files[i])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if found {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *auditChainSink) resumeFile(name string) (bool, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+	var found bool
+	err = scanAuditRecords(f, func(_ int, line []byte) error {
+		r, err := parseAuditRecord(line)
+		if err != nil || r.Chain == "" {
+			// Records which can't be parsed or aren't chained are reported
+			// by the verifier.
+			return nil
+		}
+		s.seq, s.prev, found = r.Seq, r.Chain, true
+		return nil
+	})
+	return found, err
+}
+
+// chainAuditRecord returns the record of the formatted event val with the
+// chain fields for the sequence number seq and the chain value prev of the
+// previous record, and the chain value of the record.
+func chainAuditRecord(ctx context.Context, w wrapping.Wrapper, seq uint64, prev string, val []byte) ([]byte, string, error) {
+	const op = "event.chainAuditRecord"
+	val = bytes.TrimSpace(val)
+	if len(val) < 2 || val[0] != '{' || val[len(val)-1] != '}' {
+		return nil, "", fmt.Errorf("%s: formatted event is not a json object: %w", op, ErrInvalidParameter)
+	}
+	keyId, err := json.Marshal(w.KeyID())
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(val[:len(val)-1])
+	if len(bytes.TrimSpace(val[1:len(val)-1])) > 0 {
+		buf.WriteByte(',')
+	}
+	fmt.Fprintf(&buf, `"%s":%d,"%s":%s`, AuditSeqField, seq, AuditKeyIdField, keyId)
+	chain, err := auditChainValue(ctx, w, prev, buf.Bytes())
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	fmt.Fprintf(&buf, `,"%s":"%s"}`+"\n", AuditChainField, chain)
+	return buf.Bytes(), chain, nil
+}
+
+// auditChainValue returns the chain value of the signed part of a record,
+// given the chain value of the previous record.
+func auditChainValue(ctx context.Context, w wrapping.Wrapper, prev string, signed []byte) (string, error) {
+	data := make([]byte, 0, len(prev)+1+len(signed))
+	data = append(data, prev...)
+	data = append(data, '\n')
+	data = append(data, signed...)
+	return crypto.HmacSha256(ctx, data, w, nil, []byte(auditChainInfo), crypto.WithBase64Encoding())
+}
+
+// auditRecord is the type and the chain fields of a record.
+type auditRecord struct {
+	Type   string `json:"type"`
+	Seq    uint64 `json:"auditseq"`
+	KeyId  string `json:"auditkeyid"`
+	Chain  string `json:"auditchain"`
+	signed []byte
+}
+
+// parseAuditRecord returns the type and the chain fields of the record line.
+// The chain fields are empty if the record is not chained.
+func parseAuditRecord(line []byte) (*auditRecord, error) {
+	const op = "event.parseAuditRecord"
+	var r auditRecord
+	if err := json.Unmarshal(line, &r); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if r.Chain == "" {
+		return &r, nil
+	}
+	i := bytes.LastIndex(line, []byte(`,"`+AuditChainField+`":"`))
+	if i < 0 || r.Seq == 0 || r.KeyId == "" {
+		return nil, fmt.Errorf("%s: malformed chain fields: %w", op, ErrInvalidParameter)
+	}
+	r.signed = line[:i]
+	return &r, nil
+}
+
+// scanAuditRecords calls fn with each non empty line of r and its number.
+func scanAuditRecords(r io.Reader, fn func(int, []byte) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxAuditRecordSize)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := fn(n, line); err != nil {
+			return err
+		}
+	}
+	return sc.Err()
+}
+
+// AuditFiles returns the files written by a file sink with the path and file
+// name, including the rotated files, in the order they have been written.
+func AuditFiles(path, fileName string) ([]string, error) {
+	const op = "event.AuditFiles"
+	if fileName == "" {
+		return nil, fmt.Errorf("%s: missing file name: %w", op, ErrInvalidParameter)
+	}
+	// Rotated files are named after the file name, with the unix nano time
+	// of their creation inserted before the extension.
+	ext := filepath.Ext(fileName)
+	if ext == "" {
+		ext = ".log"
+	}
+	prefix := strings.TrimSuffix(fileName, ext) + "-"
+	matches, err := filepath.Glob(filepath.Join(path, globEscape(prefix)+"*"+globEscape(ext)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	type rotated struct {
+		name string
+		ts   int64
+	}
+	var files []rotated
+	for _, m := range matches {
+		ts, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(filepath.Base(m), prefix), ext), 10, 64)
+		if err != nil {
+			continue
+		}
+		files = append(files, rotated{name: m, ts: ts})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].ts < files[j].ts })
+
+	ret := make([]string, 0, len(files)+1)
+	for _, f := range files {
+		ret = append(ret, f.name)
+	}
+	current := filepath.Join(path, fileName)
+	if _, err := os.Stat(current); err == nil {
+		ret = append(ret, current)
+	}
+	return ret, nil
+}
+
+func globEscape(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`)
+	return r.Replace(s)
+}
+
+// AuditKeyFunc returns the audit wrapper for the key id.
+type AuditKeyFunc func(ctx context.Context, keyId string) (wrapping.Wrapper, error)
+
+// AuditChainIssue is an issue found when verifying an audit chain.
+type AuditChainIssue struct {
+	File    string `json:"file"`          // File is the file of the record.
+	Line    int    `json:"line"`          // Line is the line number of the record in the file.
+	Seq     uint64 `json:"seq,omitempty"` // Seq is the sequence number of the record, if known.
+	Message string `json:"message"`       // Message describes the issue.
+}
+
+func (i *AuditChainIssue) String() string {
+	return fmt.Sprintf("%s:%d: %s", i.File, i.Line, i.Message)
+}
+
+// AuditChainVerifier verifies the chain of the audit events written by a sink
+// with audit integrity enabled. Files must be verified in the order they have
+// been written, using the same verifier.
+//
+// The verifier reports records which have been modified, missing records,
+// records out of order, restarts of the chain and audit events which are not
+// chained. As the last record of the
+// chain isn't covered by a later record, removing records at the end of the
+// chain is not detected.
+type AuditChainVerifier struct {
+	keyFn AuditKeyFunc
+	keys  map[string]wrapping.Wrapper
+
+	seq  uint64
+	prev string
+
+	// Records is the number of chained records verified.
+	Records int
+}
+
+// NewAuditChainVerifier returns a verifier getting the audit wrappers from
+// keyFn.
+func NewAuditChainVerifier(keyFn AuditKeyFunc) (*AuditChainVerifier, error) {
+	const op = "event.NewAuditChainVerifier"
+	if keyFn == nil {
+		return nil, fmt.Errorf("%s: missing key function: %w", op, ErrInvalidParameter)
+	}
+	return &AuditChainVerifier{
+		keyFn: keyFn,
+		keys:  map[string]wrapping.Wrapper{},
+	}, nil
+}
+
+// Verify the records read from r, named name in the issues returned. Audit
+// events which are not chained are reported, other records which are not
+// chained, such as observation events, are ignored.
+func (v *AuditChainVerifier) Verify(ctx context.Context, name string, r io.Reader) ([]*AuditChainIssue, error) {
+	const op = "event.(AuditChainVerifier).Verify"
+	var issues []*AuditChainIssue
+	err := scanAuditRecords(r, func(n int, line []byte) error {
+		issue := func(seq uint64, format string, a ...interface{}) {
+			issues = append(issues, &AuditChainIssue{File: name, Line: n, Seq: seq, Message: fmt.Sprintf(format, a...)})
+		}
+		rec, err := parseAuditRecord(line)
+		if err != nil {
+			issue(0, "unable to parse record: %s", err)
+			return nil
+		}
+		if rec.Chain == "" {
+			if Type(rec.Type) == AuditType {
+				issue(0, "audit event is not chained")
+			}
+			return nil
+		}
+		v.Records++
+
+		verify := true
+		switch {
+		case v.seq == 0 && rec.Seq != 1:
+			// Earlier records may have been pruned by rotation, in which
+			// case the chain value of the previous record is not known.
+			issue(rec.Seq, "chain starts at sequence %d, events 1 to %d are missing", rec.Seq, rec.Seq-1)
+			verify = false
+		case v.seq != 0 && rec.Seq == 1:
+			issue(rec.Seq, "chain restarted after sequence %d", v.seq)
+			v.prev = ""
+		case v.seq != 0 && rec.Seq <= v.seq:
+			issue(rec.Seq, "sequence %d is out of order or duplicated after sequence %d", rec.Seq, v.seq)
+			verify = false
+		case v.seq != 0 && rec.Seq > v.seq+1:
+			issue(rec.Seq, "gap in chain, events %d to %d are missing", v.seq+1, rec.Seq-1)
+			verify = false
+		}
+		if verify {
+			w, err := v.key(ctx, rec.KeyId)
+			if err != nil {
+				return fmt.Errorf("unable to get audit key %s: %w", rec.KeyId, err)
+			}
+			want, err := auditChainValue(ctx, w, v.prev, rec.signed)
+			if err != nil {
+				return err
+			}
+			if !hmac.Equal([]byte(want), []byte(rec.Chain)) {
+				issue(rec.Seq, "event %d has been modified", rec.Seq)
+			}
+		}
+		v.seq = rec.Seq
+		v.prev = rec.Chain
+		return nil
+	})
+	if err != nil {
+		return issues, fmt.Errorf("%s: %w", op, err)
+	}
+	return issues, nil
+}
+
+func (v *AuditChainVerifier) key(ctx context.Context, keyId string) (wrapping.Wrapper, error) {
+	if w, ok := v.keys[keyId]; ok {
+		return w, nil
+	}
+	w, err := v.keyFn(ctx, keyId)
+	if err != nil {
+		return nil, err
+	}
+	v.keys[keyId] = w
+	return w, nil
+}
@@ -0,0 +1,364 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/eventlogger/sinks/writer"
+	"github.com/hashicorp/go-hclog"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAuditChainEvent(typ Type, id string) *eventlogger.Event {
+	e := &eventlogger.Event{
+		Type:      eventlogger.EventType(typ),
+		CreatedAt: time.Now(),
+	}
+	e.FormattedAs(string(JSONSinkFormat), []byte(fmt.Sprintf(`{"id":%q,"type":%q}`+"\n", id, typ)))
+	return e
+}
+
+func testAuditKeyFunc(wrappers ...wrapping.Wrapper) AuditKeyFunc {
+	return func(_ context.Context, keyId string) (wrapping.Wrapper, error) {
+		for _, w := range wrappers {
+			if w.KeyID() == keyId {
+				return w, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key %s", keyId)
+	}
+}
+
+func Test_auditChainSink(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	w1, w2 := crypto.TestWrapper(t), crypto.TestWrapper(t)
+
+	// writeChain writes 4 audit events and an observation event, rotating the
+	// wrapper after the second audit event.
+	writeChain := func(t *testing.T) []string {
+		t.Helper()
+		var buf bytes.Buffer
+		s, err := newAuditChainSink(&writer.Sink{Format: string(JSONSinkFormat), Writer: &buf}, JSONSinkFormat, w1)
+		require.NoError(t, err)
+		for i := 1; i <= 4; i++ {
+			if i == 3 {
+				require.NoError(t, s.Rotate(ctx, w2))
+				_, err = s.Process(ctx, testAuditChainEvent(ObservationType, "observation"))
+				require.NoError(t, err)
+			}
+			_, err = s.Process(ctx, testAuditChainEvent(AuditType, fmt.Sprintf("audit-%d", i)))
+			require.NoError(t, err)
+		}
+		return strings.SplitAfter(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	}
+	verify := func(t *testing.T, lines []string) ([]*AuditChainIssue, *AuditChainVerifier) {
+		t.Helper()
+		v, err := NewAuditChainVerifier(testAuditKeyFunc(w1, w2))
+		require.NoError(t, err)
+		issues, err := v.Verify(ctx, "test", strings.NewReader(strings.Join(lines, "")))
+		require.NoError(t, err)
+		return issues, v
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		lines := writeChain(t)
+		require.Len(lines, 5)
+		assert.Contains(lines[0], `{"id":"audit-1","type":"audit","auditseq":1,"auditkeyid":`)
+		assert.Equal(`{"id":"observation","type":"observation"}`+"\n", lines[2])
+		assert.Contains(lines[4], `"auditseq":4,"auditkeyid":"`+w2.KeyID()+`"`)
+
+		issues, v := verify(t, lines)
+		assert.Empty(issues)
+		assert.Equal(4, v.Records)
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		assert := assert.New(t)
+		lines := writeChain(t)
+		lines[1] = strings.Replace(lines[1], "audit-2", "audit-X", 1)
+		issues, _ := verify(t, lines)
+		if assert.Len(issues, 1) {
+			assert.Equal(2, issues[0].Line)
+			assert.Equal("event 2 has been modified", issues[0].Message)
+		}
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		assert := assert.New(t)
+		lines := writeChain(t)
+		lines = append(lines[:1], lines[2:]...)
+		issues, _ := verify(t, lines)
+		if assert.Len(issues, 1) {
+			assert.Equal("gap in chain, events 2 to 2 are missing", issues[0].Message)
+		}
+	})
+
+	t.Run("reordered", func(t *testing.T) {
+		assert := assert.New(t)
+		lines := writeChain(t)
+		lines[0], lines[1] = lines[1], lines[0]
+		issues, _ := verify(t, lines)
+		if assert.Len(issues, 3) {
+			assert.Equal("chain starts at sequence 2, events 1 to 1 are missing", issues[0].Message)
+			assert.Equal("chain restarted after sequence 2", issues[1].Message)
+			assert.Equal("gap in chain, events 2 to 2 are missing", issues[2].Message)
+		}
+	})
+
+	t.Run("truncated-start", func(t *testing.T) {
+		assert := assert.New(t)
+		lines := writeChain(t)
+		issues, _ := verify(t, lines[1:])
+		if assert.Len(issues, 1) {
+			assert.Equal(uint64(2), issues[0].Seq)
+		}
+	})
+
+	t.Run("truncated-end", func(t *testing.T) {
+		// Removing records at the end of the chain is not detected.
+		assert := assert.New(t)
+		lines := writeChain(t)
+		issues, v := verify(t, lines[:len(lines)-1])
+		assert.Empty(issues)
+		assert.Equal(3, v.Records)
+	})
+
+	t.Run("unchained", func(t *testing.T) {
+		assert := assert.New(t)
+		lines := writeChain(t)
+		lines = append(lines[:2], append([]string{`{"id":"audit-X","type":"audit"}` + "\n"}, lines[2:]...)...)
+		issues, v := verify(t, lines)
+		if assert.Len(issues, 1) {
+			assert.Equal(3, issues[0].Line)
+			assert.Equal("audit event is not chained", issues[0].Message)
+		}
+		assert.Equal(4, v.Records)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		assert := assert.New(t)
+		lines := writeChain(t)
+		lines[1] = lines[1][:len(lines[1])/2] + "\n"
+		issues, _ := verify(t, lines)
+		if assert.Len(issues, 2) {
+			assert.Contains(issues[0].Message, "unable to parse record")
+			assert.Equal("gap in chain, events 2 to 2 are missing", issues[1].Message)
+		}
+	})
+
+	t.Run("missing-wrapper", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		var buf bytes.Buffer
+		s, err := newAuditChainSink(&writer.Sink{Format: string(JSONSinkFormat), Writer: &buf}, JSONSinkFormat, nil)
+		require.NoError(err)
+		for i := 1; i <= 2; i++ {
+			_, err = s.Process(ctx, testAuditChainEvent(AuditType, fmt.Sprintf("audit-%d", i)))
+			require.NoError(err)
+		}
+		assert.Empty(buf.String())
+
+		require.NoError(s.Rotate(ctx, w1))
+		_, err = s.Process(ctx, testAuditChainEvent(AuditType, "audit-3"))
+		require.NoError(err)
+		lines := strings.SplitAfter(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		require.Len(lines, 3)
+		assert.Contains(lines[0], `{"id":"audit-1","type":"audit","auditseq":1,`)
+		issues, v := verify(t, lines)
+		assert.Empty(issues)
+		assert.Equal(3, v.Records)
+	})
+
+	t.Run("too-many-pending", func(t *testing.T) {
+		require := require.New(t)
+		s, err := newAuditChainSink(&writer.Sink{Format: string(JSONSinkFormat), Writer: ioutil.Discard}, JSONSinkFormat, nil)
+		require.NoError(err)
+		for i := 0; i < maxPendingAuditEvents; i++ {
+			_, err = s.Process(ctx, testAuditChainEvent(AuditType, "audit"))
+			require.NoError(err)
+		}
+		_, err = s.Process(ctx, testAuditChainEvent(AuditType, "audit"))
+		require.ErrorIs(err, ErrInvalidParameter)
+	})
+
+	t.Run("unsupported-format", func(t *testing.T) {
+		_, err := newAuditChainSink(&writer.Sink{Writer: ioutil.Discard}, TextSinkFormat, w1)
+		require.ErrorIs(t, err, ErrInvalidParameter)
+	})
+}
+
+func Test_auditChainSinkResume(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := crypto.TestWrapper(t)
+	dir := t.TempDir()
+
+	// Each sink writes to a new rotated file, as they would across restarts
+	// of a file sink with rotation enabled.
+	for i := 0; i < 3; i++ {
+		fs := &eventlogger.FileSink{
+			Format:   string(JSONSinkFormat),
+			Path:     dir,
+			FileName: "audit.log",
+			MaxBytes: 1024 * 1024,
+		}
+		s, err := newAuditChainSink(fs, JSONSinkFormat, w)
+		require.NoError(err)
+		files, err := AuditFiles(dir, "audit.log")
+		require.NoError(err)
+		require.Len(files, i)
+		require.NoError(s.resume(files))
+		assert.Equal(uint64(i*2), s.seq)
+		for j := 0; j < 2; j++ {
+			_, err = s.Process(ctx, testAuditChainEvent(AuditType, "audit"))
+			require.NoError(err)
+		}
+		// Ensure the next file has a different timestamp.
+		time.Sleep(time.Millisecond)
+	}
+
+	files, err := AuditFiles(dir, "audit.log")
+	require.NoError(err)
+	require.Len(files, 3)
+	v, err := NewAuditChainVerifier(testAuditKeyFunc(w))
+	require.NoError(err)
+	for _, name := range files {
+		f, err := os.Open(name)
+		require.NoError(err)
+		issues, err := v.Verify(ctx, name, f)
+		f.Close()
+		require.NoError(err)
+		assert.Empty(issues)
+	}
+	assert.Equal(6, v.Records)
+}
+
+func TestAuditFiles(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	dir := t.TempDir()
+	for _, name := range []string{"audit-200.log", "audit-1000.log", "audit.log", "audit-x.log", "other-100.log", "audit-100.json"} {
+		require.NoError(ioutil.WriteFile(filepath.Join(dir, name), nil, 0o600))
+	}
+	files, err := AuditFiles(dir, "audit.log")
+	require.NoError(err)
+	assert.Equal([]string{
+		filepath.Join(dir, "audit-200.log"),
+		filepath.Join(dir, "audit-1000.log"),
+		filepath.Join(dir, "audit.log"),
+	}, files)
+
+	_, err = AuditFiles(dir, "")
+	assert.ErrorIs(err, ErrInvalidParameter)
+}
+
+func TestEventer_auditIntegrity(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := crypto.TestWrapper(t)
+	dir := t.TempDir()
+
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:        "audit",
+				Type:        FileSink,
+				Format:      JSONSinkFormat,
+				EventTypes:  []Type{AuditType},
+				FileConfig:  &FileSinkTypeConfig{Path: dir, FileName: "audit.log"},
+				AuditConfig: &AuditConfig{IntegrityEnabled: true},
+			},
+		},
+	}
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+	})
+	e, err := NewEventer(testLogger, testLock, "TestEventer_auditIntegrity", c, WithAuditWrapper(w))
+	require.NoError(err)
+	require.Len(e.auditPipelines, 1)
+	assert.True(e.auditPipelines[0].sinkConfig.AuditConfig.IntegrityEnabled)
+
+	for i := 0; i < 2; i++ {
+		a, err := newAudit("test", WithRequestInfo(&RequestInfo{Id: fmt.Sprintf("req-%d", i)}), WithFlush())
+		require.NoError(err)
+		require.NoError(e.writeAudit(ctx, a))
+	}
+
+	v, err := NewAuditChainVerifier(testAuditKeyFunc(w))
+	require.NoError(err)
+	f, err := os.Open(filepath.Join(dir, "audit.log"))
+	require.NoError(err)
+	defer f.Close()
+	issues, err := v.Verify(ctx, f.Name(), f)
+	require.NoError(err)
+	assert.Empty(issues)
+	assert.Equal(2, v.Records)
+}
+
+func TestEventer_auditIntegrityWithoutWrapper(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	w := crypto.TestWrapper(t)
+	dir := t.TempDir()
+
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:        "audit",
+				Type:        FileSink,
+				Format:      JSONSinkFormat,
+				EventTypes:  []Type{AuditType},
+				FileConfig:  &FileSinkTypeConfig{Path: dir, FileName: "audit.log"},
+				AuditConfig: &AuditConfig{IntegrityEnabled: true},
+			},
+		},
+	}
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+	})
+	e, err := NewEventer(testLogger, testLock, "TestEventer_auditIntegrityWithoutWrapper", c)
+	require.NoError(err)
+
+	// The events emitted before the eventer has an audit wrapper are held
+	// until it's rotated in.
+	a, err := newAudit("test", WithRequestInfo(&RequestInfo{Id: "req-0"}), WithFlush())
+	require.NoError(err)
+	require.NoError(e.writeAudit(ctx, a))
+	_, err = os.Stat(filepath.Join(dir, "audit.log"))
+	assert.True(os.IsNotExist(err))
+
+	require.NoError(e.RotateAuditWrapper(ctx, w))
+	a, err = newAudit("test", WithRequestInfo(&RequestInfo{Id: "req-1"}), WithFlush())
+	require.NoError(err)
+	require.NoError(e.writeAudit(ctx, a))
+
+	v, err := NewAuditChainVerifier(testAuditKeyFunc(w))
+	require.NoError(err)
+	f, err := os.Open(filepath.Join(dir, "audit.log"))
+	require.NoError(err)
+	defer f.Close()
+	issues, err := v.Verify(ctx, f.Name(), f)
+	require.NoError(err)
+	assert.Empty(issues)
+	assert.Equal(2, v.Records)
+}
@@ -12,6 +12,11 @@ type AuditConfig struct {
 	// FilterOperations to be applied to DataClassifications.
 	FilterOverrides AuditFilterOperations `hcl:"audit_filter_overrides"`
 
+	// IntegrityEnabled specifies if the audit events written to the sink are
+	// chained, so that modified or missing events can be detected.
+	// Requires a cloudevents-json or hclog-json format.
+	IntegrityEnabled bool `hcl:"integrity_enabled"`
+
 	// wrapper to use for audit event crypto operations.
 	wrapper wrapping.Wrapper
 }
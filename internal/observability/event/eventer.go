@@ -210,6 +210,10 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 	// reused.
 	allSinkFilenames := map[string]bool{}
 
+	// webhook sinks are flushed after the gated filters, so the events flushed
+	// by the filters are sent along.
+	var webhookNodes []flushable
+
 	for _, s := range c.Sinks {
 		fmtId, fmtNode, err := newFmtFilterNode(serverName, *s, opt...)
 		e.auditWrapperNodes = append(e.auditWrapperNodes, fmtNode)
@@ -253,13 +257,31 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			sinkId = eventlogger.NodeID(id)
+		case WebhookSink:
+			webhookNode, err := newWebhookSink(s.WebhookConfig, log)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			webhookNodes = append(webhookNodes, webhookNode)
+			sinkNode = webhookNode
+			id, err := NewId("webhook")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
+		case SyslogSink:
+			sinkNode, err = newSyslogSink(s.SyslogConfig, s.Format)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			id, err := NewId("syslog")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
 		default:
 			return nil, fmt.Errorf("%s: unknown sink type %s", op, s.Type)
 		}
-		err = e.broker.RegisterNode(sinkId, sinkNode)
-		if err != nil {
-			return nil, fmt.Errorf("%s: failed to register sink node %s: %w", op, sinkId, err)
-		}
 		var addToAudit, addToObservation, addToErr, addToSys bool
 		for _, t := range s.EventTypes {
 			switch t {
@@ -278,15 +300,39 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				addToSys = true
 			}
 		}
+		if addToAudit && s.AuditConfig != nil && s.AuditConfig.IntegrityEnabled {
+			chainNode, err := newAuditChainSink(sinkNode, s.Format, opts.withAuditWrapper)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			if s.Type == FileSink {
+				files, err := AuditFiles(s.FileConfig.Path, s.FileConfig.FileName)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				if err := chainNode.resume(files); err != nil {
+					return nil, fmt.Errorf("%s: unable to resume audit chain: %w", op, err)
+				}
+			}
+			e.auditWrapperNodes = append(e.auditWrapperNodes, chainNode)
+			sinkNode = chainNode
+		}
+		err = e.broker.RegisterNode(sinkId, sinkNode)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to register sink node %s: %w", op, sinkId, err)
+		}
 		if addToAudit {
 			var fop AuditFilterOperations
+			var integrity bool
 			if s.AuditConfig != nil {
 				fop = s.AuditConfig.FilterOverrides
+				integrity = s.AuditConfig.IntegrityEnabled
 			}
 			s.AuditConfig, err = NewAuditConfig(WithAuditWrapper(opts.withAuditWrapper), WithFilterOperations(fop))
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
+			s.AuditConfig.IntegrityEnabled = integrity
 			encryptFilter, err := NewAuditEncryptFilter(opt...)
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
@@ -462,6 +508,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		return nil, fmt.Errorf("%s: failed to set success threshold for sysevents: %w", op, err)
 	}
 
+	e.flushableNodes = append(e.flushableNodes, webhookNodes...)
 	e.auditPipelines = append(e.auditPipelines, auditPipelines...)
 	e.errPipelines = append(e.errPipelines, errPipelines...)
 	e.observationPipelines = append(e.observationPipelines, observationPipelines...)
@@ -550,6 +597,10 @@ func (e *Eventer) RotateAuditWrapper(ctx context.Context, newWrapper wrapping.Wr
 			w.Rotate(newWrapper)
 		case *encrypt.Filter:
 			w.Rotate(encrypt.WithWrapper(newWrapper))
+		case *auditChainSink:
+			if err := w.Rotate(ctx, newWrapper); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
 		default:
 			return fmt.Errorf("%s: unsupported node type (%s): %w", op, reflect.TypeOf(w), ErrInvalidParameter)
 		}
@@ -1,23 +1,30 @@
 package event
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
 	"time"
 )
 
 // SinkConfig defines the configuration for a Eventer sink
 type SinkConfig struct {
-	Name           string                `hcl:"name"`             // Name defines a name for the sink.
-	Description    string                `hcl:"description"`      // Description defines a description for the sink.
-	EventTypes     []Type                `hcl:"event_types"`      // EventTypes defines a list of event types that will be sent to the sink. See the docs for EventTypes for a list of accepted values.
-	EventSourceUrl string                `hcl:"event_source_url"` // EventSource defines an optional event source URL for the sink.  If not defined a default source will be composed of the https://hashicorp.com/boundary.io/ServerName/Path/FileName.
-	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
-	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
-	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink or FileSink).
-	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
-	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
-	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
+	Name           string                 `hcl:"name"`             // Name defines a name for the sink.
+	Description    string                 `hcl:"description"`      // Description defines a description for the sink.
+	EventTypes     []Type                 `hcl:"event_types"`      // EventTypes defines a list of event types that will be sent to the sink. See the docs for EventTypes for a list of accepted values.
+	EventSourceUrl string                 `hcl:"event_source_url"` // EventSource defines an optional event source URL for the sink.  If not defined a default source will be composed of the https://hashicorp.com/boundary.io/ServerName/Path/FileName.
+	AllowFilters   []string               `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
+	DenyFilters    []string               `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
+	Format         SinkFormat             `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
+	Type           SinkType               `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, WebhookSink or SyslogSink).
+	StderrConfig   *StderrSinkTypeConfig  `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
+	FileConfig     *FileSinkTypeConfig    `hcl:"file"`             // FileConfig defines parameters for a file output.
+	WebhookConfig  *WebhookSinkTypeConfig `hcl:"webhook"`          // WebhookConfig defines parameters for a webhook output.
+	SyslogConfig   *SyslogSinkTypeConfig  `hcl:"syslog"`           // SyslogConfig defines parameters for a syslog output.
+	AuditConfig    *AuditConfig           `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
 }
 
 func (sc *SinkConfig) Validate() error {
@@ -36,6 +43,12 @@ func (sc *SinkConfig) Validate() error {
 	if sc.FileConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.WebhookConfig != nil {
+		foundSinkTypeConfigs++
+	}
+	if sc.SyslogConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if foundSinkTypeConfigs > 1 {
 		return fmt.Errorf("%s: too many sink type config blocks: %w", op, ErrInvalidParameter)
 	}
@@ -56,6 +69,23 @@ func (sc *SinkConfig) Validate() error {
 		if sc.FileConfig.FileName == "" {
 			return fmt.Errorf("%s: missing file name: %w", op, ErrInvalidParameter)
 		}
+	case WebhookSink:
+		if sc.WebhookConfig == nil {
+			return fmt.Errorf(`%s: missing "webhook" block: %w`, op, ErrInvalidParameter)
+		}
+		if sc.Format != JSONSinkFormat {
+			return fmt.Errorf("%s: webhook sinks only support the %s format: %w", op, JSONSinkFormat, ErrInvalidParameter)
+		}
+		if err := sc.WebhookConfig.validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case SyslogSink:
+		if sc.SyslogConfig == nil {
+			return fmt.Errorf(`%s: missing "syslog" block: %w`, op, ErrInvalidParameter)
+		}
+		if err := sc.SyslogConfig.validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 	if sc.Name == "" {
 		return fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
@@ -77,6 +107,13 @@ func (sc *SinkConfig) Validate() error {
 				return fmt.Errorf("%s: invalid audit config: %w", op, err)
 			}
 		}
+		if (et == AuditType || et == EveryType) && sc.AuditConfig != nil && sc.AuditConfig.IntegrityEnabled {
+			switch sc.Format {
+			case JSONSinkFormat, JSONHclogSinkFormat:
+			default:
+				return fmt.Errorf("%s: invalid audit config: integrity requires the %s or %s format: %w", op, JSONSinkFormat, JSONHclogSinkFormat, ErrInvalidParameter)
+			}
+		}
 	}
 
 	return nil
@@ -95,6 +132,82 @@ type FileSinkTypeConfig struct {
 	RotateMaxFiles    int           `hcl:"rotate_max_files" mapstructure:"rotate_max_files"` // RotateMaxFiles defines how may historical rotated files should be kept for a FileSink
 }
 
+// WebhookSinkTypeConfig contains configuration structures for webhook sink types
+type WebhookSinkTypeConfig struct {
+	Url               string            `hcl:"url"                mapstructure:"url"`                // Url defines the HTTP(S) endpoint the batches of events are posted to
+	Headers           map[string]string `hcl:"headers"            mapstructure:"headers"`            // Headers defines additional headers sent with each request
+	BatchSize         int               `hcl:"batch_size"         mapstructure:"batch_size"`         // BatchSize defines the maximum number of events posted in a single request
+	FlushInterval     time.Duration     `mapstructure:"flush_interval"`                              // FlushInterval defines how long events are batched before being posted
+	FlushIntervalHCL  string            `hcl:"flush_interval" json:"-"`                              // FlushIntervalHCL defines hcl string version of FlushInterval
+	Timeout           time.Duration     `hcl:"-" mapstructure:"timeout"`                             // Timeout defines the timeout of each request
+	TimeoutHCL        string            `hcl:"timeout" json:"-"`                                     // TimeoutHCL defines hcl string version of Timeout
+	MaxRetries        *int              `hcl:"max_retries"        mapstructure:"max_retries"`        // MaxRetries defines how many times a failed request is retried
+	DeliveryGuarantee DeliveryGuarantee `hcl:"delivery_guarantee" mapstructure:"delivery_guarantee"` // DeliveryGuarantee defines whether sending an event waits for its batch to be delivered
+	TlsCaCert         string            `hcl:"tls_ca_cert"        mapstructure:"tls_ca_cert"`        // TlsCaCert defines the path of a PEM encoded CA certificate used to verify the endpoint
+	TlsSkipVerify     bool              `hcl:"tls_skip_verify"    mapstructure:"tls_skip_verify"`    // TlsSkipVerify disables the verification of the certificate of the endpoint
+}
+
+func (c *WebhookSinkTypeConfig) validate() error {
+	const op = "event.(WebhookSinkTypeConfig).validate"
+	if c.Url == "" {
+		return fmt.Errorf("%s: missing url: %w", op, ErrInvalidParameter)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return fmt.Errorf("%s: invalid url %q: %w", op, c.Url, ErrInvalidParameter)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%s: url %q must use the http or https scheme: %w", op, c.Url, ErrInvalidParameter)
+	}
+	if c.BatchSize < 0 {
+		return fmt.Errorf("%s: batch size must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.FlushInterval < 0 {
+		return fmt.Errorf("%s: flush interval must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.MaxRetries != nil && *c.MaxRetries < 0 {
+		return fmt.Errorf("%s: max retries must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if err := c.DeliveryGuarantee.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// SyslogSinkTypeConfig contains configuration structures for syslog sink types
+type SyslogSinkTypeConfig struct {
+	Network       string `hcl:"network"         mapstructure:"network"`         // Network defines the transport to the syslog server (udp, tcp or tls)
+	Address       string `hcl:"address"         mapstructure:"address"`         // Address defines the host:port of the syslog server
+	Facility      string `hcl:"facility"        mapstructure:"facility"`        // Facility defines the syslog facility of the messages
+	AppName       string `hcl:"app_name"        mapstructure:"app_name"`        // AppName defines the APP-NAME of the messages
+	TlsCaCert     string `hcl:"tls_ca_cert"     mapstructure:"tls_ca_cert"`     // TlsCaCert defines the path of a PEM encoded CA certificate used to verify the server when using tls
+	TlsSkipVerify bool   `hcl:"tls_skip_verify" mapstructure:"tls_skip_verify"` // TlsSkipVerify disables the verification of the certificate of the server when using tls
+}
+
+func (c *SyslogSinkTypeConfig) validate() error {
+	const op = "event.(SyslogSinkTypeConfig).validate"
+	switch c.Network {
+	case "", "udp", "tcp", "tls":
+	default:
+		return fmt.Errorf("%s: %q is not a valid syslog network: %w", op, c.Network, ErrInvalidParameter)
+	}
+	if c.Address == "" {
+		return fmt.Errorf("%s: missing address: %w", op, ErrInvalidParameter)
+	}
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("%s: invalid address %q: %w", op, c.Address, ErrInvalidParameter)
+	}
+	if c.Facility != "" {
+		if _, ok := syslogFacilities[c.Facility]; !ok {
+			return fmt.Errorf("%s: %q is not a valid syslog facility: %w", op, c.Facility, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
 // FilterType defines a type for filters (allow or deny)
 type FilterType string
 
@@ -123,3 +236,26 @@ func (s SinkFilter) Validate() error {
 	}
 	return nil
 }
+
+// sinkTlsConfig returns the TLS configuration of the connections of a sink to
+// serverName, trusting the CA certificate in the PEM file caCert if set.
+func sinkTlsConfig(caCert, serverName string, skipVerify bool) (*tls.Config, error) {
+	const op = "event.sinkTlsConfig"
+	tlsConfig := &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: skipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if caCert != "" {
+		pem, err := ioutil.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to read ca cert %s: %w", op, caCert, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found in ca cert %s: %w", op, caCert, ErrInvalidParameter)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
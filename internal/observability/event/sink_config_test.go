@@ -204,6 +204,183 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: `too many sink type config blocks`,
 		},
+		{
+			name: "audit-integrity-with-text-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName: "tmp.file",
+				},
+				Format: TextSinkFormat,
+				AuditConfig: &AuditConfig{
+					IntegrityEnabled: true,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "integrity requires the cloudevents-json or hclog-json format",
+		},
+		{
+			name: "webhook-sink-with-no-webhook-block",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "webhook" block`,
+		},
+		{
+			name: "webhook-sink-with-text-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     TextSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{
+					Url: "https://localhost/events",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "webhook sinks only support the cloudevents-json format",
+		},
+		{
+			name: "webhook-sink-with-no-url",
+			sc: SinkConfig{
+				Name:          "sink-name",
+				EventTypes:    []Type{EveryType},
+				Type:          WebhookSink,
+				Format:        JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing url",
+		},
+		{
+			name: "webhook-sink-with-invalid-url-scheme",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{
+					Url: "ftp://localhost/events",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "must use the http or https scheme",
+		},
+		{
+			name: "webhook-sink-with-invalid-delivery-guarantee",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{
+					Url:               "https://localhost/events",
+					DeliveryGuarantee: "invalid",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid delivery guarantee",
+		},
+		{
+			name: "syslog-sink-with-no-syslog-block",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "syslog" block`,
+		},
+		{
+			name: "syslog-sink-with-invalid-network",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network: "unix",
+					Address: "localhost:514",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog network",
+		},
+		{
+			name: "syslog-sink-with-no-address",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing address",
+		},
+		{
+			name: "syslog-sink-with-invalid-facility",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Address:  "localhost:514",
+					Facility: "invalid",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid syslog facility",
+		},
+		{
+			name: "type mismatch webhook type syslog config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Address: "localhost:514",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "webhook" block`,
+		},
+		{
+			name: "valid-webhook",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{EveryType},
+				Type:       WebhookSink,
+				Format:     JSONSinkFormat,
+				WebhookConfig: &WebhookSinkTypeConfig{
+					Url:               "https://localhost/events",
+					DeliveryGuarantee: Enforced,
+				},
+			},
+		},
+		{
+			name: "valid-syslog",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     TextSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network:  "tcp",
+					Address:  "localhost:514",
+					Facility: "local7",
+				},
+			},
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
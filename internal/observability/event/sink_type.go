@@ -5,16 +5,18 @@ import (
 )
 
 const (
-	StderrSink SinkType = "stderr" // StderrSink is written to stderr
-	FileSink   SinkType = "file"   // FileSink is written to a file
+	StderrSink  SinkType = "stderr"  // StderrSink is written to stderr
+	FileSink    SinkType = "file"    // FileSink is written to a file
+	WebhookSink SinkType = "webhook" // WebhookSink is posted to an HTTP endpoint
+	SyslogSink  SinkType = "syslog"  // SyslogSink is sent to a syslog server
 )
 
-type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr)
+type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, webhook, syslog)
 
 func (t SinkType) Validate() error {
 	const op = "event.(SinkType).validate"
 	switch t {
-	case StderrSink, FileSink:
+	case StderrSink, FileSink, WebhookSink, SyslogSink:
 		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink type: %w", op, t, ErrInvalidParameter)
@@ -0,0 +1,205 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	defaultSyslogNetwork  = "udp"
+	defaultSyslogFacility = "local0"
+	defaultSyslogAppName  = "boundary"
+
+	syslogDialTimeout  = 10 * time.Second
+	syslogWriteTimeout = 10 * time.Second
+
+	syslogSeverityError = 3 // syslogSeverityError is the severity of error events
+	syslogSeverityInfo  = 6 // syslogSeverityInfo is the severity of all other events
+
+	// syslogTimestampFormat is the RFC 5424 timestamp format, which allows at
+	// most 6 digits of fractional seconds.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogFacilities maps the names of the syslog facilities to their codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// syslogSink is an eventlogger sink node which sends events to a syslog
+// server as RFC 5424 messages. Messages are sent one per datagram over udp and
+// framed with octet counting (RFC 6587) over tcp and tls.
+type syslogSink struct {
+	network   string
+	address   string
+	tlsConfig *tls.Config
+	facility  int
+	appName   string
+	hostname  string
+	format    string
+
+	l    sync.Mutex
+	conn net.Conn
+}
+
+var _ eventlogger.Node = (*syslogSink)(nil)
+
+func newSyslogSink(c *SyslogSinkTypeConfig, format SinkFormat) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing syslog config: %w", op, ErrInvalidParameter)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s := &syslogSink{
+		network:  c.Network,
+		address:  c.Address,
+		facility: syslogFacilities[defaultSyslogFacility],
+		appName:  c.AppName,
+		format:   string(format),
+	}
+	if s.network == "" {
+		s.network = defaultSyslogNetwork
+	}
+	if c.Facility != "" {
+		s.facility = syslogFacilities[c.Facility]
+	}
+	if s.appName == "" {
+		s.appName = defaultSyslogAppName
+	}
+	if s.format == "" {
+		s.format = string(JSONSinkFormat)
+	}
+	var err error
+	if s.hostname, err = os.Hostname(); err != nil || s.hostname == "" {
+		s.hostname = "-"
+	}
+	if s.network == "tls" {
+		host, _, err := net.SplitHostPort(c.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if s.tlsConfig, err = sinkTlsConfig(c.TlsCaCert, host, c.TlsSkipVerify); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return s, nil
+}
+
+// Process sends the event to the syslog server, reconnecting once if the
+// connection has been lost.
+func (s *syslogSink) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not formatted as %s: %w", op, s.format, ErrInvalidParameter)
+	}
+	msg := s.message(e, val)
+
+	s.l.Lock()
+	defer s.l.Unlock()
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			if s.conn, err = s.dial(); err != nil {
+				continue
+			}
+		}
+		if err = s.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err == nil {
+			if _, err = s.conn.Write(msg); err == nil {
+				// Sinks are leafs, so do not return the event, since nothing
+				// more can happen to it downstream.
+				return nil, nil
+			}
+		}
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	return nil, fmt.Errorf("%s: unable to send event to %s: %w", op, s.address, err)
+}
+
+// Reopen closes the connection to the syslog server, which is reopened when
+// the next event is sent.
+func (s *syslogSink) Reopen() error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// Type describes the type of the node as a Sink.
+func (s *syslogSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+func (s *syslogSink) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: syslogDialTimeout}
+	if s.network == "tls" {
+		return tls.DialWithDialer(dialer, "tcp", s.address, s.tlsConfig)
+	}
+	return dialer.Dial(s.network, s.address)
+}
+
+// message returns the RFC 5424 message of the event, framed for the network
+// of the sink.
+func (s *syslogSink) message(e *eventlogger.Event, val []byte) []byte {
+	severity := syslogSeverityInfo
+	if Type(e.Type) == ErrorType {
+		severity = syslogSeverityError
+	}
+	msgId := string(e.Type)
+	if msgId == "" {
+		msgId = "-"
+	}
+	timestamp := "-"
+	if !e.CreatedAt.IsZero() {
+		timestamp = e.CreatedAt.UTC().Format(syslogTimestampFormat)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %d %s - ", s.facility*8+severity, timestamp, s.hostname, s.appName, os.Getpid(), msgId)
+	buf.Write(bytes.TrimSpace(val))
+	if s.network == "udp" {
+		return buf.Bytes()
+	}
+	framed := make([]byte, 0, buf.Len()+8)
+	framed = strconv.AppendInt(framed, int64(buf.Len()), 10)
+	framed = append(framed, ' ')
+	return append(framed, buf.Bytes()...)
+}
@@ -0,0 +1,133 @@
+package event
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_syslogSink(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	createdAt := time.Date(2021, 11, 1, 10, 30, 0, 123456789, time.UTC)
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	testEvent := func(typ Type) *eventlogger.Event {
+		e := &eventlogger.Event{
+			Type:      eventlogger.EventType(typ),
+			CreatedAt: createdAt,
+		}
+		e.FormattedAs(string(JSONSinkFormat), []byte(`{"type":"`+string(typ)+`"}`+"\n"))
+		return e
+	}
+	wantMessage := func(pri int, typ Type) string {
+		return fmt.Sprintf("<%d>1 2021-11-01T10:30:00.123456Z %s boundary %d %s - {\"type\":\"%s\"}", pri, hostname, os.Getpid(), typ, typ)
+	}
+
+	t.Run("udp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(err)
+		t.Cleanup(func() { pc.Close() })
+
+		s, err := newSyslogSink(&SyslogSinkTypeConfig{
+			Address: pc.LocalAddr().String(),
+		}, JSONSinkFormat)
+		require.NoError(err)
+		t.Cleanup(func() { s.Reopen() })
+
+		_, err = s.Process(ctx, testEvent(ObservationType))
+		require.NoError(err)
+		_, err = s.Process(ctx, testEvent(ErrorType))
+		require.NoError(err)
+
+		buf := make([]byte, 1024)
+		require.NoError(pc.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		n, _, err := pc.ReadFrom(buf)
+		require.NoError(err)
+		// local0 (16) * 8 + info (6)
+		assert.Equal(wantMessage(134, ObservationType), string(buf[:n]))
+		n, _, err = pc.ReadFrom(buf)
+		require.NoError(err)
+		// local0 (16) * 8 + err (3)
+		assert.Equal(wantMessage(131, ErrorType), string(buf[:n]))
+	})
+
+	t.Run("tcp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(err)
+		t.Cleanup(func() { l.Close() })
+
+		s, err := newSyslogSink(&SyslogSinkTypeConfig{
+			Network:  "tcp",
+			Address:  l.Addr().String(),
+			Facility: "auth",
+			AppName:  "test-app",
+		}, JSONSinkFormat)
+		require.NoError(err)
+		t.Cleanup(func() { s.Reopen() })
+
+		for i := 0; i < 2; i++ {
+			_, err = s.Process(ctx, testEvent(AuditType))
+			require.NoError(err)
+		}
+
+		conn, err := l.Accept()
+		require.NoError(err)
+		defer conn.Close()
+		require.NoError(conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+		r := bufio.NewReader(conn)
+		// auth (4) * 8 + info (6)
+		want := strings.Replace(wantMessage(38, AuditType), "boundary", "test-app", 1)
+		for i := 0; i < 2; i++ {
+			length, err := r.ReadString(' ')
+			require.NoError(err)
+			n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+			require.NoError(err)
+			msg := make([]byte, n)
+			_, err = io.ReadFull(r, msg)
+			require.NoError(err)
+			assert.Equal(want, string(msg))
+		}
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		require := require.New(t)
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(err)
+		addr := l.Addr().String()
+		require.NoError(l.Close())
+
+		s, err := newSyslogSink(&SyslogSinkTypeConfig{
+			Network: "tcp",
+			Address: addr,
+		}, JSONSinkFormat)
+		require.NoError(err)
+		_, err = s.Process(ctx, testEvent(ObservationType))
+		require.Error(err)
+		require.Contains(err.Error(), "unable to send event")
+	})
+
+	t.Run("missing-event", func(t *testing.T) {
+		require := require.New(t)
+		s, err := newSyslogSink(&SyslogSinkTypeConfig{Address: "127.0.0.1:514"}, JSONSinkFormat)
+		require.NoError(err)
+		_, err = s.Process(ctx, nil)
+		require.ErrorIs(err, ErrInvalidParameter)
+		_, err = s.Process(ctx, &eventlogger.Event{})
+		require.ErrorIs(err, ErrInvalidParameter)
+	})
+}
@@ -0,0 +1,288 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	defaultWebhookBatchSize     = 100
+	defaultWebhookFlushInterval = 5 * time.Second
+	defaultWebhookTimeout       = 10 * time.Second
+
+	// webhookContentType is the content type of batches of cloudevents in
+	// the structured JSON format.
+	webhookContentType = "application/cloudevents-batch+json"
+)
+
+// webhookSink is an eventlogger sink node which posts batches of events
+// formatted as cloudevents-json to an HTTP endpoint.
+//
+// Events are batched until either the batch size is reached or the flush
+// interval has elapsed since the first event of the batch. Batches are sent in
+// the background and failed requests are retried with an exponential backoff,
+// until FlushAll is called. With an Enforced delivery guarantee, Process sends
+// the batch of the event right away, waits for it to be delivered and returns
+// an error if it could not be, otherwise delivery errors are only logged.
+type webhookSink struct {
+	url           string
+	headers       map[string]string
+	client        *http.Client
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    uint
+	backoff       backoff
+	guarantee     DeliveryGuarantee
+	logger        hclog.Logger
+
+	l      sync.Mutex
+	batch  *webhookBatch
+	ctx    context.Context // ctx is the context of the batches sent in the background
+	cancel context.CancelFunc
+}
+
+// webhookBatch is a batch of events posted in a single request.
+type webhookBatch struct {
+	events [][]byte
+	timer  *time.Timer
+	once   sync.Once
+	done   chan struct{} // done is closed once the batch has been sent
+	err    error         // err is the error sending the batch, set before done is closed
+}
+
+var _ eventlogger.Node = (*webhookSink)(nil)
+var _ flushable = (*webhookSink)(nil)
+
+func newWebhookSink(c *WebhookSinkTypeConfig, logger hclog.Logger) (*webhookSink, error) {
+	const op = "event.newWebhookSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing webhook config: %w", op, ErrInvalidParameter)
+	}
+	if logger == nil {
+		return nil, fmt.Errorf("%s: missing logger: %w", op, ErrInvalidParameter)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	tlsConfig, err := sinkTlsConfig(c.TlsCaCert, u.Hostname(), c.TlsSkipVerify)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.TLSClientConfig = tlsConfig
+
+	s := &webhookSink{
+		url:           c.Url,
+		headers:       c.Headers,
+		client:        &http.Client{Transport: transport, Timeout: c.Timeout},
+		batchSize:     c.BatchSize,
+		flushInterval: c.FlushInterval,
+		maxRetries:    stdRetryCount,
+		backoff:       webhookBackoff{},
+		guarantee:     c.DeliveryGuarantee,
+		logger:        logger,
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	if s.batchSize == 0 {
+		s.batchSize = defaultWebhookBatchSize
+	}
+	if s.flushInterval == 0 {
+		s.flushInterval = defaultWebhookFlushInterval
+	}
+	if s.client.Timeout == 0 {
+		s.client.Timeout = defaultWebhookTimeout
+	}
+	if c.MaxRetries != nil {
+		s.maxRetries = uint(*c.MaxRetries)
+	}
+	return s, nil
+}
+
+// Process adds the event to the current batch, sending the batch if it is
+// full or if delivery is enforced.
+func (s *webhookSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(webhookSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(string(JSONSinkFormat))
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not formatted as %s: %w", op, JSONSinkFormat, ErrInvalidParameter)
+	}
+
+	s.l.Lock()
+	if s.batch == nil {
+		b := &webhookBatch{done: make(chan struct{})}
+		b.timer = time.AfterFunc(s.flushInterval, func() { s.send(s.sendCtx(), b, s.maxRetries) })
+		s.batch = b
+	}
+	b := s.batch
+	b.events = append(b.events, bytes.TrimSpace(val))
+	full := len(b.events) >= s.batchSize
+	sendCtx := s.ctx
+	s.l.Unlock()
+
+	if s.guarantee != Enforced {
+		if full {
+			go s.send(sendCtx, b, s.maxRetries)
+		}
+		// Sinks are leafs, so do not return the event, since nothing more
+		// can happen to it downstream.
+		return nil, nil
+	}
+	// Enforced events do not wait for the flush interval: the batch is sent
+	// right away, in the background so that waiting for it honors ctx.
+	go s.send(sendCtx, b, s.maxRetries)
+	select {
+	case <-b.done:
+		if b.err != nil {
+			return nil, fmt.Errorf("%s: %w", op, b.err)
+		}
+		return nil, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+// FlushAll is called when Boundary is stopping: it stops retrying the batches
+// being sent in the background and posts the current batch once, so that
+// stopping isn't delayed by the retries of an unavailable endpoint.
+func (s *webhookSink) FlushAll(ctx context.Context) error {
+	const op = "event.(webhookSink).FlushAll"
+	s.l.Lock()
+	b := s.batch
+	cancel := s.cancel
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.l.Unlock()
+	cancel()
+	if b == nil {
+		return nil
+	}
+	s.send(ctx, b, 0)
+	if b.err != nil {
+		return fmt.Errorf("%s: %w", op, b.err)
+	}
+	return nil
+}
+
+// Reopen is a no-op for webhook sinks.
+func (s *webhookSink) Reopen() error {
+	return nil
+}
+
+// Type describes the type of the node as a Sink.
+func (s *webhookSink) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeSink
+}
+
+// sendCtx returns the context of the batches sent in the background.
+func (s *webhookSink) sendCtx() context.Context {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.ctx
+}
+
+// send sends the batch b once, no matter how many times it is called, retrying
+// failed requests up to maxRetries times until ctx is done. It returns once the
+// batch has been sent.
+func (s *webhookSink) send(ctx context.Context, b *webhookBatch, maxRetries uint) {
+	s.l.Lock()
+	if s.batch == b {
+		s.batch = nil
+	}
+	s.l.Unlock()
+
+	b.once.Do(func() {
+		b.timer.Stop()
+		b.err = s.post(ctx, b.events, maxRetries)
+		if b.err != nil && s.guarantee != Enforced {
+			s.logger.Error("unable to send events to webhook", "url", s.url, "events", len(b.events), "error", b.err.Error())
+		}
+		close(b.done)
+	})
+}
+
+// post posts the events as a JSON array, retrying failed requests up to
+// maxRetries times. Requests and retries are stopped once ctx is done.
+func (s *webhookSink) post(ctx context.Context, events [][]byte, maxRetries uint) error {
+	const op = "event.(webhookSink).post"
+	body := make([]byte, 0, 2+len(events)*512)
+	body = append(body, '[')
+	body = append(body, bytes.Join(events, []byte{','})...)
+	body = append(body, ']')
+
+	var lastErr error
+	for attempt := uint(0); attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			t := time.NewTimer(s.backoff.duration(attempt))
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return fmt.Errorf("%s: stopped retrying: %w", op, lastErr)
+			}
+		}
+		retryable, err := s.postOnce(ctx, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retryable || ctx.Err() != nil {
+			break
+		}
+	}
+	return fmt.Errorf("%s: %w", op, lastErr)
+}
+
+// postOnce makes a single request, returning whether a failed request may be
+// retried.
+func (s *webhookSink) postOnce(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", webhookContentType)
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return true, fmt.Errorf("unexpected response status %s", resp.Status)
+	default:
+		return false, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+}
+
+// webhookBackoff is an exponential backoff starting around half a second,
+// suited to endpoints which may be unavailable for a while.
+type webhookBackoff struct{}
+
+func (b webhookBackoff) duration(attempt uint) time.Duration {
+	r := rand.Float64()
+	return time.Duration(math.Exp2(float64(attempt-1)) * float64(500*time.Millisecond) * (r + 0.5))
+}
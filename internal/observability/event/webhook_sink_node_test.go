@@ -0,0 +1,329 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWebhookServer records the batches posted to it, replying with the
+// statuses given in order, then with http.StatusOK.
+type testWebhookServer struct {
+	*httptest.Server
+	l        sync.Mutex
+	statuses []int
+	requests []*http.Request
+	batches  [][]map[string]interface{}
+}
+
+func newTestWebhookServer(t *testing.T, statuses ...int) *testWebhookServer {
+	t.Helper()
+	s := &testWebhookServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.l.Lock()
+		defer s.l.Unlock()
+		s.requests = append(s.requests, r)
+		if len(s.statuses) > 0 {
+			status := s.statuses[0]
+			s.statuses = s.statuses[1:]
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				return
+			}
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var batch []map[string]interface{}
+		require.NoError(t, json.Unmarshal(body, &batch))
+		s.batches = append(s.batches, batch)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *testWebhookServer) received() ([]*http.Request, [][]map[string]interface{}) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return s.requests, s.batches
+}
+
+func testWebhookEvent(t *testing.T, id string) *eventlogger.Event {
+	t.Helper()
+	e := &eventlogger.Event{
+		Type:      eventlogger.EventType(ObservationType),
+		CreatedAt: time.Now(),
+	}
+	e.FormattedAs(string(JSONSinkFormat), []byte(`{"id":"`+id+`","type":"observation"}`+"\n"))
+	return e
+}
+
+func Test_webhookSink(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testLogger := hclog.New(&hclog.LoggerOptions{Name: "test"})
+	maxRetries := func(i int) *int { return &i }
+
+	t.Run("batch-size", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:           srv.URL,
+			Headers:       map[string]string{"Authorization": "Bearer token"},
+			BatchSize:     2,
+			FlushInterval: time.Hour,
+		}, testLogger)
+		require.NoError(err)
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		reqs, _ := srv.received()
+		assert.Empty(reqs)
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "2"))
+		require.NoError(err)
+		// The full batch is sent in the background.
+		assert.Eventually(func() bool {
+			_, batches := srv.received()
+			return len(batches) == 1
+		}, time.Second, 10*time.Millisecond)
+		reqs, batches := srv.received()
+		require.Len(reqs, 1)
+		assert.Equal(webhookContentType, reqs[0].Header.Get("Content-Type"))
+		assert.Equal("Bearer token", reqs[0].Header.Get("Authorization"))
+		require.Len(batches, 1)
+		require.Len(batches[0], 2)
+		assert.Equal("1", batches[0][0]["id"])
+		assert.Equal("2", batches[0][1]["id"])
+	})
+
+	t.Run("flush-interval", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:           srv.URL,
+			FlushInterval: 10 * time.Millisecond,
+		}, testLogger)
+		require.NoError(err)
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		assert.Eventually(func() bool {
+			_, batches := srv.received()
+			return len(batches) == 1 && len(batches[0]) == 1
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("flush-all", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:           srv.URL,
+			FlushInterval: time.Hour,
+		}, testLogger)
+		require.NoError(err)
+
+		require.NoError(s.FlushAll(ctx))
+		reqs, _ := srv.received()
+		assert.Empty(reqs)
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		_, batches := srv.received()
+		require.Len(batches, 1)
+		assert.Len(batches[0], 1)
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:               srv.URL,
+			BatchSize:         1,
+			DeliveryGuarantee: Enforced,
+		}, testLogger)
+		require.NoError(err)
+		s.backoff = expBackoff{}
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		reqs, batches := srv.received()
+		assert.Len(reqs, 3)
+		assert.Len(batches, 1)
+	})
+
+	t.Run("enforced-delivery-failure", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusInternalServerError, http.StatusInternalServerError)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:               srv.URL,
+			BatchSize:         1,
+			MaxRetries:        maxRetries(1),
+			DeliveryGuarantee: Enforced,
+		}, testLogger)
+		require.NoError(err)
+		s.backoff = expBackoff{}
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.Error(err)
+		assert.Contains(err.Error(), "500 Internal Server Error")
+		reqs, _ := srv.received()
+		assert.Len(reqs, 2)
+	})
+
+	t.Run("enforced-sent-immediately", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:               srv.URL,
+			FlushInterval:     time.Hour,
+			DeliveryGuarantee: Enforced,
+		}, testLogger)
+		require.NoError(err)
+
+		// The event must not wait for the flush interval.
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		start := time.Now()
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		assert.Less(int64(time.Since(start)), int64(time.Second))
+		_, batches := srv.received()
+		require.Len(batches, 1)
+		assert.Len(batches[0], 1)
+
+		// Best-effort events are still batched.
+		s.guarantee = BestEffort
+		_, err = s.Process(ctx, testWebhookEvent(t, "2"))
+		require.NoError(err)
+		_, batches = srv.received()
+		assert.Len(batches, 1)
+	})
+
+	t.Run("client-error-not-retried", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusBadRequest)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:       srv.URL,
+			BatchSize: 1,
+		}, testLogger)
+		require.NoError(err)
+		s.backoff = expBackoff{}
+
+		// best effort delivery doesn't return the error
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		assert.Eventually(func() bool {
+			reqs, _ := srv.received()
+			return len(reqs) == 1
+		}, time.Second, 10*time.Millisecond)
+		require.NoError(s.FlushAll(ctx))
+		reqs, batches := srv.received()
+		assert.Len(reqs, 1)
+		assert.Empty(batches)
+	})
+
+	t.Run("best-effort-not-blocked-by-retries", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusServiceUnavailable)
+		var logs syncBuffer
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:       srv.URL,
+			BatchSize: 1,
+		}, hclog.New(&hclog.LoggerOptions{Name: "test", Output: &logs}))
+		require.NoError(err)
+		s.backoff = testWebhookBackoff(time.Hour)
+
+		start := time.Now()
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		assert.Less(int64(time.Since(start)), int64(time.Second))
+		assert.Eventually(func() bool {
+			reqs, _ := srv.received()
+			return len(reqs) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		// Stopping stops the retries of the batch.
+		start = time.Now()
+		require.NoError(s.FlushAll(ctx))
+		assert.Less(int64(time.Since(start)), int64(time.Second))
+		assert.Eventually(func() bool {
+			return strings.Contains(logs.String(), "stopped retrying")
+		}, time.Second, 10*time.Millisecond)
+		reqs, _ := srv.received()
+		assert.Len(reqs, 1)
+	})
+
+	t.Run("flush-all-not-retried", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		srv := newTestWebhookServer(t, http.StatusServiceUnavailable)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{
+			Url:           srv.URL,
+			FlushInterval: time.Hour,
+		}, testLogger)
+		require.NoError(err)
+		s.backoff = testWebhookBackoff(time.Hour)
+
+		_, err = s.Process(ctx, testWebhookEvent(t, "1"))
+		require.NoError(err)
+		err = s.FlushAll(ctx)
+		require.Error(err)
+		assert.Contains(err.Error(), "503 Service Unavailable")
+		reqs, _ := srv.received()
+		assert.Len(reqs, 1)
+
+		// The sink can still be used after being flushed.
+		_, err = s.Process(ctx, testWebhookEvent(t, "2"))
+		require.NoError(err)
+		require.NoError(s.FlushAll(ctx))
+		_, batches := srv.received()
+		assert.Len(batches, 1)
+	})
+
+	t.Run("missing-event", func(t *testing.T) {
+		require := require.New(t)
+		s, err := newWebhookSink(&WebhookSinkTypeConfig{Url: "http://localhost"}, testLogger)
+		require.NoError(err)
+		_, err = s.Process(ctx, nil)
+		require.ErrorIs(err, ErrInvalidParameter)
+		_, err = s.Process(ctx, &eventlogger.Event{})
+		require.ErrorIs(err, ErrInvalidParameter)
+	})
+}
+
+// testWebhookBackoff is a constant backoff.
+type testWebhookBackoff time.Duration
+
+func (b testWebhookBackoff) duration(uint) time.Duration {
+	return time.Duration(b)
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	l   sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.l.Lock()
+	defer b.l.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.l.Lock()
+	defer b.l.Unlock()
+	return b.buf.String()
+}
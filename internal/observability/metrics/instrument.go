@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcUnaryInterceptor returns a unary server interceptor counting the
+// requests handled and observing their latency.
+func GrpcUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		service, method := splitMethodName(info.FullMethod)
+		code := status.Code(err).String()
+		GrpcRequests.WithLabelValues(service, method, code).Inc()
+		GrpcRequestDuration.WithLabelValues(service, method, code).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// InstrumentHttpHandler wraps h to count the requests it handles and observe
+// their latency under the handler label name.
+func InstrumentHttpHandler(name string, h http.Handler) http.Handler {
+	labels := prometheus.Labels{"handler": name}
+	return promhttp.InstrumentHandlerCounter(
+		HttpRequests.MustCurryWith(labels),
+		promhttp.InstrumentHandlerDuration(HttpRequestDuration.MustCurryWith(labels), h),
+	)
+}
+
+// splitMethodName splits a full gRPC method name of the form
+// /package.service/method into its service and method.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
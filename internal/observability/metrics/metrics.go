@@ -0,0 +1,156 @@
+// Package metrics defines the Prometheus metrics of Boundary controllers and
+// workers, and the handler exposing them on listeners with the metrics
+// purpose.
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "boundary"
+
+// Registry is the registry of all the metrics of the process.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// GrpcRequests counts the gRPC requests handled, by service, method and
+	// status code.
+	GrpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Count of gRPC requests handled by service, method and code.",
+	}, []string{"service", "method", "code"})
+
+	// GrpcRequestDuration observes the latency of the gRPC requests handled,
+	// by service, method and status code.
+	GrpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC requests handled by service, method and code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method", "code"})
+
+	// HttpRequests counts the HTTP requests handled, by handler, method and
+	// status code.
+	HttpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Count of HTTP requests handled by handler, method and code.",
+	}, []string{"handler", "method", "code"})
+
+	// HttpRequestDuration observes the latency of the HTTP requests handled,
+	// by handler, method and status code.
+	HttpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests handled by handler, method and code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method", "code"})
+
+	// WorkerSessions is the number of sessions known to a worker.
+	WorkerSessions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "sessions_active",
+		Help:      "Number of sessions known to the worker.",
+	}, []string{"worker"})
+
+	// WorkerConnections is the number of open connections proxied by a
+	// worker.
+	WorkerConnections = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "connections_active",
+		Help:      "Number of open connections proxied by the worker.",
+	}, []string{"worker"})
+
+	// WorkerProxiedBytes counts the bytes proxied by a worker, by direction
+	// (up from the client to the endpoint, down from the endpoint to the
+	// client).
+	WorkerProxiedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "proxied_bytes_total",
+		Help:      "Count of bytes proxied by the worker by direction.",
+	}, []string{"direction"})
+
+	// SchedulerJobRunDuration observes the duration of the runs of the
+	// scheduler jobs, by job name.
+	SchedulerJobRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "job_run_duration_seconds",
+		Help:      "Duration of scheduler job runs by job name.",
+		Buckets:   []float64{.01, .1, 1, 10, 60, 300, 900, 3600},
+	}, []string{"job"})
+
+	// SchedulerJobRunFailures counts the failed runs of the scheduler jobs,
+	// by job name.
+	SchedulerJobRunFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "scheduler",
+		Name:      "job_run_failures_total",
+		Help:      "Count of failed scheduler job runs by job name.",
+	}, []string{"job"})
+
+	// VaultCredentialIssueErrors counts the errors retrieving credentials
+	// from Vault, by credential library.
+	VaultCredentialIssueErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "vault",
+		Name:      "credential_issue_errors_total",
+		Help:      "Count of errors issuing credentials from Vault by credential library.",
+	}, []string{"credential_library_id"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		GrpcRequests,
+		GrpcRequestDuration,
+		HttpRequests,
+		HttpRequestDuration,
+		WorkerSessions,
+		WorkerConnections,
+		WorkerProxiedBytes,
+		SchedulerJobRunDuration,
+		SchedulerJobRunFailures,
+		VaultCredentialIssueErrors,
+	)
+}
+
+var (
+	dbStatsMu        sync.Mutex
+	dbStatsCollector prometheus.Collector
+)
+
+// RegisterDatabase registers the collector of the connection pool statistics
+// of db, replacing the one of a previously registered database.
+func RegisterDatabase(db *sql.DB) error {
+	dbStatsMu.Lock()
+	defer dbStatsMu.Unlock()
+	if dbStatsCollector != nil {
+		Registry.Unregister(dbStatsCollector)
+	}
+	dbStatsCollector = collectors.NewDBStatsCollector(db, namespace)
+	return Registry.Register(dbStatsCollector)
+}
+
+// Handler returns the handler exposing the metrics in the Prometheus
+// exposition format on the /metrics path.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+	return mux
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGrpcUnaryInterceptor(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	interceptor := GrpcUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.v1.TestService/GetTest"}
+
+	before := testutil.ToFloat64(GrpcRequests.WithLabelValues("test.v1.TestService", "GetTest", "NotFound"))
+	_, err := interceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+	require.Error(err)
+	assert.Equal(before+1, testutil.ToFloat64(GrpcRequests.WithLabelValues("test.v1.TestService", "GetTest", "NotFound")))
+
+	before = testutil.ToFloat64(GrpcRequests.WithLabelValues("test.v1.TestService", "GetTest", "OK"))
+	resp, err := interceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	require.NoError(err)
+	assert.Equal("ok", resp)
+	assert.Equal(before+1, testutil.ToFloat64(GrpcRequests.WithLabelValues("test.v1.TestService", "GetTest", "OK")))
+}
+
+func TestSplitMethodName(t *testing.T) {
+	tests := []struct {
+		in                      string
+		wantService, wantMethod string
+	}{
+		{in: "/controller.api.services.v1.TargetService/GetTarget", wantService: "controller.api.services.v1.TargetService", wantMethod: "GetTarget"},
+		{in: "invalid", wantService: "unknown", wantMethod: "unknown"},
+	}
+	for _, tt := range tests {
+		service, method := splitMethodName(tt.in)
+		assert.Equal(t, tt.wantService, service, tt.in)
+		assert.Equal(t, tt.wantMethod, method, tt.in)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	assert := assert.New(t)
+	h := InstrumentHttpHandler("test", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(http.StatusTeapot, rec.Code)
+
+	srv := httptest.NewServer(Handler())
+	defer srv.Close()
+	families := TestScrape(t, srv.URL)
+	for _, name := range []string{
+		"boundary_http_requests_total",
+		"boundary_http_request_duration_seconds",
+		"go_goroutines",
+	} {
+		assert.Contains(families, name)
+	}
+	var found bool
+	for _, m := range families["boundary_http_requests_total"].GetMetric() {
+		labels := map[string]string{}
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		if labels["handler"] == "test" && labels["code"] == "418" && labels["method"] == "get" {
+			found = true
+			assert.GreaterOrEqual(m.GetCounter().GetValue(), float64(1))
+		}
+	}
+	assert.True(found)
+
+	resp, err := http.Get(srv.URL + "/other")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/go-secure-stdlib/listenerutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListenerConfig returns the configuration of a TCP listener, without
+// TLS, for the metrics purpose.
+func TestListenerConfig() *listenerutil.ListenerConfig {
+	return &listenerutil.ListenerConfig{
+		Type:               "tcp",
+		Purpose:            []string{"metrics"},
+		TLSDisable:         true,
+		MaxRequestDuration: globals.DefaultMaxRequestDuration,
+	}
+}
+
+// TestScrape scrapes the metrics exposed at addr, the http URL of a metrics
+// listener, and returns them by name.
+func TestScrape(t *testing.T, addr string) map[string]*dto.MetricFamily {
+	t.Helper()
+	resp, err := http.Get(addr + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	require.NoError(t, err)
+	return families
+}
@@ -14,6 +14,7 @@ construction is thus synthesizing something reasonable from a set of Grants.
 */
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -26,6 +27,10 @@ const AnonymousUserId = "u_anon"
 // action is allowed on a resource based on a principal's (user or group) grants.
 type ACL struct {
 	scopeMap map[string][]Grant
+
+	// requestContext is what the time bounds and conditions of grants are
+	// evaluated against
+	requestContext RequestContext
 }
 
 // ACLResults provides a type for the permission's engine results so that we can
@@ -70,19 +75,43 @@ func NewACL(grants ...Grant) ACL {
 	return ret
 }
 
+// WithRequestContext returns a copy of the ACL which evaluates the time bounds
+// and conditions of its grants against rc. Without a request context, time
+// bounds are evaluated against the current time and conditions are evaluated
+// against an empty request.
+func (a ACL) WithRequestContext(rc RequestContext) ACL {
+	a.requestContext = rc
+	return a
+}
+
 // Allowed determines if the grants for an ACL allow an action for a resource.
+// Grants whose time bounds or conditions are not satisfied by the request
+// context of the ACL are ignored, except for deny grants whose conditions
+// cannot be evaluated, which apply. Deny grants override allow grants: if any
+// deny grant matches the action on the resource, the action is not authorized
+// and no output fields are returned.
 func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 	// First, get the grants within the specified scope
 	grants := a.scopeMap[r.ScopeId]
 	results.scopeMap = a.scopeMap
 
-	var parentAction action.Type
-	split := strings.Split(aType.String(), ":")
-	if len(split) == 2 {
-		parentAction = action.Map[split[0]]
+	parentAction := parentActionOf(aType)
+
+	// Deny grants are checked first as any match is final
+	for _, grant := range grants {
+		if grant.effect != EffectDeny || !grant.applies(a.requestContext) {
+			continue
+		}
+		if grant.matchesAction(aType, parentAction) && grant.matchesResource(r, aType) {
+			return
+		}
 	}
+
 	// Now, go through and check the cases indicated above
 	for _, grant := range grants {
+		if grant.effect == EffectDeny || !grant.applies(a.requestContext) {
+			continue
+		}
 		var outputFieldsOnly bool
 		switch {
 		case len(grant.actions) == 0:
@@ -94,14 +123,8 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 			} else {
 				continue
 			}
-		case grant.actions[aType]:
+		case grant.matchesAction(aType, parentAction):
 			// We have this action
-		case grant.actions[parentAction]:
-			// We don't have this action, but it's a subaction and we have the
-			// parent action. As an example, if we are looking for "read:self"
-			// and have "read", this is sufficient.
-		case grant.actions[action.All]:
-			// All actions are allowed
 		default:
 			// No actions in the grant match what we're looking for, so continue
 			// with the next grant
@@ -114,57 +137,7 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 		// If the action was not found above but we did find output fields in
 		// patterns that match, we do not authorize the request, but we do build
 		// up the output fields patterns.
-		var found bool
-		switch {
-		// id=<resource.id>;actions=<action> where ID cannot be a wildcard; or
-		// id=<resource.id>;output_fields=<fields> where fields cannot be a
-		// wildcard.
-		case grant.id == r.Id &&
-			grant.id != "" &&
-			grant.id != "*" &&
-			grant.typ == resource.Unknown &&
-			aType != action.List &&
-			aType != action.Create:
-
-			found = true
-
-		// type=<resource.type>;actions=<action> when action is list or create.
-		// Must be a top level collection, otherwise must be one of the two
-		// formats specified below. Or,
-		// type=resource.type;output_fields=<fields> and no action.
-		case grant.id == "" &&
-			r.Id == "" &&
-			grant.typ == r.Type &&
-			grant.typ != resource.Unknown &&
-			topLevelType(r.Type) &&
-			(aType == action.List ||
-				aType == action.Create):
-
-			found = true
-
-		// id=*;type=<resource.type>;actions=<action> where type cannot be
-		// unknown but can be a wildcard to allow any resource at all; or
-		// id=*;type=<resource.type>;output_fields=<fields> with no action.
-		case grant.id == "*" &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type ||
-				grant.typ == resource.All):
-
-			found = true
-
-		// id=<pin>;type=<resource.type>;actions=<action> where type can be a
-		// wildcard and this this is operating on a non-top-level type. Same for
-		// output fields only.
-		case grant.id != "" &&
-			grant.id == r.Pin &&
-			grant.typ != resource.Unknown &&
-			(grant.typ == r.Type || grant.typ == resource.All) &&
-			!topLevelType(r.Type):
-
-			found = true
-		}
-
-		if found {
+		if grant.matchesResource(r, aType) {
 			if !outputFieldsOnly {
 				results.Authorized = true
 			}
@@ -176,9 +149,161 @@ func (a ACL) Allowed(r Resource, aType action.Type) (results ACLResults) {
 	return
 }
 
+// Explanation details which grants of an ACL determine whether an action on a
+// resource is authorized.
+type Explanation struct {
+	// Authorized is whether the action on the resource is authorized, as
+	// returned by Allowed
+	Authorized bool
+
+	// Allowing contains the allow grants matching the action on the resource
+	Allowing []Grant
+
+	// Denying contains the deny grants matching the action on the resource.
+	// If any, the action is not authorized regardless of Allowing.
+	Denying []Grant
+
+	// Inapplicable contains the grants matching the action on the resource
+	// whose time bounds or conditions are not satisfied by the request context
+	// of the ACL
+	Inapplicable []Grant
+
+	// Evaluated contains all the grants of the ACL in the scope of the
+	// resource, which were evaluated whether or not they match
+	Evaluated []Grant
+
+	// Missing is a grant which would allow the action on the resource, set
+	// when no grant matches the action on the resource
+	Missing string
+}
+
+// Explain determines if the grants for an ACL allow an action for a resource,
+// the same way as Allowed, and returns the grants matching the action on the
+// resource. Grants specifying only output fields are not returned.
+func (a ACL) Explain(r Resource, aType action.Type) Explanation {
+	ret := Explanation{
+		Authorized: a.Allowed(r, aType).Authorized,
+		Evaluated:  a.scopeMap[r.ScopeId],
+	}
+	parentAction := parentActionOf(aType)
+	for _, grant := range a.scopeMap[r.ScopeId] {
+		if !grant.matchesAction(aType, parentAction) || !grant.matchesResource(r, aType) {
+			continue
+		}
+		switch {
+		case !grant.applies(a.requestContext):
+			ret.Inapplicable = append(ret.Inapplicable, grant)
+		case grant.effect == EffectDeny:
+			ret.Denying = append(ret.Denying, grant)
+		default:
+			ret.Allowing = append(ret.Allowing, grant)
+		}
+	}
+	if len(ret.Allowing) == 0 && len(ret.Denying) == 0 && len(ret.Inapplicable) == 0 {
+		ret.Missing = missingGrant(r, aType)
+	}
+	return ret
+}
+
+// missingGrant returns the grant allowing the action on the resource r: on
+// its ID, or for collection actions on its type, within its parent if any.
+func missingGrant(r Resource, aType action.Type) string {
+	switch {
+	case r.Id != "":
+		return fmt.Sprintf("id=%s;actions=%s", r.Id, aType.String())
+	case r.Pin != "":
+		return fmt.Sprintf("id=%s;type=%s;actions=%s", r.Pin, r.Type.String(), aType.String())
+	default:
+		return fmt.Sprintf("type=%s;actions=%s", r.Type.String(), aType.String())
+	}
+}
+
+// parentActionOf returns the parent action of a subaction, e.g. read for
+// read:self, or action.Unknown if aType is not a subaction.
+func parentActionOf(aType action.Type) action.Type {
+	split := strings.Split(aType.String(), ":")
+	if len(split) == 2 {
+		return action.Map[split[0]]
+	}
+	return action.Unknown
+}
+
+// matchesAction returns whether the actions of the grant include aType,
+// either directly, through its parent action, or through the wildcard action.
+func (g Grant) matchesAction(aType, parentAction action.Type) bool {
+	switch {
+	case g.actions[aType]:
+		// We have this action
+	case g.actions[parentAction]:
+		// We don't have this action, but it's a subaction and we have the
+		// parent action. As an example, if we are looking for "read:self"
+		// and have "read", this is sufficient.
+	case g.actions[action.All]:
+		// All actions are allowed
+	default:
+		return false
+	}
+	return true
+}
+
+// matchesResource returns whether the id and type of the grant select the
+// resource for the action.
+func (g Grant) matchesResource(r Resource, aType action.Type) bool {
+	switch {
+	// id=<resource.id>;actions=<action> where ID cannot be a wildcard; or
+	// id=<resource.id>;output_fields=<fields> where fields cannot be a
+	// wildcard.
+	case g.id == r.Id &&
+		g.id != "" &&
+		g.id != "*" &&
+		g.typ == resource.Unknown &&
+		aType != action.List &&
+		aType != action.Create:
+
+		return true
+
+	// type=<resource.type>;actions=<action> when action is list or create.
+	// Must be a top level collection, otherwise must be one of the two
+	// formats specified below. Or,
+	// type=resource.type;output_fields=<fields> and no action.
+	case g.id == "" &&
+		r.Id == "" &&
+		g.typ == r.Type &&
+		g.typ != resource.Unknown &&
+		topLevelType(r.Type) &&
+		(aType == action.List ||
+			aType == action.Create):
+
+		return true
+
+	// id=*;type=<resource.type>;actions=<action> where type cannot be
+	// unknown but can be a wildcard to allow any resource at all; or
+	// id=*;type=<resource.type>;output_fields=<fields> with no action.
+	case g.id == "*" &&
+		g.typ != resource.Unknown &&
+		(g.typ == r.Type ||
+			g.typ == resource.All):
+
+		return true
+
+	// id=<pin>;type=<resource.type>;actions=<action> where type can be a
+	// wildcard and this this is operating on a non-top-level type. Same for
+	// output fields only.
+	case g.id != "" &&
+		g.id == r.Pin &&
+		g.typ != resource.Unknown &&
+		(g.typ == r.Type || g.typ == resource.All) &&
+		!topLevelType(r.Type):
+
+		return true
+	}
+	return false
+}
+
 func topLevelType(typ resource.Type) bool {
 	switch typ {
-	case resource.AuthMethod,
+	case resource.AccessRequest,
+		resource.AuthMethod,
 		resource.AuthToken,
 		resource.CredentialStore,
 		resource.Group,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -337,6 +338,401 @@ func Test_ACLAllowed(t *testing.T) {
 	}
 }
 
+func Test_ACLAllowedConstraints(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2030, 1, 1, 12, 30, 0, 0, time.UTC) // a tuesday
+	r := Resource{ScopeId: "o_a", Id: "ttcp_1234567890", Type: resource.Target}
+
+	tests := []struct {
+		name               string
+		grant              string
+		principalCondition string
+		requestContext     RequestContext
+		authorized         bool
+	}{
+		{
+			name:           "unconstrained",
+			grant:          "id=*;type=target;actions=read",
+			requestContext: RequestContext{Time: now},
+			authorized:     true,
+		},
+		{
+			name:           "within time bounds",
+			grant:          "id=*;type=target;actions=read;not_before=2030-01-01T12:00:00Z;not_after=2030-01-01T13:00:00Z",
+			requestContext: RequestContext{Time: now},
+			authorized:     true,
+		},
+		{
+			name:           "before not_before",
+			grant:          "id=*;type=target;actions=read;not_before=2030-01-01T13:00:00Z",
+			requestContext: RequestContext{Time: now},
+		},
+		{
+			name:           "at not_after",
+			grant:          "id=*;type=target;actions=read;not_after=2030-01-01T12:30:00Z",
+			requestContext: RequestContext{Time: now},
+		},
+		{
+			name:           "client network matches",
+			grant:          `id=*;type=target;actions=read;condition="10.0.0.0/8" in "/request/client_networks"`,
+			requestContext: RequestContext{ClientIp: "10.1.2.3:54321", Time: now},
+			authorized:     true,
+		},
+		{
+			name:           "client network does not match",
+			grant:          `id=*;type=target;actions=read;condition="10.0.0.0/8" in "/request/client_networks"`,
+			requestContext: RequestContext{ClientIp: "192.168.1.1", Time: now},
+		},
+		{
+			name:           "missing client ip",
+			grant:          `id=*;type=target;actions=read;condition="10.0.0.0/8" in "/request/client_networks"`,
+			requestContext: RequestContext{Time: now},
+		},
+		{
+			name:           "time of day matches",
+			grant:          `id=*;type=target;actions=read;condition="/request/time/weekday" == "tuesday" and "/request/time/hour" matches "^(09|1[0-6])$"`,
+			requestContext: RequestContext{Time: now},
+			authorized:     true,
+		},
+		{
+			name:           "bad selector",
+			grant:          `id=*;type=target;actions=read;condition="/request/nope" == "tuesday"`,
+			requestContext: RequestContext{Time: now},
+		},
+		{
+			name:               "principal condition matches",
+			grant:              "id=*;type=target;actions=read",
+			principalCondition: `"/request/client_ip" == "fd00::1"`,
+			requestContext:     RequestContext{ClientIp: "[fd00::1]:9200", Time: now},
+			authorized:         true,
+		},
+		{
+			name:               "principal condition does not match",
+			grant:              `id=*;type=target;actions=read;condition="/request/time/weekday" == "tuesday"`,
+			principalCondition: `"fd00::/8" in "/request/client_networks"`,
+			requestContext:     RequestContext{ClientIp: "10.1.2.3", Time: now},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grant, err := Parse("o_a", test.grant, WithPrincipalCondition(test.principalCondition))
+			require.NoError(t, err)
+			acl := NewACL(grant).WithRequestContext(test.requestContext)
+			assert.Equal(t, test.authorized, acl.Allowed(r, action.Read).Authorized)
+		})
+	}
+}
+
+func Test_ACLAllowedDeny(t *testing.T) {
+	t.Parallel()
+
+	type actionAuthorized struct {
+		action     action.Type
+		authorized bool
+	}
+	tests := []struct {
+		name              string
+		grants            []string
+		otherScopeGrants  []string
+		resource          Resource
+		actionsAuthorized []actionAuthorized
+	}{
+		{
+			name: "deny specific id overrides wildcard allow",
+			grants: []string{
+				"id=*;type=target;actions=*",
+				"id=ttcp_prod;actions=authorize-session;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.Update, authorized: true},
+				{action: action.AuthorizeSession},
+			},
+		},
+		{
+			name: "deny specific id leaves other ids alone",
+			grants: []string{
+				"id=*;type=target;actions=*",
+				"id=ttcp_prod;actions=authorize-session;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_dev", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.AuthorizeSession, authorized: true},
+			},
+		},
+		{
+			name: "deny wildcard id overrides specific allow",
+			grants: []string{
+				"id=ttcp_prod;actions=read,authorize-session",
+				"id=*;type=target;actions=authorize-session;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.AuthorizeSession},
+			},
+		},
+		{
+			name: "deny all actions",
+			grants: []string{
+				"id=*;type=*;actions=*",
+				"id=ttcp_prod;actions=*;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.Delete},
+				{action: action.AuthorizeSession},
+			},
+		},
+		{
+			name: "deny wildcard type",
+			grants: []string{
+				"id=*;type=*;actions=*",
+				"id=*;type=*;actions=delete;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Id: "hcst_1234567890", Type: resource.HostCatalog},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.Delete},
+			},
+		},
+		{
+			name: "deny parent action denies subaction",
+			grants: []string{
+				"id=*;type=*;actions=*",
+				"id=u_1234567890;actions=read;effect=deny",
+			},
+			resource: Resource{ScopeId: "o_a", Id: "u_1234567890", Type: resource.User},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.ReadSelf},
+				{action: action.Update, authorized: true},
+			},
+		},
+		{
+			name: "deny subaction leaves parent action alone",
+			grants: []string{
+				"id=*;type=*;actions=*",
+				"id=u_1234567890;actions=read:self;effect=deny",
+			},
+			resource: Resource{ScopeId: "o_a", Id: "u_1234567890", Type: resource.User},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.ReadSelf},
+			},
+		},
+		{
+			name: "deny collection action",
+			grants: []string{
+				"type=target;actions=list,create",
+				"type=target;actions=create;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.List, authorized: true},
+				{action: action.Create},
+			},
+		},
+		{
+			name: "deny with unsatisfied condition",
+			grants: []string{
+				"id=*;type=target;actions=*",
+				`id=ttcp_prod;actions=*;effect=deny;condition="/request/client_ip" == "10.0.0.1"`,
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.AuthorizeSession, authorized: true},
+			},
+		},
+		{
+			name: "deny with condition which cannot be evaluated",
+			grants: []string{
+				"id=*;type=target;actions=*",
+				`id=ttcp_prod;actions=*;effect=deny;condition="/request/nope" == "tuesday"`,
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.AuthorizeSession},
+			},
+		},
+		{
+			name: "deny in other scope",
+			grants: []string{
+				"id=*;type=target;actions=*",
+			},
+			otherScopeGrants: []string{
+				"id=ttcp_prod;actions=*;effect=deny",
+			},
+			resource: Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.AuthorizeSession, authorized: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var grants []Grant
+			for _, g := range test.grants {
+				grant, err := Parse(test.resource.ScopeId, g)
+				require.NoError(t, err)
+				grants = append(grants, grant)
+			}
+			for _, g := range test.otherScopeGrants {
+				grant, err := Parse("p_b", g)
+				require.NoError(t, err)
+				grants = append(grants, grant)
+			}
+			acl := NewACL(grants...)
+			for _, aa := range test.actionsAuthorized {
+				result := acl.Allowed(test.resource, aa.action)
+				assert.Equal(t, aa.authorized, result.Authorized, "action: %s", aa.action)
+				if !aa.authorized {
+					assert.Empty(t, result.OutputFields)
+				}
+			}
+		})
+	}
+}
+
+func Test_ACLExplain(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2030, 1, 1, 12, 30, 0, 0, time.UTC)
+	r := Resource{ScopeId: "p_a", Id: "ttcp_prod", Type: resource.Target}
+
+	type roleGrant struct {
+		roleId string
+		grant  string
+	}
+	tests := []struct {
+		name             string
+		grants           []roleGrant
+		action           action.Type
+		wantAuthorized   bool
+		wantAllowing     []string
+		wantDenying      []string
+		wantInapplicable []string
+		wantMissing      string
+	}{
+		{
+			name: "allowed",
+			grants: []roleGrant{
+				{roleId: "r_1", grant: "id=*;type=target;actions=read,authorize-session"},
+				{roleId: "r_2", grant: "id=ttcp_prod;actions=read"},
+				{roleId: "r_3", grant: "id=ttcp_dev;actions=authorize-session"},
+				{roleId: "r_4", grant: "id=*;type=target;output_fields=id"},
+			},
+			action:         action.AuthorizeSession,
+			wantAuthorized: true,
+			wantAllowing:   []string{"r_1"},
+		},
+		{
+			name: "denied",
+			grants: []roleGrant{
+				{roleId: "r_1", grant: "id=*;type=target;actions=*"},
+				{roleId: "r_2", grant: "id=ttcp_prod;actions=authorize-session;effect=deny"},
+			},
+			action:       action.AuthorizeSession,
+			wantAllowing: []string{"r_1"},
+			wantDenying:  []string{"r_2"},
+		},
+		{
+			name: "no matching grant",
+			grants: []roleGrant{
+				{roleId: "r_1", grant: "id=*;type=target;actions=read"},
+			},
+			action:      action.AuthorizeSession,
+			wantMissing: "id=ttcp_prod;actions=authorize-session",
+		},
+		{
+			name: "inapplicable",
+			grants: []roleGrant{
+				{roleId: "r_1", grant: "id=*;type=target;actions=authorize-session;not_after=2030-01-01T12:00:00Z"},
+				{roleId: "r_2", grant: "id=ttcp_prod;actions=*;effect=deny;not_before=2030-01-01T13:00:00Z"},
+				{roleId: "r_3", grant: "id=ttcp_prod;actions=authorize-session"},
+			},
+			action:           action.AuthorizeSession,
+			wantAuthorized:   true,
+			wantAllowing:     []string{"r_3"},
+			wantInapplicable: []string{"r_1", "r_2"},
+		},
+	}
+
+	roleIds := func(grants []Grant) []string {
+		var ret []string
+		for _, g := range grants {
+			ret = append(ret, g.RoleId())
+		}
+		return ret
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var grants []Grant
+			for _, g := range test.grants {
+				grant, err := Parse(r.ScopeId, g.grant, WithRoleId(g.roleId))
+				require.NoError(t, err)
+				grants = append(grants, grant)
+			}
+			acl := NewACL(grants...).WithRequestContext(RequestContext{Time: now})
+			got := acl.Explain(r, test.action)
+			assert.Equal(t, test.wantAuthorized, got.Authorized)
+			assert.Equal(t, acl.Allowed(r, test.action).Authorized, got.Authorized)
+			assert.Equal(t, test.wantAllowing, roleIds(got.Allowing))
+			assert.Equal(t, test.wantDenying, roleIds(got.Denying))
+			assert.Equal(t, test.wantInapplicable, roleIds(got.Inapplicable))
+			assert.Len(t, got.Evaluated, len(test.grants))
+			assert.Equal(t, test.wantMissing, got.Missing)
+		})
+	}
+}
+
+func Test_missingGrant(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		resource Resource
+		action   action.Type
+		want     string
+	}{
+		{
+			name:     "id",
+			resource: Resource{ScopeId: "p_a", Id: "hst_1234567890", Type: resource.Host, Pin: "hcst_1234567890"},
+			action:   action.Read,
+			want:     "id=hst_1234567890;actions=read",
+		},
+		{
+			name:     "collection in parent",
+			resource: Resource{ScopeId: "p_a", Type: resource.Host, Pin: "hcst_1234567890"},
+			action:   action.List,
+			want:     "id=hcst_1234567890;type=host;actions=list",
+		},
+		{
+			name:     "collection",
+			resource: Resource{ScopeId: "p_a", Type: resource.Target},
+			action:   action.Create,
+			want:     "type=target;actions=create",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := missingGrant(test.resource, test.action)
+			assert.Equal(t, test.want, got)
+			// The grant must allow the action.
+			grant, err := Parse(test.resource.ScopeId, got)
+			require.NoError(t, err)
+			assert.True(t, NewACL(grant).Allowed(test.resource, test.action).Authorized)
+		})
+	}
+}
+
 func TestJsonMarshal(t *testing.T) {
 	res := &Resource{
 		ScopeId: "scope",
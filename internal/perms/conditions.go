@@ -0,0 +1,126 @@
+package perms
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-bexpr"
+)
+
+// RequestContext contains the information about a request against which the
+// time bounds and conditions of grants are evaluated.
+type RequestContext struct {
+	// ClientIp is the IP address of the client performing the request
+	ClientIp string
+
+	// Time is the time of the request. If zero, the current time is used.
+	Time time.Time
+}
+
+// now returns the time of the request, defaulting to the current time.
+func (rc RequestContext) now() time.Time {
+	if rc.Time.IsZero() {
+		return time.Now()
+	}
+	return rc.Time
+}
+
+// datum returns the value conditions are evaluated against. Conditions select
+// its fields with paths such as "/request/client_ip". Times are in UTC.
+//
+// client_networks contains every network containing the client IP, in CIDR
+// notation, so that a condition such as `"10.0.0.0/8" in
+// "/request/client_networks"` matches clients within that network.
+func (rc RequestContext) datum() map[string]interface{} {
+	t := rc.now().UTC()
+	ip := rc.ClientIp
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	return map[string]interface{}{
+		"request": map[string]interface{}{
+			"client_ip":       ip,
+			"client_networks": clientNetworks(ip),
+			"time": map[string]interface{}{
+				"date":    t.Format("2006-01-02"),
+				"hour":    fmt.Sprintf("%02d", t.Hour()),
+				"minute":  fmt.Sprintf("%02d", t.Minute()),
+				"weekday": strings.ToLower(t.Weekday().String()),
+			},
+		},
+	}
+}
+
+// clientNetworks returns the networks containing ip, from the most to the
+// least specific.
+func clientNetworks(ip string) []string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil
+	}
+	bits := 8 * net.IPv6len
+	if v4 := parsed.To4(); v4 != nil {
+		parsed, bits = v4, 8*net.IPv4len
+	}
+	networks := make([]string, 0, bits+1)
+	for ones := bits; ones >= 0; ones-- {
+		n := net.IPNet{IP: parsed.Mask(net.CIDRMask(ones, bits)), Mask: net.CIDRMask(ones, bits)}
+		networks = append(networks, n.String())
+	}
+	return networks
+}
+
+// compileCondition returns an evaluator for the condition.
+func compileCondition(condition string) (*bexpr.Evaluator, error) {
+	const op = "perms.compileCondition"
+	eval, err := bexpr.CreateEvaluator(condition)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("invalid condition %q", condition)))
+	}
+	return eval, nil
+}
+
+// ValidateCondition returns an error if the condition is not a valid boolean
+// expression.
+func ValidateCondition(condition string) error {
+	const op = "perms.ValidateCondition"
+	if _, err := compileCondition(condition); err != nil {
+		return errors.WrapDeprecated(err, op)
+	}
+	return nil
+}
+
+// applies returns whether the time bounds and conditions of the grant are
+// satisfied by the request. Conditions which cannot be evaluated, e.g.
+// because they select fields missing from the request context, fail closed:
+// an allow grant with such a condition does not apply while a deny grant
+// does.
+func (g Grant) applies(rc RequestContext) bool {
+	now := rc.now()
+	if !g.notBefore.IsZero() && now.Before(g.notBefore) {
+		return false
+	}
+	if !g.notAfter.IsZero() && !now.Before(g.notAfter) {
+		return false
+	}
+	if g.conditionEval == nil && g.principalConditionEval == nil {
+		return true
+	}
+	datum := rc.datum()
+	for _, eval := range []*bexpr.Evaluator{g.conditionEval, g.principalConditionEval} {
+		if eval == nil {
+			continue
+		}
+		ok, err := eval.Evaluate(datum)
+		switch {
+		case err != nil:
+			return g.effect == EffectDeny
+		case !ok:
+			return false
+		}
+	}
+	return true
+}
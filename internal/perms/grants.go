@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/go-bexpr"
 )
 
 // GrantTuple is simply a struct that can be reference from other code to return
@@ -18,6 +20,10 @@ type GrantTuple struct {
 	RoleId  string
 	ScopeId string
 	Grant   string
+
+	// Condition is the condition of the assignment of the role to the
+	// principal, if any
+	Condition string
 }
 
 // Scope provides an in-memory representation of iam.Scope without the
@@ -30,6 +36,18 @@ type Scope struct {
 	Type scope.Type
 }
 
+// Effect is the effect of a grant on the actions it matches.
+type Effect string
+
+const (
+	// EffectAllow grants the actions. It is the effect of grants which do not
+	// specify one.
+	EffectAllow Effect = "allow"
+
+	// EffectDeny denies the actions, overriding any grant allowing them.
+	EffectDeny Effect = "deny"
+)
+
 // Grant is a Go representation of a parsed grant
 type Grant struct {
 	// The scope ID, which will be a project ID or an org ID
@@ -47,6 +65,25 @@ type Grant struct {
 	// The set of output fields granted
 	OutputFields OutputFieldsMap
 
+	// The effect of the grant, if provided
+	effect Effect
+
+	// The time bounds of the grant, if provided. The grant applies from
+	// notBefore and until notAfter.
+	notBefore time.Time
+	notAfter  time.Time
+
+	// The condition of the grant, if provided, and its evaluator
+	condition     string
+	conditionEval *bexpr.Evaluator
+
+	// The evaluator of the condition of the assignment of the grant's role to
+	// the principal, if provided
+	principalConditionEval *bexpr.Evaluator
+
+	// The ID of the role the grant belongs to, if provided
+	roleId string
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
@@ -60,6 +97,35 @@ func (g Grant) Type() resource.Type {
 	return g.typ
 }
 
+// Effect returns the effect of the grant, which defaults to EffectAllow.
+func (g Grant) Effect() Effect {
+	if g.effect == "" {
+		return EffectAllow
+	}
+	return g.effect
+}
+
+// NotBefore returns the time from which the grant applies, if provided.
+func (g Grant) NotBefore() time.Time {
+	return g.notBefore
+}
+
+// NotAfter returns the time until which the grant applies, if provided.
+func (g Grant) NotAfter() time.Time {
+	return g.notAfter
+}
+
+// Condition returns the condition of the grant, if provided.
+func (g Grant) Condition() string {
+	return g.condition
+}
+
+// RoleId returns the ID of the role the grant belongs to, if provided when
+// parsing the grant.
+func (g Grant) RoleId() string {
+	return g.roleId
+}
+
 func (g Grant) Actions() (typs []action.Type, strs []string) {
 	typs = make([]action.Type, 0, len(g.actions))
 	strs = make([]string, 0, len(g.actions))
@@ -76,9 +142,16 @@ func (g Grant) Actions() (typs []action.Type, strs []string) {
 
 func (g Grant) clone() *Grant {
 	ret := &Grant{
-		scope: g.scope,
-		id:    g.id,
-		typ:   g.typ,
+		scope:                  g.scope,
+		id:                     g.id,
+		typ:                    g.typ,
+		effect:                 g.effect,
+		notBefore:              g.notBefore,
+		notAfter:               g.notAfter,
+		condition:              g.condition,
+		conditionEval:          g.conditionEval,
+		principalConditionEval: g.principalConditionEval,
+		roleId:                 g.roleId,
 	}
 	if g.actionsBeingParsed != nil {
 		ret.actionsBeingParsed = append(ret.actionsBeingParsed, g.actionsBeingParsed...)
@@ -123,6 +196,23 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(g.OutputFields.Fields(), ",")))
 	}
 
+	if g.effect == EffectDeny {
+		builder = append(builder, fmt.Sprintf("effect=%s", g.effect))
+	}
+
+	if !g.notBefore.IsZero() {
+		builder = append(builder, fmt.Sprintf("not_before=%s", g.notBefore.UTC().Format(time.RFC3339)))
+	}
+
+	if !g.notAfter.IsZero() {
+		builder = append(builder, fmt.Sprintf("not_after=%s", g.notAfter.UTC().Format(time.RFC3339)))
+	}
+
+	// The condition must come last as it may contain semicolons
+	if g.condition != "" {
+		builder = append(builder, fmt.Sprintf("condition=%s", g.condition))
+	}
+
 	return strings.Join(builder, ";")
 }
 
@@ -147,6 +237,18 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 	if len(g.OutputFields) > 0 {
 		res["output_fields"] = g.OutputFields.Fields()
 	}
+	if g.effect == EffectDeny {
+		res["effect"] = g.effect
+	}
+	if !g.notBefore.IsZero() {
+		res["not_before"] = g.notBefore.UTC().Format(time.RFC3339)
+	}
+	if !g.notAfter.IsZero() {
+		res["not_after"] = g.notAfter.UTC().Format(time.RFC3339)
+	}
+	if g.condition != "" {
+		res["condition"] = g.condition
+	}
 	b, err := json.Marshal(res)
 	if err != nil {
 		return nil, errors.WrapDeprecated(err, op, errors.WithCode(errors.Encode))
@@ -220,13 +322,81 @@ func (g *Grant) unmarshalJSON(data []byte) error {
 			}
 		}
 	}
+	if rawEffect, ok := raw["effect"]; ok {
+		effect, ok := rawEffect.(string)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", "effect"))
+		}
+		if err := g.setEffect(effect); err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+	}
+	for _, k := range []string{"not_before", "not_after"} {
+		rawTime, ok := raw[k]
+		if !ok {
+			continue
+		}
+		timeStr, ok := rawTime.(string)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", k))
+		}
+		if err := g.setTimeBound(k, timeStr); err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+	}
+	if rawCondition, ok := raw["condition"]; ok {
+		condition, ok := rawCondition.(string)
+		if !ok {
+			return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", "condition"))
+		}
+		g.condition = condition
+	}
+	return nil
+}
+
+// setEffect sets the effect of the grant from its string representation.
+func (g *Grant) setEffect(value string) error {
+	const op = "perms.(Grant).setEffect"
+	switch effect := Effect(strings.ToLower(value)); effect {
+	case EffectAllow, EffectDeny:
+		g.effect = effect
+	default:
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown effect %q", value))
+	}
+	return nil
+}
+
+// setTimeBound sets the not_before or not_after time bound of the grant from
+// its RFC 3339 representation.
+func (g *Grant) setTimeBound(key, value string) error {
+	const op = "perms.(Grant).setTimeBound"
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unable to parse %q value %q as an RFC 3339 time", key, value))
+	}
+	switch key {
+	case "not_before":
+		g.notBefore = t
+	case "not_after":
+		g.notAfter = t
+	}
 	return nil
 }
 
 func (g *Grant) unmarshalText(grantString string) error {
 	const op = "perms.(Grant).unmarshalText"
 	segments := strings.Split(grantString, ";")
-	for _, segment := range segments {
+	for i, segment := range segments {
+		// The condition is always the last segment, and may itself contain
+		// semicolons and equal signs
+		if strings.HasPrefix(segment, "condition=") {
+			g.condition = strings.TrimPrefix(strings.Join(segments[i:], ";"), "condition=")
+			if g.condition == "" {
+				return errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("segment %q not formatted correctly, missing value", segment))
+			}
+			break
+		}
+
 		kv := strings.Split(segment, "=")
 
 		// Ensure we don't accept "foo=bar=baz", "=foo", or "foo="
@@ -264,6 +434,16 @@ func (g *Grant) unmarshalText(grantString string) error {
 
 		case "output_fields":
 			g.OutputFields = g.OutputFields.AddFields(strings.Split(kv[1], ","))
+
+		case "effect":
+			if err := g.setEffect(kv[1]); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
+
+		case "not_before", "not_after":
+			if err := g.setTimeBound(kv[0], kv[1]); err != nil {
+				return errors.WrapDeprecated(err, op)
+			}
 		}
 	}
 
@@ -286,8 +466,11 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		return Grant{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing scope id")
 	}
 
+	opts := getOpts(opt...)
+
 	grant := Grant{
-		scope: Scope{Id: scopeId},
+		scope:  Scope{Id: scopeId},
+		roleId: opts.withRoleId,
 	}
 	switch {
 	case scopeId == scope.Global.String():
@@ -312,8 +495,6 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		}
 	}
 
-	opts := getOpts(opt...)
-
 	// Check for templated values ID, and substitute in with the authenticated values
 	// if so
 	if grant.id != "" && strings.HasPrefix(grant.id, "{{") {
@@ -341,6 +522,14 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		return Grant{}, errors.WrapDeprecated(err, op)
 	}
 
+	if err := grant.validateEffect(); err != nil {
+		return Grant{}, errors.WrapDeprecated(err, op)
+	}
+
+	if err := grant.validateConstraints(opts.withPrincipalCondition); err != nil {
+		return Grant{}, errors.WrapDeprecated(err, op)
+	}
+
 	if !opts.withSkipFinalValidation {
 		// Filter out some forms that don't make sense
 
@@ -396,8 +585,14 @@ func Parse(scopeId, grantString string, opt ...Option) (Grant, error) {
 		// This might be zero if output fields is populated
 		if len(grant.actions) > 0 {
 			// Create a dummy resource and pass it through Allowed and ensure that
-			// we get allowed.
-			acl := NewACL(grant)
+			// we get allowed. Time bounds and conditions are left out as they
+			// depend on the request, and deny grants are checked as if they
+			// were allowing the actions they match.
+			unconstrained := grant.clone()
+			unconstrained.effect = EffectAllow
+			unconstrained.notBefore, unconstrained.notAfter = time.Time{}, time.Time{}
+			unconstrained.conditionEval, unconstrained.principalConditionEval = nil, nil
+			acl := NewACL(*unconstrained)
 			r := Resource{
 				ScopeId: scopeId,
 				Id:      grant.id,
@@ -435,6 +630,46 @@ func (g Grant) validateType() error {
 	return nil
 }
 
+// validateEffect ensures that deny grants deny actions. Output fields are
+// meaningless on deny grants as nothing is returned for denied actions.
+func (g Grant) validateEffect() error {
+	const op = "perms.(Grant).validateEffect"
+	if g.effect != EffectDeny {
+		return nil
+	}
+	if len(g.actions) == 0 {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "deny grants must specify actions")
+	}
+	if g.OutputFields != nil {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "deny grants cannot specify output fields")
+	}
+	return nil
+}
+
+// validateConstraints ensures the time bounds of the grant are ordered and
+// compiles its condition and the principal condition, if any.
+func (g *Grant) validateConstraints(principalCondition string) error {
+	const op = "perms.(Grant).validateConstraints"
+	if !g.notBefore.IsZero() && !g.notAfter.IsZero() && !g.notBefore.Before(g.notAfter) {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "not_before must be before not_after")
+	}
+	if g.condition != "" {
+		eval, err := compileCondition(g.condition)
+		if err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+		g.conditionEval = eval
+	}
+	if principalCondition != "" {
+		eval, err := compileCondition(principalCondition)
+		if err != nil {
+			return errors.WrapDeprecated(err, op)
+		}
+		g.principalConditionEval = eval
+	}
+	return nil
+}
+
 func (g *Grant) parseAndValidateActions() error {
 	const op = "perms.(Grant).parseAndValidateActions"
 	if len(g.actionsBeingParsed) == 0 {
@@ -3,6 +3,7 @@ package perms
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/types/action"
@@ -108,7 +109,7 @@ func Test_ActionParsingValidation(t *testing.T) {
 func Test_ValidateType(t *testing.T) {
 	t.Parallel()
 	var g Grant
-	for i := resource.Unknown; i <= resource.CredentialLibrary; i++ {
+	for i := resource.Unknown; i <= resource.AccessRequest; i++ {
 		g.typ = i
 		if i == resource.Controller || i == resource.Worker {
 			assert.Error(t, g.validateType())
@@ -190,6 +191,38 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"id":"baz","output_fields":["id","name","version"],"type":"group"}`,
 			canonicalString: `id=baz;type=group;actions=create,read;output_fields=id,name,version`,
 		},
+		{
+			name: "deny",
+			input: Grant{
+				id: "baz",
+				scope: Scope{
+					Type: scope.Project,
+				},
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				actionsBeingParsed: []string{"delete"},
+				effect:             EffectDeny,
+			},
+			jsonOutput:      `{"actions":["delete"],"effect":"deny","id":"baz"}`,
+			canonicalString: `id=baz;actions=delete;effect=deny`,
+		},
+		{
+			name: "explicit allow",
+			input: Grant{
+				id: "baz",
+				scope: Scope{
+					Type: scope.Project,
+				},
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				actionsBeingParsed: []string{"delete"},
+				effect:             EffectAllow,
+			},
+			jsonOutput:      `{"actions":["delete"],"id":"baz"}`,
+			canonicalString: `id=baz;actions=delete`,
+		},
 	}
 
 	for _, test := range tests {
@@ -676,6 +709,73 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "good deny",
+			input: "id=ttcp_prod;actions=authorize-session;effect=deny",
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id: "ttcp_prod",
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				effect: EffectDeny,
+			},
+		},
+		{
+			name:  "good json deny",
+			input: `{"id":"*","type":"target","actions":["*"],"effect":"DENY"}`,
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.All: true,
+				},
+				effect: EffectDeny,
+			},
+		},
+		{
+			name:  "good explicit allow",
+			input: "id=*;type=target;actions=read;effect=allow",
+			expected: Grant{
+				scope: Scope{
+					Id:   "o_scope",
+					Type: scope.Org,
+				},
+				id:  "*",
+				typ: resource.Target,
+				actions: map[action.Type]bool{
+					action.Read: true,
+				},
+				effect: EffectAllow,
+			},
+		},
+		{
+			name:  "bad effect",
+			input: "id=*;type=target;actions=read;effect=maybe",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(Grant).setEffect: unknown effect "maybe": parameter violation: error #100`,
+		},
+		{
+			name:  "bad json effect",
+			input: `{"id":"*","type":"target","actions":["read"],"effect":true}`,
+			err:   `perms.Parse: unable to parse JSON grant string: perms.(Grant).unmarshalJSON: unable to interpret "effect" as string: parameter violation: error #100`,
+		},
+		{
+			name:  "deny without actions",
+			input: "id=*;type=target;output_fields=id;effect=deny",
+			err:   "perms.Parse: perms.(Grant).validateEffect: deny grants must specify actions: parameter violation: error #100",
+		},
+		{
+			name:  "deny with output fields",
+			input: "id=*;type=target;actions=read;output_fields=id;effect=deny",
+			err:   "perms.Parse: perms.(Grant).validateEffect: deny grants cannot specify output fields: parameter violation: error #100",
+		},
 	}
 
 	_, err := Parse("", "")
@@ -705,3 +805,104 @@ func Test_Parse(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseConstraints(t *testing.T) {
+	t.Parallel()
+
+	notBefore := time.Date(2030, 1, 1, 9, 0, 0, 0, time.UTC)
+	notAfter := time.Date(2030, 1, 2, 17, 0, 0, 0, time.UTC)
+
+	type input struct {
+		name               string
+		input              string
+		principalCondition string
+		err                string
+		notBefore          time.Time
+		notAfter           time.Time
+		condition          string
+		canonical          string
+	}
+
+	tests := []input{
+		{
+			name:      "text time bounds",
+			input:     "id=*;type=target;actions=read;not_before=2030-01-01T09:00:00Z;not_after=2030-01-02T17:00:00Z",
+			notBefore: notBefore,
+			notAfter:  notAfter,
+			canonical: "id=*;type=target;actions=read;not_before=2030-01-01T09:00:00Z;not_after=2030-01-02T17:00:00Z",
+		},
+		{
+			name:      "json time bounds",
+			input:     `{"id":"*","type":"target","actions":["read"],"not_before":"2030-01-01T10:00:00+01:00","not_after":"2030-01-02T17:00:00Z"}`,
+			notBefore: notBefore,
+			notAfter:  notAfter,
+			canonical: "id=*;type=target;actions=read;not_before=2030-01-01T09:00:00Z;not_after=2030-01-02T17:00:00Z",
+		},
+		{
+			name:  "bad time",
+			input: "id=*;type=target;actions=read;not_before=tomorrow",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: perms.(Grant).setTimeBound: unable to parse "not_before" value "tomorrow" as an RFC 3339 time: parameter violation: error #100`,
+		},
+		{
+			name:  "bad json time",
+			input: `{"id":"*","type":"target","actions":["read"],"not_after":5}`,
+			err:   `perms.Parse: unable to parse JSON grant string: perms.(Grant).unmarshalJSON: unable to interpret "not_after" as string: parameter violation: error #100`,
+		},
+		{
+			name:  "unordered time bounds",
+			input: "id=*;type=target;actions=read;not_before=2030-01-02T17:00:00Z;not_after=2030-01-01T09:00:00Z",
+			err:   "perms.Parse: perms.(Grant).validateConstraints: not_before must be before not_after: parameter violation: error #100",
+		},
+		{
+			name:      "text condition",
+			input:     `id=*;type=target;actions=read;condition="10.0.0.0/8" in "/request/client_networks" and "/request/time/weekday" != "a;b=c"`,
+			condition: `"10.0.0.0/8" in "/request/client_networks" and "/request/time/weekday" != "a;b=c"`,
+			canonical: `id=*;type=target;actions=read;condition="10.0.0.0/8" in "/request/client_networks" and "/request/time/weekday" != "a;b=c"`,
+		},
+		{
+			name:      "json condition",
+			input:     `{"id":"*","type":"target","actions":["read"],"condition":"\"/request/client_ip\" == \"10.0.0.1\""}`,
+			condition: `"/request/client_ip" == "10.0.0.1"`,
+			canonical: `id=*;type=target;actions=read;condition="/request/client_ip" == "10.0.0.1"`,
+		},
+		{
+			name:  "empty condition",
+			input: "id=*;type=target;actions=read;condition=",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: segment "condition=" not formatted correctly, missing value: parameter violation: error #100`,
+		},
+		{
+			name:  "bad condition",
+			input: "id=*;type=target;actions=read;condition=/request/client_ip ==",
+			err:   "perms.Parse: perms.(Grant).validateConstraints: perms.compileCondition: invalid condition",
+		},
+		{
+			name:               "bad principal condition",
+			input:              "id=*;type=target;actions=read",
+			principalCondition: "nope nope",
+			err:                "perms.Parse: perms.(Grant).validateConstraints: perms.compileCondition: invalid condition",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			grant, err := Parse("o_scope", test.input, WithPrincipalCondition(test.principalCondition))
+			if test.err != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), test.err)
+				return
+			}
+			require.NoError(err)
+			assert.True(test.notBefore.Equal(grant.NotBefore()))
+			assert.True(test.notAfter.Equal(grant.NotAfter()))
+			assert.Equal(test.condition, grant.Condition())
+			assert.Equal(test.condition != "", grant.conditionEval != nil)
+			assert.Equal(test.canonical, grant.CanonicalString())
+
+			// The canonical string must parse back to the same grant
+			reparsed, err := Parse("o_scope", grant.CanonicalString())
+			require.NoError(err)
+			assert.Equal(grant.CanonicalString(), reparsed.CanonicalString())
+		})
+	}
+}
@@ -17,6 +17,8 @@ type options struct {
 	withUserId              string
 	withAccountId           string
 	withSkipFinalValidation bool
+	withPrincipalCondition  string
+	withRoleId              string
 }
 
 func getDefaultOptions() options {
@@ -45,3 +47,20 @@ func WithSkipFinalValidation(skipFinalValidation bool) Option {
 		o.withSkipFinalValidation = skipFinalValidation
 	}
 }
+
+// WithPrincipalCondition provides the condition of the assignment of the
+// grant's role to the principal, which must be satisfied alongside the
+// grant's own condition for the grant to apply
+func WithPrincipalCondition(condition string) Option {
+	return func(o *options) {
+		o.withPrincipalCondition = condition
+	}
+}
+
+// WithRoleId provides the ID of the role the grant belongs to, which is
+// reported when explaining ACL decisions
+func WithRoleId(roleId string) Option {
+	return func(o *options) {
+		o.withRoleId = roleId
+	}
+}
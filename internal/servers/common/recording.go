@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// RecordingPath is the path of the worker proxy listener endpoint serving the
+// recordings of the connections proxied by the worker.
+const RecordingPath = "/v1/recording"
+
+// RecordingHopsHeader lists, comma separated, the proxy addresses of the
+// workers through which a request for a recording is forwarded before reaching
+// the worker holding the recording.
+const RecordingHopsHeader = "X-Boundary-Recording-Hops"
+
+// ErrRecordingNotFound is returned by FetchRecording when the worker holding
+// the recording does not have it.
+var ErrRecordingNotFound = stderrors.New("recording not found")
+
+// FetchRecording requests the recording of the connection connectionId of the
+// session sessionId from the worker whose proxy listener is at the last of
+// addrs, through the workers at the preceding addresses. tlsConf must be a
+// worker auth configuration offering the worker recording proto; as its
+// connection nonce can only be used once, it must not be reused. The caller
+// must close the returned reader.
+func FetchRecording(ctx context.Context, tlsConf *tls.Config, addrs []string, sessionId, connectionId string) (io.ReadCloser, error) {
+	if len(addrs) == 0 {
+		return nil, stderrors.New("missing worker addresses")
+	}
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = tlsConf
+	transport.DisableKeepAlives = true
+	transport.ForceAttemptHTTP2 = false
+
+	u := url.URL{
+		Scheme: "https",
+		Host:   addrs[0],
+		Path:   RecordingPath,
+		RawQuery: url.Values{
+			"session_id":    []string{sessionId},
+			"connection_id": []string{connectionId},
+		}.Encode(),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording request: %w", err)
+	}
+	if len(addrs) > 1 {
+		req.Header.Set(RecordingHopsHeader, strings.Join(addrs[1:], ","))
+	}
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting recording from worker at %s: %w", addrs[0], err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, ErrRecordingNotFound
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %q requesting recording from worker at %s", resp.Status, addrs[0])
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/gen/controller/tokens"
+	"github.com/hashicorp/boundary/internal/iam"
 
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
@@ -539,16 +540,34 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 		return
 	}
 
-	var parsedGrants []perms.Grant
-
-	// Fetch and parse grants for this user ID (which may include grants for
-	// u_anon and u_auth)
-	grantTuples, err = iamRepo.GrantsForUser(v.ctx, userId)
+	retAcl, grantTuples, err = userAcl(v.ctx, iamRepo, userId, accountId, perms.RequestContext{
+		ClientIp: v.requestInfo.ClientIp,
+		Time:     time.Now(),
+	})
 	if err != nil {
 		retErr = errors.Wrap(ctx, err, op)
 		return
 	}
-	parsedGrants = make([]perms.Grant, 0, len(grantTuples))
+	aclResults = retAcl.Allowed(*v.res, v.act)
+	// We don't set authenticated above because setting this but not authorized
+	// is used for further permissions checks, such as during recursive listing.
+	// So we want to make sure any code relying on that has the full set of
+	// grants successfully loaded.
+	aclResults.AuthenticationFinished = true
+	retErr = nil
+	return
+}
+
+// userAcl fetches and parses the grants for the user ID (which may include
+// grants for u_anon and u_auth) and returns the resulting ACL, evaluating time
+// bounds and conditions against rc, along with the grants.
+func userAcl(ctx context.Context, iamRepo *iam.Repository, userId, accountId string, rc perms.RequestContext) (perms.ACL, []perms.GrantTuple, error) {
+	const op = "auth.userAcl"
+	grantTuples, err := iamRepo.GrantsForUser(ctx, userId)
+	if err != nil {
+		return perms.ACL{}, nil, errors.Wrap(ctx, err, op)
+	}
+	parsedGrants := make([]perms.Grant, 0, len(grantTuples))
 	// Note: Below, we always skip validation so that we don't error on formats
 	// that we've since restricted, e.g. "id=foo;actions=create,read". These
 	// will simply not have an effect.
@@ -558,23 +577,29 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 			pair.Grant,
 			perms.WithUserId(userId),
 			perms.WithAccountId(accountId),
+			perms.WithPrincipalCondition(pair.Condition),
+			perms.WithRoleId(pair.RoleId),
 			perms.WithSkipFinalValidation(true))
 		if err != nil {
-			retErr = errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", pair.Grant)))
-			return
+			return perms.ACL{}, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to parse grant %#v", pair.Grant)))
 		}
 		parsedGrants = append(parsedGrants, parsed)
 	}
+	return perms.NewACL(parsedGrants...).WithRequestContext(rc), grantTuples, nil
+}
 
-	retAcl = perms.NewACL(parsedGrants...)
-	aclResults = retAcl.Allowed(*v.res, v.act)
-	// We don't set authenticated above because setting this but not authorized
-	// is used for further permissions checks, such as during recursive listing.
-	// So we want to make sure any code relying on that has the full set of
-	// grants successfully loaded.
-	aclResults.AuthenticationFinished = true
-	retErr = nil
-	return
+// CheckPermissions determines whether the user is authorized to perform the
+// action on the resource, evaluating the user's grants the same way Verify
+// does for requests made by the user, and returns the grants determining the
+// decision. Time bounds and conditions are evaluated against rc. As no auth
+// token is involved, {{account.id}} templates in grants are not substituted.
+func CheckPermissions(ctx context.Context, iamRepo *iam.Repository, userId string, res perms.Resource, act action.Type, rc perms.RequestContext) (perms.Explanation, error) {
+	const op = "auth.CheckPermissions"
+	acl, _, err := userAcl(ctx, iamRepo, userId, "", rc)
+	if err != nil {
+		return perms.Explanation{}, errors.Wrap(ctx, err, op)
+	}
+	return acl.Explain(res, act), nil
 }
 
 // FetchActionSetForId returns the allowed actions for a given ID using the
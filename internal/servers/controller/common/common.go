@@ -1,9 +1,16 @@
 package common
 
 import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/host/health"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -14,15 +21,23 @@ import (
 )
 
 type (
-	AuthTokenRepoFactory       = oidc.AuthTokenRepoFactory
-	VaultCredentialRepoFactory = func() (*vault.Repository, error)
-	IamRepoFactory             func() (*iam.Repository, error)
-	OidcAuthRepoFactory        = oidc.OidcRepoFactory
-	PasswordAuthRepoFactory    func() (*password.Repository, error)
-	ServersRepoFactory         func() (*servers.Repository, error)
-	StaticRepoFactory          func() (*static.Repository, error)
-	PluginHostRepoFactory      func() (*pluginhost.Repository, error)
-	HostPluginRepoFactory      func() (*hostplugin.Repository, error)
-	SessionRepoFactory         func() (*session.Repository, error)
-	TargetRepoFactory          func() (*target.Repository, error)
+	AccessRequestRepoFactory    func() (*accessrequest.Repository, error)
+	AuthTokenRepoFactory        = oidc.AuthTokenRepoFactory
+	VaultCredentialRepoFactory  = func() (*vault.Repository, error)
+	StaticCredentialRepoFactory = func() (*credstatic.Repository, error)
+	IamRepoFactory              func() (*iam.Repository, error)
+	OidcAuthRepoFactory         = oidc.OidcRepoFactory
+	LdapAuthRepoFactory         func() (*ldap.Repository, error)
+	PasswordAuthRepoFactory     func() (*password.Repository, error)
+	ServersRepoFactory          func() (*servers.Repository, error)
+	StaticRepoFactory           func() (*static.Repository, error)
+	PluginHostRepoFactory       func() (*pluginhost.Repository, error)
+	HostHealthRepoFactory       func() (*health.Repository, error)
+	HostPluginRepoFactory       func() (*hostplugin.Repository, error)
+	SessionRepoFactory          func() (*session.Repository, error)
+	TargetRepoFactory           func() (*target.Repository, error)
 )
+
+// RecordingFetcher returns the recording of the connection connectionId of the
+// session sessionId held by the worker workerId.
+type RecordingFetcher func(ctx context.Context, workerId, sessionId, connectionId string) (io.ReadCloser, error)
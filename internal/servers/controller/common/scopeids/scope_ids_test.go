@@ -2,6 +2,7 @@ package scopeids_test
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -47,7 +48,10 @@ func TestListingScopeIds(t *testing.T) {
 	sessionsRepoFn := func() (*session.Repository, error) {
 		return session.NewRepository(rw, rw, kms)
 	}
-	sess, err := sessions.NewService(sessionsRepoFn, iamRepoFn)
+	fetchRecordingFn := func(context.Context, string, string, string) (io.ReadCloser, error) {
+		return nil, nil
+	}
+	sess, err := sessions.NewService(sessionsRepoFn, iamRepoFn, fetchRecordingFn, 0)
 	require.NoError(t, err)
 
 	tcs := []struct {
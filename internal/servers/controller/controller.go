@@ -9,18 +9,25 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/health"
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/plugin/inventory"
+	"github.com/hashicorp/boundary/internal/host/plugin/kubernetes"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/plugin/host"
 	hostplugin "github.com/hashicorp/boundary/internal/plugin/host"
 	"github.com/hashicorp/boundary/internal/scheduler"
@@ -62,17 +69,21 @@ type Controller struct {
 	gatewayMux      *runtime.ServeMux
 
 	// Repo factory methods
-	AuthTokenRepoFn       common.AuthTokenRepoFactory
-	VaultCredentialRepoFn common.VaultCredentialRepoFactory
-	IamRepoFn             common.IamRepoFactory
-	OidcRepoFn            common.OidcAuthRepoFactory
-	PasswordAuthRepoFn    common.PasswordAuthRepoFactory
-	ServersRepoFn         common.ServersRepoFactory
-	SessionRepoFn         common.SessionRepoFactory
-	StaticHostRepoFn      common.StaticRepoFactory
-	PluginHostRepoFn      common.PluginHostRepoFactory
-	HostPluginRepoFn      common.HostPluginRepoFactory
-	TargetRepoFn          common.TargetRepoFactory
+	AccessRequestRepoFn    common.AccessRequestRepoFactory
+	AuthTokenRepoFn        common.AuthTokenRepoFactory
+	VaultCredentialRepoFn  common.VaultCredentialRepoFactory
+	StaticCredentialRepoFn common.StaticCredentialRepoFactory
+	IamRepoFn              common.IamRepoFactory
+	OidcRepoFn             common.OidcAuthRepoFactory
+	LdapRepoFn             common.LdapAuthRepoFactory
+	PasswordAuthRepoFn     common.PasswordAuthRepoFactory
+	ServersRepoFn          common.ServersRepoFactory
+	SessionRepoFn          common.SessionRepoFactory
+	StaticHostRepoFn       common.StaticRepoFactory
+	PluginHostRepoFn       common.PluginHostRepoFactory
+	HostHealthRepoFn       common.HostHealthRepoFactory
+	HostPluginRepoFn       common.HostPluginRepoFactory
+	TargetRepoFn           common.TargetRepoFactory
 
 	scheduler *scheduler.Scheduler
 
@@ -135,6 +146,16 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			if _, err = conf.RegisterHostPlugin(ctx, "loopback", plg, opts...); err != nil {
 				return nil, err
 			}
+		case base.EnabledPluginHostInventory:
+			plg := pluginhost.NewWrappingPluginClient(inventory.NewPlugin())
+			if _, err := conf.RegisterHostPlugin(ctx, "inventory", plg, hostplugin.WithDescription("Built-in inventory host plugin")); err != nil {
+				return nil, fmt.Errorf("error registering inventory host plugin: %w", err)
+			}
+		case base.EnabledPluginHostKubernetes:
+			plg := pluginhost.NewWrappingPluginClient(kubernetes.NewPlugin())
+			if _, err := conf.RegisterHostPlugin(ctx, "kubernetes", plg, hostplugin.WithDescription("Built-in kubernetes host plugin")); err != nil {
+				return nil, fmt.Errorf("error registering kubernetes host plugin: %w", err)
+			}
 		case base.EnabledPluginHostAzure, base.EnabledPluginHostAws:
 			pluginType := strings.ToLower(enabledPlugin.String())
 			client, cleanup, err := external_host_plugins.CreateHostPlugin(
@@ -159,6 +180,13 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 
 	// Set up repo stuff
 	dbase := db.New(c.conf.Database)
+	sqlDb, err := c.conf.Database.SqlDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting sql database: %w", err)
+	}
+	if err := metrics.RegisterDatabase(sqlDb); err != nil {
+		return nil, fmt.Errorf("error registering database metrics: %w", err)
+	}
 	kmsRepo, err := kms.NewRepository(dbase, dbase)
 	if err != nil {
 		return nil, fmt.Errorf("error creating kms repository: %w", err)
@@ -185,7 +213,7 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error getting audit wrapper from kms: %w", err)
 	}
-	if c.conf.Eventer.RotateAuditWrapper(ctx, auditWrapper); err != nil {
+	if err := c.conf.Eventer.RotateAuditWrapper(ctx, auditWrapper); err != nil {
 		return nil, fmt.Errorf("error rotating eventer audit wrapper: %w", err)
 	}
 	jobRepoFn := func() (*job.Repository, error) {
@@ -201,8 +229,11 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error creating new scheduler: %w", err)
 	}
+	// accessRevoker revokes the access of users when they or their accounts
+	// are deleted or disabled.
+	accessRevoker := session.NewAccessRevoker()
 	c.IamRepoFn = func() (*iam.Repository, error) {
-		return iam.NewRepository(dbase, dbase, c.kms, iam.WithRandomReader(c.conf.SecureRandomReader))
+		return iam.NewRepository(dbase, dbase, c.kms, iam.WithRandomReader(c.conf.SecureRandomReader), iam.WithAccessRevoker(accessRevoker))
 	}
 	c.StaticHostRepoFn = func() (*static.Repository, error) {
 		return static.NewRepository(dbase, dbase, c.kms)
@@ -210,6 +241,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.PluginHostRepoFn = func() (*pluginhost.Repository, error) {
 		return pluginhost.NewRepository(dbase, dbase, c.kms, c.scheduler, c.conf.HostPlugins)
 	}
+	c.HostHealthRepoFn = func() (*health.Repository, error) {
+		return health.NewRepository(dbase, dbase, c.kms)
+	}
 	c.HostPluginRepoFn = func() (*host.Repository, error) {
 		return host.NewRepository(dbase, dbase, c.kms)
 	}
@@ -221,14 +255,20 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.VaultCredentialRepoFn = func() (*vault.Repository, error) {
 		return vault.NewRepository(dbase, dbase, c.kms, c.scheduler)
 	}
+	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(dbase, dbase, c.kms)
+	}
 	c.ServersRepoFn = func() (*servers.Repository, error) {
 		return servers.NewRepository(dbase, dbase, c.kms)
 	}
 	c.OidcRepoFn = func() (*oidc.Repository, error) {
-		return oidc.NewRepository(ctx, dbase, dbase, c.kms)
+		return oidc.NewRepository(ctx, dbase, dbase, c.kms, oidc.WithAccessRevoker(accessRevoker))
+	}
+	c.LdapRepoFn = func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, dbase, dbase, c.kms, ldap.WithAccessRevoker(accessRevoker))
 	}
 	c.PasswordAuthRepoFn = func() (*password.Repository, error) {
-		return password.NewRepository(dbase, dbase, c.kms)
+		return password.NewRepository(dbase, dbase, c.kms, password.WithAccessRevoker(accessRevoker))
 	}
 	c.TargetRepoFn = func() (*target.Repository, error) {
 		return target.NewRepository(dbase, dbase, c.kms)
@@ -236,7 +276,9 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.SessionRepoFn = func() (*session.Repository, error) {
 		return session.NewRepository(dbase, dbase, c.kms)
 	}
-
+	c.AccessRequestRepoFn = func() (*accessrequest.Repository, error) {
+		return accessrequest.NewRepository(dbase, dbase, c.kms)
+	}
 	return c, nil
 }
 
@@ -290,10 +332,16 @@ func (c *Controller) registerJobs() error {
 	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
 		return err
 	}
+	if err := accessrequest.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 
 	if err := c.registerSessionCleanupJob(); err != nil {
 		return err
 	}
+	if err := c.registerPrincipalRoleCleanupJob(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -312,6 +360,20 @@ func (c *Controller) registerSessionCleanupJob() error {
 	return nil
 }
 
+// registerPrincipalRoleCleanupJob is a helper method to abstract
+// registering the principal role cleanup job specifically.
+func (c *Controller) registerPrincipalRoleCleanupJob() error {
+	principalRoleCleanupJob, err := newPrincipalRoleCleanupJob(c.IamRepoFn)
+	if err != nil {
+		return fmt.Errorf("error creating principal role cleanup job: %w", err)
+	}
+	if err = c.scheduler.RegisterJob(c.baseContext, principalRoleCleanupJob); err != nil {
+		return fmt.Errorf("error registering principal role cleanup job: %w", err)
+	}
+
+	return nil
+}
+
 func (c *Controller) Shutdown(serversOnly bool) error {
 	const op = "controller.(Controller).Shutdown"
 	if !c.started.Load() {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"google.golang.org/grpc"
@@ -73,11 +74,12 @@ func newGatewayServer(
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
-				requestCtxInterceptor,         // populated requestInfo from headers into the request ctx
-				auditRequestInterceptor(ctx),  // before we get started, audit the request
-				errorInterceptor(ctx),         // convert domain and api errors into headers for the http proxy
-				statusCodeInterceptor(ctx),    // convert grpc codes into http status codes for the http proxy (can modify the resp)
-				auditResponseInterceptor(ctx), // as we finish, audit the response
+				metrics.GrpcUnaryInterceptor(), // count the request and observe its latency
+				requestCtxInterceptor,          // populated requestInfo from headers into the request ctx
+				auditRequestInterceptor(ctx),   // before we get started, audit the request
+				errorInterceptor(ctx),          // convert domain and api errors into headers for the http proxy
+				statusCodeInterceptor(ctx),     // convert grpc codes into http status codes for the http proxy (can modify the resp)
+				auditResponseInterceptor(ctx),  // as we finish, audit the response
 				grpc_recovery.UnaryServerInterceptor( // recover from panics with a grpc internal error
 					grpc_recovery.WithRecoveryHandlerContext(recoveryHandler()),
 				),
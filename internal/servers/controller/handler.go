@@ -18,12 +18,15 @@ import (
 	"github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/servers/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accessrequests"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accounts"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentials"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentialstores"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/host_catalogs"
@@ -31,6 +34,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/hosts"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/managed_groups"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/roles"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scim"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scopes"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
@@ -60,8 +64,15 @@ func (c *Controller) handler(props HandlerProperties) (http.Handler, error) {
 	if err != nil {
 		return nil, err
 	}
-	mux.Handle("/v1/", h)
-	mux.Handle("/", handleUi(c))
+	mux.Handle("/v1/", metrics.InstrumentHttpHandler("api", h))
+	if len(c.conf.RawConfig.Controller.Scim) > 0 {
+		sh, err := handleScim(c, props)
+		if err != nil {
+			return nil, err
+		}
+		mux.Handle(scim.PathPrefix, metrics.InstrumentHttpHandler("scim", sh))
+	}
+	mux.Handle("/", metrics.InstrumentHttpHandler("ui", handleUi(c)))
 
 	corsWrappedHandler := wrapHandlerWithCors(mux, props)
 	commonWrappedHandler := wrapHandlerWithCommonFuncs(corsWrappedHandler, c, props)
@@ -93,7 +104,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.HostSetService_ServiceDesc.ServiceName]; !ok {
-		hss, err := host_sets.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn)
+		hss, err := host_sets.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn, c.HostHealthRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create host set handler service: %w", err)
 		}
@@ -103,7 +114,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.HostService_ServiceDesc.ServiceName]; !ok {
-		hs, err := hosts.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn)
+		hs, err := hosts.NewService(c.StaticHostRepoFn, c.PluginHostRepoFn, c.HostHealthRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create host handler service: %w", err)
 		}
@@ -113,7 +124,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AccountService_ServiceDesc.ServiceName]; !ok {
-		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn)
+		accts, err := accounts.NewService(c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create account handler service: %w", err)
 		}
@@ -123,7 +134,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.AuthMethodService_ServiceDesc.ServiceName]; !ok {
-		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
+		authMethods, err := authmethods.NewService(c.kms, c.PasswordAuthRepoFn, c.OidcRepoFn, c.LdapRepoFn, c.IamRepoFn, c.AuthTokenRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create auth method handler service: %w", err)
 		}
@@ -172,7 +183,9 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			c.SessionRepoFn,
 			c.PluginHostRepoFn,
 			c.StaticHostRepoFn,
-			c.VaultCredentialRepoFn)
+			c.HostHealthRepoFn,
+			c.VaultCredentialRepoFn,
+			c.StaticCredentialRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create target handler service: %w", err)
 		}
@@ -202,7 +215,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.SessionService_ServiceDesc.ServiceName]; !ok {
-		ss, err := sessions.NewService(c.SessionRepoFn, c.IamRepoFn)
+		ss, err := sessions.NewService(c.SessionRepoFn, c.IamRepoFn, c.fetchRecording, c.conf.RawConfig.Controller.MaxRecordingDownloadSizeBytes)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create session handler service: %w", err)
 		}
@@ -211,8 +224,18 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			return nil, fmt.Errorf("failed to register session service handler: %w", err)
 		}
 	}
+	if _, ok := currentServices[services.AccessRequestService_ServiceDesc.ServiceName]; !ok {
+		ars, err := accessrequests.NewService(c.AccessRequestRepoFn, c.IamRepoFn, c.TargetRepoFn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create access request handler service: %w", err)
+		}
+		services.RegisterAccessRequestServiceServer(c.gatewayServer, ars)
+		if err := services.RegisterAccessRequestServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register access request service handler: %w", err)
+		}
+	}
 	if _, ok := currentServices[services.ManagedGroupService_ServiceDesc.ServiceName]; !ok {
-		mgs, err := managed_groups.NewService(c.OidcRepoFn)
+		mgs, err := managed_groups.NewService(c.OidcRepoFn, c.LdapRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create managed groups handler service: %w", err)
 		}
@@ -222,7 +245,7 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 		}
 	}
 	if _, ok := currentServices[services.CredentialStoreService_ServiceDesc.ServiceName]; !ok {
-		cs, err := credentialstores.NewService(c.VaultCredentialRepoFn, c.IamRepoFn)
+		cs, err := credentialstores.NewService(c.VaultCredentialRepoFn, c.StaticCredentialRepoFn, c.IamRepoFn)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create credential store handler service: %w", err)
 		}
@@ -241,10 +264,28 @@ func handleGrpcGateway(c *Controller, props HandlerProperties) (http.Handler, er
 			return nil, fmt.Errorf("failed to register credential library service handler: %w", err)
 		}
 	}
+	if _, ok := currentServices[services.CredentialService_ServiceDesc.ServiceName]; !ok {
+		cr, err := credentials.NewService(c.StaticCredentialRepoFn, c.IamRepoFn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create credential handler service: %w", err)
+		}
+		services.RegisterCredentialServiceServer(c.gatewayServer, cr)
+		if err := services.RegisterCredentialServiceHandlerFromEndpoint(ctx, c.gatewayMux, gatewayTarget, dialOptions); err != nil {
+			return nil, fmt.Errorf("failed to register credential service handler: %w", err)
+		}
+	}
 
 	return c.gatewayMux, nil
 }
 
+func handleScim(c *Controller, props HandlerProperties) (http.Handler, error) {
+	orgIds := make(map[string]string, len(c.conf.RawConfig.Controller.Scim))
+	for _, s := range c.conf.RawConfig.Controller.Scim {
+		orgIds[s.BearerToken] = s.ScopeId
+	}
+	return scim.NewHandler(props.CancelCtx, c.IamRepoFn, orgIds)
+}
+
 func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProperties) http.Handler {
 	const op = "controller.wrapHandlerWithCommonFuncs"
 	var maxRequestDuration time.Duration
@@ -286,7 +327,11 @@ func wrapHandlerWithCommonFuncs(h http.Handler, c *Controller, props HandlerProp
 			DisableAuthzFailures: disableAuthzFailures,
 		}
 
-		requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		// SCIM requests are authenticated with the bearer token of an org
+		// rather than an auth token
+		if !strings.HasPrefix(r.URL.Path, scim.PathPrefix) {
+			requestInfo.PublicId, requestInfo.EncryptedToken, requestInfo.TokenFormat = auth.GetTokenFromRequest(ctx, c.kms, r)
+		}
 
 		if info, ok := event.RequestInfoFromContext(ctx); ok {
 			// piggyback some eventing fields with the auth info proto message
@@ -123,6 +123,7 @@ func TestHandleImplementedPaths(t *testing.T) {
 			"v1/scopes/someid",
 			"v1/sessions",
 			"v1/sessions/someid",
+			"v1/sessions/someid:download-recording",
 			"v1/targets",
 			"v1/targets/someid",
 			"v1/users",
@@ -145,6 +146,13 @@ func TestHandleImplementedPaths(t *testing.T) {
 			// custom methods
 			"v1/accounts/someid:set-password",
 			"v1/accounts/someid:change-password",
+			"v1/accounts/someid:enroll-totp",
+			"v1/accounts/someid:confirm-totp",
+			"v1/accounts/someid:enroll-webauthn",
+			"v1/accounts/someid:confirm-webauthn",
+			"v1/accounts/someid:remove-mfa",
+			"v1/accounts/someid:generate-recovery-codes",
+			"v1/accounts/someid:unlock",
 			"v1/auth-methods/someid:authenticate",
 			"v1/groups/someid:add-members",
 			"v1/groups/someid:set-members",
@@ -0,0 +1,654 @@
+package accessrequests
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accessrequests"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.ReadSelf,
+		action.Cancel,
+		action.CancelSelf,
+		action.Approve,
+		action.Deny,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.Create,
+		action.List,
+	}
+)
+
+// Service handles request as described by the pbs.AccessRequestServiceServer interface.
+type Service struct {
+	pbs.UnimplementedAccessRequestServiceServer
+
+	repoFn       common.AccessRequestRepoFactory
+	iamRepoFn    common.IamRepoFactory
+	targetRepoFn common.TargetRepoFactory
+}
+
+// NewService returns an access request service which handles access request related requests to boundary.
+func NewService(repoFn common.AccessRequestRepoFactory, iamRepoFn common.IamRepoFactory, targetRepoFn common.TargetRepoFactory) (Service, error) {
+	const op = "accessrequests.NewService"
+	if repoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing access request repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	if targetRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing target repository")
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, targetRepoFn: targetRepoFn}, nil
+}
+
+var _ pbs.AccessRequestServiceServer = Service{}
+
+// GetAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) GetAccessRequest(ctx context.Context, req *pbs.GetAccessRequestRequest) (*pbs.GetAccessRequestResponse, error) {
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ReadSelf)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.selfOrOther(ctx, authResults, ar, action.Read)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GetAccessRequestResponse{Item: item}, nil
+}
+
+// ListAccessRequests implements the interface pbs.AccessRequestServiceServer.
+func (s Service) ListAccessRequests(ctx context.Context, req *pbs.ListAccessRequestsRequest) (*pbs.ListAccessRequestsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(ctx,
+		s.iamRepoFn, authResults, req.GetScopeId(), resource.AccessRequest, req.GetRecursive(), false)
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListAccessRequestsResponse{}, nil
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("scope_id", "target_id", "user_id", "status", "reviewer_id")
+	var finalItems []*pb.AccessRequest
+	res := perms.Resource{
+		Type: resource.AccessRequest,
+	}
+	for {
+		arList, err := s.listFromRepo(ctx, scopeIds, page, conds)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range arList {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			if authorizedActions.OnlySelf() && item.GetUserId() != authResults.UserId {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(arList)) {
+			break
+		}
+	}
+
+	return &pbs.ListAccessRequestsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
+}
+
+// CreateAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) CreateAccessRequest(ctx context.Context, req *pbs.CreateAccessRequestRequest) (*pbs.CreateAccessRequestResponse, error) {
+	const op = "accessrequests.(Service).CreateAccessRequest"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if authResults.UserId == "" || authResults.UserId == auth.AnonymousUserId {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Access can only be requested by authenticated users.")
+	}
+
+	targetRepo, err := s.targetRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	t, _, _, err := targetRepo.LookupTarget(ctx, req.GetItem().GetTargetId())
+	if err != nil && !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+	if t == nil || t.GetScopeId() != req.GetItem().GetScopeId() {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{globals.TargetIdField: "Target not found in the provided scope."})
+	}
+
+	ar, err := s.createInRepo(ctx, authResults.UserId, req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(ctx, ar, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateAccessRequestResponse{Item: item, Uri: fmt.Sprintf("access-requests/%s", item.GetId())}, nil
+}
+
+// ApproveAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) ApproveAccessRequest(ctx context.Context, req *pbs.ApproveAccessRequestRequest) (*pbs.ApproveAccessRequestResponse, error) {
+	if err := validateReviewRequest(req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Approve)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.reviewInRepo(ctx, req.GetId(), req.GetVersion(), authResults.UserId, req.GetComment(), action.Approve)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.toProtoWithAuth(ctx, authResults, ar, action.Approve)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ApproveAccessRequestResponse{Item: item}, nil
+}
+
+// DenyAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) DenyAccessRequest(ctx context.Context, req *pbs.DenyAccessRequestRequest) (*pbs.DenyAccessRequestResponse, error) {
+	if err := validateReviewRequest(req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Deny)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.reviewInRepo(ctx, req.GetId(), req.GetVersion(), authResults.UserId, req.GetComment(), action.Deny)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.toProtoWithAuth(ctx, authResults, ar, action.Deny)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.DenyAccessRequestResponse{Item: item}, nil
+}
+
+// CancelAccessRequest implements the interface pbs.AccessRequestServiceServer.
+func (s Service) CancelAccessRequest(ctx context.Context, req *pbs.CancelAccessRequestRequest) (*pbs.CancelAccessRequestResponse, error) {
+	if err := validateReviewRequest(req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CancelSelf)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ar, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	// Check to see if we need to verify Cancel vs. just CancelSelf
+	if ar.GetUserId() != authResults.UserId {
+		if !authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions).HasAction(action.Cancel) {
+			return nil, handlers.ForbiddenError()
+		}
+	}
+
+	ar, err = s.cancelInRepo(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.selfOrOther(ctx, authResults, ar, action.Cancel)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.CancelAccessRequestResponse{Item: item}, nil
+}
+
+// selfOrOther returns the proto of the access request ar, using the output
+// fields of the request if it belongs to the user, or the output fields of
+// action a otherwise. The user must be granted action a on requests of other
+// users.
+func (s Service) selfOrOther(ctx context.Context, authResults auth.VerifyResults, ar *accessrequest.AccessRequest, a action.Type) (*pb.AccessRequest, error) {
+	const op = "accessrequests.(Service).selfOrOther"
+	var outputFields perms.OutputFieldsMap
+	authorizedActions := authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions)
+
+	if ar.GetUserId() != authResults.UserId {
+		if !authorizedActions.HasAction(a) {
+			return nil, handlers.ForbiddenError()
+		}
+		outputFields = authResults.FetchOutputFields(perms.Resource{
+			Id:      ar.GetPublicId(),
+			ScopeId: ar.GetScopeId(),
+			Type:    resource.AccessRequest,
+		}, a).SelfOrDefaults(authResults.UserId)
+	} else {
+		var ok bool
+		outputFields, ok = requests.OutputFields(ctx)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+		}
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+	return toProto(ctx, ar, outputOpts...)
+}
+
+// toProtoWithAuth returns the proto of the access request ar using the
+// output fields of the request.
+func (s Service) toProtoWithAuth(ctx context.Context, authResults auth.VerifyResults, ar *accessrequest.AccessRequest, _ action.Type) (*pb.AccessRequest, error) {
+	const op = "accessrequests.(Service).toProtoWithAuth"
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, ar.GetPublicId(), IdActions).Strings()))
+	}
+	return toProto(ctx, ar, outputOpts...)
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*accessrequest.AccessRequest, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ar, err := repo.LookupAccessRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ar == nil {
+		return nil, handlers.NotFoundErrorf("Access request %q doesn't exist.", id)
+	}
+	return ar, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*accessrequest.AccessRequest, error) {
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	arList, err := repo.ListAccessRequests(ctx, scopeIds, accessrequest.WithLimit(page.Limit()), accessrequest.WithStartPageAfterId(page.StartAfterId()), accessrequest.WithFilterConditions(conds))
+	if err != nil {
+		return nil, err
+	}
+	return arList, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, userId string, item *pb.AccessRequest) (*accessrequest.AccessRequest, error) {
+	const op = "accessrequests.(Service).createInRepo"
+	ar, err := accessrequest.NewAccessRequest(ctx, item.GetScopeId(), item.GetTargetId(), userId,
+		strings.TrimSpace(item.GetJustification()), time.Duration(item.GetDurationSeconds())*time.Second)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build access request for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateAccessRequest(ctx, ar)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create access request"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create access request but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) reviewInRepo(ctx context.Context, id string, version uint32, reviewerId, comment string, a action.Type) (*accessrequest.AccessRequest, error) {
+	const op = "accessrequests.(Service).reviewInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	opts := []accessrequest.Option{accessrequest.WithReviewComment(strings.TrimSpace(comment))}
+	var out *accessrequest.AccessRequest
+	switch a {
+	case action.Approve:
+		out, err = repo.ApproveAccessRequest(ctx, id, version, reviewerId, opts...)
+	default:
+		out, err = repo.DenyAccessRequest(ctx, id, version, reviewerId, opts...)
+	}
+	switch {
+	case err == nil:
+		return out, nil
+	case errors.Match(errors.T(errors.Forbidden), err):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.PermissionDenied, "Users cannot review their own access requests.")
+	case errors.Match(errors.T(errors.InvalidAccessRequestState), err):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Only pending access requests can be approved or denied.")
+	default:
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to review access request"))
+	}
+}
+
+func (s Service) cancelInRepo(ctx context.Context, id string, version uint32) (*accessrequest.AccessRequest, error) {
+	const op = "accessrequests.(Service).cancelInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CancelAccessRequest(ctx, id, version)
+	switch {
+	case err == nil:
+		return out, nil
+	case errors.Match(errors.T(errors.InvalidAccessRequestState), err):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, "Only pending access requests can be canceled.")
+	default:
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to cancel access request"))
+	}
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.AccessRequest), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.Approve, action.Deny:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		ar, err := repo.LookupAccessRequest(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if ar == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = ar.GetScopeId()
+		opts = append(opts, auth.WithId(id))
+	default:
+		res.Error = stderrors.New("unsupported action")
+		return res
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(ctx context.Context, in *accessrequest.AccessRequest, opt ...handlers.Option) (*pb.AccessRequest, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building access request proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.AccessRequest{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetScopeId()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.TargetIdField) {
+		out.TargetId = in.GetTargetId()
+	}
+	if outputFields.Has(globals.UserIdField) {
+		out.UserId = in.GetUserId()
+	}
+	if outputFields.Has(globals.JustificationField) {
+		out.Justification = in.GetJustification()
+	}
+	if outputFields.Has(globals.DurationSecondsField) {
+		out.DurationSeconds = in.GetDurationSeconds()
+	}
+	if outputFields.Has(globals.StatusField) {
+		out.Status = in.GetStatus()
+	}
+	if outputFields.Has(globals.ReviewerIdField) {
+		out.ReviewerId = in.GetReviewerId()
+	}
+	if outputFields.Has(globals.ReviewCommentField) {
+		out.ReviewComment = in.GetReviewComment()
+	}
+	if outputFields.Has(globals.RoleIdField) {
+		out.RoleId = in.GetRoleId()
+	}
+	if outputFields.Has(globals.ExpirationTimeField) && in.GetExpirationTime() != nil {
+		out.ExpirationTime = in.GetExpirationTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetAccessRequestRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, accessrequest.AccessRequestPrefix)
+}
+
+func validateListRequest(req *pbs.ListAccessRequestsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Project.Prefix()) &&
+		!req.GetRecursive() {
+		badFields["scope_id"] = "This field must be a valid project scope ID or the list operation must be recursive."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func validateCreateRequest(req *pbs.CreateAccessRequestRequest) error {
+	badFields := map[string]string{}
+	item := req.GetItem()
+	if !handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Project.Prefix()) {
+		badFields[globals.ScopeIdField] = "This field must be a valid project scope id."
+	}
+	if !handlers.ValidId(handlers.Id(item.GetTargetId()), target.Prefixes()...) {
+		badFields[globals.TargetIdField] = "This field must be a valid target id."
+	}
+	if strings.TrimSpace(item.GetJustification()) == "" {
+		badFields[globals.JustificationField] = "This field is required."
+	}
+	if item.GetDurationSeconds() == 0 {
+		badFields[globals.DurationSecondsField] = "This field must be greater than 0."
+	}
+	if item.GetId() != "" {
+		badFields[globals.IdField] = "This is a read only field."
+	}
+	if item.GetUserId() != "" {
+		badFields[globals.UserIdField] = "This is a read only field."
+	}
+	if item.GetStatus() != "" {
+		badFields[globals.StatusField] = "This is a read only field."
+	}
+	if item.GetReviewerId() != "" {
+		badFields[globals.ReviewerIdField] = "This is a read only field."
+	}
+	if item.GetReviewComment() != "" {
+		badFields[globals.ReviewCommentField] = "This is a read only field."
+	}
+	if item.GetRoleId() != "" {
+		badFields[globals.RoleIdField] = "This is a read only field."
+	}
+	if item.GetExpirationTime() != nil {
+		badFields[globals.ExpirationTimeField] = "This is a read only field."
+	}
+	if item.GetCreatedTime() != nil {
+		badFields[globals.CreatedTimeField] = "This is a read only field."
+	}
+	if item.GetUpdatedTime() != nil {
+		badFields[globals.UpdatedTimeField] = "This is a read only field."
+	}
+	if item.GetVersion() != 0 {
+		badFields[globals.VersionField] = "Cannot specify this field in a create request."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateReviewRequest(id string, version uint32) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(id), accessrequest.AccessRequestPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if version == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
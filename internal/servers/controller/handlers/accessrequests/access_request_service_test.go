@@ -0,0 +1,274 @@
+package accessrequests_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accessrequests"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accessrequests"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self", "approve", "deny"}
+
+type testEnv struct {
+	iamRepoFn    func() (*iam.Repository, error)
+	repoFn       func() (*accessrequest.Repository, error)
+	targetRepoFn func() (*target.Repository, error)
+	org, prj     *iam.Scope
+	tar          target.Target
+	user         *iam.User
+	reviewer     *iam.User
+	conn         *db.DB
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	rw := db.New(conn)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	env := &testEnv{conn: conn}
+	env.iamRepoFn = func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	env.repoFn = func() (*accessrequest.Repository, error) {
+		return accessrequest.NewRepository(rw, rw, kms)
+	}
+	env.targetRepoFn = func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kms)
+	}
+	env.org, env.prj = iam.TestScopes(t, iamRepo)
+	env.tar = tcp.TestTarget(context.Background(), t, conn, env.prj.GetPublicId(), "test")
+	env.user = iam.TestUser(t, iamRepo, env.org.GetPublicId())
+	env.reviewer = iam.TestUser(t, iamRepo, env.org.GetPublicId())
+	return env
+}
+
+func (env *testEnv) service(t *testing.T) accessrequests.Service {
+	t.Helper()
+	s, err := accessrequests.NewService(env.repoFn, env.iamRepoFn, env.targetRepoFn)
+	require.NoError(t, err, "Couldn't create new access request service.")
+	return s
+}
+
+func TestGetAccessRequest(t *testing.T) {
+	env := newTestEnv(t)
+	ar := accessrequest.TestAccessRequest(t, env.conn, env.prj.GetPublicId(), env.tar.GetPublicId(), env.user.GetPublicId())
+
+	wireAr := &pb.AccessRequest{
+		Id:                ar.GetPublicId(),
+		ScopeId:           env.prj.GetPublicId(),
+		Scope:             &scopes.ScopeInfo{Id: env.prj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: env.org.GetPublicId()},
+		TargetId:          env.tar.GetPublicId(),
+		UserId:            env.user.GetPublicId(),
+		Justification:     ar.GetJustification(),
+		DurationSeconds:   ar.GetDurationSeconds(),
+		Status:            accessrequest.StatusPending.String(),
+		CreatedTime:       ar.GetCreateTime().GetTimestamp(),
+		UpdatedTime:       ar.GetUpdateTime().GetTimestamp(),
+		Version:           ar.GetVersion(),
+		AuthorizedActions: testAuthorizedActions,
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.GetAccessRequestRequest
+		res  *pbs.GetAccessRequestResponse
+		err  error
+	}{
+		{
+			name: "Get an access request",
+			req:  &pbs.GetAccessRequestRequest{Id: ar.GetPublicId()},
+			res:  &pbs.GetAccessRequestResponse{Item: wireAr},
+		},
+		{
+			name: "Get a non existant access request",
+			req:  &pbs.GetAccessRequestRequest{Id: accessrequest.AccessRequestPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.GetAccessRequestRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s := env.service(t)
+			ctx := auth.DisabledAuthTestContext(env.iamRepoFn, env.prj.GetPublicId(), auth.WithUserId(env.user.GetPublicId()))
+			got, gErr := s.GetAccessRequest(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetAccessRequest(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "GetAccessRequest(%q) got response %q, wanted %q", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestCreateAccessRequest(t *testing.T) {
+	env := newTestEnv(t)
+	_, otherPrj := iam.TestScopes(t, iam.TestRepo(t, env.conn, db.TestWrapper(t)))
+
+	cases := []struct {
+		name   string
+		userId string
+		item   *pb.AccessRequest
+		err    error
+	}{
+		{
+			name:   "Create a valid access request",
+			userId: env.user.GetPublicId(),
+			item: &pb.AccessRequest{
+				ScopeId:         env.prj.GetPublicId(),
+				TargetId:        env.tar.GetPublicId(),
+				Justification:   "incident 42",
+				DurationSeconds: 3600,
+			},
+		},
+		{
+			name:   "Missing justification",
+			userId: env.user.GetPublicId(),
+			item: &pb.AccessRequest{
+				ScopeId:         env.prj.GetPublicId(),
+				TargetId:        env.tar.GetPublicId(),
+				DurationSeconds: 3600,
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:   "Missing duration",
+			userId: env.user.GetPublicId(),
+			item: &pb.AccessRequest{
+				ScopeId:       env.prj.GetPublicId(),
+				TargetId:      env.tar.GetPublicId(),
+				Justification: "incident 42",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:   "Read only status",
+			userId: env.user.GetPublicId(),
+			item: &pb.AccessRequest{
+				ScopeId:         env.prj.GetPublicId(),
+				TargetId:        env.tar.GetPublicId(),
+				Justification:   "incident 42",
+				DurationSeconds: 3600,
+				Status:          accessrequest.StatusApproved.String(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:   "Target in another scope",
+			userId: env.user.GetPublicId(),
+			item: &pb.AccessRequest{
+				ScopeId:         otherPrj.GetPublicId(),
+				TargetId:        env.tar.GetPublicId(),
+				Justification:   "incident 42",
+				DurationSeconds: 3600,
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:   "Anonymous user",
+			userId: auth.AnonymousUserId,
+			item: &pb.AccessRequest{
+				ScopeId:         env.prj.GetPublicId(),
+				TargetId:        env.tar.GetPublicId(),
+				Justification:   "incident 42",
+				DurationSeconds: 3600,
+			},
+			err: handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s := env.service(t)
+			ctx := auth.DisabledAuthTestContext(env.iamRepoFn, tc.item.GetScopeId(), auth.WithUserId(tc.userId))
+			got, gErr := s.CreateAccessRequest(ctx, &pbs.CreateAccessRequestRequest{Item: tc.item})
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateAccessRequest(%+v) got error %v, wanted %v", tc.item, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal("access-requests/"+got.GetItem().GetId(), got.GetUri())
+			assert.Equal(tc.userId, got.GetItem().GetUserId())
+			assert.Equal(accessrequest.StatusPending.String(), got.GetItem().GetStatus())
+		})
+	}
+}
+
+func TestReviewAccessRequest(t *testing.T) {
+	env := newTestEnv(t)
+	s := env.service(t)
+	reviewerCtx := auth.DisabledAuthTestContext(env.iamRepoFn, env.prj.GetPublicId(), auth.WithUserId(env.reviewer.GetPublicId()))
+	userCtx := auth.DisabledAuthTestContext(env.iamRepoFn, env.prj.GetPublicId(), auth.WithUserId(env.user.GetPublicId()))
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := accessrequest.TestAccessRequest(t, env.conn, env.prj.GetPublicId(), env.tar.GetPublicId(), env.user.GetPublicId())
+		got, err := s.ApproveAccessRequest(reviewerCtx, &pbs.ApproveAccessRequestRequest{Id: ar.GetPublicId(), Version: ar.GetVersion(), Comment: "ok"})
+		require.NoError(err)
+		assert.Equal(accessrequest.StatusApproved.String(), got.GetItem().GetStatus())
+		assert.Equal(env.reviewer.GetPublicId(), got.GetItem().GetReviewerId())
+		assert.Equal("ok", got.GetItem().GetReviewComment())
+		assert.NotEmpty(got.GetItem().GetRoleId())
+		assert.NotNil(got.GetItem().GetExpirationTime())
+
+		_, err = s.DenyAccessRequest(reviewerCtx, &pbs.DenyAccessRequestRequest{Id: ar.GetPublicId(), Version: got.GetItem().GetVersion()})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.FailedPrecondition)), "unexpected error: %v", err)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := accessrequest.TestAccessRequest(t, env.conn, env.prj.GetPublicId(), env.tar.GetPublicId(), env.user.GetPublicId())
+		got, err := s.DenyAccessRequest(reviewerCtx, &pbs.DenyAccessRequestRequest{Id: ar.GetPublicId(), Version: ar.GetVersion()})
+		require.NoError(err)
+		assert.Equal(accessrequest.StatusDenied.String(), got.GetItem().GetStatus())
+		assert.Empty(got.GetItem().GetRoleId())
+	})
+
+	t.Run("self-review", func(t *testing.T) {
+		ar := accessrequest.TestAccessRequest(t, env.conn, env.prj.GetPublicId(), env.tar.GetPublicId(), env.user.GetPublicId())
+		_, err := s.ApproveAccessRequest(userCtx, &pbs.ApproveAccessRequestRequest{Id: ar.GetPublicId(), Version: ar.GetVersion()})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)), "unexpected error: %v", err)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar := accessrequest.TestAccessRequest(t, env.conn, env.prj.GetPublicId(), env.tar.GetPublicId(), env.user.GetPublicId())
+		got, err := s.CancelAccessRequest(userCtx, &pbs.CancelAccessRequestRequest{Id: ar.GetPublicId(), Version: ar.GetVersion()})
+		require.NoError(err)
+		assert.Equal(accessrequest.StatusCanceled.String(), got.GetItem().GetStatus())
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		ar := accessrequest.TestAccessRequest(t, env.conn, env.prj.GetPublicId(), env.tar.GetPublicId(), env.user.GetPublicId())
+		_, err := s.ApproveAccessRequest(reviewerCtx, &pbs.ApproveAccessRequestRequest{Id: ar.GetPublicId()})
+		assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "unexpected error: %v", err)
+	})
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/auth/password"
@@ -27,6 +29,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -49,11 +52,19 @@ const (
 	subjectField    = "attributes.subject"
 	nameClaimField  = "attributes.full_name"
 	emailClaimField = "attributes.email"
+
+	// ldap field names
+	ldapLoginNameField      = "attributes.login_name"
+	ldapFullNameField       = "attributes.full_name"
+	ldapEmailField          = "attributes.email"
+	ldapDnField             = "attributes.dn"
+	ldapMemberOfGroupsField = "attributes.member_of_groups"
 )
 
 var (
 	pwMaskManager   handlers.MaskManager
 	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -65,6 +76,13 @@ var (
 			action.Delete,
 			action.SetPassword,
 			action.ChangePassword,
+			action.EnrollTotp,
+			action.ConfirmTotp,
+			action.EnrollWebAuthn,
+			action.ConfirmWebAuthn,
+			action.RemoveMfa,
+			action.GenerateRecoveryCodes,
+			action.Unlock,
 		},
 		oidc.Subtype: {
 			action.NoOp,
@@ -72,6 +90,12 @@ var (
 			action.Update,
 			action.Delete,
 		},
+		ldap.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -90,6 +114,9 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&oidcstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.OidcAccountAttributes{}}); err != nil {
 		panic(err)
 	}
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.Account{}}, handlers.MaskSource{&pb.Account{}, &pb.LdapAccountAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.AccountServiceServer interface.
@@ -98,10 +125,12 @@ type Service struct {
 
 	pwRepoFn   common.PasswordAuthRepoFactory
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
+	iamRepoFn  common.IamRepoFactory
 }
 
 // NewService returns a account service which handles account related requests to boundary.
-func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory) (Service, error) {
+func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
 	const op = "accounts.NewService"
 	if pwRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing password repository")
@@ -109,7 +138,13 @@ func NewService(pwRepo common.PasswordAuthRepoFactory, oidcRepo common.OidcAuthR
 	if oidcRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidc repository provided")
 	}
-	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo}, nil
+	if ldapRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository")
+	}
+	if iamRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	return Service{pwRepoFn: pwRepo, oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo, iamRepoFn: iamRepo}, nil
 }
 
 var _ pbs.AccountServiceServer = Service{}
@@ -119,58 +154,78 @@ func (s Service) ListAccounts(ctx context.Context, req *pbs.ListAccountsRequest)
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
-	}
-	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId())
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(ul) == 0 {
-		return &pbs.ListAccountsResponse{}, nil
+	_, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
 	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Account, 0, len(ul))
+	var finalItems []*pb.Account
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.Account,
 		Pin:     req.GetAuthMethodId(),
 	}
-	for _, acct := range ul {
-		res.Id = acct.GetPublicId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())], requestauth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	for {
+		ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), page)
+		if err != nil {
+			return nil, err
 		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+		ids := make([]string, 0, len(ul))
+		for _, acct := range ul {
+			ids = append(ids, acct.GetPublicId())
 		}
-
-		item, err := toProto(ctx, acct, outputOpts...)
+		disabled, err := s.disabledAccounts(ctx, ids...)
 		if err != nil {
 			return nil, err
 		}
+		for _, acct := range ul {
+			if !page.Next(acct.GetPublicId()) {
+				break
+			}
+			res.Id = acct.GetPublicId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())], requestauth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		// This comes last so that we can use item fields in the filter after
-		// the allowed fields are populated above
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if outputFields.Has(globals.DisabledField) {
+				outputOpts = append(outputOpts, handlers.WithDisabled(disabled[acct.GetPublicId()]))
+			}
+
+			item, err := toProto(ctx, acct, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			// This comes last so that we can use item fields in the filter after
+			// the allowed fields are populated above
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(ul)) {
+			break
 		}
 	}
-	return &pbs.ListAccountsResponse{Items: finalItems}, nil
+	return &pbs.ListAccountsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetAccount implements the interface pbs.AccountServiceServer.
@@ -206,6 +261,13 @@ func (s Service) GetAccount(ctx context.Context, req *pbs.GetAccountRequest) (*p
 	if outputFields.Has(globals.ManagedGroupIdsField) {
 		outputOpts = append(outputOpts, handlers.WithManagedGroupIds(mgIds))
 	}
+	if outputFields.Has(globals.DisabledField) {
+		disabled, err := s.disabledAccounts(ctx, acct.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithDisabled(disabled[acct.GetPublicId()]))
+	}
 
 	item, err := toProto(ctx, acct, outputOpts...)
 	if err != nil {
@@ -285,6 +347,14 @@ func (s Service) UpdateAccount(ctx context.Context, req *pbs.UpdateAccountReques
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
 	}
 
+	if outputFields.Has(globals.DisabledField) {
+		disabled, err := s.disabledAccounts(ctx, acct.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithDisabled(disabled[acct.GetPublicId()]))
+	}
+
 	item, err := toProto(ctx, acct, outputOpts...)
 	if err != nil {
 		return nil, err
@@ -340,6 +410,14 @@ func (s Service) ChangePassword(ctx context.Context, req *pbs.ChangePasswordRequ
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
 	}
 
+	if outputFields.Has(globals.DisabledField) {
+		disabled, err := s.disabledAccounts(ctx, acct.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithDisabled(disabled[acct.GetPublicId()]))
+	}
+
 	item, err := toProto(ctx, acct, outputOpts...)
 	if err != nil {
 		return nil, err
@@ -379,6 +457,14 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
 	}
 
+	if outputFields.Has(globals.DisabledField) {
+		disabled, err := s.disabledAccounts(ctx, acct.GetPublicId())
+		if err != nil {
+			return nil, err
+		}
+		outputOpts = append(outputOpts, handlers.WithDisabled(disabled[acct.GetPublicId()]))
+	}
+
 	item, err := toProto(ctx, acct, outputOpts...)
 	if err != nil {
 		return nil, err
@@ -405,6 +491,15 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []st
 			}
 			return nil, nil, err
 		}
+		if a == nil {
+			return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		if a.Mfa, err = repo.LookupMfa(ctx, id); err != nil {
+			return nil, nil, err
+		}
+		if a.Lockout, err = repo.LookupLockout(ctx, id); err != nil {
+			return nil, nil, err
+		}
 		acct = a
 	case oidc.Subtype:
 		repo, err := s.oidcRepoFn()
@@ -426,6 +521,26 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []st
 			mgIds = append(mgIds, mg.GetManagedGroupId())
 		}
 		acct = a
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := repo.LookupAccount(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if a == nil {
+			return nil, nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+		}
+		mgs, err := repo.ListManagedGroupMembershipsByMember(ctx, a.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, mg := range mgs {
+			mgIds = append(mgIds, mg.GetManagedGroupId())
+		}
+		acct = a
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -515,6 +630,41 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (*ldap.Account, error) {
+	const op = "accounts.(Service).createLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := &pb.LdapAccountAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), attrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{attributesField: "Attribute fields do not match the expected format."})
+	}
+	a, err := ldap.NewAccount(ctx, am.GetPublicId(), attrs.GetLoginName(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build account for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateAccount(ctx, am.GetScopeId(), a)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create account"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.Account) (auth.Account, error) {
 	const op = "accounts.(Service).createInRepo"
 	if item == nil {
@@ -540,6 +690,15 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create account but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -611,12 +770,68 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.Account) (*ldap.Account, error) {
+	const op = "accounts.(Service).updateLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil account.")
+	}
+	u := ldap.AllocAccount()
+	u.PublicId = id
+	if item.GetName() != nil {
+		u.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		u.Description = item.GetDescription().GetValue()
+	}
+
+	version := item.GetVersion()
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateAccount(ctx, scopeId, u, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update account"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateAccountRequest) (auth.Account, error) {
 	const op = "accounts.(Service).updateInRepo"
+	// The disabled state of accounts is stored with the base account and
+	// updated through the iam repository, so it is not part of the subtype
+	// update.
+	var mask []string
+	var updateDisabled bool
+	for _, p := range req.GetUpdateMask().GetPaths() {
+		for _, f := range strings.Split(p, ",") {
+			switch f = strings.TrimSpace(f); f {
+			case globals.DisabledField:
+				updateDisabled = true
+			default:
+				mask = append(mask, f)
+			}
+		}
+	}
+
 	var out auth.Account
-	switch auth.SubtypeFromId(req.GetId()) {
-	case password.Subtype:
-		a, err := s.updatePwInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	switch {
+	case len(mask) == 0:
+		a, _, err := s.getFromRepo(ctx, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		out = a
+	case auth.SubtypeFromId(req.GetId()) == password.Subtype:
+		a, err := s.updatePwInRepo(ctx, scopeId, authMethodId, req.GetId(), mask, req.GetItem())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -624,8 +839,17 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
 		}
 		out = a
-	case oidc.Subtype:
-		a, err := s.updateOidcInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	case auth.SubtypeFromId(req.GetId()) == oidc.Subtype:
+		a, err := s.updateOidcInRepo(ctx, scopeId, authMethodId, req.GetId(), mask, req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if a == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update account but no error returned from repository.")
+		}
+		out = a
+	case auth.SubtypeFromId(req.GetId()) == ldap.Subtype:
+		a, err := s.updateLdapInRepo(ctx, scopeId, authMethodId, req.GetId(), mask, req.GetItem())
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -634,9 +858,37 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 		}
 		out = a
 	}
+	if updateDisabled {
+		repo, err := s.iamRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := repo.SetAccountDisabled(ctx, req.GetId(), req.GetItem().GetDisabled()); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 	return out, nil
 }
 
+// disabledAccounts returns the set of the disabled accounts among the accounts
+// with the given ids.
+func (s Service) disabledAccounts(ctx context.Context, ids ...string) (map[string]bool, error) {
+	const op = "accounts.(Service).disabledAccounts"
+	repo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	disabledIds, err := repo.ListDisabledAccounts(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	disabled := make(map[string]bool, len(disabledIds))
+	for _, id := range disabledIds {
+		disabled[id] = true
+	}
+	return disabled, nil
+}
+
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
 	const op = "accounts.(Service).deleteFromRepo"
 	var rows int
@@ -654,6 +906,12 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteAccount(ctx, scopeId, id)
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteAccount(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -664,7 +922,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.Account, error) {
+func (s Service) listFromRepo(ctx context.Context, authMethodId string, page *handlers.ListPage) ([]auth.Account, error) {
 	const op = "accounts.(Service).listFromRepo"
 
 	var outUl []auth.Account
@@ -674,7 +932,7 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		pwl, err := pwRepo.ListAccounts(ctx, authMethodId)
+		pwl, err := pwRepo.ListAccounts(ctx, authMethodId, password.WithLimit(page.Limit()), password.WithStartPageAfterId(page.StartAfterId()))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
@@ -686,13 +944,25 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		oidcl, err := oidcRepo.ListAccounts(ctx, authMethodId)
+		oidcl, err := oidcRepo.ListAccounts(ctx, authMethodId, oidc.WithLimit(page.Limit()), oidc.WithStartPageAfterId(page.StartAfterId()))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
 		for _, a := range oidcl {
 			outUl = append(outUl, a)
 		}
+	case ldap.Subtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ldapl, err := ldapRepo.ListAccounts(ctx, authMethodId, ldap.WithLimit(page.Limit()), ldap.WithStartPageAfterId(page.StartAfterId()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range ldapl {
+			outUl = append(outUl, a)
+		}
 	}
 	return outUl, nil
 }
@@ -756,6 +1026,11 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.Account), requestauth.WithAction(a)}
@@ -786,6 +1061,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case ldap.Subtype:
+			acct, err := ldapRepo.LookupAccount(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if acct == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = acct.GetAuthMethodId()
 		}
 		opts = append(opts, requestauth.WithId(id))
 	}
@@ -814,6 +1100,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 			return nil, res
 		}
 		authMeth = am
+	case ldap.Subtype:
+		am, err := ldapRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
 	}
 	opts = append(opts, requestauth.WithScopeId(authMeth.GetScopeId()), requestauth.WithPin(parentId))
 	return authMeth, requestauth.Verify(ctx, opts...)
@@ -859,6 +1156,9 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 	if outputFields.Has(globals.ManagedGroupIdsField) {
 		out.ManagedGroupIds = opts.WithManagedGroupIds
 	}
+	if outputFields.Has(globals.DisabledField) {
+		out.Disabled = opts.WithDisabled
+	}
 	switch i := in.(type) {
 	case *password.Account:
 		if outputFields.Has(globals.TypeField) {
@@ -867,7 +1167,12 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 		if !outputFields.Has(globals.AttributesField) {
 			break
 		}
-		st, err := handlers.ProtoToStruct(&pb.PasswordAccountAttributes{LoginName: i.GetLoginName()})
+		attrs := &pb.PasswordAccountAttributes{LoginName: i.GetLoginName()}
+		toMfaAttributes(i.Mfa, attrs)
+		if i.Lockout.Locked() {
+			attrs.LockedUntil = timestamppb.New(i.Lockout.LockedUntil)
+		}
+		st, err := handlers.ProtoToStruct(attrs)
 		if err != nil {
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building password attribute struct: %v", err)
 		}
@@ -910,6 +1215,29 @@ func toProto(ctx context.Context, in auth.Account, opt ...handlers.Option) (*pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
 		}
 		out.Attributes = st
+	case *ldap.Account:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		attrs := &pb.LdapAccountAttributes{
+			LoginName: i.GetLoginName(),
+			FullName:  i.GetFullName(),
+			Email:     i.GetEmail(),
+			Dn:        i.GetDn(),
+		}
+		groups, err := i.GetMemberOfGroupNames(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("error unmarshaling stored member of groups"))
+		}
+		attrs.MemberOfGroups = groups
+		st, err := handlers.ProtoToStruct(attrs)
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+		}
+		out.Attributes = st
 	}
 	return &out, nil
 }
@@ -953,7 +1281,7 @@ func validateGetRequest(req *pbs.GetAccountRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateAccountRequest) error {
@@ -966,6 +1294,9 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 		if req.GetItem().GetAuthMethodId() == "" {
 			badFields[authMethodIdField] = "This field is required."
 		}
+		if req.GetItem().GetDisabled() {
+			badFields[globals.DisabledField] = "Can only be set on update."
+		}
 		switch auth.SubtypeFromId(req.GetItem().GetAuthMethodId()) {
 		case password.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != password.Subtype.String() {
@@ -1004,6 +1335,29 @@ func validateCreateRequest(req *pbs.CreateAccountRequest) error {
 			if attrs.GetEmail() != "" {
 				badFields[emailClaimField] = "This is a read only field."
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[typeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := &pb.LdapAccountAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[attributesField] = "Attribute fields do not match the expected format."
+			}
+			if strings.TrimSpace(attrs.GetLoginName()) == "" {
+				badFields[ldapLoginNameField] = "This is a required field for this type."
+			}
+			if attrs.GetFullName() != "" {
+				badFields[ldapFullNameField] = "This is a read only field."
+			}
+			if attrs.GetEmail() != "" {
+				badFields[ldapEmailField] = "This is a read only field."
+			}
+			if attrs.GetDn() != "" {
+				badFields[ldapDnField] = "This is a read only field."
+			}
+			if len(attrs.GetMemberOfGroups()) > 0 {
+				badFields[ldapMemberOfGroupsField] = "This is a read only field."
+			}
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -1047,9 +1401,25 @@ func validateUpdateRequest(req *pbs.UpdateAccountRequest) error {
 			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), nameClaimField) {
 				badFields[nameClaimField] = "Field is read only."
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			attrs := &pb.LdapAccountAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[attributesField] = "Attribute fields do not match the expected format."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), ldapLoginNameField) {
+				badFields[ldapLoginNameField] = "Field cannot be updated."
+			}
+			for _, f := range []string{ldapFullNameField, ldapEmailField, ldapDnField, ldapMemberOfGroupsField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "Field is read only."
+				}
+			}
 		}
 		return badFields
-	}, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	}, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
@@ -1057,7 +1427,7 @@ func validateDeleteRequest(req *pbs.DeleteAccountRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix)
 }
 
 func validateListRequest(req *pbs.ListAccountsRequest) error {
@@ -1066,7 +1436,7 @@ func validateListRequest(req *pbs.ListAccountsRequest) error {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), password.AuthMethodPrefix, oidc.AuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 		badFields[authMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -7,10 +7,12 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accounts"
+	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
@@ -45,6 +48,13 @@ var (
 		action.Delete.String(),
 		action.SetPassword.String(),
 		action.ChangePassword.String(),
+		action.EnrollTotp.String(),
+		action.ConfirmTotp.String(),
+		action.EnrollWebAuthn.String(),
+		action.ConfirmWebAuthn.String(),
+		action.RemoveMfa.String(),
+		action.GenerateRecoveryCodes.String(),
+		action.Unlock.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -66,11 +76,19 @@ func TestNewService(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap), nil
+	}
 
 	cases := []struct {
 		name     string
 		pwRepo   common.PasswordAuthRepoFactory
 		oidcRepo common.OidcAuthRepoFactory
+		ldapRepo common.LdapAuthRepoFactory
+		iamRepo  common.IamRepoFactory
 		wantErr  bool
 	}{
 		{
@@ -80,22 +98,42 @@ func TestNewService(t *testing.T) {
 		{
 			name:     "nil-pw-repo",
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			iamRepo:  iamRepoFn,
 			wantErr:  true,
 		},
 		{
-			name:    "nil-oidc-repo",
-			pwRepo:  pwRepoFn,
-			wantErr: true,
+			name:     "nil-oidc-repo",
+			pwRepo:   pwRepoFn,
+			ldapRepo: ldapRepoFn,
+			iamRepo:  iamRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-ldap-repo",
+			pwRepo:   pwRepoFn,
+			oidcRepo: oidcRepoFn,
+			iamRepo:  iamRepoFn,
+			wantErr:  true,
+		},
+		{
+			name:     "nil-iam-repo",
+			pwRepo:   pwRepoFn,
+			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			wantErr:  true,
 		},
 		{
 			name:     "success",
 			pwRepo:   pwRepoFn,
 			oidcRepo: oidcRepoFn,
+			ldapRepo: ldapRepoFn,
+			iamRepo:  iamRepoFn,
 		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			_, err := accounts.NewService(tc.pwRepo, tc.oidcRepo)
+			_, err := accounts.NewService(tc.pwRepo, tc.oidcRepo, tc.ldapRepo, tc.iamRepo)
 			if tc.wantErr {
 				assert.Error(t, err)
 			} else {
@@ -117,11 +155,14 @@ func TestGet(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Couldn't create new auth token service.")
 
 	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -256,6 +297,9 @@ func TestListPassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
@@ -347,7 +391,7 @@ func TestListPassword(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+			s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			// Test non-anon first
@@ -390,6 +434,9 @@ func TestListOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -497,7 +544,7 @@ func TestListOidc(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+			s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 			require.NoError(err, "Couldn't create new user service.")
 
 			got, gErr := s.ListAccounts(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.req)
@@ -543,6 +590,9 @@ func TestDelete(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -562,7 +612,7 @@ func TestDelete(t *testing.T) {
 	)
 	oidcA := oidc.TestAccount(t, conn, oidcAm, "test-subject")
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new user service.")
 
 	cases := []struct {
@@ -639,6 +689,9 @@ func TestDelete_twice(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
@@ -647,7 +700,7 @@ func TestDelete_twice(t *testing.T) {
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
 	ac := password.TestAccount(t, conn, am.GetPublicId(), "name1")
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(err, "Error when getting new user service")
 	req := &pbs.DeleteAccountRequest{
 		Id: ac.GetPublicId(),
@@ -671,11 +724,14 @@ func TestCreatePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -873,11 +929,14 @@ func TestCreateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
 
-	s, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	s, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new account service.")
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
@@ -1086,13 +1145,16 @@ func TestUpdatePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
 	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new accounts service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1467,6 +1529,9 @@ func TestUpdateOidc(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kmsCache)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kmsCache)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kmsCache)
 	}
@@ -1482,7 +1547,7 @@ func TestUpdateOidc(t *testing.T) {
 		oidc.WithSigningAlgs(oidc.RS256),
 		oidc.WithApiUrl(oidc.TestConvertToUrls(t, "https://www.alice.com/callback")[0]))
 
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -1850,12 +1915,15 @@ func TestSetPassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -1989,12 +2057,15 @@ func TestChangePassword(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iam.NewRepository(rw, rw, kms)
 	}
 
 	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
-	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn)
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	createAccount := func(t *testing.T, pw string) *pb.Account {
@@ -2151,3 +2222,216 @@ func TestChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestMfa(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new account service.")
+	am := password.TestAuthMethod(t, conn, o.GetPublicId())
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "mfauser")
+	reqCtx := func() context.Context {
+		return requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+	}
+	mfaAttrs := func(t *testing.T, item *pb.Account) *pb.PasswordAccountAttributes {
+		attrs := &pb.PasswordAccountAttributes{}
+		require.NoError(t, handlers.StructToProto(item.GetAttributes(), attrs))
+		return attrs
+	}
+
+	assert, require := assert.New(t), require.New(t)
+
+	_, err = tested.ConfirmTotp(reqCtx(), &pbs.ConfirmTotpRequest{Id: acct.GetPublicId(), Code: "123456"})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "unexpected error %v", err)
+
+	enrollResp, err := tested.EnrollTotp(reqCtx(), &pbs.EnrollTotpRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+	assert.NotEmpty(enrollResp.GetSecret())
+	assert.Contains(enrollResp.GetUrl(), "otpauth://totp/")
+	assert.False(mfaAttrs(t, enrollResp.GetItem()).GetTotpEnabled())
+
+	_, err = tested.ConfirmTotp(reqCtx(), &pbs.ConfirmTotpRequest{Id: acct.GetPublicId(), Code: "not a code"})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "unexpected error %v", err)
+
+	confirmResp, err := tested.ConfirmTotp(reqCtx(), &pbs.ConfirmTotpRequest{Id: acct.GetPublicId(), Code: password.TestTotpCode(t, enrollResp.GetSecret())})
+	require.NoError(err)
+	assert.Len(confirmResp.GetRecoveryCodes(), 10)
+	attrs := mfaAttrs(t, confirmResp.GetItem())
+	assert.True(attrs.GetTotpEnabled())
+	assert.Equal(uint32(10), attrs.GetRecoveryCodesRemaining())
+
+	getResp, err := tested.GetAccount(reqCtx(), &pbs.GetAccountRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+	assert.True(mfaAttrs(t, getResp.GetItem()).GetTotpEnabled())
+
+	_, err = tested.EnrollWebAuthn(reqCtx(), &pbs.EnrollWebAuthnRequest{Id: acct.GetPublicId()})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "no relying party id: %v", err)
+
+	codesResp, err := tested.GenerateRecoveryCodes(reqCtx(), &pbs.GenerateRecoveryCodesRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+	assert.Len(codesResp.GetRecoveryCodes(), 10)
+	assert.NotEqual(confirmResp.GetRecoveryCodes(), codesResp.GetRecoveryCodes())
+
+	removeResp, err := tested.RemoveMfa(reqCtx(), &pbs.RemoveMfaRequest{Id: acct.GetPublicId(), Type: password.MfaTypeTotp})
+	require.NoError(err)
+	attrs = mfaAttrs(t, removeResp.GetItem())
+	assert.False(attrs.GetTotpEnabled())
+	assert.Zero(attrs.GetRecoveryCodesRemaining())
+
+	_, err = tested.RemoveMfa(reqCtx(), &pbs.RemoveMfaRequest{Id: acct.GetPublicId(), Type: password.MfaTypeTotp})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "unexpected error %v", err)
+}
+
+func TestUnlock(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new account service.")
+	am := password.TestAuthMethod(t, conn, o.GetPublicId(), password.WithLockout(1, time.Minute, time.Hour))
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "lockeduser")
+	reqCtx := requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	assert, require := assert.New(t), require.New(t)
+
+	_, err = tested.Unlock(reqCtx, &pbs.UnlockRequest{Id: "bad id"})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "unexpected error %v", err)
+	_, err = tested.Unlock(reqCtx, &pbs.UnlockRequest{Id: intglobals.NewPasswordAccountPrefix + "_DoesntExis"})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "unexpected error %v", err)
+
+	repo, err := pwRepoFn()
+	require.NoError(err)
+	authAcct, err := repo.Authenticate(ctx, o.GetPublicId(), am.GetPublicId(), "lockeduser", "wrong password")
+	require.NoError(err)
+	require.Nil(authAcct)
+
+	attrs := &pb.PasswordAccountAttributes{}
+	getResp, err := tested.GetAccount(reqCtx, &pbs.GetAccountRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+	require.NoError(handlers.StructToProto(getResp.GetItem().GetAttributes(), attrs))
+	assert.NotNil(attrs.GetLockedUntil())
+
+	unlockResp, err := tested.Unlock(reqCtx, &pbs.UnlockRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+	attrs = &pb.PasswordAccountAttributes{}
+	require.NoError(handlers.StructToProto(unlockResp.GetItem().GetAttributes(), attrs))
+	assert.Nil(attrs.GetLockedUntil())
+}
+
+func TestUpdate_Disabled(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(rw, rw, kms, iam.WithAccessRevoker(session.NewAccessRevoker()))
+	}
+	tokenRepo, err := authtoken.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn)
+	require.NoError(t, err, "Error when getting new account service.")
+	at := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	reqCtx := requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId())
+
+	assert, require := assert.New(t), require.New(t)
+
+	_, err = tested.CreateAccount(reqCtx, &pbs.CreateAccountRequest{Item: &pb.Account{
+		AuthMethodId: at.GetAuthMethodId(),
+		Type:         "password",
+		Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+			"login_name": structpb.NewStringValue("disabled"),
+		}},
+		Disabled: true,
+	}})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "unexpected error %v", err)
+
+	getResp, err := tested.GetAccount(reqCtx, &pbs.GetAccountRequest{Id: at.GetAuthAccountId()})
+	require.NoError(err)
+	require.False(getResp.GetItem().GetDisabled())
+	version := getResp.GetItem().GetVersion()
+
+	// Disabling the account revokes the auth tokens of its user without
+	// updating the account itself.
+	updateResp, err := tested.UpdateAccount(reqCtx, &pbs.UpdateAccountRequest{
+		Id:         at.GetAuthAccountId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.DisabledField}},
+		Item:       &pb.Account{Version: version, Disabled: true},
+	})
+	require.NoError(err)
+	assert.True(updateResp.GetItem().GetDisabled())
+	assert.Equal(version, updateResp.GetItem().GetVersion())
+	found, err := tokenRepo.LookupAuthToken(ctx, at.GetPublicId())
+	require.NoError(err)
+	assert.Nil(found)
+
+	getResp, err = tested.GetAccount(reqCtx, &pbs.GetAccountRequest{Id: at.GetAuthAccountId()})
+	require.NoError(err)
+	assert.True(getResp.GetItem().GetDisabled())
+	listResp, err := tested.ListAccounts(reqCtx, &pbs.ListAccountsRequest{AuthMethodId: at.GetAuthMethodId()})
+	require.NoError(err)
+	require.Len(listResp.GetItems(), 1)
+	assert.True(listResp.GetItems()[0].GetDisabled())
+
+	// The disabled state can be updated along with the other fields.
+	updateResp, err = tested.UpdateAccount(reqCtx, &pbs.UpdateAccountRequest{
+		Id:         at.GetAuthAccountId(),
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"name,disabled"}},
+		Item:       &pb.Account{Version: version, Name: wrapperspb.String("enabled")},
+	})
+	require.NoError(err)
+	assert.False(updateResp.GetItem().GetDisabled())
+	assert.Equal("enabled", updateResp.GetItem().GetName().GetValue())
+	assert.Equal(version+1, updateResp.GetItem().GetVersion())
+
+	_, err = tested.UpdateAccount(reqCtx, &pbs.UpdateAccountRequest{
+		Id:         intglobals.NewPasswordAccountPrefix + "_DoesntExis",
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.DisabledField}},
+		Item:       &pb.Account{Version: version, Disabled: true},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "unexpected error %v", err)
+}
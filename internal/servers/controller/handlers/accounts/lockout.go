@@ -0,0 +1,33 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/types/action"
+)
+
+// Unlock implements the interface pbs.AccountServiceServer.
+func (s Service) Unlock(ctx context.Context, req *pbs.UnlockRequest) (*pbs.UnlockResponse, error) {
+	const op = "accounts.(Service).Unlock"
+	if err := validateMfaId(req.GetId()); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.Unlock)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := repo.UnlockAccount(ctx, req.GetId()); err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	item, err := s.pwAccountToProto(ctx, repo, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.UnlockResponse{Item: item}, nil
+}
@@ -0,0 +1,357 @@
+package accounts
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/intglobals"
+	"github.com/hashicorp/boundary/internal/requests"
+	requestauth "github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/accounts"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// mfa field names
+	codeField               = "code"
+	challengeIdField        = "challenge_id"
+	credentialIdField       = "credential_id"
+	clientDataJsonField     = "client_data_json"
+	authenticatorDataField  = "authenticator_data"
+	publicKeyField          = "public_key"
+	publicKeyAlgorithmField = "public_key_algorithm"
+)
+
+// webAuthnEncoding is the encoding of the binary WebAuthn values.
+var webAuthnEncoding = base64.RawURLEncoding
+
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	const op = "accounts.(Service).EnrollTotp"
+	if err := validateMfaId(req.GetId()); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	secret, url, err := repo.EnrollTotp(ctx, req.GetId())
+	if err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	item, err := s.pwAccountToProto(ctx, repo, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.EnrollTotpResponse{Item: item, Secret: secret, Url: url}, nil
+}
+
+// ConfirmTotp implements the interface pbs.AccountServiceServer.
+func (s Service) ConfirmTotp(ctx context.Context, req *pbs.ConfirmTotpRequest) (*pbs.ConfirmTotpResponse, error) {
+	const op = "accounts.(Service).ConfirmTotp"
+	if err := validateMfaId(req.GetId()); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetCode()) == "" {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{codeField: "This is a required field."})
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.ConfirmTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	codes, err := repo.ConfirmTotp(ctx, req.GetId(), req.GetCode())
+	if err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	item, err := s.pwAccountToProto(ctx, repo, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ConfirmTotpResponse{Item: item, RecoveryCodes: codes}, nil
+}
+
+// EnrollWebAuthn implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollWebAuthn(ctx context.Context, req *pbs.EnrollWebAuthnRequest) (*pbs.EnrollWebAuthnResponse, error) {
+	const op = "accounts.(Service).EnrollWebAuthn"
+	if err := validateMfaId(req.GetId()); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollWebAuthn)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	reg, err := repo.EnrollWebAuthn(ctx, req.GetId())
+	if err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	resp := &pbs.EnrollWebAuthnResponse{
+		ChallengeId:    reg.ChallengeId,
+		Challenge:      webAuthnEncoding.EncodeToString(reg.Challenge),
+		RpId:           reg.RpId,
+		UserId:         webAuthnEncoding.EncodeToString(reg.UserId),
+		UserName:       reg.UserName,
+		ExpirationTime: timestamppb.New(reg.ExpirationTime),
+	}
+	for _, id := range reg.ExcludeCredentialIds {
+		resp.ExcludeCredentialIds = append(resp.ExcludeCredentialIds, webAuthnEncoding.EncodeToString(id))
+	}
+	return resp, nil
+}
+
+// ConfirmWebAuthn implements the interface pbs.AccountServiceServer.
+func (s Service) ConfirmWebAuthn(ctx context.Context, req *pbs.ConfirmWebAuthnRequest) (*pbs.ConfirmWebAuthnResponse, error) {
+	const op = "accounts.(Service).ConfirmWebAuthn"
+	att, err := validateConfirmWebAuthnRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.ConfirmWebAuthn)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	codes, err := repo.ConfirmWebAuthn(ctx, req.GetId(), req.GetChallengeId(), att)
+	if err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	item, err := s.pwAccountToProto(ctx, repo, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ConfirmWebAuthnResponse{Item: item, RecoveryCodes: codes}, nil
+}
+
+// RemoveMfa implements the interface pbs.AccountServiceServer.
+func (s Service) RemoveMfa(ctx context.Context, req *pbs.RemoveMfaRequest) (*pbs.RemoveMfaResponse, error) {
+	const op = "accounts.(Service).RemoveMfa"
+	credentialId, err := validateRemoveMfaRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveMfa)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := repo.RemoveMfa(ctx, req.GetId(), req.GetType(), credentialId); err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	item, err := s.pwAccountToProto(ctx, repo, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.RemoveMfaResponse{Item: item}, nil
+}
+
+// GenerateRecoveryCodes implements the interface pbs.AccountServiceServer.
+func (s Service) GenerateRecoveryCodes(ctx context.Context, req *pbs.GenerateRecoveryCodesRequest) (*pbs.GenerateRecoveryCodesResponse, error) {
+	const op = "accounts.(Service).GenerateRecoveryCodes"
+	if err := validateMfaId(req.GetId()); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.GenerateRecoveryCodes)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	codes, err := repo.GenerateRecoveryCodes(ctx, req.GetId())
+	if err != nil {
+		return nil, mfaRepoError(ctx, op, err)
+	}
+	item, err := s.pwAccountToProto(ctx, repo, req.GetId(), &authResults)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.GenerateRecoveryCodesResponse{Item: item, RecoveryCodes: codes}, nil
+}
+
+// pwAccountToProto returns the account with id, including its second factor
+// and lockout status.
+func (s Service) pwAccountToProto(ctx context.Context, repo *password.Repository, id string, authResults *requestauth.VerifyResults) (*pb.Account, error) {
+	const op = "accounts.(Service).pwAccountToProto"
+	acct, err := repo.LookupAccount(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, handlers.NotFoundErrorf("Account %q doesn't exist.", id)
+	}
+	if acct.Mfa, err = repo.LookupMfa(ctx, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct.Lockout, err = repo.LookupLockout(ctx, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, acct.GetPublicId(), IdActions[auth.SubtypeFromId(acct.GetPublicId())]).Strings()))
+	}
+	if outputFields.Has(globals.DisabledField) {
+		disabled, err := s.disabledAccounts(ctx, acct.GetPublicId())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		outputOpts = append(outputOpts, handlers.WithDisabled(disabled[acct.GetPublicId()]))
+	}
+	return toProto(ctx, acct, outputOpts...)
+}
+
+// toMfaAttributes sets the second factor status of in on attrs.
+func toMfaAttributes(in *password.MfaStatus, attrs *pb.PasswordAccountAttributes) {
+	if in == nil {
+		return
+	}
+	attrs.TotpEnabled = in.TotpEnabled
+	attrs.RecoveryCodesRemaining = uint32(in.RecoveryCodesRemaining)
+	for _, c := range in.WebAuthnCredentials {
+		attrs.WebauthnCredentials = append(attrs.WebauthnCredentials, &pb.WebAuthnCredential{
+			Id:          webAuthnEncoding.EncodeToString(c.GetCredentialId()),
+			Name:        c.GetName(),
+			CreatedTime: c.GetCreateTime().GetTimestamp(),
+		})
+	}
+}
+
+// mfaRepoError converts the errors of the second factor methods of the
+// password repository for the API.
+func mfaRepoError(ctx context.Context, op errors.Op, err error) error {
+	switch {
+	case errors.IsNotFoundError(err):
+		return handlers.NotFoundErrorf("%s.", mfaErrorMsg(err))
+	case errors.Match(errors.T(errors.InvalidParameter), err), errors.Match(errors.T(errors.NotUnique), err):
+		return handlers.InvalidArgumentErrorf(fmt.Sprintf("Error in provided request: %s.", mfaErrorMsg(err)), nil)
+	case errors.Match(errors.T(errors.AuthAttemptExpired), err):
+		return handlers.InvalidArgumentErrorf("Error in provided request: the challenge has expired.", nil)
+	}
+	return errors.Wrap(ctx, err, op)
+}
+
+// mfaErrorMsg returns the message of the innermost error of err, which
+// describes why the repository rejected a request.
+func mfaErrorMsg(err error) string {
+	var msg string
+	for err != nil {
+		e, ok := err.(*errors.Err)
+		if !ok {
+			break
+		}
+		if e.Msg != "" {
+			msg = e.Msg
+		}
+		err = e.Wrapped
+	}
+	return msg
+}
+
+func validateMfaId(id string) error {
+	if !handlers.ValidId(handlers.Id(id), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{idField: "Improperly formatted identifier."})
+	}
+	return nil
+}
+
+func validateConfirmWebAuthnRequest(req *pbs.ConfirmWebAuthnRequest) (*password.WebAuthnAttestation, error) {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	if req.GetChallengeId() == "" {
+		badFields[challengeIdField] = "This is a required field."
+	}
+	att := &password.WebAuthnAttestation{
+		Name:               req.GetName(),
+		PublicKeyAlgorithm: req.GetPublicKeyAlgorithm(),
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+		dst   *[]byte
+	}{
+		{credentialIdField, req.GetCredentialId(), &att.CredentialId},
+		{clientDataJsonField, req.GetClientDataJson(), &att.ClientDataJSON},
+		{authenticatorDataField, req.GetAuthenticatorData(), &att.AuthenticatorData},
+		{publicKeyField, req.GetPublicKey(), &att.PublicKey},
+	} {
+		if f.value == "" {
+			badFields[f.name] = "This is a required field."
+			continue
+		}
+		b, err := webAuthnEncoding.DecodeString(strings.TrimRight(f.value, "="))
+		if err != nil {
+			badFields[f.name] = "Must be base64url encoded."
+			continue
+		}
+		*f.dst = b
+	}
+	if req.GetPublicKeyAlgorithm() == 0 {
+		badFields[publicKeyAlgorithmField] = "This is a required field."
+	}
+	if len(badFields) > 0 {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return att, nil
+}
+
+func validateRemoveMfaRequest(req *pbs.RemoveMfaRequest) ([]byte, error) {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), intglobals.OldPasswordAccountPrefix, intglobals.NewPasswordAccountPrefix) {
+		badFields[idField] = "Improperly formatted identifier."
+	}
+	var credentialId []byte
+	switch req.GetType() {
+	case password.MfaTypeTotp:
+		if req.GetCredentialId() != "" {
+			badFields[credentialIdField] = "Must not be set when removing a TOTP authenticator."
+		}
+	case password.MfaTypeWebAuthn:
+		var err error
+		if credentialId, err = webAuthnEncoding.DecodeString(strings.TrimRight(req.GetCredentialId(), "=")); err != nil || len(credentialId) == 0 {
+			badFields[credentialIdField] = "The base64url encoded ID of the credential is required when removing a WebAuthn credential."
+		}
+	default:
+		badFields[typeField] = fmt.Sprintf("Must be %q or %q.", password.MfaTypeTotp, password.MfaTypeWebAuthn)
+	}
+	if len(badFields) > 0 {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return credentialId, nil
+}
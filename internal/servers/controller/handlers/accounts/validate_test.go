@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -130,6 +131,36 @@ func TestValidateCreateRequest(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "missing ldap login name",
+			item: &pb.Account{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(ldapLoginNameField, "This is a required field for this type."),
+		},
+		{
+			name: "read only ldap dn field",
+			item: &pb.Account{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"login_name": structpb.NewStringValue("alice"),
+					"dn":         structpb.NewStringValue("uid=alice,dc=example,dc=com"),
+				}},
+			},
+			errContains: fieldError(ldapDnField, "This is a read only field."),
+		},
+		{
+			name: "no ldap errors",
+			item: &pb.Account{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"login_name": structpb.NewStringValue("alice"),
+				}},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -219,6 +250,17 @@ func TestValidateUpdateRequest(t *testing.T) {
 			},
 			errContains: fieldError(attributesField, "Attribute fields do not match the expected format."),
 		},
+		{
+			name: "ldap login name update",
+			req: &pbs.UpdateAccountRequest{
+				Id:         ldap.AccountPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{ldapLoginNameField}},
+				Item: &pb.Account{
+					Version: 1,
+				},
+			},
+			errContains: fieldError(ldapLoginNameField, "Field cannot be updated."),
+		},
 		{
 			name: "no error",
 			req: &pbs.UpdateAccountRequest{
@@ -279,3 +321,135 @@ func TestValidateUpdateRequest(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateRemoveMfaRequest(t *testing.T) {
+	t.Parallel()
+	pwId := intglobals.NewPasswordAccountPrefix + "_1234567890"
+	cases := []struct {
+		name             string
+		req              *pbs.RemoveMfaRequest
+		wantCredentialId []byte
+		errContains      string
+	}{
+		{
+			name:        "oidc account",
+			req:         &pbs.RemoveMfaRequest{Id: oidc.AccountPrefix + "_1234567890", Type: password.MfaTypeTotp},
+			errContains: fieldError(idField, "Improperly formatted identifier."),
+		},
+		{
+			name:        "unknown type",
+			req:         &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeRecoveryCode},
+			errContains: fieldError(typeField, `Must be "totp" or "webauthn".`),
+		},
+		{
+			name:        "totp with credential id",
+			req:         &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeTotp, CredentialId: "AQID"},
+			errContains: fieldError(credentialIdField, "Must not be set when removing a TOTP authenticator."),
+		},
+		{
+			name:        "webauthn without credential id",
+			req:         &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeWebAuthn},
+			errContains: credentialIdField,
+		},
+		{
+			name:        "webauthn with invalid credential id",
+			req:         &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeWebAuthn, CredentialId: "not base64!"},
+			errContains: credentialIdField,
+		},
+		{
+			name: "totp",
+			req:  &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeTotp},
+		},
+		{
+			name:             "webauthn",
+			req:              &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeWebAuthn, CredentialId: "AQID"},
+			wantCredentialId: []byte{1, 2, 3},
+		},
+		{
+			name:             "webauthn padded",
+			req:              &pbs.RemoveMfaRequest{Id: pwId, Type: password.MfaTypeWebAuthn, CredentialId: "AQI="},
+			wantCredentialId: []byte{1, 2},
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := validateRemoveMfaRequest(tc.req)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				assert.Equal(t, tc.wantCredentialId, got)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
+
+func TestValidateConfirmWebAuthnRequest(t *testing.T) {
+	t.Parallel()
+	valid := func() *pbs.ConfirmWebAuthnRequest {
+		return &pbs.ConfirmWebAuthnRequest{
+			Id:                 intglobals.NewPasswordAccountPrefix + "_1234567890",
+			ChallengeId:        "pwmfachl_1234567890",
+			Name:               "key",
+			CredentialId:       "AQID",
+			ClientDataJson:     "e30",
+			AuthenticatorData:  "BAUG",
+			PublicKey:          "BwgJ",
+			PublicKeyAlgorithm: -7,
+		}
+	}
+
+	att, err := validateConfirmWebAuthnRequest(valid())
+	require.NoError(t, err)
+	assert.Equal(t, &password.WebAuthnAttestation{
+		Name:               "key",
+		CredentialId:       []byte{1, 2, 3},
+		ClientDataJSON:     []byte("{}"),
+		AuthenticatorData:  []byte{4, 5, 6},
+		PublicKey:          []byte{7, 8, 9},
+		PublicKeyAlgorithm: -7,
+	}, att)
+
+	cases := []struct {
+		name        string
+		modify      func(*pbs.ConfirmWebAuthnRequest)
+		errContains string
+	}{
+		{
+			name:        "missing challenge id",
+			modify:      func(r *pbs.ConfirmWebAuthnRequest) { r.ChallengeId = "" },
+			errContains: fieldError(challengeIdField, "This is a required field."),
+		},
+		{
+			name:        "missing public key",
+			modify:      func(r *pbs.ConfirmWebAuthnRequest) { r.PublicKey = "" },
+			errContains: fieldError(publicKeyField, "This is a required field."),
+		},
+		{
+			name:        "invalid client data",
+			modify:      func(r *pbs.ConfirmWebAuthnRequest) { r.ClientDataJson = "{}" },
+			errContains: fieldError(clientDataJsonField, "Must be base64url encoded."),
+		},
+		{
+			name:        "missing algorithm",
+			modify:      func(r *pbs.ConfirmWebAuthnRequest) { r.PublicKeyAlgorithm = 0 },
+			errContains: fieldError(publicKeyAlgorithmField, "This is a required field."),
+		},
+	}
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			req := valid()
+			tc.modify(req)
+			_, err := validateConfirmWebAuthnRequest(req)
+			require.Error(t, err)
+			assert.True(t, strings.Contains(err.Error(), tc.errContains),
+				"%q wasn't contained in %q", tc.errContains, err.Error())
+		})
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -73,12 +75,13 @@ type Service struct {
 	kms        *kms.Kms
 	pwRepoFn   common.PasswordAuthRepoFactory
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
 	iamRepoFn  common.IamRepoFactory
 	atRepoFn   common.AuthTokenRepoFactory
 }
 
 // NewService returns a auth method service which handles auth method related requests to boundary.
-func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, opt ...handlers.Option) (Service, error) {
+func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoFn common.OidcAuthRepoFactory, ldapRepoFn common.LdapAuthRepoFactory, iamRepoFn common.IamRepoFactory, atRepoFn common.AuthTokenRepoFactory, opt ...handlers.Option) (Service, error) {
 	const op = "authmethods.NewService"
 	if kms == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing kms")
@@ -89,13 +92,16 @@ func NewService(kms *kms.Kms, pwRepoFn common.PasswordAuthRepoFactory, oidcRepoF
 	if oidcRepoFn == nil {
 		return Service{}, fmt.Errorf("nil oidc repository provided")
 	}
+	if ldapRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository")
+	}
 	if iamRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
 	if atRepoFn == nil {
 		return Service{}, fmt.Errorf("nil auth token repository provided")
 	}
-	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}
+	s := Service{kms: kms, pwRepoFn: pwRepoFn, oidcRepoFn: oidcRepoFn, ldapRepoFn: ldapRepoFn, iamRepoFn: iamRepoFn, atRepoFn: atRepoFn}
 
 	return s, nil
 }
@@ -107,6 +113,10 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -131,57 +141,62 @@ func (s Service) ListAuthMethods(ctx context.Context, req *pbs.ListAuthMethodsRe
 		return &pbs.ListAuthMethodsResponse{}, nil
 	}
 
-	ul, err := s.listFromRepo(ctx, scopeIds, authResults)
-	if err != nil {
-		return nil, err
-	}
-	if len(ul) == 0 {
-		return &pbs.ListAuthMethodsResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.AuthMethod, 0, len(ul))
+	var finalItems []*pb.AuthMethod
 	res := perms.Resource{
 		Type: resource.AuthMethod,
 	}
-	for _, am := range ul {
-		res.Id = am.GetPublicId()
-		res.ScopeId = am.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, am.GetPublicId(), IdActions[auth.SubtypeFromId(am.GetPublicId())], requestauth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for {
+		ul, err := s.listFromRepo(ctx, scopeIds, authResults, page)
+		if err != nil {
+			return nil, err
 		}
+		for _, am := range ul {
+			if !page.Next(am.GetPublicId()) {
+				break
+			}
+			res.Id = am.GetPublicId()
+			res.ScopeId = am.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, am.GetPublicId(), IdActions[auth.SubtypeFromId(am.GetPublicId())], requestauth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[am.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			collectionActions, err := requestauth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, am.GetPublicId())
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[am.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				collectionActions, err := requestauth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, am.GetPublicId())
+				if err != nil {
+					return nil, err
+				}
+				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+			}
+
+			item, err := toAuthMethodProto(ctx, am, outputOpts...)
 			if err != nil {
 				return nil, err
 			}
-			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
-		}
 
-		item, err := toAuthMethodProto(ctx, am, outputOpts...)
-		if err != nil {
-			return nil, err
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		if page.Done(len(ul)) {
+			break
 		}
 	}
-	return &pbs.ListAuthMethodsResponse{Items: finalItems}, nil
+	return &pbs.ListAuthMethodsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetAuthMethod implements the interface pbs.AuthMethodServiceServer.
@@ -410,6 +425,10 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 		if err := validateAuthenticateOidcRequest(req); err != nil {
 			return nil, err
 		}
+	case ldap.Subtype:
+		if err := validateAuthenticateLdapRequest(req); err != nil {
+			return nil, err
+		}
 	}
 
 	authResults := s.authResult(ctx, req.GetAuthMethodId(), action.Authenticate)
@@ -423,6 +442,9 @@ func (s Service) Authenticate(ctx context.Context, req *pbs.AuthenticateRequest)
 
 	case oidc.Subtype:
 		return s.authenticateOidc(ctx, req, &authResults)
+
+	case ldap.Subtype:
+		return s.authenticateLdap(ctx, req, &authResults)
 	}
 	return nil, errors.New(ctx, errors.Internal, op, "Invalid auth method subtype not caught in validation function.")
 }
@@ -445,6 +467,17 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.AuthMethod, e
 		}
 		am, lookupErr = repo.LookupAuthMethod(ctx, id)
 
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		lam, err := repo.LookupAuthMethod(ctx, id)
+		if lam != nil {
+			am = lam
+		}
+		lookupErr = err
+
 	default:
 		return nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -462,7 +495,9 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.AuthMethod, e
 	return am, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string, authResults requestauth.VerifyResults) ([]auth.AuthMethod, error) {
+// listFromRepo returns the page's next batch of auth methods from the oidc,
+// password and ldap repositories, ordered by public id.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, authResults requestauth.VerifyResults, page *handlers.ListPage) ([]auth.AuthMethod, error) {
 	const op = "authmethods.(Service).listFromRepo"
 	reqCtx, ok := requests.RequestContextFromCtx(ctx)
 	if !ok {
@@ -475,7 +510,7 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string, authResult
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	ol, err := oidcRepo.ListAuthMethods(ctx, scopeIds, oidc.WithUnauthenticatedUser(reqCtx.UserId == requestauth.AnonymousUserId))
+	ol, err := oidcRepo.ListAuthMethods(ctx, scopeIds, oidc.WithUnauthenticatedUser(reqCtx.UserId == requestauth.AnonymousUserId), oidc.WithLimit(page.Limit()), oidc.WithStartPageAfterId(page.StartAfterId()))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -487,13 +522,31 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string, authResult
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	pl, err := repo.ListAuthMethods(ctx, scopeIds)
+	pl, err := repo.ListAuthMethods(ctx, scopeIds, password.WithLimit(page.Limit()), password.WithStartPageAfterId(page.StartAfterId()))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
 	for _, item := range pl {
 		outUl = append(outUl, item)
 	}
+
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ll, err := ldapRepo.ListAuthMethods(ctx, scopeIds, ldap.WithLimit(page.Limit()), ldap.WithStartPageAfterId(page.StartAfterId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, item := range ll {
+		outUl = append(outUl, item)
+	}
+	sort.Slice(outUl, func(i, j int) bool {
+		return outUl[i].GetPublicId() < outUl[j].GetPublicId()
+	})
+	if len(outUl) > page.Limit() {
+		outUl = outUl[:page.Limit()]
+	}
 	return outUl, nil
 }
 
@@ -519,6 +572,15 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Auth
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, scopeId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create auth method but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -550,6 +612,16 @@ func (s Service) updateInRepo(ctx context.Context, scopeId string, req *pbs.Upda
 		}
 		am = oam
 		dryRun = dr
+
+	case ldap.Subtype:
+		lam, err := s.updateLdapInRepo(ctx, scopeId, req)
+		if err != nil {
+			return nil, false, errors.Wrap(ctx, err, op)
+		}
+		if lam == nil {
+			return nil, false, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update auth method but no error returned from repository.")
+		}
+		am = lam
 	}
 
 	return am, dryRun, nil
@@ -573,6 +645,13 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, errors.Wrap(ctx, err, op)
 		}
 		rows, dErr = repo.DeleteAuthMethod(ctx, id)
+
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		rows, dErr = repo.DeleteAuthMethod(ctx, id)
 	}
 
 	if dErr != nil {
@@ -684,6 +763,22 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) reque
 				return res
 			}
 			authMeth = am
+		case ldap.Subtype:
+			repo, err := s.ldapRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			am, err := repo.LookupAuthMethod(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if am == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			authMeth = am
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method type")
 			return res
@@ -744,10 +839,19 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 		if !outputFields.Has(globals.AttributesField) {
 			break
 		}
-		st, err := handlers.ProtoToStruct(&pb.PasswordAuthMethodAttributes{
+		attrs := &pb.PasswordAuthMethodAttributes{
 			MinLoginNameLength: i.GetMinLoginNameLength(),
 			MinPasswordLength:  i.GetMinPasswordLength(),
-		})
+			MfaRequired:        i.GetMfaRequired(),
+
+			LockoutMaxFailedAttempts: i.GetLockoutMaxFailedAttempts(),
+			LockoutWindowSeconds:     i.GetLockoutWindowSeconds(),
+			LockoutDurationSeconds:   i.GetLockoutDurationSeconds(),
+		}
+		if i.GetWebauthnRpId() != "" {
+			attrs.WebauthnRpId = wrapperspb.String(i.GetWebauthnRpId())
+		}
+		st, err := handlers.ProtoToStruct(attrs)
 		if err != nil {
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building password attribute struct: %v", err)
 		}
@@ -792,6 +896,18 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
 		}
 		out.Attributes = st
+	case *ldap.AuthMethod:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		st, err := handlers.ProtoToStruct(toLdapAuthMethodAttributes(i))
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+		}
+		out.Attributes = st
 	}
 	return &out, nil
 }
@@ -820,7 +936,7 @@ func validateGetRequest(req *pbs.GetAuthMethodRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest) error {
@@ -918,6 +1034,22 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateAuthMethodRequest
 					}
 				}
 			}
+		case ldap.Subtype:
+			attrs := &pb.LdapAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[attributesField] = "Attribute fields do not match the expected format."
+			} else {
+				if len(attrs.GetUrls()) == 0 {
+					badFields[urlsField] = "Field required for creating an LDAP auth method."
+				}
+				if attrs.GetBindPassword().GetValue() != "" && attrs.GetBindDn().GetValue() == "" {
+					badFields[bindDnField] = "Field required when a bind password is provided."
+				}
+				if attrs.GetEnableGroups() && attrs.GetGroupDn().GetValue() == "" {
+					badFields[groupDnField] = "Field required when groups are enabled."
+				}
+				validateLdapAttributes(ctx, attrs, badFields)
+			}
 		default:
 			badFields[typeField] = fmt.Sprintf("This is a required field and must be %q.", password.Subtype.String())
 		}
@@ -1049,11 +1181,23 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateAuthMethodRequest
 					}
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && auth.SubtypeFromType(req.GetItem().GetType()) != ldap.Subtype {
+				badFields[typeField] = "Cannot modify the resource type."
+			}
+			attrs := &pb.LdapAuthMethodAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[attributesField] = "Attribute fields do not match the expected format."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), urlsField) && len(attrs.GetUrls()) == 0 {
+				badFields[urlsField] = "Can change but cannot unset this field."
+			}
+			validateLdapAttributes(ctx, attrs, badFields)
 		default:
 			badFields["id"] = "Incorrectly formatted identifier."
 		}
 		return badFields
-	}, password.AuthMethodPrefix, oidc.AuthMethodPrefix)
+	}, password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteAuthMethodRequest) error {
@@ -1061,7 +1205,7 @@ func validateDeleteRequest(req *pbs.DeleteAuthMethodRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "Missing request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix)
 }
 
 func validateListRequest(req *pbs.ListAuthMethodsRequest) error {
@@ -1124,7 +1268,7 @@ func validateAuthenticateRequest(req *pbs.AuthenticateRequest) error {
 	} else {
 		st := auth.SubtypeFromId(req.GetAuthMethodId())
 		switch st {
-		case password.Subtype, oidc.Subtype:
+		case password.Subtype, oidc.Subtype, ldap.Subtype:
 		default:
 			badFields[authMethodIdField] = "Unknown auth method type."
 		}
@@ -0,0 +1,243 @@
+package authmethods
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
+	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// ldap field names
+	urlsField             = "attributes.urls"
+	certificatesField     = "attributes.certificates"
+	bindDnField           = "attributes.bind_dn"
+	bindPasswordHmacField = "attributes.bind_password_hmac"
+	groupDnField          = "attributes.group_dn"
+)
+
+var ldapMaskManager handlers.MaskManager
+
+func init() {
+	var err error
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.AuthMethod{}}, handlers.MaskSource{&pb.AuthMethod{}, &pb.LdapAuthMethodAttributes{}}); err != nil {
+		panic(err)
+	}
+
+	IdActions[ldap.Subtype] = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+		action.Authenticate,
+	}
+}
+
+// createLdapInRepo creates an ldap auth method in a repo and returns the result.
+// This method should never return a nil AuthMethod without returning an error.
+func (s Service) createLdapInRepo(ctx context.Context, scopeId string, item *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	u, err := toStorageLdapAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, err := repo.CreateAuthMethod(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create auth method: %w", err)
+	}
+	return out, nil
+}
+
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId string, req *pbs.UpdateAuthMethodRequest) (*ldap.AuthMethod, error) {
+	item := req.GetItem()
+	u, err := toStorageLdapAuthMethod(ctx, scopeId, item)
+	if err != nil {
+		return nil, err
+	}
+	u.PublicId = req.GetId()
+
+	version := item.GetVersion()
+	dbMask := ldapMaskManager.Translate(req.GetUpdateMask().GetPaths())
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	out, rowsUpdated, err := repo.UpdateAuthMethod(ctx, u, version, dbMask)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update auth method: %w", err)
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("AuthMethod %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	return out, nil
+}
+
+func (s Service) authenticateLdap(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
+	reqAttrs := req.GetAttributes().GetFields()
+	tok, err := s.authenticateWithLdapRepo(ctx, req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+}
+
+func (s Service) authenticateWithLdapRepo(ctx context.Context, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	acct, err := ldapRepo.Authenticate(ctx, authMethodId, loginName, pw)
+	if err != nil {
+		return nil, err
+	}
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+
+	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+	tok, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ConvertInternalAuthTokenToApiAuthToken(
+		ctx,
+		tok,
+	)
+}
+
+// validateLdapAttributes validates the ldap attributes shared by create and
+// update requests and adds any problems found to badFields.
+func validateLdapAttributes(ctx context.Context, attrs *pb.LdapAuthMethodAttributes, badFields map[string]string) {
+	for _, u := range attrs.GetUrls() {
+		if pu, err := url.Parse(u); err != nil || (pu.Scheme != "ldap" && pu.Scheme != "ldaps") || pu.Host == "" {
+			badFields[urlsField] = fmt.Sprintf("%q cannot be parsed as an ldap or ldaps url.", u)
+			break
+		}
+	}
+	if len(attrs.GetCertificates()) > 0 {
+		if _, err := ldap.ParseCertificates(ctx, attrs.GetCertificates()...); err != nil {
+			badFields[certificatesField] = fmt.Sprintf("Cannot parse CA certificates. %v", err.Error())
+		}
+	}
+	if attrs.GetBindPasswordHmac() != "" {
+		badFields[bindPasswordHmacField] = "Field is read only."
+	}
+}
+
+func validateAuthenticateLdapRequest(req *pbs.AuthenticateRequest) error {
+	// ldap auth methods accept the same login attributes as password auth
+	// methods.
+	return validateAuthenticatePasswordRequest(req)
+}
+
+// toStorageLdapAuthMethod converts the api auth method to its storage
+// representation.  The auth method is validated when it's created in the repo,
+// so no validation is done here; this allows partial auth methods to be built
+// for updates.
+func toStorageLdapAuthMethod(ctx context.Context, scopeId string, in *pb.AuthMethod) (*ldap.AuthMethod, error) {
+	const op = "authmethod_service.toStorageLdapAuthMethod"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil auth method.")
+	}
+	attrs := &pb.LdapAuthMethodAttributes{}
+	if err := handlers.StructToProto(in.GetAttributes(), attrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{attributesField: "Attribute fields do not match the expected format."})
+	}
+
+	am := ldap.AllocAuthMethod()
+	am.ScopeId = scopeId
+	am.Name = in.GetName().GetValue()
+	am.Description = in.GetDescription().GetValue()
+	am.Urls = attrs.GetUrls()
+	am.Certificates = attrs.GetCertificates()
+	am.StartTls = attrs.GetStartTls()
+	am.InsecureTls = attrs.GetInsecureTls()
+	am.DiscoverDn = attrs.GetDiscoverDn()
+	am.AnonGroupSearch = attrs.GetAnonGroupSearch()
+	am.UpnDomain = attrs.GetUpnDomain().GetValue()
+	am.UserDn = attrs.GetUserDn().GetValue()
+	am.UserAttr = attrs.GetUserAttr().GetValue()
+	am.UserFilter = attrs.GetUserFilter().GetValue()
+	am.EnableGroups = attrs.GetEnableGroups()
+	am.GroupDn = attrs.GetGroupDn().GetValue()
+	am.GroupAttr = attrs.GetGroupAttr().GetValue()
+	am.GroupFilter = attrs.GetGroupFilter().GetValue()
+	am.BindDn = attrs.GetBindDn().GetValue()
+	am.BindPassword = attrs.GetBindPassword().GetValue()
+	return &am, nil
+}
+
+// toLdapAuthMethodAttributes converts the ldap auth method to its api
+// attributes.
+func toLdapAuthMethodAttributes(in *ldap.AuthMethod) *pb.LdapAuthMethodAttributes {
+	attrs := &pb.LdapAuthMethodAttributes{
+		Urls:            in.GetUrls(),
+		Certificates:    in.GetCertificates(),
+		StartTls:        in.GetStartTls(),
+		InsecureTls:     in.GetInsecureTls(),
+		DiscoverDn:      in.GetDiscoverDn(),
+		AnonGroupSearch: in.GetAnonGroupSearch(),
+		EnableGroups:    in.GetEnableGroups(),
+	}
+	if in.GetUpnDomain() != "" {
+		attrs.UpnDomain = wrapperspb.String(in.GetUpnDomain())
+	}
+	if in.GetUserDn() != "" {
+		attrs.UserDn = wrapperspb.String(in.GetUserDn())
+	}
+	if in.GetUserAttr() != "" {
+		attrs.UserAttr = wrapperspb.String(in.GetUserAttr())
+	}
+	if in.GetUserFilter() != "" {
+		attrs.UserFilter = wrapperspb.String(in.GetUserFilter())
+	}
+	if in.GetGroupDn() != "" {
+		attrs.GroupDn = wrapperspb.String(in.GetGroupDn())
+	}
+	if in.GetGroupAttr() != "" {
+		attrs.GroupAttr = wrapperspb.String(in.GetGroupAttr())
+	}
+	if in.GetGroupFilter() != "" {
+		attrs.GroupFilter = wrapperspb.String(in.GetGroupFilter())
+	}
+	if in.GetBindDn() != "" {
+		attrs.BindDn = wrapperspb.String(in.GetBindDn())
+	}
+	if len(in.GetBindPasswordHmac()) > 0 {
+		attrs.BindPasswordHmac = base64.RawURLEncoding.EncodeToString(in.GetBindPasswordHmac())
+	}
+	return attrs
+}
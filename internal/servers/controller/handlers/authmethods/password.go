@@ -2,6 +2,7 @@ package authmethods
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"strings"
 
@@ -15,15 +16,24 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	pba "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authtokens"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	// password field names
-	loginNameField = "login_name"
-	passwordField  = "password"
-	loginCommand   = "login"
+	loginNameField         = "login_name"
+	passwordField          = "password"
+	challengeIdField       = "challenge_id"
+	totpCodeField          = "totp_code"
+	recoveryCodeField      = "recovery_code"
+	webAuthnAssertionField = "webauthn_assertion"
+	loginCommand           = "login"
+	mfaCommand             = "mfa"
 )
 
+// webAuthnEncoding is the encoding of the binary WebAuthn values.
+var webAuthnEncoding = base64.RawURLEncoding
+
 var pwMaskManager handlers.MaskManager
 
 func init() {
@@ -89,33 +99,110 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
 	reqAttrs := req.GetAttributes().GetFields()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
+	if req.GetCommand() == mfaCommand {
+		mfaReq := &pb.PasswordAuthMethodAuthenticateMfaRequest{}
+		if err := handlers.StructToProto(req.GetAttributes(), mfaReq); err != nil {
+			return nil, handlers.InvalidArgumentErrorf("Invalid fields provided in request.",
+				map[string]string{attributesField: "Attribute fields do not match the expected format."})
+		}
+		tok, err := s.authenticateMfaWithPwRepo(ctx, mfaReq)
+		if err != nil {
+			return nil, err
+		}
+		return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+	}
+
+	pwRepo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
-}
-
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
-	iamRepo, err := s.iamRepoFn()
+	acct, err := pwRepo.Authenticate(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs[loginNameField].GetStringValue(), reqAttrs[passwordField].GetStringValue())
 	if err != nil {
 		return nil, err
 	}
-	atRepo, err := s.atRepoFn()
+	if acct == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	ma, err := pwRepo.StartMfa(ctx, acct.GetPublicId())
+	switch {
+	case errors.Match(errors.T(errors.Forbidden), err):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate: a second factor must be enrolled for this account.")
+	case err != nil:
+		return nil, err
+	case ma != nil:
+		// The password is valid but the account has to authenticate with a
+		// second factor before a token is issued.
+		return toMfaChallengeResponse(ma)
+	}
+
+	tok, err := s.createPwAuthToken(ctx, acct)
 	if err != nil {
 		return nil, err
 	}
+	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
+}
+
+// authenticateMfaWithPwRepo completes a password authentication with the
+// answer to the second factor challenge in req.
+func (s Service) authenticateMfaWithPwRepo(ctx context.Context, req *pb.PasswordAuthMethodAuthenticateMfaRequest) (*pba.AuthToken, error) {
 	pwRepo, err := s.pwRepoFn()
 	if err != nil {
 		return nil, err
 	}
+	resp := &password.MfaResponse{
+		TotpCode:     req.GetTotpCode(),
+		RecoveryCode: req.GetRecoveryCode(),
+	}
+	if a := req.GetWebauthnAssertion(); a != nil {
+		resp.WebAuthnAssertion = &password.WebAuthnAssertion{}
+		badFields := make(map[string]string)
+		for _, f := range []struct {
+			name  string
+			value string
+			dst   *[]byte
+		}{
+			{"credential_id", a.GetCredentialId(), &resp.WebAuthnAssertion.CredentialId},
+			{"client_data_json", a.GetClientDataJson(), &resp.WebAuthnAssertion.ClientDataJSON},
+			{"authenticator_data", a.GetAuthenticatorData(), &resp.WebAuthnAssertion.AuthenticatorData},
+			{"signature", a.GetSignature(), &resp.WebAuthnAssertion.Signature},
+		} {
+			b, err := webAuthnEncoding.DecodeString(strings.TrimRight(f.value, "="))
+			if err != nil || len(b) == 0 {
+				badFields[fmt.Sprintf("attributes.%s.%s", webAuthnAssertionField, f.name)] = "This is a required field and must be base64url encoded."
+				continue
+			}
+			*f.dst = b
+		}
+		if len(badFields) > 0 {
+			return nil, handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+		}
+	}
+
+	acct, err := pwRepo.VerifyMfa(ctx, req.GetChallengeId(), resp)
+	switch {
+	case errors.IsNotFoundError(err), errors.Match(errors.T(errors.AuthAttemptExpired), err):
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate: the challenge is unknown or has expired.")
+	case errors.Match(errors.T(errors.InvalidParameter), err):
+		return nil, handlers.InvalidArgumentErrorf("Invalid fields provided in request.",
+			map[string]string{attributesField: "Exactly one of totp_code, recovery_code or webauthn_assertion must be provided."})
+	case err != nil:
+		return nil, err
+	case acct == nil:
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	}
+	return s.createPwAuthToken(ctx, acct)
+}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
+// createPwAuthToken creates an auth token for an authenticated password
+// account.
+func (s Service) createPwAuthToken(ctx context.Context, acct *password.Account) (*pba.AuthToken, error) {
+	iamRepo, err := s.iamRepoFn()
 	if err != nil {
 		return nil, err
 	}
-	if acct == nil {
-		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate.")
+	atRepo, err := s.atRepoFn()
+	if err != nil {
+		return nil, err
 	}
 
 	u, err := iamRepo.LookupUserWithLogin(ctx, acct.GetPublicId())
@@ -133,6 +220,28 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 	)
 }
 
+// toMfaChallengeResponse returns the response to a login command for an
+// account which has to authenticate with a second factor.
+func toMfaChallengeResponse(ma *password.MfaAuthentication) (*pbs.AuthenticateResponse, error) {
+	out := &pb.PasswordAuthMethodAuthenticateMfaChallengeResponse{
+		ChallengeId:    ma.ChallengeId,
+		ExpirationTime: timestamppb.New(ma.ExpirationTime),
+		Factors:        ma.Factors,
+		WebauthnRpId:   ma.WebAuthnRpId,
+	}
+	if len(ma.WebAuthnChallenge) > 0 {
+		out.WebauthnChallenge = webAuthnEncoding.EncodeToString(ma.WebAuthnChallenge)
+	}
+	for _, id := range ma.WebAuthnCredentialIds {
+		out.WebauthnCredentialIds = append(out.WebauthnCredentialIds, webAuthnEncoding.EncodeToString(id))
+	}
+	attrs, err := handlers.ProtoToStruct(out)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.AuthenticateResponse{Command: mfaCommand, Attributes: attrs}, nil
+}
+
 func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 	badFields := make(map[string]string)
 
@@ -143,17 +252,32 @@ func validateAuthenticatePasswordRequest(req *pbs.AuthenticateRequest) error {
 	}
 
 	attrs := req.GetAttributes().GetFields()
-	if _, ok := attrs[loginNameField]; !ok {
-		badFields["attributes.login_name"] = "This is a required field."
-	}
-	if _, ok := attrs[passwordField]; !ok {
-		badFields["attributes.password"] = "This is a required field."
-	}
 	if req.GetCommand() == "" {
 		// TODO: Eventually, require a command. For now, fall back to "login" for backwards compat.
 		req.Command = loginCommand
 	}
-	if req.Command != loginCommand {
+	switch req.Command {
+	case loginCommand:
+		if _, ok := attrs[loginNameField]; !ok {
+			badFields["attributes.login_name"] = "This is a required field."
+		}
+		if _, ok := attrs[passwordField]; !ok {
+			badFields["attributes.password"] = "This is a required field."
+		}
+	case mfaCommand:
+		if attrs[challengeIdField].GetStringValue() == "" {
+			badFields["attributes.challenge_id"] = "This is a required field."
+		}
+		var answers int
+		for _, f := range []string{totpCodeField, recoveryCodeField, webAuthnAssertionField} {
+			if _, ok := attrs[f]; ok {
+				answers++
+			}
+		}
+		if answers != 1 {
+			badFields[attributesField] = "Exactly one of totp_code, recovery_code or webauthn_assertion must be provided."
+		}
+	default:
 		badFields[commandField] = "Invalid command for this auth method type."
 	}
 	tType := strings.ToLower(strings.TrimSpace(req.GetTokenType()))
@@ -195,5 +319,16 @@ func toStoragePwAuthMethod(scopeId string, item *pb.AuthMethod) (*password.AuthM
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.MfaRequired = pwAttrs.GetMfaRequired()
+	if pwAttrs.GetWebauthnRpId() != nil {
+		u.WebauthnRpId = pwAttrs.GetWebauthnRpId().GetValue()
+	}
+	u.LockoutMaxFailedAttempts = pwAttrs.GetLockoutMaxFailedAttempts()
+	if pwAttrs.GetLockoutWindowSeconds() != 0 {
+		u.LockoutWindowSeconds = pwAttrs.GetLockoutWindowSeconds()
+	}
+	if pwAttrs.GetLockoutDurationSeconds() != 0 {
+		u.LockoutDurationSeconds = pwAttrs.GetLockoutDurationSeconds()
+	}
 	return u, nil
 }
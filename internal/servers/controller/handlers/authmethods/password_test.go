@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
@@ -43,6 +44,9 @@ func TestUpdate_Password(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
@@ -52,7 +56,7 @@ func TestUpdate_Password(t *testing.T) {
 	iamRepo := iam.TestRepo(t, conn, wrapper)
 
 	o, _ := iam.TestScopes(t, iamRepo)
-	tested, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	tested, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(t, err, "Error when getting new auth_method service.")
 
 	defaultScopeInfo := &scopepb.ScopeInfo{Id: o.GetPublicId(), Type: o.GetType(), ParentScopeId: scope.Global.String()}
@@ -461,6 +465,9 @@ func TestAuthenticate_Password(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
@@ -592,7 +599,7 @@ func TestAuthenticate_Password(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+			s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 			require.NoError(err)
 
 			resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.request)
@@ -632,6 +639,9 @@ func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	oidcRepoFn := func() (*oidc.Repository, error) {
 		return oidc.NewRepository(ctx, rw, rw, kms)
 	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
 	pwRepoFn := func() (*password.Repository, error) {
 		return password.NewRepository(rw, rw, kms)
 	}
@@ -655,7 +665,7 @@ func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	iamUser, err := iamRepo.LookupUserWithLogin(context.Background(), acct.GetPublicId())
 	require.NoError(err)
 
-	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
 	require.NoError(err)
 	resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
 		AuthMethodId: am.GetPublicId(),
@@ -679,3 +689,118 @@ func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	assert.NotEmpty(aToken.GetToken())
 	assert.True(strings.HasPrefix(aToken.GetToken(), aToken.GetId()))
 }
+
+func TestAuthenticate_Mfa_Password(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	pwRepo, err := pwRepoFn()
+	require.NoError(t, err)
+	s, err := authmethods.NewService(kms, pwRepoFn, oidcRepoFn, ldapRepoFn, iamRepoFn, atRepoFn)
+	require.NoError(t, err)
+
+	login := func(amId string) (*pbs.AuthenticateResponse, error) {
+		return s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
+			AuthMethodId: amId,
+			TokenType:    "token",
+			Command:      "login",
+			Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"login_name": structpb.NewStringValue(testLoginName),
+				"password":   structpb.NewStringValue(testPassword),
+			}},
+		})
+	}
+	mfa := func(amId string, attrs map[string]*structpb.Value) (*pbs.AuthenticateResponse, error) {
+		return s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
+			AuthMethodId: amId,
+			TokenType:    "token",
+			Command:      "mfa",
+			Attributes:   &structpb.Struct{Fields: attrs},
+		})
+	}
+	createAccount := func(t *testing.T, am *password.AuthMethod) *password.Account {
+		acct, err := password.NewAccount(am.GetPublicId(), password.WithLoginName(testLoginName))
+		require.NoError(t, err)
+		acct, err = pwRepo.CreateAccount(ctx, o.GetPublicId(), acct, password.WithPassword(testPassword))
+		require.NoError(t, err)
+		return acct
+	}
+
+	t.Run("totp", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am := password.TestAuthMethod(t, conn, o.GetPublicId())
+		acct := createAccount(t, am)
+		secret, _, err := pwRepo.EnrollTotp(ctx, acct.GetPublicId())
+		require.NoError(err)
+		recoveryCodes, err := pwRepo.ConfirmTotp(ctx, acct.GetPublicId(), password.TestTotpCode(t, secret))
+		require.NoError(err)
+
+		resp, err := login(am.GetPublicId())
+		require.NoError(err)
+		assert.Equal("mfa", resp.GetCommand())
+		challenge := &pb.PasswordAuthMethodAuthenticateMfaChallengeResponse{}
+		require.NoError(handlers.StructToProto(resp.GetAttributes(), challenge))
+		assert.NotEmpty(challenge.GetChallengeId())
+		assert.Equal([]string{password.MfaTypeTotp, password.MfaTypeRecoveryCode}, challenge.GetFactors())
+
+		_, err = mfa(am.GetPublicId(), map[string]*structpb.Value{
+			"challenge_id": structpb.NewStringValue(challenge.GetChallengeId()),
+		})
+		assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "unexpected error %v", err)
+
+		// the code confirming the enrollment cannot be used again
+		_, err = mfa(am.GetPublicId(), map[string]*structpb.Value{
+			"challenge_id": structpb.NewStringValue(challenge.GetChallengeId()),
+			"totp_code":    structpb.NewStringValue(password.TestTotpCode(t, secret)),
+		})
+		assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "unexpected error %v", err)
+
+		// the challenge was consumed by the failed attempt
+		_, err = mfa(am.GetPublicId(), map[string]*structpb.Value{
+			"challenge_id":  structpb.NewStringValue(challenge.GetChallengeId()),
+			"recovery_code": structpb.NewStringValue(recoveryCodes[0]),
+		})
+		assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "unexpected error %v", err)
+
+		resp, err = login(am.GetPublicId())
+		require.NoError(err)
+		require.NoError(handlers.StructToProto(resp.GetAttributes(), challenge))
+		resp, err = mfa(am.GetPublicId(), map[string]*structpb.Value{
+			"challenge_id":  structpb.NewStringValue(challenge.GetChallengeId()),
+			"recovery_code": structpb.NewStringValue(recoveryCodes[0]),
+		})
+		require.NoError(err)
+		aToken := &authtokenpb.AuthToken{}
+		require.NoError(handlers.StructToProto(resp.GetAttributes(), aToken, handlers.WithDiscardUnknownFields(true)))
+		assert.NotEmpty(aToken.GetToken())
+		assert.Equal(acct.GetPublicId(), aToken.GetAccountId())
+		assert.Equal("token", resp.GetAttributes().GetFields()["token_type"].GetStringValue())
+	})
+
+	t.Run("required", func(t *testing.T) {
+		assert := assert.New(t)
+		am := password.TestAuthMethod(t, conn, o.GetPublicId(), password.WithMfaRequired(true))
+		createAccount(t, am)
+		_, err := login(am.GetPublicId())
+		assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.Unauthenticated)), "unexpected error %v", err)
+	})
+}
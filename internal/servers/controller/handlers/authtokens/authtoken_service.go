@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
@@ -66,6 +67,10 @@ func (s Service) ListAuthTokens(ctx context.Context, req *pbs.ListAuthTokensRequ
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -90,55 +95,62 @@ func (s Service) ListAuthTokens(ctx context.Context, req *pbs.ListAuthTokensRequ
 		return &pbs.ListAuthTokensResponse{}, nil
 	}
 
-	ul, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(ul) == 0 {
-		return &pbs.ListAuthTokensResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.AuthToken, 0, len(ul))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("scope_id", "user_id", "auth_method_id", "account_id")
+	var finalItems []*pb.AuthToken
 	res := perms.Resource{
 		Type: resource.AuthToken,
 	}
-	for _, at := range ul {
-		res.Id = at.GetPublicId()
-		res.ScopeId = at.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions, auth.WithResource(&res))
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		if authorizedActions.OnlySelf() && at.GetIamUserId() != authResults.UserId {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[at.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
-		}
-
-		item, err := toProto(ctx, at, outputOpts...)
+	for {
+		ul, err := s.listFromRepo(ctx, scopeIds, page, conds)
 		if err != nil {
 			return nil, err
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		for _, at := range ul {
+			if !page.Next(at.GetPublicId()) {
+				break
+			}
+			res.Id = at.GetPublicId()
+			res.ScopeId = at.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, at.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			if authorizedActions.OnlySelf() && at.GetIamUserId() != authResults.UserId {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[at.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
+
+			item, err := toProto(ctx, at, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(ul)) {
+			break
 		}
 	}
 
-	return &pbs.ListAuthTokensResponse{Items: finalItems}, nil
+	return &pbs.ListAuthTokensResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetAuthToken implements the interface pbs.AuthTokenServiceServer.
@@ -258,13 +270,13 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*authtoken.AuthToken, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*authtoken.AuthToken, error) {
 	repo, err := s.repoFn()
 	_ = repo
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListAuthTokens(ctx, scopeIds)
+	ul, err := repo.ListAuthTokens(ctx, scopeIds, authtoken.WithLimit(page.Limit()), authtoken.WithStartPageAfterId(page.StartAfterId()), authtoken.WithFilterConditions(conds))
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +31,25 @@ const (
 	httpMethodField            = "attributes.http_method"
 	httpRequestBodyField       = "attributes.http_request_body"
 	credentialMappingPathField = "credential_mapping_overrides"
+	sshUsernameField           = "attributes.ssh_username"
+	sshKeyTypeField            = "attributes.ssh_key_type"
+	sshKeyBitsField            = "attributes.ssh_key_bits"
+	sshTtlField                = "attributes.ssh_ttl"
+	sshValidPrincipalsField    = "attributes.ssh_valid_principals"
+	sshExtensionsField         = "attributes.ssh_extensions"
 )
 
+// sshCertificateFields are the attributes of a vault credential library
+// which are only valid for the ssh_certificate credential type.
+var sshCertificateFields = []string{
+	sshUsernameField,
+	sshKeyTypeField,
+	sshKeyBitsField,
+	sshTtlField,
+	sshValidPrincipalsField,
+	sshExtensionsField,
+}
+
 // Credential mapping override attributes
 const (
 	usernameAttribute string = "username_attribute"
@@ -94,56 +111,65 @@ func (s Service) ListCredentialLibraries(ctx context.Context, req *pbs.ListCrede
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
-	authResults := s.authResult(ctx, req.GetCredentialStoreId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
-	}
-
-	csl, err := s.listFromRepo(ctx, req.GetCredentialStoreId())
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(csl) == 0 {
-		return &pbs.ListCredentialLibrariesResponse{}, nil
+	authResults := s.authResult(ctx, req.GetCredentialStoreId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
 	}
 
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.CredentialLibrary, 0, len(csl))
+	var finalItems []*pb.CredentialLibrary
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.CredentialLibrary,
 		Pin:     req.GetCredentialStoreId(),
 	}
-	for _, item := range csl {
-		res.Id = item.GetPublicId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(item, outputOpts...)
+	for {
+		csl, err := s.listFromRepo(ctx, req.GetCredentialStoreId(), page)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range csl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			item, err := toProto(item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(csl)) {
+			break
 		}
 	}
-	return &pbs.ListCredentialLibrariesResponse{Items: finalItems}, nil
+	return &pbs.ListCredentialLibrariesResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetCredentialLibrary implements the interface pbs.CredentialLibraryServiceServer.
@@ -246,7 +272,7 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	cl, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem(), currentCredentialType, cur.MappingOverride)
+	cl, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem(), currentCredentialType, cur.MappingOverride, cur.SshCertificateSettings)
 	if err != nil {
 		return nil, err
 	}
@@ -289,13 +315,13 @@ func (s Service) DeleteCredentialLibrary(ctx context.Context, req *pbs.DeleteCre
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, storeId string) ([]*vault.CredentialLibrary, error) {
+func (s Service) listFromRepo(ctx context.Context, storeId string, page *handlers.ListPage) ([]*vault.CredentialLibrary, error) {
 	const op = "credentiallibraries.(Service).listFromRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	csl, err := repo.ListCredentialLibraries(ctx, storeId)
+	csl, err := repo.ListCredentialLibraries(ctx, storeId, vault.WithLimit(page.Limit()), vault.WithStartPageAfterId(page.StartAfterId()))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -344,7 +370,8 @@ func (s Service) updateInRepo(
 	masks []string,
 	in *pb.CredentialLibrary,
 	currentCredentialType credential.Type,
-	currentMapping vault.MappingOverride) (credential.Library, error) {
+	currentMapping vault.MappingOverride,
+	currentSshSettings *vault.SshCertificateSettings) (credential.Library, error) {
 
 	const op = "credentiallibraries.(Service).updateInRepo"
 
@@ -369,6 +396,16 @@ func (s Service) updateInRepo(
 	}
 	cl.PublicId = id
 
+	sshSettings, update, err := getSshCertificateSettingsUpdates(currentSshSettings, in.GetAttributes(), masks)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if update {
+		// got ssh certificate settings update, append settings db field mask
+		dbMasks = append(dbMasks, vault.SshCertificateSettingsField)
+		cl.SshCertificateSettings = sshSettings
+	}
+
 	dbMasks = append(dbMasks, maskManager.Translate(masks)...)
 	if len(dbMasks) == 0 {
 		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
@@ -544,6 +581,34 @@ func toProto(in credential.Library, opt ...handlers.Option) (*pb.CredentialLibra
 			if vaultIn.GetHttpRequestBody() != nil {
 				attrs.HttpRequestBody = wrapperspb.String(string(vaultIn.GetHttpRequestBody()))
 			}
+			if ssh := vaultIn.SshCertificateSettings; ssh != nil {
+				attrs.SshUsername = wrapperspb.String(ssh.GetUsername())
+				if ssh.GetKeyType() != "" {
+					attrs.SshKeyType = wrapperspb.String(ssh.GetKeyType())
+				}
+				if ssh.GetKeyBits() != 0 {
+					attrs.SshKeyBits = wrapperspb.UInt32(ssh.GetKeyBits())
+				}
+				if ssh.GetTtl() != "" {
+					attrs.SshTtl = wrapperspb.String(ssh.GetTtl())
+				}
+				if principals := ssh.Principals(); len(principals) > 1 {
+					attrs.SshValidPrincipals = principals[1:]
+				}
+				ext, err := ssh.ExtensionsMap()
+				if err != nil {
+					return nil, errors.WrapDeprecated(err, op, errors.WithMsg("failed to read ssh certificate extensions"))
+				}
+				if len(ext) > 0 {
+					m := make(map[string]interface{}, len(ext))
+					for k, v := range ext {
+						m[k] = v
+					}
+					if attrs.SshExtensions, err = structpb.NewStruct(m); err != nil {
+						return nil, errors.NewDeprecated(errors.Internal, op, "creating proto struct for ssh extensions")
+					}
+				}
+			}
 			var err error
 			out.Attributes, err = handlers.ProtoToStruct(attrs)
 			if err != nil {
@@ -591,6 +656,11 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 		if len(mapOpts) > 0 {
 			opts = append(opts, vault.WithMappingOverride(vault.NewUserPasswordOverride(mapOpts...)))
 		}
+	case credential.SshCertificateType:
+		opts = append(opts, vault.WithCredentialType(credentialType))
+		if attrs.GetSshUsername() != nil {
+			opts = append(opts, vault.WithSshCertificateSettings(toStorageSshCertificateSettings(attrs)))
+		}
 	}
 
 	cs, err := vault.NewCredentialLibrary(storeId, attrs.GetPath().GetValue(), opts...)
@@ -600,6 +670,35 @@ func toStorageVaultLibrary(storeId string, in *pb.CredentialLibrary) (out *vault
 	return cs, err
 }
 
+// toStorageSshCertificateSettings returns the ssh certificate settings set in
+// attrs. Extensions that are not strings are ignored, they are rejected by the
+// validateX methods.
+func toStorageSshCertificateSettings(attrs *pb.VaultCredentialLibraryAttributes) *vault.SshCertificateSettings {
+	var opts []vault.Option
+	if attrs.GetSshKeyType() != nil {
+		opts = append(opts, vault.WithSshKeyType(vault.KeyType(strings.ToLower(attrs.GetSshKeyType().GetValue()))))
+	}
+	if attrs.GetSshKeyBits() != nil {
+		opts = append(opts, vault.WithSshKeyBits(attrs.GetSshKeyBits().GetValue()))
+	}
+	if attrs.GetSshTtl() != nil {
+		opts = append(opts, vault.WithSshTtl(attrs.GetSshTtl().GetValue()))
+	}
+	if len(attrs.GetSshValidPrincipals()) > 0 {
+		opts = append(opts, vault.WithSshValidPrincipals(attrs.GetSshValidPrincipals()))
+	}
+	if ext := attrs.GetSshExtensions().AsMap(); len(ext) > 0 {
+		m := make(map[string]string, len(ext))
+		for k, v := range ext {
+			if v, ok := v.(string); ok {
+				m[k] = v
+			}
+		}
+		opts = append(opts, vault.WithSshExtensions(m))
+	}
+	return vault.NewSshCertificateSettings(attrs.GetSshUsername().GetValue(), opts...)
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //  * The path passed in is correctly formatted
@@ -631,7 +730,20 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 			if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "POST" {
 				badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 			}
-			validateMapping(badFields, credential.Type(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
+			credentialType := credential.Type(req.GetItem().GetCredentialType())
+			if credentialType == credential.SshCertificateType {
+				if attrs.GetSshUsername().GetValue() == "" {
+					badFields[sshUsernameField] = fmt.Sprintf("This is a required field when %q is %q.", globals.CredentialTypeField, credentialType)
+				}
+				if m := attrs.GetHttpMethod(); m != nil && strings.ToUpper(m.GetValue()) != "POST" {
+					badFields[httpMethodField] = fmt.Sprintf("If set, value must be 'POST' when %q is %q.", globals.CredentialTypeField, credentialType)
+				}
+				if attrs.GetHttpRequestBody() != nil {
+					badFields[httpRequestBodyField] = fmt.Sprintf("Field cannot be set when %q is %q.", globals.CredentialTypeField, credentialType)
+				}
+			}
+			validateSshCertificateAttributes(badFields, credentialType, attrs, nil)
+			validateMapping(badFields, credentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -664,6 +776,18 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 			if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "GET" {
 				badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 			}
+			if currentCredentialType == credential.SshCertificateType {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), sshUsernameField) && attrs.GetSshUsername().GetValue() == "" {
+					badFields[sshUsernameField] = "This is a required field and cannot be set to empty."
+				}
+				if m := attrs.GetHttpMethod(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), httpMethodField) && strings.ToUpper(m.GetValue()) != "POST" {
+					badFields[httpMethodField] = fmt.Sprintf("Value must be 'POST' when %q is %q.", globals.CredentialTypeField, currentCredentialType)
+				}
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), httpRequestBodyField) && attrs.GetHttpRequestBody() != nil {
+					badFields[httpRequestBodyField] = fmt.Sprintf("Field cannot be set when %q is %q.", globals.CredentialTypeField, currentCredentialType)
+				}
+			}
+			validateSshCertificateAttributes(badFields, currentCredentialType, attrs, req.GetUpdateMask().GetPaths())
 			validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 		}
 		return badFields
@@ -699,6 +823,9 @@ func validateMapping(badFields map[string]string, credentialType credential.Type
 	case credential.UserPasswordType:
 		validFields[usernameAttribute] = true
 		validFields[passwordAttribute] = true
+	case credential.SshCertificateType:
+		// The attributes of an ssh certificate are set by the library, they
+		// cannot be mapped.
 	default:
 		badFields[globals.CredentialTypeField] = fmt.Sprintf("Unknown credential type %q", credentialType)
 		return
@@ -715,6 +842,150 @@ func validateMapping(badFields map[string]string, credentialType credential.Type
 	}
 }
 
+// validateSshCertificateAttributes validates the ssh certificate attributes
+// in attrs. If masks is not nil, only the attributes included in masks are
+// validated. Attributes which depend on the current values of an updated
+// library are validated by the repository.
+func validateSshCertificateAttributes(badFields map[string]string, credentialType credential.Type, attrs *pb.VaultCredentialLibraryAttributes, masks []string) {
+	set := map[string]bool{
+		sshUsernameField:        attrs.GetSshUsername() != nil,
+		sshKeyTypeField:         attrs.GetSshKeyType() != nil,
+		sshKeyBitsField:         attrs.GetSshKeyBits() != nil,
+		sshTtlField:             attrs.GetSshTtl() != nil,
+		sshValidPrincipalsField: len(attrs.GetSshValidPrincipals()) > 0,
+		sshExtensionsField:      attrs.GetSshExtensions() != nil,
+	}
+	if masks != nil {
+		for f := range set {
+			set[f] = handlers.MaskContains(masks, f)
+		}
+	}
+
+	if credentialType != credential.SshCertificateType {
+		for f, ok := range set {
+			if ok {
+				badFields[f] = fmt.Sprintf("This field can only be set if %q is %q.", globals.CredentialTypeField, credential.SshCertificateType)
+			}
+		}
+		return
+	}
+
+	keyType := vault.KeyType(strings.ToLower(attrs.GetSshKeyType().GetValue()))
+	if set[sshKeyTypeField] && attrs.GetSshKeyType() != nil {
+		switch keyType {
+		case vault.KeyTypeEd25519, vault.KeyTypeEcdsa, vault.KeyTypeRsa:
+		default:
+			badFields[sshKeyTypeField] = "If set, value must be 'ed25519', 'ecdsa' or 'rsa'."
+		}
+	}
+	if bits := attrs.GetSshKeyBits().GetValue(); set[sshKeyBitsField] && set[sshKeyTypeField] && bits != 0 {
+		var valid []uint32
+		switch keyType {
+		case vault.KeyTypeEcdsa:
+			valid = []uint32{256, 384, 521}
+		case vault.KeyTypeRsa:
+			valid = []uint32{2048, 3072, 4096}
+		}
+		ok := false
+		for _, v := range valid {
+			ok = ok || bits == v
+		}
+		if !ok {
+			badFields[sshKeyBitsField] = fmt.Sprintf("Value is not a valid size for %q keys.", attrs.GetSshKeyType().GetValue())
+		}
+	}
+	if ttl := attrs.GetSshTtl(); set[sshTtlField] && ttl != nil && strings.TrimSpace(ttl.GetValue()) == "" {
+		badFields[sshTtlField] = "If set, value cannot be empty."
+	}
+	for _, p := range attrs.GetSshValidPrincipals() {
+		if strings.TrimSpace(p) == "" || strings.Contains(p, ",") {
+			badFields[sshValidPrincipalsField] = "Principals cannot be empty or contain a comma."
+			break
+		}
+	}
+	for k, v := range attrs.GetSshExtensions().AsMap() {
+		if _, ok := v.(string); !ok {
+			badFields[sshExtensionsField+"."+k] = fmt.Sprintf("Extension value must be a string, got %T", v)
+		}
+	}
+}
+
+// getSshCertificateSettingsUpdates returns the ssh certificate settings
+// resulting from applying the ssh certificate attributes in attrs included
+// in apiMasks to current. It returns false if apiMasks does not include any
+// ssh certificate attributes.
+//
+// The key size is reset when the key type is updated without it.
+func getSshCertificateSettingsUpdates(current *vault.SshCertificateSettings, attributes *structpb.Struct, apiMasks []string) (*vault.SshCertificateSettings, bool, error) {
+	masks := make(map[string]bool)
+	for _, m := range apiMasks {
+		if strutil.StrListContains(sshCertificateFields, m) {
+			masks[m] = true
+		}
+	}
+	if len(masks) == 0 {
+		// no ssh certificate settings updates
+		return nil, false, nil
+	}
+
+	attrs := &pb.VaultCredentialLibraryAttributes{}
+	if err := handlers.StructToProto(attributes, attrs); err != nil {
+		return nil, false, err
+	}
+	merged := &pb.VaultCredentialLibraryAttributes{}
+	if current != nil {
+		merged.SshUsername = wrapperspb.String(current.GetUsername())
+		if current.GetKeyType() != "" {
+			merged.SshKeyType = wrapperspb.String(current.GetKeyType())
+		}
+		if current.GetKeyBits() != 0 {
+			merged.SshKeyBits = wrapperspb.UInt32(current.GetKeyBits())
+		}
+		if current.GetTtl() != "" {
+			merged.SshTtl = wrapperspb.String(current.GetTtl())
+		}
+		if principals := current.Principals(); len(principals) > 1 {
+			merged.SshValidPrincipals = principals[1:]
+		}
+		ext, err := current.ExtensionsMap()
+		if err != nil {
+			return nil, false, err
+		}
+		if len(ext) > 0 {
+			m := make(map[string]interface{}, len(ext))
+			for k, v := range ext {
+				m[k] = v
+			}
+			if merged.SshExtensions, err = structpb.NewStruct(m); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	if masks[sshUsernameField] {
+		merged.SshUsername = attrs.GetSshUsername()
+	}
+	if masks[sshKeyTypeField] {
+		merged.SshKeyType = attrs.GetSshKeyType()
+		if !masks[sshKeyBitsField] {
+			merged.SshKeyBits = nil
+		}
+	}
+	if masks[sshKeyBitsField] {
+		merged.SshKeyBits = attrs.GetSshKeyBits()
+	}
+	if masks[sshTtlField] {
+		merged.SshTtl = attrs.GetSshTtl()
+	}
+	if masks[sshValidPrincipalsField] {
+		merged.SshValidPrincipals = attrs.GetSshValidPrincipals()
+	}
+	if masks[sshExtensionsField] {
+		merged.SshExtensions = attrs.GetSshExtensions()
+	}
+	return toStorageSshCertificateSettings(merged), true, nil
+}
+
 func getMappingUpdates(credentialType credential.Type, current vault.MappingOverride, new map[string]interface{}, apiMasks []string) (map[string]interface{}, bool) {
 	ret := make(map[string]interface{})
 	masks := make(map[string]bool)
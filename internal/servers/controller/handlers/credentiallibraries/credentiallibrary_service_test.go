@@ -369,6 +369,114 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "ssh_certificate type requires ssh username",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: func() *structpb.Struct {
+					attrs, err := handlers.ProtoToStruct(&pb.VaultCredentialLibraryAttributes{
+						Path: wrapperspb.String("ssh/sign/dev"),
+					})
+					require.NoError(t, err)
+					return attrs
+				}(),
+				CredentialType: string(credential.SshCertificateType),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "ssh attributes require ssh_certificate type",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: func() *structpb.Struct {
+					attrs, err := handlers.ProtoToStruct(&pb.VaultCredentialLibraryAttributes{
+						Path:        wrapperspb.String("something"),
+						SshUsername: wrapperspb.String("alice"),
+					})
+					require.NoError(t, err)
+					return attrs
+				}(),
+				CredentialType: string(credential.UserPasswordType),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Invalid ssh key type",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: func() *structpb.Struct {
+					attrs, err := handlers.ProtoToStruct(&pb.VaultCredentialLibraryAttributes{
+						Path:        wrapperspb.String("ssh/sign/dev"),
+						SshUsername: wrapperspb.String("alice"),
+						SshKeyType:  wrapperspb.String("dsa"),
+					})
+					require.NoError(t, err)
+					return attrs
+				}(),
+				CredentialType: string(credential.SshCertificateType),
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create a valid vault CredentialLibrary ssh_certificate type",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: func() *structpb.Struct {
+					attrs, err := handlers.ProtoToStruct(&pb.VaultCredentialLibraryAttributes{
+						Path:               wrapperspb.String("ssh/sign/dev"),
+						SshUsername:        wrapperspb.String("alice"),
+						SshKeyType:         wrapperspb.String("rsa"),
+						SshKeyBits:         wrapperspb.UInt32(4096),
+						SshTtl:             wrapperspb.String("5m"),
+						SshValidPrincipals: []string{"admin"},
+						SshExtensions: func() *structpb.Struct {
+							ret, err := structpb.NewStruct(map[string]interface{}{"permit-pty": ""})
+							require.NoError(t, err)
+							return ret
+						}(),
+					})
+					require.NoError(t, err)
+					return attrs
+				}(),
+				CredentialType: string(credential.SshCertificateType),
+			}},
+			idPrefix: vault.CredentialLibraryPrefix + "_",
+			res: &pbs.CreateCredentialLibraryResponse{
+				Uri: fmt.Sprintf("credential-libraries/%s_", vault.CredentialLibraryPrefix),
+				Item: &pb.CredentialLibrary{
+					Id:                store.GetPublicId(),
+					CredentialStoreId: store.GetPublicId(),
+					CreatedTime:       store.GetCreateTime().GetTimestamp(),
+					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:           1,
+					Type:              vault.Subtype.String(),
+					Attributes: func() *structpb.Struct {
+						attrs, err := handlers.ProtoToStruct(&pb.VaultCredentialLibraryAttributes{
+							Path:               wrapperspb.String("ssh/sign/dev"),
+							HttpMethod:         wrapperspb.String("POST"),
+							SshUsername:        wrapperspb.String("alice"),
+							SshKeyType:         wrapperspb.String("rsa"),
+							SshKeyBits:         wrapperspb.UInt32(4096),
+							SshTtl:             wrapperspb.String("5m"),
+							SshValidPrincipals: []string{"admin"},
+							SshExtensions: func() *structpb.Struct {
+								ret, err := structpb.NewStruct(map[string]interface{}{"permit-pty": ""})
+								require.NoError(t, err)
+								return ret
+							}(),
+						})
+						require.NoError(t, err)
+						return attrs
+					}(),
+					AuthorizedActions: testAuthorizedActions,
+					CredentialType:    string(credential.SshCertificateType),
+				},
+			},
+		},
 		{
 			name: "Using POST method",
 			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
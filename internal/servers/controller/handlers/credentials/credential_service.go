@@ -0,0 +1,751 @@
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	usernameField       = "attributes.username"
+	passwordField       = "attributes.password"
+	passwordHmacField   = "attributes.password_hmac"
+	privateKeyField     = "attributes.private_key"
+	privateKeyHmacField = "attributes.private_key_hmac"
+	objectField         = "attributes.object"
+	objectHmacField     = "attributes.object_hmac"
+)
+
+var (
+	upMaskManager   handlers.MaskManager
+	spkMaskManager  handlers.MaskManager
+	jsonMaskManager handlers.MaskManager
+
+	// IdActions contains the set of actions that can be performed on
+	// individual resources
+	IdActions = action.ActionSet{
+		action.NoOp,
+		action.Read,
+		action.Update,
+		action.Delete,
+	}
+
+	// CollectionActions contains the set of actions that can be performed on
+	// this collection
+	CollectionActions = action.ActionSet{
+		action.Create,
+		action.List,
+	}
+)
+
+func init() {
+	var err error
+	if upMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.UsernamePasswordCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.UsernamePasswordAttributes{}}); err != nil {
+		panic(err)
+	}
+	if spkMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.SshPrivateKeyCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.SshPrivateKeyAttributes{}}); err != nil {
+		panic(err)
+	}
+	if jsonMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&store.JsonCredential{}},
+		handlers.MaskSource{&pb.Credential{}, &pb.JsonAttributes{}}); err != nil {
+		panic(err)
+	}
+}
+
+// Service handles request as described by the pbs.CredentialServiceServer interface.
+type Service struct {
+	pbs.UnimplementedCredentialServiceServer
+
+	iamRepoFn common.IamRepoFactory
+	repoFn    common.StaticCredentialRepoFactory
+}
+
+// NewService returns a credential service which handles credential related requests to boundary.
+func NewService(repo common.StaticCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+	const op = "credentials.NewService"
+	if iamRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
+	}
+	if repo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+}
+
+var _ pbs.CredentialServiceServer = Service{}
+
+// ListCredentials implements the interface pbs.CredentialServiceServer
+func (s Service) ListCredentials(ctx context.Context, req *pbs.ListCredentialsRequest) (*pbs.ListCredentialsResponse, error) {
+	if err := validateListRequest(req); err != nil {
+		return nil, err
+	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetCredentialStoreId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	filter, err := handlers.NewFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	var finalItems []*pb.Credential
+	res := perms.Resource{
+		ScopeId: authResults.Scope.Id,
+		Type:    resource.Credential,
+		Pin:     req.GetCredentialStoreId(),
+	}
+	for {
+		cl, err := s.listFromRepo(ctx, req.GetCredentialStoreId(), page)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range cl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(cl)) {
+			break
+		}
+	}
+	return &pbs.ListCredentialsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
+}
+
+// GetCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) GetCredential(ctx context.Context, req *pbs.GetCredentialRequest) (*pbs.GetCredentialResponse, error) {
+	const op = "credentials.(Service).GetCredential"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetCredentialResponse{Item: item}, nil
+}
+
+// CreateCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) CreateCredential(ctx context.Context, req *pbs.CreateCredentialRequest) (*pbs.CreateCredentialResponse, error) {
+	const op = "credentials.(Service).CreateCredential"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetCredentialStoreId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateCredentialResponse{
+		Item: item,
+		Uri:  fmt.Sprintf("credentials/%s", item.GetId()),
+	}, nil
+}
+
+// UpdateCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) UpdateCredential(ctx context.Context, req *pbs.UpdateCredentialRequest) (*pbs.UpdateCredentialResponse, error) {
+	const op = "credentials.(Service).UpdateCredential"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.updateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpdateCredentialResponse{Item: item}, nil
+}
+
+// DeleteCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) DeleteCredential(ctx context.Context, req *pbs.DeleteCredentialRequest) (*pbs.DeleteCredentialResponse, error) {
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	_, err := s.deleteFromRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s Service) listFromRepo(ctx context.Context, storeId string, page *handlers.ListPage) ([]credential.Static, error) {
+	const op = "credentials.(Service).listFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	cl, err := repo.ListCredentials(ctx, storeId, static.WithLimit(page.Limit()), static.WithStartPageAfterId(page.StartAfterId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return cl, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static, error) {
+	const op = "credentials.(Service).getFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c, err := repo.LookupCredential(ctx, id)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if c == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential %q not found", id))
+	}
+	return c, err
+}
+
+func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Credential) (credential.Static, error) {
+	const op = "credentials.(Service).createInRepo"
+	c, err := toStorageCredential(item.GetCredentialStoreId(), credential.Type(item.GetType()), item)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreateCredential(ctx, scopeId, c)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []string, item *pb.Credential) (credential.Static, error) {
+	const op = "credentials.(Service).updateInRepo"
+	credType := credentialTypeFromId(id)
+	c, err := toStorageCredential(item.GetCredentialStoreId(), credType, item)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := setPublicId(c, id); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var dbMask []string
+	switch credType {
+	case credential.UserPasswordType:
+		dbMask = upMaskManager.Translate(mask)
+	case credential.SshPrivateKeyType:
+		dbMask = spkMaskManager.Translate(mask)
+	case credential.JsonType:
+		dbMask = jsonMaskManager.Translate(mask)
+	}
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateCredential(ctx, scopeId, c, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
+	const op = "credentials.(Service).deleteFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return false, err
+	}
+	rows, err := repo.DeleteCredential(ctx, scopeId, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	const op = "credentials.(Service).authResult"
+	res := auth.VerifyResults{}
+	repo, err := s.repoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.Credential), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+	default:
+		opts = append(opts, auth.WithId(id))
+
+		switch credential.SubtypeFromId(id) {
+		case static.Subtype:
+			c, err := repo.LookupCredential(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if c == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = c.GetStoreId()
+		default:
+			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential subtype from id")
+			return res
+		}
+	}
+
+	if parentId == "" {
+		res.Error = errors.New(ctx, errors.RecordNotFound, op, "unable to find credential store for provided credential")
+		return res
+	}
+
+	opts = append(opts, auth.WithPin(parentId))
+
+	switch credential.SubtypeFromId(parentId) {
+	case static.Subtype:
+		cs, err := repo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetScopeId()))
+	default:
+		res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential store subtype from id")
+		return res
+	}
+
+	return auth.Verify(ctx, opts...)
+}
+
+func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, error) {
+	const op = "credentials.toProto"
+
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building credential proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.Credential{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.CredentialStoreIdField) {
+		out.CredentialStoreId = in.GetStoreId()
+	}
+	if outputFields.Has(globals.TypeField) {
+		out.Type = string(in.CredentialType())
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	if outputFields.Has(globals.AttributesField) {
+		var attrs proto.Message
+		switch c := in.(type) {
+		case *static.UsernamePasswordCredential:
+			attrs = &pb.UsernamePasswordAttributes{
+				Username:     wrapperspb.String(c.GetUsername()),
+				PasswordHmac: base64.RawURLEncoding.EncodeToString(c.GetPasswordHmac()),
+			}
+		case *static.SshPrivateKeyCredential:
+			attrs = &pb.SshPrivateKeyAttributes{
+				Username:       wrapperspb.String(c.GetUsername()),
+				PrivateKeyHmac: base64.RawURLEncoding.EncodeToString(c.GetPrivateKeyHmac()),
+			}
+		case *static.JsonCredential:
+			attrs = &pb.JsonAttributes{
+				ObjectHmac: base64.RawURLEncoding.EncodeToString(c.GetObjectHmac()),
+			}
+		default:
+			return nil, errors.NewDeprecated(errors.Internal, op, fmt.Sprintf("unknown static credential type %T", in))
+		}
+		var err error
+		if out.Attributes, err = handlers.ProtoToStruct(attrs); err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("failed to convert resource from storage to api"))
+		}
+	}
+	return &out, nil
+}
+
+// toStorageCredential builds a static credential of type credType from in.
+// The secret of the credential is only validated by the repository so in
+// may omit it when building a credential for an update.
+func toStorageCredential(storeId string, credType credential.Type, in *pb.Credential) (credential.Static, error) {
+	const op = "credentials.toStorageCredential"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	switch credType {
+	case credential.UserPasswordType:
+		attrs := &pb.UsernamePasswordAttributes{}
+		if err := handlers.StructToProto(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to parse the attributes"))
+		}
+		c, err := static.NewUsernamePasswordCredential(storeId, attrs.GetUsername().GetValue(), credential.Password(attrs.GetPassword().GetValue()), opts...)
+		if err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential"))
+		}
+		return c, nil
+	case credential.SshPrivateKeyType:
+		attrs := &pb.SshPrivateKeyAttributes{}
+		if err := handlers.StructToProto(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to parse the attributes"))
+		}
+		c, err := static.NewSshPrivateKeyCredential(storeId, attrs.GetUsername().GetValue(), credential.PrivateKey(attrs.GetPrivateKey().GetValue()), opts...)
+		if err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential"))
+		}
+		return c, nil
+	case credential.JsonType:
+		attrs := &pb.JsonAttributes{}
+		if err := handlers.StructToProto(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to parse the attributes"))
+		}
+		var object []byte
+		if attrs.GetObject() != nil {
+			var err error
+			if object, err = json.Marshal(attrs.GetObject().AsMap()); err != nil {
+				return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to encode the object"))
+			}
+		}
+		c, err := static.NewJsonCredential(storeId, object, opts...)
+		if err != nil {
+			return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential"))
+		}
+		return c, nil
+	}
+	return nil, errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("unknown credential type %q", credType))
+}
+
+func setPublicId(c credential.Static, id string) error {
+	const op = "credentials.setPublicId"
+	switch c := c.(type) {
+	case *static.UsernamePasswordCredential:
+		c.PublicId = id
+	case *static.SshPrivateKeyCredential:
+		c.PublicId = id
+	case *static.JsonCredential:
+		c.PublicId = id
+	default:
+		return errors.NewDeprecated(errors.Internal, op, fmt.Sprintf("unknown static credential type %T", c))
+	}
+	return nil
+}
+
+// credentialTypeFromId returns the type of the static credential identified
+// by id. It returns credential.UnspecifiedType if id is not the id of a
+// static credential.
+func credentialTypeFromId(id string) credential.Type {
+	switch {
+	case handlers.ValidId(handlers.Id(id), static.UsernamePasswordCredentialPrefix):
+		return credential.UserPasswordType
+	case handlers.ValidId(handlers.Id(id), static.SshPrivateKeyCredentialPrefix):
+		return credential.SshPrivateKeyType
+	case handlers.ValidId(handlers.Id(id), static.JsonCredentialPrefix):
+		return credential.JsonType
+	}
+	return credential.UnspecifiedType
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//  * The path passed in is correctly formatted
+//  * All required parameters are set
+//  * There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetCredentialRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, static.CredentialPrefixes()...)
+}
+
+func validateCreateRequest(req *pbs.CreateCredentialRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if !handlers.ValidId(handlers.Id(req.GetItem().GetCredentialStoreId()), static.CredentialStorePrefix) {
+			badFields[globals.CredentialStoreIdField] = "This field must be a valid static credential store id."
+		}
+		switch credential.Type(req.GetItem().GetType()) {
+		case credential.UserPasswordType:
+			attrs := &pb.UsernamePasswordAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if attrs.GetUsername().GetValue() == "" {
+				badFields[usernameField] = "This is a required field."
+			}
+			if attrs.GetPassword().GetValue() == "" {
+				badFields[passwordField] = "This is a required field."
+			}
+			if attrs.GetPasswordHmac() != "" {
+				badFields[passwordHmacField] = "This is a read only field."
+			}
+		case credential.SshPrivateKeyType:
+			attrs := &pb.SshPrivateKeyAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if attrs.GetUsername().GetValue() == "" {
+				badFields[usernameField] = "This is a required field."
+			}
+			if attrs.GetPrivateKey().GetValue() == "" {
+				badFields[privateKeyField] = "This is a required field."
+			}
+			if attrs.GetPrivateKeyHmac() != "" {
+				badFields[privateKeyHmacField] = "This is a read only field."
+			}
+		case credential.JsonType:
+			attrs := &pb.JsonAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if len(attrs.GetObject().GetFields()) == 0 {
+				badFields[objectField] = "This is a required field and must be a non-empty object."
+			}
+			if attrs.GetObjectHmac() != "" {
+				badFields[objectHmacField] = "This is a read only field."
+			}
+		default:
+			badFields[globals.TypeField] = "This is a required field and must be a known credential type."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateCredentialRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		credType := credentialTypeFromId(req.GetId())
+		if req.GetItem().GetType() != "" && credential.Type(req.GetItem().GetType()) != credType {
+			badFields[globals.TypeField] = "Cannot modify resource type."
+		}
+		paths := req.GetUpdateMask().GetPaths()
+		switch credType {
+		case credential.UserPasswordType:
+			attrs := &pb.UsernamePasswordAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(paths, usernameField) && attrs.GetUsername().GetValue() == "" {
+				badFields[usernameField] = "This is a required field and cannot be unset."
+			}
+			if handlers.MaskContains(paths, passwordField) && attrs.GetPassword().GetValue() == "" {
+				badFields[passwordField] = "This is a required field and cannot be unset."
+			}
+			if attrs.GetPasswordHmac() != "" {
+				badFields[passwordHmacField] = "This is a read only field."
+			}
+		case credential.SshPrivateKeyType:
+			attrs := &pb.SshPrivateKeyAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(paths, usernameField) && attrs.GetUsername().GetValue() == "" {
+				badFields[usernameField] = "This is a required field and cannot be unset."
+			}
+			if handlers.MaskContains(paths, privateKeyField) && attrs.GetPrivateKey().GetValue() == "" {
+				badFields[privateKeyField] = "This is a required field and cannot be unset."
+			}
+			if attrs.GetPrivateKeyHmac() != "" {
+				badFields[privateKeyHmacField] = "This is a read only field."
+			}
+		case credential.JsonType:
+			attrs := &pb.JsonAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+				break
+			}
+			if handlers.MaskContains(paths, objectField) && len(attrs.GetObject().GetFields()) == 0 {
+				badFields[objectField] = "This is a required field and cannot be unset."
+			}
+			if attrs.GetObjectHmac() != "" {
+				badFields[objectHmacField] = "This is a read only field."
+			}
+		}
+		return badFields
+	}, static.CredentialPrefixes()...)
+}
+
+func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, static.CredentialPrefixes()...)
+}
+
+func validateListRequest(req *pbs.ListCredentialsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), static.CredentialStorePrefix) {
+		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
+	}
+	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
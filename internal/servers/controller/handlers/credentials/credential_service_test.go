@@ -0,0 +1,556 @@
+package credentials
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentials"
+	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+
+func upAttributes(t *testing.T, c *static.UsernamePasswordCredential) *structpb.Struct {
+	t.Helper()
+	attrs, err := handlers.ProtoToStruct(&pb.UsernamePasswordAttributes{
+		Username:     wrapperspb.String(c.GetUsername()),
+		PasswordHmac: base64.RawURLEncoding.EncodeToString(c.GetPasswordHmac()),
+	})
+	require.NoError(t, err)
+	return attrs
+}
+
+func TestList(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prjNoCreds := iam.TestScopes(t, iamRepo)
+	storeNoCreds := static.TestCredentialStores(t, conn, wrapper, prjNoCreds.GetPublicId(), 1)[0]
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	store := static.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	var wantCredentials []*pb.Credential
+	for _, c := range static.TestUsernamePasswordCredentials(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), 10) {
+		wantCredentials = append(wantCredentials, &pb.Credential{
+			Id:                c.GetPublicId(),
+			CredentialStoreId: c.GetStoreId(),
+			Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: prj.GetParentId()},
+			CreatedTime:       c.GetCreateTime().GetTimestamp(),
+			UpdatedTime:       c.GetUpdateTime().GetTimestamp(),
+			Version:           c.GetVersion(),
+			Type:              string(credential.UserPasswordType),
+			AuthorizedActions: testAuthorizedActions,
+			Attributes:        upAttributes(t, c),
+		})
+	}
+
+	cases := []struct {
+		name string
+		req  *pbs.ListCredentialsRequest
+		res  *pbs.ListCredentialsResponse
+		err  error
+	}{
+		{
+			name: "List Many Credentials",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId()},
+			res:  &pbs.ListCredentialsResponse{Items: wantCredentials},
+		},
+		{
+			name: "List No Credentials",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: storeNoCreds.GetPublicId()},
+			res:  &pbs.ListCredentialsResponse{},
+		},
+		{
+			name: "Filter to One Credential",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId(), Filter: fmt.Sprintf(`"/item/id"==%q`, wantCredentials[1].GetId())},
+			res:  &pbs.ListCredentialsResponse{Items: wantCredentials[1:2]},
+		},
+		{
+			name: "Filter to No Credentials",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId(), Filter: `"/item/id"=="doesnt match"`},
+			res:  &pbs.ListCredentialsResponse{},
+		},
+		{
+			name: "Filter Bad Format",
+			req:  &pbs.ListCredentialsRequest{CredentialStoreId: store.GetPublicId(), Filter: `"//id/"=="bad"`},
+			err:  handlers.InvalidArgumentErrorf("bad format", nil),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := NewService(repoFn, iamRepoFn)
+			require.NoError(t, err, "Couldn't create new credential service.")
+
+			// Test non-anonymous listing
+			got, gErr := s.ListCredentials(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err), "ListCredentials(%q) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(t, gErr)
+			sort.Slice(got.Items, func(i, j int) bool {
+				return got.Items[i].GetId() < got.Items[j].GetId()
+			})
+			want := proto.Clone(tc.res).(*pbs.ListCredentialsResponse)
+			sort.Slice(want.Items, func(i, j int) bool {
+				return want.Items[i].GetId() < want.Items[j].GetId()
+			})
+			assert.Empty(t, cmp.Diff(got, want, protocmp.Transform()))
+
+			// Test anonymous listing
+			got, gErr = s.ListCredentials(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId(), auth.WithUserId(auth.AnonymousUserId)), tc.req)
+			require.NoError(t, gErr)
+			assert.Len(t, got.Items, len(tc.res.Items))
+			for _, item := range got.GetItems() {
+				require.Nil(t, item.CreatedTime)
+				require.Nil(t, item.UpdatedTime)
+				require.Zero(t, item.Version)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	toStruct := func(t *testing.T, m proto.Message) *structpb.Struct {
+		t.Helper()
+		attrs, err := handlers.ProtoToStruct(m)
+		require.NoError(t, err)
+		return attrs
+	}
+	object, err := structpb.NewStruct(map[string]interface{}{"api_key": "s3cr3t"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		req      *pbs.CreateCredentialRequest
+		idPrefix string
+		wantType credential.Type
+		err      error
+	}{
+		{
+			name: "missing store id",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				Type: string(credential.UserPasswordType),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{
+					Username: wrapperspb.String("user"),
+					Password: wrapperspb.String("pass"),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing type",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{
+					Username: wrapperspb.String("user"),
+					Password: wrapperspb.String("pass"),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing password",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.UserPasswordType),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{
+					Username: wrapperspb.String("user"),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "password hmac set",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.UserPasswordType),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{
+					Username:     wrapperspb.String("user"),
+					Password:     wrapperspb.String("pass"),
+					PasswordHmac: "hmac",
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "invalid private key",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.SshPrivateKeyType),
+				Attributes: toStruct(t, &pb.SshPrivateKeyAttributes{
+					Username:   wrapperspb.String("user"),
+					PrivateKey: wrapperspb.String("not a key"),
+				}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "empty object",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.JsonType),
+				Attributes:        toStruct(t, &pb.JsonAttributes{}),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "valid user password",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.UserPasswordType),
+				Name:              wrapperspb.String("name"),
+				Description:       wrapperspb.String("desc"),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{
+					Username: wrapperspb.String("user"),
+					Password: wrapperspb.String("pass"),
+				}),
+			}},
+			idPrefix: static.UsernamePasswordCredentialPrefix + "_",
+			wantType: credential.UserPasswordType,
+		},
+		{
+			name: "valid ssh private key",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.SshPrivateKeyType),
+				Attributes: toStruct(t, &pb.SshPrivateKeyAttributes{
+					Username:   wrapperspb.String("user"),
+					PrivateKey: wrapperspb.String(string(static.TestSshPrivateKey(t))),
+				}),
+			}},
+			idPrefix: static.SshPrivateKeyCredentialPrefix + "_",
+			wantType: credential.SshPrivateKeyType,
+		},
+		{
+			name: "valid json",
+			req: &pbs.CreateCredentialRequest{Item: &pb.Credential{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              string(credential.JsonType),
+				Attributes:        toStruct(t, &pb.JsonAttributes{Object: object}),
+			}},
+			idPrefix: static.JsonCredentialPrefix + "_",
+			wantType: credential.JsonType,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := NewService(repoFn, iamRepoFn)
+			require.NoError(err, "Error when getting new credential service.")
+
+			got, gErr := s.CreateCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.Truef(errors.Is(gErr, tc.err), "CreateCredential(...) got error %v, wanted %v", gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			require.NotNil(got)
+
+			assert.True(strings.HasPrefix(got.GetUri(), "credentials/"+tc.idPrefix))
+			item := got.GetItem()
+			assert.True(strings.HasPrefix(item.GetId(), tc.idPrefix))
+			assert.Equal(store.GetPublicId(), item.GetCredentialStoreId())
+			assert.Equal(string(tc.wantType), item.GetType())
+			assert.Equal(uint32(1), item.GetVersion())
+			assert.Equal(testAuthorizedActions, item.GetAuthorizedActions())
+			assert.Equal(tc.req.GetItem().GetName(), item.GetName())
+			assert.Equal(tc.req.GetItem().GetDescription(), item.GetDescription())
+
+			// The secret must never be returned, only its hmac.
+			attrs := item.GetAttributes().AsMap()
+			assert.NotContains(attrs, "password")
+			assert.NotContains(attrs, "private_key")
+			assert.NotContains(attrs, "object")
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user", "pass")
+
+	s, err := NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		id   string
+		res  *pbs.GetCredentialResponse
+		err  error
+	}{
+		{
+			name: "success",
+			id:   c.GetPublicId(),
+			res: &pbs.GetCredentialResponse{
+				Item: &pb.Credential{
+					Id:                c.GetPublicId(),
+					CredentialStoreId: c.GetStoreId(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Type:              string(credential.UserPasswordType),
+					AuthorizedActions: testAuthorizedActions,
+					CreatedTime:       c.GetCreateTime().GetTimestamp(),
+					UpdatedTime:       c.GetUpdateTime().GetTimestamp(),
+					Version:           1,
+					Attributes:        upAttributes(t, c),
+				},
+			},
+		},
+		{
+			name: "not found error",
+			id:   fmt.Sprintf("%s_1234567890", static.UsernamePasswordCredentialPrefix),
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "bad prefix",
+			id:   fmt.Sprintf("%s_1234567890", static.CredentialStorePrefix),
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &pbs.GetCredentialRequest{Id: tc.id}
+			// Test non-anonymous get
+			got, gErr := s.GetCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), req)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err))
+				return
+			}
+			require.NoError(t, gErr)
+			assert.Empty(t, cmp.Diff(got, tc.res, protocmp.Transform()))
+
+			// Test anonymous get
+			got, gErr = s.GetCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId(), auth.WithUserId(auth.AnonymousUserId)), req)
+			require.NoError(t, gErr)
+			require.Nil(t, got.Item.CreatedTime)
+			require.Nil(t, got.Item.UpdatedTime)
+			require.Zero(t, got.Item.Version)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	store := static.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+	c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user", "pass")
+
+	s, err := NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		id   string
+		err  error
+		res  *pbs.DeleteCredentialResponse
+	}{
+		{
+			name: "success",
+			id:   c.GetPublicId(),
+		},
+		{
+			name: "not found error",
+			id:   fmt.Sprintf("%s_1234567890", static.UsernamePasswordCredentialPrefix),
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "bad prefix",
+			id:   fmt.Sprintf("%s_1234567890", static.CredentialStorePrefix),
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, gErr := s.DeleteCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), &pbs.DeleteCredentialRequest{Id: tc.id})
+			assert.EqualValuesf(t, tc.res, got, "DeleteCredential got response %q, wanted %q", got, tc.res)
+			if tc.err != nil {
+				require.Error(t, gErr)
+				assert.True(t, errors.Is(gErr, tc.err))
+				return
+			}
+			require.NoError(t, gErr)
+			g, err := s.GetCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), &pbs.GetCredentialRequest{Id: tc.id})
+			assert.Nil(t, g)
+			assert.True(t, errors.Is(err, handlers.NotFoundError()))
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
+	store := static.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
+
+	s, err := NewService(repoFn, iamRepoFn)
+	require.NoError(t, err)
+
+	toStruct := func(t *testing.T, m proto.Message) *structpb.Struct {
+		t.Helper()
+		attrs, err := handlers.ProtoToStruct(m)
+		require.NoError(t, err)
+		return attrs
+	}
+
+	t.Run("name and username", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user", "pass")
+		got, err := s.UpdateCredential(ctx, &pbs.UpdateCredentialRequest{
+			Id:         c.GetPublicId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", usernameField}},
+			Item: &pb.Credential{
+				Version:    c.GetVersion(),
+				Name:       wrapperspb.String("new name"),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{Username: wrapperspb.String("new user")}),
+			},
+		})
+		require.NoError(err)
+		assert.Equal("new name", got.GetItem().GetName().GetValue())
+		assert.Equal("new user", got.GetItem().GetAttributes().AsMap()["username"])
+		assert.Equal(base64.RawURLEncoding.EncodeToString(c.GetPasswordHmac()), got.GetItem().GetAttributes().AsMap()["password_hmac"])
+		assert.Equal(c.GetVersion()+1, got.GetItem().GetVersion())
+	})
+
+	t.Run("password changes hmac", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user2", "pass")
+		got, err := s.UpdateCredential(ctx, &pbs.UpdateCredentialRequest{
+			Id:         c.GetPublicId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{passwordField}},
+			Item: &pb.Credential{
+				Version:    c.GetVersion(),
+				Attributes: toStruct(t, &pb.UsernamePasswordAttributes{Password: wrapperspb.String("new pass")}),
+			},
+		})
+		require.NoError(err)
+		assert.NotEqual(base64.RawURLEncoding.EncodeToString(c.GetPasswordHmac()), got.GetItem().GetAttributes().AsMap()["password_hmac"])
+	})
+
+	t.Run("unset password", func(t *testing.T) {
+		c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user3", "pass")
+		_, err := s.UpdateCredential(ctx, &pbs.UpdateCredentialRequest{
+			Id:         c.GetPublicId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{passwordField}},
+			Item:       &pb.Credential{Version: c.GetVersion()},
+		})
+		require.Error(t, err)
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("change type", func(t *testing.T) {
+		c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user4", "pass")
+		_, err := s.UpdateCredential(ctx, &pbs.UpdateCredentialRequest{
+			Id:         c.GetPublicId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			Item: &pb.Credential{
+				Version: c.GetVersion(),
+				Type:    string(credential.JsonType),
+				Name:    wrapperspb.String("name"),
+			},
+		})
+		require.Error(t, err)
+		assert.Truef(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+	})
+
+	t.Run("wrong version", func(t *testing.T) {
+		c := static.TestUsernamePasswordCredential(t, conn, wrapper, prj.GetPublicId(), store.GetPublicId(), "user5", "pass")
+		_, err := s.UpdateCredential(ctx, &pbs.UpdateCredentialRequest{
+			Id:         c.GetPublicId(),
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			Item: &pb.Credential{
+				Version: c.GetVersion() + 10,
+				Name:    wrapperspb.String("name"),
+			},
+		})
+		require.Error(t, err)
+	})
+}
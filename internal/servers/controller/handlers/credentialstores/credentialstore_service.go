@@ -5,9 +5,12 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
+	staticstore "github.com/hashicorp/boundary/internal/credential/static/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -19,9 +22,11 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentials"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -37,7 +42,8 @@ const (
 )
 
 var (
-	maskManager handlers.MaskManager
+	maskManager       handlers.MaskManager
+	staticMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -55,8 +61,13 @@ var (
 		action.List,
 	}
 
-	collectionTypeMap = map[resource.Type]action.ActionSet{
-		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	collectionTypeMap = map[subtypes.Subtype]map[resource.Type]action.ActionSet{
+		vault.Subtype: {
+			resource.CredentialLibrary: credentiallibraries.CollectionActions,
+		},
+		static.Subtype: {
+			resource.Credential: credentials.CollectionActions,
+		},
 	}
 )
 
@@ -66,18 +77,23 @@ func init() {
 		handlers.MaskSource{&pb.CredentialStore{}, &pb.VaultCredentialStoreAttributes{}}); err != nil {
 		panic(err)
 	}
+	if staticMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.CredentialStore{}},
+		handlers.MaskSource{&pb.CredentialStore{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.CredentialStoreServiceServer interface.
 type Service struct {
 	pbs.UnimplementedCredentialStoreServiceServer
 
-	iamRepoFn common.IamRepoFactory
-	repoFn    common.VaultCredentialRepoFactory
+	iamRepoFn    common.IamRepoFactory
+	repoFn       common.VaultCredentialRepoFactory
+	staticRepoFn common.StaticCredentialRepoFactory
 }
 
 // NewService returns a credential store service which handles credential store related requests to boundary.
-func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
+func NewService(repo common.VaultCredentialRepoFactory, staticRepo common.StaticCredentialRepoFactory, iamRepo common.IamRepoFactory) (Service, error) {
 	const op = "credentialstores.NewService"
 	if iamRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
@@ -85,7 +101,10 @@ func NewService(repo common.VaultCredentialRepoFactory, iamRepo common.IamRepoFa
 	if repo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing vault credential repository")
 	}
-	return Service{iamRepoFn: iamRepo, repoFn: repo}, nil
+	if staticRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static credential repository")
+	}
+	return Service{iamRepoFn: iamRepo, repoFn: repo, staticRepoFn: staticRepo}, nil
 }
 
 var _ pbs.CredentialStoreServiceServer = Service{}
@@ -95,6 +114,10 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -119,57 +142,62 @@ func (s Service) ListCredentialStores(ctx context.Context, req *pbs.ListCredenti
 		return &pbs.ListCredentialStoresResponse{}, nil
 	}
 
-	csl, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(csl) == 0 {
-		return &pbs.ListCredentialStoresResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.CredentialStore, 0, len(csl))
+	var finalItems []*pb.CredentialStore
 	res := perms.Resource{
 		Type: resource.CredentialStore,
 	}
-	for _, item := range csl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for {
+		csl, err := s.listFromRepo(ctx, scopeIds, page)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range csl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, item.GetPublicId())
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[credential.SubtypeFromId(item.GetPublicId())], authResults.Scope.Id, item.GetPublicId())
+				if err != nil {
+					return nil, err
+				}
+				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+			}
+
+			item, err := toProto(item, outputOpts...)
 			if err != nil {
 				return nil, err
 			}
-			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
-		}
 
-		item, err := toProto(item, outputOpts...)
-		if err != nil {
-			return nil, err
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		if page.Done(len(csl)) {
+			break
 		}
 	}
-	return &pbs.ListCredentialStoresResponse{Items: finalItems}, nil
+	return &pbs.ListCredentialStoresResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetCredentialStore implements the interface pbs.CredentialStoreServiceServer.
@@ -202,7 +230,7 @@ func (s Service) GetCredentialStore(ctx context.Context, req *pbs.GetCredentialS
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, cs.GetPublicId())
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[credential.SubtypeFromId(cs.GetPublicId())], authResults.Scope.Id, cs.GetPublicId())
 		if err != nil {
 			return nil, err
 		}
@@ -247,7 +275,7 @@ func (s Service) CreateCredentialStore(ctx context.Context, req *pbs.CreateCrede
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, cs.GetPublicId())
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[credential.SubtypeFromId(cs.GetPublicId())], authResults.Scope.Id, cs.GetPublicId())
 		if err != nil {
 			return nil, err
 		}
@@ -295,7 +323,7 @@ func (s Service) UpdateCredentialStore(ctx context.Context, req *pbs.UpdateCrede
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, cs.GetPublicId(), IdActions).Strings()))
 	}
 	if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap, authResults.Scope.Id, cs.GetPublicId())
+		collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[credential.SubtypeFromId(cs.GetPublicId())], authResults.Scope.Id, cs.GetPublicId())
 		if err != nil {
 			return nil, err
 		}
@@ -326,48 +354,113 @@ func (s Service) DeleteCredentialStore(ctx context.Context, req *pbs.DeleteCrede
 	return nil, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*vault.CredentialStore, error) {
+// listFromRepo returns the page's next batch of credential stores from both
+// the vault and static repositories, ordered by public id.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage) ([]credential.Store, error) {
 	const op = "credentialstores.(Service).listFromRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	csl, err := repo.ListCredentialStores(ctx, scopeIds)
+	vsl, err := repo.ListCredentialStores(ctx, scopeIds, vault.WithLimit(page.Limit()), vault.WithStartPageAfterId(page.StartAfterId()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var csl []credential.Store
+	for _, cs := range vsl {
+		csl = append(csl, cs)
+	}
+	staticRepo, err := s.staticRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ssl, err := staticRepo.ListCredentialStores(ctx, scopeIds, static.WithLimit(page.Limit()), static.WithStartPageAfterId(page.StartAfterId()))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	for _, cs := range ssl {
+		csl = append(csl, cs)
+	}
+	sort.Slice(csl, func(i, j int) bool {
+		return csl[i].GetPublicId() < csl[j].GetPublicId()
+	})
+	if len(csl) > page.Limit() {
+		csl = csl[:page.Limit()]
+	}
 	return csl, nil
 }
 
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store, error) {
 	const op = "credentialstores.(Service).getFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	cs, err := repo.LookupCredentialStore(ctx, id)
-	if err != nil && !errors.IsNotFoundError(err) {
-		return nil, errors.Wrap(ctx, err, op)
+	var cs credential.Store
+	switch credential.SubtypeFromId(id) {
+	case vault.Subtype:
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if vs != nil {
+			cs = vs
+		}
+	case static.Subtype:
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ss, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if ss != nil {
+			cs = ss
+		}
 	}
 	if cs == nil {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("credential store %q not found", id))
 	}
-	return cs, err
+	return cs, nil
 }
 
 func (s Service) createInRepo(ctx context.Context, projId string, item *pb.CredentialStore) (credential.Store, error) {
 	const op = "credentialstores.(Service).createInRepo"
-	cs, err := toStorageVaultStore(projId, item)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	out, err := repo.CreateCredentialStore(ctx, cs)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+	var out credential.Store
+	switch credential.SubtypeFromType(item.GetType()) {
+	case vault.Subtype:
+		cs, err := toStorageVaultStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vs, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		if vs != nil {
+			out = vs
+		}
+	case static.Subtype:
+		cs, err := toStorageStaticStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ss, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		if ss != nil {
+			out = ss
+		}
 	}
 	if out == nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store but no error returned from repository.")
@@ -377,23 +470,49 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.Crede
 
 func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []string, item *pb.CredentialStore) (credential.Store, error) {
 	const op = "credentialstores.(Service).updateInRepo"
-	cs, err := toStorageVaultStore(projId, item)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	cs.PublicId = id
+	var out credential.Store
+	var rowsUpdated int
+	switch credential.SubtypeFromId(id) {
+	case vault.Subtype:
+		cs, err := toStorageVaultStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
 
-	dbMask := maskManager.Translate(mask)
-	if len(dbMask) == 0 {
-		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
-	}
-	repo, err := s.repoFn()
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op)
-	}
-	out, rowsUpdated, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		dbMask := maskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		repo, err := s.repoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		vs, n, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+		out, rowsUpdated = vs, n
+	case static.Subtype:
+		cs, err := toStorageStaticStore(projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		dbMask := staticMaskManager.Translate(mask)
+		if len(dbMask) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		repo, err := s.staticRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ss, n, err := repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
+		out, rowsUpdated = ss, n
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
@@ -403,11 +522,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 
 func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	const op = "credentialstores.(Service).deleteFromRepo"
-	repo, err := s.repoFn()
-	if err != nil {
-		return false, err
+	var rows int
+	var err error
+	switch credential.SubtypeFromId(id) {
+	case vault.Subtype:
+		repo, rerr := s.repoFn()
+		if rerr != nil {
+			return false, rerr
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+	case static.Subtype:
+		repo, rerr := s.staticRepoFn()
+		if rerr != nil {
+			return false, rerr
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
 	}
-	rows, err := repo.DeleteCredentialStore(ctx, id)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return false, nil
@@ -424,11 +554,6 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
-	repo, err := s.repoFn()
-	if err != nil {
-		res.Error = err
-		return res
-	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -445,10 +570,36 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 	default:
-		cs, err := repo.LookupCredentialStore(ctx, id)
-		if err != nil {
-			res.Error = err
-			return res
+		var cs credential.Store
+		switch credential.SubtypeFromId(id) {
+		case vault.Subtype:
+			repo, err := s.repoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			vs, err := repo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if vs != nil {
+				cs = vs
+			}
+		case static.Subtype:
+			repo, err := s.staticRepoFn()
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			ss, err := repo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if ss != nil {
+				cs = ss
+			}
 		}
 		if cs == nil {
 			res.Error = handlers.NotFoundError()
@@ -545,6 +696,22 @@ func toProto(in credential.Store, opt ...handlers.Option) (*pb.CredentialStore,
 	return &out, nil
 }
 
+func toStorageStaticStore(scopeId string, in *pb.CredentialStore) (*static.CredentialStore, error) {
+	const op = "credentialstores.toStorageStaticStore"
+	var opts []static.Option
+	if in.GetName() != nil {
+		opts = append(opts, static.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, static.WithDescription(in.GetDescription().GetValue()))
+	}
+	cs, err := static.NewCredentialStore(scopeId, opts...)
+	if err != nil {
+		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("unable to build credential store"))
+	}
+	return cs, nil
+}
+
 func toStorageVaultStore(scopeId string, in *pb.CredentialStore) (out *vault.CredentialStore, err error) {
 	const op = "credentialstores.toStorageVaultStore"
 	var opts []vault.Option
@@ -607,7 +774,7 @@ func toStorageVaultStore(scopeId string, in *pb.CredentialStore) (out *vault.Cre
 //  * All required parameters are set
 //  * There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix, static.CredentialStorePrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
@@ -649,6 +816,10 @@ func validateCreateRequest(req *pbs.CreateCredentialStoreRequest) error {
 			if len(cs) > 0 && pk == nil {
 				badFields[clientCertField] = "Cannot set a client certificate without a private key."
 			}
+		case static.Subtype:
+			if len(req.GetItem().GetAttributes().GetFields()) > 0 {
+				badFields[globals.AttributesField] = "Static credential stores do not have attributes."
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -691,13 +862,20 @@ func validateUpdateRequest(req *pbs.UpdateCredentialStoreRequest) error {
 			if err != nil {
 				badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 			}
+		case static.Subtype:
+			if req.GetItem().GetType() != "" && credential.SubtypeFromType(req.GetItem().GetType()) != static.Subtype {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			if len(req.GetItem().GetAttributes().GetFields()) > 0 {
+				badFields[globals.AttributesField] = "Static credential stores do not have attributes."
+			}
 		}
 		return badFields
-	}, vault.CredentialStorePrefix)
+	}, vault.CredentialStorePrefix, static.CredentialStorePrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, vault.CredentialStorePrefix, static.CredentialStorePrefix)
 }
 
 func validateListRequest(req *pbs.ListCredentialStoresRequest) error {
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -63,6 +64,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prjNoStores := iam.TestScopes(t, iamRepo)
 	_, prj := iam.TestScopes(t, iamRepo)
@@ -127,7 +131,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -169,6 +173,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	defaultCreated := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0].GetCreateTime().GetTimestamp()
@@ -522,7 +529,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(repoFn, iamRepoFn)
+			s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -580,11 +587,14 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -670,11 +680,14 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -731,11 +744,14 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(repoFn, iamRepoFn)
+	s, err := NewService(repoFn, staticRepoFn, iamRepoFn)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -13,7 +13,8 @@ type filterItem struct {
 }
 
 type Filter struct {
-	eval *bexpr.Evaluator
+	filter string
+	eval   *bexpr.Evaluator
 }
 
 // NewFilter returns a Filter which can be evluated against.  An empty string paramter indicates
@@ -27,7 +28,18 @@ func NewFilter(f string) (*Filter, error) {
 	if err != nil {
 		return nil, errors.WrapDeprecated(err, op, errors.WithMsg("couldn't build filter"), errors.WithCode(errors.InvalidParameter))
 	}
-	return &Filter{eval: e}, nil
+	return &Filter{filter: f, eval: e}, nil
+}
+
+// Conditions returns the conditions of the filter comparing the given top
+// level fields of an item which a repository can evaluate in the database,
+// so that it only returns the items which may match the filter. They do not
+// replace Match: the filter must still be evaluated against the item that is
+// returned, as its output fields may not include the fields being compared.
+func (f *Filter) Conditions(fields ...string) []filter.Condition {
+	// The filter was parsed when it was created, so this cannot fail.
+	conds, _ := filter.Conditions(f.filter, fields...)
+	return conds
 }
 
 // Match returns if the provided interface matches the filter.
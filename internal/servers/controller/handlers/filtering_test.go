@@ -3,6 +3,8 @@ package handlers
 import (
 	"testing"
 
+	"github.com/hashicorp/boundary/internal/filter"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -117,3 +119,14 @@ func TestNewFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestFilter_Conditions(t *testing.T) {
+	f, err := NewFilter("")
+	require.NoError(t, err)
+	assert.Empty(t, f.Conditions("name"))
+
+	f, err = NewFilter(`"/item/name" == "foo" and "/item/description" == "bar"`)
+	require.NoError(t, err)
+	assert.Equal(t, []filter.Condition{{Field: "name", Operator: filter.EqualOperator, Value: "foo"}}, f.Conditions("name"))
+	assert.Empty(t, f.Conditions("type"))
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
@@ -77,6 +78,10 @@ func (s Service) ListGroups(ctx context.Context, req *pbs.ListGroupsRequest) (*p
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -101,50 +106,57 @@ func (s Service) ListGroups(ctx context.Context, req *pbs.ListGroupsRequest) (*p
 		return &pbs.ListGroupsResponse{}, nil
 	}
 
-	gl, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(gl) == 0 {
-		return &pbs.ListGroupsResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Group, 0, len(gl))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("name", "scope_id")
+	var finalItems []*pb.Group
 	res := perms.Resource{
 		Type: resource.Group,
 	}
-	for _, item := range gl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, outputOpts...)
+	for {
+		gl, err := s.listFromRepo(ctx, scopeIds, page, conds)
 		if err != nil {
 			return nil, err
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		for _, item := range gl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, item, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(gl)) {
+			break
 		}
 	}
-	return &pbs.ListGroupsResponse{Items: finalItems}, nil
+	return &pbs.ListGroupsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetGroups implements the interface pbs.GroupServiceServer.
@@ -483,13 +495,13 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Group, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*iam.Group, error) {
 	const op = "groups.(Service).listFromRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	gl, err := repo.ListGroups(ctx, scopeIds)
+	gl, err := repo.ListGroups(ctx, scopeIds, iam.WithLimit(page.Limit()), iam.WithStartPageAfterId(page.StartAfterId()), iam.WithFilterConditions(conds))
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
@@ -327,6 +328,10 @@ func TestList(t *testing.T) {
 				return
 			}
 			require.NoError(gErr)
+			// Items are listed in order of their id.
+			sort.Slice(tc.res.Items, func(i, j int) bool {
+				return tc.res.Items[i].GetId() < tc.res.Items[j].GetId()
+			})
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListGroups(%q) got response %q, wanted %q", tc.req.GetScopeId(), got, tc.res)
 
 			// Test the anon case
@@ -3,6 +3,7 @@ package host_catalogs
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -112,6 +113,10 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -136,72 +141,77 @@ func (s Service) ListHostCatalogs(ctx context.Context, req *pbs.ListHostCatalogs
 		return &pbs.ListHostCatalogsResponse{}, nil
 	}
 
-	items, pluginInfoMap, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(items) == 0 {
-		return &pbs.ListHostCatalogsResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.HostCatalog, 0, len(items))
+	var finalItems []*pb.HostCatalog
 	res := perms.Resource{
 		Type: resource.HostCatalog,
 	}
-	for _, item := range items {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for {
+		items, pluginInfoMap, err := s.listFromRepo(ctx, scopeIds, page)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range items {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			var subtype subtypes.Subtype
-			switch item.(type) {
-			case *static.HostCatalog:
-				subtype = static.Subtype
-			case *plugin.HostCatalog:
-				subtype = plugin.Subtype
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
 			}
-			if subtype != "" {
-				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, item.GetPublicId())
-				if err != nil {
-					return nil, err
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				var subtype subtypes.Subtype
+				switch item.(type) {
+				case *static.HostCatalog:
+					subtype = static.Subtype
+				case *plugin.HostCatalog:
+					subtype = plugin.Subtype
+				}
+				if subtype != "" {
+					collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, collectionTypeMap[subtype], authResults.Scope.Id, item.GetPublicId())
+					if err != nil {
+						return nil, err
+					}
+					outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 				}
-				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
 			}
-		}
-		switch hc := item.(type) {
-		case *plugin.HostCatalog:
-			if plgInfo, ok := pluginInfoMap[hc.GetPluginId()]; ok {
-				outputOpts = append(outputOpts, handlers.WithPlugin(plgInfo))
+			switch hc := item.(type) {
+			case *plugin.HostCatalog:
+				if plgInfo, ok := pluginInfoMap[hc.GetPluginId()]; ok {
+					outputOpts = append(outputOpts, handlers.WithPlugin(plgInfo))
+				}
 			}
-		}
 
-		item, err := toProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
-		}
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(items)) {
+			break
 		}
 	}
-	return &pbs.ListHostCatalogsResponse{Items: finalItems}, nil
+	return &pbs.ListHostCatalogsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetHostCatalog implements the interface pbs.HostCatalogServiceServer.
@@ -428,12 +438,14 @@ func (s Service) getFromRepo(ctx context.Context, id string) (host.Catalog, *plu
 	return cat, plg, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]host.Catalog, map[string]*plugins.PluginInfo, error) {
+// listFromRepo returns the page's next batch of host catalogs from both the
+// static and plugin repositories, ordered by public id.
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage) ([]host.Catalog, map[string]*plugins.PluginInfo, error) {
 	repo, err := s.staticRepoFn()
 	if err != nil {
 		return nil, nil, err
 	}
-	ul, err := repo.ListCatalogs(ctx, scopeIds)
+	ul, err := repo.ListCatalogs(ctx, scopeIds, static.WithLimit(page.Limit()), static.WithStartPageAfterId(page.StartAfterId()))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -445,13 +457,19 @@ func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]host.Ca
 	if err != nil {
 		return nil, nil, err
 	}
-	pl, plgs, err := pluginRepo.ListCatalogs(ctx, scopeIds)
+	pl, plgs, err := pluginRepo.ListCatalogs(ctx, scopeIds, host.WithLimit(page.Limit()), host.WithStartPageAfterId(page.StartAfterId()))
 	if err != nil {
 		return nil, nil, err
 	}
 	for _, c := range pl {
 		res = append(res, c)
 	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].GetPublicId() < res[j].GetPublicId()
+	})
+	if len(res) > page.Limit() {
+		res = res[:page.Limit()]
+	}
 	pluginsMap := make(map[string]*plugins.PluginInfo, len(plgs))
 	for _, plg := range plgs {
 		pluginsMap[plg.GetPublicId()] = toPluginInfo(plg)
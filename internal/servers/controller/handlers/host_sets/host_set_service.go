@@ -3,11 +3,13 @@ package host_sets
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/health"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	plugstore "github.com/hashicorp/boundary/internal/host/plugin/store"
 	"github.com/hashicorp/boundary/internal/host/static"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hostsets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"github.com/hashicorp/go-bexpr"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
@@ -64,7 +67,7 @@ var (
 
 func init() {
 	var err error
-	if maskManager[static.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.HostSet{}, &staticstore.UnimplementedSetFields{}}, handlers.MaskSource{&pb.HostSet{}}); err != nil {
+	if maskManager[static.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&staticstore.HostSet{}, &staticstore.UnimplementedSetFields{}}, handlers.MaskSource{&pb.HostSet{}, &pb.StaticHostSetAttributes{}}); err != nil {
 		panic(err)
 	}
 	if maskManager[plugin.Subtype], err = handlers.NewMaskManager(handlers.MaskDestination{&plugstore.HostSet{}}, handlers.MaskSource{&pb.HostSet{}}); err != nil {
@@ -77,13 +80,14 @@ type Service struct {
 
 	staticRepoFn common.StaticRepoFactory
 	pluginRepoFn common.PluginHostRepoFactory
+	healthRepoFn common.HostHealthRepoFactory
 }
 
 var _ pbs.HostSetServiceServer = Service{}
 
 // NewService returns a host set Service which handles host set related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(staticRepoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory) (Service, error) {
+func NewService(staticRepoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, healthRepoFn common.HostHealthRepoFactory) (Service, error) {
 	const op = "host_sets.NewService"
 	if staticRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static repository")
@@ -91,7 +95,10 @@ func NewService(staticRepoFn common.StaticRepoFactory, pluginRepoFn common.Plugi
 	if pluginRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing plugin repository")
 	}
-	return Service{staticRepoFn: staticRepoFn, pluginRepoFn: pluginRepoFn}, nil
+	if healthRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing host health repository")
+	}
+	return Service{staticRepoFn: staticRepoFn, pluginRepoFn: pluginRepoFn, healthRepoFn: healthRepoFn}, nil
 }
 
 func (s Service) ListHostSets(ctx context.Context, req *pbs.ListHostSetsRequest) (*pbs.ListHostSetsResponse, error) {
@@ -102,60 +109,77 @@ func (s Service) ListHostSetsWithOptions(ctx context.Context, req *pbs.ListHostS
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
-	}
-	hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId(), opt...)
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(hl) == 0 {
-		return &pbs.ListHostSetsResponse{}, nil
+	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
 	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.HostSet, 0, len(hl))
+	var finalItems []*pb.HostSet
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.HostSet,
 		Pin:     req.GetHostCatalogId(),
 	}
-	for _, item := range hl {
-		res.Id = item.GetPublicId()
-		idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if plg != nil {
-			outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	for {
+		hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId(), page, opt...)
+		if err != nil {
+			return nil, err
 		}
-
-		item, err := toProto(ctx, item, nil, outputOpts...)
+		checks, err := s.listHealthChecks(ctx, hl)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range hl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if plg != nil {
+				outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+			}
+
+			hc := checks[item.GetPublicId()]
+			item, err := toProto(ctx, item, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+			if outputFields.Has(globals.HealthCheckField) && hc != nil {
+				item.HealthCheck = healthCheckToProto(hc)
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(hl)) {
+			break
 		}
 	}
-	return &pbs.ListHostSetsResponse{Items: finalItems}, nil
+	return &pbs.ListHostSetsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetHostSet implements the interface pbs.HostSetServiceServer.
@@ -196,6 +220,9 @@ func (s Service) GetHostSet(ctx context.Context, req *pbs.GetHostSetRequest) (*p
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addHealthCheck(ctx, item, outputFields); err != nil {
+		return nil, err
+	}
 
 	return &pbs.GetHostSetResponse{Item: item}, nil
 }
@@ -238,6 +265,9 @@ func (s Service) CreateHostSet(ctx context.Context, req *pbs.CreateHostSetReques
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addHealthCheck(ctx, item, outputFields); err != nil {
+		return nil, err
+	}
 
 	return &pbs.CreateHostSetResponse{
 		Item: item,
@@ -283,6 +313,9 @@ func (s Service) UpdateHostSet(ctx context.Context, req *pbs.UpdateHostSetReques
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addHealthCheck(ctx, item, outputFields); err != nil {
+		return nil, err
+	}
 
 	return &pbs.UpdateHostSetResponse{Item: item}, nil
 }
@@ -338,6 +371,9 @@ func (s Service) AddHostSetHosts(ctx context.Context, req *pbs.AddHostSetHostsRe
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addHealthCheck(ctx, item, outputFields); err != nil {
+		return nil, err
+	}
 
 	return &pbs.AddHostSetHostsResponse{Item: item}, nil
 }
@@ -376,6 +412,9 @@ func (s Service) SetHostSetHosts(ctx context.Context, req *pbs.SetHostSetHostsRe
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addHealthCheck(ctx, item, outputFields); err != nil {
+		return nil, err
+	}
 
 	return &pbs.SetHostSetHostsResponse{Item: item}, nil
 }
@@ -415,6 +454,9 @@ func (s Service) RemoveHostSetHosts(ctx context.Context, req *pbs.RemoveHostSetH
 	if err != nil {
 		return nil, err
 	}
+	if err := s.addHealthCheck(ctx, item, outputFields); err != nil {
+		return nil, err
+	}
 
 	return &pbs.RemoveHostSetHostsResponse{Item: item}, nil
 }
@@ -512,6 +554,19 @@ func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, it
 	default:
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized catalog type")
 	}
+	if item.GetHealthCheck() != nil {
+		c, err := toStorageHealthCheck(ctx, hSet.GetPublicId(), item.GetHealthCheck())
+		if err != nil {
+			return nil, nil, err
+		}
+		repo, err := s.healthRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := repo.SetCheck(ctx, scopeId, c); err != nil {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to set host set health check"))
+		}
+	}
 	return hSet, plg, nil
 }
 
@@ -525,6 +580,12 @@ func (s Service) updateStaticInRepo(ctx context.Context, scopeId, catalogId stri
 	h.PublicId = req.GetId()
 	dbMask := maskManager[static.Subtype].Translate(req.GetUpdateMask().GetPaths())
 	if len(dbMask) == 0 {
+		if healthCheckInMask(req.GetUpdateMask().GetPaths()) {
+			// Only the health check is updated, which is not stored with
+			// the set.
+			hs, hl, _, err := s.lookupForHealthCheckUpdate(ctx, req)
+			return hs, hl, err
+		}
 		return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
 	repo, err := s.staticRepoFn()
@@ -555,6 +616,11 @@ func (s Service) updatePluginInRepo(ctx context.Context, scopeId string, req *pb
 	h.PublicId = req.GetId()
 	dbMask := maskManager[plugin.Subtype].Translate(req.GetUpdateMask().GetPaths(), "attributes")
 	if len(dbMask) == 0 {
+		if healthCheckInMask(req.GetUpdateMask().GetPaths()) {
+			// Only the health check is updated, which is not stored with
+			// the set.
+			return s.lookupForHealthCheckUpdate(ctx, req)
+		}
 		return nil, nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
 	}
 	repo, err := s.pluginRepoFn()
@@ -577,15 +643,158 @@ func (s Service) updatePluginInRepo(ctx context.Context, scopeId string, req *pb
 
 func (s Service) updateInRepo(ctx context.Context, scopeId, catalogId string, req *pbs.UpdateHostSetRequest) (hs host.Set, hosts []host.Host, plg *plugins.PluginInfo, err error) {
 	const op = "host_sets.(Service).updateInRepo"
+	// The health check is built and validated before the set is updated so
+	// an invalid check does not leave the set partially updated.
+	var check *health.Check
+	updateCheck := healthCheckInMask(req.GetUpdateMask().GetPaths())
+	if updateCheck {
+		if check, err = s.healthCheckForUpdate(ctx, req); err != nil {
+			return
+		}
+	}
 	switch host.SubtypeFromId(req.GetId()) {
 	case static.Subtype:
 		hs, hosts, err = s.updateStaticInRepo(ctx, scopeId, catalogId, req)
 	case plugin.Subtype:
 		hs, hosts, plg, err = s.updatePluginInRepo(ctx, scopeId, req)
 	}
+	if err != nil {
+		return
+	}
+	if updateCheck {
+		var repo *health.Repository
+		if repo, err = s.healthRepoFn(); err != nil {
+			return
+		}
+		if check == nil {
+			_, err = repo.DeleteCheck(ctx, scopeId, req.GetId())
+		} else {
+			_, err = repo.SetCheck(ctx, scopeId, check)
+		}
+		if err != nil {
+			err = errors.Wrap(ctx, err, op, errors.WithMsg("unable to update host set health check"))
+		}
+	}
 	return
 }
 
+// lookupForHealthCheckUpdate returns the host set of an update request which
+// only updates the health check of the set, after checking the version of
+// the request.
+func (s Service) lookupForHealthCheckUpdate(ctx context.Context, req *pbs.UpdateHostSetRequest) (host.Set, []host.Host, *plugins.PluginInfo, error) {
+	hs, hl, plg, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if hs.GetVersion() != req.GetItem().GetVersion() {
+		return nil, nil, nil, handlers.NotFoundErrorf("Host Set %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	return hs, hl, plg, nil
+}
+
+// healthCheckForUpdate returns the health check of the host set of an update
+// request, or nil if the request removes the check. The fields of the
+// current check which are not in the update mask are kept.
+func (s Service) healthCheckForUpdate(ctx context.Context, req *pbs.UpdateHostSetRequest) (*health.Check, error) {
+	in := req.GetItem().GetHealthCheck()
+	if in == nil {
+		return nil, nil
+	}
+	var paths []string
+	for _, p := range req.GetUpdateMask().GetPaths() {
+		for _, p := range strings.Split(p, ",") {
+			paths = append(paths, strings.TrimSpace(p))
+		}
+	}
+	replace := handlers.MaskContains(paths, globals.HealthCheckField)
+
+	merged := &pb.HealthCheck{}
+	if !replace {
+		repo, err := s.healthRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		current, err := repo.LookupCheck(ctx, req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		if current != nil {
+			merged = healthCheckToProto(current)
+		}
+	}
+	contains := func(f string) bool {
+		return replace || handlers.MaskContains(paths, globals.HealthCheckField+"."+f)
+	}
+	if contains("type") {
+		merged.Type = in.GetType()
+	}
+	if contains("port") {
+		merged.Port = in.GetPort()
+	}
+	if contains("path") {
+		merged.Path = in.GetPath()
+	}
+	if contains("interval_seconds") {
+		merged.IntervalSeconds = in.GetIntervalSeconds()
+	}
+	if contains("timeout_seconds") {
+		merged.TimeoutSeconds = in.GetTimeoutSeconds()
+	}
+	if contains("worker_filter") {
+		merged.WorkerFilter = in.GetWorkerFilter()
+	}
+	badFields := map[string]string{}
+	validateHealthCheck(merged, badFields)
+	if len(badFields) > 0 {
+		return nil, handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return toStorageHealthCheck(ctx, req.GetId(), merged)
+}
+
+// addHealthCheck adds the health check of item to item if the health check
+// is an output field.
+func (s Service) addHealthCheck(ctx context.Context, item *pb.HostSet, outputFields perms.OutputFieldsMap) error {
+	if !outputFields.Has(globals.HealthCheckField) {
+		return nil
+	}
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return err
+	}
+	c, err := repo.LookupCheck(ctx, item.GetId())
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		item.HealthCheck = healthCheckToProto(c)
+	}
+	return nil
+}
+
+// listHealthChecks returns the health checks of sets by set id.
+func (s Service) listHealthChecks(ctx context.Context, sets []host.Set) (map[string]*health.Check, error) {
+	if len(sets) == 0 {
+		return nil, nil
+	}
+	setIds := make([]string, 0, len(sets))
+	for _, hs := range sets {
+		setIds = append(setIds, hs.GetPublicId())
+	}
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	checks, err := repo.ListChecks(ctx, health.WithSetIds(setIds))
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]*health.Check, len(checks))
+	for _, c := range checks {
+		ret[c.GetSetId()] = c
+	}
+	return ret, nil
+}
+
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
 	const op = "host_sets.(Service).deleteFromRepo"
 	rows := 0
@@ -612,7 +821,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host.Option) ([]host.Set, *plugins.PluginInfo, error) {
+func (s Service) listFromRepo(ctx context.Context, catalogId string, page *handlers.ListPage, opt ...host.Option) ([]host.Set, *plugins.PluginInfo, error) {
 	const op = "host_sets.(Service).listFromRepo"
 	var plg *plugins.PluginInfo
 	var sets []host.Set
@@ -622,7 +831,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host
 		if err != nil {
 			return nil, nil, err
 		}
-		sl, err := repo.ListSets(ctx, catalogId)
+		sl, err := repo.ListSets(ctx, catalogId, static.WithLimit(page.Limit()), static.WithStartPageAfterId(page.StartAfterId()))
 		if err != nil {
 			return nil, nil, errors.Wrap(ctx, err, op)
 		}
@@ -634,6 +843,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string, opt ...host
 		if err != nil {
 			return nil, nil, err
 		}
+		opt = append(opt, host.WithLimit(page.Limit()), host.WithStartPageAfterId(page.StartAfterId()))
 		sl, hsplg, err := repo.ListSets(ctx, catalogId, opt...)
 		if err != nil {
 			return nil, nil, errors.Wrap(ctx, err, op)
@@ -862,6 +1072,14 @@ func toProto(ctx context.Context, in host.Set, hosts []host.Host, opt ...handler
 	}
 
 	switch h := in.(type) {
+	case *static.HostSet:
+		if outputFields.Has(globals.AttributesField) && h.GetFilter() != "" {
+			attrs, err := handlers.ProtoToStruct(&pb.StaticHostSetAttributes{Filter: wrapperspb.String(h.GetFilter())})
+			if err != nil {
+				return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert static attribute to struct: %s", err)
+			}
+			out.Attributes = attrs
+		}
 	case *plugin.HostSet:
 		if outputFields.Has(globals.PreferredEndpointsField) {
 			out.PreferredEndpoints = h.PreferredEndpoints
@@ -884,6 +1102,30 @@ func toProto(ctx context.Context, in host.Set, hosts []host.Host, opt ...handler
 	return &out, nil
 }
 
+func healthCheckToProto(c *health.Check) *pb.HealthCheck {
+	return &pb.HealthCheck{
+		Type:            c.GetType(),
+		Port:            c.GetPort(),
+		Path:            c.GetPath(),
+		IntervalSeconds: c.GetIntervalSeconds(),
+		TimeoutSeconds:  c.GetTimeoutSeconds(),
+		WorkerFilter:    c.GetWorkerFilter(),
+	}
+}
+
+func toStorageHealthCheck(ctx context.Context, setId string, item *pb.HealthCheck) (*health.Check, error) {
+	const op = "host_set_service.toStorageHealthCheck"
+	c, err := health.NewCheck(ctx, setId, health.CheckType(item.GetType()), item.GetPort(),
+		health.WithPath(item.GetPath()),
+		health.WithIntervalSeconds(item.GetIntervalSeconds()),
+		health.WithTimeoutSeconds(item.GetTimeoutSeconds()),
+		health.WithWorkerFilter(item.GetWorkerFilter()))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build health check"))
+	}
+	return c, nil
+}
+
 func toStorageStaticSet(ctx context.Context, catalogId string, item *pb.HostSet) (*static.HostSet, error) {
 	const op = "host_set_service.toStorageStaticSet"
 	var opts []static.Option
@@ -893,6 +1135,13 @@ func toStorageStaticSet(ctx context.Context, catalogId string, item *pb.HostSet)
 	if item.GetDescription() != nil {
 		opts = append(opts, static.WithDescription(item.GetDescription().GetValue()))
 	}
+	attrs := &pb.StaticHostSetAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), attrs); err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Failed converting attributes to subtype proto: %s", err)
+	}
+	if attrs.GetFilter() != nil {
+		opts = append(opts, static.WithFilter(attrs.GetFilter().GetValue()))
+	}
 	hs, err := static.NewHostSet(catalogId, opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host set for creation"))
@@ -948,6 +1197,9 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateHostSetRequest) e
 				badFields[globals.PreferredEndpointsField] = fmt.Errorf("Error parsing preferred endpoints: %w.", err).Error()
 			}
 		}
+		if req.GetItem().GetHealthCheck() != nil {
+			validateHealthCheck(req.GetItem().GetHealthCheck(), badFields)
+		}
 		switch host.SubtypeFromId(req.GetItem().GetHostCatalogId()) {
 		case static.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != static.Subtype.String() {
@@ -956,6 +1208,7 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateHostSetRequest) e
 			if len(req.GetItem().PreferredEndpoints) > 0 {
 				badFields[globals.PreferredEndpointsField] = "This field is not yet supported for static host sets."
 			}
+			validateStaticAttributes(req.GetItem().GetAttributes(), badFields)
 		case plugin.Subtype:
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != plugin.Subtype.String() {
 				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
@@ -984,6 +1237,9 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateHostSetRequest) e
 			if req.GetItem().GetType() != "" && req.GetItem().GetType() != static.Subtype.String() {
 				badFields[globals.TypeField] = "Cannot modify the resource type."
 			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.filter") {
+				validateStaticAttributes(req.GetItem().GetAttributes(), badFields)
+			}
 		case plugin.Subtype:
 			if val := req.GetItem().GetSyncIntervalSeconds(); val != nil {
 				if val.GetValue() == 0 || val.GetValue() < -1 {
@@ -995,6 +1251,75 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateHostSetRequest) e
 	}, static.HostSetPrefix, plugin.HostSetPrefix)
 }
 
+// validateStaticAttributes adds the invalid fields of the attributes of a
+// static host set to badFields.
+func validateStaticAttributes(attributes *structpb.Struct, badFields map[string]string) {
+	attrs := &pb.StaticHostSetAttributes{}
+	if err := handlers.StructToProto(attributes, attrs); err != nil {
+		badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+		return
+	}
+	if filter := attrs.GetFilter().GetValue(); filter != "" {
+		if _, err := bexpr.CreateEvaluator(filter); err != nil {
+			badFields["attributes.filter"] = fmt.Sprintf("Unable to parse filter: %v.", err)
+		}
+	}
+}
+
+// validateHealthCheck adds the invalid fields of the health check of a host
+// set to badFields.
+func validateHealthCheck(hc *pb.HealthCheck, badFields map[string]string) {
+	field := func(f string) string { return globals.HealthCheckField + "." + f }
+	switch health.CheckType(hc.GetType()) {
+	case health.TcpCheck:
+		if hc.GetPath() != "" {
+			badFields[field("path")] = "This field can only be set for http health checks."
+		}
+	case health.HttpCheck:
+		if hc.GetPath() != "" && !strings.HasPrefix(hc.GetPath(), "/") {
+			badFields[field("path")] = "Must start with /."
+		}
+	default:
+		badFields[field("type")] = fmt.Sprintf("Must be %q or %q.", health.TcpCheck, health.HttpCheck)
+	}
+	if hc.GetPort() == 0 || hc.GetPort() > 65535 {
+		badFields[field("port")] = "Must be between 1 and 65535."
+	}
+	interval := hc.GetIntervalSeconds()
+	if interval == 0 {
+		interval = health.DefaultIntervalSeconds
+	}
+	if interval < health.MinIntervalSeconds {
+		badFields[field("interval_seconds")] = fmt.Sprintf("Must be at least %d.", health.MinIntervalSeconds)
+	}
+	timeout := hc.GetTimeoutSeconds()
+	if timeout == 0 {
+		timeout = health.DefaultTimeoutSeconds
+	}
+	if timeout >= interval {
+		badFields[field("timeout_seconds")] = "Must be less than the interval."
+	}
+	if hc.GetWorkerFilter() != "" {
+		if _, err := bexpr.CreateEvaluator(hc.GetWorkerFilter()); err != nil {
+			badFields[field("worker_filter")] = fmt.Sprintf("Unable to parse filter: %v.", err)
+		}
+	}
+}
+
+// healthCheckInMask reports whether paths update the health check of a host
+// set.
+func healthCheckInMask(paths []string) bool {
+	for _, p := range paths {
+		for _, p := range strings.Split(p, ",") {
+			p = strings.TrimSpace(p)
+			if p == globals.HealthCheckField || strings.HasPrefix(p, globals.HealthCheckField+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func validateDeleteRequest(req *pbs.DeleteHostSetRequest) error {
 	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, static.HostSetPrefix, plugin.HostSetPrefix)
 }
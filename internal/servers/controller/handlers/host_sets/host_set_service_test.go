@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/health"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -63,6 +64,9 @@ func TestGet_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
@@ -122,7 +126,7 @@ func TestGet_Static(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostSetRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -157,6 +161,9 @@ func TestGet_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 
 	name := "test"
 	prefEndpoints := []string{"cidr:1.2.3.4", "cidr:2.3.4.5/24"}
@@ -226,7 +233,7 @@ func TestGet_Plugin(t *testing.T) {
 			req := proto.Clone(toMerge).(*pbs.GetHostSetRequest)
 			proto.Merge(req, tc.req)
 
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create a new host set service.")
 
 			got, gErr := s.GetHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), req)
@@ -262,6 +269,9 @@ func TestList_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
@@ -317,7 +327,7 @@ func TestList_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test with non-anon user
@@ -328,6 +338,10 @@ func TestList_Static(t *testing.T) {
 				return
 			}
 			require.NoError(gErr)
+			// Items are listed in order of their id.
+			sort.Slice(tc.res.Items, func(i, j int) bool {
+				return tc.res.Items[i].GetId() < tc.res.Items[j].GetId()
+			})
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListHostSets(%q) got response %q, wanted %q", tc.req, got, tc.res)
 
 			// Test with anon user
@@ -361,6 +375,9 @@ func TestList_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	name := "test"
 	plg := hostplugin.TestPlugin(t, conn, name)
 	plgm := map[string]plgpb.HostPluginServiceClient{
@@ -430,7 +447,7 @@ func TestList_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := host_sets.NewService(repoFn, pluginRepoFn)
+			s, err := host_sets.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test with non-anon user
@@ -475,13 +492,16 @@ func TestDelete_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn, pluginRepoFn)
+	s, err := host_sets.NewService(repoFn, pluginRepoFn, healthRepoFn)
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -546,6 +566,9 @@ func TestDelete_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
@@ -558,7 +581,7 @@ func TestDelete_Plugin(t *testing.T) {
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := plugin.TestSet(t, conn, kms, sche, hc, plgm)
 
-	s, err := host_sets.NewService(repoFn, pluginRepoFn)
+	s, err := host_sets.NewService(repoFn, pluginRepoFn, healthRepoFn)
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -624,13 +647,16 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostSetRequest{
 		Id: h.GetPublicId(),
@@ -661,6 +687,9 @@ func TestCreate_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
@@ -767,7 +796,7 @@ func TestCreate_Static(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := host_sets.NewService(repoFn, plgRepoFn)
+			s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -817,6 +846,9 @@ func TestCreate_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	name := "test"
 	plg := hostplugin.TestPlugin(t, conn, name)
 	plgRepoFn := func() (*plugin.Repository, error) {
@@ -1028,7 +1060,7 @@ func TestCreate_Plugin(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := host_sets.NewService(repoFn, plgRepoFn)
+			s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHostSet(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -1080,6 +1112,9 @@ func TestUpdate_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	repo, err := repoFn()
 	require.NoError(t, err, "Couldn't create new static repo.")
 
@@ -1112,7 +1147,7 @@ func TestUpdate_Static(t *testing.T) {
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	tested, err := host_sets.NewService(repoFn, plgRepoFn)
+	tested, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -1462,13 +1497,16 @@ func TestUpdate_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginHostRepo := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, plgm)
 	}
 	iamRepoFn := func() (*iam.Repository, error) {
 		return iamRepo, nil
 	}
-	tested, err := host_sets.NewService(repoFn, pluginHostRepo)
+	tested, err := host_sets.NewService(repoFn, pluginHostRepo, healthRepoFn)
 	require.NoError(t, err, "Failed to create a new host catalog service.")
 
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
@@ -1795,10 +1833,13 @@ func TestAddHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -1916,10 +1957,13 @@ func TestSetHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2033,10 +2077,13 @@ func TestRemoveHostSetHosts(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	plgRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
-	s, err := host_sets.NewService(repoFn, plgRepoFn)
+	s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
 	require.NoError(t, err, "Error when getting new host set service.")
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2150,3 +2197,111 @@ func TestRemoveHostSetHosts(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthCheck_Static(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	rw := db.New(conn)
+	repoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
+	plgRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+
+	assert, require := assert.New(t), require.New(t)
+	s, err := host_sets.NewService(repoFn, plgRepoFn, healthRepoFn)
+	require.NoError(err, "Couldn't create a new host set service.")
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+
+	created, err := s.CreateHostSet(ctx, &pbs.CreateHostSetRequest{Item: &pb.HostSet{
+		HostCatalogId: hc.GetPublicId(),
+		HealthCheck: &pb.HealthCheck{
+			Type: "http",
+			Port: 8080,
+			Path: "/healthz",
+		},
+	}})
+	require.NoError(err)
+	want := &pb.HealthCheck{
+		Type:            "http",
+		Port:            8080,
+		Path:            "/healthz",
+		IntervalSeconds: health.DefaultIntervalSeconds,
+		TimeoutSeconds:  health.DefaultTimeoutSeconds,
+	}
+	assert.Empty(cmp.Diff(want, created.GetItem().GetHealthCheck(), protocmp.Transform()))
+	id := created.GetItem().GetId()
+
+	got, err := s.GetHostSet(ctx, &pbs.GetHostSetRequest{Id: id})
+	require.NoError(err)
+	assert.Empty(cmp.Diff(want, got.GetItem().GetHealthCheck(), protocmp.Transform()))
+
+	// Only the fields in the mask are updated, and the version is unchanged.
+	updated, err := s.UpdateHostSet(ctx, &pbs.UpdateHostSetRequest{
+		Id: id,
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"health_check.port", "health_check.interval_seconds"},
+		},
+		Item: &pb.HostSet{
+			Version:     created.GetItem().GetVersion(),
+			HealthCheck: &pb.HealthCheck{Port: 9090, IntervalSeconds: 60, Path: "/ignored"},
+		},
+	})
+	require.NoError(err)
+	want.Port, want.IntervalSeconds = 9090, 60
+	assert.Empty(cmp.Diff(want, updated.GetItem().GetHealthCheck(), protocmp.Transform()))
+	assert.Equal(created.GetItem().GetVersion(), updated.GetItem().GetVersion())
+
+	// An invalid check is rejected.
+	_, err = s.UpdateHostSet(ctx, &pbs.UpdateHostSetRequest{
+		Id: id,
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"health_check.type"},
+		},
+		Item: &pb.HostSet{
+			Version:     created.GetItem().GetVersion(),
+			HealthCheck: &pb.HealthCheck{Type: "tcp"},
+		},
+	})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+
+	// Setting the check to null removes it.
+	updated, err = s.UpdateHostSet(ctx, &pbs.UpdateHostSetRequest{
+		Id: id,
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{"health_check"},
+		},
+		Item: &pb.HostSet{
+			Version: created.GetItem().GetVersion(),
+		},
+	})
+	require.NoError(err)
+	assert.Nil(updated.GetItem().GetHealthCheck())
+
+	_, err = s.CreateHostSet(ctx, &pbs.CreateHostSetRequest{Item: &pb.HostSet{
+		HostCatalogId: hc.GetPublicId(),
+		HealthCheck: &pb.HealthCheck{
+			Type:            "tcp",
+			Port:            22,
+			IntervalSeconds: 10,
+			TimeoutSeconds:  10,
+		},
+	}})
+	assert.Truef(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+}
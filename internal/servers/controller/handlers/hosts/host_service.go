@@ -25,6 +25,8 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/hosts"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -66,13 +68,14 @@ type Service struct {
 
 	staticRepoFn common.StaticRepoFactory
 	pluginRepoFn common.PluginHostRepoFactory
+	healthRepoFn common.HostHealthRepoFactory
 }
 
 var _ pbs.HostServiceServer = Service{}
 
 // NewService returns a host Service which handles host related requests to boundary and uses the provided
 // repositories for storage and retrieval.
-func NewService(repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory) (Service, error) {
+func NewService(repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostRepoFactory, healthRepoFn common.HostHealthRepoFactory) (Service, error) {
 	const op = "hosts.NewService"
 	if repoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing static repository")
@@ -80,71 +83,83 @@ func NewService(repoFn common.StaticRepoFactory, pluginRepoFn common.PluginHostR
 	if pluginRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing plugin host repository")
 	}
-	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn}, nil
+	if healthRepoFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing host health repository")
+	}
+	return Service{staticRepoFn: repoFn, pluginRepoFn: pluginRepoFn, healthRepoFn: healthRepoFn}, nil
 }
 
 func (s Service) ListHosts(ctx context.Context, req *pbs.ListHostsRequest) (*pbs.ListHostsResponse, error) {
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
-	}
-	hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId())
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(hl) == 0 {
-		return &pbs.ListHostsResponse{}, nil
+	_, authResults := s.parentAndAuthResult(ctx, req.GetHostCatalogId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
 	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Host, 0, len(hl))
+	var finalItems []*pb.Host
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.Host,
 		Pin:     req.GetHostCatalogId(),
 	}
-	for _, item := range hl {
-		res.Id = item.GetPublicId()
-		idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if plg != nil {
-			outputOpts = append(outputOpts, handlers.WithPlugin(plg))
-		}
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		switch h := item.(type) {
-		case *plugin.Host:
-			outputOpts = append(outputOpts, handlers.WithHostSetIds(h.SetIds))
-		}
-		item, err := toProto(ctx, item, outputOpts...)
+	for {
+		hl, plg, err := s.listFromRepo(ctx, req.GetHostCatalogId(), page)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range hl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			idActions := idActionsTypeMap[host.SubtypeFromId(res.Id)]
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if plg != nil {
+				outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+			}
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			switch h := item.(type) {
+			case *plugin.Host:
+				outputOpts = append(outputOpts, handlers.WithHostSetIds(h.SetIds))
+			}
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(hl)) {
+			break
 		}
 	}
-	return &pbs.ListHostsResponse{Items: finalItems}, nil
+	return &pbs.ListHostsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetHost implements the interface pbs.HostServiceServer.
@@ -189,6 +204,11 @@ func (s Service) GetHost(ctx context.Context, req *pbs.GetHostRequest) (*pbs.Get
 	if err != nil {
 		return nil, err
 	}
+	if outputFields.Has(globals.HealthField) {
+		if item.Health, err = s.healthFromRepo(ctx, h.GetPublicId()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pbs.GetHostResponse{Item: item}, nil
 }
@@ -324,6 +344,30 @@ func (s Service) getFromRepo(ctx context.Context, id string) (host.Host, *plugin
 	return h, plg, nil
 }
 
+// healthFromRepo returns the health of the host id in each of its sets with a
+// health check.
+func (s Service) healthFromRepo(ctx context.Context, id string) ([]*pb.HostHealth, error) {
+	repo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	hh, err := repo.ListHostHealth(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var ret []*pb.HostHealth
+	for _, h := range hh {
+		ret = append(ret, &pb.HostHealth{
+			HostSetId:   h.SetId,
+			Status:      h.Status.String(),
+			Message:     h.Message,
+			WorkerId:    h.WorkerId,
+			CheckedTime: timestamppb.New(h.CheckedTime),
+		})
+	}
+	return ret, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, item *pb.Host) (*static.Host, error) {
 	const op = "hosts.(Service).createInRepo"
 	ha := &pb.StaticHostAttributes{}
@@ -334,6 +378,9 @@ func (s Service) createInRepo(ctx context.Context, scopeId, catalogId string, it
 	if ha.GetAddress() != nil {
 		opts = append(opts, static.WithAddress(ha.GetAddress().GetValue()))
 	}
+	if len(ha.GetTags()) > 0 {
+		opts = append(opts, static.WithTags(tagsFromProto(ha.GetTags())))
+	}
 	if item.GetName() != nil {
 		opts = append(opts, static.WithName(item.GetName().GetValue()))
 	}
@@ -375,6 +422,9 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, catalogId, id string
 	if addr := ha.GetAddress(); addr != nil {
 		opts = append(opts, static.WithAddress(addr.GetValue()))
 	}
+	if tags := ha.GetTags(); len(tags) > 0 {
+		opts = append(opts, static.WithTags(tagsFromProto(tags)))
+	}
 	h, err := static.NewHost(catalogId, opts...)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("Unable to build host for update"))
@@ -411,7 +461,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Host, *plugins.PluginInfo, error) {
+func (s Service) listFromRepo(ctx context.Context, catalogId string, page *handlers.ListPage) ([]host.Host, *plugins.PluginInfo, error) {
 	var hosts []host.Host
 	var plg *plugins.PluginInfo
 	switch host.SubtypeFromId(catalogId) {
@@ -420,7 +470,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Hos
 		if err != nil {
 			return nil, nil, err
 		}
-		hl, err := repo.ListHosts(ctx, catalogId)
+		hl, err := repo.ListHosts(ctx, catalogId, static.WithLimit(page.Limit()), static.WithStartPageAfterId(page.StartAfterId()))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -432,7 +482,7 @@ func (s Service) listFromRepo(ctx context.Context, catalogId string) ([]host.Hos
 		if err != nil {
 			return nil, nil, err
 		}
-		hl, hlPlg, err := repo.ListHostsByCatalogId(ctx, catalogId)
+		hl, hlPlg, err := repo.ListHostsByCatalogId(ctx, catalogId, plugin.WithLimit(page.Limit()), plugin.WithStartPageAfterId(page.StartAfterId()))
 		if err != nil {
 			return nil, nil, err
 		}
@@ -579,7 +629,10 @@ func toProto(ctx context.Context, in host.Host, opt ...handlers.Option) (*pb.Hos
 	if outputFields.Has(globals.AttributesField) {
 		switch h := in.(type) {
 		case *static.Host:
-			st, err := handlers.ProtoToStruct(&pb.StaticHostAttributes{Address: wrapperspb.String(h.GetAddress())})
+			st, err := handlers.ProtoToStruct(&pb.StaticHostAttributes{
+				Address: wrapperspb.String(h.GetAddress()),
+				Tags:    tagsToProto(h.TagMap()),
+			})
 			if err != nil {
 				return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to convert static attribute to struct: %s", err)
 			}
@@ -657,6 +710,9 @@ func validateCreateRequest(req *pbs.CreateHostRequest) error {
 			default:
 				badFields["attributes.address"] = fmt.Sprintf("Error parsing address: %v.", err)
 			}
+			if msg := validateTags(attrs.GetTags()); msg != "" {
+				badFields["attributes.tags"] = msg
+			}
 		case plugin.Subtype:
 			badFields[globals.HostCatalogIdField] = "Cannot manually create hosts for this type of catalog."
 		}
@@ -684,6 +740,15 @@ func validateUpdateRequest(req *pbs.UpdateHostRequest) error {
 					}
 				}
 			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), "attributes.tags") {
+				attrs := &pb.StaticHostAttributes{}
+				if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+					badFields["attributes"] = "Attribute fields do not match the expected format."
+				}
+				if msg := validateTags(attrs.GetTags()); msg != "" {
+					badFields["attributes.tags"] = msg
+				}
+			}
 		case plugin.Subtype:
 			badFields[globals.IdField] = "Cannot modify this type of host."
 		default:
@@ -717,3 +782,51 @@ func validateListRequest(req *pbs.ListHostsRequest) error {
 	}
 	return nil
 }
+
+// validateTags returns a message describing why tags are invalid, or an
+// empty string if they are valid.
+func validateTags(tags map[string]*structpb.ListValue) string {
+	for k, vs := range tags {
+		if strings.TrimSpace(k) == "" || len(k) > static.MaxHostTagLength {
+			return fmt.Sprintf("Tag keys must not be empty or longer than %d characters.", static.MaxHostTagLength)
+		}
+		if len(vs.GetValues()) == 0 {
+			return fmt.Sprintf("Tag %q must have at least one value.", k)
+		}
+		for _, v := range vs.GetValues() {
+			sv, ok := v.GetKind().(*structpb.Value_StringValue)
+			if !ok {
+				return fmt.Sprintf("The values of tag %q must be strings.", k)
+			}
+			if strings.TrimSpace(sv.StringValue) == "" || len(sv.StringValue) > static.MaxHostTagLength {
+				return fmt.Sprintf("Tag values must not be empty or longer than %d characters.", static.MaxHostTagLength)
+			}
+		}
+	}
+	return ""
+}
+
+func tagsFromProto(tags map[string]*structpb.ListValue) map[string][]string {
+	ret := make(map[string][]string, len(tags))
+	for k, vs := range tags {
+		for _, v := range vs.GetValues() {
+			ret[k] = append(ret[k], v.GetStringValue())
+		}
+	}
+	return ret
+}
+
+func tagsToProto(tags map[string][]string) map[string]*structpb.ListValue {
+	if len(tags) == 0 {
+		return nil
+	}
+	ret := make(map[string]*structpb.ListValue, len(tags))
+	for k, vs := range tags {
+		l := &structpb.ListValue{}
+		for _, v := range vs {
+			l.Values = append(l.Values, structpb.NewStringValue(v))
+		}
+		ret[k] = l
+	}
+	return ret
+}
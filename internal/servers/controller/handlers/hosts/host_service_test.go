@@ -12,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/health"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -64,6 +65,9 @@ func TestGet_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
@@ -113,7 +117,7 @@ func TestGet_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -156,6 +160,9 @@ func TestGet_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := plugin.TestHost(t, conn, hc.GetPublicId(), "test")
 	hs := plugin.TestSet(t, conn, kms, sche, hc, plgm)
@@ -211,7 +218,7 @@ func TestGet_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create a new host service.")
 
 			got, gErr := s.GetHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -250,6 +257,9 @@ func TestList_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hcs := static.TestCatalogs(t, conn, proj.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 
@@ -309,7 +319,7 @@ func TestList_Static(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -320,6 +330,10 @@ func TestList_Static(t *testing.T) {
 				return
 			}
 			require.NoError(gErr)
+			// Items are listed in order of their id.
+			sort.Slice(tc.res.Items, func(i, j int) bool {
+				return tc.res.Items[i].GetId() < tc.res.Items[j].GetId()
+			})
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListHosts(%q) got response %q, wanted %q", tc.req, got, tc.res)
 
 			// Test anonymous listing
@@ -359,6 +373,9 @@ func TestList_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hcs := plugin.TestCatalogs(t, conn, proj.GetPublicId(), plg.GetPublicId(), 2)
 	hc, hcNoHosts := hcs[0], hcs[1]
 	hs := plugin.TestSet(t, conn, kms, sche, hc, plgm)
@@ -430,7 +447,7 @@ func TestList_Plugin(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -481,6 +498,9 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
@@ -488,7 +508,7 @@ func TestDelete(t *testing.T) {
 	pluginHc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	pluginH := plugin.TestHost(t, conn, pluginHc.GetPublicId(), "test")
 
-	s, err := hosts.NewService(repoFn, pluginRepoFn)
+	s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 	require.NoError(t, err, "Couldn't create a new host set service.")
 
 	cases := []struct {
@@ -565,10 +585,13 @@ func TestDelete_twice(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
 
-	s, err := hosts.NewService(repoFn, pluginRepoFn)
+	s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 	require.NoError(err, "Couldn't create a new host set service.")
 	req := &pbs.DeleteHostRequest{
 		Id: h.GetPublicId(),
@@ -602,6 +625,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
 
 	plg := hostplugin.TestPlugin(t, conn, "test")
@@ -738,7 +764,7 @@ func TestCreate(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
-			s, err := hosts.NewService(repoFn, pluginRepoFn)
+			s, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 			require.NoError(err, "Failed to create a new host set service.")
 
 			got, gErr := s.CreateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
@@ -789,6 +815,9 @@ func TestUpdate_Static(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	repo, err := repoFn()
 	require.NoError(t, err, "Couldn't create new static repo.")
 
@@ -813,7 +842,7 @@ func TestUpdate_Static(t *testing.T) {
 		Id: h.GetPublicId(),
 	}
 
-	tested, err := hosts.NewService(repoFn, pluginRepoFn)
+	tested, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 	require.NoError(t, err, "Failed to create a new host set service.")
 
 	cases := []struct {
@@ -1197,12 +1226,15 @@ func TestUpdate_Plugin(t *testing.T) {
 	repoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 
 	plg := hostplugin.TestPlugin(t, conn, "test")
 	hc := plugin.TestCatalog(t, conn, proj.GetPublicId(), plg.GetPublicId())
 	h := plugin.TestHost(t, conn, hc.GetPublicId(), "test")
 
-	tested, err := hosts.NewService(repoFn, pluginRepoFn)
+	tested, err := hosts.NewService(repoFn, pluginRepoFn, healthRepoFn)
 	require.NoError(t, err)
 
 	got, err := tested.UpdateHost(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), &pbs.UpdateHostRequest{
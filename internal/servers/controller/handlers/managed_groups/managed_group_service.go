@@ -3,9 +3,12 @@ package managed_groups
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
+	ldapstore "github.com/hashicorp/boundary/internal/auth/ldap/store"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	oidcstore "github.com/hashicorp/boundary/internal/auth/oidc/store"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -28,10 +31,14 @@ import (
 const (
 	// oidc field names
 	attrFilterField = "attributes.filter"
+
+	// ldap field names
+	attrGroupNamesField = "attributes.group_names"
 )
 
 var (
 	oidcMaskManager handlers.MaskManager
+	ldapMaskManager handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -42,6 +49,12 @@ var (
 			action.Update,
 			action.Delete,
 		},
+		ldap.Subtype: {
+			action.NoOp,
+			action.Read,
+			action.Update,
+			action.Delete,
+		},
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -57,6 +70,9 @@ func init() {
 	if oidcMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&oidcstore.ManagedGroup{}}, handlers.MaskSource{&pb.ManagedGroup{}, &pb.OidcManagedGroupAttributes{}}); err != nil {
 		panic(err)
 	}
+	if ldapMaskManager, err = handlers.NewMaskManager(handlers.MaskDestination{&ldapstore.ManagedGroup{}}, handlers.MaskSource{&pb.ManagedGroup{}, &pb.LdapManagedGroupAttributes{}}); err != nil {
+		panic(err)
+	}
 }
 
 // Service handles request as described by the pbs.ManagedGroupServiceServer interface.
@@ -64,15 +80,19 @@ type Service struct {
 	pbs.UnimplementedManagedGroupServiceServer
 
 	oidcRepoFn common.OidcAuthRepoFactory
+	ldapRepoFn common.LdapAuthRepoFactory
 }
 
 // NewService returns a managed group service which handles managed group related requests to boundary.
-func NewService(oidcRepo common.OidcAuthRepoFactory) (Service, error) {
+func NewService(oidcRepo common.OidcAuthRepoFactory, ldapRepo common.LdapAuthRepoFactory) (Service, error) {
 	const op = "managed_groups.NewService"
 	if oidcRepo == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing oidc repository provided")
 	}
-	return Service{oidcRepoFn: oidcRepo}, nil
+	if ldapRepo == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing ldap repository")
+	}
+	return Service{oidcRepoFn: oidcRepo, ldapRepoFn: ldapRepo}, nil
 }
 
 var _ pbs.ManagedGroupServiceServer = Service{}
@@ -82,58 +102,67 @@ func (s Service) ListManagedGroups(ctx context.Context, req *pbs.ListManagedGrou
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
-	_, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
-	if authResults.Error != nil {
-		return nil, authResults.Error
-	}
-	ul, err := s.listFromRepo(ctx, req.GetAuthMethodId())
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	if len(ul) == 0 {
-		return &pbs.ListManagedGroupsResponse{}, nil
+	_, authResults := s.parentAndAuthResult(ctx, req.GetAuthMethodId(), action.List)
+	if authResults.Error != nil {
+		return nil, authResults.Error
 	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.ManagedGroup, 0, len(ul))
+	var finalItems []*pb.ManagedGroup
 
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
 		Type:    resource.ManagedGroup,
 		Pin:     req.GetAuthMethodId(),
 	}
-	for _, mg := range ul {
-		res.Id = mg.GetPublicId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[auth.SubtypeFromId(mg.GetPublicId())], requestauth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, mg, outputOpts...)
+	for {
+		ul, err := s.listFromRepo(ctx, req.GetAuthMethodId(), page)
 		if err != nil {
 			return nil, err
 		}
+		for _, mg := range ul {
+			if !page.Next(mg.GetPublicId()) {
+				break
+			}
+			res.Id = mg.GetPublicId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, mg.GetPublicId(), IdActions[auth.SubtypeFromId(mg.GetPublicId())], requestauth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		// This comes last so that we can use item fields in the filter after
-		// the allowed fields are populated above
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, mg, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			// This comes last so that we can use item fields in the filter after
+			// the allowed fields are populated above
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(ul)) {
+			break
 		}
 	}
-	return &pbs.ListManagedGroupsResponse{Items: finalItems}, nil
+	return &pbs.ListManagedGroupsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetManagedGroup implements the interface pbs.ManagedGroupServiceServer.
@@ -299,6 +328,29 @@ func (s Service) getFromRepo(ctx context.Context, id string) (auth.ManagedGroup,
 			}
 		}
 		out = mg
+	case ldap.Subtype:
+		repo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, nil, err
+		}
+		mg, err := repo.LookupManagedGroup(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if mg == nil {
+			return nil, nil, handlers.NotFoundErrorf("ManagedGroup %q doesn't exist.", id)
+		}
+		ids, err := repo.ListManagedGroupMembershipsByGroup(ctx, mg.GetPublicId())
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) > 0 {
+			memberIds = make([]string, len(ids))
+			for i, v := range ids {
+				memberIds[i] = v.MemberId
+			}
+		}
+		out = mg
 	default:
 		return nil, nil, handlers.NotFoundErrorf("Unrecognized id.")
 	}
@@ -341,6 +393,42 @@ func (s Service) createOidcInRepo(ctx context.Context, am auth.AuthMethod, item
 	return out, nil
 }
 
+func (s Service) createLdapInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
+	const op = "managed_groups.(Service).createLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing item")
+	}
+	var opts []ldap.Option
+	if item.GetName() != nil {
+		opts = append(opts, ldap.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, ldap.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := &pb.LdapManagedGroupAttributes{}
+	if err := handlers.StructToProto(item.GetAttributes(), attrs); err != nil {
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+			map[string]string{"attributes": "Attribute fields do not match the expected format."})
+	}
+	mg, err := ldap.NewManagedGroup(ctx, am.GetPublicId(), attrs.GetGroupNames(), opts...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build managed group for creation: %v.", err)
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := repo.CreateManagedGroup(ctx, am.GetScopeId(), mg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create managed group"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+	}
+	return out, nil
+}
+
 func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.ManagedGroup) (auth.ManagedGroup, error) {
 	const op = "managed_groups.(Service).createInRepo"
 	if item == nil {
@@ -357,6 +445,15 @@ func (s Service) createInRepo(ctx context.Context, am auth.AuthMethod, item *pb.
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
 		}
 		out = am
+	case ldap.Subtype:
+		am, err := s.createLdapInRepo(ctx, am, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if am == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create managed group but no error returned from repository.")
+		}
+		out = am
 	}
 	return out, nil
 }
@@ -404,6 +501,54 @@ func (s Service) updateOidcInRepo(ctx context.Context, scopeId, amId, id string,
 	return out, nil
 }
 
+func (s Service) updateLdapInRepo(ctx context.Context, scopeId, amId, id string, mask []string, item *pb.ManagedGroup) (*ldap.ManagedGroup, error) {
+	const op = "managed_groups.(Service).updateLdapInRepo"
+	if item == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil managed group.")
+	}
+	mg := ldap.AllocManagedGroup()
+	mg.PublicId = id
+	if item.GetName() != nil {
+		mg.Name = item.GetName().GetValue()
+	}
+	if item.GetDescription() != nil {
+		mg.Description = item.GetDescription().GetValue()
+	}
+	if apiAttr := item.GetAttributes(); apiAttr != nil {
+		attrs := &pb.LdapManagedGroupAttributes{}
+		if err := handlers.StructToProto(apiAttr, attrs); err != nil {
+			return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+				map[string]string{globals.AttributesField: "Attribute fields do not match the expected format."})
+		}
+		// Set this regardless; it'll only take effect if the masks contain the value
+		if len(attrs.GetGroupNames()) > 0 {
+			if err := mg.SetGroupNames(ctx, attrs.GetGroupNames()); err != nil {
+				return nil, handlers.InvalidArgumentErrorf("Error in provided request.",
+					map[string]string{attrGroupNamesField: "Contains an invalid group name."})
+			}
+		}
+	}
+
+	version := item.GetVersion()
+
+	dbMask := ldapMaskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.ldapRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdateManagedGroup(ctx, scopeId, mg, version, dbMask)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update managed group"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Managed Group %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string, req *pbs.UpdateManagedGroupRequest) (auth.ManagedGroup, error) {
 	const op = "managed_groups.(Service).updateInRepo"
 	var out auth.ManagedGroup
@@ -417,6 +562,15 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, authMethodId string,
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
 		}
 		out = mg
+	case ldap.Subtype:
+		mg, err := s.updateLdapInRepo(ctx, scopeId, authMethodId, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if mg == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to update managed group but no error returned from repository.")
+		}
+		out = mg
 	}
 	return out, nil
 }
@@ -432,6 +586,12 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 			return false, iErr
 		}
 		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
+	case ldap.Subtype:
+		repo, iErr := s.ldapRepoFn()
+		if iErr != nil {
+			return false, iErr
+		}
+		rows, err = repo.DeleteManagedGroup(ctx, scopeId, id)
 	}
 	if err != nil {
 		if errors.IsNotFoundError(err) {
@@ -442,7 +602,7 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.ManagedGroup, error) {
+func (s Service) listFromRepo(ctx context.Context, authMethodId string, page *handlers.ListPage) ([]auth.ManagedGroup, error) {
 	const op = "managed_groups.(Service).listFromRepo"
 
 	var outUl []auth.ManagedGroup
@@ -452,13 +612,25 @@ func (s Service) listFromRepo(ctx context.Context, authMethodId string) ([]auth.
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
-		oidcl, err := oidcRepo.ListManagedGroups(ctx, authMethodId)
+		oidcl, err := oidcRepo.ListManagedGroups(ctx, authMethodId, oidc.WithLimit(page.Limit()), oidc.WithStartPageAfterId(page.StartAfterId()))
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op)
 		}
 		for _, a := range oidcl {
 			outUl = append(outUl, a)
 		}
+	case ldap.Subtype:
+		ldapRepo, err := s.ldapRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ldapl, err := ldapRepo.ListManagedGroups(ctx, authMethodId, ldap.WithLimit(page.Limit()), ldap.WithStartPageAfterId(page.StartAfterId()))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, a := range ldapl {
+			outUl = append(outUl, a)
+		}
 	}
 	return outUl, nil
 }
@@ -471,6 +643,11 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		res.Error = err
 		return nil, res
 	}
+	ldapRepo, err := s.ldapRepoFn()
+	if err != nil {
+		res.Error = err
+		return nil, res
+	}
 
 	var parentId string
 	opts := []requestauth.Option{requestauth.WithType(resource.ManagedGroup), requestauth.WithAction(a)}
@@ -490,6 +667,17 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 				return nil, res
 			}
 			parentId = acct.GetAuthMethodId()
+		case ldap.Subtype:
+			mg, err := ldapRepo.LookupManagedGroup(ctx, id)
+			if err != nil {
+				res.Error = err
+				return nil, res
+			}
+			if mg == nil {
+				res.Error = handlers.NotFoundError()
+				return nil, res
+			}
+			parentId = mg.GetAuthMethodId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized managed group subtype")
 			return nil, res
@@ -511,6 +699,18 @@ func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Ty
 		}
 		authMeth = am
 		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
+	case ldap.Subtype:
+		am, err := ldapRepo.LookupAuthMethod(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return nil, res
+		}
+		if am == nil {
+			res.Error = handlers.NotFoundError()
+			return nil, res
+		}
+		authMeth = am
+		opts = append(opts, requestauth.WithScopeId(am.GetScopeId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidPublicId, op, "unrecognized auth method subtype")
 		return nil, res
@@ -573,6 +773,24 @@ func toProto(ctx context.Context, in auth.ManagedGroup, opt ...handlers.Option)
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building oidc attribute struct: %v", err)
 		}
 		out.Attributes = st
+	case *ldap.ManagedGroup:
+		if outputFields.Has(globals.TypeField) {
+			out.Type = ldap.Subtype.String()
+		}
+		if !outputFields.Has(globals.AttributesField) {
+			break
+		}
+		names, err := i.GetGroupNameList(ctx)
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed reading ldap group names: %v", err)
+		}
+		st, err := handlers.ProtoToStruct(&pb.LdapManagedGroupAttributes{
+			GroupNames: names,
+		})
+		if err != nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "failed building ldap attribute struct: %v", err)
+		}
+		out.Attributes = st
 	}
 	return &out, nil
 }
@@ -587,7 +805,7 @@ func validateGetRequest(req *pbs.GetManagedGroupRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix)
 }
 
 func validateCreateRequest(req *pbs.CreateManagedGroupRequest) error {
@@ -616,6 +834,15 @@ func validateCreateRequest(req *pbs.CreateManagedGroupRequest) error {
 					badFields[attrFilterField] = fmt.Sprintf("Error evaluating submitted filter expression: %v.", err)
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[globals.TypeField] = "Doesn't match the parent resource's type."
+			}
+			attrs := &pb.LdapManagedGroupAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+			}
+			validateLdapGroupNames(attrs.GetGroupNames(), badFields)
 		default:
 			badFields[globals.AuthMethodIdField] = "Unknown auth method type from ID."
 		}
@@ -648,11 +875,22 @@ func validateUpdateRequest(req *pbs.UpdateManagedGroupRequest) error {
 					}
 				}
 			}
+		case ldap.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != ldap.Subtype.String() {
+				badFields[globals.TypeField] = "Cannot modify the resource type."
+			}
+			attrs := &pb.LdapManagedGroupAttributes{}
+			if err := handlers.StructToProto(req.GetItem().GetAttributes(), attrs); err != nil {
+				badFields[globals.AttributesField] = "Attribute fields do not match the expected format."
+			}
+			if handlers.MaskContains(req.GetUpdateMask().GetPaths(), attrGroupNamesField) {
+				validateLdapGroupNames(attrs.GetGroupNames(), badFields)
+			}
 		default:
 			badFields[globals.IdField] = "Unrecognized resource type."
 		}
 		return badFields
-	}, intglobals.OidcManagedGroupPrefix)
+	}, intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteManagedGroupRequest) error {
@@ -660,7 +898,7 @@ func validateDeleteRequest(req *pbs.DeleteManagedGroupRequest) error {
 	if req == nil {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix)
 }
 
 func validateListRequest(req *pbs.ListManagedGroupsRequest) error {
@@ -669,7 +907,7 @@ func validateListRequest(req *pbs.ListManagedGroupsRequest) error {
 		return errors.NewDeprecated(errors.InvalidParameter, op, "nil request")
 	}
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), oidc.AuthMethodPrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetAuthMethodId()), oidc.AuthMethodPrefix, ldap.AuthMethodPrefix) {
 		badFields[globals.AuthMethodIdField] = "Invalid formatted identifier."
 	}
 	if _, err := handlers.NewFilter(req.GetFilter()); err != nil {
@@ -680,3 +918,18 @@ func validateListRequest(req *pbs.ListManagedGroupsRequest) error {
 	}
 	return nil
 }
+
+// validateLdapGroupNames ensures at least one group name was provided and that
+// none of them are empty.
+func validateLdapGroupNames(names []string, badFields map[string]string) {
+	if len(names) == 0 {
+		badFields[attrGroupNamesField] = "This field is required."
+		return
+	}
+	for _, n := range names {
+		if strings.TrimSpace(n) == "" {
+			badFields[attrGroupNamesField] = "Group names cannot be empty."
+			return
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
@@ -73,6 +74,49 @@ func TestValidateCreateRequest(t *testing.T) {
 				}},
 			},
 		},
+		{
+			name: "mismatched ldap authmethod oidc type",
+			item: &pb.ManagedGroup{
+				Type:         oidc.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+			},
+			errContains: fieldError(globals.TypeField, "Doesn't match the parent resource's type."),
+		},
+		{
+			name: "missing ldap attributes",
+			item: &pb.ManagedGroup{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes:   &structpb.Struct{Fields: map[string]*structpb.Value{}},
+			},
+			errContains: fieldError(attrGroupNamesField, "This field is required."),
+		},
+		{
+			name: "empty ldap group name",
+			item: &pb.ManagedGroup{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"group_names": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+						structpb.NewStringValue("admins"),
+						structpb.NewStringValue(" "),
+					}}),
+				}},
+			},
+			errContains: fieldError(attrGroupNamesField, "Group names cannot be empty."),
+		},
+		{
+			name: "no ldap errors",
+			item: &pb.ManagedGroup{
+				Type:         ldap.Subtype.String(),
+				AuthMethodId: ldap.AuthMethodPrefix + "_1234567890",
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"group_names": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+						structpb.NewStringValue("admins"),
+					}}),
+				}},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -118,6 +162,17 @@ func TestValidateUpdateRequest(t *testing.T) {
 			},
 			errContains: fieldError(globals.AttributesField, "Attribute fields do not match the expected format."),
 		},
+		{
+			name: "ldap unset group names",
+			req: &pbs.UpdateManagedGroupRequest{
+				Id:         intglobals.LdapManagedGroupPrefix + "_1234567890",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{attrGroupNamesField}},
+				Item: &pb.ManagedGroup{
+					Version: 1,
+				},
+			},
+			errContains: fieldError(attrGroupNamesField, "This field is required."),
+		},
 		{
 			name: "no error",
 			req: &pbs.UpdateManagedGroupRequest{
@@ -33,6 +33,7 @@ type options struct {
 	WithManagedGroupIds             []string
 	WithMemberIds                   []string
 	WithHostSetIds                  []string
+	WithDisabled                    bool
 }
 
 func getDefaultOptions() options {
@@ -119,3 +120,11 @@ func WithHostSetIds(ids []string) Option {
 		o.WithHostSetIds = ids
 	}
 }
+
+// WithDisabled provides an option when creating responses to include whether
+// the resource is disabled if allowed
+func WithDisabled(disabled bool) Option {
+	return func(o *options) {
+		o.WithDisabled = disabled
+	}
+}
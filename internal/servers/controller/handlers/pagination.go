@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// DefaultPageSize is the number of items returned by a list request which
+	// does not set a page size.
+	DefaultPageSize = 1000
+
+	// MaxPageSize is the largest page size a list request can set.
+	MaxPageSize = 10000
+)
+
+const (
+	pageSizeField  = "page_size"
+	listTokenField = "list_token"
+)
+
+// listToken is the content of the opaque list token returned with a page of
+// items. Items are listed in order of their public id, so the id of the last
+// item considered for a page is enough to resume listing after it.
+type listToken struct {
+	StartAfterId string `json:"a"`
+	// Filter is a hash of the filter of the request the token was returned
+	// for, so the token cannot be used with another filter.
+	Filter string `json:"f,omitempty"`
+}
+
+// ListPage tracks the page of items being built for a list request. Items
+// are read from the repositories in batches, in order of their public id,
+// and a batch can contain items which are not returned because the caller is
+// not authorized to see them or because they do not match the request's
+// filter. A handler reads batches until the page is full or there are no
+// more items:
+//
+//	for {
+//		items, err := repo.List(..., WithLimit(page.Limit()), WithStartPageAfterId(page.StartAfterId()))
+//		for _, item := range items {
+//			if !page.Next(item.GetPublicId()) {
+//				break
+//			}
+//			// authorize, convert and filter the item, then call page.Add
+//		}
+//		if page.Done(len(items)) {
+//			break
+//		}
+//	}
+//
+// The list token of the response is returned by ListToken.
+type ListPage struct {
+	size         int
+	filter       string
+	startAfterId string
+	count        int
+	more         bool
+}
+
+// NewListPage returns the ListPage for a list request with the given page
+// size, list token and filter. An error suitable for returning to the caller
+// is returned if the page size or list token are invalid.
+func NewListPage(pageSize uint32, token, filter string) (*ListPage, error) {
+	p := &ListPage{
+		size:   int(pageSize),
+		filter: filterHash(filter),
+	}
+	switch {
+	case pageSize == 0:
+		p.size = DefaultPageSize
+	case pageSize > MaxPageSize:
+		return nil, InvalidArgumentErrorf("Invalid page size.", map[string]string{pageSizeField: fmt.Sprintf("Must be at most %d.", MaxPageSize)})
+	}
+	if token == "" {
+		return p, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, InvalidArgumentErrorf("Invalid list token.", map[string]string{listTokenField: "Not a valid list token."})
+	}
+	var t listToken
+	if err := json.Unmarshal(b, &t); err != nil || t.StartAfterId == "" {
+		return nil, InvalidArgumentErrorf("Invalid list token.", map[string]string{listTokenField: "Not a valid list token."})
+	}
+	if t.Filter != p.filter {
+		return nil, InvalidArgumentErrorf("Invalid list token.", map[string]string{listTokenField: "The list token was returned for a request with a different filter."})
+	}
+	p.startAfterId = t.StartAfterId
+	return p, nil
+}
+
+// Limit returns the number of items to read from the repository in a batch.
+func (p *ListPage) Limit() int {
+	return p.size
+}
+
+// StartAfterId returns the public id the next batch of items read from the
+// repository must start after. It is empty for the first batch of the first
+// page.
+func (p *ListPage) StartAfterId() string {
+	return p.startAfterId
+}
+
+// Next records that the item with the given public id is being considered
+// for the page. It returns false if the page is already full, in which case
+// the item is left for the next page.
+func (p *ListPage) Next(id string) bool {
+	if p.count >= p.size {
+		p.more = true
+		return false
+	}
+	p.startAfterId = id
+	return true
+}
+
+// Add records that the item last passed to Next was added to the page.
+func (p *ListPage) Add() {
+	p.count++
+}
+
+// Done is called after each batch of n items read from the repository. It
+// returns true if the page is complete and no more batches should be read.
+func (p *ListPage) Done(n int) bool {
+	return p.more || n < p.Limit()
+}
+
+// ListToken returns the list token to return with the page. It is empty if
+// there are no items after the page.
+func (p *ListPage) ListToken() string {
+	if !p.more {
+		return ""
+	}
+	// Marshaling a struct of strings cannot fail.
+	b, _ := json.Marshal(listToken{StartAfterId: p.startAfterId, Filter: p.filter})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func filterHash(filter string) string {
+	if filter == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(filter))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListPage(t *testing.T) {
+	t.Run("default-page-size", func(t *testing.T) {
+		p, err := NewListPage(0, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultPageSize, p.Limit())
+		assert.Empty(t, p.StartAfterId())
+	})
+	t.Run("page-size", func(t *testing.T) {
+		p, err := NewListPage(10, "", "")
+		require.NoError(t, err)
+		assert.Equal(t, 10, p.Limit())
+	})
+	t.Run("page-size-too-large", func(t *testing.T) {
+		_, err := NewListPage(MaxPageSize+1, "", "")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, InvalidArgumentErrorf("", nil))
+	})
+	t.Run("invalid-token", func(t *testing.T) {
+		for _, token := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+			_, err := NewListPage(0, token, "")
+			require.Error(t, err, token)
+			assert.ErrorIs(t, err, InvalidArgumentErrorf("", nil), token)
+		}
+	})
+	t.Run("token-for-other-filter", func(t *testing.T) {
+		p, err := NewListPage(1, "", `"/item/name"=="foo"`)
+		require.NoError(t, err)
+		require.True(t, p.Next("id_1"))
+		p.Add()
+		require.False(t, p.Next("id_2"))
+		token := p.ListToken()
+		require.NotEmpty(t, token)
+
+		_, err = NewListPage(1, token, `"/item/name"=="bar"`)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, InvalidArgumentErrorf("", nil))
+
+		p, err = NewListPage(1, token, `"/item/name"=="foo"`)
+		require.NoError(t, err)
+		assert.Equal(t, "id_1", p.StartAfterId())
+	})
+}
+
+func TestListPage(t *testing.T) {
+	// ids 00 to 24, of which the odd ones match.
+	var ids []string
+	for i := 0; i < 25; i++ {
+		ids = append(ids, fmt.Sprintf("id_%02d", i))
+	}
+	list := func(limit int, after string) []string {
+		var out []string
+		for _, id := range ids {
+			if id > after && len(out) < limit {
+				out = append(out, id)
+			}
+		}
+		return out
+	}
+	match := func(id string) bool {
+		var n int
+		fmt.Sscanf(id, "id_%d", &n)
+		return n%2 == 1
+	}
+
+	var pages [][]string
+	var token string
+	for {
+		p, err := NewListPage(5, token, "odd")
+		require.NoError(t, err)
+		var page []string
+		for {
+			items := list(p.Limit(), p.StartAfterId())
+			for _, id := range items {
+				if !p.Next(id) {
+					break
+				}
+				if match(id) {
+					page = append(page, id)
+					p.Add()
+				}
+			}
+			if p.Done(len(items)) {
+				break
+			}
+		}
+		pages = append(pages, page)
+		token = p.ListToken()
+		if token == "" {
+			break
+		}
+	}
+	assert.Equal(t, [][]string{
+		{"id_01", "id_03", "id_05", "id_07", "id_09"},
+		{"id_11", "id_13", "id_15", "id_17", "id_19"},
+		{"id_21", "id_23"},
+	}, pages)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
@@ -22,6 +23,7 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/roles"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -81,6 +83,10 @@ func (s Service) ListRoles(ctx context.Context, req *pbs.ListRolesRequest) (*pbs
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -105,50 +111,57 @@ func (s Service) ListRoles(ctx context.Context, req *pbs.ListRolesRequest) (*pbs
 		return &pbs.ListRolesResponse{}, nil
 	}
 
-	items, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(items) == 0 {
-		return &pbs.ListRolesResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Role, 0, len(items))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("name", "scope_id")
+	var finalItems []*pb.Role
 	res := perms.Resource{
 		Type: resource.Role,
 	}
-	for _, item := range items {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, nil, outputOpts...)
+	for {
+		items, err := s.listFromRepo(ctx, scopeIds, page, conds)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range items {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			item, err := toProto(ctx, item, nil, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(items)) {
+			break
 		}
 	}
-	return &pbs.ListRolesResponse{Items: finalItems}, nil
+	return &pbs.ListRolesResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetRoles implements the interface pbs.RoleServiceServer.
@@ -292,7 +305,7 @@ func (s Service) AddRolePrincipals(ctx context.Context, req *pbs.AddRolePrincipa
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.addPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion())
+	r, prs, rgs, err := s.addPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion(), principalRoleOpts(req.GetNotBefore(), req.GetNotAfter(), req.GetCondition())...)
 	if err != nil {
 		return nil, err
 	}
@@ -330,7 +343,7 @@ func (s Service) SetRolePrincipals(ctx context.Context, req *pbs.SetRolePrincipa
 	if authResults.Error != nil {
 		return nil, authResults.Error
 	}
-	r, prs, rgs, err := s.setPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion())
+	r, prs, rgs, err := s.setPrinciplesInRepo(ctx, req.GetId(), req.GetPrincipalIds(), req.GetVersion(), principalRoleOpts(req.GetNotBefore(), req.GetNotAfter(), req.GetCondition())...)
 	if err != nil {
 		return nil, err
 	}
@@ -610,25 +623,25 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Role, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*iam.Role, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	rl, err := repo.ListRoles(ctx, scopeIds)
+	rl, err := repo.ListRoles(ctx, scopeIds, iam.WithLimit(page.Limit()), iam.WithStartPageAfterId(page.StartAfterId()), iam.WithFilterConditions(conds))
 	if err != nil {
 		return nil, err
 	}
 	return rl, nil
 }
 
-func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
+func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32, opt ...iam.Option) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).addPrincpleInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, err = repo.AddPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false))
+	_, err = repo.AddPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false), opt...)
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add principals to role: %v.", err)
@@ -643,13 +656,13 @@ func (s Service) addPrinciplesInRepo(ctx context.Context, roleId string, princip
 	return out, pr, roleGrants, nil
 }
 
-func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
+func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32, opt ...iam.Option) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).setPrinciplesInRepo"
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	_, _, err = repo.SetPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false))
+	_, _, err = repo.SetPrincipalRoles(ctx, roleId, version, strutil.RemoveDuplicates(principalIds, false), opt...)
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set principals on role: %v.", err)
@@ -664,6 +677,22 @@ func (s Service) setPrinciplesInRepo(ctx context.Context, roleId string, princip
 	return out, pr, roleGrants, nil
 }
 
+// principalRoleOpts returns the options bounding the principal roles to add
+// from the fields of add and set principals requests.
+func principalRoleOpts(notBefore, notAfter *timestamppb.Timestamp, condition string) []iam.Option {
+	var opts []iam.Option
+	if notBefore != nil {
+		opts = append(opts, iam.WithNotBefore(notBefore.AsTime()))
+	}
+	if notAfter != nil {
+		opts = append(opts, iam.WithNotAfter(notAfter.AsTime()))
+	}
+	if condition != "" {
+		opts = append(opts, iam.WithCondition(condition))
+	}
+	return opts
+}
+
 func (s Service) removePrinciplesInRepo(ctx context.Context, roleId string, principalIds []string, version uint32) (*iam.Role, []iam.PrincipalRole, []*iam.RoleGrant, error) {
 	const op = "roles.(Service).removePrinciplesInRepo"
 	repo, err := s.repoFn()
@@ -837,9 +866,12 @@ func toProto(ctx context.Context, in *iam.Role, principals []iam.PrincipalRole,
 	if outputFields.Has(globals.PrincipalsField) {
 		for _, p := range principals {
 			principal := &pb.Principal{
-				Id:      p.GetPrincipalId(),
-				Type:    p.GetType(),
-				ScopeId: p.GetPrincipalScopeId(),
+				Id:        p.GetPrincipalId(),
+				Type:      p.GetType(),
+				ScopeId:   p.GetPrincipalScopeId(),
+				NotBefore: p.GetNotBefore().GetTimestamp(),
+				NotAfter:  p.GetNotAfter().GetTimestamp(),
+				Condition: p.GetCondition(),
 			}
 			out.Principals = append(out.Principals, principal)
 		}
@@ -862,14 +894,23 @@ func toProto(ctx context.Context, in *iam.Role, principals []iam.PrincipalRole,
 				})
 			} else {
 				_, actions := parsed.Actions()
+				grantJson := &pb.GrantJson{
+					Id:        parsed.Id(),
+					Type:      parsed.Type().String(),
+					Actions:   actions,
+					Condition: parsed.Condition(),
+					Effect:    string(parsed.Effect()),
+				}
+				if !parsed.NotBefore().IsZero() {
+					grantJson.NotBefore = timestamppb.New(parsed.NotBefore())
+				}
+				if !parsed.NotAfter().IsZero() {
+					grantJson.NotAfter = timestamppb.New(parsed.NotAfter())
+				}
 				out.Grants = append(out.Grants, &pb.Grant{
 					Raw:       g.GetRawGrant(),
 					Canonical: g.GetCanonicalGrant(),
-					Json: &pb.GrantJson{
-						Id:      parsed.Id(),
-						Type:    parsed.Type().String(),
-						Actions: actions,
-					},
+					Json:      grantJson,
 				})
 			}
 		}
@@ -971,7 +1012,7 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -980,6 +1021,7 @@ func validateAddRolePrincipalsRequest(req *pbs.AddRolePrincipalsRequest) error {
 			break
 		}
 	}
+	validatePrincipalRoleConstraints(badFields, req.GetNotBefore(), req.GetNotAfter(), req.GetCondition())
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
@@ -997,7 +1039,7 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -1006,12 +1048,26 @@ func validateSetRolePrincipalsRequest(req *pbs.SetRolePrincipalsRequest) error {
 			break
 		}
 	}
+	validatePrincipalRoleConstraints(badFields, req.GetNotBefore(), req.GetNotAfter(), req.GetCondition())
 	if len(badFields) > 0 {
 		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
 	}
 	return nil
 }
 
+// validatePrincipalRoleConstraints records in badFields any issue with the
+// time bounds and condition of add and set principals requests.
+func validatePrincipalRoleConstraints(badFields map[string]string, notBefore, notAfter *timestamppb.Timestamp, condition string) {
+	if notBefore != nil && notAfter != nil && !notBefore.AsTime().Before(notAfter.AsTime()) {
+		badFields["not_after"] = "Must be after not_before."
+	}
+	if condition != "" {
+		if err := perms.ValidateCondition(condition); err != nil {
+			badFields["condition"] = fmt.Sprintf("Improperly formatted condition %q.", condition)
+		}
+	}
+}
+
 func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), iam.RolePrefix) {
@@ -1026,7 +1082,7 @@ func validateRemoveRolePrincipalsRequest(req *pbs.RemoveRolePrincipalsRequest) e
 	for _, id := range req.GetPrincipalIds() {
 		if !handlers.ValidId(handlers.Id(id), iam.GroupPrefix) &&
 			!handlers.ValidId(handlers.Id(id), iam.UserPrefix) &&
-			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix) {
+			!handlers.ValidId(handlers.Id(id), intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix) {
 			badFields["principal_ids"] = "Must only have valid user, group, and/or managed group ids."
 			break
 		}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
@@ -305,6 +307,10 @@ func TestList(t *testing.T) {
 				return
 			}
 			require.NoError(gErr)
+			// Items are listed in order of their id.
+			sort.Slice(tc.res.Items, func(i, j int) bool {
+				return tc.res.Items[i].GetId() < tc.res.Items[j].GetId()
+			})
 			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "ListRoles(%q) got response %q, wanted %q", tc.req, got, tc.res)
 
 			// Test the anon case
@@ -577,6 +583,7 @@ func TestUpdate(t *testing.T) {
 			Id:      g.Id(),
 			Type:    g.Type().String(),
 			Actions: actions,
+			Effect:  string(g.Effect()),
 		},
 	}
 	conn, _ := db.TestSetup(t, "postgres")
@@ -1156,6 +1163,29 @@ func TestAddPrincipal(t *testing.T) {
 		}
 	}
 
+	t.Run("Add bounded user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := iam.TestRole(t, conn, p.GetPublicId())
+		notBefore := timestamppb.New(time.Now().Truncate(time.Second))
+		notAfter := timestamppb.New(notBefore.AsTime().Add(time.Hour))
+		condition := `"10.0.0.0/8" in "/request/client_networks"`
+		req := &pbs.AddRolePrincipalsRequest{
+			Id:           role.GetPublicId(),
+			Version:      role.GetVersion(),
+			PrincipalIds: []string{users[0].GetPublicId()},
+			NotBefore:    notBefore,
+			NotAfter:     notAfter,
+			Condition:    condition,
+		}
+		got, err := s.AddRolePrincipals(auth.DisabledAuthTestContext(repoFn, p.GetPublicId()), req)
+		require.NoError(err)
+		require.Len(got.GetItem().GetPrincipals(), 1)
+		principal := got.GetItem().GetPrincipals()[0]
+		assert.True(notBefore.AsTime().Equal(principal.GetNotBefore().AsTime()))
+		assert.True(notAfter.AsTime().Equal(principal.GetNotAfter().AsTime()))
+		assert.Equal(condition, principal.GetCondition())
+	})
+
 	role := iam.TestRole(t, conn, p.GetPublicId())
 
 	failCases := []struct {
@@ -1189,6 +1219,27 @@ func TestAddPrincipal(t *testing.T) {
 			},
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Not After Before Not Before",
+			req: &pbs.AddRolePrincipalsRequest{
+				Id:           role.GetPublicId(),
+				Version:      role.GetVersion(),
+				PrincipalIds: []string{users[0].GetPublicId()},
+				NotBefore:    timestamppb.New(time.Now().Add(time.Hour)),
+				NotAfter:     timestamppb.Now(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Bad Condition",
+			req: &pbs.AddRolePrincipalsRequest{
+				Id:           role.GetPublicId(),
+				Version:      role.GetVersion(),
+				PrincipalIds: []string{users[0].GetPublicId()},
+				Condition:    `"/request/client_ip" ==`,
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 	}
 	for _, tc := range failCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,327 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+// group is the SCIM representation of a group. The displayName is stored as
+// the name of the group.
+type group struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Members     []member `json:"members,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type member struct {
+	Value string `json:"value"`
+	Ref   string `json:"$ref,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+func toGroup(g *iam.Group, members []*iam.GroupMember) *group {
+	out := &group{
+		Schemas:     []string{schemaGroup},
+		Id:          g.GetPublicId(),
+		DisplayName: g.GetName(),
+		Meta: newMeta("Groups", g.GetPublicId(),
+			g.GetCreateTime().GetTimestamp().AsTime(),
+			g.GetUpdateTime().GetTimestamp().AsTime(),
+			g.GetVersion()),
+	}
+	for _, m := range members {
+		out.Members = append(out.Members, member{
+			Value: m.GetMemberId(),
+			Ref:   PathPrefix + "Users/" + m.GetMemberId(),
+			Type:  "User",
+		})
+	}
+	return out
+}
+
+func (h *Handler) serveGroups(ctx context.Context, r *http.Request, orgId, id string) (interface{}, int, error) {
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		return h.listGroups(ctx, r, orgId)
+	case id == "" && r.Method == http.MethodPost:
+		return h.createGroup(ctx, r, orgId)
+	case id == "":
+		return nil, 0, errMethodNotAllowed
+	}
+
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	g, members, err := repo.LookupGroup(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if g == nil || g.GetScopeId() != orgId {
+		return nil, 0, errNotFound
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return toGroup(g, members), http.StatusOK, nil
+	case http.MethodPut:
+		return h.replaceGroup(ctx, r, g)
+	case http.MethodPatch:
+		return h.patchGroup(ctx, r, g, members)
+	case http.MethodDelete:
+		if _, err := repo.DeleteGroup(ctx, g.GetPublicId()); err != nil {
+			return nil, 0, err
+		}
+		return nil, http.StatusNoContent, nil
+	default:
+		return nil, 0, errMethodNotAllowed
+	}
+}
+
+func (h *Handler) listGroups(ctx context.Context, r *http.Request, orgId string) (interface{}, int, error) {
+	filter, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, 0, err
+	}
+	if filter != nil && filter.attribute != "displayname" {
+		return nil, 0, badRequest("invalidFilter", "Groups can only be filtered by displayName.")
+	}
+	excludeMembers := strings.Contains(strings.ToLower(r.URL.Query().Get("excludedAttributes")), "members")
+
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	groups, err := repo.ListGroups(ctx, []string{orgId}, iam.WithLimit(-1))
+	if err != nil {
+		return nil, 0, err
+	}
+	resources := make([]interface{}, 0, len(groups))
+	for _, g := range groups {
+		if filter != nil && !strings.EqualFold(g.GetName(), filter.value) {
+			continue
+		}
+		var members []*iam.GroupMember
+		if !excludeMembers {
+			if members, err = repo.ListGroupMembers(ctx, g.GetPublicId(), iam.WithLimit(-1)); err != nil {
+				return nil, 0, err
+			}
+		}
+		resources = append(resources, toGroup(g, members))
+	}
+	res, err := newListResponse(r, resources)
+	if err != nil {
+		return nil, 0, err
+	}
+	return res, http.StatusOK, nil
+}
+
+func (h *Handler) createGroup(ctx context.Context, r *http.Request, orgId string) (interface{}, int, error) {
+	const op = "scim.(Handler).createGroup"
+	var in group
+	if err := decodeBody(r, &in); err != nil {
+		return nil, 0, err
+	}
+	if in.DisplayName == "" {
+		return nil, 0, badRequest("invalidValue", "Missing displayName.")
+	}
+	userIds, err := h.memberUserIds(ctx, orgId, in.Members)
+	if err != nil {
+		return nil, 0, err
+	}
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	g, err := iam.NewGroup(orgId, iam.WithName(in.DisplayName))
+	if err != nil {
+		return nil, 0, err
+	}
+	g, err = repo.CreateGroup(ctx, g)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(userIds) > 0 {
+		if _, err := repo.AddGroupMembers(ctx, g.GetPublicId(), g.GetVersion(), userIds); err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+	}
+	return h.lookupGroup(ctx, g.GetPublicId(), http.StatusCreated)
+}
+
+func (h *Handler) replaceGroup(ctx context.Context, r *http.Request, g *iam.Group) (interface{}, int, error) {
+	var in group
+	if err := decodeBody(r, &in); err != nil {
+		return nil, 0, err
+	}
+	if in.DisplayName == "" {
+		return nil, 0, badRequest("invalidValue", "Missing displayName.")
+	}
+	userIds, err := h.memberUserIds(ctx, g.GetScopeId(), in.Members)
+	if err != nil {
+		return nil, 0, err
+	}
+	return h.updateGroup(ctx, g, in.DisplayName, userIds)
+}
+
+func (h *Handler) patchGroup(ctx context.Context, r *http.Request, g *iam.Group, currentMembers []*iam.GroupMember) (interface{}, int, error) {
+	ops, err := decodePatch(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	displayName := g.GetName()
+	members := make(map[string]bool, len(currentMembers))
+	for _, m := range currentMembers {
+		members[m.GetMemberId()] = true
+	}
+
+	apply := func(op, path string, value json.RawMessage) error {
+		lowerPath := strings.ToLower(path)
+		switch {
+		case lowerPath == "displayname":
+			if op == "remove" {
+				return badRequest("mutability", "displayName cannot be removed.")
+			}
+			return unmarshalValue(value, &displayName)
+
+		case lowerPath == "members":
+			var in []member
+			if op != "remove" || len(value) > 0 {
+				if err := unmarshalValue(value, &in); err != nil {
+					return err
+				}
+			}
+			switch op {
+			case "replace":
+				members = make(map[string]bool, len(in))
+			case "remove":
+				if len(in) == 0 {
+					members = map[string]bool{}
+				}
+			}
+			for _, m := range in {
+				members[m.Value] = op != "remove"
+			}
+			return nil
+
+		case strings.HasPrefix(lowerPath, "members[") && op == "remove":
+			// Only filters of the form members[value eq "u_1234567890"] are
+			// supported.
+			filter, err := parseFilter(strings.TrimSuffix(path[len("members["):], "]"))
+			if err != nil || filter == nil || filter.attribute != "value" || !strings.HasSuffix(path, "]") {
+				return badRequest("invalidPath", "Unsupported path %q.", path)
+			}
+			members[filter.value] = false
+			return nil
+
+		default:
+			return badRequest("invalidPath", "Unsupported path %q.", path)
+		}
+	}
+	for _, o := range ops {
+		if o.Path != "" {
+			if err := apply(o.Op, o.Path, o.Value); err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+		if o.Op == "remove" {
+			return nil, 0, badRequest("noTarget", "A path is required to remove attributes.")
+		}
+		var attrs map[string]json.RawMessage
+		if err := unmarshalValue(o.Value, &attrs); err != nil {
+			return nil, 0, err
+		}
+		for path, value := range attrs {
+			if err := apply(o.Op, path, value); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+
+	var in []member
+	for id, isMember := range members {
+		if isMember {
+			in = append(in, member{Value: id})
+		}
+	}
+	sort.Slice(in, func(i, j int) bool { return in[i].Value < in[j].Value })
+	userIds, err := h.memberUserIds(ctx, g.GetScopeId(), in)
+	if err != nil {
+		return nil, 0, err
+	}
+	return h.updateGroup(ctx, g, displayName, userIds)
+}
+
+// updateGroup sets the name and members of the group.
+func (h *Handler) updateGroup(ctx context.Context, g *iam.Group, displayName string, userIds []string) (interface{}, int, error) {
+	const op = "scim.(Handler).updateGroup"
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	version := g.GetVersion()
+	if displayName != g.GetName() {
+		updated := g.Clone().(*iam.Group)
+		updated.Name = displayName
+		g, _, _, err = repo.UpdateGroup(ctx, updated, version, []string{"Name"})
+		if err != nil {
+			return nil, 0, errors.Wrap(ctx, err, op)
+		}
+		version = g.GetVersion()
+	}
+	if _, _, err := repo.SetGroupMembers(ctx, g.GetPublicId(), version, userIds); err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return h.lookupGroup(ctx, g.GetPublicId(), http.StatusOK)
+}
+
+func (h *Handler) lookupGroup(ctx context.Context, groupId string, status int) (interface{}, int, error) {
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	g, members, err := repo.LookupGroup(ctx, groupId)
+	if err != nil {
+		return nil, 0, err
+	}
+	if g == nil {
+		return nil, 0, errNotFound
+	}
+	return toGroup(g, members), status, nil
+}
+
+// memberUserIds returns the IDs of the members, which must be users of the org.
+func (h *Handler) memberUserIds(ctx context.Context, orgId string, members []member) ([]string, error) {
+	if len(members) == 0 {
+		return nil, nil
+	}
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	userIds := make([]string, 0, len(members))
+	for i, m := range members {
+		if m.Value == "" {
+			return nil, badRequest("invalidValue", "Missing value of member %d.", i)
+		}
+		u, _, err := repo.LookupUser(ctx, m.Value)
+		if err != nil {
+			return nil, err
+		}
+		if u == nil || u.GetScopeId() != orgId {
+			return nil, badRequest("invalidValue", "Member %q is not a user of the org.", m.Value)
+		}
+		userIds = append(userIds, m.Value)
+	}
+	return userIds, nil
+}
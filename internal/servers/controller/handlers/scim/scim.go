@@ -0,0 +1,373 @@
+// Package scim provides a SCIM 2.0 server allowing identity providers to
+// provision the users and groups of orgs.
+//
+// Each org is provisioned using a dedicated bearer token, and requests can
+// only read and modify the users and groups of the org of their token.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+const (
+	// PathPrefix is the path under which the SCIM server is mounted.
+	PathPrefix = "/scim/v2/"
+
+	contentType = "application/scim+json"
+
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// Handler is an http.Handler serving the SCIM 2.0 Users and Groups endpoints.
+type Handler struct {
+	iamRepoFn common.IamRepoFactory
+
+	// orgIds maps the bearer tokens to the IDs of the orgs they provision.
+	orgIds map[string]string
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler returns a SCIM handler for the orgs of orgIds, which maps the
+// bearer token of each org to its ID. The access of de-provisioned users is
+// revoked by the iam repository.
+func NewHandler(ctx context.Context, iamRepoFn common.IamRepoFactory, orgIds map[string]string) (*Handler, error) {
+	const op = "scim.NewHandler"
+	if iamRepoFn == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	for token, orgId := range orgIds {
+		if token == "" {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing bearer token")
+		}
+		if !strings.HasPrefix(orgId, scope.Org.Prefix()+"_") {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%q is not an org id", orgId))
+		}
+	}
+	return &Handler{
+		iamRepoFn: iamRepoFn,
+		orgIds:    orgIds,
+	}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	orgId, ok := h.authenticate(r)
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+		writeError(ctx, w, &apiError{status: http.StatusUnauthorized, detail: "Missing or invalid bearer token."})
+		return
+	}
+	if max, ok := ctx.Value(globals.ContextMaxRequestSizeTypeKey).(int64); ok && max > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, max)
+	}
+
+	resourceType, id := splitPath(strings.TrimPrefix(r.URL.Path, PathPrefix))
+	var res interface{}
+	var status int
+	var err error
+	switch resourceType {
+	case "Users":
+		res, status, err = h.serveUsers(ctx, r, orgId, id)
+	case "Groups":
+		res, status, err = h.serveGroups(ctx, r, orgId, id)
+	case "ServiceProviderConfig":
+		if id != "" || r.Method != http.MethodGet {
+			err = errNotFound
+			break
+		}
+		res, status = serviceProviderConfig(), http.StatusOK
+	default:
+		err = errNotFound
+	}
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+	writeResponse(ctx, w, status, res)
+}
+
+// authenticate returns the ID of the org provisioned by the bearer token of the
+// request. Every token is compared in constant time.
+func (h *Handler) authenticate(r *http.Request) (string, bool) {
+	authHeader := strings.TrimSpace(r.Header.Get("Authorization"))
+	headerSplit := strings.SplitN(authHeader, " ", 2)
+	if len(headerSplit) != 2 || !strings.EqualFold(headerSplit[0], "bearer") {
+		return "", false
+	}
+	received := []byte(strings.TrimSpace(headerSplit[1]))
+	var orgId string
+	for token, id := range h.orgIds {
+		if subtle.ConstantTimeCompare(received, []byte(token)) == 1 {
+			orgId = id
+		}
+	}
+	return orgId, orgId != ""
+}
+
+// splitPath splits a path relative to PathPrefix into a resource type and an
+// optional resource ID.
+func splitPath(p string) (string, string) {
+	p = strings.Trim(p, "/")
+	i := strings.Index(p, "/")
+	if i == -1 {
+		return p, ""
+	}
+	return p[:i], p[i+1:]
+}
+
+// apiError is an error returned to the SCIM client as described by RFC 7644
+// section 3.12.
+type apiError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *apiError) Error() string {
+	return e.detail
+}
+
+var (
+	errNotFound         = &apiError{status: http.StatusNotFound, detail: "Resource not found."}
+	errMethodNotAllowed = &apiError{status: http.StatusMethodNotAllowed, detail: "Method not allowed."}
+)
+
+func badRequest(scimType, format string, a ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, a...)}
+}
+
+func writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	const op = "scim.writeError"
+	var apiErr *apiError
+	switch {
+	case stderrors.As(err, &apiErr):
+	case errors.IsUniqueError(err):
+		apiErr = &apiError{status: http.StatusConflict, scimType: "uniqueness", detail: err.Error()}
+	case errors.IsNotFoundError(err):
+		apiErr = errNotFound
+	case errors.Match(errors.T(errors.InvalidParameter), err):
+		apiErr = badRequest("invalidValue", "%s", err.Error())
+	default:
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error serving scim request"))
+		apiErr = &apiError{status: http.StatusInternalServerError, detail: "Internal error."}
+	}
+	res := map[string]interface{}{
+		"schemas": []string{schemaError},
+		"status":  strconv.Itoa(apiErr.status),
+		"detail":  apiErr.detail,
+	}
+	if apiErr.scimType != "" {
+		res["scimType"] = apiErr.scimType
+	}
+	writeResponse(ctx, w, apiErr.status, res)
+}
+
+func writeResponse(ctx context.Context, w http.ResponseWriter, status int, res interface{}) {
+	const op = "scim.writeResponse"
+	if res == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error encoding scim response"))
+	}
+}
+
+// decodeBody decodes the JSON body of the request into v.
+func decodeBody(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return badRequest("invalidSyntax", "Error decoding request body: %v.", err)
+	}
+	return nil
+}
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+	Location     string `json:"location"`
+	Version      string `json:"version,omitempty"`
+}
+
+func newMeta(resourceType, id string, created, lastModified time.Time, version uint32) *meta {
+	m := &meta{
+		ResourceType: resourceType[:len(resourceType)-1],
+		Location:     PathPrefix + resourceType + "/" + id,
+		Version:      fmt.Sprintf(`W/"%d"`, version),
+	}
+	if !created.IsZero() {
+		m.Created = created.UTC().Format(time.RFC3339)
+	}
+	if !lastModified.IsZero() {
+		m.LastModified = lastModified.UTC().Format(time.RFC3339)
+	}
+	return m
+}
+
+type listResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+// newListResponse returns the page of resources requested by the startIndex
+// and count query parameters of the request.
+func newListResponse(r *http.Request, resources []interface{}) (*listResponse, error) {
+	startIndex, count, err := pageParams(r)
+	if err != nil {
+		return nil, err
+	}
+	page := []interface{}{}
+	if startIndex <= len(resources) {
+		page = resources[startIndex-1:]
+		if count >= 0 && count < len(page) {
+			page = page[:count]
+		}
+	}
+	return newPageResponse(startIndex, len(resources), page), nil
+}
+
+// newPageResponse returns the page of resources starting at the 1-based index
+// startIndex of a list of total resources.
+func newPageResponse(startIndex, total int, page []interface{}) *listResponse {
+	if page == nil {
+		page = []interface{}{}
+	}
+	return &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// pageParams returns the startIndex and count query parameters of the
+// request. startIndex is 1 and count is -1 if they are not set.
+func pageParams(r *http.Request) (startIndex, count int, err error) {
+	startIndex, count = 1, -1
+	q := r.URL.Query()
+	if v := q.Get("startIndex"); v != "" {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, badRequest("invalidValue", "Invalid startIndex %q.", v)
+		}
+		if i > 1 {
+			startIndex = i
+		}
+	}
+	if v := q.Get("count"); v != "" {
+		c, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, 0, badRequest("invalidValue", "Invalid count %q.", v)
+		}
+		if c < 0 {
+			c = 0
+		}
+		count = c
+	}
+	return startIndex, count, nil
+}
+
+// equalityFilter is a filter of the form `attribute eq "value"`, which is the
+// only form of filter supported.
+type equalityFilter struct {
+	attribute string
+	value     string
+}
+
+// parseFilter parses the filter query parameter of list requests. Attribute
+// names are case-insensitive and are returned in lower case.
+func parseFilter(filter string) (*equalityFilter, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+	parts := strings.SplitN(filter, " ", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[1], "eq") {
+		return nil, badRequest("invalidFilter", "Unsupported filter %q, only equality filters are supported.", filter)
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return nil, badRequest("invalidFilter", "Invalid value in filter %q.", filter)
+	}
+	return &equalityFilter{attribute: strings.ToLower(parts[0]), value: value}, nil
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// decodePatch decodes the PATCH request body, lower casing the ops.
+func decodePatch(r *http.Request) ([]patchOperation, error) {
+	var req patchRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Operations) == 0 {
+		return nil, badRequest("invalidValue", "No operations provided.")
+	}
+	for i, o := range req.Operations {
+		req.Operations[i].Op = strings.ToLower(o.Op)
+		switch req.Operations[i].Op {
+		case "add", "remove", "replace":
+		default:
+			return nil, badRequest("invalidSyntax", "Unsupported operation %q.", o.Op)
+		}
+	}
+	return req.Operations, nil
+}
+
+func serviceProviderConfig() map[string]interface{} {
+	supported := func(s bool) map[string]interface{} {
+		return map[string]interface{}{"supported": s}
+	}
+	return map[string]interface{}{
+		"schemas":        []string{schemaServiceProviderConfig},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": 0},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication using the bearer token configured for the org.",
+			},
+		},
+	}
+}
@@ -0,0 +1,337 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "test-scim-token"
+
+func testHandler(t *testing.T, conn *db.DB, orgId string) *Handler {
+	t.Helper()
+	wrap := db.TestWrapper(t)
+	revoker := session.NewAccessRevoker()
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrap, iam.WithAccessRevoker(revoker)), nil
+	}
+	h, err := NewHandler(context.Background(), iamRepoFn, map[string]string{testToken: orgId})
+	require.NoError(t, err)
+	return h
+}
+
+// do performs the request against h and decodes the response body, if any,
+// into a map.
+func do(t *testing.T, h http.Handler, method, path, body string) (int, map[string]interface{}) {
+	t.Helper()
+	r := httptest.NewRequest(method, path, strings.NewReader(body))
+	r.Header.Set("Authorization", "Bearer "+testToken)
+	r.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if w.Body.Len() == 0 {
+		return w.Code, nil
+	}
+	res := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	return w.Code, res
+}
+
+func TestNewHandler(t *testing.T) {
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) { return nil, nil }
+
+	_, err := NewHandler(ctx, iamRepoFn, map[string]string{"token": "o_1234567890"})
+	assert.NoError(t, err)
+	_, err = NewHandler(ctx, nil, nil)
+	assert.Error(t, err)
+	_, err = NewHandler(ctx, iamRepoFn, map[string]string{"": "o_1234567890"})
+	assert.Error(t, err)
+	_, err = NewHandler(ctx, iamRepoFn, map[string]string{"token": "global"})
+	assert.Error(t, err)
+}
+
+func TestHandler_Authenticate(t *testing.T) {
+	h := &Handler{orgIds: map[string]string{"token1": "o_1", "token2": "o_2"}}
+	cases := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{name: "first org", header: "Bearer token1", want: "o_1"},
+		{name: "second org", header: "bearer  token2 ", want: "o_2"},
+		{name: "unknown token", header: "Bearer token3"},
+		{name: "prefix of token", header: "Bearer token"},
+		{name: "basic auth", header: "Basic token1"},
+		{name: "missing token", header: "Bearer"},
+		{name: "no header"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, PathPrefix+"Users", nil)
+			if tc.header != "" {
+				r.Header.Set("Authorization", tc.header)
+			}
+			got, ok := h.authenticate(r)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.want != "", ok)
+		})
+	}
+
+	r := httptest.NewRequest(http.MethodGet, PathPrefix+"Users", nil)
+	r.Header.Set("Authorization", "Bearer token3")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+}
+
+func TestParseFilter(t *testing.T) {
+	cases := []struct {
+		filter  string
+		want    *equalityFilter
+		wantErr bool
+	}{
+		{filter: ""},
+		{filter: `userName eq "bjensen"`, want: &equalityFilter{attribute: "username", value: "bjensen"}},
+		{filter: `displayName EQ "Sales Team"`, want: &equalityFilter{attribute: "displayname", value: "Sales Team"}},
+		{filter: `userName eq "quoted \"name\""`, want: &equalityFilter{attribute: "username", value: `quoted "name"`}},
+		{filter: `userName sw "bj"`, wantErr: true},
+		{filter: `userName eq bjensen`, wantErr: true},
+		{filter: `userName`, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.filter, func(t *testing.T) {
+			got, err := parseFilter(tc.filter)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewListResponse(t *testing.T) {
+	resources := []interface{}{"a", "b", "c"}
+	cases := []struct {
+		query     string
+		wantStart int
+		wantPage  []interface{}
+		wantErr   bool
+	}{
+		{query: "", wantStart: 1, wantPage: resources},
+		{query: "startIndex=2", wantStart: 2, wantPage: []interface{}{"b", "c"}},
+		{query: "startIndex=2&count=1", wantStart: 2, wantPage: []interface{}{"b"}},
+		{query: "startIndex=0&count=2", wantStart: 1, wantPage: []interface{}{"a", "b"}},
+		{query: "startIndex=4", wantStart: 4, wantPage: []interface{}{}},
+		{query: "count=0", wantStart: 1, wantPage: []interface{}{}},
+		{query: "count=x", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, PathPrefix+"Users?"+tc.query, nil)
+			got, err := newListResponse(r, resources)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, len(resources), got.TotalResults)
+			assert.Equal(t, tc.wantStart, got.StartIndex)
+			assert.Equal(t, tc.wantPage, got.Resources)
+			assert.Equal(t, len(tc.wantPage), got.ItemsPerPage)
+		})
+	}
+}
+
+func TestUsers(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	org, _ := iam.TestScopes(t, iamRepo)
+	otherOrg, _ := iam.TestScopes(t, iamRepo)
+	otherUser := iam.TestUser(t, iamRepo, otherOrg.GetPublicId())
+	h := testHandler(t, conn, org.GetPublicId())
+
+	code, res := do(t, h, http.MethodPost, PathPrefix+"Users", `{"schemas":["`+schemaUser+`"],"userName":"bjensen","displayName":"Barbara Jensen"}`)
+	require.Equal(t, http.StatusCreated, code, res)
+	id := res["id"].(string)
+	assert.Equal(t, "bjensen", res["userName"])
+	assert.Equal(t, "Barbara Jensen", res["displayName"])
+	assert.Equal(t, true, res["active"])
+
+	code, res = do(t, h, http.MethodPost, PathPrefix+"Users", `{"userName":"bjensen"}`)
+	assert.Equal(t, http.StatusConflict, code, res)
+	assert.Equal(t, "uniqueness", res["scimType"])
+
+	code, res = do(t, h, http.MethodGet, PathPrefix+"Users?filter="+`userName+eq+"BJENSEN"`, "")
+	require.Equal(t, http.StatusOK, code, res)
+	assert.EqualValues(t, 1, res["totalResults"])
+
+	code, res = do(t, h, http.MethodGet, PathPrefix+"Users?filter="+`userName+eq+"other"`, "")
+	require.Equal(t, http.StatusOK, code, res)
+	assert.EqualValues(t, 0, res["totalResults"])
+
+	// The email of a user is the email of its primary account.
+	code, res = do(t, h, http.MethodPost, PathPrefix+"Users", `{"userName":"alice","emails":[{"value":"alice@example.com","primary":true}]}`)
+	assert.Equal(t, http.StatusBadRequest, code, res)
+	assert.Equal(t, "mutability", res["scimType"])
+	code, res = do(t, h, http.MethodPut, PathPrefix+"Users/"+id, `{"userName":"bjensen","emails":[{"value":"bjensen@example.com"}]}`)
+	assert.Equal(t, http.StatusBadRequest, code, res)
+	assert.Equal(t, "mutability", res["scimType"])
+
+	code, res = do(t, h, http.MethodPost, PathPrefix+"Users", `{"userName":"alice"}`)
+	require.Equal(t, http.StatusCreated, code, res)
+	aliceId := res["id"].(string)
+	code, res = do(t, h, http.MethodGet, PathPrefix+"Users?startIndex=2&count=1", "")
+	require.Equal(t, http.StatusOK, code, res)
+	assert.EqualValues(t, 2, res["totalResults"])
+	assert.EqualValues(t, 2, res["startIndex"])
+	assert.EqualValues(t, 1, res["itemsPerPage"])
+	if resources, ok := res["Resources"].([]interface{}); assert.True(t, ok) && assert.Len(t, resources, 1) {
+		last := id
+		if aliceId > id {
+			last = aliceId
+		}
+		assert.Equal(t, last, resources[0].(map[string]interface{})["id"])
+	}
+	code, res = do(t, h, http.MethodGet, PathPrefix+"Users?count=0", "")
+	require.Equal(t, http.StatusOK, code, res)
+	assert.EqualValues(t, 2, res["totalResults"])
+	assert.EqualValues(t, 0, res["itemsPerPage"])
+	code, _ = do(t, h, http.MethodDelete, PathPrefix+"Users/"+aliceId, "")
+	assert.Equal(t, http.StatusNoContent, code)
+
+	code, res = do(t, h, http.MethodPatch, PathPrefix+"Users/"+id,
+		`{"schemas":["`+schemaPatchOp+`"],"Operations":[{"op":"Replace","path":"displayName","value":"Babs Jensen"}]}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, "Babs Jensen", res["displayName"])
+
+	code, res = do(t, h, http.MethodPut, PathPrefix+"Users/"+id, `{"userName":"bjensen2"}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, "bjensen2", res["userName"])
+	assert.Nil(t, res["displayName"])
+
+	// Users of other orgs are not visible
+	code, _ = do(t, h, http.MethodGet, PathPrefix+"Users/"+otherUser.GetPublicId(), "")
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = do(t, h, http.MethodDelete, PathPrefix+"Users/"+otherUser.GetPublicId(), "")
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = do(t, h, http.MethodDelete, PathPrefix+"Users/"+id, "")
+	assert.Equal(t, http.StatusNoContent, code)
+	code, _ = do(t, h, http.MethodGet, PathPrefix+"Users/"+id, "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestUsers_Deprovision(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	sessionRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	tokenRepo, err := authtoken.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name   string
+		method string
+		body   string
+		status int
+	}{
+		{name: "deactivate", method: http.MethodPatch, body: `{"Operations":[{"op":"replace","value":{"active":false}}]}`, status: http.StatusOK},
+		{name: "delete", method: http.MethodDelete, status: http.StatusNoContent},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := session.TestSessionParams(t, conn, wrap, iamRepo)
+			s := session.TestSession(t, conn, wrap, params)
+			u, _, err := iamRepo.LookupUser(ctx, params.UserId)
+			require.NoError(t, err)
+			h := testHandler(t, conn, u.GetScopeId())
+
+			code, res := do(t, h, tc.method, PathPrefix+"Users/"+params.UserId, tc.body)
+			require.Equal(t, tc.status, code, res)
+
+			if tc.method == http.MethodPatch {
+				assert.Equal(t, false, res["active"])
+			}
+			s, _, err = sessionRepo.LookupSession(ctx, s.PublicId)
+			require.NoError(t, err)
+			assert.Equal(t, session.StatusCanceling, s.States[0].Status)
+			at, err := tokenRepo.LookupAuthToken(ctx, params.AuthTokenId)
+			require.NoError(t, err)
+			assert.Nil(t, at)
+		})
+	}
+}
+
+func TestGroups(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	org, _ := iam.TestScopes(t, iamRepo)
+	otherOrg, _ := iam.TestScopes(t, iamRepo)
+	u1 := iam.TestUser(t, iamRepo, org.GetPublicId())
+	u2 := iam.TestUser(t, iamRepo, org.GetPublicId())
+	otherUser := iam.TestUser(t, iamRepo, otherOrg.GetPublicId())
+	h := testHandler(t, conn, org.GetPublicId())
+
+	memberIds := func(res map[string]interface{}) []string {
+		var ids []string
+		members, _ := res["members"].([]interface{})
+		for _, m := range members {
+			ids = append(ids, m.(map[string]interface{})["value"].(string))
+		}
+		return ids
+	}
+
+	code, res := do(t, h, http.MethodPost, PathPrefix+"Groups", fmt.Sprintf(`{"displayName":"admins","members":[{"value":%q}]}`, u1.GetPublicId()))
+	require.Equal(t, http.StatusCreated, code, res)
+	id := res["id"].(string)
+	assert.Equal(t, "admins", res["displayName"])
+	assert.ElementsMatch(t, []string{u1.GetPublicId()}, memberIds(res))
+
+	code, res = do(t, h, http.MethodPost, PathPrefix+"Groups", fmt.Sprintf(`{"displayName":"others","members":[{"value":%q}]}`, otherUser.GetPublicId()))
+	assert.Equal(t, http.StatusBadRequest, code, res)
+
+	code, res = do(t, h, http.MethodPatch, PathPrefix+"Groups/"+id,
+		fmt.Sprintf(`{"Operations":[{"op":"add","path":"members","value":[{"value":%q}]}]}`, u2.GetPublicId()))
+	require.Equal(t, http.StatusOK, code, res)
+	assert.ElementsMatch(t, []string{u1.GetPublicId(), u2.GetPublicId()}, memberIds(res))
+
+	code, res = do(t, h, http.MethodPatch, PathPrefix+"Groups/"+id,
+		fmt.Sprintf(`{"Operations":[{"op":"remove","path":"members[value eq %q]"},{"op":"replace","path":"displayName","value":"operators"}]}`, u1.GetPublicId()))
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Equal(t, "operators", res["displayName"])
+	assert.ElementsMatch(t, []string{u2.GetPublicId()}, memberIds(res))
+
+	code, res = do(t, h, http.MethodGet, PathPrefix+"Groups?filter="+`displayName+eq+"operators"&excludedAttributes=members`, "")
+	require.Equal(t, http.StatusOK, code, res)
+	assert.EqualValues(t, 1, res["totalResults"])
+
+	code, res = do(t, h, http.MethodPut, PathPrefix+"Groups/"+id, `{"displayName":"operators","members":[]}`)
+	require.Equal(t, http.StatusOK, code, res)
+	assert.Empty(t, memberIds(res))
+
+	code, _ = do(t, h, http.MethodDelete, PathPrefix+"Groups/"+id, "")
+	assert.Equal(t, http.StatusNoContent, code)
+	code, _ = do(t, h, http.MethodGet, PathPrefix+"Groups/"+id, "")
+	assert.Equal(t, http.StatusNotFound, code)
+}
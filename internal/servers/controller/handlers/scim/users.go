@@ -0,0 +1,279 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
+	"github.com/hashicorp/boundary/internal/iam"
+)
+
+// user is the SCIM representation of a user. The userName is stored as the
+// name of the user, and the displayName as its description. Inactive users are
+// disabled. The email of a user is the email of its primary account, so emails
+// cannot be provisioned.
+type user struct {
+	Schemas     []string `json:"schemas"`
+	Id          string   `json:"id,omitempty"`
+	ExternalId  string   `json:"externalId,omitempty"`
+	UserName    string   `json:"userName"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	Meta        *meta    `json:"meta,omitempty"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+func toUser(u *iam.User) *user {
+	active := !u.GetDisabled()
+	out := &user{
+		Schemas:     []string{schemaUser},
+		Id:          u.GetPublicId(),
+		UserName:    u.GetName(),
+		DisplayName: u.GetDescription(),
+		Active:      &active,
+		Meta: newMeta("Users", u.GetPublicId(),
+			u.GetCreateTime().GetTimestamp().AsTime(),
+			u.GetUpdateTime().GetTimestamp().AsTime(),
+			u.GetVersion()),
+	}
+	if u.GetEmail() != "" {
+		out.Emails = []email{{Value: u.GetEmail(), Primary: true}}
+	}
+	return out
+}
+
+func (h *Handler) serveUsers(ctx context.Context, r *http.Request, orgId, id string) (interface{}, int, error) {
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		return h.listUsers(ctx, r, orgId)
+	case id == "" && r.Method == http.MethodPost:
+		return h.createUser(ctx, r, orgId)
+	case id == "":
+		return nil, 0, errMethodNotAllowed
+	}
+
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	u, _, err := repo.LookupUser(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if u == nil || u.GetScopeId() != orgId {
+		return nil, 0, errNotFound
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return toUser(u), http.StatusOK, nil
+	case http.MethodPut:
+		return h.replaceUser(ctx, r, u)
+	case http.MethodPatch:
+		return h.patchUser(ctx, r, u)
+	case http.MethodDelete:
+		if _, err := repo.DeleteUser(ctx, u.GetPublicId()); err != nil {
+			return nil, 0, err
+		}
+		return nil, http.StatusNoContent, nil
+	default:
+		return nil, 0, errMethodNotAllowed
+	}
+}
+
+// listUsers lists the users of the org, filtering and paging them in the
+// database.
+func (h *Handler) listUsers(ctx context.Context, r *http.Request, orgId string) (interface{}, int, error) {
+	eq, err := parseFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, 0, err
+	}
+	var opts []iam.Option
+	if eq != nil {
+		if eq.attribute != "username" {
+			return nil, 0, badRequest("invalidFilter", "Users can only be filtered by userName.")
+		}
+		// userName is not case sensitive.
+		opts = append(opts, iam.WithFilterConditions([]filter.Condition{
+			{Field: "name", Operator: filter.EqualFoldOperator, Value: eq.value},
+		}))
+	}
+	startIndex, count, err := pageParams(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := repo.CountUsers(ctx, []string{orgId}, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+	var resources []interface{}
+	if count != 0 && startIndex <= total {
+		// A negative count lists all the remaining users.
+		users, err := repo.ListUsers(ctx, []string{orgId}, append(opts, iam.WithOffset(startIndex-1), iam.WithLimit(count))...)
+		if err != nil {
+			return nil, 0, err
+		}
+		resources = make([]interface{}, 0, len(users))
+		for _, u := range users {
+			resources = append(resources, toUser(u))
+		}
+	}
+	return newPageResponse(startIndex, total, resources), http.StatusOK, nil
+}
+
+// checkEmails returns an error if emails set an email other than the email of
+// the user, which is the email of its primary account.
+func checkEmails(emails []email, userEmail string) error {
+	for _, e := range emails {
+		if !strings.EqualFold(e.Value, userEmail) {
+			return badRequest("mutability", "emails cannot be provisioned, the email of a user is the email of its primary account.")
+		}
+	}
+	return nil
+}
+
+func (h *Handler) createUser(ctx context.Context, r *http.Request, orgId string) (interface{}, int, error) {
+	var in user
+	if err := decodeBody(r, &in); err != nil {
+		return nil, 0, err
+	}
+	if in.UserName == "" {
+		return nil, 0, badRequest("invalidValue", "Missing userName.")
+	}
+	// A new user has no account.
+	if err := checkEmails(in.Emails, ""); err != nil {
+		return nil, 0, err
+	}
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	u, err := iam.NewUser(orgId, iam.WithName(in.UserName), iam.WithDescription(in.DisplayName))
+	if err != nil {
+		return nil, 0, err
+	}
+	u.Disabled = in.Active != nil && !*in.Active
+	u, err = repo.CreateUser(ctx, u)
+	if err != nil {
+		return nil, 0, err
+	}
+	return toUser(u), http.StatusCreated, nil
+}
+
+func (h *Handler) replaceUser(ctx context.Context, r *http.Request, u *iam.User) (interface{}, int, error) {
+	var in user
+	if err := decodeBody(r, &in); err != nil {
+		return nil, 0, err
+	}
+	if in.UserName == "" {
+		return nil, 0, badRequest("invalidValue", "Missing userName.")
+	}
+	if err := checkEmails(in.Emails, u.GetEmail()); err != nil {
+		return nil, 0, err
+	}
+	return h.updateUser(ctx, u, in.UserName, in.DisplayName, in.Active == nil || *in.Active)
+}
+
+func (h *Handler) patchUser(ctx context.Context, r *http.Request, u *iam.User) (interface{}, int, error) {
+	ops, err := decodePatch(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	userName, displayName, active := u.GetName(), u.GetDescription(), !u.GetDisabled()
+	apply := func(op, path string, value json.RawMessage) error {
+		switch strings.ToLower(path) {
+		case "username":
+			if op == "remove" {
+				return badRequest("mutability", "userName cannot be removed.")
+			}
+			return unmarshalValue(value, &userName)
+		case "displayname":
+			if op == "remove" {
+				displayName = ""
+				return nil
+			}
+			return unmarshalValue(value, &displayName)
+		case "active":
+			if op == "remove" {
+				return badRequest("mutability", "active cannot be removed.")
+			}
+			return unmarshalValue(value, &active)
+		default:
+			return badRequest("invalidPath", "Unsupported path %q.", path)
+		}
+	}
+	for _, o := range ops {
+		if o.Path != "" {
+			if err := apply(o.Op, o.Path, o.Value); err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+		if o.Op == "remove" {
+			return nil, 0, badRequest("noTarget", "A path is required to remove attributes.")
+		}
+		var attrs map[string]json.RawMessage
+		if err := unmarshalValue(o.Value, &attrs); err != nil {
+			return nil, 0, err
+		}
+		for path, value := range attrs {
+			if err := apply(o.Op, path, value); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	return h.updateUser(ctx, u, userName, displayName, active)
+}
+
+// updateUser sets the name, description and disabled state of the user. The
+// iam repository revokes the access of deactivated users.
+func (h *Handler) updateUser(ctx context.Context, u *iam.User, userName, displayName string, active bool) (interface{}, int, error) {
+	const op = "scim.(Handler).updateUser"
+	var paths []string
+	if userName != u.GetName() {
+		paths = append(paths, "Name")
+	}
+	if displayName != u.GetDescription() {
+		paths = append(paths, "Description")
+	}
+	if active == u.GetDisabled() {
+		paths = append(paths, "Disabled")
+	}
+	if len(paths) == 0 {
+		return toUser(u), http.StatusOK, nil
+	}
+	repo, err := h.iamRepoFn()
+	if err != nil {
+		return nil, 0, err
+	}
+	updated := u.Clone().(*iam.User)
+	updated.Name, updated.Description, updated.Disabled = userName, displayName, !active
+	u, _, _, err = repo.UpdateUser(ctx, updated, u.GetVersion(), paths)
+	if err != nil {
+		return nil, 0, errors.Wrap(ctx, err, op)
+	}
+	return toUser(u), http.StatusOK, nil
+}
+
+// unmarshalValue unmarshals the value of a PATCH operation into v.
+func unmarshalValue(value json.RawMessage, v interface{}) error {
+	if len(value) == 0 {
+		return badRequest("invalidValue", "Missing value.")
+	}
+	if err := json.Unmarshal(value, v); err != nil {
+		return badRequest("invalidValue", "Invalid value: %v.", err)
+	}
+	return nil
+}
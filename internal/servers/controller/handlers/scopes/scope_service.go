@@ -3,22 +3,34 @@ package scopes
 import (
 	"context"
 	"fmt"
+	"net"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/accessrequest"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/auth/password"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/accessrequests"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/authtokens"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/credentialstores"
@@ -28,6 +40,9 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/users"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -46,6 +61,7 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.CheckPermissions,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -75,6 +91,7 @@ var (
 		},
 
 		scope.Project.String(): {
+			resource.AccessRequest:   accessrequests.CollectionActions,
 			resource.CredentialStore: credentialstores.CollectionActions,
 			resource.Group:           groups.CollectionActions,
 			resource.HostCatalog:     host_catalogs.CollectionActions,
@@ -118,6 +135,10 @@ func (s Service) ListScopes(ctx context.Context, req *pbs.ListScopesRequest) (*p
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -142,59 +163,66 @@ func (s Service) ListScopes(ctx context.Context, req *pbs.ListScopesRequest) (*p
 		return &pbs.ListScopesResponse{}, nil
 	}
 
-	pl, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(pl) == 0 {
-		return &pbs.ListScopesResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Scope, 0, len(pl))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("name", "type", "scope_id")
+	var finalItems []*pb.Scope
 	res := perms.Resource{
 		Type: resource.Scope,
 	}
-	for _, item := range pl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetParentId()
-
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
+	for {
+		pl, err := s.listFromRepo(ctx, scopeIds, page, conds)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range pl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetParentId()
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetParentId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-		if outputFields.Has(globals.AuthorizedCollectionActionsField) {
-			collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, scopeCollectionTypeMapMap[item.Type], item.GetPublicId(), "")
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetParentId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+			if outputFields.Has(globals.AuthorizedCollectionActionsField) {
+				collectionActions, err := auth.CalculateAuthorizedCollectionActions(ctx, authResults, scopeCollectionTypeMapMap[item.Type], item.GetPublicId(), "")
+				if err != nil {
+					return nil, err
+				}
+				outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
+			}
+
+			item, err := ToProto(ctx, item, outputOpts...)
 			if err != nil {
 				return nil, err
 			}
-			outputOpts = append(outputOpts, handlers.WithAuthorizedCollectionActions(collectionActions))
-		}
 
-		item, err := ToProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		if page.Done(len(pl)) {
+			break
 		}
 	}
 	SortScopes(finalItems)
-	return &pbs.ListScopesResponse{Items: finalItems}, nil
+	return &pbs.ListScopesResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetScopes implements the interface pbs.ScopeServiceServer.
@@ -226,7 +254,12 @@ func (s Service) GetScope(ctx context.Context, req *pbs.GetScopeRequest) (*pbs.G
 	act := IdActions
 	// Can't delete global so elide it
 	if p.GetPublicId() == scope.Global.String() {
-		act = act[0:3]
+		act = make(action.ActionSet, 0, len(IdActions)-1)
+		for _, a := range IdActions {
+			if a != action.Delete {
+				act = append(act, a)
+			}
+		}
 	}
 	if outputFields.Has(globals.AuthorizedActionsField) {
 		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), act).Strings()))
@@ -353,6 +386,69 @@ func (s Service) DeleteScope(ctx context.Context, req *pbs.DeleteScopeRequest) (
 	return nil, nil
 }
 
+// CheckPermissions implements the interface pbs.ScopeServiceServer.
+func (s Service) CheckPermissions(ctx context.Context, req *pbs.CheckPermissionsRequest) (*pbs.CheckPermissionsResponse, error) {
+	const op = "scopes.(Service).CheckPermissions"
+
+	if err := validateCheckPermissionsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.CheckPermissions)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	u, _, err := repo.LookupUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return nil, handlers.NotFoundErrorf("User %q doesn't exist.", req.GetUserId())
+	}
+
+	res, err := checkedResource(ctx, repo, req)
+	if err != nil {
+		return nil, err
+	}
+	act := action.Map[req.GetAction()]
+	rc := perms.RequestContext{
+		ClientIp: req.GetClientIp(),
+		Time:     time.Now(),
+	}
+	explanation, err := auth.CheckPermissions(ctx, repo, u.GetPublicId(), res, act, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.PermissionCheck{
+		ScopeId:            res.ScopeId,
+		UserId:             u.GetPublicId(),
+		ResourceId:         res.Id,
+		ResourceType:       res.Type.String(),
+		ParentId:           res.Pin,
+		Action:             act.String(),
+		Authorized:         explanation.Authorized,
+		AllowingGrants:     permissionGrantsToProto(explanation.Allowing),
+		DenyingGrants:      permissionGrantsToProto(explanation.Denying),
+		InapplicableGrants: permissionGrantsToProto(explanation.Inapplicable),
+		EvaluatedGrants:    permissionGrantsToProto(explanation.Evaluated),
+		MissingGrant:       explanation.Missing,
+	}
+	switch {
+	case explanation.Authorized:
+		out.Reason = "allowed"
+	case len(explanation.Denying) > 0:
+		out.Reason = "denied"
+	default:
+		out.Reason = "no-matching-grant"
+	}
+	return &pbs.CheckPermissionsResponse{Item: out}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -488,12 +584,12 @@ func SortScopes(scps []*pb.Scope) {
 	})
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.Scope, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*iam.Scope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	scps, err := repo.ListScopes(ctx, scopeIds)
+	scps, err := repo.ListScopes(ctx, scopeIds, iam.WithLimit(page.Limit()), iam.WithStartPageAfterId(page.StartAfterId()), iam.WithFilterConditions(conds))
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to list scopes: %v", err)
 	}
@@ -539,6 +635,105 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 	return auth.Verify(ctx, opts...)
 }
 
+// checkedResource returns the resource whose permissions are checked by req.
+// The scope and parent of a resource are looked up rather than taken from the
+// request, and resources outside of the scope of the request are not found.
+// For collection actions, the scope is the one of the parent, if any.
+func checkedResource(ctx context.Context, repo *iam.Repository, req *pbs.CheckPermissionsRequest) (perms.Resource, error) {
+	const op = "scopes.checkedResource"
+	res := perms.Resource{
+		ScopeId: req.GetId(),
+		Id:      req.GetResourceId(),
+		Type:    resource.Map[req.GetResourceType()],
+		Pin:     req.GetParentId(),
+	}
+	lookupId, lookupType := res.Id, resourceTypeFromId(res.Id)
+	if res.Id != "" {
+		res.Type = lookupType
+	} else if res.Pin != "" {
+		lookupId, lookupType = res.Pin, resourceTypeFromId(res.Pin)
+	}
+	if lookupId == "" {
+		return res, nil
+	}
+	scopeId, parentId, err := repo.LookupResourceScope(ctx, lookupId, lookupType)
+	switch {
+	case errors.IsNotFoundError(err):
+		return perms.Resource{}, handlers.NotFoundErrorf("Resource %q doesn't exist.", lookupId)
+	case err != nil:
+		return perms.Resource{}, errors.Wrap(ctx, err, op)
+	case scopeId != res.ScopeId:
+		return perms.Resource{}, handlers.NotFoundErrorf("Resource %q doesn't exist in scope %q.", lookupId, res.ScopeId)
+	}
+	if res.Id != "" {
+		res.Pin = parentId
+	}
+	return res, nil
+}
+
+func permissionGrantsToProto(in []perms.Grant) []*pb.PermissionGrant {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*pb.PermissionGrant, 0, len(in))
+	for _, g := range in {
+		out = append(out, &pb.PermissionGrant{
+			RoleId: g.RoleId(),
+			Grant:  g.CanonicalString(),
+		})
+	}
+	return out
+}
+
+// resourceTypeFromId returns the type of the resource with the given ID based
+// on its prefix, or resource.Unknown if the prefix is not known.
+func resourceTypeFromId(id string) resource.Type {
+	if id == scope.Global.String() {
+		return resource.Scope
+	}
+	i := strings.Index(id, "_")
+	if i < 1 {
+		return resource.Unknown
+	}
+	switch id[:i] {
+	case scope.Org.Prefix(), scope.Project.Prefix():
+		return resource.Scope
+	case iam.UserPrefix:
+		return resource.User
+	case iam.GroupPrefix:
+		return resource.Group
+	case iam.RolePrefix:
+		return resource.Role
+	case password.AuthMethodPrefix, oidc.AuthMethodPrefix, ldap.AuthMethodPrefix:
+		return resource.AuthMethod
+	case intglobals.NewPasswordAccountPrefix, intglobals.OldPasswordAccountPrefix, oidc.AccountPrefix, ldap.AccountPrefix:
+		return resource.Account
+	case intglobals.OidcManagedGroupPrefix, intglobals.LdapManagedGroupPrefix:
+		return resource.ManagedGroup
+	case authtoken.AuthTokenPrefix:
+		return resource.AuthToken
+	case static.HostCatalogPrefix, plugin.HostCatalogPrefix:
+		return resource.HostCatalog
+	case static.HostSetPrefix, plugin.HostSetPrefix:
+		return resource.HostSet
+	case static.HostPrefix, plugin.HostPrefix:
+		return resource.Host
+	case tcp.TargetPrefix, ssh.TargetPrefix:
+		return resource.Target
+	case session.SessionPrefix:
+		return resource.Session
+	case vault.CredentialStorePrefix, credstatic.CredentialStorePrefix:
+		return resource.CredentialStore
+	case vault.CredentialLibraryPrefix:
+		return resource.CredentialLibrary
+	case credstatic.UsernamePasswordCredentialPrefix, credstatic.SshPrivateKeyCredentialPrefix, credstatic.JsonCredentialPrefix:
+		return resource.Credential
+	case accessrequest.AccessRequestPrefix:
+		return resource.AccessRequest
+	}
+	return resource.Unknown
+}
+
 func ToProto(ctx context.Context, in *iam.Scope, opt ...handlers.Option) (*pb.Scope, error) {
 	opts := handlers.GetOpts(opt...)
 	if opts.WithOutputFields == nil {
@@ -730,6 +925,69 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+func validateCheckPermissionsRequest(req *pbs.CheckPermissionsRequest) error {
+	badFields := map[string]string{}
+	id := req.GetId()
+	switch {
+	case id == scope.Global.String():
+	case strings.HasPrefix(id, scope.Org.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Org.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	case strings.HasPrefix(id, scope.Project.Prefix()):
+		if !handlers.ValidId(handlers.Id(id), scope.Project.Prefix()) {
+			badFields["id"] = "Invalidly formatted scope id."
+		}
+	default:
+		badFields["id"] = "Invalidly formatted scope id."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetUserId()), iam.UserPrefix) {
+		badFields["user_id"] = "Invalidly formatted user id."
+	}
+	switch act := action.Map[req.GetAction()]; act {
+	case action.Unknown, action.All:
+		badFields["action"] = "Must be a known action."
+	case action.List, action.Create:
+		if req.GetResourceId() != "" {
+			badFields["resource_id"] = fmt.Sprintf("Cannot be specified for the %s action.", act.String())
+		}
+		if req.GetResourceType() == "" {
+			badFields["resource_type"] = fmt.Sprintf("Must be specified for the %s action.", act.String())
+		}
+	default:
+		if req.GetResourceId() == "" {
+			badFields["resource_id"] = fmt.Sprintf("Must be specified for the %s action.", act.String())
+		}
+	}
+	if typ := req.GetResourceType(); typ != "" {
+		switch resource.Map[typ] {
+		case resource.Unknown, resource.All, resource.Controller, resource.Worker:
+			badFields["resource_type"] = "Must be a known resource type."
+		}
+	}
+	if id := req.GetResourceId(); id != "" {
+		switch typ := resourceTypeFromId(id); {
+		case typ == resource.Unknown:
+			badFields["resource_id"] = "Must be the id of a known type of resource."
+		case req.GetResourceType() != "" && resource.Map[req.GetResourceType()] != typ:
+			badFields["resource_type"] = "Must match the type of the resource."
+		}
+		if req.GetParentId() != "" {
+			badFields["parent_id"] = "Cannot be specified with resource_id, the parent of the resource is derived from it."
+		}
+	}
+	if id := req.GetParentId(); id != "" && resourceTypeFromId(id) == resource.Unknown {
+		badFields["parent_id"] = "Must be the id of a known type of resource."
+	}
+	if req.GetClientIp() != "" && net.ParseIP(req.GetClientIp()) == nil {
+		badFields["client_ip"] = "Must be a valid IP address."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
@@ -11,11 +11,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/scopes"
+	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -30,7 +32,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "check-permissions"}
 
 func createDefaultScopesAndRepo(t *testing.T) (*iam.Scope, *iam.Scope, func() (*iam.Repository, error)) {
 	t.Helper()
@@ -136,6 +138,12 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 }
 
 var projectAuthorizedCollectionActions = map[string]*structpb.ListValue{
+	"access-requests": {
+		Values: []*structpb.Value{
+			structpb.NewStringValue("create"),
+			structpb.NewStringValue("list"),
+		},
+	},
 	"credential-stores": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("create"),
@@ -1275,3 +1283,203 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckPermissions(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+	u := iam.TestUser(t, iamRepo, org.GetPublicId())
+	allowedTarget := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "allowed")
+	deniedTarget := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "denied")
+	catalog := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	host := static.TestHosts(t, conn, catalog.GetPublicId(), 1)[0]
+
+	allowRole := iam.TestRole(t, conn, proj.GetPublicId())
+	iam.TestRoleGrant(t, conn, allowRole.GetPublicId(), "id=*;type=target;actions=read,authorize-session")
+	iam.TestUserRole(t, conn, allowRole.GetPublicId(), u.GetPublicId())
+	denyRole := iam.TestRole(t, conn, proj.GetPublicId())
+	iam.TestRoleGrant(t, conn, denyRole.GetPublicId(), fmt.Sprintf("id=%s;actions=authorize-session;effect=deny", deniedTarget.GetPublicId()))
+	iam.TestUserRole(t, conn, denyRole.GetPublicId(), u.GetPublicId())
+
+	permissionGrant := func(roleId, grant string) *pb.PermissionGrant {
+		parsed, err := perms.Parse(proj.GetPublicId(), grant)
+		require.NoError(t, err)
+		return &pb.PermissionGrant{RoleId: roleId, Grant: parsed.CanonicalString()}
+	}
+	allowGrant := permissionGrant(allowRole.GetPublicId(), "id=*;type=target;actions=read,authorize-session")
+	denyGrant := permissionGrant(denyRole.GetPublicId(), fmt.Sprintf("id=%s;actions=authorize-session;effect=deny", deniedTarget.GetPublicId()))
+	evaluatedGrants := []*pb.PermissionGrant{allowGrant, denyGrant}
+
+	cases := []struct {
+		name string
+		req  *pbs.CheckPermissionsRequest
+		res  *pbs.CheckPermissionsResponse
+		err  error
+	}{
+		{
+			name: "allowed",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: allowedTarget.GetPublicId(), Action: "authorize-session"},
+			res: &pbs.CheckPermissionsResponse{Item: &pb.PermissionCheck{
+				ScopeId:         proj.GetPublicId(),
+				UserId:          u.GetPublicId(),
+				ResourceId:      allowedTarget.GetPublicId(),
+				ResourceType:    "target",
+				Action:          "authorize-session",
+				Authorized:      true,
+				Reason:          "allowed",
+				AllowingGrants:  []*pb.PermissionGrant{allowGrant},
+				EvaluatedGrants: evaluatedGrants,
+			}},
+		},
+		{
+			name: "denied",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: deniedTarget.GetPublicId(), Action: "authorize-session"},
+			res: &pbs.CheckPermissionsResponse{Item: &pb.PermissionCheck{
+				ScopeId:         proj.GetPublicId(),
+				UserId:          u.GetPublicId(),
+				ResourceId:      deniedTarget.GetPublicId(),
+				ResourceType:    "target",
+				Action:          "authorize-session",
+				Reason:          "denied",
+				AllowingGrants:  []*pb.PermissionGrant{allowGrant},
+				DenyingGrants:   []*pb.PermissionGrant{denyGrant},
+				EvaluatedGrants: evaluatedGrants,
+			}},
+		},
+		{
+			name: "no matching grant",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: deniedTarget.GetPublicId(), Action: "delete"},
+			res: &pbs.CheckPermissionsResponse{Item: &pb.PermissionCheck{
+				ScopeId:         proj.GetPublicId(),
+				UserId:          u.GetPublicId(),
+				ResourceId:      deniedTarget.GetPublicId(),
+				ResourceType:    "target",
+				Action:          "delete",
+				Reason:          "no-matching-grant",
+				EvaluatedGrants: evaluatedGrants,
+				MissingGrant:    fmt.Sprintf("id=%s;actions=delete", deniedTarget.GetPublicId()),
+			}},
+		},
+		{
+			name: "collection action",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceType: "target", Action: "list"},
+			res: &pbs.CheckPermissionsResponse{Item: &pb.PermissionCheck{
+				ScopeId:         proj.GetPublicId(),
+				UserId:          u.GetPublicId(),
+				ResourceType:    "target",
+				Action:          "list",
+				Reason:          "no-matching-grant",
+				EvaluatedGrants: evaluatedGrants,
+				MissingGrant:    "type=target;actions=list",
+			}},
+		},
+		{
+			name: "parent derived from resource",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: host.GetPublicId(), Action: "read"},
+			res: &pbs.CheckPermissionsResponse{Item: &pb.PermissionCheck{
+				ScopeId:         proj.GetPublicId(),
+				UserId:          u.GetPublicId(),
+				ResourceId:      host.GetPublicId(),
+				ResourceType:    "host",
+				ParentId:        catalog.GetPublicId(),
+				Action:          "read",
+				Reason:          "no-matching-grant",
+				EvaluatedGrants: evaluatedGrants,
+				MissingGrant:    fmt.Sprintf("id=%s;actions=read", host.GetPublicId()),
+			}},
+		},
+		{
+			name: "collection action in parent",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceType: "host", ParentId: catalog.GetPublicId(), Action: "list"},
+			res: &pbs.CheckPermissionsResponse{Item: &pb.PermissionCheck{
+				ScopeId:         proj.GetPublicId(),
+				UserId:          u.GetPublicId(),
+				ResourceType:    "host",
+				ParentId:        catalog.GetPublicId(),
+				Action:          "list",
+				Reason:          "no-matching-grant",
+				EvaluatedGrants: evaluatedGrants,
+				MissingGrant:    fmt.Sprintf("id=%s;type=host;actions=list", catalog.GetPublicId()),
+			}},
+		},
+		{
+			name: "resource in another scope",
+			req:  &pbs.CheckPermissionsRequest{Id: org.GetPublicId(), UserId: u.GetPublicId(), ResourceId: allowedTarget.GetPublicId(), Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "parent in another scope",
+			req:  &pbs.CheckPermissionsRequest{Id: org.GetPublicId(), UserId: u.GetPublicId(), ResourceType: "host", ParentId: catalog.GetPublicId(), Action: "list"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "non existing resource",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: "ttcp_1234567890", Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "mismatched resource type",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: allowedTarget.GetPublicId(), ResourceType: "host", Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "parent with resource id",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: host.GetPublicId(), ParentId: catalog.GetPublicId(), Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "non existing user",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: "u_DoesntExis", ResourceId: allowedTarget.GetPublicId(), Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "bad user id",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: "g_1234567890", ResourceId: allowedTarget.GetPublicId(), Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "unknown action",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: allowedTarget.GetPublicId(), Action: "fly"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "unknown resource id prefix",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: "j_1234567890", Action: "read"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "resource id with list",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: allowedTarget.GetPublicId(), Action: "list"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "bad client ip",
+			req:  &pbs.CheckPermissionsRequest{Id: proj.GetPublicId(), UserId: u.GetPublicId(), ResourceId: allowedTarget.GetPublicId(), Action: "read", ClientIp: "nope"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	sortGrants := protocmp.SortRepeated(func(a, b *pb.PermissionGrant) bool {
+		return a.GetRoleId()+a.GetGrant() < b.GetRoleId()+b.GetGrant()
+	})
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := scopes.NewService(repoFn)
+			require.NoError(err, "Couldn't create new scope service.")
+
+			got, gErr := s.CheckPermissions(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CheckPermissions(%+v) got error\n%v, wanted\n%v", tc.req, gErr, tc.err)
+			}
+			assert.Empty(cmp.Diff(tc.res, got, protocmp.Transform(), sortGrants), "CheckPermissions(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
+		})
+	}
+}
@@ -2,14 +2,19 @@ package sessions
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	stderrors "errors"
 	"fmt"
+	"io"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/requests"
+	servercommon "github.com/hashicorp/boundary/internal/servers/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/common/scopeids"
@@ -32,6 +37,7 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.DownloadRecording,
 	}
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -41,16 +47,24 @@ var (
 	}
 )
 
+// DefaultMaxRecordingSize is the maximum size in bytes of the recordings
+// which can be downloaded when no maximum size is configured.
+const DefaultMaxRecordingSize = 64 << 20
+
 // Service handles request as described by the pbs.SessionServiceServer interface.
 type Service struct {
 	pbs.UnimplementedSessionServiceServer
 
-	repoFn    common.SessionRepoFactory
-	iamRepoFn common.IamRepoFactory
+	repoFn           common.SessionRepoFactory
+	iamRepoFn        common.IamRepoFactory
+	fetchRecordingFn common.RecordingFetcher
+	maxRecordingSize uint64
 }
 
 // NewService returns a session service which handles session related requests to boundary.
-func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory) (Service, error) {
+// Recordings larger than maxRecordingSize bytes can't be downloaded, as they are
+// returned in a single response; DefaultMaxRecordingSize is used if it is 0.
+func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactory, fetchRecordingFn common.RecordingFetcher, maxRecordingSize uint64) (Service, error) {
 	const op = "sessions.NewService"
 	if repoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing session repository")
@@ -58,7 +72,13 @@ func NewService(repoFn common.SessionRepoFactory, iamRepoFn common.IamRepoFactor
 	if iamRepoFn == nil {
 		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing iam repository")
 	}
-	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn}, nil
+	if fetchRecordingFn == nil {
+		return Service{}, errors.NewDeprecated(errors.InvalidParameter, op, "missing recording fetcher")
+	}
+	if maxRecordingSize == 0 {
+		maxRecordingSize = DefaultMaxRecordingSize
+	}
+	return Service{repoFn: repoFn, iamRepoFn: iamRepoFn, fetchRecordingFn: fetchRecordingFn, maxRecordingSize: maxRecordingSize}, nil
 }
 
 var _ pbs.SessionServiceServer = Service{}
@@ -122,6 +142,10 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
@@ -147,55 +171,62 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 		return &pbs.ListSessionsResponse{}, nil
 	}
 
-	sesList, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(sesList) == 0 {
-		return &pbs.ListSessionsResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Session, 0, len(sesList))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("scope_id", "user_id", "target_id", "host_id", "host_set_id", "auth_token_id", "status")
+	var finalItems []*pb.Session
 	res := perms.Resource{
 		Type: resource.Session,
 	}
-	for _, item := range sesList {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.ScopeId
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
-		if len(authorizedActions) == 0 {
-			continue
+	for {
+		sesList, err := s.listFromRepo(ctx, scopeIds, page, conds)
+		if err != nil {
+			return nil, err
 		}
+		for _, item := range sesList {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.ScopeId
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res))
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
-			continue
-		}
+			if authorizedActions.OnlySelf() && item.UserId != authResults.UserId {
+				continue
+			}
 
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
-		}
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.ScopeId]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+			}
 
-		item, err := toProto(ctx, item, outputOpts...)
-		if err != nil {
-			return nil, err
-		}
+			item, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(sesList)) {
+			break
 		}
 	}
 
-	return &pbs.ListSessionsResponse{Items: finalItems}, nil
+	return &pbs.ListSessionsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // CancelSession implements the interface pbs.SessionServiceServer.
@@ -269,6 +300,75 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// DownloadSessionRecording implements the interface pbs.SessionServiceServer.
+func (s Service) DownloadSessionRecording(ctx context.Context, req *pbs.DownloadSessionRecordingRequest) (*pbs.DownloadSessionRecordingResponse, error) {
+	const op = "sessions.(Service).DownloadSessionRecording"
+
+	if err := validateDownloadRecordingRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DownloadRecording)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	ses, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var rec *session.ConnectionRecording
+	for _, c := range ses.Connections {
+		if c.PublicId == req.GetConnectionId() {
+			rec = c.Recording
+			if rec == nil {
+				return nil, handlers.NotFoundErrorf("Connection %q of session %q was not recorded.", req.GetConnectionId(), req.GetId())
+			}
+		}
+	}
+	if rec == nil {
+		return nil, handlers.NotFoundErrorf("Connection %q doesn't exist in session %q.", req.GetConnectionId(), req.GetId())
+	}
+	// The recording is held in memory to be returned in the response.
+	if rec.SizeBytes > s.maxRecordingSize {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition,
+			"Recording of connection %q is %d bytes, which is larger than the maximum download size of %d bytes.", req.GetConnectionId(), rec.SizeBytes, s.maxRecordingSize)
+	}
+
+	// Connections are proxied by the worker the session was activated on,
+	// which is where the recording is stored.
+	rc, err := s.fetchRecordingFn(ctx, ses.ServerId, ses.GetPublicId(), req.GetConnectionId())
+	if err != nil {
+		if stderrors.Is(err, servercommon.ErrRecordingNotFound) {
+			return nil, handlers.NotFoundErrorf("Recording of connection %q not found on worker %q.", req.GetConnectionId(), ses.ServerId)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to fetch recording from worker"))
+	}
+	defer rc.Close()
+
+	// Verify the recording against the metadata reported by the worker when
+	// the connection was closed, reading at most one extra byte.
+	h := sha256.New()
+	content, err := io.ReadAll(io.TeeReader(io.LimitReader(rc, int64(rec.SizeBytes)+1), h))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to read recording from worker"))
+	}
+	if uint64(len(content)) != rec.SizeBytes || hex.EncodeToString(h.Sum(nil)) != rec.Checksum {
+		return nil, errors.New(ctx, errors.Internal, op, "recording does not match its size and checksum")
+	}
+
+	return &pbs.DownloadSessionRecordingResponse{
+		Recording: &pb.Recording{
+			WorkerId:    ses.ServerId,
+			StoragePath: rec.StoragePath,
+			SizeBytes:   rec.SizeBytes,
+			Checksum:    rec.Checksum,
+			StartTime:   rec.StartTime.GetTimestamp(),
+			EndTime:     rec.EndTime.GetTimestamp(),
+		},
+		Content: content,
+	}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -287,12 +387,12 @@ func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session,
 	return sess, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*session.Session, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	sesList, err := repo.ListSessions(ctx, session.WithScopeIds(scopeIds))
+	sesList, err := repo.ListSessions(ctx, session.WithScopeIds(scopeIds), session.WithLimit(page.Limit()), session.WithStartPageAfterId(page.StartAfterId()), session.WithFilterConditions(conds))
 	if err != nil {
 		return nil, err
 	}
@@ -334,7 +434,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.DownloadRecording:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -403,6 +503,9 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	if outputFields.Has(globals.EndpointField) {
 		out.Endpoint = in.Endpoint
 	}
+	if outputFields.Has(globals.HostSelectionStrategyField) {
+		out.HostSelectionStrategy = in.HostSelectionStrategy
+	}
 	if outputFields.Has(globals.HostIdField) {
 		out.HostId = in.HostId
 	}
@@ -446,7 +549,8 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 		if outputFields.Has(globals.ConnectionsField) {
 			connections := make([]*pb.Connection, 0, len(in.Connections))
 			for _, c := range in.Connections {
-				connections = append(connections, &pb.Connection{
+				pc := &pb.Connection{
+					Id:                 c.PublicId,
 					ClientTcpAddress:   c.ClientTcpAddress,
 					ClientTcpPort:      c.ClientTcpPort,
 					EndpointTcpAddress: c.EndpointTcpAddress,
@@ -454,7 +558,20 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 					BytesUp:            c.BytesUp,
 					BytesDown:          c.BytesDown,
 					ClosedReason:       c.ClosedReason,
-				})
+				}
+				if c.Recording != nil {
+					// Connections are proxied by the worker the session was
+					// activated on, which is where the recording is stored.
+					pc.Recording = &pb.Recording{
+						WorkerId:    in.ServerId,
+						StoragePath: c.Recording.StoragePath,
+						SizeBytes:   c.Recording.SizeBytes,
+						Checksum:    c.Recording.Checksum,
+						StartTime:   c.Recording.StartTime.GetTimestamp(),
+						EndTime:     c.Recording.EndTime.GetTimestamp(),
+					}
+				}
+				connections = append(connections, pc)
 			}
 			out.Connections = append(out.Connections, connections...)
 		}
@@ -500,3 +617,17 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	}
 	return nil
 }
+
+func validateDownloadRecordingRequest(req *pbs.DownloadSessionRecordingRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), session.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetConnectionId()), session.ConnectionPrefix) {
+		badFields["connection_id"] = "Improperly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
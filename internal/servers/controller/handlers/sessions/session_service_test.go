@@ -1,15 +1,21 @@
 package sessions_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/http/httptest"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
@@ -17,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/servers"
+	servercommon "github.com/hashicorp/boundary/internal/servers/common"
 	"github.com/hashicorp/boundary/internal/servers/controller/auth"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/sessions"
@@ -32,7 +39,7 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self"}
+var testAuthorizedActions = []string{"no-op", "read", "read:self", "cancel", "cancel:self", "download-recording"}
 
 func TestGetSession(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
@@ -128,7 +135,7 @@ func TestGetSession(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn, noRecordingFn, 0)
 			require.NoError(err, "Couldn't create new session service.")
 
 			got, gErr := s.GetSession(auth.DisabledAuthTestContext(iamRepoFn, tc.scopeId), tc.req)
@@ -189,7 +196,7 @@ func TestList_Self(t *testing.T) {
 		Endpoint:    "tcp://127.0.0.1:22",
 	})
 
-	s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+	s, err := sessions.NewService(sessRepoFn, iamRepoFn, noRecordingFn, 0)
 	require.NoError(t, err, "Couldn't create new session service.")
 
 	cases := []struct {
@@ -408,7 +415,7 @@ func TestList(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			require, assert := require.New(t), assert.New(t)
-			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn, noRecordingFn, 0)
 			require.NoError(err, "Couldn't create new session service.")
 
 			// Test without anon user
@@ -419,6 +426,10 @@ func TestList(t *testing.T) {
 				return
 			}
 			require.NoError(gErr)
+			// Items are listed in order of their id.
+			sort.Slice(tc.res.Items, func(i, j int) bool {
+				return tc.res.Items[i].GetId() < tc.res.Items[j].GetId()
+			})
 			if tc.res != nil {
 				require.Equal(len(tc.res.GetItems()), len(got.GetItems()), "Didn't get expected number of sessions: %v", got.GetItems())
 				for i, wantSess := range tc.res.GetItems() {
@@ -573,7 +584,7 @@ func TestCancel(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := sessions.NewService(sessRepoFn, iamRepoFn)
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn, noRecordingFn, 0)
 			require.NoError(err, "Couldn't create new session service.")
 
 			tc.req.Version = version
@@ -630,3 +641,155 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func noRecordingFn(context.Context, string, string, string) (io.ReadCloser, error) {
+	return nil, servercommon.ErrRecordingNotFound
+}
+
+func TestDownloadRecording(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	rw := db.New(conn)
+	sessRepo, err := session.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func() (*session.Repository, error) {
+		return sessRepo, nil
+	}
+
+	sess := session.TestDefaultSession(t, conn, wrap, iamRepo)
+	worker := session.TestWorker(t, conn, wrap)
+	sess, _, err = sessRepo.ActivateSession(context.Background(), sess.PublicId, sess.Version, worker.PrivateId, worker.Type, session.TestTofu(t))
+	require.NoError(t, err)
+	recorded := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+	unrecorded := session.TestConnection(t, conn, sess.PublicId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+
+	content := []byte(`{"version":1}` + "\n")
+	sum := sha256.Sum256(content)
+	rec := &session.ConnectionRecording{
+		StoragePath: "/recordings/" + sess.PublicId + "/" + recorded.PublicId + ".rec",
+		SizeBytes:   uint64(len(content)),
+		Checksum:    hex.EncodeToString(sum[:]),
+		StartTime:   timestamp.Now(),
+		EndTime:     timestamp.Now(),
+	}
+	_, err = sessRepo.CloseConnections(context.Background(), []session.CloseWith{
+		{
+			ConnectionId: recorded.PublicId,
+			ClosedReason: session.ConnectionClosedByUser,
+			Recording:    rec,
+		},
+		{
+			ConnectionId: unrecorded.PublicId,
+			ClosedReason: session.ConnectionClosedByUser,
+		},
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		req     *pbs.DownloadSessionRecordingRequest
+		fetched []byte
+		maxSize uint64
+		res     *pbs.DownloadSessionRecordingResponse
+		err     error
+	}{
+		{
+			name:    "Download a recording",
+			req:     &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recorded.PublicId},
+			fetched: content,
+			res: &pbs.DownloadSessionRecordingResponse{
+				Recording: &pb.Recording{
+					WorkerId:    worker.PrivateId,
+					StoragePath: rec.StoragePath,
+					SizeBytes:   rec.SizeBytes,
+					Checksum:    rec.Checksum,
+					StartTime:   rec.StartTime.GetTimestamp(),
+					EndTime:     rec.EndTime.GetTimestamp(),
+				},
+				Content: content,
+			},
+		},
+		{
+			name:    "Corrupted recording",
+			req:     &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recorded.PublicId},
+			fetched: []byte(`{"version":2}` + "\n"),
+			err:     errors.E(context.Background(), errors.WithCode(errors.Internal)),
+		},
+		{
+			name:    "Truncated recording",
+			req:     &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recorded.PublicId},
+			fetched: content[:4],
+			err:     errors.E(context.Background(), errors.WithCode(errors.Internal)),
+		},
+		{
+			name:    "Recording larger than the maximum size",
+			req:     &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recorded.PublicId},
+			fetched: content,
+			maxSize: uint64(len(content)) - 1,
+			err:     handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name: "Recording missing on the worker",
+			req:  &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: recorded.PublicId},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Connection not recorded",
+			req:  &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: unrecorded.PublicId},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Connection of another session",
+			req:  &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: session.ConnectionPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Download from a non existing Session",
+			req:  &pbs.DownloadSessionRecordingRequest{Id: session.SessionPrefix + "_DoesntExis", ConnectionId: recorded.PublicId},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong connection id prefix",
+			req:  &pbs.DownloadSessionRecordingRequest{Id: sess.PublicId, ConnectionId: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			fetchFn := func(_ context.Context, workerId, sessionId, connectionId string) (io.ReadCloser, error) {
+				assert.Equal(worker.PrivateId, workerId)
+				assert.Equal(tc.req.GetId(), sessionId)
+				assert.Equal(tc.req.GetConnectionId(), connectionId)
+				if tc.fetched == nil {
+					return nil, servercommon.ErrRecordingNotFound
+				}
+				return io.NopCloser(bytes.NewReader(tc.fetched)), nil
+			}
+			s, err := sessions.NewService(sessRepoFn, iamRepoFn, fetchFn, tc.maxSize)
+			require.NoError(err, "Couldn't create new session service.")
+
+			got, gErr := s.DownloadSessionRecording(auth.DisabledAuthTestContext(iamRepoFn, sess.ScopeId), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				if errors.Match(errors.T(errors.Internal), gErr) {
+					assert.True(errors.Match(errors.T(tc.err), gErr), "DownloadSessionRecording(%+v) got error %#v, wanted %#v", tc.req, gErr, tc.err)
+				} else {
+					assert.True(errors.Is(gErr, tc.err), "DownloadSessionRecording(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				}
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "DownloadSessionRecording(%q) got response\n%q, wanted\n%q", tc.req, got, tc.res)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package targets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+// selectEndpoint chooses the endpoint of a new session of the user among
+// endpoints, which must not be empty, with the host selection strategy of the
+// target.
+func (s Service) selectEndpoint(ctx context.Context, t target.Target, userId string, endpoints []*host.Endpoint) (*host.Endpoint, error) {
+	const op = "targets.(Service).selectEndpoint"
+	switch target.HostSelectionStrategy(t.GetHostSelectionStrategy()) {
+	case target.RoundRobinHostSelection:
+		sessionRepo, err := s.sessionRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		lastTimes, err := sessionRepo.LastSessionTimesByHost(ctx, t.GetPublicId(), endpointHostIds(endpoints))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return leastRecentlyUsedEndpoint(endpoints, lastTimes), nil
+	case target.LeastSessionsHostSelection:
+		sessionRepo, err := s.sessionRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		counts, err := sessionRepo.ActiveSessionCountsByHost(ctx, endpointHostIds(endpoints))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return leastSessionsEndpoint(endpoints, counts), nil
+	case target.StickyPerUserHostSelection:
+		return userEndpoint(endpoints, userId), nil
+	default:
+		return endpoints[rand.Intn(len(endpoints))], nil
+	}
+}
+
+// endpointHostIds returns the ids of the hosts of endpoints without
+// duplicates. A host can be in several host sets of a target.
+func endpointHostIds(endpoints []*host.Endpoint) []string {
+	ids := make([]string, 0, len(endpoints))
+	seen := make(map[string]bool, len(endpoints))
+	for _, ep := range endpoints {
+		if !seen[ep.HostId] {
+			seen[ep.HostId] = true
+			ids = append(ids, ep.HostId)
+		}
+	}
+	return ids
+}
+
+// sortedEndpoints returns a copy of endpoints sorted by host id and set id, so
+// the strategies choose the same endpoint whatever the order in which the host
+// sources returned them.
+func sortedEndpoints(endpoints []*host.Endpoint) []*host.Endpoint {
+	sorted := make([]*host.Endpoint, len(endpoints))
+	copy(sorted, endpoints)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].HostId != sorted[j].HostId {
+			return sorted[i].HostId < sorted[j].HostId
+		}
+		return sorted[i].SetId < sorted[j].SetId
+	})
+	return sorted
+}
+
+// leastRecentlyUsedEndpoint returns the endpoint whose host has gone the
+// longest without a session, given the time of the last session of each host
+// in lastTimes. Hosts which never had a session come first. Choosing the least
+// recently used host for each new session cycles through the hosts in turn.
+func leastRecentlyUsedEndpoint(endpoints []*host.Endpoint, lastTimes map[string]time.Time) *host.Endpoint {
+	var chosen *host.Endpoint
+	var chosenTime time.Time
+	for _, ep := range sortedEndpoints(endpoints) {
+		last := lastTimes[ep.HostId]
+		if chosen == nil || last.Before(chosenTime) {
+			chosen, chosenTime = ep, last
+		}
+	}
+	return chosen
+}
+
+// leastSessionsEndpoint returns an endpoint whose host has the fewest
+// sessions, given the number of sessions of each host in counts. Ties are
+// broken at random so the hosts share the load when sessions are authorized
+// at the same time.
+func leastSessionsEndpoint(endpoints []*host.Endpoint, counts map[string]int) *host.Endpoint {
+	var least []*host.Endpoint
+	fewest := -1
+	for _, ep := range endpoints {
+		switch c := counts[ep.HostId]; {
+		case fewest == -1 || c < fewest:
+			least, fewest = []*host.Endpoint{ep}, c
+		case c == fewest:
+			least = append(least, ep)
+		}
+	}
+	return least[rand.Intn(len(least))]
+}
+
+// userEndpoint returns the endpoint of the user using rendezvous hashing: the
+// host with the highest hash of the user id and host id is chosen. The user
+// keeps getting the same host while it is available, and only the users of a
+// host which becomes unavailable are moved to other hosts.
+func userEndpoint(endpoints []*host.Endpoint, userId string) *host.Endpoint {
+	var chosen *host.Endpoint
+	var chosenWeight uint64
+	for _, ep := range sortedEndpoints(endpoints) {
+		sum := sha256.Sum256([]byte(userId + "/" + ep.HostId))
+		weight := binary.BigEndian.Uint64(sum[:8])
+		if chosen == nil || weight > chosenWeight {
+			chosen, chosenWeight = ep, weight
+		}
+	}
+	return chosen
+}
@@ -0,0 +1,111 @@
+package targets
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEndpoints(n int) []*host.Endpoint {
+	var eps []*host.Endpoint
+	for i := 0; i < n; i++ {
+		eps = append(eps, &host.Endpoint{
+			HostId:  fmt.Sprintf("hst_%010d", i),
+			SetId:   "hsst_1234567890",
+			Address: fmt.Sprintf("10.0.0.%d", i),
+		})
+	}
+	return eps
+}
+
+func TestEndpointHostIds(t *testing.T) {
+	eps := testEndpoints(2)
+	eps = append(eps, &host.Endpoint{HostId: eps[0].HostId, SetId: "hsst_0987654321", Address: eps[0].Address})
+	assert.Equal(t, []string{eps[0].HostId, eps[1].HostId}, endpointHostIds(eps))
+}
+
+func TestLeastRecentlyUsedEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	eps := testEndpoints(3)
+
+	// Choosing the least recently used host for each new session cycles
+	// through the hosts, whatever the order of the endpoints.
+	lastTimes := map[string]time.Time{}
+	now := time.Now()
+	var got []string
+	for i := 0; i < 6; i++ {
+		ep := leastRecentlyUsedEndpoint([]*host.Endpoint{eps[2], eps[0], eps[1]}, lastTimes)
+		got = append(got, ep.HostId)
+		lastTimes[ep.HostId] = now.Add(time.Duration(i) * time.Second)
+	}
+	assert.Equal([]string{
+		eps[0].HostId, eps[1].HostId, eps[2].HostId,
+		eps[0].HostId, eps[1].HostId, eps[2].HostId,
+	}, got)
+
+	// A host added to the target is chosen first.
+	eps = append(eps, testEndpoints(4)[3])
+	assert.Equal(eps[3], leastRecentlyUsedEndpoint(eps, lastTimes))
+}
+
+func TestLeastSessionsEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	eps := testEndpoints(3)
+
+	counts := map[string]int{eps[0].HostId: 3, eps[1].HostId: 1, eps[2].HostId: 2}
+	assert.Equal(eps[1], leastSessionsEndpoint(eps, counts))
+
+	// Hosts without sessions are not in the counts.
+	delete(counts, eps[2].HostId)
+	assert.Equal(eps[2], leastSessionsEndpoint(eps, counts))
+
+	// Ties are broken among the hosts with the fewest sessions only.
+	counts = map[string]int{eps[0].HostId: 1}
+	for i := 0; i < 20; i++ {
+		assert.NotEqual(eps[0], leastSessionsEndpoint(eps, counts))
+	}
+}
+
+func TestUserEndpoint(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	eps := testEndpoints(5)
+
+	chosen := userEndpoint(eps, "u_1234567890")
+	require.NotNil(chosen)
+	for i := 0; i < 5; i++ {
+		assert.Equal(chosen, userEndpoint(eps, "u_1234567890"))
+	}
+	// The order of the endpoints does not matter.
+	reversed := make([]*host.Endpoint, 0, len(eps))
+	for i := len(eps) - 1; i >= 0; i-- {
+		reversed = append(reversed, eps[i])
+	}
+	assert.Equal(chosen, userEndpoint(reversed, "u_1234567890"))
+
+	// The user keeps the same host when another host is removed, and gets
+	// another host when its host is removed.
+	var others, without []*host.Endpoint
+	for _, ep := range eps {
+		if ep != chosen {
+			others = append(others, ep)
+		}
+	}
+	for _, ep := range eps {
+		if ep != others[0] {
+			without = append(without, ep)
+		}
+	}
+	assert.Equal(chosen, userEndpoint(without, "u_1234567890"))
+	assert.NotEqual(chosen, userEndpoint(others, "u_1234567890"))
+
+	// Users are spread over the hosts.
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		seen[userEndpoint(eps, fmt.Sprintf("u_%010d", i)).HostId] = true
+	}
+	assert.Greater(len(seen), 1)
+}
@@ -0,0 +1,87 @@
+package ssh
+
+import (
+	"io"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/target/ssh/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	xssh "golang.org/x/crypto/ssh"
+)
+
+type attribute struct {
+	*pb.SshTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetKnownHosts().GetValue() != "" {
+		opts = append(opts, target.WithKnownHosts(a.GetKnownHosts().GetValue()))
+	}
+	return opts
+}
+
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil && a.GetDefaultPort().GetValue() == 0 {
+		badFields["attributes.default_port"] = "This optional field cannot be set to 0."
+	}
+	if a.GetKnownHosts() != nil && !validKnownHosts(a.GetKnownHosts().GetValue()) {
+		badFields["attributes.known_hosts"] = "This optional field must contain at least one host key in the known_hosts format."
+	}
+	return badFields
+}
+
+// validKnownHosts reports whether knownHosts contains at least one host key
+// and no malformed entries.
+func validKnownHosts(knownHosts string) bool {
+	in := []byte(knownHosts)
+	var keys int
+	for {
+		_, _, _, _, rest, err := xssh.ParseKnownHosts(in)
+		if err == io.EOF {
+			return keys > 0
+		}
+		if err != nil {
+			return false
+		}
+		keys++
+		in = rest
+	}
+}
+
+func newAttribute(t target.Target) targets.Attributes {
+	a := &attribute{
+		&pb.SshTargetAttributes{},
+	}
+	if t != nil {
+		if t.GetDefaultPort() > 0 {
+			a.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+		}
+		if t.GetKnownHosts() != "" {
+			a.KnownHosts = &wrappers.StringValue{Value: t.GetKnownHosts()}
+		}
+	}
+	return a
+}
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		handlers.MaskDestination{&store.Target{}},
+		handlers.MaskSource{&pb.Target{}, &pb.SshTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(ssh.Subtype, maskManager, newAttribute)
+}
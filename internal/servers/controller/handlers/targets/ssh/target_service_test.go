@@ -0,0 +1,390 @@
+package ssh_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/credential/vault"
+	"github.com/hashicorp/boundary/internal/db"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
+	spbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"github.com/hashicorp/boundary/internal/host/plugin"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers"
+	spb "github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/controller/auth"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	wrapping "github.com/hashicorp/go-kms-wrapping"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/ssh"
+)
+
+var testAuthorizedActions = []string{
+	"no-op",
+	"read",
+	"update",
+	"delete",
+	"add-host-sets",
+	"set-host-sets",
+	"remove-host-sets",
+	"add-host-sources",
+	"set-host-sources",
+	"remove-host-sources",
+	"add-credential-libraries",
+	"set-credential-libraries",
+	"remove-credential-libraries",
+	"add-credential-sources",
+	"set-credential-sources",
+	"remove-credential-sources",
+	"authorize-session",
+}
+
+func testService(t *testing.T, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapper) (targets.Service, error) {
+	rw := db.New(conn)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	repoFn := func() (*target.Repository, error) {
+		return target.NewRepository(rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	credentialRepoFn := func() (*vault.Repository, error) {
+		return vault.NewRepository(rw, rw, kms, sche)
+	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, healthRepoFn, credentialRepoFn, staticCredRepoFn)
+}
+
+const testKnownHosts = "host.example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIP2ok+F+7IyWHHZZr9XskQHGqkk8RXzgddLHZgsSnbSk\n"
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	cases := []struct {
+		name string
+		req  *pbs.CreateTargetRequest
+		res  *pbs.CreateTargetResponse
+		err  error
+	}{
+		{
+			name: "Create a valid target",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:     proj.GetPublicId(),
+				Name:        wrapperspb.String("name"),
+				Description: wrapperspb.String("desc"),
+				Type:        ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port": structpb.NewNumberValue(22),
+				}},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", ssh.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId:     proj.GetPublicId(),
+					Scope:       &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:        wrapperspb.String("name"),
+					Description: wrapperspb.String("desc"),
+					Type:        ssh.Subtype.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port": structpb.NewNumberValue(22),
+					}},
+					SessionMaxSeconds:            wrapperspb.UInt32(28800),
+					SessionConnectionLimit:       wrapperspb.Int32(1),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Create with known hosts",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("known hosts"),
+				Type:    ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port": structpb.NewNumberValue(22),
+					"known_hosts":  structpb.NewStringValue(testKnownHosts),
+				}},
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", ssh.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("known hosts"),
+					Type:    ssh.Subtype.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port": structpb.NewNumberValue(22),
+						"known_hosts":  structpb.NewStringValue(testKnownHosts),
+					}},
+					SessionMaxSeconds:            wrapperspb.UInt32(28800),
+					SessionConnectionLimit:       wrapperspb.Int32(1),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Create with malformed known hosts",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Type:    ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"known_hosts": structpb.NewStringValue("host.example.com not-a-key"),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with default port 0",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:     proj.GetPublicId(),
+				Name:        wrapperspb.String("name"),
+				Description: wrapperspb.String("desc"),
+				Type:        ssh.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port": structpb.NewNumberValue(0),
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+
+			s, err := testService(t, conn, kms, wrapper)
+			require.NoError(err, "Failed to create a new target service.")
+
+			got, gErr := s.CreateTarget(auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreateTarget(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+			} else {
+				assert.Nil(gErr, "Unexpected err: %v", gErr)
+			}
+
+			if got != nil {
+				assert.Contains(got.GetUri(), tc.res.GetUri())
+				assert.True(strings.HasPrefix(got.GetItem().GetId(), ssh.TargetPrefix), got.GetItem().GetId())
+
+				// Clear all values which are hard to compare against.
+				got.Uri, tc.res.Uri = "", ""
+				got.Item.Id, tc.res.Item.Id = "", ""
+				got.Item.CreatedTime, got.Item.UpdatedTime, tc.res.Item.CreatedTime, tc.res.Item.UpdatedTime = nil, nil, nil, nil
+			}
+			if tc.res != nil {
+				tc.res.Item.Version = 1
+			}
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform()), "CreateTarget(%q)\n got response %q\n, wanted %q\n", tc.req, got, tc.res)
+		})
+	}
+}
+
+func TestAddTargetCredentialSources_OnlyEgress(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err)
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, proj.GetPublicId())
+
+	store := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
+	cred := credstatic.TestSshPrivateKeyCredential(t, conn, wrapper, proj.GetPublicId(), store.GetPublicId(), "alice", credstatic.TestSshPrivateKey(t))
+
+	t.Run("application", func(t *testing.T) {
+		tar := ssh.TestTarget(context.Background(), t, conn, proj.GetPublicId(), "application")
+		_, err := s.AddTargetCredentialSources(ctx, &pbs.AddTargetCredentialSourcesRequest{
+			Id:                             tar.GetPublicId(),
+			Version:                        tar.GetVersion(),
+			ApplicationCredentialSourceIds: []string{cred.GetPublicId()},
+		})
+		require.Error(t, err)
+	})
+	t.Run("egress", func(t *testing.T) {
+		tar := ssh.TestTarget(context.Background(), t, conn, proj.GetPublicId(), "egress")
+		got, err := s.AddTargetCredentialSources(ctx, &pbs.AddTargetCredentialSourcesRequest{
+			Id:                        tar.GetPublicId(),
+			Version:                   tar.GetVersion(),
+			EgressCredentialSourceIds: []string{cred.GetPublicId()},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{cred.GetPublicId()}, got.GetItem().GetEgressCredentialSourceIds())
+	})
+}
+
+func TestAuthorizeSession(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
+
+	org, proj := iam.TestScopes(t, iamRepo)
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	ctx = auth.NewVerifierContext(requests.NewRequestContext(ctx),
+		iamRepoFn,
+		atRepoFn,
+		serversRepoFn,
+		kms,
+		&authpb.RequestInfo{
+			Token:       at.GetToken(),
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+		})
+
+	r := iam.TestRole(t, conn, proj.GetPublicId())
+	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
+	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
+
+	s, err := testService(t, conn, kms, wrapper)
+	require.NoError(t, err)
+
+	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	shs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	_ = static.TestSetMembers(t, conn, shs.GetPublicId(), []*static.Host{h})
+
+	store := credstatic.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
+	privKey := credstatic.TestSshPrivateKey(t)
+	cred := credstatic.TestSshPrivateKeyCredential(t, conn, wrapper, proj.GetPublicId(), store.GetPublicId(), "alice", privKey)
+
+	tar := ssh.TestTarget(ctx, t, conn, proj.GetPublicId(), "ssh target")
+	apiTar, err := s.AddTargetHostSources(ctx, &pbs.AddTargetHostSourcesRequest{
+		Id:            tar.GetPublicId(),
+		Version:       tar.GetVersion(),
+		HostSourceIds: []string{shs.GetPublicId()},
+	})
+	require.NoError(t, err)
+	_, err = s.AddTargetCredentialSources(ctx, &pbs.AddTargetCredentialSourcesRequest{
+		Id:                        tar.GetPublicId(),
+		Version:                   apiTar.GetItem().GetVersion(),
+		EgressCredentialSourceIds: []string{cred.GetPublicId()},
+	})
+	require.NoError(t, err)
+
+	// Tell our DB that there is a worker ready to serve the data
+	workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, staticHostRepoFn, pluginHostRepoFn, healthRepoFn, &sync.Map{}, kms)
+	_, err = workerService.Status(ctx, &spbs.StatusRequest{
+		Worker: &spb.Server{
+			PrivateId: "testworker",
+			Address:   "localhost:8457",
+		},
+	})
+	require.NoError(t, err)
+
+	asRes, err := s.AuthorizeSession(ctx, &pbs.AuthorizeSessionRequest{
+		Id: tar.GetPublicId(),
+	})
+	require.NoError(t, err)
+	got := asRes.GetItem()
+	assert.Equal(t, ssh.Subtype.String(), got.GetType())
+	assert.Equal(t, fmt.Sprintf("ssh://%s", h.GetAddress()), got.GetEndpoint())
+	// Egress credentials are only given to the worker.
+	assert.Empty(t, got.GetCredentials())
+
+	lookupRes, err := workerService.LookupSession(ctx, &spbs.LookupSessionRequest{
+		SessionId: got.GetSessionId(),
+	})
+	require.NoError(t, err)
+	require.Len(t, lookupRes.GetCredentials(), 1)
+	gotKey := lookupRes.GetCredentials()[0].GetSshPrivateKey()
+	require.NotNil(t, gotKey)
+	assert.Equal(t, "alice", gotKey.GetUsername())
+	assert.Equal(t, privKey, gotKey.GetPrivateKey())
+}
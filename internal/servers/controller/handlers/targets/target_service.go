@@ -6,15 +6,16 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
-	"math/rand"
 	"net/url"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/host"
@@ -74,6 +75,10 @@ var (
 		action.Create,
 		action.List,
 	}
+
+	// credentialSourcePrefixes contains the id prefixes of the resources
+	// that can be added to a target as a credential source.
+	credentialSourcePrefixes = append([]string{vault.CredentialLibraryPrefix}, credstatic.CredentialPrefixes()...)
 )
 
 // Service handles request as described by the pbs.TargetServiceServer interface.
@@ -86,7 +91,9 @@ type Service struct {
 	sessionRepoFn    common.SessionRepoFactory
 	pluginHostRepoFn common.PluginHostRepoFactory
 	staticHostRepoFn common.StaticRepoFactory
+	healthRepoFn     common.HostHealthRepoFactory
 	vaultCredRepoFn  common.VaultCredentialRepoFactory
+	staticCredRepoFn common.StaticCredentialRepoFactory
 	kmsCache         *kms.Kms
 }
 
@@ -100,7 +107,9 @@ func NewService(
 	sessionRepoFn common.SessionRepoFactory,
 	pluginHostRepoFn common.PluginHostRepoFactory,
 	staticHostRepoFn common.StaticRepoFactory,
-	vaultCredRepoFn common.VaultCredentialRepoFactory) (Service, error) {
+	healthRepoFn common.HostHealthRepoFactory,
+	vaultCredRepoFn common.VaultCredentialRepoFactory,
+	staticCredRepoFn common.StaticCredentialRepoFactory) (Service, error) {
 	const op = "targets.NewService"
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target repository")
@@ -120,9 +129,15 @@ func NewService(
 	if staticHostRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static host repository")
 	}
+	if healthRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing host health repository")
+	}
 	if vaultCredRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
+	if staticCredRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
+	}
 	return Service{
 		repoFn:           repoFn,
 		iamRepoFn:        iamRepoFn,
@@ -130,7 +145,9 @@ func NewService(
 		sessionRepoFn:    sessionRepoFn,
 		pluginHostRepoFn: pluginHostRepoFn,
 		staticHostRepoFn: staticHostRepoFn,
+		healthRepoFn:     healthRepoFn,
 		vaultCredRepoFn:  vaultCredRepoFn,
+		staticCredRepoFn: staticCredRepoFn,
 		kmsCache:         kmsCache,
 	}, nil
 }
@@ -142,6 +159,10 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -166,50 +187,57 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 		return &pbs.ListTargetsResponse{}, nil
 	}
 
-	tl, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(tl) == 0 {
-		return &pbs.ListTargetsResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.Target, 0, len(tl))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("name", "type", "scope_id")
+	var finalItems []*pb.Target
 	res := perms.Resource{
 		Type: resource.Target,
 	}
-	for _, item := range tl {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, nil, outputOpts...)
+	for {
+		tl, err := s.listFromRepo(ctx, scopeIds, page, conds)
 		if err != nil {
 			return nil, err
 		}
+		for _, item := range tl {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
 
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, item, nil, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(tl)) {
+			break
 		}
 	}
-	return &pbs.ListTargetsResponse{Items: finalItems}, nil
+	return &pbs.ListTargetsResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetTargets implements the interface pbs.TargetServiceServer.
@@ -872,18 +900,26 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	// worker IDs below is used to contain their IDs in the same order. This is
 	// used to fetch tags for filtering. But we avoid allocation unless we
 	// actually need it.
+	//
+	// Workers which connect to the controllers through upstream workers are
+	// reached through the first worker of their chain of upstream workers, so
+	// that is the address given to the client.
 	var workers []*pb.WorkerInfo
 	var workerIds []string
 	hasWorkerFilter := len(t.GetWorkerFilter()) > 0
-	servers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	workerServers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
 	if err != nil {
 		return nil, err
 	}
-	for _, v := range servers {
+	for _, v := range workerServers {
+		ingress := servers.IngressWorker(workerServers, v.GetPrivateId())
+		if ingress == nil {
+			continue
+		}
 		if hasWorkerFilter {
 			workerIds = append(workerIds, v.GetPrivateId())
 		}
-		workers = append(workers, &pb.WorkerInfo{Address: v.Address})
+		workers = append(workers, &pb.WorkerInfo{Address: ingress.Address})
 	}
 
 	if hasWorkerFilter && len(workerIds) > 0 {
@@ -934,6 +970,7 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 		workers = finalWorkers
 	}
+	workers = uniqueWorkerAddresses(workers)
 	if len(workers) == 0 {
 		return nil, handlers.ApiErrorWithCodeAndMessage(
 			codes.FailedPrecondition,
@@ -976,7 +1013,18 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		endpoints = append(endpoints, eps...)
 	}
 
+	// Skip the endpoints whose host failed the last health check of its set.
+	// Hosts without a recent result are considered available.
+	allEndpoints := endpoints
+	if len(endpoints) > 0 {
+		endpoints, err = s.healthyEndpoints(ctx, endpoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var chosenEndpoint *host.Endpoint
+	var selectionStrategy string
 	if requestedId != "" {
 		for _, ep := range endpoints {
 			if ep.HostId == requestedId {
@@ -984,6 +1032,15 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 			}
 		}
 		if chosenEndpoint == nil {
+			for _, ep := range allEndpoints {
+				if ep.HostId == requestedId {
+					return nil, handlers.InvalidArgumentErrorf(
+						"Errors in provided fields.",
+						map[string]string{
+							"host_id": "The requested host is unhealthy.",
+						})
+				}
+			}
 			// We didn't find it
 			return nil, handlers.InvalidArgumentErrorf(
 				"Errors in provided fields.",
@@ -995,10 +1052,19 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	if chosenEndpoint == nil {
 		if len(endpoints) == 0 {
+			if len(allEndpoints) > 0 {
+				return nil, handlers.ApiErrorWithCodeAndMessage(
+					codes.FailedPrecondition,
+					"All hosts from available target host sources are unhealthy.")
+			}
 			// No hosts were found, error
 			return nil, handlers.NotFoundErrorf("No endpoint found from available target host sources.")
 		}
-		chosenEndpoint = endpoints[rand.Intn(len(endpoints))]
+		chosenEndpoint, err = s.selectEndpoint(ctx, t, authResults.UserId, endpoints)
+		if err != nil {
+			return nil, err
+		}
+		selectionStrategy = t.GetHostSelectionStrategy()
 	}
 
 	// Generate the endpoint URL
@@ -1014,7 +1080,16 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 
 	var reqs []credential.Request
 	var dynCreds []*session.DynamicCredential
+	staticPurposes := make(map[string][]credential.Purpose)
+	var staticIds []string
 	for _, l := range libs {
+		if credential.SubtypeFromId(l.Id()) == credstatic.Subtype {
+			if _, ok := staticPurposes[l.Id()]; !ok {
+				staticIds = append(staticIds, l.Id())
+			}
+			staticPurposes[l.Id()] = append(staticPurposes[l.Id()], l.CredentialPurpose())
+			continue
+		}
 		reqs = append(reqs, credential.Request{
 			SourceId: l.Id(),
 			Purpose:  l.CredentialPurpose(),
@@ -1025,17 +1100,22 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	expTime := timestamppb.Now()
 	expTime.Seconds += int64(t.GetSessionMaxSeconds())
 	sessionComposition := session.ComposedOf{
-		UserId:             authResults.UserId,
-		HostId:             chosenEndpoint.HostId,
-		TargetId:           t.GetPublicId(),
-		HostSetId:          chosenEndpoint.SetId,
-		AuthTokenId:        authResults.AuthTokenId,
-		ScopeId:            authResults.Scope.Id,
-		Endpoint:           endpointUrl.String(),
-		ExpirationTime:     &timestamp.Timestamp{Timestamp: expTime},
-		ConnectionLimit:    t.GetSessionConnectionLimit(),
-		WorkerFilter:       t.GetWorkerFilter(),
-		DynamicCredentials: dynCreds,
+		UserId:                       authResults.UserId,
+		HostId:                       chosenEndpoint.HostId,
+		TargetId:                     t.GetPublicId(),
+		HostSetId:                    chosenEndpoint.SetId,
+		AuthTokenId:                  authResults.AuthTokenId,
+		ScopeId:                      authResults.Scope.Id,
+		Endpoint:                     endpointUrl.String(),
+		ExpirationTime:               &timestamp.Timestamp{Timestamp: expTime},
+		ConnectionLimit:              t.GetSessionConnectionLimit(),
+		MaxBytesUp:                   t.GetSessionMaxBytesUp(),
+		MaxBytesDown:                 t.GetSessionMaxBytesDown(),
+		ConnectionIdleTimeoutSeconds: t.GetConnectionIdleTimeoutSeconds(),
+		HostSelectionStrategy:        selectionStrategy,
+		WorkerFilter:                 t.GetWorkerFilter(),
+		EndpointKnownHosts:           t.GetKnownHosts(),
+		DynamicCredentials:           dynCreds,
 	}
 
 	sess, err := session.New(sessionComposition)
@@ -1046,8 +1126,13 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	if err != nil {
 		return nil, err
 	}
-	sess, privKey, err := sessionRepo.CreateSession(ctx, wrapper, sess)
+	sess, privKey, err := sessionRepo.CreateSession(ctx, wrapper, sess, session.WithUserSessionLimit(t.GetUserSessionLimit()))
 	if err != nil {
+		if errors.Match(errors.T(errors.SessionLimitExceeded), err) {
+			return nil, handlers.ApiErrorWithCodeAndMessage(
+				codes.FailedPrecondition,
+				"The user has reached the maximum number of sessions allowed for this target.")
+		}
 		return nil, err
 	}
 
@@ -1063,46 +1148,38 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}
 	}
 
+	var staticCreds []credential.Static
+	if len(staticIds) > 0 {
+		staticRepo, err := s.staticCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		staticCreds, err = staticRepo.Retrieve(ctx, authResults.Scope.Id, staticIds)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
 	var creds []*pb.SessionCredential
 	var workerCreds []session.Credential
 	for _, c := range cs {
 		switch c.Purpose() {
 		case credential.EgressPurpose:
-			m, err := credentialToProto(ctx, c)
+			data, err := marshalWorkerCredential(ctx, c)
 			if err != nil {
 				return nil, errors.Wrap(ctx, err, op)
 			}
-			data, err := proto.Marshal(m)
-			if err != nil {
-				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to proto"))
-			}
 			workerCreds = append(workerCreds, data)
 
 		case credential.ApplicationPurpose:
 			l := c.Library()
-			secret := c.Secret()
-			// TODO: Access the json directly from the vault response instead of re-marshalling it.
-			jSecret, err := json.Marshal(secret)
+			secret, err := sessionSecret(ctx, c.Secret())
 			if err != nil {
-				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to json"))
+				return nil, errors.Wrap(ctx, err, op)
 			}
-			var sSecret *structpb.Struct
-			switch secret.(type) {
-			case map[string]interface{}:
-				// In this case we actually have to re-decode it. The proto wrappers
-				// choke on json.Number and at the time I'm writing this I don't
-				// have time to write a walk function to dig through with reflect
-				// and find all json.Numbers and replace them. So we eat the
-				// inefficiency. So note that we are specifically _not_ using a
-				// decoder with UseNumber here.
-				var dSecret map[string]interface{}
-				if err := json.Unmarshal(jSecret, &dSecret); err != nil {
-					return nil, errors.Wrap(ctx, err, op, errors.WithMsg("decoding json for proto marshaling"))
-				}
-				sSecret, err = structpb.NewStruct(dSecret)
-				if err != nil {
-					return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for secret"))
-				}
+			credType := l.CredentialType()
+			if credType == credential.UnspecifiedType {
+				credType = ""
 			}
 			creds = append(creds, &pb.SessionCredential{
 				CredentialLibrary: &pb.CredentialLibrary{
@@ -1118,17 +1195,47 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 					Description:       l.GetDescription(),
 					CredentialStoreId: l.GetStoreId(),
 					Type:              credential.SubtypeFromId(l.GetPublicId()).String(),
+					CredentialType:    string(credType),
 				},
-				Secret: &pb.SessionSecret{
-					Raw:     base64.StdEncoding.EncodeToString(jSecret),
-					Decoded: sSecret,
-				},
+				Secret: secret,
 			})
 
 		default:
 			return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unsupported credential purpose %s", c.Purpose()))
 		}
 	}
+	for _, c := range staticCreds {
+		for _, purpose := range staticPurposes[c.GetPublicId()] {
+			switch purpose {
+			case credential.EgressPurpose:
+				data, err := marshalWorkerCredential(ctx, c)
+				if err != nil {
+					return nil, errors.Wrap(ctx, err, op)
+				}
+				workerCreds = append(workerCreds, data)
+
+			case credential.ApplicationPurpose:
+				secret, err := sessionSecret(ctx, c.Secret())
+				if err != nil {
+					return nil, errors.Wrap(ctx, err, op)
+				}
+				creds = append(creds, &pb.SessionCredential{
+					CredentialSource: &pb.CredentialSource{
+						Id:                c.GetPublicId(),
+						Name:              c.GetName(),
+						Description:       c.GetDescription(),
+						CredentialStoreId: c.GetStoreId(),
+						Type:              credential.SubtypeFromId(c.GetPublicId()).String(),
+						CredentialType:    string(c.CredentialType()),
+					},
+					Secret: secret,
+				})
+
+			default:
+				return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("unsupported credential purpose %s", purpose))
+			}
+		}
+	}
 
 	if len(workerCreds) > 0 {
 		// store credentials in repo, worker will request creds when a connection is established
@@ -1203,6 +1310,21 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
+	if item.GetUserSessionLimit() != nil {
+		opts = append(opts, target.WithUserSessionLimit(item.GetUserSessionLimit().GetValue()))
+	}
+	if item.GetSessionMaxBytesUp() != nil {
+		opts = append(opts, target.WithSessionMaxBytesUp(item.GetSessionMaxBytesUp().GetValue()))
+	}
+	if item.GetSessionMaxBytesDown() != nil {
+		opts = append(opts, target.WithSessionMaxBytesDown(item.GetSessionMaxBytesDown().GetValue()))
+	}
+	if item.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeout(item.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
+	if item.GetHostSelectionStrategy() != nil {
+		opts = append(opts, target.WithHostSelectionStrategy(target.HostSelectionStrategy(item.GetHostSelectionStrategy().GetValue())))
+	}
 	if item.GetWorkerFilter() != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
@@ -1246,6 +1368,21 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if item.GetSessionConnectionLimit() != nil {
 		opts = append(opts, target.WithSessionConnectionLimit(item.GetSessionConnectionLimit().GetValue()))
 	}
+	if item.GetUserSessionLimit() != nil {
+		opts = append(opts, target.WithUserSessionLimit(item.GetUserSessionLimit().GetValue()))
+	}
+	if item.GetSessionMaxBytesUp() != nil {
+		opts = append(opts, target.WithSessionMaxBytesUp(item.GetSessionMaxBytesUp().GetValue()))
+	}
+	if item.GetSessionMaxBytesDown() != nil {
+		opts = append(opts, target.WithSessionMaxBytesDown(item.GetSessionMaxBytesDown().GetValue()))
+	}
+	if item.GetConnectionIdleTimeoutSeconds() != nil {
+		opts = append(opts, target.WithConnectionIdleTimeout(item.GetConnectionIdleTimeoutSeconds().GetValue()))
+	}
+	if item.GetHostSelectionStrategy() != nil {
+		opts = append(opts, target.WithHostSelectionStrategy(target.HostSelectionStrategy(item.GetHostSelectionStrategy().GetValue())))
+	}
 	if filter := item.GetWorkerFilter(); filter != nil {
 		opts = append(opts, target.WithWorkerFilter(item.GetWorkerFilter().GetValue()))
 	}
@@ -1305,12 +1442,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]target.Target, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]target.Target, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListTargets(ctx, target.WithScopeIds(scopeIds))
+	ul, err := repo.ListTargets(ctx, target.WithScopeIds(scopeIds), target.WithLimit(page.Limit()), target.WithStartPageAfterId(page.StartAfterId()), target.WithFilterConditions(conds))
 	if err != nil {
 		return nil, err
 	}
@@ -1381,12 +1518,12 @@ func (s Service) addCredentialSourcesInRepo(ctx context.Context, targetId string
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	credLibs, err := createCredLibs(targetId, applicationIds, nil, egressIds)
+	credLibs, staticCreds, err := createCredSources(targetId, applicationIds, nil, egressIds)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
 
-	out, hs, credSources, err := repo.AddTargetCredentialSources(ctx, targetId, version, credLibs)
+	out, hs, credSources, err := repo.AddTargetCredentialSources(ctx, targetId, version, credLibs, target.WithStaticCredentials(staticCreds))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to add credential sources to target: %v.", err)
@@ -1404,12 +1541,12 @@ func (s Service) setCredentialSourcesInRepo(ctx context.Context, targetId string
 		return nil, nil, nil, err
 	}
 
-	credLibs, err := createCredLibs(targetId, applicationIds, nil, egressIds)
+	credLibs, staticCreds, err := createCredSources(targetId, applicationIds, nil, egressIds)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
 
-	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, credLibs)
+	_, _, _, err = repo.SetTargetCredentialSources(ctx, targetId, version, credLibs, target.WithStaticCredentials(staticCreds))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
@@ -1432,11 +1569,11 @@ func (s Service) removeCredentialSourcesInRepo(ctx context.Context, targetId str
 		return nil, nil, nil, err
 	}
 
-	credLibs, err := createCredLibs(targetId, applicationIds, nil, egressIds)
+	credLibs, staticCreds, err := createCredSources(targetId, applicationIds, nil, egressIds)
 	if err != nil {
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to set credential sources in target: %v.", err)
 	}
-	_, err = repo.DeleteTargetCredentialSources(ctx, targetId, version, credLibs)
+	_, err = repo.DeleteTargetCredentialSources(ctx, targetId, version, credLibs, target.WithStaticCredentials(staticCreds))
 	if err != nil {
 		// TODO: Figure out a way to surface more helpful error info beyond the Internal error.
 		return nil, nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to remove credential sources from target: %v.", err)
@@ -1543,6 +1680,21 @@ func toProto(ctx context.Context, in target.Target, hostSources []target.HostSou
 	if outputFields.Has(globals.SessionConnectionLimitField) {
 		out.SessionConnectionLimit = wrapperspb.Int32(in.GetSessionConnectionLimit())
 	}
+	if outputFields.Has(globals.UserSessionLimitField) {
+		out.UserSessionLimit = wrapperspb.Int32(in.GetUserSessionLimit())
+	}
+	if outputFields.Has(globals.SessionMaxBytesUpField) {
+		out.SessionMaxBytesUp = wrapperspb.Int64(in.GetSessionMaxBytesUp())
+	}
+	if outputFields.Has(globals.SessionMaxBytesDownField) {
+		out.SessionMaxBytesDown = wrapperspb.Int64(in.GetSessionMaxBytesDown())
+	}
+	if outputFields.Has(globals.ConnectionIdleTimeoutSecondsField) {
+		out.ConnectionIdleTimeoutSeconds = wrapperspb.UInt32(in.GetConnectionIdleTimeoutSeconds())
+	}
+	if outputFields.Has(globals.HostSelectionStrategyField) && in.GetHostSelectionStrategy() != "" {
+		out.HostSelectionStrategy = wrapperspb.String(in.GetHostSelectionStrategy())
+	}
 	if outputFields.Has(globals.WorkerFilterField) && in.GetWorkerFilter() != "" {
 		out.WorkerFilter = wrapperspb.String(in.GetWorkerFilter())
 	}
@@ -1663,6 +1815,10 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
+		validateSessionQuotas(req.GetItem(), badFields)
+		if hs := req.GetItem().GetHostSelectionStrategy(); hs != nil && !target.ValidHostSelectionStrategy(hs.GetValue()) {
+			badFields[globals.HostSelectionStrategyField] = `This must be one of "random", "round_robin", "least_sessions" or "sticky_per_user".`
+		}
 		if req.GetItem().GetType() == "" {
 			badFields[globals.TypeField] = "This is a required field."
 		} else if target.SubtypeFromType(req.GetItem().GetType()) == "" {
@@ -1692,6 +1848,29 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 	})
 }
 
+// validateSessionQuotas adds to badFields the session quotas of item which are
+// out of range.
+func validateSessionQuotas(item *pb.Target, badFields map[string]string) {
+	if item.GetUserSessionLimit() != nil {
+		val := item.GetUserSessionLimit().GetValue()
+		if val != -1 && val <= 0 {
+			badFields[globals.UserSessionLimitField] = "This must be -1 (unlimited) or greater than zero."
+		}
+	}
+	if item.GetSessionMaxBytesUp() != nil {
+		val := item.GetSessionMaxBytesUp().GetValue()
+		if val != -1 && val <= 0 {
+			badFields[globals.SessionMaxBytesUpField] = "This must be -1 (unlimited) or greater than zero."
+		}
+	}
+	if item.GetSessionMaxBytesDown() != nil {
+		val := item.GetSessionMaxBytesDown().GetValue()
+		if val != -1 && val <= 0 {
+			badFields[globals.SessionMaxBytesDownField] = "This must be -1 (unlimited) or greater than zero."
+		}
+	}
+}
+
 func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1710,6 +1889,10 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 		if req.GetItem().GetSessionMaxSeconds() != nil && req.GetItem().GetSessionMaxSeconds().GetValue() == 0 {
 			badFields[globals.SessionMaxSecondsField] = "This must be greater than zero."
 		}
+		validateSessionQuotas(req.GetItem(), badFields)
+		if hs := req.GetItem().GetHostSelectionStrategy(); hs != nil && !target.ValidHostSelectionStrategy(hs.GetValue()) {
+			badFields[globals.HostSelectionStrategyField] = `This must be one of "random", "round_robin", "least_sessions" or "sticky_per_user".`
+		}
 		if filter := req.GetItem().GetWorkerFilter(); filter != nil {
 			if _, err := bexpr.CreateEvaluator(filter.GetValue()); err != nil {
 				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
@@ -1973,13 +2156,13 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 		badFields[globals.EgressCredentialSourceIdsField] = "Application or Egress Credential Source IDs must be provided."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -1999,13 +2182,13 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 		badFields[globals.VersionField] = "Required field."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -2029,13 +2212,13 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 		badFields[globals.EgressCredentialSourceIdsField] = "Application or Egress Credential Source IDs must be provided."
 	}
 	for _, cl := range req.GetApplicationCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.ApplicationCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
 	}
 	for _, cl := range req.GetEgressCredentialSourceIds() {
-		if !handlers.ValidId(handlers.Id(cl), vault.CredentialLibraryPrefix) {
+		if !handlers.ValidId(handlers.Id(cl), credentialSourcePrefixes...) {
 			badFields[globals.EgressCredentialSourceIdsField] = fmt.Sprintf("Incorrectly formatted credential source identifier %q.", cl)
 			break
 		}
@@ -2087,8 +2270,11 @@ func validateAuthorizeSessionRequest(req *pbs.AuthorizeSessionRequest) error {
 	return nil
 }
 
-func createCredLibs(targetId string, applicationIds, ingressIds, egressIds []string) ([]*target.CredentialLibrary, error) {
+// createCredSources splits the credential source ids into the credential
+// libraries and the static credentials they identify.
+func createCredSources(targetId string, applicationIds, ingressIds, egressIds []string) ([]*target.CredentialLibrary, []*target.StaticCredential, error) {
 	credLibs := make([]*target.CredentialLibrary, 0, len(applicationIds)+len(ingressIds)+len(egressIds))
+	var staticCreds []*target.StaticCredential
 
 	byPurpose := map[credential.Purpose][]string{
 		credential.ApplicationPurpose: strutil.RemoveDuplicates(applicationIds, false),
@@ -2097,14 +2283,70 @@ func createCredLibs(targetId string, applicationIds, ingressIds, egressIds []str
 	}
 	for purpose, ids := range byPurpose {
 		for _, id := range ids {
+			if credential.SubtypeFromId(id) == credstatic.Subtype {
+				c, err := target.NewStaticCredential(targetId, id, purpose)
+				if err != nil {
+					return nil, nil, err
+				}
+				staticCreds = append(staticCreds, c)
+				continue
+			}
 			l, err := target.NewCredentialLibrary(targetId, id, purpose)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			credLibs = append(credLibs, l)
 		}
 	}
-	return credLibs, nil
+	return credLibs, staticCreds, nil
+}
+
+// marshalWorkerCredential marshals cred so it can be stored for the worker
+// to use when a connection is established.
+func marshalWorkerCredential(ctx context.Context, cred credential.Credential) (session.Credential, error) {
+	const op = "targets.marshalWorkerCredential"
+	m, err := credentialToProto(ctx, cred)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to proto"))
+	}
+	return data, nil
+}
+
+// sessionSecret converts the secret of a credential into the secret
+// returned to the user in a SessionCredential.
+func sessionSecret(ctx context.Context, secret credential.SecretData) (*pb.SessionSecret, error) {
+	const op = "targets.sessionSecret"
+	// TODO: Access the json directly from the vault response instead of re-marshalling it.
+	jSecret, err := json.Marshal(secret)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("marshalling secret to json"))
+	}
+	var sSecret *structpb.Struct
+	switch secret.(type) {
+	case map[string]interface{}:
+		// In this case we actually have to re-decode it. The proto wrappers
+		// choke on json.Number and at the time I'm writing this I don't
+		// have time to write a walk function to dig through with reflect
+		// and find all json.Numbers and replace them. So we eat the
+		// inefficiency. So note that we are specifically _not_ using a
+		// decoder with UseNumber here.
+		var dSecret map[string]interface{}
+		if err := json.Unmarshal(jSecret, &dSecret); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("decoding json for proto marshaling"))
+		}
+		sSecret, err = structpb.NewStruct(dSecret)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for secret"))
+		}
+	}
+	return &pb.SessionSecret{
+		Raw:     base64.StdEncoding.EncodeToString(jSecret),
+		Decoded: sSecret,
+	}, nil
 }
 
 // credentialToProto converts the strongly typed credential.Credential into a known proto.Message.
@@ -2121,7 +2363,81 @@ func credentialToProto(ctx context.Context, cred credential.Credential) (*server
 			},
 		}, nil
 
+	case credential.SshCertificate:
+		// An ssh certificate is also a key pair, so it must be matched first.
+		return &serverpb.Credential{
+			Credential: &serverpb.Credential_SshCertificate{
+				SshCertificate: &serverpb.SshCertificate{
+					Username:    c.Username(),
+					PrivateKey:  []byte(c.Private()),
+					Certificate: c.Certificate(),
+				},
+			},
+		}, nil
+
+	case credential.KeyPair:
+		return &serverpb.Credential{
+			Credential: &serverpb.Credential_SshPrivateKey{
+				SshPrivateKey: &serverpb.SshPrivateKey{
+					Username:   c.Username(),
+					PrivateKey: []byte(c.Private()),
+				},
+			},
+		}, nil
+
 	default:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 	}
 }
+
+// healthyEndpoints returns endpoints without the entries whose host failed
+// the last health check of their set.
+func (s Service) healthyEndpoints(ctx context.Context, endpoints []*host.Endpoint) ([]*host.Endpoint, error) {
+	healthRepo, err := s.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	setIds := make([]string, 0, len(endpoints))
+	seen := make(map[string]bool, len(endpoints))
+	for _, ep := range endpoints {
+		if !seen[ep.SetId] {
+			seen[ep.SetId] = true
+			setIds = append(setIds, ep.SetId)
+		}
+	}
+	unhealthy, err := healthRepo.ListUnhealthy(ctx, setIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(unhealthy) == 0 {
+		return endpoints, nil
+	}
+	type hostSet struct{ hostId, setId string }
+	skip := make(map[hostSet]bool, len(unhealthy))
+	for _, hh := range unhealthy {
+		skip[hostSet{hh.HostId, hh.SetId}] = true
+	}
+	ret := make([]*host.Endpoint, 0, len(endpoints))
+	for _, ep := range endpoints {
+		if !skip[hostSet{ep.HostId, ep.SetId}] {
+			ret = append(ret, ep)
+		}
+	}
+	return ret, nil
+}
+
+// uniqueWorkerAddresses returns workers without the entries whose address is
+// the same as the one of a previous entry. Workers reached through the same
+// upstream worker share its address.
+func uniqueWorkerAddresses(workers []*pb.WorkerInfo) []*pb.WorkerInfo {
+	seen := make(map[string]bool, len(workers))
+	ret := make([]*pb.WorkerInfo, 0, len(workers))
+	for _, w := range workers {
+		if seen[w.GetAddress()] {
+			continue
+		}
+		seen[w.GetAddress()] = true
+		ret = append(ret, w)
+	}
+	return ret
+}
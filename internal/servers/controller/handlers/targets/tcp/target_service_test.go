@@ -15,11 +15,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	spbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host/health"
 	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -95,13 +97,19 @@ func testService(t *testing.T, conn *db.DB, kms *kms.Kms, wrapper wrapping.Wrapp
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginHostRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
-	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
+	return targets.NewService(context.Background(), kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, healthRepoFn, credentialRepoFn, staticCredRepoFn)
 }
 
 func TestGet(t *testing.T) {
@@ -124,19 +132,24 @@ func TestGet(t *testing.T) {
 	tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), "test", target.WithHostSources([]string{hs[0].GetPublicId(), hs[1].GetPublicId()}))
 
 	pTar := &pb.Target{
-		Id:                     tar.GetPublicId(),
-		ScopeId:                proj.GetPublicId(),
-		Name:                   wrapperspb.String("test"),
-		CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-		UpdatedTime:            tar.GetUpdateTime().GetTimestamp(),
-		Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: o.GetPublicId()},
-		Type:                   tcp.Subtype.String(),
-		HostSetIds:             []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
-		HostSourceIds:          []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
-		Attributes:             new(structpb.Struct),
-		SessionMaxSeconds:      wrapperspb.UInt32(28800),
-		SessionConnectionLimit: wrapperspb.Int32(1),
-		AuthorizedActions:      testAuthorizedActions,
+		Id:                           tar.GetPublicId(),
+		ScopeId:                      proj.GetPublicId(),
+		Name:                         wrapperspb.String("test"),
+		CreatedTime:                  tar.GetCreateTime().GetTimestamp(),
+		UpdatedTime:                  tar.GetUpdateTime().GetTimestamp(),
+		Scope:                        &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: o.GetPublicId()},
+		Type:                         tcp.Subtype.String(),
+		HostSetIds:                   []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
+		HostSourceIds:                []string{hs[0].GetPublicId(), hs[1].GetPublicId()},
+		Attributes:                   new(structpb.Struct),
+		SessionMaxSeconds:            wrapperspb.UInt32(28800),
+		SessionConnectionLimit:       wrapperspb.Int32(1),
+		UserSessionLimit:             wrapperspb.Int32(-1),
+		SessionMaxBytesUp:            wrapperspb.Int64(-1),
+		SessionMaxBytesDown:          wrapperspb.Int64(-1),
+		ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+		HostSelectionStrategy:        wrapperspb.String("random"),
+		AuthorizedActions:            testAuthorizedActions,
 	}
 	for _, ihs := range hs {
 		pTar.HostSets = append(pTar.HostSets, &pb.HostSet{Id: ihs.GetPublicId(), HostCatalogId: ihs.GetCatalogId()})
@@ -219,34 +232,44 @@ func TestList(t *testing.T) {
 		name := fmt.Sprintf("tar%d", i)
 		tar := tcp.TestTarget(ctx, t, conn, proj.GetPublicId(), name, target.WithHostSources([]string{hss[0].GetPublicId(), hss[1].GetPublicId()}))
 		wantTars = append(wantTars, &pb.Target{
-			Id:                     tar.GetPublicId(),
-			ScopeId:                proj.GetPublicId(),
-			Name:                   wrapperspb.String(name),
-			Scope:                  &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
-			CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-			UpdatedTime:            tar.GetUpdateTime().GetTimestamp(),
-			Version:                tar.GetVersion(),
-			Type:                   tcp.Subtype.String(),
-			Attributes:             new(structpb.Struct),
-			SessionMaxSeconds:      wrapperspb.UInt32(28800),
-			SessionConnectionLimit: wrapperspb.Int32(1),
-			AuthorizedActions:      testAuthorizedActions,
+			Id:                           tar.GetPublicId(),
+			ScopeId:                      proj.GetPublicId(),
+			Name:                         wrapperspb.String(name),
+			Scope:                        &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+			CreatedTime:                  tar.GetCreateTime().GetTimestamp(),
+			UpdatedTime:                  tar.GetUpdateTime().GetTimestamp(),
+			Version:                      tar.GetVersion(),
+			Type:                         tcp.Subtype.String(),
+			Attributes:                   new(structpb.Struct),
+			SessionMaxSeconds:            wrapperspb.UInt32(28800),
+			SessionConnectionLimit:       wrapperspb.Int32(1),
+			UserSessionLimit:             wrapperspb.Int32(-1),
+			SessionMaxBytesUp:            wrapperspb.Int64(-1),
+			SessionMaxBytesDown:          wrapperspb.Int64(-1),
+			ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+			HostSelectionStrategy:        wrapperspb.String("random"),
+			AuthorizedActions:            testAuthorizedActions,
 		})
 		totalTars = append(totalTars, wantTars[i])
 		tar = tcp.TestTarget(ctx, t, conn, otherProj.GetPublicId(), name, target.WithHostSources([]string{otherHss[0].GetPublicId(), otherHss[1].GetPublicId()}))
 		totalTars = append(totalTars, &pb.Target{
-			Id:                     tar.GetPublicId(),
-			ScopeId:                otherProj.GetPublicId(),
-			Name:                   wrapperspb.String(name),
-			Scope:                  &scopes.ScopeInfo{Id: otherProj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: otherOrg.GetPublicId()},
-			CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-			UpdatedTime:            tar.GetUpdateTime().GetTimestamp(),
-			Version:                tar.GetVersion(),
-			Type:                   tcp.Subtype.String(),
-			Attributes:             new(structpb.Struct),
-			SessionMaxSeconds:      wrapperspb.UInt32(28800),
-			SessionConnectionLimit: wrapperspb.Int32(1),
-			AuthorizedActions:      testAuthorizedActions,
+			Id:                           tar.GetPublicId(),
+			ScopeId:                      otherProj.GetPublicId(),
+			Name:                         wrapperspb.String(name),
+			Scope:                        &scopes.ScopeInfo{Id: otherProj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: otherOrg.GetPublicId()},
+			CreatedTime:                  tar.GetCreateTime().GetTimestamp(),
+			UpdatedTime:                  tar.GetUpdateTime().GetTimestamp(),
+			Version:                      tar.GetVersion(),
+			Type:                         tcp.Subtype.String(),
+			Attributes:                   new(structpb.Struct),
+			SessionMaxSeconds:            wrapperspb.UInt32(28800),
+			SessionConnectionLimit:       wrapperspb.Int32(1),
+			UserSessionLimit:             wrapperspb.Int32(-1),
+			SessionMaxBytesUp:            wrapperspb.Int64(-1),
+			SessionMaxBytesDown:          wrapperspb.Int64(-1),
+			ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+			HostSelectionStrategy:        wrapperspb.String("random"),
+			AuthorizedActions:            testAuthorizedActions,
 		})
 	}
 
@@ -463,13 +486,115 @@ func TestCreate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					SessionMaxSeconds:      wrapperspb.UInt32(28800),
-					SessionConnectionLimit: wrapperspb.Int32(1),
-					AuthorizedActions:      testAuthorizedActions,
-					WorkerFilter:           wrapperspb.String(`type == "bar"`),
+					SessionMaxSeconds:            wrapperspb.UInt32(28800),
+					SessionConnectionLimit:       wrapperspb.Int32(1),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
+					WorkerFilter:                 wrapperspb.String(`type == "bar"`),
+				},
+			},
+		},
+		{
+			name: "Create with session quotas",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("quotas"),
+				Type:    tcp.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port": structpb.NewNumberValue(2),
+				}},
+				UserSessionLimit:             wrapperspb.Int32(2),
+				SessionMaxBytesUp:            wrapperspb.Int64(1 << 20),
+				SessionMaxBytesDown:          wrapperspb.Int64(1 << 30),
+				ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(300),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", tcp.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("quotas"),
+					Type:    tcp.Subtype.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port": structpb.NewNumberValue(2),
+					}},
+					SessionMaxSeconds:            wrapperspb.UInt32(28800),
+					SessionConnectionLimit:       wrapperspb.Int32(1),
+					UserSessionLimit:             wrapperspb.Int32(2),
+					SessionMaxBytesUp:            wrapperspb.Int64(1 << 20),
+					SessionMaxBytesDown:          wrapperspb.Int64(1 << 30),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(300),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
+				},
+			},
+		},
+		{
+			name: "Create with user session limit 0",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:          proj.GetPublicId(),
+				Name:             wrapperspb.String("name"),
+				Type:             tcp.Subtype.String(),
+				UserSessionLimit: wrapperspb.Int32(0),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with negative session max bytes up",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:           proj.GetPublicId(),
+				Name:              wrapperspb.String("name"),
+				Type:              tcp.Subtype.String(),
+				SessionMaxBytesUp: wrapperspb.Int64(-2),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Create with host selection strategy",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId: proj.GetPublicId(),
+				Name:    wrapperspb.String("least sessions"),
+				Type:    tcp.Subtype.String(),
+				Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+					"default_port": structpb.NewNumberValue(2),
+				}},
+				HostSelectionStrategy: wrapperspb.String("least_sessions"),
+			}},
+			res: &pbs.CreateTargetResponse{
+				Uri: fmt.Sprintf("targets/%s_", tcp.TargetPrefix),
+				Item: &pb.Target{
+					ScopeId: proj.GetPublicId(),
+					Scope:   &scopes.ScopeInfo{Id: proj.GetPublicId(), Type: scope.Project.String(), ParentScopeId: org.GetPublicId()},
+					Name:    wrapperspb.String("least sessions"),
+					Type:    tcp.Subtype.String(),
+					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+						"default_port": structpb.NewNumberValue(2),
+					}},
+					SessionMaxSeconds:            wrapperspb.UInt32(28800),
+					SessionConnectionLimit:       wrapperspb.Int32(1),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("least_sessions"),
+					AuthorizedActions:            testAuthorizedActions,
 				},
 			},
 		},
+		{
+			name: "Create with unknown host selection strategy",
+			req: &pbs.CreateTargetRequest{Item: &pb.Target{
+				ScopeId:               proj.GetPublicId(),
+				Name:                  wrapperspb.String("name"),
+				Type:                  tcp.Subtype.String(),
+				HostSelectionStrategy: wrapperspb.String("fastest"),
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create with default port 0",
 			req: &pbs.CreateTargetRequest{Item: &pb.Target{
@@ -657,14 +782,19 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					CreatedTime:            tar.GetCreateTime().GetTimestamp(),
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					CreatedTime:                  tar.GetCreateTime().GetTimestamp(),
+					HostSetIds:                   hsIds,
+					HostSets:                     hostSets,
+					HostSourceIds:                hostSourceIds,
+					HostSources:                  hostSources,
+					SessionMaxSeconds:            wrapperspb.UInt32(3600),
+					SessionConnectionLimit:       wrapperspb.Int32(5),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
 				},
 			},
 		},
@@ -692,13 +822,18 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					HostSetIds:                   hsIds,
+					HostSets:                     hostSets,
+					HostSourceIds:                hostSourceIds,
+					HostSources:                  hostSources,
+					SessionMaxSeconds:            wrapperspb.UInt32(3600),
+					SessionConnectionLimit:       wrapperspb.Int32(5),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
 				},
 			},
 		},
@@ -779,13 +914,18 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					HostSetIds:                   hsIds,
+					HostSets:                     hostSets,
+					HostSourceIds:                hostSourceIds,
+					HostSources:                  hostSources,
+					SessionMaxSeconds:            wrapperspb.UInt32(3600),
+					SessionConnectionLimit:       wrapperspb.Int32(5),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
 				},
 			},
 		},
@@ -812,13 +952,18 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					HostSetIds:                   hsIds,
+					HostSets:                     hostSets,
+					HostSourceIds:                hostSourceIds,
+					HostSources:                  hostSources,
+					SessionMaxSeconds:            wrapperspb.UInt32(3600),
+					SessionConnectionLimit:       wrapperspb.Int32(5),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
 				},
 			},
 		},
@@ -844,14 +989,19 @@ func TestUpdate(t *testing.T) {
 					Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
 						"default_port": structpb.NewNumberValue(2),
 					}},
-					Type:                   tcp.Subtype.String(),
-					HostSetIds:             hsIds,
-					HostSets:               hostSets,
-					HostSourceIds:          hostSourceIds,
-					HostSources:            hostSources,
-					SessionMaxSeconds:      wrapperspb.UInt32(3600),
-					SessionConnectionLimit: wrapperspb.Int32(5),
-					AuthorizedActions:      testAuthorizedActions,
+					Type:                         tcp.Subtype.String(),
+					HostSetIds:                   hsIds,
+					HostSets:                     hostSets,
+					HostSourceIds:                hostSourceIds,
+					HostSources:                  hostSources,
+					SessionMaxSeconds:            wrapperspb.UInt32(3600),
+					SessionConnectionLimit:       wrapperspb.Int32(5),
+					UserSessionLimit:             wrapperspb.Int32(-1),
+					SessionMaxBytesUp:            wrapperspb.Int64(-1),
+					SessionMaxBytesDown:          wrapperspb.Int64(-1),
+					ConnectionIdleTimeoutSeconds: wrapperspb.UInt32(0),
+					HostSelectionStrategy:        wrapperspb.String("random"),
+					AuthorizedActions:            testAuthorizedActions,
 				},
 			},
 		},
@@ -2631,9 +2781,15 @@ func TestAuthorizeSession(t *testing.T) {
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
@@ -2682,7 +2838,7 @@ func TestAuthorizeSession(t *testing.T) {
 	_ = iam.TestUserRole(t, conn, r.GetPublicId(), at.GetIamUserId())
 	_ = iam.TestRoleGrant(t, conn, r.GetPublicId(), "id=*;type=*;actions=*")
 
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, healthRepoFn, credentialRepoFn, staticCredRepoFn)
 	require.NoError(t, err)
 
 	hc := static.TestCatalogs(t, conn, proj.GetPublicId(), 1)[0]
@@ -2756,7 +2912,7 @@ func TestAuthorizeSession(t *testing.T) {
 			require.NoError(t, err)
 
 			// Tell our DB that there is a worker ready to serve the data
-			workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms)
+			workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, staticHostRepoFn, pluginHostRepoFn, healthRepoFn, &sync.Map{}, kms)
 			_, err = workerService.Status(ctx, &spbs.StatusRequest{
 				Worker: &spb.Server{
 					PrivateId: "testworker",
@@ -2869,18 +3025,24 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	staticHostRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(rw, rw, kms)
 	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 	pluginHostRepoFn := func() (*plugin.Repository, error) {
 		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
 	}
 	credentialRepoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(rw, rw, kms, sche)
 	}
+	staticCredRepoFn := func() (*credstatic.Repository, error) {
+		return credstatic.NewRepository(rw, rw, kms)
+	}
 	atRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(rw, rw, kms)
 	}
 	org, proj := iam.TestScopes(t, iamRepo)
 
-	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, credentialRepoFn)
+	s, err := targets.NewService(ctx, kms, repoFn, iamRepoFn, serversRepoFn, sessionRepoFn, pluginHostRepoFn, staticHostRepoFn, healthRepoFn, credentialRepoFn, staticCredRepoFn)
 	require.NoError(t, err)
 
 	// Authorized user gets full permissions
@@ -2905,7 +3067,7 @@ func TestAuthorizeSession_Errors(t *testing.T) {
 	store := vault.TestCredentialStore(t, conn, wrapper, proj.GetPublicId(), v.Addr, tok, sec.Auth.Accessor)
 
 	workerExists := func(tar target.Target) (version uint32) {
-		workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, &sync.Map{}, kms)
+		workerService := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, staticHostRepoFn, pluginHostRepoFn, healthRepoFn, &sync.Map{}, kms)
 		_, err := workerService.Status(context.Background(), &spbs.StatusRequest{
 			Worker: &spb.Server{
 				PrivateId: "testworker",
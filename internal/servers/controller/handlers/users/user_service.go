@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/auth/ldap"
 	"github.com/hashicorp/boundary/internal/auth/oidc"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/iam/store"
@@ -79,6 +81,10 @@ func (s Service) ListUsers(ctx context.Context, req *pbs.ListUsersRequest) (*pbs
 	if err := validateListRequest(req); err != nil {
 		return nil, err
 	}
+	page, err := handlers.NewListPage(req.GetPageSize(), req.GetListToken(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
 	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
 	if authResults.Error != nil {
 		// If it's forbidden, and it's a recursive request, and they're
@@ -103,50 +109,57 @@ func (s Service) ListUsers(ctx context.Context, req *pbs.ListUsersRequest) (*pbs
 		return &pbs.ListUsersResponse{}, nil
 	}
 
-	ul, err := s.listFromRepo(ctx, scopeIds)
-	if err != nil {
-		return nil, err
-	}
-	if len(ul) == 0 {
-		return &pbs.ListUsersResponse{}, nil
-	}
-
 	filter, err := handlers.NewFilter(req.GetFilter())
 	if err != nil {
 		return nil, err
 	}
-	finalItems := make([]*pb.User, 0, len(ul))
+	// The repository only returns the items which may match the filter.
+	conds := filter.Conditions("name", "scope_id")
+	var finalItems []*pb.User
 	res := perms.Resource{
 		Type: resource.User,
 	}
-	for _, item := range ul {
-		res.Id = item.GetPublicId()
-		res.ScopeId = item.GetScopeId()
-		authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
-		if len(authorizedActions) == 0 {
-			continue
-		}
-
-		outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
-		outputOpts := make([]handlers.Option, 0, 3)
-		outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
-		if outputFields.Has(globals.ScopeField) {
-			outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
-		}
-		if outputFields.Has(globals.AuthorizedActionsField) {
-			outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
-		}
-
-		item, err := toProto(ctx, item, nil, outputOpts...)
+	for {
+		ul, err := s.listFromRepo(ctx, scopeIds, page, conds)
 		if err != nil {
 			return nil, err
 		}
-
-		if filter.Match(item) {
-			finalItems = append(finalItems, item)
+		for _, item := range ul {
+			if !page.Next(item.GetPublicId()) {
+				break
+			}
+			res.Id = item.GetPublicId()
+			res.ScopeId = item.GetScopeId()
+			authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+			if len(authorizedActions) == 0 {
+				continue
+			}
+
+			outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+			outputOpts := make([]handlers.Option, 0, 3)
+			outputOpts = append(outputOpts, handlers.WithOutputFields(&outputFields))
+			if outputFields.Has(globals.ScopeField) {
+				outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+			}
+			if outputFields.Has(globals.AuthorizedActionsField) {
+				outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+			}
+
+			item, err := toProto(ctx, item, nil, outputOpts...)
+			if err != nil {
+				return nil, err
+			}
+
+			if filter.Match(item) {
+				finalItems = append(finalItems, item)
+				page.Add()
+			}
+		}
+		if page.Done(len(ul)) {
+			break
 		}
 	}
-	return &pbs.ListUsersResponse{Items: finalItems}, nil
+	return &pbs.ListUsersResponse{Items: finalItems, ListToken: page.ListToken()}, nil
 }
 
 // GetUsers implements the interface pbs.UserServiceServer.
@@ -423,6 +436,7 @@ func (s Service) createInRepo(ctx context.Context, orgId string, item *pb.User)
 	if err != nil {
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build user for creation: %v.", err)
 	}
+	u.Disabled = item.GetDisabled()
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
@@ -452,6 +466,7 @@ func (s Service) updateInRepo(ctx context.Context, orgId, id string, mask []stri
 		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build user for update: %v.", err)
 	}
 	u.PublicId = id
+	u.Disabled = item.GetDisabled()
 	dbMask := maskManager.Translate(mask)
 	if len(dbMask) == 0 {
 		return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
@@ -486,12 +501,12 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 	return rows > 0, nil
 }
 
-func (s Service) listFromRepo(ctx context.Context, scopeIds []string) ([]*iam.User, error) {
+func (s Service) listFromRepo(ctx context.Context, scopeIds []string, page *handlers.ListPage, conds []filter.Condition) ([]*iam.User, error) {
 	repo, err := s.repoFn()
 	if err != nil {
 		return nil, err
 	}
-	ul, err := repo.ListUsers(ctx, scopeIds)
+	ul, err := repo.ListUsers(ctx, scopeIds, iam.WithLimit(page.Limit()), iam.WithStartPageAfterId(page.StartAfterId()), iam.WithFilterConditions(conds))
 	if err != nil {
 		return nil, err
 	}
@@ -641,6 +656,9 @@ func toProto(ctx context.Context, in *iam.User, accts []string, opt ...handlers.
 	if outputFields.Has(globals.EmailField) {
 		out.Email = in.GetEmail()
 	}
+	if outputFields.Has(globals.DisabledField) {
+		out.Disabled = in.GetDisabled()
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -719,6 +737,7 @@ func validateAddUserAccountsRequest(req *pbs.AddUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -744,6 +763,7 @@ func validateSetUserAccountsRequest(req *pbs.SetUserAccountsRequest) error {
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
@@ -772,6 +792,7 @@ func validateRemoveUserAccountsRequest(req *pbs.RemoveUserAccountsRequest) error
 			intglobals.OldPasswordAccountPrefix,
 			intglobals.NewPasswordAccountPrefix,
 			oidc.AccountPrefix,
+			ldap.AccountPrefix,
 		) {
 			badFields["account_ids"] = "Values must be valid account ids."
 			break
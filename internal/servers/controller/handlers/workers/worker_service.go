@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/db/timestamp"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/boundary/internal/servers"
@@ -17,6 +22,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
@@ -26,22 +32,46 @@ type workerServiceServer struct {
 	pbs.UnimplementedServerCoordinationServiceServer
 	pbs.UnimplementedSessionServiceServer
 
-	serversRepoFn common.ServersRepoFactory
-	sessionRepoFn common.SessionRepoFactory
-	updateTimes   *sync.Map
-	kms           *kms.Kms
+	serversRepoFn    common.ServersRepoFactory
+	sessionRepoFn    common.SessionRepoFactory
+	staticHostRepoFn common.StaticRepoFactory
+	pluginHostRepoFn common.PluginHostRepoFactory
+	healthRepoFn     common.HostHealthRepoFactory
+	updateTimes      *sync.Map
+	kms              *kms.Kms
+
+	healthChecksMu     sync.Mutex
+	healthChecksExpiry time.Time
+	setHealthChecks    []*setHealthChecks
+}
+
+// healthChecksTTL is how long the health checks of the hosts of the host sets
+// are reused before being listed again. Workers report their status every few
+// seconds, and hosts added to a set are checked once the checks expire.
+const healthChecksTTL = 30 * time.Second
+
+// setHealthChecks are the health checks of the hosts of a host set.
+type setHealthChecks struct {
+	workerFilter string
+	checks       []*pbs.HealthCheck
 }
 
 func NewWorkerServiceServer(
 	serversRepoFn common.ServersRepoFactory,
 	sessionRepoFn common.SessionRepoFactory,
+	staticHostRepoFn common.StaticRepoFactory,
+	pluginHostRepoFn common.PluginHostRepoFactory,
+	healthRepoFn common.HostHealthRepoFactory,
 	updateTimes *sync.Map,
 	kms *kms.Kms) *workerServiceServer {
 	return &workerServiceServer{
-		serversRepoFn: serversRepoFn,
-		sessionRepoFn: sessionRepoFn,
-		updateTimes:   updateTimes,
-		kms:           kms,
+		serversRepoFn:    serversRepoFn,
+		sessionRepoFn:    sessionRepoFn,
+		staticHostRepoFn: staticHostRepoFn,
+		pluginHostRepoFn: pluginHostRepoFn,
+		healthRepoFn:     healthRepoFn,
+		updateTimes:      updateTimes,
+		kms:              kms,
 	}
 }
 
@@ -211,6 +241,149 @@ func (ws *workerServiceServer) Status(ctx context.Context, req *pbs.StatusReques
 		event.WriteSysEvent(ctx, op, "marked unclaimed connections as closed", "server_id", req.Worker.PrivateId, "count", closedConns)
 	}
 
+	// Health checks are best effort: a failure to store their results or to
+	// compute the checks of the worker must not fail its status update.
+	if err := ws.reportHealthCheckResults(ctx, req.Worker.PrivateId, req.GetHealthCheckResults()); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error storing health check results", "server_id", req.Worker.PrivateId))
+	}
+	healthChecks, err := ws.healthChecks(ctx, serverRepo, req.Worker.PrivateId)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error computing health checks", "server_id", req.Worker.PrivateId))
+	} else {
+		ret.HealthChecks = healthChecks
+	}
+
+	return ret, nil
+}
+
+func (ws *workerServiceServer) reportHealthCheckResults(ctx context.Context, workerId string, results []*pbs.HealthCheckResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+	healthRepo, err := ws.healthRepoFn()
+	if err != nil {
+		return err
+	}
+	res := make([]*health.Result, 0, len(results))
+	for _, r := range results {
+		res = append(res, &health.Result{
+			HostId:  r.GetHostId(),
+			SetId:   r.GetHostSetId(),
+			Healthy: r.GetHealthy(),
+			Message: r.GetMessage(),
+		})
+	}
+	return healthRepo.ReportResults(ctx, workerId, res)
+}
+
+// healthChecks returns the health checks of the hosts which the worker
+// workerId must run: one check for each host of each host set with a health
+// check whose worker filter matches the worker.
+func (ws *workerServiceServer) healthChecks(ctx context.Context, serverRepo *servers.Repository, workerId string) ([]*pbs.HealthCheck, error) {
+	sets, err := ws.listSetHealthChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sets) == 0 {
+		return nil, nil
+	}
+
+	tags, err := serverRepo.ListTagsForServers(ctx, []string{workerId})
+	if err != nil {
+		return nil, err
+	}
+	tagMap := make(map[string][]string)
+	for _, tag := range tags {
+		tagMap[tag.Key] = append(tagMap[tag.Key], tag.Value)
+	}
+	filterInput := map[string]interface{}{
+		"name": workerId,
+		"tags": tagMap,
+	}
+
+	var ret []*pbs.HealthCheck
+	for _, set := range sets {
+		if set.workerFilter != "" {
+			eval, err := bexpr.CreateEvaluator(set.workerFilter)
+			if err != nil {
+				return nil, err
+			}
+			ok, err := eval.Evaluate(filterInput)
+			if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		ret = append(ret, set.checks...)
+	}
+	return ret, nil
+}
+
+// listSetHealthChecks returns the health checks of the hosts of each host set
+// with a health check. The checks are shared by the status updates of all
+// workers and listed again once they are older than healthChecksTTL.
+func (ws *workerServiceServer) listSetHealthChecks(ctx context.Context) ([]*setHealthChecks, error) {
+	ws.healthChecksMu.Lock()
+	defer ws.healthChecksMu.Unlock()
+	if time.Now().Before(ws.healthChecksExpiry) {
+		return ws.setHealthChecks, nil
+	}
+
+	healthRepo, err := ws.healthRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	checks, err := healthRepo.ListChecks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	staticHostRepo, err := ws.staticHostRepoFn()
+	if err != nil {
+		return nil, err
+	}
+	pluginHostRepo, err := ws.pluginHostRepoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*setHealthChecks, 0, len(checks))
+	for _, c := range checks {
+		var eps []*host.Endpoint
+		switch host.SubtypeFromId(c.GetSetId()) {
+		case static.Subtype:
+			eps, err = staticHostRepo.Endpoints(ctx, c.GetSetId())
+		default:
+			eps, err = pluginHostRepo.Endpoints(ctx, []string{c.GetSetId()})
+		}
+		if err != nil {
+			return nil, err
+		}
+		set := &setHealthChecks{
+			workerFilter: c.GetWorkerFilter(),
+			checks:       make([]*pbs.HealthCheck, 0, len(eps)),
+		}
+		for _, ep := range eps {
+			address := ep.Address
+			if h, _, err := net.SplitHostPort(address); err == nil {
+				address = h
+			}
+			set.checks = append(set.checks, &pbs.HealthCheck{
+				HostId:          ep.HostId,
+				HostSetId:       ep.SetId,
+				Address:         address,
+				Type:            c.GetType(),
+				Port:            c.GetPort(),
+				Path:            c.GetPath(),
+				IntervalSeconds: c.GetIntervalSeconds(),
+				TimeoutSeconds:  c.GetTimeoutSeconds(),
+			})
+		}
+		ret = append(ret, set)
+	}
+	ws.setHealthChecks = ret
+	ws.healthChecksExpiry = time.Now().Add(healthChecksTTL)
 	return ret, nil
 }
 
@@ -233,6 +406,10 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 		return nil, status.Error(codes.Internal, "Empty session states during lookup.")
 	}
 
+	// downstreamWorkerAddress is set when the requesting worker does not match
+	// the worker filter of the session but is an upstream worker of one that
+	// does, in which case it relays connections to that worker.
+	var downstreamWorkerAddress string
 	if sessionInfo.WorkerFilter != "" {
 		if req.ServerId == "" {
 			event.WriteError(ctx, op, errors.New("worker filter enabled for session but got no server ID from worker"))
@@ -272,10 +449,37 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 				fmt.Sprintf("Worker filter expression evaluation resulted in error: %s", err))
 		}
 		if !ok {
-			return nil, handlers.ApiErrorWithCodeAndMessage(
-				codes.FailedPrecondition,
-				"Worker filter expression precludes this worker from serving this session")
+			hop, err := downstreamHop(ctx, serversRepo, eval, req.ServerId)
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error looking for a downstream worker", "server_id", req.ServerId))
+				return &pbs.LookupSessionResponse{}, status.Errorf(codes.Internal, "Error looking for a downstream worker: %v", err)
+			}
+			if hop == nil {
+				return nil, handlers.ApiErrorWithCodeAndMessage(
+					codes.FailedPrecondition,
+					"Worker filter expression precludes this worker from serving this session")
+			}
+			downstreamWorkerAddress = hop.GetAddress()
+		}
+	}
+
+	if downstreamWorkerAddress != "" {
+		// A relaying worker only needs what it takes to terminate the
+		// client's TLS connection and to open one to the downstream worker.
+		resp := &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId:   sessionInfo.GetPublicId(),
+				Certificate: sessionInfo.Certificate,
+			},
+			Status:                  sessionInfo.States[0].Status.ProtoVal(),
+			Version:                 sessionInfo.Version,
+			Expiration:              sessionInfo.ExpirationTime.Timestamp,
+			DownstreamWorkerAddress: downstreamWorkerAddress,
 		}
+		if resp.Authorization.PrivateKey, err = ws.sessionPrivateKey(ctx, sessionInfo); err != nil {
+			return nil, err
+		}
+		return resp, nil
 	}
 
 	creds, err := sessRepo.ListSessionCredentials(ctx, sessionInfo.ScopeId, sessionInfo.PublicId)
@@ -299,23 +503,36 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 			SessionId:   sessionInfo.GetPublicId(),
 			Certificate: sessionInfo.Certificate,
 		},
-		Status:          sessionInfo.States[0].Status.ProtoVal(),
-		Version:         sessionInfo.Version,
-		TofuToken:       string(sessionInfo.TofuToken),
-		Endpoint:        sessionInfo.Endpoint,
-		Expiration:      sessionInfo.ExpirationTime.Timestamp,
-		ConnectionLimit: sessionInfo.ConnectionLimit,
-		ConnectionsLeft: authzSummary.ConnectionLimit,
-		HostId:          sessionInfo.HostId,
-		HostSetId:       sessionInfo.HostSetId,
-		TargetId:        sessionInfo.TargetId,
-		UserId:          sessionInfo.UserId,
-		Credentials:     workerCreds,
+		Status:                       sessionInfo.States[0].Status.ProtoVal(),
+		Version:                      sessionInfo.Version,
+		TofuToken:                    string(sessionInfo.TofuToken),
+		Endpoint:                     sessionInfo.Endpoint,
+		Expiration:                   sessionInfo.ExpirationTime.Timestamp,
+		ConnectionLimit:              sessionInfo.ConnectionLimit,
+		ConnectionsLeft:              authzSummary.ConnectionLimit,
+		HostId:                       sessionInfo.HostId,
+		HostSetId:                    sessionInfo.HostSetId,
+		TargetId:                     sessionInfo.TargetId,
+		UserId:                       sessionInfo.UserId,
+		Credentials:                  workerCreds,
+		MaxBytesUp:                   sessionInfo.MaxBytesUp,
+		MaxBytesDown:                 sessionInfo.MaxBytesDown,
+		ConnectionIdleTimeoutSeconds: sessionInfo.ConnectionIdleTimeoutSeconds,
+		EndpointKnownHosts:           sessionInfo.EndpointKnownHosts,
 	}
 	if resp.ConnectionsLeft != -1 {
 		resp.ConnectionsLeft -= int32(authzSummary.CurrentConnectionCount)
 	}
 
+	if resp.Authorization.PrivateKey, err = ws.sessionPrivateKey(ctx, sessionInfo); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// sessionPrivateKey returns the private key of the session certificate.
+func (ws *workerServiceServer) sessionPrivateKey(ctx context.Context, sessionInfo *session.Session) ([]byte, error) {
 	wrapper, err := ws.kms.GetWrapper(ctx, sessionInfo.ScopeId, kms.KeyPurposeSessions, kms.WithKeyId(sessionInfo.KeyId))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error getting sessions wrapper: %v", err)
@@ -323,12 +540,55 @@ func (ws *workerServiceServer) LookupSession(ctx context.Context, req *pbs.Looku
 
 	// Derive the private key, which should match. Deriving on both ends allows
 	// us to not store it in the DB.
-	_, resp.Authorization.PrivateKey, err = session.DeriveED25519Key(wrapper, sessionInfo.UserId, sessionInfo.GetPublicId())
+	_, privKey, err := session.DeriveED25519Key(wrapper, sessionInfo.UserId, sessionInfo.GetPublicId())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Error deriving session key: %v", err)
 	}
+	return privKey, nil
+}
 
-	return resp, nil
+// downstreamHop returns the worker to which the worker serverId relays the
+// connections of a session whose worker filter is evaluated by eval, or nil if
+// no downstream worker of serverId matches the filter.
+func downstreamHop(ctx context.Context, serversRepo *servers.Repository, eval *bexpr.Evaluator, serverId string) (*servers.Server, error) {
+	workers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	if err != nil {
+		return nil, err
+	}
+	workerIds := make([]string, 0, len(workers))
+	for _, w := range workers {
+		workerIds = append(workerIds, w.GetPrivateId())
+	}
+	tags, err := serversRepo.ListTagsForServers(ctx, workerIds)
+	if err != nil {
+		return nil, err
+	}
+	tagMap := make(map[string]map[string][]string)
+	for _, tag := range tags {
+		currWorkerMap := tagMap[tag.ServerId]
+		if currWorkerMap == nil {
+			currWorkerMap = make(map[string][]string)
+			tagMap[tag.ServerId] = currWorkerMap
+		}
+		currWorkerMap[tag.Key] = append(currWorkerMap[tag.Key], tag.Value)
+	}
+	for _, w := range workers {
+		hop := servers.DownstreamHop(workers, serverId, w.GetPrivateId())
+		if hop == nil {
+			continue
+		}
+		ok, err := eval.Evaluate(map[string]interface{}{
+			"name": w.GetPrivateId(),
+			"tags": tagMap[w.GetPrivateId()],
+		})
+		if err != nil && !errors.Is(err, pointerstructure.ErrNotFound) {
+			return nil, err
+		}
+		if ok {
+			return hop, nil
+		}
+	}
+	return nil, nil
 }
 
 func (ws *workerServiceServer) CancelSession(ctx context.Context, req *pbs.CancelSessionRequest) (*pbs.CancelSessionResponse, error) {
@@ -453,12 +713,27 @@ func (ws *workerServiceServer) CloseConnection(ctx context.Context, req *pbs.Clo
 
 	for _, v := range req.GetCloseRequestData() {
 		closeIds = append(closeIds, v.GetConnectionId())
-		closeWiths = append(closeWiths, session.CloseWith{
+		cw := session.CloseWith{
 			ConnectionId: v.GetConnectionId(),
 			BytesUp:      v.GetBytesUp(),
 			BytesDown:    v.GetBytesDown(),
 			ClosedReason: session.ClosedReason(v.GetReason()),
-		})
+		}
+		if rec := v.GetRecording(); rec != nil {
+			cw.Recording = &session.ConnectionRecording{
+				ConnectionId: v.GetConnectionId(),
+				StoragePath:  rec.GetStoragePath(),
+				SizeBytes:    rec.GetSizeBytes(),
+				Checksum:     rec.GetChecksum(),
+			}
+			if rec.GetStartTime() != nil {
+				cw.Recording.StartTime = &timestamp.Timestamp{Timestamp: rec.GetStartTime()}
+			}
+			if rec.GetEndTime() != nil {
+				cw.Recording.EndTime = &timestamp.Timestamp{Timestamp: rec.GetEndTime()}
+			}
+		}
+		closeWiths = append(closeWiths, cw)
 	}
 	sessRepo, err := ws.sessionRepoFn()
 	if err != nil {
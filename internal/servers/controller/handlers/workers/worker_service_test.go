@@ -10,14 +10,18 @@ import (
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/internal/target"
 	"github.com/hashicorp/boundary/internal/target/tcp"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -37,6 +41,16 @@ func TestLookupSession(t *testing.T) {
 	sessionRepoFn := func() (*session.Repository, error) {
 		return session.NewRepository(rw, rw, kms)
 	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
 
 	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
 	uId := at.GetIamUserId()
@@ -97,7 +111,7 @@ func TestLookupSession(t *testing.T) {
 	err = repo.AddSessionCredentials(ctx, egressSess.ScopeId, egressSess.GetPublicId(), workerCreds)
 	require.NoError(t, err)
 
-	s := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, new(sync.Map), kms)
+	s := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, staticHostRepoFn, pluginHostRepoFn, healthRepoFn, new(sync.Map), kms)
 	require.NotNil(t, s)
 
 	cases := []struct {
@@ -171,3 +185,102 @@ func TestLookupSession(t *testing.T) {
 		})
 	}
 }
+
+func TestStatus_HealthChecks(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	serversRepoFn := func() (*servers.Repository, error) {
+		return servers.NewRepository(rw, rw, kms)
+	}
+	sessionRepoFn := func() (*session.Repository, error) {
+		return session.NewRepository(rw, rw, kms)
+	}
+	staticHostRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(rw, rw, kms)
+	}
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	pluginHostRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(rw, rw, kms, sche, map[string]plgpb.HostPluginServiceClient{})
+	}
+	healthRepoFn := func() (*health.Repository, error) {
+		return health.NewRepository(rw, rw, kms)
+	}
+
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	sets := static.TestSets(t, conn, hc.GetPublicId(), 2)
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, sets[0].GetPublicId(), []*static.Host{h})
+	static.TestSetMembers(t, conn, sets[1].GetPublicId(), []*static.Host{h})
+	health.TestCheck(t, conn, sets[0].GetPublicId(), health.HttpCheck, 8080, health.WithPath("/healthz"))
+	health.TestCheck(t, conn, sets[1].GetPublicId(), health.TcpCheck, 22, health.WithWorkerFilter(`"east" in "/tags/region"`))
+
+	s := workers.NewWorkerServiceServer(serversRepoFn, sessionRepoFn, staticHostRepoFn, pluginHostRepoFn, healthRepoFn, new(sync.Map), kms)
+	require.NotNil(t, s)
+
+	newReq := func(id, region string, results ...*pbs.HealthCheckResult) *pbs.StatusRequest {
+		return &pbs.StatusRequest{
+			Worker: &servers.Server{
+				PrivateId: id,
+				Address:   "127.0.0.1",
+				Tags: map[string]*servers.TagValues{
+					"region": {Values: []string{region}},
+				},
+			},
+			UpdateTags:         true,
+			HealthCheckResults: results,
+		}
+	}
+
+	t.Run("filtered", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.Status(ctx, newReq("west-worker", "west"))
+		require.NoError(err)
+		require.Len(got.GetHealthChecks(), 1)
+		assert.Empty(cmp.Diff(&pbs.HealthCheck{
+			HostId:          h.GetPublicId(),
+			HostSetId:       sets[0].GetPublicId(),
+			Address:         h.GetAddress(),
+			Type:            "http",
+			Port:            8080,
+			Path:            "/healthz",
+			IntervalSeconds: health.DefaultIntervalSeconds,
+			TimeoutSeconds:  health.DefaultTimeoutSeconds,
+		}, got.GetHealthChecks()[0], cmpopts.IgnoreUnexported(pbs.HealthCheck{})))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.Status(ctx, newReq("east-worker", "east"))
+		require.NoError(err)
+		var setIds []string
+		for _, c := range got.GetHealthChecks() {
+			setIds = append(setIds, c.GetHostSetId())
+		}
+		assert.ElementsMatch([]string{sets[0].GetPublicId(), sets[1].GetPublicId()}, setIds)
+	})
+
+	t.Run("results", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		_, err := s.Status(ctx, newReq("east-worker", "east", &pbs.HealthCheckResult{
+			HostId:    h.GetPublicId(),
+			HostSetId: sets[1].GetPublicId(),
+			Message:   "connection refused",
+		}))
+		require.NoError(err)
+
+		repo, err := healthRepoFn()
+		require.NoError(err)
+		got, err := repo.ListHostHealth(ctx, h.GetPublicId())
+		require.NoError(err)
+		require.Len(got, 1)
+		assert.Equal(sets[1].GetPublicId(), got[0].SetId)
+		assert.Equal(health.StatusUnhealthy, got[0].Status)
+		assert.Equal("connection refused", got[0].Message)
+		assert.Equal("east-worker", got[0].WorkerId)
+	})
+}
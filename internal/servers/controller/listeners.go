@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/servers/controller/handlers/workers"
 	"github.com/hashicorp/go-multierror"
 	"google.golang.org/grpc"
@@ -117,13 +118,14 @@ func (c *Controller) startListeners(ctx context.Context) error {
 			grpc.MaxSendMsgSize(math.MaxInt32),
 			grpc.UnaryInterceptor(
 				grpc_middleware.ChainUnaryServer(
+					metrics.GrpcUnaryInterceptor(),
 					workerReqInterceptor,
 					auditRequestInterceptor(ctx),  // before we get started, audit the request
 					auditResponseInterceptor(ctx), // as we finish, audit the response
 				),
 			),
 		)
-		workerService := workers.NewWorkerServiceServer(c.ServersRepoFn, c.SessionRepoFn, c.workerStatusUpdateTimes, c.kms)
+		workerService := workers.NewWorkerServiceServer(c.ServersRepoFn, c.SessionRepoFn, c.StaticHostRepoFn, c.PluginHostRepoFn, c.HostHealthRepoFn, c.workerStatusUpdateTimes, c.kms)
 		pbs.RegisterServerCoordinationServiceServer(workerServer, workerService)
 		pbs.RegisterSessionServiceServer(workerServer, workerService)
 
@@ -137,6 +139,22 @@ func (c *Controller) startListeners(ctx context.Context) error {
 		return nil
 	}
 
+	configureForMetrics := func(ln *base.ServerListener) error {
+		server, ls, err := base.MetricsServer(ln, metrics.Handler())
+		if err != nil {
+			return err
+		}
+		server.ErrorLog = c.logger.StandardLogger(nil)
+		ln.HTTPServer = server
+		for _, l := range ls {
+			l := l
+			servers = append(servers, func() {
+				go server.Serve(l)
+			})
+		}
+		return nil
+	}
+
 	c.gatewayListener, _ = newGatewayListener()
 	servers = append(servers, func() {
 		go c.gatewayServer.Serve(c.gatewayListener)
@@ -150,6 +168,8 @@ func (c *Controller) startListeners(ctx context.Context) error {
 				err = configureForAPI(ln)
 			case "cluster":
 				err = configureForCluster(ln)
+			case "metrics":
+				err = configureForMetrics(ln)
 			case "proxy":
 				// Do nothing, in a dev mode we might see it here
 			default:
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/observability/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestControllerMetrics(t *testing.T) {
+	require, assert := require.New(t), assert.New(t)
+	c := NewTestController(t, &TestControllerOpts{
+		EnableMetrics: true,
+	})
+	defer c.Shutdown()
+
+	resp, err := http.Get(c.ApiAddrs()[0] + "/v1/scopes")
+	require.NoError(err)
+	resp.Body.Close()
+
+	addrs := c.MetricsAddrs()
+	require.Len(addrs, 1)
+	families := metrics.TestScrape(t, addrs[0])
+	for _, name := range []string{
+		"boundary_http_requests_total",
+		"boundary_http_request_duration_seconds",
+		"boundary_grpc_requests_total",
+		"boundary_grpc_request_duration_seconds",
+		"go_sql_open_connections",
+	} {
+		assert.Contains(families, name)
+	}
+}
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/servers/controller/common"
+)
+
+// principalRoleCleanupJob defines a periodic job that deletes the
+// assignments of roles to principals once their not after time has passed.
+//
+// Expired assignments are already ignored when computing the grants of a
+// user, so this job only keeps them from accumulating.
+type principalRoleCleanupJob struct {
+	iamRepoFn common.IamRepoFactory
+
+	// The total number of principal roles deleted in the last run.
+	totalDeleted int
+}
+
+// newPrincipalRoleCleanupJob instantiates the principal role cleanup job.
+func newPrincipalRoleCleanupJob(iamRepoFn common.IamRepoFactory) (*principalRoleCleanupJob, error) {
+	const op = "controller.newPrincipalRoleCleanupJob"
+	if iamRepoFn == nil {
+		return nil, errors.NewDeprecated(errors.InvalidParameter, op, "missing iamRepoFn")
+	}
+	return &principalRoleCleanupJob{
+		iamRepoFn: iamRepoFn,
+	}, nil
+}
+
+// Name returns a short, unique name for the job.
+func (j *principalRoleCleanupJob) Name() string { return "principal_role_cleanup" }
+
+// Description returns the description for the job.
+func (j *principalRoleCleanupJob) Description() string {
+	return "Delete the assignments of roles to principals whose not after time has passed"
+}
+
+// NextRunIn returns the next run time after a job is completed.
+func (j *principalRoleCleanupJob) NextRunIn() (time.Duration, error) { return time.Minute, nil }
+
+// Status returns the status of the running job.
+func (j *principalRoleCleanupJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.totalDeleted,
+		Total:     j.totalDeleted,
+	}
+}
+
+// Run executes the job.
+func (j *principalRoleCleanupJob) Run(ctx context.Context) error {
+	const op = "controller.(principalRoleCleanupJob).Run"
+	j.totalDeleted = 0
+
+	iamRepo, err := j.iamRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("error getting iam repo"))
+	}
+	j.totalDeleted, err = iamRepo.DeleteExpiredPrincipalRoles(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
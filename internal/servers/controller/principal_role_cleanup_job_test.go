@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assert the interface
+var _ = scheduler.Job(new(principalRoleCleanupJob))
+
+func TestPrincipalRoleCleanupJob(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+	user := iam.TestUser(t, iamRepo, org.PublicId)
+	ctx := context.Background()
+
+	expiredRole := iam.TestRole(t, conn, org.PublicId)
+	iam.TestUserRole(t, conn, expiredRole.PublicId, user.PublicId, iam.WithNotAfter(time.Now().Add(-time.Minute)))
+	currentRole := iam.TestRole(t, conn, org.PublicId)
+	iam.TestUserRole(t, conn, currentRole.PublicId, user.PublicId, iam.WithNotAfter(time.Now().Add(time.Hour)))
+
+	job, err := newPrincipalRoleCleanupJob(func() (*iam.Repository, error) { return iamRepo, nil })
+	require.NoError(err)
+	require.NoError(job.Run(ctx))
+	assert.Equal(1, job.Status().Completed)
+
+	principals, err := iamRepo.ListPrincipalRoles(ctx, expiredRole.PublicId)
+	require.NoError(err)
+	assert.Empty(principals)
+	principals, err = iamRepo.ListPrincipalRoles(ctx, currentRole.PublicId)
+	require.NoError(err)
+	assert.Len(principals, 1)
+}
+
+func TestNewPrincipalRoleCleanupJob(t *testing.T) {
+	t.Parallel()
+	_, err := newPrincipalRoleCleanupJob(nil)
+	require.Error(t, err)
+}
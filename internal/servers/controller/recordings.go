@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/servers"
+	"github.com/hashicorp/boundary/internal/servers/common"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// fetchRecording returns the recording of the connection connectionId of the
+// session sessionId from the worker named workerId. The request is sent to the
+// proxy listener of the ingress worker of workerId, which forwards it down the
+// chain of upstream workers of workerId if it has any.
+func (c *Controller) fetchRecording(ctx context.Context, workerId, sessionId, connectionId string) (io.ReadCloser, error) {
+	serversRepo, err := c.ServersRepoFn()
+	if err != nil {
+		return nil, fmt.Errorf("error getting servers repo: %w", err)
+	}
+	workers, err := serversRepo.ListServers(ctx, servers.ServerTypeWorker)
+	if err != nil {
+		return nil, fmt.Errorf("error listing workers: %w", err)
+	}
+	cur := servers.IngressWorker(workers, workerId)
+	if cur == nil {
+		return nil, fmt.Errorf("worker %q is not connected", workerId)
+	}
+	addrs := []string{cur.GetAddress()}
+	for cur.GetPrivateId() != workerId {
+		if cur = servers.DownstreamHop(workers, cur.GetPrivateId(), workerId); cur == nil {
+			return nil, fmt.Errorf("no route to worker %q", workerId)
+		}
+		addrs = append(addrs, cur.GetAddress())
+	}
+
+	info := &base.WorkerAuthInfo{
+		Name: c.conf.RawConfig.Controller.Name,
+	}
+	if info.ConnectionNonce, err = base62.Random(20); err != nil {
+		return nil, err
+	}
+	tlsConf, err := info.ClientTLSConfig(ctx, c.conf.WorkerAuthKms, c.conf.SecureRandomReader)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tls config for worker auth: %w", err)
+	}
+	tlsConf.NextProtos = append(tlsConf.NextProtos, base.WorkerRecordingProto)
+	return common.FetchRecording(ctx, tlsConf, addrs, sessionId, connectionId)
+}
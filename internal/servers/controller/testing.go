@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/boundary/internal/intglobals"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -132,6 +133,12 @@ func (tc *TestController) ClusterAddrs() []string {
 	return tc.addrs("cluster")
 }
 
+// MetricsAddrs returns the addresses of the metrics listeners, which are only
+// set up with the EnableMetrics option.
+func (tc *TestController) MetricsAddrs() []string {
+	return tc.addrs("metrics")
+}
+
 func (tc *TestController) DbConn() *db.DB {
 	return tc.b.Database
 }
@@ -204,6 +211,8 @@ func (tc *TestController) addrs(purpose string) []string {
 		if tc.clusterAddrs != nil {
 			return tc.clusterAddrs
 		}
+	case "metrics":
+		prefix = "http://"
 	}
 
 	addrs := make([]string, 0, len(tc.b.Listeners))
@@ -355,6 +364,9 @@ type TestControllerOpts struct {
 	// If true, the controller will not be started
 	DisableAutoStart bool
 
+	// EnableMetrics adds a listener exposing the metrics of the controller
+	EnableMetrics bool
+
 	// DisableAuthorizationFailures will still cause authz checks to be
 	// performed but they won't cause 403 Forbidden. Useful for API-level
 	// testing to avoid a lot of faff.
@@ -562,11 +574,15 @@ func TestControllerConfig(t *testing.T, ctx context.Context, tc *TestController,
 		tc.b.RecoveryKms = opts.RecoveryKms
 	}
 
+	if opts.EnableMetrics {
+		opts.Config.Listeners = append(opts.Config.Listeners, metrics.TestListenerConfig())
+	}
+
 	// Ensure the listeners use random port allocation
 	for _, listener := range opts.Config.Listeners {
 		listener.RandomPort = true
 	}
-	if err := tc.b.SetupListeners(nil, opts.Config.SharedConfig, []string{"api", "cluster"}); err != nil {
+	if err := tc.b.SetupListeners(nil, opts.Config.SharedConfig, []string{"api", "cluster", "metrics"}); err != nil {
 		t.Fatal(err)
 	}
 	if err := tc.b.SetupControllerPublicClusterAddress(opts.Config, ""); err != nil {
@@ -3,18 +3,12 @@ package controller
 import (
 	"context"
 	"crypto/tls"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"net"
 	"strings"
 
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/servers"
-	wrapping "github.com/hashicorp/go-kms-wrapping"
-	"google.golang.org/protobuf/proto"
 )
 
 type workerAuthEntry struct {
@@ -29,7 +23,7 @@ type workerAuthEntry struct {
 func (c Controller) validateWorkerTls(hello *tls.ClientHelloInfo) (*tls.Config, error) {
 	for _, p := range hello.SupportedProtos {
 		switch {
-		case strings.HasPrefix(p, "v1workerauth-"):
+		case strings.HasPrefix(p, base.WorkerAuthProtoPrefix):
 			tlsConf, workerInfo, err := c.v1WorkerAuthConfig(hello.SupportedProtos)
 			if err == nil {
 				// Store info that can be retrieved in the intercepting
@@ -61,36 +55,10 @@ func (c Controller) validateWorkerTls(hello *tls.ClientHelloInfo) (*tls.Config,
 // * Ensures that the nonce is unique to prevent replay attacks
 // * Returns the shared TLS configuration that is used to establish the connection
 func (c Controller) v1WorkerAuthConfig(protos []string) (*tls.Config, *base.WorkerAuthInfo, error) {
-	var firstMatchProto string
-	var encString string
-	for _, p := range protos {
-		if strings.HasPrefix(p, "v1workerauth-") {
-			// Strip that and the number
-			encString += strings.TrimPrefix(p, "v1workerauth-")[3:]
-			if firstMatchProto == "" {
-				firstMatchProto = p
-			}
-		}
-	}
-	if firstMatchProto == "" {
-		return nil, nil, errors.New("no matching proto found")
-	}
-	marshaledEncInfo, err := base64.RawStdEncoding.DecodeString(encString)
+	info, firstMatchProto, err := base.DecodeWorkerAuthInfo(context.Background(), c.conf.WorkerAuthKms, protos)
 	if err != nil {
 		return nil, nil, err
 	}
-	encInfo := new(wrapping.EncryptedBlobInfo)
-	if err := proto.Unmarshal(marshaledEncInfo, encInfo); err != nil {
-		return nil, nil, err
-	}
-	marshaledInfo, err := c.conf.WorkerAuthKms.Decrypt(context.Background(), encInfo, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	info := new(base.WorkerAuthInfo)
-	if err := json.Unmarshal(marshaledInfo, info); err != nil {
-		return nil, nil, err
-	}
 
 	// Check for replays
 	serversRepo, err := c.ServersRepoFn()
@@ -101,21 +69,10 @@ func (c Controller) v1WorkerAuthConfig(protos []string) (*tls.Config, *base.Work
 		return nil, nil, fmt.Errorf("unable to add connection nonce to database: %w", err)
 	}
 
-	rootCAs := x509.NewCertPool()
-	if ok := rootCAs.AppendCertsFromPEM(info.CertPEM); !ok {
-		return nil, info, errors.New("unable to add ca cert to cert pool")
-	}
-	tlsCert, err := tls.X509KeyPair(info.CertPEM, info.KeyPEM)
+	tlsConfig, err := info.ServerTLSConfig(firstMatchProto)
 	if err != nil {
 		return nil, info, err
 	}
-	tlsConfig := &tls.Config{
-		Certificates: []tls.Certificate{tlsCert},
-		ClientCAs:    rootCAs,
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-		NextProtos:   []string{firstMatchProto},
-		MinVersion:   tls.VersionTLS13,
-	}
 
 	return tlsConfig, info, nil
 }
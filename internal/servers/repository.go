@@ -147,7 +147,7 @@ func (r *Repository) UpsertServer(ctx context.Context, server *Server, opt ...Op
 			var err error
 			onConflict := &db.OnConflict{
 				Target: db.Constraint("server_pkey"),
-				Action: append(db.SetColumns([]string{"type", "description", "address"}), db.SetColumnValues(map[string]interface{}{"update_time": "now()"})...),
+				Action: append(db.SetColumns([]string{"type", "description", "address", "upstream"}), db.SetColumnValues(map[string]interface{}{"update_time": "now()"})...),
 			}
 			err = w.Create(ctx, server, db.WithOnConflict(onConflict), db.WithReturnRowsAffected(&rowsUpdated))
 			if err != nil {
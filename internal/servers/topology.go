@@ -0,0 +1,49 @@
+package servers
+
+// IngressWorker returns the worker that clients connect to in order to reach
+// the worker named name. This is the first worker of the chain of upstream
+// workers of name, or the worker itself when it connects to the controllers
+// directly. It returns nil if name is not in workers, if one of the upstream
+// workers is not in workers, or if the chain loops.
+func IngressWorker(workers []*Server, name string) *Server {
+	byName := workersByName(workers)
+	cur := byName[name]
+	seen := map[string]bool{}
+	for cur != nil && cur.GetUpstream() != "" {
+		if seen[cur.GetPrivateId()] {
+			return nil
+		}
+		seen[cur.GetPrivateId()] = true
+		cur = byName[cur.GetUpstream()]
+	}
+	return cur
+}
+
+// DownstreamHop returns the worker to which the worker named from relays the
+// connections meant for the worker named to. This is the worker directly
+// downstream of from in the chain of upstream workers of to. It returns nil if
+// from is not in the chain of upstream workers of to.
+func DownstreamHop(workers []*Server, from, to string) *Server {
+	byName := workersByName(workers)
+	cur := byName[to]
+	seen := map[string]bool{}
+	for cur != nil && cur.GetUpstream() != "" {
+		if seen[cur.GetPrivateId()] {
+			return nil
+		}
+		seen[cur.GetPrivateId()] = true
+		if cur.GetUpstream() == from {
+			return cur
+		}
+		cur = byName[cur.GetUpstream()]
+	}
+	return nil
+}
+
+func workersByName(workers []*Server) map[string]*Server {
+	byName := make(map[string]*Server, len(workers))
+	for _, w := range workers {
+		byName[w.GetPrivateId()] = w
+	}
+	return byName
+}
@@ -0,0 +1,62 @@
+package servers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopology(t *testing.T) {
+	t.Parallel()
+	// w1 <- w2 <- w3, w4 connects directly, w5 has an unknown upstream and
+	// w6 and w7 are upstreams of each other.
+	workers := []*Server{
+		{PrivateId: "w1", Address: "w1:9202"},
+		{PrivateId: "w2", Address: "w2:9202", Upstream: "w1"},
+		{PrivateId: "w3", Address: "w3:9202", Upstream: "w2"},
+		{PrivateId: "w4", Address: "w4:9202"},
+		{PrivateId: "w5", Address: "w5:9202", Upstream: "unknown"},
+		{PrivateId: "w6", Address: "w6:9202", Upstream: "w7"},
+		{PrivateId: "w7", Address: "w7:9202", Upstream: "w6"},
+	}
+
+	t.Run("IngressWorker", func(t *testing.T) {
+		tests := []struct {
+			name string
+			want string
+		}{
+			{name: "w1", want: "w1"},
+			{name: "w2", want: "w1"},
+			{name: "w3", want: "w1"},
+			{name: "w4", want: "w4"},
+			{name: "w5"},
+			{name: "w6"},
+			{name: "missing"},
+		}
+		for _, tt := range tests {
+			got := IngressWorker(workers, tt.name)
+			assert.Equal(t, tt.want, got.GetPrivateId(), tt.name)
+		}
+	})
+
+	t.Run("DownstreamHop", func(t *testing.T) {
+		tests := []struct {
+			from, to string
+			want     string
+		}{
+			{from: "w1", to: "w3", want: "w2"},
+			{from: "w2", to: "w3", want: "w3"},
+			{from: "w1", to: "w2", want: "w2"},
+			{from: "w3", to: "w1"},
+			{from: "w1", to: "w1"},
+			{from: "w4", to: "w3"},
+			{from: "w1", to: "w5"},
+			{from: "w1", to: "w6"},
+			{from: "w1", to: "missing"},
+		}
+		for _, tt := range tests {
+			got := DownstreamHop(workers, tt.from, tt.to)
+			assert.Equal(t, tt.want, got.GetPrivateId(), "%s to %s", tt.from, tt.to)
+		}
+	})
+}
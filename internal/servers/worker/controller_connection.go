@@ -2,53 +2,56 @@ package worker
 
 import (
 	"context"
-	"crypto/ed25519"
 	"crypto/tls"
-	"crypto/x509"
-	"encoding/base64"
-	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"math"
-	"math/big"
-	mathrand "math/rand"
 	"net"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/resolver"
-	"google.golang.org/protobuf/proto"
 )
 
 func (w *Worker) startControllerConnections() error {
 	const op = "worker.(Worker).startControllerConnections"
-	initialAddrs := make([]resolver.Address, 0, len(w.conf.RawConfig.Worker.Controllers))
-	for _, addr := range w.conf.RawConfig.Worker.Controllers {
+	// When upstream workers are configured, the controllers are reached
+	// through them rather than directly
+	rawAddrs, defaultPort := w.conf.RawConfig.Worker.Controllers, "9201"
+	if len(w.conf.RawConfig.Worker.Upstreams) > 0 {
+		rawAddrs, defaultPort = w.conf.RawConfig.Worker.Upstreams, "9202"
+	}
+	initialAddrs := make([]resolver.Address, 0, len(rawAddrs))
+	strAddrs := make([]string, 0, len(rawAddrs))
+	for _, addr := range rawAddrs {
 		switch {
 		case strings.HasPrefix(addr, "/"):
+			if len(w.conf.RawConfig.Worker.Upstreams) > 0 {
+				return fmt.Errorf("upstream worker address %q cannot be a unix socket", addr)
+			}
 			initialAddrs = append(initialAddrs, resolver.Address{Addr: addr})
 		default:
 			host, port, err := net.SplitHostPort(addr)
 			if err != nil && strings.Contains(err.Error(), "missing port in address") {
-				host, port, err = net.SplitHostPort(net.JoinHostPort(addr, "9201"))
+				host, port, err = net.SplitHostPort(net.JoinHostPort(addr, defaultPort))
 			}
 			if err != nil {
 				return fmt.Errorf("error parsing controller address: %w", err)
 			}
 			initialAddrs = append(initialAddrs, resolver.Address{Addr: net.JoinHostPort(host, port)})
 		}
+		strAddrs = append(strAddrs, initialAddrs[len(initialAddrs)-1].Addr)
 	}
 
 	if len(initialAddrs) == 0 {
 		return errors.New("no initial controller addresses found")
 	}
+	w.controllerAddrs.Store(strAddrs)
 
 	w.Resolver().InitialState(resolver.State{
 		Addresses: initialAddrs,
@@ -70,6 +73,8 @@ func (w *Worker) controllerDialerFunc() func(context.Context, string) (net.Conn,
 		dialer := &net.Dialer{}
 		var nonTlsConn net.Conn
 		switch {
+		case len(w.conf.RawConfig.Worker.Upstreams) > 0:
+			nonTlsConn, err = w.dialUpstreamRelay(ctx, addr)
 		case strings.HasPrefix(addr, "/"):
 			nonTlsConn, err = dialer.DialContext(ctx, "unix", addr)
 		default:
@@ -150,94 +155,9 @@ func (w *Worker) workerAuthTLSConfig() (*tls.Config, *base.WorkerAuthInfo, error
 			w.testReusedAuthNonce = info.ConnectionNonce
 		}
 	}
-
-	pubKey, privKey, err := ed25519.GenerateKey(w.conf.SecureRandomReader)
-	if err != nil {
-		return nil, nil, err
-	}
-	host, err := base62.Random(20)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	template := &x509.Certificate{
-		ExtKeyUsage: []x509.ExtKeyUsage{
-			x509.ExtKeyUsageServerAuth,
-			x509.ExtKeyUsageClientAuth,
-		},
-		DNSNames:              []string{host},
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageKeyAgreement | x509.KeyUsageCertSign,
-		SerialNumber:          big.NewInt(mathrand.Int63()),
-		NotBefore:             time.Now().Add(-30 * time.Second),
-		NotAfter:              time.Now().Add(globals.WorkerAuthNonceValidityPeriod),
-		BasicConstraintsValid: true,
-		IsCA:                  true,
-	}
-	certBytes, err := x509.CreateCertificate(w.conf.SecureRandomReader, template, template, pubKey, privKey)
+	tlsConfig, err := info.ClientTLSConfig(context.Background(), w.conf.WorkerAuthKms, w.conf.SecureRandomReader)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	certPEMBlock := &pem.Block{
-		Type:  "CERTIFICATE",
-		Bytes: certBytes,
-	}
-	info.CertPEM = pem.EncodeToMemory(certPEMBlock)
-
-	marshaledKey, err := x509.MarshalPKCS8PrivateKey(privKey)
-	if err != nil {
-		return nil, nil, err
-	}
-	keyPEMBlock := &pem.Block{
-		Type:  "PRIVATE KEY",
-		Bytes: marshaledKey,
-	}
-	info.KeyPEM = pem.EncodeToMemory(keyPEMBlock)
-
-	// Marshal and encrypt
-	marshaledInfo, err := json.Marshal(info)
-	if err != nil {
-		return nil, nil, err
-	}
-	encInfo, err := w.conf.WorkerAuthKms.Encrypt(context.Background(), marshaledInfo, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	marshaledEncInfo, err := proto.Marshal(encInfo)
-	if err != nil {
-		return nil, nil, err
-	}
-	b64alpn := base64.RawStdEncoding.EncodeToString(marshaledEncInfo)
-	var nextProtos []string
-	var count int
-	for i := 0; i < len(b64alpn); i += 230 {
-		end := i + 230
-		if end > len(b64alpn) {
-			end = len(b64alpn)
-		}
-		nextProtos = append(nextProtos, fmt.Sprintf("v1workerauth-%02d-%s", count, b64alpn[i:end]))
-		count++
-	}
-
-	cert, err := x509.ParseCertificate(certBytes)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	// Build local tls config
-	rootCAs := x509.NewCertPool()
-	rootCAs.AddCert(cert)
-	tlsCert, err := tls.X509KeyPair(info.CertPEM, info.KeyPEM)
-	if err != nil {
-		return nil, nil, err
-	}
-	tlsConfig := &tls.Config{
-		ServerName:   host,
-		Certificates: []tls.Certificate{tlsCert},
-		RootCAs:      rootCAs,
-		NextProtos:   nextProtos,
-		MinVersion:   tls.VersionTLS13,
-	}
-
 	return tlsConfig, info, nil
 }
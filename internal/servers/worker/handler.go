@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/proxy"
 	"github.com/hashicorp/boundary/internal/servers/common"
 	proxyHandlers "github.com/hashicorp/boundary/internal/servers/worker/proxy"
@@ -37,7 +38,8 @@ func (w *Worker) handler(props HandlerProperties) (http.Handler, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	mux.Handle("/v1/proxy", h)
+	mux.Handle("/v1/proxy", metrics.InstrumentHttpHandler("proxy", h))
+	mux.Handle(common.RecordingPath, w.handleRecording())
 
 	genericWrappedHandler := w.wrapGenericHandler(mux, props)
 
@@ -94,6 +96,7 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig) (http.Han
 		version := si.LookupSessionResponse.GetVersion()
 		endpoint := si.LookupSessionResponse.GetEndpoint()
 		credentials := si.LookupSessionResponse.GetCredentials()
+		downstreamAddr := si.LookupSessionResponse.GetDownstreamWorkerAddress()
 		sessStatus := si.Status
 		si.RUnlock()
 
@@ -112,6 +115,18 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig) (http.Han
 		connCtx, connCancel := context.WithDeadline(ctx, expiration.AsTime())
 		defer connCancel()
 
+		// The session is served by a worker downstream of this one, to which
+		// the connection is relayed as is.
+		if downstreamAddr != "" {
+			if err := w.relaySession(connCtx, conn, si, downstreamAddr); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error relaying connection to downstream worker", "session_id", sessionId, "address", downstreamAddr))
+				if err = conn.Close(websocket.StatusInternalError, "unable to relay connection"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+				}
+			}
+			return
+		}
+
 		sessClient, err := w.ControllerSessionConn()
 		if err != nil {
 			event.WriteError(ctx, op, err)
@@ -199,6 +214,14 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig) (http.Han
 			return
 		}
 
+		if up, down := si.BytesLeft(); up == 0 || down == 0 {
+			event.WriteError(ctx, op, session.ErrBytesLimit, event.WithInfoMsg("refusing connection", "session_id", sessionId))
+			if err = conn.Close(websocket.StatusPolicyViolation, "session bytes limit reached"); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error closing client connection"))
+			}
+			return
+		}
+
 		var ci *session.ConnInfo
 		var connsLeft int32
 		ci, connsLeft, err = session.AuthorizeConnection(ctx, sessClient, workerId, sessionId)
@@ -254,6 +277,9 @@ func (w *Worker) handleProxy(listenerCfg *listenerutil.ListenerConfig) (http.Han
 		if len(credentials) > 0 {
 			proxyOpts = append(proxyOpts, proxyHandlers.WithEgressCredentials(credentials))
 		}
+		if recordingPath := w.conf.RawConfig.Worker.RecordingStoragePath; recordingPath != "" {
+			proxyOpts = append(proxyOpts, proxyHandlers.WithRecordingStoragePath(recordingPath))
+		}
 
 		if err = handleProxyFn(connCtx, conf, proxyOpts...); err != nil {
 			event.WriteError(ctx, op, err, event.WithInfoMsg("error handling proxy", "session_id", sessionId, "endpoint", endpoint))
@@ -0,0 +1,180 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/host/health"
+	"google.golang.org/protobuf/proto"
+)
+
+// The controllers send the complete list of the health checks run by the
+// worker in each status response. The worker runs each check when its
+// interval has elapsed, keeps the latest result of each check, and reports
+// the results in its next status request.
+
+// healthCheckKey identifies the health check of a host of a host set.
+type healthCheckKey struct {
+	hostId string
+	setId  string
+}
+
+type runningHealthCheck struct {
+	check   *pbs.HealthCheck
+	lastRun time.Time
+	running bool
+}
+
+// healthChecker runs the health checks of the worker and stores their
+// results until they are reported to a controller.
+type healthChecker struct {
+	mu      sync.Mutex
+	checks  map[healthCheckKey]*runningHealthCheck
+	results map[healthCheckKey]*pbs.HealthCheckResult
+}
+
+func newHealthChecker() *healthChecker {
+	return &healthChecker{
+		checks:  make(map[healthCheckKey]*runningHealthCheck),
+		results: make(map[healthCheckKey]*pbs.HealthCheckResult),
+	}
+}
+
+// update replaces the health checks of the worker with checks and starts the
+// checks whose interval has elapsed since they last ran. The results of the
+// checks which are removed are discarded.
+func (h *healthChecker) update(ctx context.Context, checks []*pbs.HealthCheck) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	current := make(map[healthCheckKey]*runningHealthCheck, len(checks))
+	for _, c := range checks {
+		key := healthCheckKey{hostId: c.GetHostId(), setId: c.GetHostSetId()}
+		if rc, ok := h.checks[key]; ok && proto.Equal(rc.check, c) {
+			current[key] = rc
+			continue
+		}
+		current[key] = &runningHealthCheck{check: c}
+	}
+	for key := range h.results {
+		if _, ok := current[key]; !ok {
+			delete(h.results, key)
+		}
+	}
+	h.checks = current
+
+	now := time.Now()
+	for key, rc := range h.checks {
+		interval := time.Duration(rc.check.GetIntervalSeconds()) * time.Second
+		if rc.running || now.Sub(rc.lastRun) < interval {
+			continue
+		}
+		rc.running = true
+		rc.lastRun = now
+		go h.run(ctx, key, rc.check)
+	}
+}
+
+// run runs the health check c and stores its result, unless the check was
+// replaced or removed while it ran.
+func (h *healthChecker) run(ctx context.Context, key healthCheckKey, c *pbs.HealthCheck) {
+	res := &pbs.HealthCheckResult{
+		HostId:    c.GetHostId(),
+		HostSetId: c.GetHostSetId(),
+		Healthy:   true,
+	}
+	if err := runHealthCheck(ctx, c); err != nil {
+		res.Healthy = false
+		res.Message = err.Error()
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if rc, ok := h.checks[key]; ok && rc.check == c {
+		rc.running = false
+		h.results[key] = res
+	}
+}
+
+// drainResults returns the results stored since the last call and removes
+// them from the health checker.
+func (h *healthChecker) drainResults() []*pbs.HealthCheckResult {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.results) == 0 {
+		return nil
+	}
+	ret := make([]*pbs.HealthCheckResult, 0, len(h.results))
+	for key, res := range h.results {
+		ret = append(ret, res)
+		delete(h.results, key)
+	}
+	return ret
+}
+
+// requeue stores results which could not be reported again, unless a newer
+// result of the same check was stored since they were drained.
+func (h *healthChecker) requeue(results []*pbs.HealthCheckResult) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, res := range results {
+		key := healthCheckKey{hostId: res.GetHostId(), setId: res.GetHostSetId()}
+		if _, ok := h.checks[key]; !ok {
+			continue
+		}
+		if _, ok := h.results[key]; !ok {
+			h.results[key] = res
+		}
+	}
+}
+
+// runHealthCheck runs the health check c. It returns an error describing the
+// failure of the check if the host is unhealthy.
+func runHealthCheck(ctx context.Context, c *pbs.HealthCheck) error {
+	timeout := time.Duration(c.GetTimeoutSeconds()) * time.Second
+	addr := net.JoinHostPort(c.GetAddress(), strconv.FormatUint(uint64(c.GetPort()), 10))
+	switch health.CheckType(c.GetType()) {
+	case health.TcpCheck:
+		d := net.Dialer{Timeout: timeout}
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+
+	case health.HttpCheck:
+		path := c.GetPath()
+		if path == "" {
+			path = "/"
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+addr+path, nil)
+		if err != nil {
+			return err
+		}
+		client := &http.Client{
+			Timeout: timeout,
+			// A redirect is a response of the host, not a failure.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("unhealthy status code %d", resp.StatusCode)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown health check type %q", c.GetType())
+	}
+}
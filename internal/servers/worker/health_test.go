@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunHealthCheck(t *testing.T) {
+	t.Parallel()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	openPort := uint32(ln.Addr().(*net.TCPAddr).Port)
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := uint32(closed.Addr().(*net.TCPAddr).Port)
+	require.NoError(t, closed.Close())
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusOK)
+		case "/moved":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+	_, p, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	httpPort, err := strconv.ParseUint(p, 10, 32)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		checkType   string
+		port        uint32
+		path        string
+		wantHealthy bool
+	}{
+		{name: "tcp-open", checkType: "tcp", port: openPort, wantHealthy: true},
+		{name: "tcp-closed", checkType: "tcp", port: closedPort},
+		{name: "http-ok", checkType: "http", port: uint32(httpPort), path: "/healthz", wantHealthy: true},
+		{name: "http-redirect", checkType: "http", port: uint32(httpPort), path: "/moved", wantHealthy: true},
+		{name: "http-unavailable", checkType: "http", port: uint32(httpPort), path: "/down"},
+		{name: "http-closed", checkType: "http", port: closedPort, path: "/healthz"},
+		{name: "unknown-type", checkType: "udp", port: openPort},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := runHealthCheck(context.Background(), &pbs.HealthCheck{
+				Address:        "127.0.0.1",
+				Type:           tt.checkType,
+				Port:           tt.port,
+				Path:           tt.path,
+				TimeoutSeconds: 1,
+			})
+			if tt.wantHealthy {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHealthChecker(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	check := &pbs.HealthCheck{
+		HostId:          "hst_1234567890",
+		HostSetId:       "hsst_1234567890",
+		Address:         "127.0.0.1",
+		Type:            "tcp",
+		Port:            uint32(ln.Addr().(*net.TCPAddr).Port),
+		IntervalSeconds: 30,
+		TimeoutSeconds:  1,
+	}
+
+	ctx := context.Background()
+	h := newHealthChecker()
+	h.update(ctx, []*pbs.HealthCheck{check})
+
+	var results []*pbs.HealthCheckResult
+	require.Eventually(func() bool {
+		results = h.drainResults()
+		return len(results) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Len(results, 1)
+	assert.Equal(check.HostId, results[0].GetHostId())
+	assert.Equal(check.HostSetId, results[0].GetHostSetId())
+	assert.True(results[0].GetHealthy())
+	assert.Empty(h.drainResults())
+
+	// The check is not run again before its interval elapses.
+	h.update(ctx, []*pbs.HealthCheck{check})
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(h.drainResults())
+
+	// Results which could not be reported are kept.
+	h.requeue(results)
+	assert.Len(h.drainResults(), 1)
+
+	// The results of removed checks are discarded.
+	h.requeue(results)
+	h.update(ctx, nil)
+	assert.Empty(h.drainResults())
+	h.requeue(results)
+	assert.Empty(h.drainResults())
+}
@@ -11,8 +11,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/libs/alpnmux"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -34,6 +36,26 @@ func (w *Worker) startListeners() error {
 				// We may have this in dev mode; ignore
 				continue
 
+			case "metrics":
+				// In a combined controller and worker the controller serves
+				// the metrics of both
+				if w.conf.RawConfig.Controller != nil {
+					continue
+				}
+				server, ls, err := base.MetricsServer(ln, metrics.Handler())
+				if err != nil {
+					return fmt.Errorf("%s: %w", op, err)
+				}
+				server.ErrorLog = logger
+				ln.HTTPServer = server
+				for _, l := range ls {
+					l := l
+					servers = append(servers, func() {
+						go server.Serve(l)
+					})
+				}
+				continue
+
 			case "proxy":
 				// Do nothing; handle below
 
@@ -58,6 +80,9 @@ func (w *Worker) startListeners() error {
 				BaseContext: func(net.Listener) context.Context {
 					return cancelCtx
 				},
+				TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){
+					base.WorkerRecordingProto: serveRecordingConn,
+				},
 			}
 			ln.HTTPServer = server
 
@@ -78,7 +103,7 @@ func (w *Worker) startListeners() error {
 			ln.Mux.UnregisterProto(alpnmux.DefaultProto)
 			ln.Mux.UnregisterProto(alpnmux.NoProto)
 			l, err := ln.Mux.RegisterProto(alpnmux.DefaultProto, &tls.Config{
-				GetConfigForClient: w.getSessionTls,
+				GetConfigForClient: w.getListenerTls,
 			})
 			if err != nil {
 				return fmt.Errorf("error getting tls listener: %w", err)
@@ -87,6 +112,10 @@ func (w *Worker) startListeners() error {
 				return errors.New("could not get tls listener")
 			}
 
+			// Connections of downstream workers are relayed rather than
+			// served
+			l = &relayListener{Listener: l, w: w}
+
 			servers = append(servers, func() {
 				go server.Serve(l)
 			})
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerMetrics(t *testing.T) {
+	require := require.New(t)
+	tw := NewTestWorker(t, &TestWorkerOpts{
+		WorkerAuthKms:             db.TestWrapper(t),
+		EnableMetrics:             true,
+		StatusGracePeriodDuration: time.Second,
+	})
+	defer tw.Shutdown()
+
+	addrs := tw.MetricsAddrs()
+	require.Len(addrs, 1)
+
+	// The session and connection gauges are set at the first status report,
+	// even if it fails without a controller.
+	require.Eventually(func() bool {
+		families := metrics.TestScrape(t, addrs[0])
+		for _, name := range []string{"boundary_worker_sessions_active", "boundary_worker_connections_active"} {
+			family, ok := families[name]
+			if !ok {
+				return false
+			}
+			var found bool
+			for _, m := range family.GetMetric() {
+				for _, l := range m.GetLabel() {
+					if l.GetName() == "worker" && l.GetValue() == tw.Name() {
+						found = true
+						assert.Equal(t, float64(0), m.GetGauge().GetValue())
+					}
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+}
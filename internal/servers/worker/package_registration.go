@@ -1,5 +1,6 @@
 package worker
 
 import (
+	_ "github.com/hashicorp/boundary/internal/servers/worker/proxy/ssh"
 	_ "github.com/hashicorp/boundary/internal/servers/worker/proxy/tcp"
 )
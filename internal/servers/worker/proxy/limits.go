@@ -0,0 +1,152 @@
+package proxy
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/observability/metrics"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+)
+
+// SessionReader counts the bytes read from the connections of a session
+// against the bytes limits of the session.
+type SessionReader struct {
+	si       *session.Info
+	activity func()
+
+	mu        sync.Mutex
+	bytesUp   uint64
+	bytesDown uint64
+}
+
+// NewSessionReader returns a SessionReader for the session si. activity, which
+// may be nil, is called every time data is read in either direction.
+func NewSessionReader(si *session.Info, activity func()) *SessionReader {
+	return &SessionReader{
+		si:       si,
+		activity: activity,
+	}
+}
+
+// Reader returns a reader of r, which carries data in direction d. Reads are
+// shortened so the session does not go past its bytes limit in direction d,
+// and session.ErrBytesLimit is returned once the limit is reached.
+func (s *SessionReader) Reader(d Direction, r io.Reader) io.Reader {
+	return &sessionReader{s: s, d: d, r: r}
+}
+
+// Bytes returns the number of bytes read up and down by the readers of s.
+func (s *SessionReader) Bytes() (up, down uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bytesUp, s.bytesDown
+}
+
+// add counts n bytes read in direction d against the bytes limits of the
+// session, and returns how many of them fit within the limit.
+func (s *SessionReader) add(d Direction, n int) int {
+	var added int64
+	if d == DirectionUp {
+		added, _ = s.si.AddBytes(int64(n), 0)
+	} else {
+		_, added = s.si.AddBytes(0, int64(n))
+	}
+	metrics.WorkerProxiedBytes.WithLabelValues(string(d)).Add(float64(added))
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d == DirectionUp {
+		s.bytesUp += uint64(added)
+	} else {
+		s.bytesDown += uint64(added)
+	}
+	return int(added)
+}
+
+type sessionReader struct {
+	s *SessionReader
+	d Direction
+	r io.Reader
+}
+
+func (r *sessionReader) Read(p []byte) (int, error) {
+	left := r.left()
+	if left == 0 {
+		return 0, session.ErrBytesLimit
+	}
+	if left > 0 && int64(len(p)) > left {
+		p = p[:left]
+	}
+	n, err := r.r.Read(p)
+	if n > 0 {
+		// Other readers of the session may have used up what was left
+		// while this one was reading, in which case the bytes past the
+		// limit are dropped.
+		if added := r.s.add(r.d, n); added < n {
+			n, err = added, session.ErrBytesLimit
+		}
+		if r.s.activity != nil {
+			r.s.activity()
+		}
+	}
+	return n, err
+}
+
+func (r *sessionReader) left() int64 {
+	up, down := r.s.si.BytesLeft()
+	if r.d == DirectionUp {
+		return up
+	}
+	return down
+}
+
+// IdleTimer calls a function once no activity has been reported for a
+// duration.
+type IdleTimer struct {
+	timeout time.Duration
+	timer   *time.Timer
+
+	mu    sync.Mutex
+	fired bool
+}
+
+// NewIdleTimer returns an IdleTimer calling f once Activity has not been called
+// for timeout. If timeout is zero the timer never fires.
+func NewIdleTimer(timeout time.Duration, f func()) *IdleTimer {
+	t := &IdleTimer{timeout: timeout}
+	if timeout > 0 {
+		t.timer = time.AfterFunc(timeout, func() {
+			t.mu.Lock()
+			fired := t.fired
+			t.fired = true
+			t.mu.Unlock()
+			if !fired {
+				f()
+			}
+		})
+	}
+	return t
+}
+
+// Activity restarts the timer.
+func (t *IdleTimer) Activity() {
+	if t.timer == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.fired {
+		t.timer.Reset(t.timeout)
+	}
+}
+
+// Stop stops the timer and reports whether it fired.
+func (t *IdleTimer) Stop() bool {
+	if t.timer == nil {
+		return false
+	}
+	t.timer.Stop()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.fired
+}
@@ -0,0 +1,108 @@
+package proxy
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionReader(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			MaxBytesUp:   5,
+			MaxBytesDown: -1,
+		},
+	}
+	var activity int
+	s := NewSessionReader(si, func() { activity++ })
+
+	var up, down bytes.Buffer
+	_, err := io.Copy(&up, s.Reader(DirectionUp, strings.NewReader("0123456789")))
+	require.ErrorIs(err, session.ErrBytesLimit)
+	assert.Equal("01234", up.String())
+
+	_, err = io.Copy(&down, s.Reader(DirectionDown, strings.NewReader("0123456789")))
+	require.NoError(err)
+	assert.Equal("0123456789", down.String())
+
+	gotUp, gotDown := s.Bytes()
+	assert.Equal(uint64(5), gotUp)
+	assert.Equal(uint64(10), gotDown)
+	assert.Equal(int64(5), si.BytesUp)
+	assert.Equal(int64(10), si.BytesDown)
+	assert.Positive(activity)
+
+	// Another reader of the session cannot read past the limit either.
+	_, err = s.Reader(DirectionUp, strings.NewReader("more")).Read(make([]byte, 4))
+	assert.ErrorIs(err, session.ErrBytesLimit)
+}
+
+func TestSessionReader_concurrentReaders(t *testing.T) {
+	t.Parallel()
+	assert := assert.New(t)
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			MaxBytesUp:   -1,
+			MaxBytesDown: 4,
+		},
+	}
+	s := NewSessionReader(si, nil)
+
+	// The first reader uses up the limit while the second one is reading,
+	// as the channels of an ssh connection may do.
+	r1 := s.Reader(DirectionDown, strings.NewReader("0123"))
+	var n1 int
+	var err1 error
+	r2 := s.Reader(DirectionDown, readerFunc(func(p []byte) (int, error) {
+		n1, err1 = r1.Read(make([]byte, 4))
+		return copy(p, "4567"), nil
+	}))
+	n2, err2 := r2.Read(make([]byte, 4))
+	assert.Equal(4, n1)
+	assert.NoError(err1)
+	assert.Equal(0, n2)
+	assert.ErrorIs(err2, session.ErrBytesLimit)
+	_, gotDown := s.Bytes()
+	assert.Equal(uint64(4), gotDown)
+	assert.Equal(int64(4), si.BytesDown)
+}
+
+func TestIdleTimer(t *testing.T) {
+	t.Parallel()
+	t.Run("no-timeout", func(t *testing.T) {
+		timer := NewIdleTimer(0, func() { t.Error("idle timer fired") })
+		timer.Activity()
+		assert.False(t, timer.Stop())
+	})
+	t.Run("active", func(t *testing.T) {
+		timer := NewIdleTimer(time.Second, func() { t.Error("idle timer fired") })
+		timer.Activity()
+		assert.False(t, timer.Stop())
+	})
+	t.Run("idle", func(t *testing.T) {
+		fired := make(chan struct{})
+		timer := NewIdleTimer(10*time.Millisecond, func() { close(fired) })
+		select {
+		case <-fired:
+		case <-time.After(5 * time.Second):
+			t.Fatal("idle timer did not fire")
+		}
+		timer.Activity()
+		assert.True(t, timer.Stop())
+	})
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) { return f(p) }
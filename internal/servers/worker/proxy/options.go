@@ -18,12 +18,14 @@ func GetOpts(opt ...Option) Options {
 
 // Options = how options are represented
 type Options struct {
-	WithEgressCredentials []*serverpb.Credential
+	WithEgressCredentials    []*serverpb.Credential
+	WithRecordingStoragePath string
 }
 
 func getDefaultOptions() Options {
 	return Options{
-		WithEgressCredentials: nil,
+		WithEgressCredentials:    nil,
+		WithRecordingStoragePath: "",
 	}
 }
 
@@ -33,3 +35,11 @@ func WithEgressCredentials(creds []*serverpb.Credential) Option {
 		o.WithEgressCredentials = creds
 	}
 }
+
+// WithRecordingStoragePath enables session recording for proxies that support
+// it, writing recordings into the provided directory
+func WithRecordingStoragePath(path string) Option {
+	return func(o *Options) {
+		o.WithRecordingStoragePath = path
+	}
+}
@@ -26,4 +26,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithEgressCredentials = []*serverpb.Credential{c}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithRecordingStoragePath", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithRecordingStoragePath("/var/lib/boundary/recordings"))
+		testOpts := getDefaultOptions()
+		assert.NotEqual(opts, testOpts)
+		testOpts.WithRecordingStoragePath = "/var/lib/boundary/recordings"
+		assert.Equal(opts, testOpts)
+	})
 }
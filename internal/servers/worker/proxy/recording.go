@@ -0,0 +1,190 @@
+package proxy
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RecordingVersion is the version of the recording file format written by a
+// Recorder.
+const RecordingVersion = 1
+
+// RecordingFileExtension is the extension used for all recording files.
+const RecordingFileExtension = ".rec"
+
+// Direction specifies which way data was flowing through the proxy when it was
+// recorded.
+type Direction string
+
+const (
+	// DirectionUp is data sent by the client to the endpoint.
+	DirectionUp Direction = "up"
+	// DirectionDown is data sent by the endpoint to the client.
+	DirectionDown Direction = "down"
+)
+
+// RecordingHeader is the first line written to every recording file. It tags
+// the recording with the session and connection it belongs to.
+type RecordingHeader struct {
+	Version      int       `json:"version"`
+	SessionId    string    `json:"session_id"`
+	ConnectionId string    `json:"connection_id"`
+	StartTime    time.Time `json:"start_time"`
+}
+
+// RecordingFrame is a single chunk of data read from one side of the proxy.
+// Offset is the time elapsed since the start of the recording.
+type RecordingFrame struct {
+	Offset    time.Duration `json:"offset"`
+	Direction Direction     `json:"direction"`
+	Data      []byte        `json:"data"`
+}
+
+// Recorder writes both directions of a proxied connection, with timing, to a
+// local file. The file is made up of newline delimited JSON: a RecordingHeader
+// followed by one RecordingFrame per chunk of data. A Recorder is safe for
+// concurrent use by the two copy loops of a proxy.
+type Recorder struct {
+	mu        sync.Mutex
+	file      *os.File
+	buf       *bufio.Writer
+	enc       *json.Encoder
+	hash      hash.Hash
+	size      uint64
+	path      string
+	startTime time.Time
+	closed    bool
+}
+
+// NewRecorder creates a recording file for the connection within a
+// subdirectory of storagePath named after the session.
+func NewRecorder(storagePath, sessionId, connectionId string) (*Recorder, error) {
+	switch {
+	case storagePath == "":
+		return nil, errors.New("missing recording storage path")
+	case sessionId == "":
+		return nil, errors.New("missing session id")
+	case connectionId == "":
+		return nil, errors.New("missing connection id")
+	}
+
+	path := RecordingPath(storagePath, sessionId, connectionId)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("error creating recording directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording file: %w", err)
+	}
+
+	r := &Recorder{
+		file:      f,
+		hash:      sha256.New(),
+		path:      path,
+		startTime: time.Now(),
+	}
+	r.buf = bufio.NewWriter(io.MultiWriter(f, r.hash, (*sizeCounter)(&r.size)))
+	r.enc = json.NewEncoder(r.buf)
+
+	hdr := RecordingHeader{
+		Version:      RecordingVersion,
+		SessionId:    sessionId,
+		ConnectionId: connectionId,
+		StartTime:    r.startTime.UTC(),
+	}
+	if err := r.enc.Encode(hdr); err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("error writing recording header: %w", err)
+	}
+	return r, nil
+}
+
+// RecordingPath returns the location within storagePath of the recording file
+// of the connection connectionId of the session sessionId.
+func RecordingPath(storagePath, sessionId, connectionId string) string {
+	return filepath.Join(storagePath, sessionId, connectionId+RecordingFileExtension)
+}
+
+// Path returns the location of the recording file.
+func (r *Recorder) Path() string {
+	return r.path
+}
+
+// Writer returns an io.Writer that records everything written to it as
+// flowing in the given direction. It is intended to be used with io.TeeReader
+// so that recording happens inline with proxying.
+func (r *Recorder) Writer(d Direction) io.Writer {
+	return &recordingWriter{r: r, direction: d}
+}
+
+func (r *Recorder) writeFrame(d Direction, p []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return errors.New("recording is closed")
+	}
+	return r.enc.Encode(RecordingFrame{
+		Offset:    time.Since(r.startTime),
+		Direction: d,
+		Data:      p,
+	})
+}
+
+// Close flushes and closes the recording file and returns the metadata
+// describing it, suitable for reporting to the controller.
+func (r *Recorder) Close() (*pbs.ConnectionRecording, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil, errors.New("recording already closed")
+	}
+	r.closed = true
+	endTime := time.Now()
+	if err := r.buf.Flush(); err != nil {
+		_ = r.file.Close()
+		return nil, fmt.Errorf("error flushing recording: %w", err)
+	}
+	if err := r.file.Close(); err != nil {
+		return nil, fmt.Errorf("error closing recording: %w", err)
+	}
+	return &pbs.ConnectionRecording{
+		StoragePath: r.path,
+		SizeBytes:   r.size,
+		Checksum:    hex.EncodeToString(r.hash.Sum(nil)),
+		StartTime:   timestamppb.New(r.startTime),
+		EndTime:     timestamppb.New(endTime),
+	}, nil
+}
+
+type recordingWriter struct {
+	r         *Recorder
+	direction Direction
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	if err := w.r.writeFrame(w.direction, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// sizeCounter counts the number of bytes written through it.
+type sizeCounter uint64
+
+func (c *sizeCounter) Write(p []byte) (int, error) {
+	*c += sizeCounter(len(p))
+	return len(p), nil
+}
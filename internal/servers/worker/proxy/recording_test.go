@@ -0,0 +1,142 @@
+package proxy
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecorder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		storagePath  string
+		sessionId    string
+		connectionId string
+		wantErr      string
+	}{
+		{
+			name:         "missing-storage-path",
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+			wantErr:      "missing recording storage path",
+		},
+		{
+			name:         "missing-session-id",
+			storagePath:  t.TempDir(),
+			connectionId: "sc_1234567890",
+			wantErr:      "missing session id",
+		},
+		{
+			name:        "missing-connection-id",
+			storagePath: t.TempDir(),
+			sessionId:   "s_1234567890",
+			wantErr:     "missing connection id",
+		},
+		{
+			name:         "valid",
+			storagePath:  t.TempDir(),
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			r, err := NewRecorder(tt.storagePath, tt.sessionId, tt.connectionId)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Nil(r)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(filepath.Join(tt.storagePath, tt.sessionId, tt.connectionId+RecordingFileExtension), r.Path())
+			_, err = r.Close()
+			require.NoError(err)
+
+			// A second recorder for the same connection must not overwrite
+			// the first recording.
+			_, err = NewRecorder(tt.storagePath, tt.sessionId, tt.connectionId)
+			require.Error(err)
+		})
+	}
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+
+	r, err := NewRecorder(t.TempDir(), "s_1234567890", "sc_1234567890")
+	require.NoError(err)
+
+	up, down := r.Writer(DirectionUp), r.Writer(DirectionDown)
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		n, err := up.Write([]byte("ping"))
+		assert.NoError(err)
+		assert.Equal(4, n)
+	}()
+	go func() {
+		defer wg.Done()
+		n, err := down.Write([]byte("pong\x00\xff"))
+		assert.NoError(err)
+		assert.Equal(6, n)
+	}()
+	wg.Wait()
+
+	got, err := r.Close()
+	require.NoError(err)
+	require.NotNil(got)
+	assert.Equal(r.Path(), got.GetStoragePath())
+	assert.False(got.GetStartTime().AsTime().After(got.GetEndTime().AsTime()))
+
+	_, err = r.Close()
+	assert.Error(err)
+	_, err = up.Write([]byte("late"))
+	assert.Error(err)
+
+	raw, err := os.ReadFile(r.Path())
+	require.NoError(err)
+	assert.Equal(uint64(len(raw)), got.GetSizeBytes())
+	sum := sha256.Sum256(raw)
+	assert.Equal(hex.EncodeToString(sum[:]), got.GetChecksum())
+
+	f, err := os.Open(r.Path())
+	require.NoError(err)
+	defer f.Close()
+	dec := json.NewDecoder(bufio.NewReader(f))
+
+	var hdr RecordingHeader
+	require.NoError(dec.Decode(&hdr))
+	assert.Equal(RecordingVersion, hdr.Version)
+	assert.Equal("s_1234567890", hdr.SessionId)
+	assert.Equal("sc_1234567890", hdr.ConnectionId)
+
+	frames := map[Direction][]byte{}
+	for {
+		var frame RecordingFrame
+		err := dec.Decode(&frame)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(err)
+		assert.GreaterOrEqual(int64(frame.Offset), int64(0))
+		frames[frame.Direction] = append(frames[frame.Direction], frame.Data...)
+	}
+	assert.Equal([]byte("ping"), frames[DirectionUp])
+	assert.Equal([]byte("pong\x00\xff"), frames[DirectionDown])
+}
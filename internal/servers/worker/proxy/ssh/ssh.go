@@ -0,0 +1,466 @@
+// Package ssh provides a worker proxy handler that terminates the client's SSH
+// connection at the worker and opens a second SSH connection to the endpoint,
+// authenticating with the session's egress credentials. The credentials are
+// never sent to the client.
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	xssh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"nhooyr.io/websocket"
+)
+
+func init() {
+	err := proxy.RegisterHandler("ssh", handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// defaultPort is used when the endpoint does not specify a port.
+const defaultPort = "22"
+
+// handleProxy accepts an SSH connection from the client over the incoming
+// websocket conn and proxies its channels and requests to an SSH connection it
+// creates with the remote endpoint. The connection to the endpoint is
+// authenticated with the credentials provided by proxy.WithEgressCredentials,
+// which must be provided. handleProxy sets the connectionId as connected in
+// the repository once the endpoint has accepted the credentials.
+//
+// The client is not asked to authenticate: it has already been authorized for
+// the session by the time handleProxy is called. The worker presents a host
+// key that is generated for each connection.
+//
+// The host key of the endpoint is verified with the known hosts of the
+// session's target. Passwords are never sent to an endpoint whose host key
+// cannot be verified, since whoever answers on the endpoint's address would
+// receive them.
+//
+// handleProxy blocks until either SSH connection is closed.
+//
+// The bytes limits and the connection idle timeout of the session are
+// enforced on the data of the channels, as the tcp proxy does on the whole
+// connection: the SSH transport and the global requests are not counted.
+// When a bytes limit is reached both SSH connections are closed, and every
+// other connection of the session is canceled.
+//
+// If proxy.WithRecordingStoragePath is provided, the data of every channel is
+// recorded in the clear, after the SSH transport has been removed, and the
+// recording metadata is stored on the connection's info so it can be reported
+// when the connection is closed.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
+	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint information: %w", err)
+	}
+	if sessionUrl.Scheme != "ssh" {
+		return fmt.Errorf("invalid scheme for ssh proxy: %v", sessionUrl.Scheme)
+	}
+	conf.SessionInfo.RLock()
+	knownHosts := conf.SessionInfo.LookupSessionResponse.GetEndpointKnownHosts()
+	conf.SessionInfo.RUnlock()
+	clientConfig, err := endpointClientConfig(opts.WithEgressCredentials, knownHosts)
+	if err != nil {
+		return err
+	}
+	serverConfig, err := clientServerConfig()
+	if err != nil {
+		return err
+	}
+
+	addr := sessionUrl.Host
+	if sessionUrl.Port() == "" {
+		addr = net.JoinHostPort(sessionUrl.Hostname(), defaultPort)
+	}
+	remoteConn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("error dialing endpoint: %w", err)
+	}
+	endpointConn, endpointChans, endpointReqs, err := xssh.NewClientConn(remoteConn, addr, clientConfig)
+	if err != nil {
+		_ = remoteConn.Close()
+		return fmt.Errorf("error establishing ssh connection with endpoint: %w", err)
+	}
+	defer endpointConn.Close()
+
+	endpointAddr := remoteConn.RemoteAddr().(*net.TCPAddr)
+	connectionInfo := &pbs.ConnectConnectionRequest{
+		ConnectionId:       conf.ConnectionId,
+		ClientTcpAddress:   conf.ClientAddress.IP.String(),
+		ClientTcpPort:      uint32(conf.ClientAddress.Port),
+		EndpointTcpAddress: endpointAddr.IP.String(),
+		EndpointTcpPort:    uint32(endpointAddr.Port),
+		Type:               "ssh",
+		UserClientIp:       conf.UserClientIp.String(),
+	}
+
+	connStatus, err := session.ConnectConnection(ctx, conf.SessionClient, connectionInfo)
+	if err != nil {
+		return fmt.Errorf("error marking connection as connected: %w", err)
+	}
+
+	// Update connection info to set connection status
+	conf.SessionInfo.Lock()
+	conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Status = connStatus
+	conf.SessionInfo.Unlock()
+
+	// Get a wrapped net.Conn so the ssh server can use it
+	netConn := websocket.NetConn(ctx, conf.ClientConn, websocket.MessageBinary)
+	clientConn, clientChans, clientReqs, err := xssh.NewServerConn(netConn, serverConfig)
+	if err != nil {
+		return fmt.Errorf("error establishing ssh connection with client: %w", err)
+	}
+	defer clientConn.Close()
+
+	conf.SessionInfo.RLock()
+	idleTimeout := time.Duration(conf.SessionInfo.LookupSessionResponse.GetConnectionIdleTimeoutSeconds()) * time.Second
+	conf.SessionInfo.RUnlock()
+	closeConns := func() {
+		_ = clientConn.Close()
+		_ = endpointConn.Close()
+	}
+	idle := proxy.NewIdleTimer(idleTimeout, closeConns)
+	p := &sshProxy{
+		counter:    proxy.NewSessionReader(conf.SessionInfo, idle.Activity),
+		closeConns: closeConns,
+	}
+	if opts.WithRecordingStoragePath != "" {
+		p.recorder, err = proxy.NewRecorder(opts.WithRecordingStoragePath, conf.SessionInfo.Id, conf.ConnectionId)
+		if err != nil {
+			return fmt.Errorf("error starting connection recording: %w", err)
+		}
+	}
+
+	p.wg.Add(4)
+	go p.forwardGlobalRequests(clientReqs, endpointConn)
+	go p.forwardGlobalRequests(endpointReqs, clientConn)
+	go p.forwardChannels(clientChans, endpointConn, proxy.DirectionUp)
+	go p.forwardChannels(endpointChans, clientConn, proxy.DirectionDown)
+
+	// When either side goes away, take the other one down with it.
+	connWg := new(sync.WaitGroup)
+	connWg.Add(2)
+	go func() {
+		defer connWg.Done()
+		_ = clientConn.Wait()
+		_ = endpointConn.Close()
+	}()
+	go func() {
+		defer connWg.Done()
+		_ = endpointConn.Wait()
+		_ = clientConn.Close()
+	}()
+	connWg.Wait()
+	p.wg.Wait()
+
+	idleTimedOut := idle.Stop()
+	var limitErr error
+	switch {
+	case p.reachedBytesLimit():
+		limitErr = session.ErrBytesLimit
+	case idleTimedOut:
+		limitErr = session.ErrIdleTimeout
+	}
+	bytesUp, bytesDown := p.counter.Bytes()
+	conf.SessionInfo.ConnectionFinished(conf.ConnectionId, bytesUp, bytesDown, limitErr)
+
+	if p.recorder != nil {
+		recording, err := p.recorder.Close()
+		if err != nil {
+			return fmt.Errorf("error finishing connection recording: %w", err)
+		}
+		conf.SessionInfo.Lock()
+		conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Recording = recording
+		conf.SessionInfo.Unlock()
+	}
+	return nil
+}
+
+// endpointClientConfig builds the configuration used to connect to the
+// endpoint from the session's egress credentials and the known hosts of the
+// endpoint. Certificates and private keys are offered before passwords. All of
+// the credentials must be for the same user. Passwords are refused when there
+// are no known hosts to verify the host key of the endpoint.
+func endpointClientConfig(creds []*pbs.Credential, knownHosts string) (*xssh.ClientConfig, error) {
+	if len(creds) == 0 {
+		return nil, errors.New("missing egress credentials for ssh proxy")
+	}
+	var username string
+	var signers []xssh.Signer
+	var passwords []xssh.AuthMethod
+	for _, c := range creds {
+		var user string
+		switch v := c.GetCredential().(type) {
+		case *pbs.Credential_UserPassword:
+			user = v.UserPassword.GetUsername()
+			passwords = append(passwords, xssh.Password(v.UserPassword.GetPassword()))
+		case *pbs.Credential_SshPrivateKey:
+			user = v.SshPrivateKey.GetUsername()
+			signer, err := xssh.ParsePrivateKey(v.SshPrivateKey.GetPrivateKey())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing egress private key: %w", err)
+			}
+			signers = append(signers, signer)
+		case *pbs.Credential_SshCertificate:
+			user = v.SshCertificate.GetUsername()
+			signer, err := xssh.ParsePrivateKey(v.SshCertificate.GetPrivateKey())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing egress private key: %w", err)
+			}
+			pub, _, _, _, err := xssh.ParseAuthorizedKey(v.SshCertificate.GetCertificate())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing egress certificate: %w", err)
+			}
+			cert, ok := pub.(*xssh.Certificate)
+			if !ok {
+				return nil, errors.New("egress certificate is not an ssh certificate")
+			}
+			certSigner, err := xssh.NewCertSigner(cert, signer)
+			if err != nil {
+				return nil, fmt.Errorf("error creating egress certificate signer: %w", err)
+			}
+			signers = append(signers, certSigner)
+		default:
+			return nil, fmt.Errorf("unsupported egress credential %T for ssh proxy", v)
+		}
+		switch {
+		case user == "":
+			return nil, errors.New("egress credential is missing a username")
+		case username == "":
+			username = user
+		case username != user:
+			return nil, errors.New("egress credentials for ssh proxy must all have the same username")
+		}
+	}
+
+	var auth []xssh.AuthMethod
+	if len(signers) > 0 {
+		auth = append(auth, xssh.PublicKeys(signers...))
+	}
+	auth = append(auth, passwords...)
+
+	// Without known hosts, the host key of the endpoint cannot be verified.
+	// Key and certificate signatures are bound to the ssh connection and are
+	// of no use to another host, but a password can be replayed.
+	hostKeyCallback := xssh.InsecureIgnoreHostKey()
+	switch {
+	case knownHosts != "":
+		var err error
+		if hostKeyCallback, err = knownHostsCallback(knownHosts); err != nil {
+			return nil, err
+		}
+	case len(passwords) > 0:
+		return nil, errors.New("refusing to send a password to an endpoint whose host key cannot be verified: the target has no known hosts")
+	}
+	return &xssh.ClientConfig{
+		User:            username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, nil
+}
+
+// knownHostsCallback returns a callback verifying the host key of the endpoint
+// with knownHosts, in the known_hosts format. The knownhosts package only
+// reads files, so knownHosts is written to a temporary file while it is
+// parsed.
+func knownHostsCallback(knownHosts string) (xssh.HostKeyCallback, error) {
+	f, err := os.CreateTemp("", "known_hosts")
+	if err != nil {
+		return nil, fmt.Errorf("error creating known hosts file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(knownHosts)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error writing known hosts file: %w", err)
+	}
+	cb, err := knownhosts.New(f.Name())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing known hosts: %w", err)
+	}
+	return cb, nil
+}
+
+// clientServerConfig builds the configuration used to accept the client's ssh
+// connection, with a newly generated host key.
+func clientServerConfig() (*xssh.ServerConfig, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating host key: %w", err)
+	}
+	signer, err := xssh.NewSignerFromKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("error creating host key signer: %w", err)
+	}
+	conf := &xssh.ServerConfig{
+		NoClientAuth: true,
+	}
+	conf.AddHostKey(signer)
+	return conf, nil
+}
+
+// sshProxy forwards channels and requests between the client's and the
+// endpoint's ssh connections.
+type sshProxy struct {
+	wg         sync.WaitGroup
+	recorder   *proxy.Recorder
+	counter    *proxy.SessionReader
+	closeConns func()
+
+	mu         sync.Mutex
+	bytesLimit bool
+}
+
+// forwardGlobalRequests sends every global request received on in to out and
+// replies with out's answer.
+func (p *sshProxy) forwardGlobalRequests(in <-chan *xssh.Request, out xssh.Conn) {
+	defer p.wg.Done()
+	for req := range in {
+		ok, payload, err := out.SendRequest(req.Type, req.WantReply, req.Payload)
+		if err != nil {
+			ok, payload = false, nil
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, payload)
+		}
+	}
+}
+
+// forwardChannels opens a matching channel on out for every new channel
+// received on in and pipes the two together. Data read from the channels in
+// was received on flows in direction d.
+func (p *sshProxy) forwardChannels(in <-chan xssh.NewChannel, out xssh.Conn, d proxy.Direction) {
+	defer p.wg.Done()
+	for newCh := range in {
+		outCh, outReqs, err := out.OpenChannel(newCh.ChannelType(), newCh.ExtraData())
+		if err != nil {
+			var openErr *xssh.OpenChannelError
+			if errors.As(err, &openErr) {
+				_ = newCh.Reject(openErr.Reason, openErr.Message)
+			} else {
+				_ = newCh.Reject(xssh.ConnectionFailed, err.Error())
+			}
+			continue
+		}
+		inCh, inReqs, err := newCh.Accept()
+		if err != nil {
+			_ = outCh.Close()
+			continue
+		}
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.pipe(inCh, inReqs, d, outCh, outReqs, opposite(d))
+		}()
+	}
+}
+
+// pipe copies data, extended data and requests between a and b until both
+// channels are closed. Each channel is closed once everything the other one
+// sent has been forwarded to it.
+func (p *sshProxy) pipe(a xssh.Channel, aReqs <-chan *xssh.Request, aDir proxy.Direction, b xssh.Channel, bReqs <-chan *xssh.Request, bDir proxy.Direction) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		p.forwardChannel(a, aReqs, aDir, b)
+	}()
+	go func() {
+		defer wg.Done()
+		p.forwardChannel(b, bReqs, bDir, a)
+	}()
+	wg.Wait()
+}
+
+// forwardChannel forwards everything received on src to dst, then closes
+// dst.
+func (p *sshProxy) forwardChannel(src xssh.Channel, srcReqs <-chan *xssh.Request, d proxy.Direction, dst xssh.Channel) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		// No data may be sent after EOF, so wait for both data streams to
+		// finish before sending it.
+		var dataWg sync.WaitGroup
+		dataWg.Add(2)
+		go func() {
+			defer dataWg.Done()
+			_, err := io.Copy(dst, p.tee(src, d))
+			p.copyDone(err)
+		}()
+		go func() {
+			defer dataWg.Done()
+			_, err := io.Copy(dst.Stderr(), p.tee(src.Stderr(), d))
+			p.copyDone(err)
+		}()
+		dataWg.Wait()
+		_ = dst.CloseWrite()
+	}()
+	go func() {
+		defer wg.Done()
+		for req := range srcReqs {
+			ok, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+			if err != nil {
+				ok = false
+			}
+			if req.WantReply {
+				_ = req.Reply(ok, nil)
+			}
+		}
+	}()
+	wg.Wait()
+	_ = dst.Close()
+}
+
+// tee counts everything read from r against the bytes limits of the session,
+// and records it when recording is enabled.
+func (p *sshProxy) tee(r io.Reader, d proxy.Direction) io.Reader {
+	r = p.counter.Reader(d, r)
+	if p.recorder == nil {
+		return r
+	}
+	return io.TeeReader(r, p.recorder.Writer(d))
+}
+
+// copyDone closes both ssh connections if a copy stopped because a bytes
+// limit of the session was reached.
+func (p *sshProxy) copyDone(err error) {
+	if !errors.Is(err, session.ErrBytesLimit) {
+		return
+	}
+	p.mu.Lock()
+	p.bytesLimit = true
+	p.mu.Unlock()
+	p.closeConns()
+}
+
+func (p *sshProxy) reachedBytesLimit() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.bytesLimit
+}
+
+func opposite(d proxy.Direction) proxy.Direction {
+	if d == proxy.DirectionUp {
+		return proxy.DirectionDown
+	}
+	return proxy.DirectionUp
+}
@@ -0,0 +1,612 @@
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	sess "github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xssh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"nhooyr.io/websocket"
+)
+
+// testEndpoint is an ssh server that accepts a single user, authenticated
+// with either a password, a public key or a certificate signed by ca, and
+// answers "exec" requests by writing the command back to the client.
+type testEndpoint struct {
+	addr     string
+	hostKey  xssh.PublicKey
+	user     string
+	password string
+	pubKey   xssh.PublicKey
+	ca       xssh.PublicKey
+	authMeth chan string
+}
+
+func newTestEndpoint(t *testing.T, user, password string, pubKey xssh.PublicKey) *testEndpoint {
+	t.Helper()
+	return startTestEndpoint(t, user, password, pubKey, nil)
+}
+
+// newTestEndpointWithHostCA starts a testEndpoint whose host key is certified
+// by hostCA.
+func newTestEndpointWithHostCA(t *testing.T, user, password string, hostCA xssh.Signer) *testEndpoint {
+	t.Helper()
+	return startTestEndpoint(t, user, password, nil, hostCA)
+}
+
+func startTestEndpoint(t *testing.T, user, password string, pubKey xssh.PublicKey, hostCA xssh.Signer) *testEndpoint {
+	t.Helper()
+	require := require.New(t)
+	e := &testEndpoint{
+		user:     user,
+		password: password,
+		pubKey:   pubKey,
+		authMeth: make(chan string, 10),
+	}
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(err)
+	hostKey, err := xssh.NewSignerFromKey(priv)
+	require.NoError(err)
+	e.hostKey = hostKey.PublicKey()
+	if hostCA != nil {
+		cert := &xssh.Certificate{
+			Key:         hostKey.PublicKey(),
+			CertType:    xssh.HostCert,
+			ValidBefore: xssh.CertTimeInfinity,
+		}
+		require.NoError(cert.SignCert(rand.Reader, hostCA))
+		hostKey, err = xssh.NewCertSigner(cert, hostKey)
+		require.NoError(err)
+	}
+	conf := &xssh.ServerConfig{
+		PasswordCallback: func(c xssh.ConnMetadata, pw []byte) (*xssh.Permissions, error) {
+			if c.User() == e.user && e.password != "" && string(pw) == e.password {
+				e.authMeth <- "password"
+				return nil, nil
+			}
+			return nil, fmt.Errorf("bad password")
+		},
+		PublicKeyCallback: func(c xssh.ConnMetadata, k xssh.PublicKey) (*xssh.Permissions, error) {
+			if _, ok := k.(*xssh.Certificate); ok && e.ca != nil {
+				checker := &xssh.CertChecker{
+					IsUserAuthority: func(auth xssh.PublicKey) bool {
+						return bytes.Equal(auth.Marshal(), e.ca.Marshal())
+					},
+				}
+				if _, err := checker.Authenticate(c, k); err != nil {
+					return nil, err
+				}
+				e.authMeth <- "certificate"
+				return nil, nil
+			}
+			if c.User() == e.user && e.pubKey != nil && bytes.Equal(k.Marshal(), e.pubKey.Marshal()) {
+				e.authMeth <- "publickey"
+				return nil, nil
+			}
+			return nil, fmt.Errorf("bad key")
+		},
+	}
+	conf.AddHostKey(hostKey)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() { l.Close() })
+	e.addr = l.Addr().String()
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go e.serve(c, conf)
+		}
+	}()
+	return e
+}
+
+func (e *testEndpoint) serve(c net.Conn, conf *xssh.ServerConfig) {
+	sc, chans, reqs, err := xssh.NewServerConn(c, conf)
+	if err != nil {
+		_ = c.Close()
+		return
+	}
+	defer sc.Close()
+	go xssh.DiscardRequests(reqs)
+	for newCh := range chans {
+		if newCh.ChannelType() != "session" {
+			_ = newCh.Reject(xssh.UnknownChannelType, "unsupported")
+			continue
+		}
+		ch, chReqs, err := newCh.Accept()
+		if err != nil {
+			continue
+		}
+		go func() {
+			defer ch.Close()
+			for req := range chReqs {
+				if req.Type != "exec" {
+					_ = req.Reply(false, nil)
+					continue
+				}
+				_ = req.Reply(true, nil)
+				cmdLen := binary.BigEndian.Uint32(req.Payload)
+				cmd := string(req.Payload[4 : 4+cmdLen])
+				_, _ = fmt.Fprintf(ch, "ran %s", cmd)
+				_, _ = fmt.Fprint(ch.Stderr(), "on stderr")
+				_ = ch.CloseWrite()
+				_, _ = ch.SendRequest("exit-status", false, xssh.Marshal(struct{ Status uint32 }{3}))
+				return
+			}
+		}()
+	}
+}
+
+// knownHosts returns the known_hosts line of the host key of e.
+func (e *testEndpoint) knownHosts() string {
+	return knownhosts.Line([]string{e.addr}, e.hostKey)
+}
+
+// testConfig returns the configuration of a connection to the endpoint e,
+// whose host key is known.
+func testConfig(t *testing.T, ctx context.Context, e *testEndpoint) (proxy.Config, *websocket.Conn) {
+	t.Helper()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(t, clientConn)
+	require.NotNil(t, proxyConn)
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId: "s_1234567890",
+			},
+			EndpointKnownHosts: e.knownHosts(),
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"sc_1234567890": {},
+		},
+	}
+	return proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: "ssh://" + e.addr,
+		SessionClient:  pbs.NewMockSessionServiceClient(),
+		SessionInfo:    si,
+		ConnectionId:   "sc_1234567890",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}, clientConn
+}
+
+// runExec dials the proxy as an ssh client that offers no credentials and
+// runs cmd.
+func runExec(t *testing.T, ctx context.Context, clientConn *websocket.Conn, cmd string) (stdout, stderr string, exitErr error) {
+	t.Helper()
+	require := require.New(t)
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	c, chans, reqs, err := xssh.NewClientConn(netConn, "worker", &xssh.ClientConfig{
+		User:            "not-used",
+		HostKeyCallback: xssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(err)
+	client := xssh.NewClient(c, chans, reqs)
+	defer client.Close()
+
+	sess, err := client.NewSession()
+	require.NoError(err)
+	defer sess.Close()
+	var outBuf, errBuf bytes.Buffer
+	sess.Stdout = &outBuf
+	sess.Stderr = &errBuf
+	exitErr = sess.Run(cmd)
+	return outBuf.String(), errBuf.String(), exitErr
+}
+
+func TestHandleProxy(t *testing.T) {
+	t.Parallel()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	privPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
+	signer, err := xssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+
+	// The certificate is signed for a different key than the one the
+	// endpoint accepts on its own.
+	_, certPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	certPkcs8, err := x509.MarshalPKCS8PrivateKey(certPriv)
+	require.NoError(t, err)
+	certPrivPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: certPkcs8})
+	certSigner, err := xssh.NewSignerFromKey(certPriv)
+	require.NoError(t, err)
+	_, caPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	caSigner, err := xssh.NewSignerFromKey(caPriv)
+	require.NoError(t, err)
+	cert := &xssh.Certificate{
+		Key:             certSigner.PublicKey(),
+		CertType:        xssh.UserCert,
+		ValidPrincipals: []string{"alice"},
+		ValidBefore:     xssh.CertTimeInfinity,
+	}
+	require.NoError(t, cert.SignCert(rand.Reader, caSigner))
+
+	tests := []struct {
+		name     string
+		creds    []*pbs.Credential
+		wantAuth string
+	}{
+		{
+			name: "user-password",
+			creds: []*pbs.Credential{
+				{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "secret"}}},
+			},
+			wantAuth: "password",
+		},
+		{
+			name: "ssh-private-key",
+			creds: []*pbs.Credential{
+				{Credential: &pbs.Credential_SshPrivateKey{SshPrivateKey: &pbs.SshPrivateKey{Username: "alice", PrivateKey: privPem}}},
+			},
+			wantAuth: "publickey",
+		},
+		{
+			name: "ssh-certificate",
+			creds: []*pbs.Credential{
+				{Credential: &pbs.Credential_SshCertificate{SshCertificate: &pbs.SshCertificate{
+					Username:    "alice",
+					PrivateKey:  certPrivPem,
+					Certificate: xssh.MarshalAuthorizedKey(cert),
+				}}},
+			},
+			wantAuth: "certificate",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require, assert := require.New(t), assert.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			e := newTestEndpoint(t, "alice", "secret", signer.PublicKey())
+			e.ca = caSigner.PublicKey()
+			conf, clientConn := testConfig(t, ctx, e)
+
+			done := make(chan error)
+			go func() {
+				done <- handleProxy(ctx, conf, proxy.WithEgressCredentials(tt.creds))
+			}()
+
+			stdout, stderr, err := runExec(t, ctx, clientConn, "whoami")
+			require.Error(err)
+			exitErr, ok := err.(*xssh.ExitError)
+			require.True(ok, "unexpected error %v", err)
+			assert.Equal(3, exitErr.ExitStatus())
+			assert.Equal("ran whoami", stdout)
+			assert.Equal("on stderr", stderr)
+			assert.Equal(tt.wantAuth, <-e.authMeth)
+
+			require.NoError(<-done)
+			conf.SessionInfo.RLock()
+			defer conf.SessionInfo.RUnlock()
+			assert.Equal(pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED, conf.SessionInfo.ConnInfoMap["sc_1234567890"].Status)
+		})
+	}
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := newTestEndpoint(t, "alice", "secret", nil)
+	conf, clientConn := testConfig(t, ctx, e)
+	creds := []*pbs.Credential{
+		{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "secret"}}},
+	}
+
+	recordingPath := t.TempDir()
+	done := make(chan error)
+	go func() {
+		done <- handleProxy(ctx, conf, proxy.WithEgressCredentials(creds), proxy.WithRecordingStoragePath(recordingPath))
+	}()
+
+	_, _, _ = runExec(t, ctx, clientConn, "uptime")
+	require.NoError(<-done)
+
+	conf.SessionInfo.RLock()
+	recording := conf.SessionInfo.ConnInfoMap["sc_1234567890"].Recording
+	conf.SessionInfo.RUnlock()
+	require.NotNil(recording)
+
+	f, err := os.Open(recording.GetStoragePath())
+	require.NoError(err)
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	var hdr proxy.RecordingHeader
+	require.NoError(dec.Decode(&hdr))
+	var down strings.Builder
+	for dec.More() {
+		var frame proxy.RecordingFrame
+		require.NoError(dec.Decode(&frame))
+		if frame.Direction == proxy.DirectionDown {
+			down.Write(frame.Data)
+		}
+	}
+	assert.Contains(down.String(), "ran uptime")
+	assert.NotContains(down.String(), "secret")
+}
+
+func TestHandleProxy_BytesLimit(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := newTestEndpoint(t, "alice", "secret", nil)
+	conf, clientConn := testConfig(t, ctx, e)
+	var otherCanceled bool
+	conf.SessionInfo.LookupSessionResponse.MaxBytesUp = -1
+	conf.SessionInfo.LookupSessionResponse.MaxBytesDown = 4
+	conf.SessionInfo.ConnInfoMap["sc_0987654321"] = &session.ConnInfo{ConnCancel: func() { otherCanceled = true }}
+	creds := []*pbs.Credential{
+		{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "secret"}}},
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- handleProxy(ctx, conf, proxy.WithEgressCredentials(creds))
+	}()
+
+	stdout, stderr, _ := runExec(t, ctx, clientConn, "whoami")
+	assert.LessOrEqual(len(stdout+stderr), 4)
+	require.NoError(<-done)
+
+	conf.SessionInfo.RLock()
+	defer conf.SessionInfo.RUnlock()
+	ci := conf.SessionInfo.ConnInfoMap["sc_1234567890"]
+	assert.Equal(uint64(4), ci.BytesDown)
+	assert.Equal(sess.ConnectionBytesLimit, ci.CloseReason)
+	assert.Equal(sess.ConnectionBytesLimit, conf.SessionInfo.ConnInfoMap["sc_0987654321"].CloseReason)
+	assert.True(otherCanceled)
+}
+
+func TestHandleProxy_IdleTimeout(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	e := newTestEndpoint(t, "alice", "secret", nil)
+	conf, clientConn := testConfig(t, ctx, e)
+	conf.SessionInfo.LookupSessionResponse.ConnectionIdleTimeoutSeconds = 1
+	creds := []*pbs.Credential{
+		{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "secret"}}},
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- handleProxy(ctx, conf, proxy.WithEgressCredentials(creds))
+	}()
+
+	// The client connects but doesn't open any channel, so the proxy closes
+	// the connection once the idle timeout is reached.
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+	c, _, _, err := xssh.NewClientConn(netConn, "worker", &xssh.ClientConfig{
+		User:            "not-used",
+		HostKeyCallback: xssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(err)
+	defer c.Close()
+
+	select {
+	case err := <-done:
+		require.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("connection was not closed after the idle timeout")
+	}
+
+	conf.SessionInfo.RLock()
+	defer conf.SessionInfo.RUnlock()
+	assert.Equal(sess.ConnectionIdleTimeout, conf.SessionInfo.ConnInfoMap["sc_1234567890"].CloseReason)
+}
+
+func TestHandleProxy_HostKey(t *testing.T) {
+	t.Parallel()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	privPem := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})
+	signer, err := xssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := xssh.NewSignerFromKey(otherPriv)
+	require.NoError(t, err)
+	_, caPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostCA, err := xssh.NewSignerFromKey(caPriv)
+	require.NoError(t, err)
+
+	passwordCreds := []*pbs.Credential{
+		{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "secret"}}},
+	}
+	keyCreds := []*pbs.Credential{
+		{Credential: &pbs.Credential_SshPrivateKey{SshPrivateKey: &pbs.SshPrivateKey{Username: "alice", PrivateKey: privPem}}},
+	}
+
+	tests := []struct {
+		name        string
+		hostCA      bool
+		knownHosts  func(e *testEndpoint) string
+		creds       []*pbs.Credential
+		wantAuth    string
+		errContains string
+	}{
+		{
+			name:        "password-without-known-hosts",
+			knownHosts:  func(*testEndpoint) string { return "" },
+			creds:       passwordCreds,
+			errContains: "refusing to send a password",
+		},
+		{
+			name:       "key-without-known-hosts",
+			knownHosts: func(*testEndpoint) string { return "" },
+			creds:      keyCreds,
+			wantAuth:   "publickey",
+		},
+		{
+			name: "unknown-host-key",
+			knownHosts: func(e *testEndpoint) string {
+				return knownhosts.Line([]string{e.addr}, otherKey.PublicKey())
+			},
+			creds:       passwordCreds,
+			errContains: "knownhosts: key mismatch",
+		},
+		{
+			name: "other-host",
+			knownHosts: func(e *testEndpoint) string {
+				return knownhosts.Line([]string{"other.example.com"}, e.hostKey)
+			},
+			creds:       passwordCreds,
+			errContains: "knownhosts: key is unknown",
+		},
+		{
+			name:   "host-certificate",
+			hostCA: true,
+			knownHosts: func(e *testEndpoint) string {
+				return "@cert-authority " + knownhosts.Normalize(e.addr) + " " + string(xssh.MarshalAuthorizedKey(hostCA.PublicKey()))
+			},
+			creds:    passwordCreds,
+			wantAuth: "password",
+		},
+		{
+			name:        "malformed-known-hosts",
+			knownHosts:  func(*testEndpoint) string { return "not a host key" },
+			creds:       passwordCreds,
+			errContains: "error parsing known hosts",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require, assert := require.New(t), assert.New(t)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var e *testEndpoint
+			if tt.hostCA {
+				e = newTestEndpointWithHostCA(t, "alice", "secret", hostCA)
+			} else {
+				e = newTestEndpoint(t, "alice", "secret", signer.PublicKey())
+			}
+			conf, clientConn := testConfig(t, ctx, e)
+			conf.SessionInfo.LookupSessionResponse.EndpointKnownHosts = tt.knownHosts(e)
+
+			done := make(chan error)
+			go func() {
+				done <- handleProxy(ctx, conf, proxy.WithEgressCredentials(tt.creds))
+			}()
+			if tt.wantAuth == "" {
+				err := <-done
+				require.Error(err)
+				assert.Contains(err.Error(), tt.errContains)
+				assert.Empty(e.authMeth)
+				return
+			}
+
+			_, _, _ = runExec(t, ctx, clientConn, "whoami")
+			require.NoError(<-done)
+			assert.Equal(tt.wantAuth, <-e.authMeth)
+		})
+	}
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	e := newTestEndpoint(t, "alice", "secret", nil)
+
+	tests := []struct {
+		name        string
+		endpoint    string
+		creds       []*pbs.Credential
+		errContains string
+	}{
+		{
+			name:        "wrong-scheme",
+			endpoint:    "tcp://" + e.addr,
+			errContains: "invalid scheme",
+		},
+		{
+			name:        "no-credentials",
+			endpoint:    "ssh://" + e.addr,
+			errContains: "missing egress credentials",
+		},
+		{
+			name:     "mismatched-usernames",
+			endpoint: "ssh://" + e.addr,
+			creds: []*pbs.Credential{
+				{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "secret"}}},
+				{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "bob", Password: "secret"}}},
+			},
+			errContains: "same username",
+		},
+		{
+			name:     "bad-password",
+			endpoint: "ssh://" + e.addr,
+			creds: []*pbs.Credential{
+				{Credential: &pbs.Credential_UserPassword{UserPassword: &pbs.UserPassword{Username: "alice", Password: "wrong"}}},
+			},
+			errContains: "error establishing ssh connection with endpoint",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			conf := proxy.Config{
+				ClientAddress:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 50000},
+				RemoteEndpoint: tt.endpoint,
+				SessionClient:  pbs.NewMockSessionServiceClient(),
+				SessionInfo: &session.Info{
+					LookupSessionResponse: &pbs.LookupSessionResponse{EndpointKnownHosts: e.knownHosts()},
+					ConnInfoMap:           map[string]*session.ConnInfo{"sc_1234567890": {}},
+				},
+				ConnectionId: "sc_1234567890",
+			}
+			err := handleProxy(ctx, conf, proxy.WithEgressCredentials(tt.creds))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
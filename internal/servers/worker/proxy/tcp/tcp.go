@@ -2,11 +2,13 @@ package tcp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/url"
 	"sync"
+	"time"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
@@ -28,8 +30,16 @@ func init() {
 // handleProxy blocks until an error (EOF on happy path) is received on either
 // connection.
 //
-// All options are ignored.
-func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) error {
+// If proxy.WithRecordingStoragePath is provided, both directions of the
+// connection are recorded and the recording metadata is stored on the
+// connection's info so it can be reported when the connection is closed. All
+// other options are ignored.
+//
+// The bytes limits and the connection idle timeout of the session are
+// enforced on the connection. When a bytes limit is reached every connection
+// of the session is closed.
+func handleProxy(ctx context.Context, conf proxy.Config, opt ...proxy.Option) error {
+	opts := proxy.GetOpts(opt...)
 	conn := conf.ClientConn
 	sessionUrl, err := url.Parse(conf.RemoteEndpoint)
 	if err != nil {
@@ -69,20 +79,67 @@ func handleProxy(ctx context.Context, conf proxy.Config, _ ...proxy.Option) erro
 	// Get a wrapped net.Conn so we can use io.Copy
 	netConn := websocket.NetConn(ctx, conn, websocket.MessageBinary)
 
+	var downSrc, upSrc io.Reader = tcpRemoteConn, netConn
+	var recorder *proxy.Recorder
+	if opts.WithRecordingStoragePath != "" {
+		recorder, err = proxy.NewRecorder(opts.WithRecordingStoragePath, conf.SessionInfo.Id, conf.ConnectionId)
+		if err != nil {
+			_ = tcpRemoteConn.Close()
+			return fmt.Errorf("error starting connection recording: %w", err)
+		}
+		// Teeing the readers loses the splice optimization, which is an
+		// acceptable cost when recording was asked for.
+		downSrc = io.TeeReader(tcpRemoteConn, recorder.Writer(proxy.DirectionDown))
+		upSrc = io.TeeReader(netConn, recorder.Writer(proxy.DirectionUp))
+	}
+
+	conf.SessionInfo.RLock()
+	idleTimeout := time.Duration(conf.SessionInfo.LookupSessionResponse.GetConnectionIdleTimeoutSeconds()) * time.Second
+	conf.SessionInfo.RUnlock()
+	idle := proxy.NewIdleTimer(idleTimeout, func() {
+		_ = netConn.Close()
+		_ = tcpRemoteConn.Close()
+	})
+	counter := proxy.NewSessionReader(conf.SessionInfo, idle.Activity)
+	downSrc = counter.Reader(proxy.DirectionDown, downSrc)
+	upSrc = counter.Reader(proxy.DirectionUp, upSrc)
+
+	var downErr, upErr error
 	connWg := new(sync.WaitGroup)
 	connWg.Add(2)
 	go func() {
 		defer connWg.Done()
-		_, _ = io.Copy(netConn, tcpRemoteConn)
+		_, downErr = io.Copy(netConn, downSrc)
 		_ = netConn.Close()
 		_ = tcpRemoteConn.Close()
 	}()
 	go func() {
 		defer connWg.Done()
-		_, _ = io.Copy(tcpRemoteConn, netConn)
+		_, upErr = io.Copy(tcpRemoteConn, upSrc)
 		_ = tcpRemoteConn.Close()
 		_ = netConn.Close()
 	}()
 	connWg.Wait()
+
+	idleTimedOut := idle.Stop()
+	var limitErr error
+	switch {
+	case errors.Is(downErr, session.ErrBytesLimit), errors.Is(upErr, session.ErrBytesLimit):
+		limitErr = session.ErrBytesLimit
+	case idleTimedOut:
+		limitErr = session.ErrIdleTimeout
+	}
+	bytesUp, bytesDown := counter.Bytes()
+	conf.SessionInfo.ConnectionFinished(conf.ConnectionId, bytesUp, bytesDown, limitErr)
+
+	if recorder != nil {
+		recording, err := recorder.Close()
+		if err != nil {
+			return fmt.Errorf("error finishing connection recording: %w", err)
+		}
+		conf.SessionInfo.Lock()
+		conf.SessionInfo.ConnInfoMap[conf.ConnectionId].Recording = recording
+		conf.SessionInfo.Unlock()
+	}
 	return nil
 }
@@ -2,13 +2,19 @@ package tcp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/servers/worker/proxy"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	sess "github.com/hashicorp/boundary/internal/session"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/testutil"
 	"github.com/stretchr/testify/assert"
@@ -102,3 +108,246 @@ func TestHandleTcpProxyV1(t *testing.T) {
 
 	cancelCtx()
 }
+
+func TestHandleTcpProxyV1_Recording(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	port := testutil.TestFreePort(t)
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	require.NoError(err)
+	defer l.Close()
+
+	endpointConnCh := make(chan net.Conn)
+	go func() {
+		c, err := l.Accept()
+		assert.NoError(err)
+		endpointConnCh <- c
+	}()
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId: "s_1234567890",
+			},
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"sc_1234567890": {},
+		},
+	}
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("tcp://localhost:%d", port),
+		SessionClient:  sessClient,
+		SessionInfo:    si,
+		ConnectionId:   "sc_1234567890",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+
+	recordingPath := t.TempDir()
+	done := make(chan error)
+	go func() {
+		done <- handleProxy(ctx, conf, proxy.WithRecordingStoragePath(recordingPath))
+	}()
+
+	endpointConn := <-endpointConnCh
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+
+	_, err = endpointConn.Write([]byte("down"))
+	require.NoError(err)
+	b := make([]byte, 4)
+	_, err = io.ReadFull(netConn, b)
+	require.NoError(err)
+	assert.Equal("down", string(b))
+
+	_, err = netConn.Write([]byte("up"))
+	require.NoError(err)
+	b = make([]byte, 2)
+	_, err = io.ReadFull(endpointConn, b)
+	require.NoError(err)
+	assert.Equal("up", string(b))
+
+	require.NoError(endpointConn.Close())
+	require.NoError(<-done)
+
+	si.RLock()
+	recording := si.ConnInfoMap["sc_1234567890"].Recording
+	si.RUnlock()
+	require.NotNil(recording)
+	assert.Equal(filepath.Join(recordingPath, "s_1234567890", "sc_1234567890"+proxy.RecordingFileExtension), recording.GetStoragePath())
+
+	f, err := os.Open(recording.GetStoragePath())
+	require.NoError(err)
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	var hdr proxy.RecordingHeader
+	require.NoError(dec.Decode(&hdr))
+	assert.Equal("s_1234567890", hdr.SessionId)
+	assert.Equal("sc_1234567890", hdr.ConnectionId)
+	got := map[proxy.Direction]string{}
+	for dec.More() {
+		var frame proxy.RecordingFrame
+		require.NoError(dec.Decode(&frame))
+		got[frame.Direction] += string(frame.Data)
+	}
+	assert.Equal("up", got[proxy.DirectionUp])
+	assert.Equal("down", got[proxy.DirectionDown])
+}
+
+func TestHandleTcpProxyV1_BytesLimit(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	port := testutil.TestFreePort(t)
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	require.NoError(err)
+	defer l.Close()
+
+	endpointConnCh := make(chan net.Conn)
+	go func() {
+		c, err := l.Accept()
+		assert.NoError(err)
+		endpointConnCh <- c
+	}()
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	var otherCanceled bool
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId: "s_1234567890",
+			},
+			MaxBytesUp:   -1,
+			MaxBytesDown: 4,
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"sc_1234567890": {},
+			"sc_0987654321": {ConnCancel: func() { otherCanceled = true }},
+		},
+	}
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("tcp://localhost:%d", port),
+		SessionClient:  sessClient,
+		SessionInfo:    si,
+		ConnectionId:   "sc_1234567890",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- handleProxy(ctx, conf)
+	}()
+
+	endpointConn := <-endpointConnCh
+	defer endpointConn.Close()
+	netConn := websocket.NetConn(ctx, clientConn, websocket.MessageBinary)
+
+	_, err = endpointConn.Write([]byte("down and more"))
+	require.NoError(err)
+	b, err := io.ReadAll(netConn)
+	require.NoError(err)
+	assert.Equal("down", string(b))
+	require.NoError(<-done)
+
+	si.RLock()
+	defer si.RUnlock()
+	ci := si.ConnInfoMap["sc_1234567890"]
+	assert.Equal(uint64(4), ci.BytesDown)
+	assert.Equal(sess.ConnectionBytesLimit, ci.CloseReason)
+	assert.Equal(sess.ConnectionBytesLimit, si.ConnInfoMap["sc_0987654321"].CloseReason)
+	assert.True(otherCanceled)
+}
+
+func TestHandleTcpProxyV1_IdleTimeout(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	defer cancelCtx()
+	clientConn, proxyConn := proxy.TestWsConn(t, ctx)
+	require.NotNil(clientConn)
+	require.NotNil(proxyConn)
+
+	port := testutil.TestFreePort(t)
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	require.NoError(err)
+	defer l.Close()
+
+	endpointConnCh := make(chan net.Conn)
+	go func() {
+		c, err := l.Accept()
+		assert.NoError(err)
+		endpointConnCh <- c
+	}()
+
+	sessClient := pbs.NewMockSessionServiceClient()
+	si := &session.Info{
+		Id: "s_1234567890",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			Authorization: &targets.SessionAuthorizationData{
+				SessionId: "s_1234567890",
+			},
+			ConnectionIdleTimeoutSeconds: 1,
+		},
+		ConnInfoMap: map[string]*session.ConnInfo{
+			"sc_1234567890": {},
+		},
+	}
+	conf := proxy.Config{
+		ClientAddress: &net.TCPAddr{
+			IP:   net.ParseIP("127.0.0.1"),
+			Port: 50000,
+		},
+		ClientConn:     proxyConn,
+		RemoteEndpoint: fmt.Sprintf("tcp://localhost:%d", port),
+		SessionClient:  sessClient,
+		SessionInfo:    si,
+		ConnectionId:   "sc_1234567890",
+		UserClientIp:   net.ParseIP("127.0.0.1"),
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- handleProxy(ctx, conf)
+	}()
+
+	endpointConn := <-endpointConnCh
+	defer endpointConn.Close()
+
+	// Nothing is sent on the connection, so the proxy closes it once the idle
+	// timeout is reached.
+	select {
+	case err := <-done:
+		require.NoError(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("connection was not closed after the idle timeout")
+	}
+
+	si.RLock()
+	defer si.RUnlock()
+	assert.Equal(sess.ConnectionIdleTimeout, si.ConnInfoMap["sc_1234567890"].CloseReason)
+}
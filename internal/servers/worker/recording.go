@@ -0,0 +1,173 @@
+package worker
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/servers/common"
+	proxyHandlers "github.com/hashicorp/boundary/internal/servers/worker/proxy"
+)
+
+// serveRecordingConn serves with h the requests sent over conn, a connection to
+// the proxy listener negotiating the worker recording proto. It is registered
+// as the TLSNextProto function of that proto, as net/http otherwise drops the
+// connections negotiating protos it does not know.
+func serveRecordingConn(s *http.Server, conn *tls.Conn, h http.Handler) {
+	srv := &http.Server{
+		Handler:           h,
+		ReadHeaderTimeout: s.ReadHeaderTimeout,
+		ReadTimeout:       s.ReadTimeout,
+		WriteTimeout:      s.WriteTimeout,
+		IdleTimeout:       s.IdleTimeout,
+		ErrorLog:          s.ErrorLog,
+	}
+	// Serve returns once the connection is closed.
+	_ = srv.Serve(newConnListener(conn))
+}
+
+// connListener is a net.Listener accepting a single connection, which it
+// hides the TLS state of so that it is served as plain HTTP/1.1.
+type connListener struct {
+	conn       net.Conn
+	acceptOnce sync.Once
+	closeOnce  sync.Once
+	closed     chan struct{}
+}
+
+func newConnListener(conn net.Conn) *connListener {
+	return &connListener{conn: conn, closed: make(chan struct{})}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	var conn net.Conn
+	l.acceptOnce.Do(func() {
+		conn = &listenedConn{Conn: l.conn, l: l}
+	})
+	if conn != nil {
+		return conn, nil
+	}
+	<-l.closed
+	return nil, net.ErrClosed
+}
+
+func (l *connListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
+
+// listenedConn closes its listener when it is closed.
+type listenedConn struct {
+	net.Conn
+	l *connListener
+}
+
+func (c *listenedConn) Close() error {
+	err := c.Conn.Close()
+	_ = c.l.Close()
+	return err
+}
+
+// handleRecording serves the recordings of the connections proxied by this
+// worker to controllers, or forwards the request to the downstream worker
+// holding the recording. Only requests made over a worker auth connection
+// negotiating the worker recording proto are accepted.
+func (w *Worker) handleRecording() http.HandlerFunc {
+	const op = "worker.(Worker).handleRecording"
+	return func(wr http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if r.TLS == nil || r.TLS.NegotiatedProtocol != base.WorkerRecordingProto {
+			wr.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.Method != http.MethodGet {
+			wr.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		sessionId := r.URL.Query().Get("session_id")
+		connectionId := r.URL.Query().Get("connection_id")
+		if !validRecordingId(sessionId) || !validRecordingId(connectionId) {
+			wr.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var rc io.ReadCloser
+		switch hops := r.Header.Get(common.RecordingHopsHeader); hops {
+		case "":
+			storagePath := w.conf.RawConfig.Worker.RecordingStoragePath
+			if storagePath == "" {
+				wr.WriteHeader(http.StatusNotFound)
+				return
+			}
+			f, err := os.Open(proxyHandlers.RecordingPath(storagePath, sessionId, connectionId))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					wr.WriteHeader(http.StatusNotFound)
+					return
+				}
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error opening recording", "session_id", sessionId, "connection_id", connectionId))
+				wr.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if fi, err := f.Stat(); err == nil {
+				wr.Header().Set("Content-Length", strconv.FormatInt(fi.Size(), 10))
+			}
+			rc = f
+		default:
+			tlsConf, _, err := w.workerAuthTLSConfig()
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error creating tls config for worker auth"))
+				wr.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			tlsConf.NextProtos = append(tlsConf.NextProtos, base.WorkerRecordingProto)
+			rc, err = common.FetchRecording(ctx, tlsConf, strings.Split(hops, ","), sessionId, connectionId)
+			if err != nil {
+				if errors.Is(err, common.ErrRecordingNotFound) {
+					wr.WriteHeader(http.StatusNotFound)
+					return
+				}
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error forwarding recording request to downstream worker", "session_id", sessionId, "connection_id", connectionId))
+				wr.WriteHeader(http.StatusBadGateway)
+				return
+			}
+		}
+		defer rc.Close()
+
+		wr.Header().Set("Content-Type", "application/octet-stream")
+		wr.WriteHeader(http.StatusOK)
+		if _, err := io.Copy(wr, rc); err != nil {
+			event.WriteError(ctx, op, fmt.Errorf("error sending recording: %w", err), event.WithInfo("session_id", sessionId, "connection_id", connectionId))
+		}
+	}
+}
+
+// validRecordingId reports whether id can be used to locate a recording within
+// the recording storage path, that is whether it is made of the characters of
+// the public IDs only.
+func validRecordingId(id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/libs/alpnmux"
+	"github.com/hashicorp/boundary/internal/servers/common"
+	proxyHandlers "github.com/hashicorp/boundary/internal/servers/worker/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleRecording(t *testing.T) {
+	t.Parallel()
+	storagePath := t.TempDir()
+	content := []byte(`{"version":1}` + "\n")
+	path := proxyHandlers.RecordingPath(storagePath, "s_1234567890", "sc_1234567890")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	w := &Worker{conf: &Config{RawConfig: &config.Config{Worker: &config.Worker{RecordingStoragePath: storagePath}}}}
+
+	tests := []struct {
+		name         string
+		proto        string
+		sessionId    string
+		connectionId string
+		wantStatus   int
+		wantContent  []byte
+	}{
+		{
+			name:         "valid",
+			proto:        base.WorkerRecordingProto,
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+			wantStatus:   http.StatusOK,
+			wantContent:  content,
+		},
+		{
+			name:         "session-proto",
+			proto:        "",
+			sessionId:    "s_1234567890",
+			connectionId: "sc_1234567890",
+			wantStatus:   http.StatusForbidden,
+		},
+		{
+			name:         "not-found",
+			proto:        base.WorkerRecordingProto,
+			sessionId:    "s_1234567890",
+			connectionId: "sc_0987654321",
+			wantStatus:   http.StatusNotFound,
+		},
+		{
+			name:         "path-traversal",
+			proto:        base.WorkerRecordingProto,
+			sessionId:    "..",
+			connectionId: "sc_1234567890",
+			wantStatus:   http.StatusBadRequest,
+		},
+		{
+			name:       "missing-connection-id",
+			proto:      base.WorkerRecordingProto,
+			sessionId:  "s_1234567890",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			q := url.Values{"session_id": []string{tt.sessionId}, "connection_id": []string{tt.connectionId}}
+			r := httptest.NewRequest(http.MethodGet, "/v1/recording?"+q.Encode(), nil)
+			r.TLS = &tls.ConnectionState{NegotiatedProtocol: tt.proto}
+			rw := httptest.NewRecorder()
+			w.handleRecording().ServeHTTP(rw, r)
+			assert.Equal(tt.wantStatus, rw.Code)
+			if tt.wantContent != nil {
+				assert.Equal(tt.wantContent, rw.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestHandleRecording_forwarded(t *testing.T) {
+	t.Parallel()
+	require, assert := require.New(t), assert.New(t)
+	ctx := context.Background()
+	wrapper := db.TestWrapper(t)
+
+	// serve starts the proxy listener of a worker storing its recordings in
+	// storagePath and returns its address.
+	serve := func(name, storagePath string) string {
+		w := &Worker{
+			baseContext: ctx,
+			relayNonces: new(sync.Map),
+			conf: &Config{
+				Server: &base.Server{WorkerAuthKms: wrapper, SecureRandomReader: rand.Reader},
+				RawConfig: &config.Config{
+					Worker: &config.Worker{Name: name, RecordingStoragePath: storagePath},
+				},
+			},
+		}
+		baseLn, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(err)
+		mux := alpnmux.New(baseLn)
+		t.Cleanup(func() { mux.Close() })
+		l, err := mux.RegisterProto(alpnmux.DefaultProto, &tls.Config{GetConfigForClient: w.getListenerTls})
+		require.NoError(err)
+		srv := &http.Server{
+			Handler: w.handleRecording(),
+			TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){
+				base.WorkerRecordingProto: serveRecordingConn,
+			},
+		}
+		go srv.Serve(&relayListener{Listener: l, w: w})
+		t.Cleanup(func() { srv.Close() })
+		return baseLn.Addr().String()
+	}
+
+	storagePath := t.TempDir()
+	content := []byte(`{"version":1}` + "\n")
+	path := proxyHandlers.RecordingPath(storagePath, "s_1234567890", "sc_1234567890")
+	require.NoError(os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(os.WriteFile(path, content, 0o600))
+	addrs := []string{serve("upstream", ""), serve("downstream", storagePath)}
+
+	fetch := func(connectionId string) (io.ReadCloser, error) {
+		info := &base.WorkerAuthInfo{Name: "controller", ConnectionNonce: "abcdefghijklmnopqrst"}
+		tlsConf, err := info.ClientTLSConfig(ctx, wrapper, rand.Reader)
+		require.NoError(err)
+		tlsConf.NextProtos = append(tlsConf.NextProtos, base.WorkerRecordingProto)
+		return common.FetchRecording(ctx, tlsConf, addrs, "s_1234567890", connectionId)
+	}
+
+	rc, err := fetch("sc_1234567890")
+	require.NoError(err)
+	got, err := io.ReadAll(rc)
+	require.NoError(err)
+	require.NoError(rc.Close())
+	assert.Equal(content, got)
+
+	// The connection nonce was used by the previous request.
+	_, err = fetch("sc_1234567890")
+	assert.Error(err)
+}
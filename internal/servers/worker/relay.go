@@ -0,0 +1,318 @@
+package worker
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/servers/worker/session"
+	"github.com/hashicorp/go-cleanhttp"
+	"nhooyr.io/websocket"
+)
+
+// Workers configured with upstreams connect to the controllers through the
+// proxy listener of one of their upstream workers. The downstream worker opens
+// a TLS connection authenticated the same way as its connections to the
+// controllers, sends the connection nonce and reads back the name of the
+// upstream worker. From then on the upstream worker relays the raw connection
+// to a controller (or to its own upstream), over which the downstream worker
+// establishes its usual authenticated connection to the controller.
+
+// maxRelayNameLength is the maximum length of the worker name sent back by an
+// upstream worker when a relay is established.
+const maxRelayNameLength = 1024
+
+// relayListener wraps the proxy listener of the worker. It hands off
+// connections of downstream workers to handleRelay and returns all others.
+type relayListener struct {
+	net.Listener
+	w *Worker
+}
+
+func (l *relayListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if tlsConn, ok := conn.(*tls.Conn); ok && strings.HasPrefix(tlsConn.ConnectionState().NegotiatedProtocol, base.WorkerAuthProtoPrefix) {
+			go l.w.handleRelay(tlsConn)
+			continue
+		}
+		return conn, nil
+	}
+}
+
+// getListenerTls returns the TLS configuration for a connection to the proxy
+// listener: the worker auth configuration for downstream workers and for
+// recording requests, and the session configuration for clients.
+func (w *Worker) getListenerTls(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+	const op = "worker.(Worker).getListenerTls"
+	for _, p := range hello.SupportedProtos {
+		if !strings.HasPrefix(p, base.WorkerAuthProtoPrefix) {
+			continue
+		}
+		info, firstMatchProto, err := base.DecodeWorkerAuthInfo(w.baseContext, w.conf.WorkerAuthKms, hello.SupportedProtos)
+		if err != nil {
+			event.WriteError(w.baseContext, op, err, event.WithInfoMsg("error decoding downstream worker auth info"))
+			return nil, err
+		}
+
+		// Check for replays, forgetting the nonces that can no longer be
+		// used as their certificates have expired.
+		now := time.Now()
+		w.relayNonces.Range(func(key, value interface{}) bool {
+			if now.Sub(value.(time.Time)) > globals.WorkerAuthNonceValidityPeriod {
+				w.relayNonces.Delete(key)
+			}
+			return true
+		})
+		if _, loaded := w.relayNonces.LoadOrStore(info.ConnectionNonce, now); loaded {
+			return nil, errors.New("connection nonce already used")
+		}
+		// Recording requests are served rather than relayed.
+		for _, p := range hello.SupportedProtos {
+			if p == base.WorkerRecordingProto {
+				return info.ServerTLSConfig(base.WorkerRecordingProto)
+			}
+		}
+		return info.ServerTLSConfig(firstMatchProto)
+	}
+	return w.getSessionTls(hello)
+}
+
+// handleRelay verifies the connection nonce sent by a downstream worker, sends
+// back the name of this worker and relays the connection upstream.
+func (w *Worker) handleRelay(conn *tls.Conn) {
+	const op = "worker.(Worker).handleRelay"
+	ctx := w.baseContext
+	defer conn.Close()
+
+	nonce := make([]byte, 20)
+	if err := conn.SetReadDeadline(time.Now().Add(10 * time.Second)); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error setting read deadline"))
+		return
+	}
+	if _, err := io.ReadFull(conn, nonce); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error reading connection nonce from downstream worker"))
+		return
+	}
+	if _, ok := w.relayNonces.Load(string(nonce)); !ok {
+		event.WriteError(ctx, op, errors.New("unknown connection nonce received from downstream worker"))
+		return
+	}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error clearing read deadline"))
+		return
+	}
+	if err := writeRelayName(conn, w.conf.RawConfig.Worker.Name); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error sending worker name to downstream worker"))
+		return
+	}
+
+	upstreamConn, err := w.dialUpstream(ctx)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error dialing upstream for downstream worker"))
+		return
+	}
+	defer upstreamConn.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(upstreamConn, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, upstreamConn)
+		done <- struct{}{}
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+// dialUpstream returns a raw connection to a controller, through an upstream
+// worker if this worker has upstreams configured.
+func (w *Worker) dialUpstream(ctx context.Context) (net.Conn, error) {
+	var addrs []string
+	if raw := w.controllerAddrs.Load(); raw != nil {
+		addrs = append(addrs, raw.([]string)...)
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("no upstream addresses known")
+	}
+	rand.Shuffle(len(addrs), func(i, j int) { addrs[i], addrs[j] = addrs[j], addrs[i] })
+
+	var lastErr error
+	for _, addr := range addrs {
+		var conn net.Conn
+		var err error
+		switch {
+		case len(w.conf.RawConfig.Worker.Upstreams) > 0:
+			conn, err = w.dialUpstreamRelay(ctx, addr)
+		case strings.HasPrefix(addr, "/"):
+			conn, err = (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		default:
+			conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+		}
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// dialUpstreamRelay establishes a relay through the upstream worker at addr
+// and returns the relayed connection. The name of the upstream worker is
+// stored to be reported to the controller.
+func (w *Worker) dialUpstreamRelay(ctx context.Context, addr string) (net.Conn, error) {
+	const op = "worker.(Worker).dialUpstreamRelay"
+	tlsConf, authInfo, err := w.workerAuthTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error creating tls config for worker auth: %w", err)
+	}
+	nonTlsConn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to dial to upstream worker: %w", err)
+	}
+	tlsConn := tls.Client(nonTlsConn, tlsConf)
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := tlsConn.SetDeadline(deadline); err != nil {
+			_ = nonTlsConn.Close()
+			return nil, fmt.Errorf("unable to set deadline: %w", err)
+		}
+	}
+	if _, err := tlsConn.Write([]byte(authInfo.ConnectionNonce)); err != nil {
+		if err := nonTlsConn.Close(); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection after writing failure"))
+		}
+		return nil, fmt.Errorf("unable to write connection nonce: %w", err)
+	}
+	name, err := readRelayName(tlsConn)
+	if err != nil {
+		if err := nonTlsConn.Close(); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection after reading failure"))
+		}
+		return nil, fmt.Errorf("unable to read upstream worker name: %w", err)
+	}
+	if err := tlsConn.SetDeadline(time.Time{}); err != nil {
+		_ = nonTlsConn.Close()
+		return nil, fmt.Errorf("unable to clear deadline: %w", err)
+	}
+	w.upstreamName.Store(name)
+	return tlsConn, nil
+}
+
+func writeRelayName(conn net.Conn, name string) error {
+	if len(name) > maxRelayNameLength {
+		return fmt.Errorf("worker name longer than %d bytes", maxRelayNameLength)
+	}
+	buf := make([]byte, 2+len(name))
+	binary.BigEndian.PutUint16(buf, uint16(len(name)))
+	copy(buf[2:], name)
+	_, err := conn.Write(buf)
+	return err
+}
+
+func readRelayName(conn net.Conn) (string, error) {
+	var l [2]byte
+	if _, err := io.ReadFull(conn, l[:]); err != nil {
+		return "", err
+	}
+	n := binary.BigEndian.Uint16(l[:])
+	if n == 0 || n > maxRelayNameLength {
+		return "", fmt.Errorf("invalid worker name length %d", n)
+	}
+	name := make([]byte, n)
+	if _, err := io.ReadFull(conn, name); err != nil {
+		return "", err
+	}
+	return string(name), nil
+}
+
+// relaySession relays the messages of the client connection conn of the
+// session si to the downstream worker at addr, which serves the session.
+func (w *Worker) relaySession(ctx context.Context, conn *websocket.Conn, si *session.Info, addr string) error {
+	si.RLock()
+	authz := si.LookupSessionResponse.GetAuthorization()
+	si.RUnlock()
+
+	parsedCert, err := x509.ParseCertificate(authz.GetCertificate())
+	if err != nil {
+		return fmt.Errorf("error parsing session certificate: %w", err)
+	}
+	if len(parsedCert.DNSNames) != 1 {
+		return fmt.Errorf("invalid length of DNS names (%d) in parsed certificate", len(parsedCert.DNSNames))
+	}
+	certPool := x509.NewCertPool()
+	certPool.AddCert(parsedCert)
+
+	transport := cleanhttp.DefaultTransport()
+	transport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{
+			{
+				Certificate: [][]byte{authz.GetCertificate()},
+				PrivateKey:  ed25519.PrivateKey(authz.GetPrivateKey()),
+				Leaf:        parsedCert,
+			},
+		},
+		RootCAs:    certPool,
+		ServerName: parsedCert.DNSNames[0],
+		MinVersion: tls.VersionTLS13,
+	}
+	downstream, _, err := websocket.Dial(ctx, fmt.Sprintf("wss://%s/v1/proxy", addr), &websocket.DialOptions{
+		HTTPClient:   &http.Client{Transport: transport},
+		Subprotocols: []string{globals.TcpProxyV1},
+	})
+	if err != nil {
+		return fmt.Errorf("error dialing downstream worker: %w", err)
+	}
+	defer downstream.Close(websocket.StatusNormalClosure, "done")
+
+	errs := make(chan error, 2)
+	go func() { errs <- copyMessages(ctx, downstream, conn) }()
+	go func() { errs <- copyMessages(ctx, conn, downstream) }()
+	err = <-errs
+	if closeErr := (websocket.CloseError{}); errors.As(err, &closeErr) {
+		// Pass the closure on to the other side.
+		_ = conn.Close(closeErr.Code, closeErr.Reason)
+		_ = downstream.Close(closeErr.Code, closeErr.Reason)
+		return nil
+	}
+	return err
+}
+
+// copyMessages copies the messages read from src to dst until either fails.
+func copyMessages(ctx context.Context, dst, src *websocket.Conn) error {
+	for {
+		typ, r, err := src.Reader(ctx)
+		if err != nil {
+			return err
+		}
+		wr, err := dst.Writer(ctx, typ)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(wr, r); err != nil {
+			return err
+		}
+		if err := wr.Close(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		workerName   string
+		wantWriteErr bool
+	}{
+		{name: "valid", workerName: "w_1234567890"},
+		{name: "max-length", workerName: strings.Repeat("a", maxRelayNameLength)},
+		{name: "too-long", workerName: strings.Repeat("a", maxRelayNameLength+1), wantWriteErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require, assert := require.New(t), assert.New(t)
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+
+			writeErr := make(chan error, 1)
+			go func() { writeErr <- writeRelayName(server, tt.workerName) }()
+			if tt.wantWriteErr {
+				require.Error(<-writeErr)
+				return
+			}
+			got, err := readRelayName(client)
+			require.NoError(err)
+			require.NoError(<-writeErr)
+			assert.Equal(tt.workerName, got)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		client, server := net.Pipe()
+		defer client.Close()
+		defer server.Close()
+		go func() { _, _ = server.Write([]byte{0, 0}) }()
+		_, err := readRelayName(client)
+		assert.Error(t, err)
+	})
+}
@@ -20,6 +20,15 @@ const ValidateSessionTimeout = 90 * time.Second
 
 var errMakeSessionCloseInfoNilCloseInfo = errors.New("nil closeInfo supplied to makeSessionCloseInfo, this is a bug, please report it")
 
+var (
+	// ErrBytesLimit is returned by proxies when a connection is closed
+	// because its session reached one of its bytes limits.
+	ErrBytesLimit = errors.New("session bytes limit reached")
+	// ErrIdleTimeout is returned by proxies when a connection is closed
+	// because it was idle for longer than the idle timeout of its session.
+	ErrIdleTimeout = errors.New("connection idle timeout reached")
+)
+
 // ConnInfo defines the information about a connection attached to a session
 type ConnInfo struct {
 	Id         string
@@ -27,6 +36,16 @@ type ConnInfo struct {
 	ConnCancel context.CancelFunc
 	Status     pbs.CONNECTIONSTATUS
 	CloseTime  time.Time
+	// Recording is set by proxies that recorded the connection, once the
+	// recording has been finished.
+	Recording *pbs.ConnectionRecording
+	// BytesUp and BytesDown are set by proxies to the number of bytes the
+	// connection transferred, once it is finished.
+	BytesUp   uint64
+	BytesDown uint64
+	// CloseReason is set when the worker closes the connection for a known
+	// reason, such as a limit of the session being reached.
+	CloseReason session.ClosedReason
 }
 
 // Info defines the information about a session
@@ -37,6 +56,84 @@ type Info struct {
 	Status                pbs.SESSIONSTATUS
 	LookupSessionResponse *pbs.LookupSessionResponse
 	ConnInfoMap           map[string]*ConnInfo
+	// BytesUp and BytesDown are the number of bytes transferred by all the
+	// connections of the session, as counted by the proxies.
+	BytesUp   int64
+	BytesDown int64
+}
+
+// BytesLeft returns the number of bytes the connections of the session can
+// still send up and receive down before the bytes limits of the session are
+// reached. A negative number means there is no limit in that direction.
+func (i *Info) BytesLeft() (up, down int64) {
+	i.RLock()
+	defer i.RUnlock()
+	return bytesLeft(i.LookupSessionResponse.GetMaxBytesUp(), i.BytesUp),
+		bytesLeft(i.LookupSessionResponse.GetMaxBytesDown(), i.BytesDown)
+}
+
+func bytesLeft(limit, transferred int64) int64 {
+	switch {
+	case limit <= 0:
+		return -1
+	case transferred >= limit:
+		return 0
+	default:
+		return limit - transferred
+	}
+}
+
+// AddBytes adds the bytes transferred by a connection of the session to the
+// totals of the session, without going past the bytes limits of the session.
+// It returns the number of bytes that were added in each direction, which is
+// less than asked for once a limit is reached.
+func (i *Info) AddBytes(up, down int64) (addedUp, addedDown int64) {
+	i.Lock()
+	defer i.Unlock()
+	if left := bytesLeft(i.LookupSessionResponse.GetMaxBytesUp(), i.BytesUp); left >= 0 && up > left {
+		up = left
+	}
+	if left := bytesLeft(i.LookupSessionResponse.GetMaxBytesDown(), i.BytesDown); left >= 0 && down > left {
+		down = left
+	}
+	i.BytesUp += up
+	i.BytesDown += down
+	return up, down
+}
+
+// ConnectionFinished records the bytes transferred by the connection connId
+// of the session once it is finished. If err is ErrBytesLimit or
+// ErrIdleTimeout, it is recorded as the reason the connection was closed. For
+// ErrBytesLimit, the other connections of the session are canceled for the
+// same reason.
+func (i *Info) ConnectionFinished(connId string, bytesUp, bytesDown uint64, err error) {
+	i.Lock()
+	defer i.Unlock()
+	if ci, ok := i.ConnInfoMap[connId]; ok {
+		ci.BytesUp = bytesUp
+		ci.BytesDown = bytesDown
+		switch {
+		case ci.CloseReason != "":
+		case errors.Is(err, ErrBytesLimit):
+			ci.CloseReason = session.ConnectionBytesLimit
+		case errors.Is(err, ErrIdleTimeout):
+			ci.CloseReason = session.ConnectionIdleTimeout
+		}
+	}
+	if !errors.Is(err, ErrBytesLimit) {
+		return
+	}
+	for id, ci := range i.ConnInfoMap {
+		if id == connId || ci.Status == pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CLOSED {
+			continue
+		}
+		if ci.CloseReason == "" {
+			ci.CloseReason = session.ConnectionBytesLimit
+		}
+		if ci.ConnCancel != nil {
+			ci.ConnCancel()
+		}
+	}
 }
 
 // Activate is a helper worker function that sends session activation request to the
@@ -139,7 +236,7 @@ func CloseConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 	// within an adequate period of time.
 	closeConnCtx, closeConnCancel := context.WithTimeout(ctx, common.StatusTimeout)
 	defer closeConnCancel()
-	response, err := closeConnection(closeConnCtx, sessClient, makeCloseConnectionRequest(closeInfo))
+	response, err := closeConnection(closeConnCtx, sessClient, makeCloseConnectionRequest(sessionInfo, closeInfo))
 	if err != nil {
 		event.WriteError(ctx, op, err, event.WithInfoMsg("error marking connections closed",
 			"warning", "error contacting controller, connections will be closed only on worker",
@@ -172,16 +269,18 @@ func CloseConnections(ctx context.Context, sessClient pbs.SessionServiceClient,
 // use with closing connections.
 //
 // closeInfo is a map, indexed by connection ID, to the individual
-// sessions IDs that those connections belong to. The values are
-// ignored; the parameter is expected as such just for convenience of
-// its caller.
-func makeCloseConnectionRequest(closeInfo map[string]string) *pbs.CloseConnectionRequest {
+// sessions IDs that those connections belong to. The session IDs are
+// used to look up the close reason, the bytes transferred and any
+// recording made of the connection in sessionInfo, which may be nil.
+func makeCloseConnectionRequest(sessionInfo *sync.Map, closeInfo map[string]string) *pbs.CloseConnectionRequest {
 	closeData := make([]*pbs.CloseConnectionRequestData, 0, len(closeInfo))
-	for connId := range closeInfo {
-		closeData = append(closeData, &pbs.CloseConnectionRequestData{
+	for connId, sessionId := range closeInfo {
+		data := &pbs.CloseConnectionRequestData{
 			ConnectionId: connId,
 			Reason:       session.UnknownReason.String(),
-		})
+		}
+		setConnInfo(data, sessionInfo, sessionId, connId)
+		closeData = append(closeData, data)
 	}
 
 	return &pbs.CloseConnectionRequest{
@@ -189,6 +288,31 @@ func makeCloseConnectionRequest(closeInfo map[string]string) *pbs.CloseConnectio
 	}
 }
 
+// setConnInfo sets the close reason, the bytes transferred and the recording
+// stored for the connection in sessionInfo on data, if there are any.
+func setConnInfo(data *pbs.CloseConnectionRequestData, sessionInfo *sync.Map, sessionId, connId string) {
+	if sessionInfo == nil {
+		return
+	}
+	siRaw, ok := sessionInfo.Load(sessionId)
+	if !ok {
+		return
+	}
+	si := siRaw.(*Info)
+	si.RLock()
+	defer si.RUnlock()
+	ci, ok := si.ConnInfoMap[connId]
+	if !ok {
+		return
+	}
+	if ci.CloseReason != "" {
+		data.Reason = ci.CloseReason.String()
+	}
+	data.BytesUp = ci.BytesUp
+	data.BytesDown = ci.BytesDown
+	data.Recording = ci.Recording
+}
+
 // makeSessionCloseInfo takes the response from CloseConnections and
 // our original closeInfo map and makes a map of slices, indexed by
 // session ID, of all of the connection responses. This allows us to
@@ -20,7 +20,75 @@ func TestWorkerMakeCloseConnectionRequest(t *testing.T) {
 			{ConnectionId: "bar", Reason: session.UnknownReason.String()},
 		},
 	}
-	actual := makeCloseConnectionRequest(in)
+	actual := makeCloseConnectionRequest(nil, in)
+	require.ElementsMatch(expected.GetCloseRequestData(), actual.GetCloseRequestData())
+}
+
+func TestWorkerMakeCloseConnectionRequestWithRecording(t *testing.T) {
+	require := require.New(t)
+	recording := &pbs.ConnectionRecording{
+		StoragePath: "/recordings/one/foo.rec",
+		SizeBytes:   42,
+	}
+	sessionInfo := new(sync.Map)
+	sessionInfo.Store("one", &Info{
+		Id: "one",
+		ConnInfoMap: map[string]*ConnInfo{
+			"foo": {Id: "foo", Recording: recording},
+		},
+	})
+	sessionInfo.Store("two", &Info{
+		Id: "two",
+		ConnInfoMap: map[string]*ConnInfo{
+			"bar": {Id: "bar"},
+		},
+	})
+	in := map[string]string{"foo": "one", "bar": "two"}
+	expected := &pbs.CloseConnectionRequest{
+		CloseRequestData: []*pbs.CloseConnectionRequestData{
+			{ConnectionId: "foo", Reason: session.UnknownReason.String(), Recording: recording},
+			{ConnectionId: "bar", Reason: session.UnknownReason.String()},
+		},
+	}
+	actual := makeCloseConnectionRequest(sessionInfo, in)
+	require.ElementsMatch(expected.GetCloseRequestData(), actual.GetCloseRequestData())
+}
+
+func TestWorkerMakeCloseConnectionRequestWithLimits(t *testing.T) {
+	require := require.New(t)
+	var canceled bool
+	si := &Info{
+		Id: "one",
+		LookupSessionResponse: &pbs.LookupSessionResponse{
+			MaxBytesUp:   100,
+			MaxBytesDown: -1,
+		},
+		ConnInfoMap: map[string]*ConnInfo{
+			"foo": {Id: "foo"},
+			"bar": {Id: "bar", ConnCancel: func() { canceled = true }},
+			"baz": {Id: "baz"},
+		},
+	}
+	si.AddBytes(100, 10)
+	up, down := si.BytesLeft()
+	require.Equal(int64(0), up)
+	require.Equal(int64(-1), down)
+
+	si.ConnectionFinished("baz", 40, 6, ErrIdleTimeout)
+	si.ConnectionFinished("foo", 60, 4, ErrBytesLimit)
+	require.True(canceled)
+
+	sessionInfo := new(sync.Map)
+	sessionInfo.Store("one", si)
+	in := map[string]string{"foo": "one", "bar": "one", "baz": "one"}
+	expected := &pbs.CloseConnectionRequest{
+		CloseRequestData: []*pbs.CloseConnectionRequestData{
+			{ConnectionId: "foo", Reason: session.ConnectionBytesLimit.String(), BytesUp: 60, BytesDown: 4},
+			{ConnectionId: "bar", Reason: session.ConnectionBytesLimit.String()},
+			{ConnectionId: "baz", Reason: session.ConnectionIdleTimeout.String(), BytesUp: 40, BytesDown: 6},
+		},
+	}
+	actual := makeCloseConnectionRequest(sessionInfo, in)
 	require.ElementsMatch(expected.GetCloseRequestData(), actual.GetCloseRequestData())
 }
 
@@ -8,6 +8,7 @@ import (
 
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/servers"
 	"github.com/hashicorp/boundary/internal/servers/worker/common"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
@@ -93,6 +94,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 	// First send info as-is. We'll perform cleanup duties after we
 	// get cancel/job change info back.
 	var activeJobs []*pbs.JobStatus
+	var activeConns int
 
 	// Range over known sessions and collect info
 	w.sessionInfoMap.Range(func(key, value interface{}) bool {
@@ -107,6 +109,9 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 				ConnectionId: k,
 				Status:       v.Status,
 			})
+			if v.Status == pbs.CONNECTIONSTATUS_CONNECTIONSTATUS_CONNECTED {
+				activeConns++
+			}
 		}
 		si.RUnlock()
 		jobInfo.SessionId = sessionId
@@ -125,6 +130,9 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 		return true
 	})
 
+	metrics.WorkerSessions.WithLabelValues(w.conf.RawConfig.Worker.Name).Set(float64(len(activeJobs)))
+	metrics.WorkerConnections.WithLabelValues(w.conf.RawConfig.Worker.Name).Set(float64(activeConns))
+
 	// Send status information
 	client := w.controllerStatusConn.Load().(pbs.ServerCoordinationServiceClient)
 	var tags map[string]*servers.TagValues
@@ -133,6 +141,7 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 	if w.updateTags.Load() {
 		tags = w.tags.Load().(map[string]*servers.TagValues)
 	}
+	healthResults := w.healthChecker.drainResults()
 	statusCtx, statusCancel := context.WithTimeout(cancelCtx, common.StatusTimeout)
 	defer statusCancel()
 	result, err := client.Status(statusCtx, &pbs.StatusRequest{
@@ -143,11 +152,14 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 			Description: w.conf.RawConfig.Worker.Description,
 			Address:     w.conf.RawConfig.Worker.PublicAddr,
 			Tags:        tags,
+			Upstream:    w.upstreamName.Load(),
 		},
-		UpdateTags: w.updateTags.Load(),
+		UpdateTags:         w.updateTags.Load(),
+		HealthCheckResults: healthResults,
 	})
 	if err != nil {
 		event.WriteError(statusCtx, op, err, event.WithInfoMsg("error making status request to controller"))
+		w.healthChecker.requeue(healthResults)
 		// Check for last successful status. Ignore nil last status, this probably
 		// means that we've never connected to a controller, and as such probably
 		// don't have any sessions to worry about anyway.
@@ -175,13 +187,17 @@ func (w *Worker) sendWorkerStatus(cancelCtx context.Context) {
 			addrs = append(addrs, resolver.Address{Addr: v.Address})
 			strAddrs = append(strAddrs, v.Address)
 		}
-		switch len(strAddrs) {
-		case 0:
+		switch {
+		case len(strAddrs) == 0:
 			event.WriteError(statusCtx, op, errors.New("got no controller addresses from controller; possibly prior to first status save, not persisting"))
+		case len(w.conf.RawConfig.Worker.Upstreams) > 0:
+			// The controllers are reached through the configured upstreams
 		default:
 			w.Resolver().UpdateState(resolver.State{Addresses: addrs})
+			w.controllerAddrs.Store(strAddrs)
 		}
 		w.lastStatusSuccess.Store(&LastStatusInformation{StatusResponse: result, StatusTime: time.Now()})
+		w.healthChecker.update(cancelCtx, result.GetHealthChecks())
 
 		for _, request := range result.GetJobsRequests() {
 			switch request.GetRequestType() {
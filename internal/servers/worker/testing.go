@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/hashicorp/boundary/internal/observability/event"
+	"github.com/hashicorp/boundary/internal/observability/metrics"
 	"github.com/hashicorp/boundary/internal/servers/worker/session"
 	"github.com/hashicorp/go-hclog"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
@@ -61,6 +62,22 @@ func (tw *TestWorker) ControllerAddrs() []string {
 	return addrs
 }
 
+// MetricsAddrs returns the addresses of the metrics listeners, which are only
+// set up with the EnableMetrics option.
+func (tw *TestWorker) MetricsAddrs() []string {
+	var addrs []string
+	for _, listener := range tw.b.Listeners {
+		if listener.Config.Purpose[0] == "metrics" {
+			tcpAddr, ok := listener.Mux.Addr().(*net.TCPAddr)
+			if !ok {
+				tw.t.Fatal("could not parse address as a TCP addr")
+			}
+			addrs = append(addrs, fmt.Sprintf("http://%s:%d", tcpAddr.IP.String(), tcpAddr.Port))
+		}
+	}
+	return addrs
+}
+
 func (tw *TestWorker) ProxyAddrs() []string {
 	if tw.addrs != nil {
 		return tw.addrs
@@ -185,6 +202,9 @@ type TestWorkerOpts struct {
 
 	// Whether to set the replay value
 	EnableAuthReplay bool
+
+	// EnableMetrics adds a listener exposing the metrics of the worker
+	EnableMetrics bool
 }
 
 func NewTestWorker(t *testing.T, opts *TestWorkerOpts) *TestWorker {
@@ -258,11 +278,15 @@ func NewTestWorker(t *testing.T, opts *TestWorkerOpts) *TestWorker {
 		}
 	}
 
+	if opts.EnableMetrics {
+		opts.Config.Listeners = append(opts.Config.Listeners, metrics.TestListenerConfig())
+	}
+
 	// Ensure the listeners use random port allocation
 	for _, listener := range opts.Config.Listeners {
 		listener.RandomPort = true
 	}
-	if err := tw.b.SetupListeners(nil, opts.Config.SharedConfig, []string{"proxy"}); err != nil {
+	if err := tw.b.SetupListeners(nil, opts.Config.SharedConfig, []string{"proxy", "metrics"}); err != nil {
 		t.Fatal(err)
 	}
 	if err := tw.b.SetupWorkerPublicAddress(opts.Config, ""); err != nil {
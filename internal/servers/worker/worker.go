@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -41,6 +42,16 @@ type Worker struct {
 	workerStartTime      time.Time
 
 	controllerResolver *atomic.Value
+	// controllerAddrs stores the addresses to which connections of downstream
+	// workers are relayed: the addresses of the controllers, or of the
+	// upstream workers if this worker has upstreams configured.
+	controllerAddrs *atomic.Value
+	// upstreamName is the name of the upstream worker through which this
+	// worker last connected to the controllers, if any.
+	upstreamName ua.String
+	// relayNonces stores the connection nonces of downstream workers with the
+	// time they were received, to prevent replays.
+	relayNonces *sync.Map
 
 	controllerSessionConn *atomic.Value
 	sessionInfoMap        *sync.Map
@@ -53,6 +64,10 @@ type Worker struct {
 	// SIGHUP.
 	updateTags ua.Bool
 
+	// healthChecker runs the health checks of hosts assigned to the worker
+	// by the controllers.
+	healthChecker *healthChecker
+
 	// Test-related values
 	testReuseAuthNonces bool
 	testReusedAuthNonce string
@@ -66,9 +81,12 @@ func New(conf *Config) (*Worker, error) {
 		controllerStatusConn:  new(atomic.Value),
 		lastStatusSuccess:     new(atomic.Value),
 		controllerResolver:    new(atomic.Value),
+		controllerAddrs:       new(atomic.Value),
+		relayNonces:           new(sync.Map),
 		controllerSessionConn: new(atomic.Value),
 		sessionInfoMap:        new(sync.Map),
 		tags:                  new(atomic.Value),
+		healthChecker:         newHealthChecker(),
 	}
 
 	w.lastStatusSuccess.Store((*LastStatusInformation)(nil))
@@ -89,6 +107,12 @@ func New(conf *Config) (*Worker, error) {
 		return nil, fmt.Errorf("error auto-generating worker name: %w", err)
 	}
 
+	if recordingPath := conf.RawConfig.Worker.RecordingStoragePath; recordingPath != "" {
+		if err := os.MkdirAll(recordingPath, 0o700); err != nil {
+			return nil, fmt.Errorf("error creating recording storage path: %w", err)
+		}
+	}
+
 	if !conf.RawConfig.DisableMlock {
 		// Ensure our memory usage is locked into physical RAM
 		if err := mlock.LockMemory(); err != nil {
@@ -41,6 +41,8 @@ type Connection struct {
 	UpdateTime *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
 	// Version of the connection
 	Version uint32 `json:"version,omitempty" gorm:"default:null"`
+	// Recording of the connection, if the worker recorded it
+	Recording *ConnectionRecording `json:"recording,omitempty" gorm:"-"`
 
 	tableName string `gorm:"-"`
 }
@@ -92,6 +94,9 @@ func (c *Connection) Clone() interface{} {
 		ClosedReason:       c.ClosedReason,
 		Version:            c.Version,
 	}
+	if c.Recording != nil {
+		clone.Recording = c.Recording.Clone().(*ConnectionRecording)
+	}
 	if c.CreateTime != nil {
 		clone.CreateTime = &timestamp.Timestamp{
 			Timestamp: &timestamppb.Timestamp{
@@ -16,6 +16,8 @@ const (
 	ConnectionCanceled     ClosedReason = "canceled"
 	ConnectionNetworkError ClosedReason = "network error"
 	ConnectionSystemError  ClosedReason = "system error"
+	ConnectionBytesLimit   ClosedReason = "bytes limit"
+	ConnectionIdleTimeout  ClosedReason = "idle timeout"
 )
 
 // String representation of the termination reason
@@ -38,6 +40,10 @@ func convertToClosedReason(s string) (ClosedReason, error) {
 		return ConnectionNetworkError, nil
 	case ConnectionSystemError.String():
 		return ConnectionSystemError, nil
+	case ConnectionBytesLimit.String():
+		return ConnectionBytesLimit, nil
+	case ConnectionIdleTimeout.String():
+		return ConnectionIdleTimeout, nil
 	default:
 		return "", errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid reason", s))
 	}
@@ -11,6 +11,9 @@ type CloseWith struct {
 	BytesUp      uint64
 	BytesDown    uint64
 	ClosedReason ClosedReason
+	// Recording is optional and only set when the worker recorded the
+	// connection. Its ConnectionId is set from the CloseWith.
+	Recording *ConnectionRecording
 }
 
 func (c CloseWith) validate() error {
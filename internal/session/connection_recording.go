@@ -0,0 +1,126 @@
+package session
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultConnectionRecordingTableName = "session_connection_recording"
+)
+
+// ConnectionRecording contains the metadata of a recording the worker made of
+// both directions of a session connection. The recording itself stays on the
+// worker at StoragePath.
+type ConnectionRecording struct {
+	// ConnectionId of the recorded connection
+	ConnectionId string `json:"connection_id,omitempty" gorm:"primary_key"`
+	// StoragePath is the location of the recording file on the worker
+	StoragePath string `json:"storage_path,omitempty" gorm:"default:null"`
+	// SizeBytes is the size of the recording file
+	SizeBytes uint64 `json:"size_bytes,omitempty" gorm:"default:null"`
+	// Checksum is the hex encoded SHA256 checksum of the recording file
+	Checksum string `json:"checksum,omitempty" gorm:"default:null"`
+	// StartTime of the recording
+	StartTime *timestamp.Timestamp `json:"start_time,omitempty" gorm:"default:current_timestamp"`
+	// EndTime of the recording
+	EndTime *timestamp.Timestamp `json:"end_time,omitempty" gorm:"default:current_timestamp"`
+	// CreateTime from the RDBMS
+	CreateTime *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+
+	tableName string `gorm:"-"`
+}
+
+var (
+	_ Cloneable       = (*ConnectionRecording)(nil)
+	_ db.VetForWriter = (*ConnectionRecording)(nil)
+)
+
+// NewConnectionRecording creates a new in memory connection recording. No
+// options are currently supported.
+func NewConnectionRecording(connectionId, storagePath string, sizeBytes uint64, checksum string, startTime, endTime *timestamp.Timestamp, _ ...Option) (*ConnectionRecording, error) {
+	const op = "session.NewConnectionRecording"
+	r := ConnectionRecording{
+		ConnectionId: connectionId,
+		StoragePath:  storagePath,
+		SizeBytes:    sizeBytes,
+		Checksum:     checksum,
+		StartTime:    startTime,
+		EndTime:      endTime,
+	}
+	if err := r.validate(); err != nil {
+		return nil, errors.WrapDeprecated(err, op)
+	}
+	return &r, nil
+}
+
+// Clone creates a clone of the ConnectionRecording.
+func (r *ConnectionRecording) Clone() interface{} {
+	clone := &ConnectionRecording{
+		ConnectionId: r.ConnectionId,
+		StoragePath:  r.StoragePath,
+		SizeBytes:    r.SizeBytes,
+		Checksum:     r.Checksum,
+	}
+	if r.StartTime != nil {
+		clone.StartTime = proto.Clone(r.StartTime).(*timestamp.Timestamp)
+	}
+	if r.EndTime != nil {
+		clone.EndTime = proto.Clone(r.EndTime).(*timestamp.Timestamp)
+	}
+	if r.CreateTime != nil {
+		clone.CreateTime = proto.Clone(r.CreateTime).(*timestamp.Timestamp)
+	}
+	return clone
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the
+// connection recording before it's written. Recordings are immutable, so only
+// creates are allowed.
+func (r *ConnectionRecording) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "session.(ConnectionRecording).VetForWrite"
+	if opType != db.CreateOp {
+		return errors.New(ctx, errors.InvalidParameter, op, "connection recordings are immutable")
+	}
+	if err := r.validate(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (r *ConnectionRecording) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return defaultConnectionRecordingTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (r *ConnectionRecording) SetTableName(n string) {
+	r.tableName = n
+}
+
+func (r *ConnectionRecording) validate() error {
+	const op = "session.(ConnectionRecording).validate"
+	if r.ConnectionId == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing connection id")
+	}
+	if r.StoragePath == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing storage path")
+	}
+	if r.Checksum == "" {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "missing checksum")
+	}
+	if r.StartTime != nil && r.EndTime != nil &&
+		r.StartTime.AsTime().After(r.EndTime.AsTime()) {
+		return errors.NewDeprecated(errors.InvalidParameter, op, "start time is after end time")
+	}
+	return nil
+}
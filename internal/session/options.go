@@ -3,6 +3,7 @@ package session
 import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/filter"
 )
 
 // getOpts - iterate the inbound Options and return a struct
@@ -29,6 +30,9 @@ type options struct {
 	withSessionIds        []string
 	withServerId          string
 	withDbOpts            []db.Option
+	withStartPageAfterId  string
+	withFilterConditions  []filter.Condition
+	withUserSessionLimit  int32
 }
 
 func getDefaultOptions() options {
@@ -52,6 +56,31 @@ func WithOrderByCreateTime(orderBy db.OrderBy) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the sessions with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.withStartPageAfterId = id
+	}
+}
+
+// WithFilterConditions provides an option to only list the items matching
+// the conditions of a list filter which can be evaluated by the database.
+func WithFilterConditions(c []filter.Condition) Option {
+	return func(o *options) {
+		o.withFilterConditions = c
+	}
+}
+
+// WithUserSessionLimit provides an option to limit the number of pending or
+// active sessions the user of a new session can have for its target. A limit
+// less than or equal to zero means no limit.
+func WithUserSessionLimit(limit int32) Option {
+	return func(o *options) {
+		o.withUserSessionLimit = limit
+	}
+}
+
 // WithScopeIds allows specifying a scope ID criteria for the function.
 func WithScopeIds(scopeIds []string) Option {
 	return func(o *options) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -44,6 +45,28 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withOrderByCreateTime = db.AscendingOrderBy
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithStartPageAfterId("s_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.withStartPageAfterId = "s_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithFilterConditions", func(t *testing.T) {
+		assert := assert.New(t)
+		conds := []filter.Condition{{Field: "name", Operator: filter.EqualOperator, Value: "foo"}}
+		opts := getOpts(WithFilterConditions(conds))
+		testOpts := getDefaultOptions()
+		testOpts.withFilterConditions = conds
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithUserSessionLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithUserSessionLimit(2))
+		testOpts := getDefaultOptions()
+		testOpts.withUserSessionLimit = 2
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithUserId", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := getOpts(WithUserId("u_1234"))
@@ -23,6 +23,34 @@ with not_active as (
 select * from not_active;
 `
 
+	// cancelUserSessions sets the state of the pending and active sessions of
+	// the user to canceling, updating their version as for any other state
+	// change.
+	cancelUserSessions = `
+with canceled as (
+	update session
+	set
+		version = version + 1
+	where
+		user_id = @user_id and
+		public_id in (
+			select
+				session_id
+			from
+				session_state
+			where
+				end_time is null and
+				state in ('pending', 'active')
+		)
+	returning public_id
+)
+insert into session_state(session_id, state)
+select
+	public_id, 'canceling'
+from
+	canceled;
+`
+
 	// updateSessionState checks that we don't already have a row for the new
 	// state or it's not already terminated (final state) before inserting a new
 	// state.
@@ -150,15 +178,63 @@ session_connection_limit(expiration_time, connection_limit) as (
 select expiration_time, connection_limit, current_connection_count 
 from  
 	session_connection_limit, session_connection_count;	
+`
+
+	// lockUserTargetSessions serializes the creation of the sessions of a
+	// user for a target until the end of the transaction, so the number of
+	// sessions read by userSessionCount cannot change before a new session is
+	// created.
+	lockUserTargetSessions = `
+select pg_advisory_xact_lock(hashtext(@target_id), hashtext(@user_id));
+`
+
+	// userSessionCount counts the pending and active sessions of a user for a
+	// target.
+	userSessionCount = `
+select count(*)
+from
+	session s,
+	session_state ss
+where
+	s.public_id = ss.session_id and
+	s.user_id = @user_id and
+	s.target_id = @target_id and
+	ss.state in ('pending', 'active') and
+	ss.end_time is null;
+`
+	// hostSessionCount counts the pending and active sessions of each host of
+	// a list of hosts.
+	hostSessionCount = `
+select s.host_id, count(*)
+from
+	session s,
+	session_state ss
+where
+	s.public_id = ss.session_id and
+	s.host_id in (%s) and
+	ss.state in ('pending', 'active') and
+	ss.end_time is null
+group by s.host_id;
+`
+
+	// hostLastSessionTime returns the creation time of the last session of
+	// each host of a list of hosts for a target.
+	hostLastSessionTime = `
+select s.host_id, max(s.create_time)
+from
+	session s
+where
+	s.target_id = @target_id and
+	s.host_id in (%s)
+group by s.host_id;
 `
 	sessionList = `
 select * 
 from
-	(select public_id from session %s) s,
+	(select public_id from session %s order by public_id %s) s,
 	session_list ss
 where 
 	s.public_id = ss.public_id 
-	%s
 %s
 `
 
@@ -167,6 +243,10 @@ where
 	//	* sessions that are expired and all their connections are closed.
 	// 	* sessions that are canceling and all their connections are closed
 	//  * sessions that have exhausted their connection limit and all their connections are closed.
+	//  * sessions that have reached a bytes limit and all their connections are closed.
+	//
+	// Sessions whose connection limit is reached and whose last closed
+	// connection was closed at the idle timeout are terminated because of it.
 	termSessionsUpdate = `
 with canceling_session(session_id) as
 (
@@ -177,6 +257,33 @@ with canceling_session(session_id) as
 	where 
 		ss.state = 'canceling' and 
 		ss.end_time is null
+),
+bytes_limit_session(session_id) as
+(
+	select 
+		session_id
+	from
+		session_connection sc
+	where 
+		sc.closed_reason = 'bytes limit'
+),
+idle_timeout_session(session_id) as
+(
+	select 
+		lc.session_id
+	from
+		(
+			select distinct on (sc.session_id)
+				sc.session_id, sc.closed_reason
+			from
+				session_connection sc
+			join session_connection_state scs on
+				scs.connection_id = sc.public_id and
+				scs.state = 'closed'
+			order by sc.session_id, scs.start_time desc
+		) lc
+	where 
+		lc.closed_reason = 'idle timeout'
 )
 update session us
 	set termination_reason = 
@@ -192,6 +299,26 @@ update session us
 			where 
 				us.public_id = cs.session_id
 			) then 'canceled' 
+		-- sessions whose connections were closed by the worker when a
+		-- bytes limit was reached
+		when us.public_id in(
+			select 
+				session_id 
+			from 
+				bytes_limit_session bs 
+			where 
+				us.public_id = bs.session_id
+			) then 'bytes limit' 
+		-- sessions whose connection limit was reached when the worker
+		-- closed their last connection at the idle timeout
+		when us.public_id in(
+			select 
+				session_id 
+			from 
+				idle_timeout_session its 
+			where 
+				us.public_id = its.session_id
+			) then 'idle timeout' 
 		-- default: session connection limit reached.
 		else 'connection limit'
 	end
@@ -220,6 +347,15 @@ where
 				canceling_session cs
 			where 
 				us.public_id = cs.session_id 
+		) or 
+		-- bytes limit reached
+		us.public_id in (
+			select 
+				session_id
+			from
+				bytes_limit_session bs
+			where 
+				us.public_id = bs.session_id 
 		)
 	) and 
 	-- make sure there are no existing connections
@@ -107,26 +107,27 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionList []*sessi
 			}
 			prevSessionId = sv.PublicId
 			workingSession = &Session{
-				PublicId:          sv.PublicId,
-				UserId:            sv.UserId,
-				HostId:            sv.HostId,
-				ServerId:          sv.ServerId,
-				ServerType:        sv.ServerType,
-				TargetId:          sv.TargetId,
-				HostSetId:         sv.HostSetId,
-				AuthTokenId:       sv.AuthTokenId,
-				ScopeId:           sv.ScopeId,
-				Certificate:       sv.Certificate,
-				ExpirationTime:    sv.ExpirationTime,
-				CtTofuToken:       sv.CtTofuToken,
-				TofuToken:         sv.TofuToken, // will always be nil since it's not stored in the database.
-				TerminationReason: sv.TerminationReason,
-				CreateTime:        sv.CreateTime,
-				UpdateTime:        sv.UpdateTime,
-				Version:           sv.Version,
-				Endpoint:          sv.Endpoint,
-				ConnectionLimit:   sv.ConnectionLimit,
-				KeyId:             sv.KeyId,
+				PublicId:              sv.PublicId,
+				UserId:                sv.UserId,
+				HostId:                sv.HostId,
+				ServerId:              sv.ServerId,
+				ServerType:            sv.ServerType,
+				TargetId:              sv.TargetId,
+				HostSetId:             sv.HostSetId,
+				AuthTokenId:           sv.AuthTokenId,
+				ScopeId:               sv.ScopeId,
+				Certificate:           sv.Certificate,
+				ExpirationTime:        sv.ExpirationTime,
+				CtTofuToken:           sv.CtTofuToken,
+				TofuToken:             sv.TofuToken, // will always be nil since it's not stored in the database.
+				TerminationReason:     sv.TerminationReason,
+				CreateTime:            sv.CreateTime,
+				UpdateTime:            sv.UpdateTime,
+				Version:               sv.Version,
+				Endpoint:              sv.Endpoint,
+				ConnectionLimit:       sv.ConnectionLimit,
+				KeyId:                 sv.KeyId,
+				HostSelectionStrategy: sv.HostSelectionStrategy,
 			}
 			if opts.withListingConvert {
 				workingSession.CtTofuToken = nil // CtTofuToken should not returned in lists
@@ -170,6 +171,16 @@ func (r *Repository) convertToSessions(ctx context.Context, sessionList []*sessi
 					BytesDown:          sv.BytesDown,
 					ClosedReason:       sv.ClosedReason,
 				}
+				if sv.RecordingStoragePath != "" {
+					connections[sv.ConnectionId].Recording = &ConnectionRecording{
+						ConnectionId: sv.ConnectionId,
+						StoragePath:  sv.RecordingStoragePath,
+						SizeBytes:    sv.RecordingSizeBytes,
+						Checksum:     sv.RecordingChecksum,
+						StartTime:    sv.RecordingStartTime,
+						EndTime:      sv.RecordingEndTime,
+					}
+				}
 			}
 		}
 
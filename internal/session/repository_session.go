@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
@@ -17,11 +18,26 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// filterColumns are the SQL expressions of the fields of a session which can
+// be used in the filter conditions of a list. The status of a session is the
+// state it is currently in.
+var filterColumns = map[string]string{
+	"scope_id":      "scope_id",
+	"user_id":       "user_id",
+	"target_id":     "target_id",
+	"host_id":       "host_id",
+	"host_set_id":   "host_set_id",
+	"auth_token_id": "auth_token_id",
+	"status":        "(select state from session_state where session_state.session_id = session.public_id and session_state.end_time is null)",
+}
+
 // CreateSession inserts into the repository and returns the new Session with
 // its State of "Pending".  The following fields must be empty when creating a
-// session: ServerId, ServerType, and PublicId.  No options are
-// currently supported.
-func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, _ ...Option) (*Session, ed25519.PrivateKey, error) {
+// session: ServerId, ServerType, and PublicId.  WithUserSessionLimit is the
+// only supported option. If the user of the session already has as many
+// pending or active sessions for the target as the limit, an error with the
+// code errors.SessionLimitExceeded is returned.
+func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.Wrapper, newSession *Session, opt ...Option) (*Session, ed25519.PrivateKey, error) {
 	const op = "session.(Repository).CreateSession"
 	if newSession == nil {
 		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing session")
@@ -79,12 +95,18 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	newSession.PublicId = id
 	newSession.KeyId = sessionWrapper.KeyID()
 
+	opts := getOpts(opt...)
 	var returnedSession *Session
 	_, err = r.writer.DoTx(
 		ctx,
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(read db.Reader, w db.Writer) error {
+			if opts.withUserSessionLimit > 0 {
+				if err := checkUserSessionLimit(ctx, w, newSession.UserId, newSession.TargetId, opts.withUserSessionLimit); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
 			returnedSession = newSession.Clone().(*Session)
 			returnedSession.DynamicCredentials = nil
 			if err = w.Create(ctx, returnedSession); err != nil {
@@ -134,6 +156,110 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 	return returnedSession, privKey, nil
 }
 
+// checkUserSessionLimit returns an error if the user has limit or more pending
+// or active sessions for the target. It must be called in the transaction
+// creating the new session, which it serializes with the other transactions
+// creating a session for the user and the target.
+func checkUserSessionLimit(ctx context.Context, w db.Writer, userId, targetId string, limit int32) error {
+	const op = "session.checkUserSessionLimit"
+	args := []interface{}{
+		sql.Named("user_id", userId),
+		sql.Named("target_id", targetId),
+	}
+	if _, err := w.Exec(ctx, lockUserTargetSessions, args); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to lock user sessions"))
+	}
+	rows, err := w.Query(ctx, userSessionCount, args)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var count int64
+	for rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if count >= int64(limit) {
+		return errors.New(ctx, errors.SessionLimitExceeded, op, fmt.Sprintf("user %s has reached the limit of %d sessions for target %s", userId, limit, targetId))
+	}
+	return nil
+}
+
+// ActiveSessionCountsByHost returns the number of pending or active sessions
+// of each host in hostIds, for all targets. Hosts without any pending or
+// active session are not in the returned map.
+func (r *Repository) ActiveSessionCountsByHost(ctx context.Context, hostIds []string) (map[string]int, error) {
+	const op = "session.(Repository).ActiveSessionCountsByHost"
+	if len(hostIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host ids")
+	}
+	inClause, args := hostIdsInClause(hostIds)
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(hostSessionCount, inClause), args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	counts := make(map[string]int, len(hostIds))
+	for rows.Next() {
+		var hostId string
+		var count int
+		if err := rows.Scan(&hostId, &count); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		counts[hostId] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
+
+// LastSessionTimesByHost returns the creation time of the last session of
+// each host in hostIds for the target. Hosts which never had a session for
+// the target are not in the returned map.
+func (r *Repository) LastSessionTimesByHost(ctx context.Context, targetId string, hostIds []string) (map[string]time.Time, error) {
+	const op = "session.(Repository).LastSessionTimesByHost"
+	if targetId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
+	}
+	if len(hostIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host ids")
+	}
+	inClause, args := hostIdsInClause(hostIds)
+	args = append(args, sql.Named("target_id", targetId))
+	rows, err := r.reader.Query(ctx, fmt.Sprintf(hostLastSessionTime, inClause), args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	times := make(map[string]time.Time, len(hostIds))
+	for rows.Next() {
+		var hostId string
+		var createTime time.Time
+		if err := rows.Scan(&hostId, &createTime); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		times[hostId] = createTime
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return times, nil
+}
+
+// hostIdsInClause returns the named parameters of an in clause for hostIds,
+// and their arguments.
+func hostIdsInClause(hostIds []string) (string, []interface{}) {
+	params := make([]string, 0, len(hostIds))
+	args := make([]interface{}, 0, len(hostIds))
+	for i, id := range hostIds {
+		params = append(params, fmt.Sprintf("@%d", i+1))
+		args = append(args, sql.Named(fmt.Sprintf("%d", i+1), id))
+	}
+	return strings.Join(params, ","), args
+}
+
 // LookupSession will look up a session in the repository and return the session
 // with its states.  Returned States are ordered by start time descending.  If the
 // session is not found, it will return nil, nil, nil. No options are currently
@@ -201,7 +327,10 @@ func (r *Repository) LookupSession(ctx context.Context, sessionId string, _ ...O
 	return &session, authzSummary, nil
 }
 
-// ListSessions will sessions.  Supports the WithLimit, WithScopeId, WithSessionIds, and WithServerId options.
+// ListSessions will sessions.  Supports the WithLimit, WithScopeId,
+// WithSessionIds, WithServerId, WithStartPageAfterId and WithFilterConditions
+// options. Sessions are ordered by their public id unless
+// WithOrderByCreateTime is used.
 func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Session, error) {
 	const op = "session.(Repository).ListSessions"
 	opts := getOpts(opt...)
@@ -233,12 +362,28 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 			inClauseCnt += 1
 			idsInClause, args = append(idsInClause, fmt.Sprintf("@%d", inClauseCnt)), append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt), id))
 		}
-		where = append(where, fmt.Sprintf("s.public_id in (%s)", strings.Join(idsInClause, ",")))
+		where = append(where, fmt.Sprintf("public_id in (%s)", strings.Join(idsInClause, ",")))
 	}
 	if opts.withServerId != "" {
 		inClauseCnt += 1
 		where, args = append(where, fmt.Sprintf("server_id = @%d", inClauseCnt)), append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt), opts.withServerId))
 	}
+	if opts.withStartPageAfterId != "" {
+		inClauseCnt += 1
+		where, args = append(where, fmt.Sprintf("public_id > @%d", inClauseCnt)), append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt), opts.withStartPageAfterId))
+	}
+	for _, c := range opts.withFilterConditions {
+		col, ok := filterColumns[c.Field]
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("field %q cannot be filtered in the database", c.Field))
+		}
+		inClauseCnt += 1
+		cond, err := c.Sql(col, fmt.Sprintf("@%d", inClauseCnt))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		where, args = append(where, cond), append(args, sql.Named(fmt.Sprintf("%d", inClauseCnt), c.Value))
+	}
 
 	var limit string
 	switch {
@@ -255,17 +400,17 @@ func (r *Repository) ListSessions(ctx context.Context, opt ...Option) ([]*Sessio
 	case db.AscendingOrderBy:
 		withOrder = "order by create_time asc"
 	case db.DescendingOrderBy:
-		fallthrough
-	default:
 		withOrder = "order by create_time"
+	default:
+		withOrder = "order by public_id"
 	}
 
 	var whereClause string
 	if len(where) > 0 {
-		whereClause = " and " + strings.Join(where, " and ")
+		whereClause = "where " + strings.Join(where, " and ")
 	}
 	q := sessionList
-	query := fmt.Sprintf(q, limit, whereClause, withOrder)
+	query := fmt.Sprintf(q, whereClause, limit, withOrder)
 
 	rows, err := r.reader.Query(ctx, query, args)
 	if err != nil {
@@ -613,6 +758,14 @@ func (r *Repository) CloseConnections(ctx context.Context, closeWith []CloseWith
 				if rowsUpdated != 1 {
 					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d would have been updated for connection %s", rowsUpdated, cw.ConnectionId))
 				}
+				if cw.Recording != nil {
+					recording := cw.Recording.Clone().(*ConnectionRecording)
+					recording.ConnectionId = cw.ConnectionId
+					if err := w.Create(ctx, recording); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to create recording for connection %s", cw.ConnectionId)))
+					}
+					updateConnection.Recording = recording
+				}
 				states, err := fetchConnectionStates(ctx, reader, cw.ConnectionId, db.WithOrder("start_time desc"))
 				if err != nil {
 					return errors.Wrap(ctx, err, op)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/host/static"
 	staticStore "github.com/hashicorp/boundary/internal/host/static/store"
 	"github.com/hashicorp/boundary/internal/iam"
@@ -210,6 +211,41 @@ func TestRepository_ListSession(t *testing.T) {
 		assert.Equal(StatusActive, got[0].States[0].Status)
 		assert.Equal(StatusPending, got[0].States[1].Status)
 	})
+	t.Run("withFilterConditions", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		require.NoError(conn.Where("1=1").Delete(AllocSession()).Error)
+		var active []string
+		for i := 0; i < 6; i++ {
+			s := TestSession(t, conn, wrapper, composedOf)
+			if i%2 == 0 {
+				_ = TestState(t, conn, s.PublicId, StatusActive)
+				active = append(active, s.PublicId)
+			}
+		}
+		got, err := repo.ListSessions(context.Background(), WithFilterConditions([]filter.Condition{
+			{Field: "status", Operator: filter.EqualOperator, Value: StatusActive.String()},
+			{Field: "user_id", Operator: filter.EqualOperator, Value: composedOf.UserId},
+		}))
+		require.NoError(err)
+		var gotIds []string
+		for _, s := range got {
+			gotIds = append(gotIds, s.PublicId)
+			assert.Equal(StatusActive, s.States[0].Status)
+		}
+		assert.ElementsMatch(active, gotIds)
+
+		got, err = repo.ListSessions(context.Background(), WithFilterConditions([]filter.Condition{
+			{Field: "target_id", Operator: filter.ContainsOperator, Value: "not a target"},
+		}))
+		require.NoError(err)
+		assert.Empty(got)
+
+		_, err = repo.ListSessions(context.Background(), WithFilterConditions([]filter.Condition{
+			{Field: "endpoint", Operator: filter.EqualOperator, Value: "tcp://localhost"},
+		}))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
 	t.Run("withServerId", func(t *testing.T) {
 		assert, require := assert.New(t), require.New(t)
 		require.NoError(conn.Where("1=1").Delete(AllocSession()).Error)
@@ -425,6 +461,95 @@ func TestRepository_CreateSession(t *testing.T) {
 			}
 		})
 	}
+	t.Run("withUserSessionLimit", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		c := TestSessionParams(t, conn, wrapper, iamRepo)
+		newSession := func() *Session {
+			s, err := New(c)
+			require.NoError(err)
+			return s
+		}
+
+		first, _, err := repo.CreateSession(context.Background(), wrapper, newSession(), WithUserSessionLimit(2))
+		require.NoError(err)
+		_, _, err = repo.CreateSession(context.Background(), wrapper, newSession(), WithUserSessionLimit(2))
+		require.NoError(err)
+
+		ses, _, err := repo.CreateSession(context.Background(), wrapper, newSession(), WithUserSessionLimit(2))
+		require.Error(err)
+		assert.Nil(ses)
+		assert.True(errors.Match(errors.T(errors.SessionLimitExceeded), err))
+
+		// terminated sessions do not count against the limit
+		_, err = repo.TerminateSession(context.Background(), first.PublicId, first.Version, ClosedByUser)
+		require.NoError(err)
+		_, _, err = repo.CreateSession(context.Background(), wrapper, newSession(), WithUserSessionLimit(2))
+		require.NoError(err)
+
+		// without the option the limit is not enforced
+		_, _, err = repo.CreateSession(context.Background(), wrapper, newSession())
+		require.NoError(err)
+	})
+}
+
+func TestRepository_HostSelectionCounts(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	// Add a second host to the host set of the target of the sessions.
+	c := TestSessionParams(t, conn, wrapper, iamRepo)
+	staticRepo, err := static.NewRepository(rw, rw, kms)
+	require.NoError(err)
+	set, _, err := staticRepo.LookupSet(ctx, c.HostSetId)
+	require.NoError(err)
+	other := static.TestHosts(t, conn, set.GetCatalogId(), 1)[0]
+	_ = static.TestSetMembers(t, conn, set.GetPublicId(), []*static.Host{other})
+	hostIds := []string{c.HostId, other.GetPublicId()}
+
+	counts, err := repo.ActiveSessionCountsByHost(ctx, hostIds)
+	require.NoError(err)
+	assert.Empty(counts)
+	times, err := repo.LastSessionTimesByHost(ctx, c.TargetId, hostIds)
+	require.NoError(err)
+	assert.Empty(times)
+
+	first := TestSession(t, conn, wrapper, c)
+	_ = TestSession(t, conn, wrapper, c)
+	c.HostId = other.GetPublicId()
+	last := TestSession(t, conn, wrapper, c)
+
+	counts, err = repo.ActiveSessionCountsByHost(ctx, hostIds)
+	require.NoError(err)
+	assert.Equal(map[string]int{hostIds[0]: 2, hostIds[1]: 1}, counts)
+	times, err = repo.LastSessionTimesByHost(ctx, c.TargetId, hostIds)
+	require.NoError(err)
+	require.Len(times, 2)
+	assert.True(last.CreateTime.AsTime().Equal(times[hostIds[1]]))
+
+	// Terminated sessions are not counted.
+	_, err = repo.TerminateSession(ctx, first.PublicId, first.Version, ClosedByUser)
+	require.NoError(err)
+	counts, err = repo.ActiveSessionCountsByHost(ctx, hostIds)
+	require.NoError(err)
+	assert.Equal(1, counts[hostIds[0]])
+
+	// Sessions of other targets are not considered.
+	times, err = repo.LastSessionTimesByHost(ctx, "ttcp_1234567890", hostIds)
+	require.NoError(err)
+	assert.Empty(times)
+
+	_, err = repo.ActiveSessionCountsByHost(ctx, nil)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
+	_, err = repo.LastSessionTimesByHost(ctx, "", hostIds)
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error: %v", err)
 }
 
 func TestRepository_updateState(t *testing.T) {
@@ -1006,6 +1131,76 @@ func TestRepository_TerminateCompletedSessions(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "sessions-with-bytes-limit-closed-connections",
+			setup: func() testArgs {
+				wantTermed := map[string]TerminationReason{}
+				// make one with unlimited connections closed at the bytes limit
+				s := setupFn(-1, time.Hour+1, true)
+				found, _, err := repo.LookupSession(context.Background(), s.PublicId)
+				require.NoError(t, err)
+				require.Len(t, found.Connections, 1)
+				_, err = repo.CloseConnections(context.Background(), []CloseWith{
+					{
+						ConnectionId: found.Connections[0].PublicId,
+						BytesUp:      1,
+						BytesDown:    1,
+						ClosedReason: ConnectionBytesLimit,
+					},
+				})
+				require.NoError(t, err)
+				wantTermed[s.PublicId] = BytesLimit
+
+				// make one with unlimited connections closed by the user
+				s2 := setupFn(-1, time.Hour+1, false)
+				return testArgs{
+					sessions:   []*Session{s, s2},
+					wantTermed: wantTermed,
+				}
+			},
+		},
+		{
+			name: "sessions-with-idle-timeout-closed-connections",
+			setup: func() testArgs {
+				wantTermed := map[string]TerminationReason{}
+				// make one with a limit of one connection closed at the idle
+				// timeout
+				s := setupFn(1, time.Hour+1, true)
+				found, _, err := repo.LookupSession(context.Background(), s.PublicId)
+				require.NoError(t, err)
+				require.Len(t, found.Connections, 1)
+				_, err = repo.CloseConnections(context.Background(), []CloseWith{
+					{
+						ConnectionId: found.Connections[0].PublicId,
+						BytesUp:      1,
+						BytesDown:    1,
+						ClosedReason: ConnectionIdleTimeout,
+					},
+				})
+				require.NoError(t, err)
+				wantTermed[s.PublicId] = IdleTimeout
+
+				// make one with unlimited connections closed at the idle
+				// timeout, which the user can reconnect to
+				s2 := setupFn(-1, time.Hour+1, true)
+				found, _, err = repo.LookupSession(context.Background(), s2.PublicId)
+				require.NoError(t, err)
+				require.Len(t, found.Connections, 1)
+				_, err = repo.CloseConnections(context.Background(), []CloseWith{
+					{
+						ConnectionId: found.Connections[0].PublicId,
+						BytesUp:      1,
+						BytesDown:    1,
+						ClosedReason: ConnectionIdleTimeout,
+					},
+				})
+				require.NoError(t, err)
+				return testArgs{
+					sessions:   []*Session{s, s2},
+					wantTermed: wantTermed,
+				}
+			},
+		},
 		{
 			name: "sessions-with-unlimited-connections",
 			setup: func() testArgs {
@@ -1121,6 +1316,34 @@ func TestRepository_CloseConnections(t *testing.T) {
 			closeWith: setupFn(2),
 			reason:    ClosedByUser,
 		},
+		{
+			name: "valid-with-recording",
+			closeWith: func() []CloseWith {
+				cw := setupFn(2)
+				cw[0].Recording = &ConnectionRecording{
+					StoragePath: "/recordings/" + cw[0].ConnectionId + ".rec",
+					SizeBytes:   1024,
+					Checksum:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					StartTime:   timestamp.Now(),
+					EndTime:     timestamp.Now(),
+				}
+				return cw
+			}(),
+			reason: ClosedByUser,
+		},
+		{
+			name: "invalid-recording",
+			closeWith: func() []CloseWith {
+				cw := setupFn(1)
+				cw[0].Recording = &ConnectionRecording{
+					SizeBytes: 1024,
+				}
+				return cw
+			}(),
+			reason:      ClosedByUser,
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
 		{
 			name:        "empty-closed-with",
 			closeWith:   []CloseWith{},
@@ -1151,10 +1374,33 @@ func TestRepository_CloseConnections(t *testing.T) {
 			}
 			require.NoError(err)
 			assert.Equal(len(tt.closeWith), len(resp))
-			for _, r := range resp {
+			for i, r := range resp {
 				require.NotNil(r.Connection)
 				require.NotNil(r.ConnectionStates)
 				assert.Equal(StatusClosed, r.ConnectionStates[0].Status)
+				if tt.closeWith[i].Recording == nil {
+					assert.Nil(r.Connection.Recording)
+					continue
+				}
+				require.NotNil(r.Connection.Recording)
+				assert.Equal(tt.closeWith[i].ConnectionId, r.Connection.Recording.ConnectionId)
+
+				c, _, err := repo.LookupConnection(context.Background(), tt.closeWith[i].ConnectionId)
+				require.NoError(err)
+				s, _, err := repo.LookupSession(context.Background(), c.SessionId)
+				require.NoError(err)
+				var found bool
+				for _, sc := range s.Connections {
+					if sc.PublicId != c.PublicId {
+						continue
+					}
+					found = true
+					require.NotNil(sc.Recording)
+					assert.Equal(tt.closeWith[i].Recording.StoragePath, sc.Recording.StoragePath)
+					assert.Equal(tt.closeWith[i].Recording.SizeBytes, sc.Recording.SizeBytes)
+					assert.Equal(tt.closeWith[i].Recording.Checksum, sc.Recording.Checksum)
+				}
+				assert.True(found)
 			}
 		})
 	}
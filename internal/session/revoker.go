@@ -0,0 +1,134 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/auth"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/observability/event"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var _ auth.AccessRevoker = (*AccessRevoker)(nil)
+
+// AccessRevoker revokes the access of users by canceling their pending and
+// active sessions and deleting their auth tokens. An audit event is written
+// for every revocation.
+type AccessRevoker struct{}
+
+// NewAccessRevoker creates a new AccessRevoker.
+func NewAccessRevoker() *AccessRevoker {
+	return &AccessRevoker{}
+}
+
+// RevokeUserAccess cancels the pending and active sessions of the user and
+// deletes the auth tokens of all of its accounts using w, the writer of the
+// transaction deleting or disabling the user.
+func (r *AccessRevoker) RevokeUserAccess(ctx context.Context, w db.Writer, userId string) error {
+	const op = "session.(AccessRevoker).RevokeUserAccess"
+	if userId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	if w == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+	canceled, err := cancelUserSessionsTx(ctx, w, userId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	deleted, err := authtoken.DeleteAuthTokensForUserTx(ctx, w, userId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	writeRevokeAudit(ctx, op, map[string]interface{}{
+		"user_id":             userId,
+		"canceled_sessions":   canceled,
+		"deleted_auth_tokens": deleted,
+	})
+	return nil
+}
+
+// RevokeAccountAccess cancels the pending and active sessions of the user
+// associated with the account, if any, and deletes the auth tokens of the
+// account using w, the writer of the transaction deleting or disabling the
+// account.
+func (r *AccessRevoker) RevokeAccountAccess(ctx context.Context, w db.Writer, accountId string) error {
+	const op = "session.(AccessRevoker).RevokeAccountAccess"
+	if accountId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if w == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing writer")
+	}
+	userId, err := accountUserId(ctx, w, accountId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	details := map[string]interface{}{
+		"account_id": accountId,
+	}
+	if userId != "" {
+		canceled, err := cancelUserSessionsTx(ctx, w, userId)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		details["user_id"] = userId
+		details["canceled_sessions"] = canceled
+	}
+	deleted, err := authtoken.DeleteAuthTokensForAccountTx(ctx, w, accountId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	details["deleted_auth_tokens"] = deleted
+	writeRevokeAudit(ctx, op, details)
+	return nil
+}
+
+// accountUserId returns the id of the user associated with the account, or an
+// empty string if there is none.
+func accountUserId(ctx context.Context, w db.Writer, accountId string) (string, error) {
+	const op = "session.accountUserId"
+	const query = `select iam_user_id from auth_account where public_id = ?`
+	rows, err := w.Query(ctx, query, []interface{}{accountId})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var userId sql.NullString
+	for rows.Next() {
+		if err := rows.Scan(&userId); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return userId.String, nil
+}
+
+// cancelUserSessionsTx cancels the pending and active sessions of the user
+// using w, returning the number of sessions canceled.
+func cancelUserSessionsTx(ctx context.Context, w db.Writer, userId string) (int, error) {
+	const op = "session.cancelUserSessionsTx"
+	canceled, err := w.Exec(ctx, cancelUserSessions, []interface{}{sql.Named("user_id", userId)})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to cancel sessions of %s", userId)))
+	}
+	return canceled, nil
+}
+
+// writeRevokeAudit writes an audit event with the details of a revocation.
+func writeRevokeAudit(ctx context.Context, op event.Op, details map[string]interface{}) {
+	d, err := structpb.NewStruct(details)
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to create audit event details"))
+		return
+	}
+	if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{Operation: string(op), Details: d})); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write audit event"))
+	}
+}
@@ -0,0 +1,93 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessRevoker(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	kms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+	tokenRepo, err := authtoken.NewRepository(rw, rw, kms)
+	require.NoError(t, err)
+
+	revoker := NewAccessRevoker()
+	assert.Error(t, revoker.RevokeUserAccess(ctx, rw, ""))
+	assert.Error(t, revoker.RevokeAccountAccess(ctx, rw, ""))
+	assert.Error(t, revoker.RevokeUserAccess(ctx, nil, "u_1234567890"))
+	assert.Error(t, revoker.RevokeAccountAccess(ctx, nil, "acctpw_1234567890"))
+
+	tests := []struct {
+		name   string
+		revoke func(db.Writer, ComposedOf, *authtoken.AuthToken) error
+	}{
+		{
+			name: "user",
+			revoke: func(w db.Writer, c ComposedOf, _ *authtoken.AuthToken) error {
+				return revoker.RevokeUserAccess(ctx, w, c.UserId)
+			},
+		},
+		{
+			name: "account",
+			revoke: func(w db.Writer, _ ComposedOf, at *authtoken.AuthToken) error {
+				return revoker.RevokeAccountAccess(ctx, w, at.GetAuthAccountId())
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			params := TestSessionParams(t, conn, wrapper, iamRepo)
+			pending := TestSession(t, conn, wrapper, params)
+			terminated := TestSession(t, conn, wrapper, params)
+			_, err := repo.TerminateSession(ctx, terminated.PublicId, terminated.Version, ClosedByUser)
+			require.NoError(err)
+			at, err := tokenRepo.LookupAuthToken(ctx, params.AuthTokenId)
+			require.NoError(err)
+			require.NotNil(at)
+
+			// The revocation is rolled back with the transaction it is part
+			// of.
+			rollback := errors.New("rollback")
+			_, err = rw.DoTx(ctx, 0, db.ExpBackoff{}, func(_ db.Reader, w db.Writer) error {
+				require.NoError(tt.revoke(w, params, at))
+				return rollback
+			})
+			require.ErrorIs(err, rollback)
+			found, _, err := repo.LookupSession(ctx, pending.PublicId)
+			require.NoError(err)
+			assert.Equal(StatusPending, found.States[0].Status)
+			at, err = tokenRepo.LookupAuthToken(ctx, params.AuthTokenId)
+			require.NoError(err)
+			require.NotNil(at)
+
+			require.NoError(tt.revoke(rw, params, at))
+
+			found, _, err = repo.LookupSession(ctx, pending.PublicId)
+			require.NoError(err)
+			assert.Equal(StatusCanceling, found.States[0].Status)
+			found, _, err = repo.LookupSession(ctx, terminated.PublicId)
+			require.NoError(err)
+			assert.Equal(StatusTerminated, found.States[0].Status)
+			at, err = tokenRepo.LookupAuthToken(ctx, params.AuthTokenId)
+			require.NoError(err)
+			assert.Nil(at)
+		})
+	}
+}
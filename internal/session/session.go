@@ -44,11 +44,25 @@ type ComposedOf struct {
 	ExpirationTime *timestamp.Timestamp
 	// Max connections for the session
 	ConnectionLimit int32
+	// Max bytes sent by the clients of the session. -1 means no limit.
+	MaxBytesUp int64
+	// Max bytes received by the clients of the session. -1 means no limit.
+	MaxBytesDown int64
+	// Seconds after which an idle connection of the session is closed. 0
+	// means no timeout.
+	ConnectionIdleTimeoutSeconds uint32
+	// Host selection strategy of the target which chose the host of the
+	// session. Empty when the host was requested by the user.
+	HostSelectionStrategy string
 	// Worker filter. Active filter when the session was created, used to
 	// validate the session via the same set of rules at consumption time as
 	// existed at creation time. Round tripping it through here saves a lookup
 	// in the DB. It is not stored in the warehouse.
 	WorkerFilter string
+	// EndpointKnownHosts are the host keys trusted for the endpoint, in the
+	// known_hosts format. Like the endpoint, it is round tripped to the worker
+	// and is not stored in the warehouse.
+	EndpointKnownHosts string
 	// DynamicCredentials are dynamic credentials that will be retrieved
 	// for the session. DynamicCredentials optional.
 	DynamicCredentials []*DynamicCredential
@@ -96,8 +110,18 @@ type Session struct {
 	Endpoint string `json:"-" gorm:"default:null"`
 	// Maximum number of connections in a session
 	ConnectionLimit int32 `json:"connection_limit,omitempty" gorm:"default:null"`
+	// Maximum number of bytes sent by the clients of the session
+	MaxBytesUp int64 `json:"max_bytes_up,omitempty" gorm:"default:null"`
+	// Maximum number of bytes received by the clients of the session
+	MaxBytesDown int64 `json:"max_bytes_down,omitempty" gorm:"default:null"`
+	// Maximum number of seconds a connection of the session can stay idle
+	ConnectionIdleTimeoutSeconds uint32 `json:"connection_idle_timeout_seconds,omitempty" gorm:"default:null"`
+	// Host selection strategy which chose the host of the session
+	HostSelectionStrategy string `json:"host_selection_strategy,omitempty" gorm:"default:null"`
 	// Worker filter
 	WorkerFilter string `json:"-" gorm:"default:null"`
+	// Host keys trusted for the endpoint
+	EndpointKnownHosts string `json:"-" gorm:"default:null"`
 
 	// key_id is the key ID that was used for the encryption operation. It can be
 	// used to identify a specific version of the key needed to decrypt the value,
@@ -131,17 +155,22 @@ var (
 func New(c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:             c.UserId,
-		HostId:             c.HostId,
-		TargetId:           c.TargetId,
-		HostSetId:          c.HostSetId,
-		AuthTokenId:        c.AuthTokenId,
-		ScopeId:            c.ScopeId,
-		Endpoint:           c.Endpoint,
-		ExpirationTime:     c.ExpirationTime,
-		ConnectionLimit:    c.ConnectionLimit,
-		WorkerFilter:       c.WorkerFilter,
-		DynamicCredentials: c.DynamicCredentials,
+		UserId:                       c.UserId,
+		HostId:                       c.HostId,
+		TargetId:                     c.TargetId,
+		HostSetId:                    c.HostSetId,
+		AuthTokenId:                  c.AuthTokenId,
+		ScopeId:                      c.ScopeId,
+		Endpoint:                     c.Endpoint,
+		ExpirationTime:               c.ExpirationTime,
+		ConnectionLimit:              c.ConnectionLimit,
+		MaxBytesUp:                   c.MaxBytesUp,
+		MaxBytesDown:                 c.MaxBytesDown,
+		ConnectionIdleTimeoutSeconds: c.ConnectionIdleTimeoutSeconds,
+		HostSelectionStrategy:        c.HostSelectionStrategy,
+		WorkerFilter:                 c.WorkerFilter,
+		EndpointKnownHosts:           c.EndpointKnownHosts,
+		DynamicCredentials:           c.DynamicCredentials,
 	}
 	if err := s.validateNewSession(); err != nil {
 		return nil, errors.WrapDeprecated(err, op)
@@ -157,21 +186,26 @@ func AllocSession() Session {
 // Clone creates a clone of the Session
 func (s *Session) Clone() interface{} {
 	clone := &Session{
-		PublicId:          s.PublicId,
-		UserId:            s.UserId,
-		HostId:            s.HostId,
-		ServerId:          s.ServerId,
-		ServerType:        s.ServerType,
-		TargetId:          s.TargetId,
-		HostSetId:         s.HostSetId,
-		AuthTokenId:       s.AuthTokenId,
-		ScopeId:           s.ScopeId,
-		TerminationReason: s.TerminationReason,
-		Version:           s.Version,
-		Endpoint:          s.Endpoint,
-		ConnectionLimit:   s.ConnectionLimit,
-		WorkerFilter:      s.WorkerFilter,
-		KeyId:             s.KeyId,
+		PublicId:                     s.PublicId,
+		UserId:                       s.UserId,
+		HostId:                       s.HostId,
+		ServerId:                     s.ServerId,
+		ServerType:                   s.ServerType,
+		TargetId:                     s.TargetId,
+		HostSetId:                    s.HostSetId,
+		AuthTokenId:                  s.AuthTokenId,
+		ScopeId:                      s.ScopeId,
+		TerminationReason:            s.TerminationReason,
+		Version:                      s.Version,
+		Endpoint:                     s.Endpoint,
+		ConnectionLimit:              s.ConnectionLimit,
+		MaxBytesUp:                   s.MaxBytesUp,
+		MaxBytesDown:                 s.MaxBytesDown,
+		ConnectionIdleTimeoutSeconds: s.ConnectionIdleTimeoutSeconds,
+		HostSelectionStrategy:        s.HostSelectionStrategy,
+		WorkerFilter:                 s.WorkerFilter,
+		EndpointKnownHosts:           s.EndpointKnownHosts,
+		KeyId:                        s.KeyId,
 	}
 	if len(s.States) > 0 {
 		clone.States = make([]*State, 0, len(s.States))
@@ -268,8 +302,18 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "expiration time is immutable")
 		case contains(opts.WithFieldMaskPaths, "ConnectionLimit"):
 			return errors.New(ctx, errors.InvalidParameter, op, "connection limit is immutable")
+		case contains(opts.WithFieldMaskPaths, "MaxBytesUp"):
+			return errors.New(ctx, errors.InvalidParameter, op, "max bytes up is immutable")
+		case contains(opts.WithFieldMaskPaths, "MaxBytesDown"):
+			return errors.New(ctx, errors.InvalidParameter, op, "max bytes down is immutable")
+		case contains(opts.WithFieldMaskPaths, "ConnectionIdleTimeoutSeconds"):
+			return errors.New(ctx, errors.InvalidParameter, op, "connection idle timeout is immutable")
+		case contains(opts.WithFieldMaskPaths, "HostSelectionStrategy"):
+			return errors.New(ctx, errors.InvalidParameter, op, "host selection strategy is immutable")
 		case contains(opts.WithFieldMaskPaths, "WorkerFilter"):
 			return errors.New(ctx, errors.InvalidParameter, op, "worker filter is immutable")
+		case contains(opts.WithFieldMaskPaths, "EndpointKnownHosts"):
+			return errors.New(ctx, errors.InvalidParameter, op, "endpoint known hosts are immutable")
 		case contains(opts.WithFieldMaskPaths, "DynamicCredentials"):
 			return errors.New(ctx, errors.InvalidParameter, op, "dynamic credentials are immutable")
 		case contains(opts.WithFieldMaskPaths, "TerminationReason"):
@@ -406,26 +450,27 @@ func (s *Session) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
 
 type sessionListView struct {
 	// Session fields
-	PublicId          string               `json:"public_id,omitempty" gorm:"primary_key"`
-	UserId            string               `json:"user_id,omitempty" gorm:"default:null"`
-	HostId            string               `json:"host_id,omitempty" gorm:"default:null"`
-	ServerId          string               `json:"server_id,omitempty" gorm:"default:null"`
-	ServerType        string               `json:"server_type,omitempty" gorm:"default:null"`
-	TargetId          string               `json:"target_id,omitempty" gorm:"default:null"`
-	HostSetId         string               `json:"host_set_id,omitempty" gorm:"default:null"`
-	AuthTokenId       string               `json:"auth_token_id,omitempty" gorm:"default:null"`
-	ScopeId           string               `json:"scope_id,omitempty" gorm:"default:null"`
-	Certificate       []byte               `json:"certificate,omitempty" gorm:"default:null"`
-	ExpirationTime    *timestamp.Timestamp `json:"expiration_time,omitempty" gorm:"default:null"`
-	CtTofuToken       []byte               `json:"ct_tofu_token,omitempty" gorm:"column:tofu_token;default:null" wrapping:"ct,tofu_token"`
-	TofuToken         []byte               `json:"tofu_token,omitempty" gorm:"-" wrapping:"pt,tofu_token"`
-	TerminationReason string               `json:"termination_reason,omitempty" gorm:"default:null"`
-	CreateTime        *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
-	UpdateTime        *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
-	Version           uint32               `json:"version,omitempty" gorm:"default:null"`
-	Endpoint          string               `json:"-" gorm:"default:null"`
-	ConnectionLimit   int32                `json:"connection_limit,omitempty" gorm:"default:null"`
-	KeyId             string               `json:"key_id,omitempty" gorm:"not_null"`
+	PublicId              string               `json:"public_id,omitempty" gorm:"primary_key"`
+	UserId                string               `json:"user_id,omitempty" gorm:"default:null"`
+	HostId                string               `json:"host_id,omitempty" gorm:"default:null"`
+	ServerId              string               `json:"server_id,omitempty" gorm:"default:null"`
+	ServerType            string               `json:"server_type,omitempty" gorm:"default:null"`
+	TargetId              string               `json:"target_id,omitempty" gorm:"default:null"`
+	HostSetId             string               `json:"host_set_id,omitempty" gorm:"default:null"`
+	AuthTokenId           string               `json:"auth_token_id,omitempty" gorm:"default:null"`
+	ScopeId               string               `json:"scope_id,omitempty" gorm:"default:null"`
+	Certificate           []byte               `json:"certificate,omitempty" gorm:"default:null"`
+	ExpirationTime        *timestamp.Timestamp `json:"expiration_time,omitempty" gorm:"default:null"`
+	CtTofuToken           []byte               `json:"ct_tofu_token,omitempty" gorm:"column:tofu_token;default:null" wrapping:"ct,tofu_token"`
+	TofuToken             []byte               `json:"tofu_token,omitempty" gorm:"-" wrapping:"pt,tofu_token"`
+	TerminationReason     string               `json:"termination_reason,omitempty" gorm:"default:null"`
+	CreateTime            *timestamp.Timestamp `json:"create_time,omitempty" gorm:"default:current_timestamp"`
+	UpdateTime            *timestamp.Timestamp `json:"update_time,omitempty" gorm:"default:current_timestamp"`
+	Version               uint32               `json:"version,omitempty" gorm:"default:null"`
+	Endpoint              string               `json:"-" gorm:"default:null"`
+	ConnectionLimit       int32                `json:"connection_limit,omitempty" gorm:"default:null"`
+	KeyId                 string               `json:"key_id,omitempty" gorm:"not_null"`
+	HostSelectionStrategy string               `json:"host_selection_strategy,omitempty" gorm:"default:null"`
 
 	// State fields
 	Status          string               `json:"state,omitempty" gorm:"column:state"`
@@ -442,6 +487,13 @@ type sessionListView struct {
 	BytesUp            uint64 `json:"bytes_up,omitempty" gorm:"default:null"`
 	BytesDown          uint64 `json:"bytes_down,omitempty" gorm:"default:null"`
 	ClosedReason       string `json:"closed_reason,omitempty" gorm:"default:null"`
+
+	// Connection recording fields
+	RecordingStoragePath string               `json:"recording_storage_path,omitempty" gorm:"default:null"`
+	RecordingSizeBytes   uint64               `json:"recording_size_bytes,omitempty" gorm:"default:null"`
+	RecordingChecksum    string               `json:"recording_checksum,omitempty" gorm:"default:null"`
+	RecordingStartTime   *timestamp.Timestamp `json:"recording_start_time,omitempty" gorm:"default:null"`
+	RecordingEndTime     *timestamp.Timestamp `json:"recording_end_time,omitempty" gorm:"default:null"`
 }
 
 // TableName returns the tablename to override the default gorm table name
@@ -18,6 +18,8 @@ const (
 	SystemError        TerminationReason = "system error"
 	ConnectionLimit    TerminationReason = "connection limit"
 	SessionCanceled    TerminationReason = "canceled"
+	BytesLimit         TerminationReason = "bytes limit"
+	IdleTimeout        TerminationReason = "idle timeout"
 )
 
 // String representation of the termination reason
@@ -42,6 +44,10 @@ func convertToReason(s string) (TerminationReason, error) {
 		return SystemError, nil
 	case ConnectionLimit.String():
 		return ConnectionLimit, nil
+	case BytesLimit.String():
+		return BytesLimit, nil
+	case IdleTimeout.String():
+		return IdleTimeout, nil
 	default:
 		return "", errors.NewDeprecated(errors.InvalidParameter, op, fmt.Sprintf("%s is not a valid reason", s))
 	}
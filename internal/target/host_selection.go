@@ -0,0 +1,45 @@
+package target
+
+// HostSelectionStrategy is the strategy used to choose the host of a new
+// session among the hosts of the host sources of a target.
+type HostSelectionStrategy string
+
+func (s HostSelectionStrategy) String() string {
+	return string(s)
+}
+
+const (
+	// RandomHostSelection chooses a host at random.
+	RandomHostSelection HostSelectionStrategy = "random"
+
+	// RoundRobinHostSelection chooses the host which has gone the longest
+	// without a session for the target.
+	RoundRobinHostSelection HostSelectionStrategy = "round_robin"
+
+	// LeastSessionsHostSelection chooses the host with the fewest pending or
+	// active sessions.
+	LeastSessionsHostSelection HostSelectionStrategy = "least_sessions"
+
+	// StickyPerUserHostSelection keeps choosing the same host for a user as
+	// long as the host is available.
+	StickyPerUserHostSelection HostSelectionStrategy = "sticky_per_user"
+)
+
+// ValidHostSelectionStrategies are the set of all HostSelectionStrategies.
+var ValidHostSelectionStrategies = []HostSelectionStrategy{
+	RandomHostSelection,
+	RoundRobinHostSelection,
+	LeastSessionsHostSelection,
+	StickyPerUserHostSelection,
+}
+
+// ValidHostSelectionStrategy returns true if s is a known host selection
+// strategy.
+func ValidHostSelectionStrategy(s string) bool {
+	for _, v := range ValidHostSelectionStrategies {
+		if string(v) == s {
+			return true
+		}
+	}
+	return false
+}
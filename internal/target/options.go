@@ -3,6 +3,7 @@ package target
 import (
 	"time"
 
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 )
 
@@ -31,10 +32,19 @@ type options struct {
 	WithType                   subtypes.Subtype
 	WithHostSources            []string
 	WithCredentialLibraries    []*CredentialLibrary
+	WithStaticCredentials      []*StaticCredential
 	WithSessionMaxSeconds      uint32
 	WithSessionConnectionLimit int32
+	WithUserSessionLimit       int32
+	WithSessionMaxBytesUp      int64
+	WithSessionMaxBytesDown    int64
+	WithConnectionIdleTimeout  uint32
+	WithHostSelectionStrategy  HostSelectionStrategy
+	WithKnownHosts             string
 	WithPublicId               string
 	WithWorkerFilter           string
+	WithStartPageAfterId       string
+	WithFilterConditions       []filter.Condition
 }
 
 func getDefaultOptions() options {
@@ -50,10 +60,18 @@ func getDefaultOptions() options {
 		WithType:                   "",
 		WithHostSources:            nil,
 		WithCredentialLibraries:    nil,
+		WithStaticCredentials:      nil,
 		WithSessionMaxSeconds:      uint32((8 * time.Hour).Seconds()),
 		WithSessionConnectionLimit: 1,
+		WithUserSessionLimit:       -1,
+		WithSessionMaxBytesUp:      -1,
+		WithSessionMaxBytesDown:    -1,
+		WithConnectionIdleTimeout:  0,
+		WithHostSelectionStrategy:  RandomHostSelection,
+		WithKnownHosts:             "",
 		WithPublicId:               "",
 		WithWorkerFilter:           "",
+		WithStartPageAfterId:       "",
 	}
 }
 
@@ -71,6 +89,22 @@ func WithName(name string) Option {
 	}
 }
 
+// WithStartPageAfterId provides an option to only list the items with a
+// public id greater than id. It is used to read the pages of a list.
+func WithStartPageAfterId(id string) Option {
+	return func(o *options) {
+		o.WithStartPageAfterId = id
+	}
+}
+
+// WithFilterConditions provides an option to only list the items matching
+// the conditions of a list filter which can be evaluated by the database.
+func WithFilterConditions(c []filter.Condition) Option {
+	return func(o *options) {
+		o.WithFilterConditions = c
+	}
+}
+
 // WithLimit provides an option to provide a limit.  Intentionally allowing
 // negative integers.   If WithLimit < 0, then unlimited results are returned.
 // If WithLimit == 0, then default limits are used for results.
@@ -136,6 +170,14 @@ func WithCredentialLibraries(cl []*CredentialLibrary) Option {
 	}
 }
 
+// WithStaticCredentials provides an option for providing a list of static
+// credentials.
+func WithStaticCredentials(c []*StaticCredential) Option {
+	return func(o *options) {
+		o.WithStaticCredentials = c
+	}
+}
+
 func WithSessionMaxSeconds(dur uint32) Option {
 	return func(o *options) {
 		o.WithSessionMaxSeconds = dur
@@ -148,6 +190,54 @@ func WithSessionConnectionLimit(limit int32) Option {
 	}
 }
 
+// WithUserSessionLimit provides an optional limit on the number of pending or
+// active sessions a user can have at once for a target. -1 means no limit.
+func WithUserSessionLimit(limit int32) Option {
+	return func(o *options) {
+		o.WithUserSessionLimit = limit
+	}
+}
+
+// WithSessionMaxBytesUp provides an optional limit on the number of bytes the
+// clients of a session can send. -1 means no limit.
+func WithSessionMaxBytesUp(max int64) Option {
+	return func(o *options) {
+		o.WithSessionMaxBytesUp = max
+	}
+}
+
+// WithSessionMaxBytesDown provides an optional limit on the number of bytes
+// the clients of a session can receive. -1 means no limit.
+func WithSessionMaxBytesDown(max int64) Option {
+	return func(o *options) {
+		o.WithSessionMaxBytesDown = max
+	}
+}
+
+// WithConnectionIdleTimeout provides an optional number of seconds after which
+// an idle session connection is closed. 0 means no timeout.
+func WithConnectionIdleTimeout(seconds uint32) Option {
+	return func(o *options) {
+		o.WithConnectionIdleTimeout = seconds
+	}
+}
+
+// WithHostSelectionStrategy provides an optional strategy used to choose the
+// host of a new session. Defaults to RandomHostSelection.
+func WithHostSelectionStrategy(s HostSelectionStrategy) Option {
+	return func(o *options) {
+		o.WithHostSelectionStrategy = s
+	}
+}
+
+// WithKnownHosts provides optional host keys trusted for the hosts of the
+// target, in the known_hosts format. It is only used by ssh targets.
+func WithKnownHosts(knownHosts string) Option {
+	return func(o *options) {
+		o.WithKnownHosts = knownHosts
+	}
+}
+
 // WithPublicId provides an optional public id
 func WithPublicId(id string) Option {
 	return func(o *options) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/target/store"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/stretchr/testify/assert"
@@ -57,6 +58,21 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithDefaultPort = uint32(22)
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStartPageAfterId", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithStartPageAfterId("ttcp_1234567890"))
+		testOpts := getDefaultOptions()
+		testOpts.WithStartPageAfterId = "ttcp_1234567890"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithFilterConditions", func(t *testing.T) {
+		assert := assert.New(t)
+		conds := []filter.Condition{{Field: "name", Operator: filter.EqualOperator, Value: "foo"}}
+		opts := GetOpts(WithFilterConditions(conds))
+		testOpts := getDefaultOptions()
+		testOpts.WithFilterConditions = conds
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithUserId", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithUserId("testId"))
@@ -108,6 +124,46 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithUserSessionLimit", func(t *testing.T) {
+		assert := assert.New(t)
+		// test default of -1
+		opts := GetOpts()
+		testOpts := getDefaultOptions()
+		testOpts.WithUserSessionLimit = -1
+		assert.Equal(opts, testOpts)
+
+		opts = GetOpts(WithUserSessionLimit(2))
+		testOpts.WithUserSessionLimit = 2
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionMaxBytesUp", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionMaxBytesUp(1 << 32))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionMaxBytesUp = 1 << 32
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSessionMaxBytesDown", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSessionMaxBytesDown(1 << 32))
+		testOpts := getDefaultOptions()
+		testOpts.WithSessionMaxBytesDown = 1 << 32
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithConnectionIdleTimeout", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithConnectionIdleTimeout(300))
+		testOpts := getDefaultOptions()
+		testOpts.WithConnectionIdleTimeout = 300
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithHostSelectionStrategy", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithHostSelectionStrategy(LeastSessionsHostSelection))
+		testOpts := getDefaultOptions()
+		testOpts.WithHostSelectionStrategy = LeastSessionsHostSelection
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithCredentialLibraries", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithCredentialLibraries([]*CredentialLibrary{
@@ -141,4 +197,25 @@ func Test_GetOpts(t *testing.T) {
 		}
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithStaticCredentials", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithStaticCredentials([]*StaticCredential{
+			{
+				StaticCredential: &store.StaticCredential{
+					CredentialStaticId: "alice",
+					CredentialPurpose:  string(credential.ApplicationPurpose),
+				},
+			},
+		}))
+		testOpts := getDefaultOptions()
+		testOpts.WithStaticCredentials = []*StaticCredential{
+			{
+				StaticCredential: &store.StaticCredential{
+					CredentialStaticId: "alice",
+					CredentialPurpose:  string(credential.ApplicationPurpose),
+				},
+			},
+		}
+		assert.Equal(opts, testOpts)
+	})
 }
@@ -43,5 +43,49 @@ final (action, library_id) as (
 )
 select * from final
 order by action, library_id;
+`
+
+	setStaticChangesQuery = `
+with
+set_credentials (credential_id) as (
+  -- returns the SET list
+  select public_id
+    from credential_static
+   where public_id in (%s)
+),
+current_credentials (credential_id) as (
+  -- returns the current list
+  select credential_static_id
+    from target_static_credential
+   where target_id          = @target_id
+     and credential_purpose = @purpose
+),
+keep_credentials (credential_id) as (
+  -- returns the KEEP list
+  select credential_id
+    from current_credentials
+   where credential_id in (select * from set_credentials)
+),
+delete_credentials (credential_id) as (
+  -- returns the DELETE list
+  select credential_id
+    from current_credentials
+   where credential_id not in (select * from set_credentials)
+),
+insert_credentials (credential_id) as (
+  -- returns the ADD list
+  select credential_id
+    from set_credentials
+   where credential_id not in (select * from keep_credentials)
+),
+final (action, credential_id) as (
+  select 'delete', credential_id
+    from delete_credentials
+   union
+  select 'add', credential_id
+    from insert_credentials
+)
+select * from final
+order by action, credential_id;
 `
 )
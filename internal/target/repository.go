@@ -8,10 +8,19 @@ import (
 	"github.com/hashicorp/boundary/internal/db"
 	dbcommon "github.com/hashicorp/boundary/internal/db/common"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/filter"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 )
 
+// filterColumns are the columns of the fields of a target which can be used
+// in the filter conditions of a list.
+var filterColumns = map[string]string{
+	"name":     "name",
+	"type":     "type",
+	"scope_id": "scope_id",
+}
+
 // Cloneable provides a cloning interface
 type Cloneable interface {
 	Clone() Target
@@ -138,7 +147,9 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	return subtype, hostSources, credSources, nil
 }
 
-// ListTargets in targets in a scope.  Supports the WithScopeId, WithLimit, WithType options.
+// ListTargets in targets in a scope, ordered by public id.  Supports the
+// WithScopeId, WithLimit, WithType, WithStartPageAfterId and
+// WithFilterConditions options.
 func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target, error) {
 	const op = "target.(Repository).ListTargets"
 	opts := GetOpts(opt...)
@@ -154,6 +165,16 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	if opts.WithType != "" {
 		where, args = append(where, "type = ?"), append(args, opts.WithType.String())
 	}
+	if opts.WithStartPageAfterId != "" {
+		where, args = append(where, "public_id > ?"), append(args, opts.WithStartPageAfterId)
+	}
+	if len(opts.WithFilterConditions) > 0 {
+		filterWhere, filterArgs, err := filter.Where(opts.WithFilterConditions, filterColumns)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		where, args = append(where, filterWhere), append(args, filterArgs...)
+	}
 
 	var foundTargets []*targetView
 	err := r.list(ctx, &foundTargets, strings.Join(where, " and "), args, opt...)
@@ -173,8 +194,8 @@ func (r *Repository) ListTargets(ctx context.Context, opt ...Option) ([]Target,
 	return targets, nil
 }
 
-// list will return a listing of resources and honor the WithLimit option or the
-// repo defaultLimit
+// list will return a listing of resources, ordered by public id, and honor the
+// WithLimit option or the repo defaultLimit
 func (r *Repository) list(ctx context.Context, resources interface{}, where string, args []interface{}, opt ...Option) error {
 	const op = "target.(Repository).list"
 	opts := GetOpts(opt...)
@@ -184,7 +205,7 @@ func (r *Repository) list(ctx context.Context, resources interface{}, where stri
 		// non-zero signals an override of the default limit for the repo.
 		limit = opts.WithLimit
 	}
-	dbOpts = append(dbOpts, db.WithLimit(limit))
+	dbOpts = append(dbOpts, db.WithLimit(limit), db.WithOrder("public_id"))
 	if err := r.reader.SearchWhere(ctx, resources, where, args, dbOpts...); err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -434,6 +455,12 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("defaultport", f):
 		case strings.EqualFold("sessionmaxseconds", f):
 		case strings.EqualFold("sessionconnectionlimit", f):
+		case strings.EqualFold("usersessionlimit", f):
+		case strings.EqualFold("sessionmaxbytesup", f):
+		case strings.EqualFold("sessionmaxbytesdown", f):
+		case strings.EqualFold("connectionidletimeoutseconds", f):
+		case strings.EqualFold("hostselectionstrategy", f):
+		case strings.EqualFold("knownhosts", f):
 		case strings.EqualFold("workerfilter", f):
 		default:
 			return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
@@ -442,15 +469,21 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbcommon.BuildUpdatePaths(
 		map[string]interface{}{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
+			"Name":                         target.GetName(),
+			"Description":                  target.GetDescription(),
+			"DefaultPort":                  target.GetDefaultPort(),
+			"SessionMaxSeconds":            target.GetSessionMaxSeconds(),
+			"SessionConnectionLimit":       target.GetSessionConnectionLimit(),
+			"UserSessionLimit":             target.GetUserSessionLimit(),
+			"SessionMaxBytesUp":            target.GetSessionMaxBytesUp(),
+			"SessionMaxBytesDown":          target.GetSessionMaxBytesDown(),
+			"ConnectionIdleTimeoutSeconds": target.GetConnectionIdleTimeoutSeconds(),
+			"HostSelectionStrategy":        target.GetHostSelectionStrategy(),
+			"KnownHosts":                   target.GetKnownHosts(),
+			"WorkerFilter":                 target.GetWorkerFilter(),
 		},
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "UserSessionLimit", "SessionMaxBytesUp", "SessionMaxBytesDown", "ConnectionIdleTimeoutSeconds"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
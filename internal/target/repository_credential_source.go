@@ -14,6 +14,20 @@ import (
 	"github.com/hashicorp/boundary/internal/target/store"
 )
 
+// staticCredentialsPurposeMap is used to group StaticCredentials by purpose.
+type staticCredentialsPurposeMap map[string][]*StaticCredential
+
+func newStaticCredentialsPurposeMap(creds []*StaticCredential) staticCredentialsPurposeMap {
+	scpm := make(staticCredentialsPurposeMap)
+	for _, p := range credential.ValidPurposes {
+		scpm[p.String()] = make([]*StaticCredential, 0)
+	}
+	for _, c := range creds {
+		scpm[c.CredentialPurpose] = append(scpm[c.CredentialPurpose], c)
+	}
+	return scpm
+}
+
 // credentialLibrariesPurposeMap is used to group CredentialLibraries by purpose.
 type credentialLibrariesPurposeMap map[string][]*CredentialLibrary
 
@@ -45,22 +59,30 @@ func newCredentialLibrariesPurposeMap(cls []*CredentialLibrary) credentialLibrar
 // and the list of credential sources attached to the target, after cIds are added,
 // will be returned on success.
 // The targetVersion must match the current version of the targetId in the repository.
-func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, _ ...Option) (Target, []HostSource, []CredentialSource, error) {
+// Static credentials can be added along with the credential libraries using the
+// WithStaticCredentials option.
+func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, opt ...Option) (Target, []HostSource, []CredentialSource, error) {
 	const op = "target.(Repository).AddTargetCredentialSources"
+	opts := GetOpts(opt...)
+	creds := opts.WithStaticCredentials
 	if targetId == "" {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
 	}
 	if targetVersion == 0 {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
-	if len(cls) == 0 {
-		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential libraries")
+	if len(cls) == 0 && len(creds) == 0 {
+		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing credential sources")
 	}
 
 	addCredLibs := make([]interface{}, 0, len(cls))
 	for _, cl := range cls {
 		addCredLibs = append(addCredLibs, cl)
 	}
+	addStaticCreds := make([]interface{}, 0, len(creds))
+	for _, c := range creds {
+		addStaticCreds = append(addStaticCreds, c)
+	}
 
 	t := allocTargetView()
 	t.PublicId = targetId
@@ -74,11 +96,11 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
 
-	vetCredentialLibraries, ok := subtypeRegistry.vetCredentialLibrariesFunc(t.Subtype())
+	vetCredentialSources, ok := subtypeRegistry.vetCredentialSourcesFunc(t.Subtype())
 	if !ok {
 		return nil, nil, nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
-	if err := vetCredentialLibraries(ctx, cls); err != nil {
+	if err := vetCredentialSources(ctx, cls, creds); err != nil {
 		return nil, nil, nil, err
 	}
 
@@ -120,11 +142,21 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 			}
 			msgs = append(msgs, &targetOplogMsg)
 
-			credLibsOplogMsgs := make([]*oplog.Message, 0, len(addCredLibs))
-			if err := w.CreateItems(ctx, addCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs)); err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target credential sources"))
+			if len(addCredLibs) > 0 {
+				credLibsOplogMsgs := make([]*oplog.Message, 0, len(addCredLibs))
+				if err := w.CreateItems(ctx, addCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target credential sources"))
+				}
+				msgs = append(msgs, credLibsOplogMsgs...)
+			}
+
+			if len(addStaticCreds) > 0 {
+				staticCredsOplogMsgs := make([]*oplog.Message, 0, len(addStaticCreds))
+				if err := w.CreateItems(ctx, addStaticCreds, db.NewOplogMsgs(&staticCredsOplogMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create target static credentials"))
+				}
+				msgs = append(msgs, staticCredsOplogMsgs...)
 			}
-			msgs = append(msgs, credLibsOplogMsgs...)
 
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -148,15 +180,19 @@ func (r *Repository) AddTargetCredentialSources(ctx context.Context, targetId st
 
 // DeleteTargetCredentialSources deletes credential sources from a target in the repository.
 // The target's current db version must match the targetVersion or an error will be returned.
-func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, _ ...Option) (int, error) {
+// Static credentials can be deleted along with the credential libraries using the
+// WithStaticCredentials option.
+func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, opt ...Option) (int, error) {
 	const op = "target.(Repository).DeleteTargetCredentialSources"
+	opts := GetOpts(opt...)
+	creds := opts.WithStaticCredentials
 	if targetId == "" {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
 	}
 	if targetVersion == 0 {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
 	}
-	if len(cls) == 0 {
+	if len(cls) == 0 && len(creds) == 0 {
 		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential source ids")
 	}
 
@@ -164,6 +200,10 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 	for _, cl := range cls {
 		deleteCredLibs = append(deleteCredLibs, cl)
 	}
+	deleteStaticCreds := make([]interface{}, 0, len(creds))
+	for _, c := range creds {
+		deleteStaticCreds = append(deleteStaticCreds, c)
+	}
 
 	t := allocTargetView()
 	t.PublicId = targetId
@@ -193,6 +233,7 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 		db.StdRetryCnt,
 		db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
+			rowsDeleted = 0
 			msgs := make([]*oplog.Message, 0, 2)
 			targetTicket, err := w.GetTicket(target)
 			if err != nil {
@@ -212,15 +253,31 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 			}
 			msgs = append(msgs, &targetOplogMsg)
 
-			credLibsOplogMsgs := make([]*oplog.Message, 0, len(deleteCredLibs))
-			rowsDeleted, err = w.DeleteItems(ctx, deleteCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs))
-			if err != nil {
-				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target credential sources"))
+			if len(deleteCredLibs) > 0 {
+				credLibsOplogMsgs := make([]*oplog.Message, 0, len(deleteCredLibs))
+				libsDeleted, err := w.DeleteItems(ctx, deleteCredLibs, db.NewOplogMsgs(&credLibsOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target credential sources"))
+				}
+				if libsDeleted != len(deleteCredLibs) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("credential sources deleted %d did not match request for %d", libsDeleted, len(deleteCredLibs)))
+				}
+				rowsDeleted += libsDeleted
+				msgs = append(msgs, credLibsOplogMsgs...)
 			}
-			if rowsDeleted != len(deleteCredLibs) {
-				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("credential sources deleted %d did not match request for %d", rowsDeleted, len(deleteCredLibs)))
+
+			if len(deleteStaticCreds) > 0 {
+				staticCredsOplogMsgs := make([]*oplog.Message, 0, len(deleteStaticCreds))
+				credsDeleted, err := w.DeleteItems(ctx, deleteStaticCreds, db.NewOplogMsgs(&staticCredsOplogMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target static credentials"))
+				}
+				if credsDeleted != len(deleteStaticCreds) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("static credentials deleted %d did not match request for %d", credsDeleted, len(deleteStaticCreds)))
+				}
+				rowsDeleted += credsDeleted
+				msgs = append(msgs, staticCredsOplogMsgs...)
 			}
-			msgs = append(msgs, credLibsOplogMsgs...)
 
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -236,9 +293,13 @@ func (r *Repository) DeleteTargetCredentialSources(ctx context.Context, targetId
 
 // SetTargetCredentialSources will set the target's credential sources. Set will add
 // and/or delete credential sources as need to reconcile the existing credential sources
-// with the request. If clIds is empty, all the credential sources will be cleared from the target.
-func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, _ ...Option) ([]HostSource, []CredentialSource, int, error) {
+// with the request. Static credentials are provided using the WithStaticCredentials
+// option. If both cls and the static credentials are empty, all the credential sources
+// will be cleared from the target.
+func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId string, targetVersion uint32, cls []*CredentialLibrary, opt ...Option) ([]HostSource, []CredentialSource, int, error) {
 	const op = "target.(Repository).SetTargetCredentialSources"
+	opts := GetOpts(opt...)
+	creds := opts.WithStaticCredentials
 	if targetId == "" {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing target id")
 	}
@@ -258,6 +319,15 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		changes = append(changes, c...)
 	}
 
+	scpm := newStaticCredentialsPurposeMap(creds)
+	for purpose, creds := range scpm {
+		c, err := r.staticChanges(ctx, targetId, creds, purpose)
+		if err != nil {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+		changes = append(changes, c...)
+	}
+
 	if len(changes) == 0 {
 		// Nothing needs to be changed, return early
 		hostSets, err := fetchHostSources(ctx, r.reader, targetId)
@@ -272,11 +342,16 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 	}
 
 	var deleteCredLibs, addCredLibs []interface{}
+	var deleteStaticCreds, addStaticCreds []interface{}
 	for _, c := range changes {
-		switch c.action {
-		case "delete":
+		switch {
+		case c.action == "delete" && c.staticCredential != nil:
+			deleteStaticCreds = append(deleteStaticCreds, c.staticCredential)
+		case c.action == "add" && c.staticCredential != nil:
+			addStaticCreds = append(addStaticCreds, c.staticCredential)
+		case c.action == "delete":
 			deleteCredLibs = append(deleteCredLibs, c.credentialLibrary)
-		case "add":
+		case c.action == "add":
 			addCredLibs = append(addCredLibs, c.credentialLibrary)
 		}
 	}
@@ -293,11 +368,11 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
 
-	vetCredentialLibraries, ok := subtypeRegistry.vetCredentialLibrariesFunc(t.Subtype())
+	vetCredentialSources, ok := subtypeRegistry.vetCredentialSourcesFunc(t.Subtype())
 	if !ok {
 		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("%s is an unsupported target type %s", t.PublicId, t.Type))
 	}
-	if err := vetCredentialLibraries(ctx, cls); err != nil {
+	if err := vetCredentialSources(ctx, cls, creds); err != nil {
 		return nil, nil, db.NoRowsAffected, err
 	}
 
@@ -363,6 +438,32 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 				msgs = append(msgs, delMsgs...)
 				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
 			}
+
+			// add new static credentials
+			if len(addStaticCreds) > 0 {
+				addMsgs := make([]*oplog.Message, 0, len(addStaticCreds))
+				if err := w.CreateItems(ctx, addStaticCreds, db.NewOplogMsgs(&addMsgs)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add target static credentials"))
+				}
+				rowsAffected += len(addMsgs)
+				msgs = append(msgs, addMsgs...)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+			}
+
+			// delete existing static credentials not part of set
+			if len(deleteStaticCreds) > 0 {
+				delMsgs := make([]*oplog.Message, 0, len(deleteStaticCreds))
+				rowsDeleted, err := w.DeleteItems(ctx, deleteStaticCreds, db.NewOplogMsgs(&delMsgs))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete target static credentials"))
+				}
+				if rowsDeleted != len(delMsgs) {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("target static credentials deleted %d did not match request for %d", rowsDeleted, len(deleteStaticCreds)))
+				}
+				rowsAffected += rowsDeleted
+				msgs = append(msgs, delMsgs...)
+				metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+			}
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, targetTicket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
 			}
@@ -387,6 +488,7 @@ func (r *Repository) SetTargetCredentialSources(ctx context.Context, targetId st
 type change struct {
 	action            string
 	credentialLibrary *CredentialLibrary
+	staticCredential  *StaticCredential
 }
 
 type changeQueryResult struct {
@@ -438,21 +540,72 @@ func (r *Repository) changes(ctx context.Context, targetId string, cls []*Creden
 	return changes, nil
 }
 
+type staticChangeQueryResult struct {
+	Action       string
+	CredentialId string
+}
+
+func (r *Repository) staticChanges(ctx context.Context, targetId string, creds []*StaticCredential, purpose string) ([]*change, error) {
+	const op = "target.(Repository).staticChanges"
+
+	var inClauseSpots []string
+	var params []interface{}
+	params = append(params, sql.Named("target_id", targetId), sql.Named("purpose", purpose))
+	for idx, c := range creds {
+		params = append(params, sql.Named(fmt.Sprintf("%d", idx+1), c.CredentialStaticId))
+		inClauseSpots = append(inClauseSpots, fmt.Sprintf("@%d", idx+1))
+	}
+	inClause := strings.Join(inClauseSpots, ",")
+	if inClause == "" {
+		inClause = "''"
+	}
+
+	query := fmt.Sprintf(setStaticChangesQuery, inClause)
+	rows, err := r.reader.Query(ctx, query, params)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("query failed"))
+	}
+	defer rows.Close()
+
+	var changes []*change
+	for rows.Next() {
+		var chg staticChangeQueryResult
+		if err := r.reader.ScanRows(rows, &chg); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		changes = append(changes, &change{
+			action: chg.Action,
+			staticCredential: &StaticCredential{
+				StaticCredential: &store.StaticCredential{
+					TargetId:           targetId,
+					CredentialStaticId: chg.CredentialId,
+					CredentialPurpose:  purpose,
+				},
+			},
+		})
+	}
+	return changes, nil
+}
+
 func fetchCredentialSources(ctx context.Context, r db.Reader, targetId string) ([]CredentialSource, error) {
 	const op = "target.fetchCredentialSources"
 	var libraries []*TargetLibrary
 	if err := r.SearchWhere(ctx, &libraries, "target_id = ?", []interface{}{targetId}); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
-	// FIXME: When we have static creds, there will need to be an updated view
-	// that unions between libs and creds, at which point the type above will
-	// change. For now we just take the libraries and wrap them.
-	if len(libraries) == 0 {
+	var staticCreds []*TargetStaticCredential
+	if err := r.SearchWhere(ctx, &staticCreds, "target_id = ?", []interface{}{targetId}); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(libraries) == 0 && len(staticCreds) == 0 {
 		return nil, nil
 	}
-	ret := make([]CredentialSource, len(libraries))
-	for i, lib := range libraries {
-		ret[i] = lib
+	ret := make([]CredentialSource, 0, len(libraries)+len(staticCreds))
+	for _, lib := range libraries {
+		ret = append(ret, lib)
+	}
+	for _, c := range staticCreds {
+		ret = append(ret, c)
 	}
 	return ret, nil
 }
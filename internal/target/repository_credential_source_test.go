@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -16,9 +17,11 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestRepository_SetTargetCredentialSources(t *testing.T) {
-	target.Register(targettest.Subtype, targettest.New, targettest.Alloc, targettest.Vet, targettest.VetCredentialLibraries, targettest.TargetPrefix)
+func init() {
+	target.Register(targettest.Subtype, targettest.New, targettest.Alloc, targettest.Vet, targettest.VetCredentialSources, targettest.TargetPrefix)
+}
 
+func TestRepository_SetTargetCredentialSources(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
 	rw := db.New(conn)
@@ -257,3 +260,58 @@ func TestRepository_SetTargetCredentialSources(t *testing.T) {
 		assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "unexpected error %s", err.Error())
 	})
 }
+
+func TestRepository_StaticCredentialSources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := target.NewRepository(rw, rw, testKms)
+	require.NoError(err)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	_, proj := iam.TestScopes(t, iamRepo)
+
+	vcs := vault.TestCredentialStores(t, conn, wrapper, proj.GetPublicId(), 1)[0]
+	lib := vault.TestCredentialLibraries(t, conn, wrapper, vcs.GetPublicId(), 1)[0]
+	scs := static.TestCredentialStore(t, conn, wrapper, proj.GetPublicId())
+	creds := static.TestUsernamePasswordCredentials(t, conn, wrapper, proj.GetPublicId(), scs.GetPublicId(), 2)
+
+	tar := targettest.TestNewTestTarget(ctx, t, conn, proj.PublicId, "static-creds")
+
+	_, _, got, err := repo.AddTargetCredentialSources(ctx, tar.GetPublicId(), 1,
+		[]*target.CredentialLibrary{target.TestNewCredentialLibrary(tar.GetPublicId(), lib.GetPublicId(), credential.ApplicationPurpose)},
+		target.WithStaticCredentials([]*target.StaticCredential{target.TestNewStaticCredential(tar.GetPublicId(), creds[0].GetPublicId(), credential.ApplicationPurpose)}))
+	require.NoError(err)
+	require.Len(got, 2)
+	storeIds := map[string]string{}
+	for _, cs := range got {
+		storeIds[cs.Id()] = cs.CredentialStoreId()
+	}
+	assert.Equal(map[string]string{
+		lib.GetPublicId():      vcs.GetPublicId(),
+		creds[0].GetPublicId(): scs.GetPublicId(),
+	}, storeIds)
+
+	// Set replaces the first static credential with the second and keeps
+	// the library.
+	_, got, affected, err := repo.SetTargetCredentialSources(ctx, tar.GetPublicId(), 2,
+		[]*target.CredentialLibrary{target.TestNewCredentialLibrary(tar.GetPublicId(), lib.GetPublicId(), credential.ApplicationPurpose)},
+		target.WithStaticCredentials([]*target.StaticCredential{target.TestNewStaticCredential(tar.GetPublicId(), creds[1].GetPublicId(), credential.ApplicationPurpose)}))
+	require.NoError(err)
+	assert.Equal(2, affected)
+	require.Len(got, 2)
+
+	deleted, err := repo.DeleteTargetCredentialSources(ctx, tar.GetPublicId(), 3, nil,
+		target.WithStaticCredentials([]*target.StaticCredential{target.TestNewStaticCredential(tar.GetPublicId(), creds[1].GetPublicId(), credential.ApplicationPurpose)}))
+	require.NoError(err)
+	assert.Equal(1, deleted)
+
+	_, _, got, err = repo.LookupTarget(ctx, tar.GetPublicId())
+	require.NoError(err)
+	require.Len(got, 1)
+	assert.Equal(lib.GetPublicId(), got[0].Id())
+}
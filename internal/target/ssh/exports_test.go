@@ -0,0 +1,19 @@
+package ssh
+
+import "github.com/hashicorp/boundary/internal/target"
+
+// Expose functions and variables for tests.
+var (
+	TestId           = testId
+	TestTargetName   = testTargetName
+	DefaultTableName = defaultTableName
+)
+
+// NewTestTarget is a test helper that bypasses the scopeId checks
+// performed by NewTarget, allowing tests to create Targets with
+// nil scopeIds for more robust testing.
+func NewTestTarget(scopeId string, opt ...target.Option) target.Target {
+	t, _ := newTarget("testScope", opt...)
+	t.SetScopeId(scopeId)
+	return t
+}
@@ -0,0 +1,62 @@
+package ssh
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_vetCredentialSources(t *testing.T) {
+	t.Parallel()
+	lib := func(p credential.Purpose) *target.CredentialLibrary {
+		cl, err := target.NewCredentialLibrary("tssh_1234567890", "clvlt_1234567890", p)
+		require.NoError(t, err)
+		return cl
+	}
+	cred := func(p credential.Purpose) *target.StaticCredential {
+		c, err := target.NewStaticCredential("tssh_1234567890", "credspk_1234567890", p)
+		require.NoError(t, err)
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		cls     []*target.CredentialLibrary
+		creds   []*target.StaticCredential
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:  "egress",
+			cls:   []*target.CredentialLibrary{lib(credential.EgressPurpose)},
+			creds: []*target.StaticCredential{cred(credential.EgressPurpose)},
+		},
+		{
+			name:    "application-library",
+			cls:     []*target.CredentialLibrary{lib(credential.EgressPurpose), lib(credential.ApplicationPurpose)},
+			wantErr: true,
+		},
+		{
+			name:    "ingress-credential",
+			creds:   []*target.StaticCredential{cred(credential.IngressPurpose)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := vetCredentialSources(context.Background(), tt.cls, tt.creds)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
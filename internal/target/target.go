@@ -24,6 +24,12 @@ type Target interface {
 	GetUpdateTime() *timestamp.Timestamp
 	GetSessionMaxSeconds() uint32
 	GetSessionConnectionLimit() int32
+	GetUserSessionLimit() int32
+	GetSessionMaxBytesUp() int64
+	GetSessionMaxBytesDown() int64
+	GetConnectionIdleTimeoutSeconds() uint32
+	GetHostSelectionStrategy() string
+	GetKnownHosts() string
 	GetWorkerFilter() string
 	Clone() Target
 	SetPublicId(context.Context, string) error
@@ -36,6 +42,12 @@ type Target interface {
 	SetUpdateTime(*timestamp.Timestamp)
 	SetSessionMaxSeconds(uint32)
 	SetSessionConnectionLimit(int32)
+	SetUserSessionLimit(int32)
+	SetSessionMaxBytesUp(int64)
+	SetSessionMaxBytesDown(int64)
+	SetConnectionIdleTimeoutSeconds(uint32)
+	SetHostSelectionStrategy(string)
+	SetKnownHosts(string)
 	SetWorkerFilter(string)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
@@ -103,6 +115,12 @@ func (t *targetView) targetSubtype(ctx context.Context) (Target, error) {
 	tt.SetUpdateTime(t.UpdateTime)
 	tt.SetSessionMaxSeconds(t.SessionMaxSeconds)
 	tt.SetSessionConnectionLimit(t.SessionConnectionLimit)
+	tt.SetUserSessionLimit(t.UserSessionLimit)
+	tt.SetSessionMaxBytesUp(t.SessionMaxBytesUp)
+	tt.SetSessionMaxBytesDown(t.SessionMaxBytesDown)
+	tt.SetConnectionIdleTimeoutSeconds(t.ConnectionIdleTimeoutSeconds)
+	tt.SetHostSelectionStrategy(t.HostSelectionStrategy)
+	tt.SetKnownHosts(t.KnownHosts)
 	tt.SetWorkerFilter(t.WorkerFilter)
 	return tt, nil
 }
@@ -112,6 +112,30 @@ func (t *Target) GetSessionConnectionLimit() int32 {
 	return t.SessionConnectionLimit
 }
 
+func (t *Target) GetUserSessionLimit() int32 {
+	return t.UserSessionLimit
+}
+
+func (t *Target) GetSessionMaxBytesUp() int64 {
+	return t.SessionMaxBytesUp
+}
+
+func (t *Target) GetSessionMaxBytesDown() int64 {
+	return t.SessionMaxBytesDown
+}
+
+func (t *Target) GetConnectionIdleTimeoutSeconds() uint32 {
+	return t.ConnectionIdleTimeoutSeconds
+}
+
+func (t *Target) GetHostSelectionStrategy() string {
+	return t.HostSelectionStrategy
+}
+
+func (t *Target) GetKnownHosts() string {
+	return ""
+}
+
 func (t *Target) GetWorkerFilter() string {
 	return t.WorkerFilter
 }
@@ -164,6 +188,28 @@ func (t *Target) SetSessionConnectionLimit(l int32) {
 	t.SessionConnectionLimit = l
 }
 
+func (t *Target) SetUserSessionLimit(l int32) {
+	t.UserSessionLimit = l
+}
+
+func (t *Target) SetSessionMaxBytesUp(max int64) {
+	t.SessionMaxBytesUp = max
+}
+
+func (t *Target) SetSessionMaxBytesDown(max int64) {
+	t.SessionMaxBytesDown = max
+}
+
+func (t *Target) SetConnectionIdleTimeoutSeconds(s uint32) {
+	t.ConnectionIdleTimeoutSeconds = s
+}
+
+func (t *Target) SetHostSelectionStrategy(s string) {
+	t.HostSelectionStrategy = s
+}
+
+func (t *Target) SetKnownHosts(string) {}
+
 func (t *Target) SetWorkerFilter(f string) {
 	t.WorkerFilter = f
 }
@@ -203,8 +249,8 @@ func Vet(ctx context.Context, t target.Target) error {
 	return nil
 }
 
-// VetCredentialLibraries allows for any CredentialLibraries.
-func VetCredentialLibraries(_ context.Context, _ []*target.CredentialLibrary) error {
+// VetCredentialSources allows for any CredentialLibraries and StaticCredentials.
+func VetCredentialSources(_ context.Context, _ []*target.CredentialLibrary, _ []*target.StaticCredential) error {
 	return nil
 }
 
@@ -217,13 +263,18 @@ func New(scopeId string, opt ...target.Option) (target.Target, error) {
 	}
 	t := &Target{
 		Target: &store.Target{
-			ScopeId:                scopeId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
+			ScopeId:                      scopeId,
+			Name:                         opts.WithName,
+			Description:                  opts.WithDescription,
+			DefaultPort:                  opts.WithDefaultPort,
+			SessionConnectionLimit:       opts.WithSessionConnectionLimit,
+			UserSessionLimit:             opts.WithUserSessionLimit,
+			SessionMaxBytesUp:            opts.WithSessionMaxBytesUp,
+			SessionMaxBytesDown:          opts.WithSessionMaxBytesDown,
+			ConnectionIdleTimeoutSeconds: opts.WithConnectionIdleTimeout,
+			HostSelectionStrategy:        opts.WithHostSelectionStrategy.String(),
+			SessionMaxSeconds:            opts.WithSessionMaxSeconds,
+			WorkerFilter:                 opts.WithWorkerFilter,
 		},
 	}
 	return t, nil
@@ -263,5 +314,14 @@ func TestNewTestTarget(ctx context.Context, t *testing.T, conn *db.DB, scopeId,
 		err := rw.CreateItems(context.Background(), newCredLibs)
 		require.NoError(err)
 	}
+	if len(opts.WithStaticCredentials) > 0 {
+		newStaticCreds := make([]interface{}, 0, len(opts.WithStaticCredentials))
+		for _, c := range opts.WithStaticCredentials {
+			c.TargetId = tar.GetPublicId()
+			newStaticCreds = append(newStaticCreds, c)
+		}
+		err := rw.CreateItems(context.Background(), newStaticCreds)
+		require.NoError(err)
+	}
 	return tar
 }
@@ -47,13 +47,18 @@ func newTarget(scopeId string, opt ...target.Option) (target.Target, error) {
 	}
 	t := &Target{
 		Target: &store.Target{
-			ScopeId:                scopeId,
-			Name:                   opts.WithName,
-			Description:            opts.WithDescription,
-			DefaultPort:            opts.WithDefaultPort,
-			SessionConnectionLimit: opts.WithSessionConnectionLimit,
-			SessionMaxSeconds:      opts.WithSessionMaxSeconds,
-			WorkerFilter:           opts.WithWorkerFilter,
+			ScopeId:                      scopeId,
+			Name:                         opts.WithName,
+			Description:                  opts.WithDescription,
+			DefaultPort:                  opts.WithDefaultPort,
+			SessionConnectionLimit:       opts.WithSessionConnectionLimit,
+			UserSessionLimit:             opts.WithUserSessionLimit,
+			SessionMaxBytesUp:            opts.WithSessionMaxBytesUp,
+			SessionMaxBytesDown:          opts.WithSessionMaxBytesDown,
+			ConnectionIdleTimeoutSeconds: opts.WithConnectionIdleTimeout,
+			HostSelectionStrategy:        opts.WithHostSelectionStrategy.String(),
+			SessionMaxSeconds:            opts.WithSessionMaxSeconds,
+			WorkerFilter:                 opts.WithWorkerFilter,
 		},
 	}
 	return t, nil
@@ -168,6 +173,35 @@ func (t *Target) SetSessionConnectionLimit(limit int32) {
 	t.SessionConnectionLimit = limit
 }
 
+func (t *Target) SetUserSessionLimit(limit int32) {
+	t.UserSessionLimit = limit
+}
+
+func (t *Target) SetSessionMaxBytesUp(max int64) {
+	t.SessionMaxBytesUp = max
+}
+
+func (t *Target) SetSessionMaxBytesDown(max int64) {
+	t.SessionMaxBytesDown = max
+}
+
+func (t *Target) SetConnectionIdleTimeoutSeconds(s uint32) {
+	t.ConnectionIdleTimeoutSeconds = s
+}
+
+func (t *Target) SetHostSelectionStrategy(s string) {
+	t.HostSelectionStrategy = s
+}
+
+// GetKnownHosts returns "": the host keys of the hosts of a tcp target are
+// not verified.
+func (t *Target) GetKnownHosts() string {
+	return ""
+}
+
+// SetKnownHosts is a no-op for tcp targets.
+func (t *Target) SetKnownHosts(string) {}
+
 func (t *Target) SetWorkerFilter(filter string) {
 	t.WorkerFilter = filter
 }
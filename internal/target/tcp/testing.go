@@ -45,6 +45,15 @@ func TestTarget(ctx context.Context, t *testing.T, conn *db.DB, scopeId, name st
 		err := rw.CreateItems(context.Background(), newCredLibs)
 		require.NoError(err)
 	}
+	if len(opts.WithStaticCredentials) > 0 {
+		newStaticCreds := make([]interface{}, 0, len(opts.WithStaticCredentials))
+		for _, c := range opts.WithStaticCredentials {
+			c.TargetId = tar.GetPublicId()
+			newStaticCreds = append(newStaticCreds, c)
+		}
+		err := rw.CreateItems(context.Background(), newStaticCreds)
+		require.NoError(err)
+	}
 	return tar
 }
 
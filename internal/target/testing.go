@@ -34,3 +34,16 @@ func TestCredentialLibrary(t *testing.T, conn *db.DB, targetId, libraryId string
 	require.NoError(err)
 	return lib
 }
+
+// TestNewStaticCredential creates a new in memory StaticCredential
+// representing the relationship between targetId and credentialId with the
+// given purpose.
+func TestNewStaticCredential(targetId, credentialId string, purpose credential.Purpose) *StaticCredential {
+	return &StaticCredential{
+		StaticCredential: &store.StaticCredential{
+			TargetId:           targetId,
+			CredentialStaticId: credentialId,
+			CredentialPurpose:  string(purpose),
+		},
+	}
+}
@@ -53,6 +53,17 @@ const (
 	AddHostSources            Type = 42
 	SetHostSources            Type = 43
 	RemoveHostSources         Type = 44
+	Approve                   Type = 45
+	Deny                      Type = 46
+	CheckPermissions          Type = 47
+	EnrollTotp                Type = 48
+	ConfirmTotp               Type = 49
+	EnrollWebAuthn            Type = 50
+	ConfirmWebAuthn           Type = 51
+	RemoveMfa                 Type = 52
+	GenerateRecoveryCodes     Type = 53
+	Unlock                    Type = 54
+	DownloadRecording         Type = 55
 )
 
 var Map = map[string]Type{
@@ -100,6 +111,17 @@ var Map = map[string]Type{
 	AddHostSources.String():            AddHostSources,
 	SetHostSources.String():            SetHostSources,
 	RemoveHostSources.String():         RemoveHostSources,
+	Approve.String():                   Approve,
+	Deny.String():                      Deny,
+	CheckPermissions.String():          CheckPermissions,
+	EnrollTotp.String():                EnrollTotp,
+	ConfirmTotp.String():               ConfirmTotp,
+	EnrollWebAuthn.String():            EnrollWebAuthn,
+	ConfirmWebAuthn.String():           ConfirmWebAuthn,
+	RemoveMfa.String():                 RemoveMfa,
+	GenerateRecoveryCodes.String():     GenerateRecoveryCodes,
+	Unlock.String():                    Unlock,
+	DownloadRecording.String():         DownloadRecording,
 }
 
 func (a Type) String() string {
@@ -149,6 +171,17 @@ func (a Type) String() string {
 		"add-host-sources",
 		"set-host-sources",
 		"remove-host-sources",
+		"approve",
+		"deny",
+		"check-permissions",
+		"enroll-totp",
+		"confirm-totp",
+		"enroll-webauthn",
+		"confirm-webauthn",
+		"remove-mfa",
+		"generate-recovery-codes",
+		"unlock",
+		"download-recording",
 	}[a]
 }
 
@@ -95,6 +95,50 @@ func TestAction(t *testing.T) {
 			action: NoOp,
 			want:   "no-op",
 		},
+		{
+			action: Approve,
+			want:   "approve",
+		},
+		{
+			action: Deny,
+			want:   "deny",
+		},
+		{
+			action: CheckPermissions,
+			want:   "check-permissions",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: ConfirmTotp,
+			want:   "confirm-totp",
+		},
+		{
+			action: EnrollWebAuthn,
+			want:   "enroll-webauthn",
+		},
+		{
+			action: ConfirmWebAuthn,
+			want:   "confirm-webauthn",
+		},
+		{
+			action: RemoveMfa,
+			want:   "remove-mfa",
+		},
+		{
+			action: GenerateRecoveryCodes,
+			want:   "generate-recovery-codes",
+		},
+		{
+			action: Unlock,
+			want:   "unlock",
+		},
+		{
+			action: DownloadRecording,
+			want:   "download-recording",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
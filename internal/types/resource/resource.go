@@ -25,6 +25,8 @@ const (
 	ManagedGroup
 	CredentialStore
 	CredentialLibrary
+	Credential
+	AccessRequest
 	// NOTE: When adding a new type, be sure to update:
 	//
 	// * The Grant.validateType function and test
@@ -57,6 +59,8 @@ func (r Type) String() string {
 		"managed-group",
 		"credential-store",
 		"credential-library",
+		"credential",
+		"access-request",
 	}[r]
 }
 
@@ -89,4 +93,6 @@ var Map = map[string]Type{
 	ManagedGroup.String():      ManagedGroup,
 	CredentialStore.String():   CredentialStore,
 	CredentialLibrary.String(): CredentialLibrary,
+	Credential.String():        Credential,
+	AccessRequest.String():     AccessRequest,
 }
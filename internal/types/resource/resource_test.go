@@ -87,6 +87,14 @@ func Test_Resource(t *testing.T) {
 			typeString: "credential-library",
 			want:       CredentialLibrary,
 		},
+		{
+			typeString: "credential",
+			want:       Credential,
+		},
+		{
+			typeString: "access-request",
+			want:       AccessRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.typeString, func(t *testing.T) {
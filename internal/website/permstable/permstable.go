@@ -63,6 +63,7 @@ var table = &Table{
 
 func main() {
 	table.Body.Resources = append(table.Body.Resources,
+		accessRequest,
 		account,
 		authMethod,
 		authToken,
@@ -306,6 +307,71 @@ func rudActions(typ string, pin bool) []*Action {
 	return ret
 }
 
+var accessRequest = &Resource{
+	Type:   "Access Request",
+	Scopes: infraScope,
+	Endpoints: []*Endpoint{
+		{
+			Path: "/access-requests",
+			Params: map[string]string{
+				"Type": "access-request",
+			},
+			Actions: clActions("an access request"),
+		},
+		{
+			Path: "/access-requests/<id>",
+			Params: map[string]string{
+				"ID":   "<id>",
+				"Type": "access-request",
+			},
+			Actions: []*Action{
+				{
+					Name:        "read",
+					Description: "Read an access request",
+					Examples: []string{
+						"id=<id>;actions=read",
+					},
+				},
+				{
+					Name:        "approve",
+					Description: "Approve a pending access request, which must not be associated with the calling user",
+					Examples: []string{
+						"id=*;type=access-request;actions=approve",
+					},
+				},
+				{
+					Name:        "deny",
+					Description: "Deny a pending access request, which must not be associated with the calling user",
+					Examples: []string{
+						"id=*;type=access-request;actions=deny",
+					},
+				},
+				{
+					Name:        "cancel",
+					Description: "Cancel a pending access request",
+					Examples: []string{
+						"id=<id>;actions=cancel",
+					},
+				},
+				{
+					Name:        "read:self",
+					Description: "Read an access request, which must be associated with the calling user",
+					Examples: []string{
+						"id=*;type=access-request;actions=read:self",
+					},
+				},
+				{
+					Name:        "cancel:self",
+					Description: "Cancel a pending access request, which must be associated with the calling user",
+					Examples: []string{
+						"id=*;type=access-request;actions=cancel:self",
+					},
+				},
+			},
+		},
+	},
+}
+
 var account = &Resource{
 	Type:   "Account",
 	Scopes: iamScopes,
@@ -342,6 +408,62 @@ var account = &Resource{
 						"id=<pin>;type=<type>;actions=change-password",
 					},
 				},
+				&Action{
+					Name:        "enroll-totp",
+					Description: "Start the enrollment of a TOTP authenticator for a password account",
+					Examples: []string{
+						"id=<id>;actions=enroll-totp",
+						"id=<pin>;type=<type>;actions=enroll-totp",
+					},
+				},
+				&Action{
+					Name:        "confirm-totp",
+					Description: "Confirm the TOTP authenticator of a password account",
+					Examples: []string{
+						"id=<id>;actions=confirm-totp",
+						"id=<pin>;type=<type>;actions=confirm-totp",
+					},
+				},
+				&Action{
+					Name:        "enroll-webauthn",
+					Description: "Start the registration of a WebAuthn credential for a password account",
+					Examples: []string{
+						"id=<id>;actions=enroll-webauthn",
+						"id=<pin>;type=<type>;actions=enroll-webauthn",
+					},
+				},
+				&Action{
+					Name:        "confirm-webauthn",
+					Description: "Register a WebAuthn credential for a password account",
+					Examples: []string{
+						"id=<id>;actions=confirm-webauthn",
+						"id=<pin>;type=<type>;actions=confirm-webauthn",
+					},
+				},
+				&Action{
+					Name:        "remove-mfa",
+					Description: "Remove a second factor from a password account",
+					Examples: []string{
+						"id=<id>;actions=remove-mfa",
+						"id=<pin>;type=<type>;actions=remove-mfa",
+					},
+				},
+				&Action{
+					Name:        "generate-recovery-codes",
+					Description: "Replace the recovery codes of a password account",
+					Examples: []string{
+						"id=<id>;actions=generate-recovery-codes",
+						"id=<pin>;type=<type>;actions=generate-recovery-codes",
+					},
+				},
+				&Action{
+					Name:        "unlock",
+					Description: "End the lockout of a password account",
+					Examples: []string{
+						"id=<id>;actions=unlock",
+						"id=<pin>;type=<type>;actions=unlock",
+					},
+				},
 			),
 		},
 	},
@@ -668,7 +790,16 @@ var scope = &Resource{
 				"ID":   "<id>",
 				"Type": "scope",
 			},
-			Actions: rudActions("a scope", false),
+			Actions: append(
+				rudActions("a scope", false),
+				&Action{
+					Name:        "check-permissions",
+					Description: "Check the permissions of a user on a resource within a scope",
+					Examples: []string{
+						"id=<id>;actions=check-permissions",
+					},
+				},
+			),
 		},
 	},
 }
@@ -727,6 +858,13 @@ var session = &Resource{
 						"id=*;type=session;actions=cancel:self",
 					},
 				},
+				{
+					Name:        "download-recording",
+					Description: "Download the recording of a connection of a session",
+					Examples: []string{
+						"id=<id>;actions=download-recording",
+					},
+				},
 			},
 		},
 	},
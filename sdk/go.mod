@@ -3,6 +3,7 @@ module github.com/hashicorp/boundary/sdk
 go 1.17
 
 require (
+	github.com/golang/protobuf v1.5.2
 	github.com/hashicorp/eventlogger v0.1.1-0.20211106154408-4ff8da3a890c
 	github.com/hashicorp/eventlogger/filters/encrypt v0.1.6-0.20211027211326-5db60a48f239
 	github.com/hashicorp/go-hclog v0.16.2
@@ -40,7 +41,6 @@ require (
 	github.com/dimchansky/utfbom v1.1.0 // indirect
 	github.com/fatih/color v1.12.0 // indirect
 	github.com/golang/groupcache v0.0.0-20200121045136-8c9f03a8e57e // indirect
-	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/golang/snappy v0.0.1 // indirect
 	github.com/google/go-cmp v0.5.6 // indirect
 	github.com/google/uuid v1.1.2 // indirect
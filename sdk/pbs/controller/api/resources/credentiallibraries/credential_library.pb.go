@@ -195,6 +195,18 @@ type VaultCredentialLibraryAttributes struct {
 	HttpMethod *wrapperspb.StringValue `protobuf:"bytes,20,opt,name=http_method,proto3" json:"http_method,omitempty"`
 	// The body of the HTTP request the library sends to vault. When set http_method must be "POST"
 	HttpRequestBody *wrapperspb.StringValue `protobuf:"bytes,30,opt,name=http_request_body,proto3" json:"http_request_body,omitempty"`
+	// The username the certificates issued by the library are valid for. Required when credential_type is "ssh_certificate".
+	SshUsername *wrapperspb.StringValue `protobuf:"bytes,40,opt,name=ssh_username,proto3" json:"ssh_username,omitempty"`
+	// The type of key generated for each certificate: "ed25519", "ecdsa" or "rsa". Defaults to "ed25519".
+	SshKeyType *wrapperspb.StringValue `protobuf:"bytes,50,opt,name=ssh_key_type,proto3" json:"ssh_key_type,omitempty"`
+	// The size of the key generated for each certificate. If unset the default size for ssh_key_type is used.
+	SshKeyBits *wrapperspb.UInt32Value `protobuf:"bytes,60,opt,name=ssh_key_bits,proto3" json:"ssh_key_bits,omitempty"`
+	// The TTL requested for each certificate, e.g. "5m". If unset the TTL of the Vault role is used.
+	SshTtl *wrapperspb.StringValue `protobuf:"bytes,70,opt,name=ssh_ttl,proto3" json:"ssh_ttl,omitempty"`
+	// Principals the certificates are valid for in addition to ssh_username.
+	SshValidPrincipals []string `protobuf:"bytes,80,rep,name=ssh_valid_principals,proto3" json:"ssh_valid_principals,omitempty"`
+	// The extensions requested for each certificate, e.g. {"permit-pty": ""}. Values must be strings.
+	SshExtensions *structpb.Struct `protobuf:"bytes,90,opt,name=ssh_extensions,proto3" json:"ssh_extensions,omitempty"`
 }
 
 func (x *VaultCredentialLibraryAttributes) Reset() {
@@ -250,6 +262,48 @@ func (x *VaultCredentialLibraryAttributes) GetHttpRequestBody() *wrapperspb.Stri
 	return nil
 }
 
+func (x *VaultCredentialLibraryAttributes) GetSshUsername() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SshUsername
+	}
+	return nil
+}
+
+func (x *VaultCredentialLibraryAttributes) GetSshKeyType() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SshKeyType
+	}
+	return nil
+}
+
+func (x *VaultCredentialLibraryAttributes) GetSshKeyBits() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.SshKeyBits
+	}
+	return nil
+}
+
+func (x *VaultCredentialLibraryAttributes) GetSshTtl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SshTtl
+	}
+	return nil
+}
+
+func (x *VaultCredentialLibraryAttributes) GetSshValidPrincipals() []string {
+	if x != nil {
+		return x.SshValidPrincipals
+	}
+	return nil
+}
+
+func (x *VaultCredentialLibraryAttributes) GetSshExtensions() *structpb.Struct {
+	if x != nil {
+		return x.SshExtensions
+	}
+	return nil
+}
+
 var File_controller_api_resources_credentiallibraries_v1_credential_library_proto protoreflect.FileDescriptor
 
 var file_controller_api_resources_credentiallibraries_v1_credential_library_proto_rawDesc = []byte{
@@ -320,7 +374,7 @@ var file_controller_api_resources_credentiallibraries_v1_credential_library_prot
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x04,
 	0xa0, 0xda, 0x29, 0x01, 0x52, 0x1c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
 	0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
-	0x65, 0x73, 0x22, 0xee, 0x02, 0x0a, 0x20, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x73, 0x22, 0x85, 0x06, 0x0a, 0x20, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x72, 0x65, 0x64,
 	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x41, 0x74, 0x74,
 	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
 	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
@@ -343,14 +397,39 @@ var file_controller_api_resources_credentiallibraries_v1_credential_library_prot
 	0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x12,
 	0x0f, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79,
 	0x52, 0x11, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x62,
-	0x6f, 0x64, 0x79, 0x42, 0x68, 0x5a, 0x66, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x79, 0x2f, 0x73, 0x64, 0x6b, 0x2f, 0x70, 0x62, 0x73, 0x2f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x3b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x64, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x73, 0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0xa0, 0xda, 0x29, 0x01, 0x52, 0x0c, 0x73,
+	0x73, 0x68, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x73,
+	0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x04, 0xa0, 0xda, 0x29, 0x01, 0x52, 0x0c, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62,
+	0x69, 0x74, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0xa0, 0xda, 0x29, 0x01, 0x52, 0x0c, 0x73,
+	0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x69, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x73,
+	0x73, 0x68, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x46, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x04, 0xa0, 0xda, 0x29, 0x01,
+	0x52, 0x07, 0x73, 0x73, 0x68, 0x5f, 0x74, 0x74, 0x6c, 0x12, 0x38, 0x0a, 0x14, 0x73, 0x73, 0x68,
+	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x73, 0x18, 0x50, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xa0, 0xda, 0x29, 0x01, 0x52, 0x14, 0x73,
+	0x73, 0x68, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x73, 0x73, 0x68, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x42, 0x04, 0xa0, 0xda, 0x29, 0x01, 0x52, 0x0e, 0x73, 0x73, 0x68, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x68, 0x5a, 0x66, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f,
+	0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x73, 0x64, 0x6b, 0x2f,
+	0x70, 0x62, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x3b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -373,6 +452,7 @@ var file_controller_api_resources_credentiallibraries_v1_credential_library_prot
 	(*wrapperspb.StringValue)(nil),           // 3: google.protobuf.StringValue
 	(*timestamppb.Timestamp)(nil),            // 4: google.protobuf.Timestamp
 	(*structpb.Struct)(nil),                  // 5: google.protobuf.Struct
+	(*wrapperspb.UInt32Value)(nil),           // 6: google.protobuf.UInt32Value
 }
 var file_controller_api_resources_credentiallibraries_v1_credential_library_proto_depIdxs = []int32{
 	2,  // 0: controller.api.resources.credentiallibraries.v1.CredentialLibrary.scope:type_name -> controller.api.resources.scopes.v1.ScopeInfo
@@ -385,11 +465,16 @@ var file_controller_api_resources_credentiallibraries_v1_credential_library_prot
 	3,  // 7: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.path:type_name -> google.protobuf.StringValue
 	3,  // 8: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.http_method:type_name -> google.protobuf.StringValue
 	3,  // 9: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.http_request_body:type_name -> google.protobuf.StringValue
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	3,  // 10: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.ssh_username:type_name -> google.protobuf.StringValue
+	3,  // 11: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.ssh_key_type:type_name -> google.protobuf.StringValue
+	6,  // 12: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.ssh_key_bits:type_name -> google.protobuf.UInt32Value
+	3,  // 13: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.ssh_ttl:type_name -> google.protobuf.StringValue
+	5,  // 14: controller.api.resources.credentiallibraries.v1.VaultCredentialLibraryAttributes.ssh_extensions:type_name -> google.protobuf.Struct
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_controller_api_resources_credentiallibraries_v1_credential_library_proto_init() }
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: controller/api/resources/scopes/v1/permission_check.proto
+
+package scopes
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PermissionGrant contains a grant which determines whether a user is
+// authorized to perform an action on a resource.
+type PermissionGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the Role containing the grant.
+	RoleId string `protobuf:"bytes,10,opt,name=role_id,proto3" json:"role_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The canonical form of the grant, with templates substituted.
+	Grant string `protobuf:"bytes,20,opt,name=grant,proto3" json:"grant,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *PermissionGrant) Reset() {
+	*x = PermissionGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionGrant) ProtoMessage() {}
+
+func (x *PermissionGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionGrant.ProtoReflect.Descriptor instead.
+func (*PermissionGrant) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_scopes_v1_permission_check_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PermissionGrant) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *PermissionGrant) GetGrant() string {
+	if x != nil {
+		return x.Grant
+	}
+	return ""
+}
+
+// PermissionCheck contains the result of evaluating whether a user is
+// authorized to perform an action on a resource.
+type PermissionCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Output only. The ID of the Scope containing the resource, derived from the resource or its parent.
+	ScopeId string `protobuf:"bytes,10,opt,name=scope_id,proto3" json:"scope_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The ID of the User whose permissions were checked.
+	UserId string `protobuf:"bytes,20,opt,name=user_id,proto3" json:"user_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The ID of the resource, if any. This is empty when checking a collection action such as list or create.
+	ResourceId string `protobuf:"bytes,30,opt,name=resource_id,proto3" json:"resource_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The type of the resource.
+	ResourceType string `protobuf:"bytes,40,opt,name=resource_type,proto3" json:"resource_type,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The ID of the parent of the resource, if any, derived from the resource.
+	ParentId string `protobuf:"bytes,50,opt,name=parent_id,proto3" json:"parent_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The action that was checked.
+	Action string `protobuf:"bytes,60,opt,name=action,proto3" json:"action,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. Whether the User is authorized to perform the action on the resource.
+	Authorized bool `protobuf:"varint,70,opt,name=authorized,proto3" json:"authorized,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The reason for the decision: "allowed" if a grant allows the action, "denied" if a deny grant denies it, or "no-matching-grant" if no grant allows it.
+	Reason string `protobuf:"bytes,80,opt,name=reason,proto3" json:"reason,omitempty" class:"public"` // @gotags: `class:"public"`
+	// Output only. The grants allowing the action on the resource.
+	AllowingGrants []*PermissionGrant `protobuf:"bytes,90,rep,name=allowing_grants,proto3" json:"allowing_grants,omitempty"`
+	// Output only. The deny grants denying the action on the resource. These override any allowing grants.
+	DenyingGrants []*PermissionGrant `protobuf:"bytes,100,rep,name=denying_grants,proto3" json:"denying_grants,omitempty"`
+	// Output only. The grants matching the action on the resource whose time bounds or conditions are not satisfied.
+	InapplicableGrants []*PermissionGrant `protobuf:"bytes,110,rep,name=inapplicable_grants,proto3" json:"inapplicable_grants,omitempty"`
+	// Output only. All the grants of the User in the Scope containing the resource, which were evaluated against the action on the resource.
+	EvaluatedGrants []*PermissionGrant `protobuf:"bytes,120,rep,name=evaluated_grants,proto3" json:"evaluated_grants,omitempty"`
+	// Output only. A grant which would allow the action on the resource, set when no grant matches the action on the resource.
+	MissingGrant string `protobuf:"bytes,130,opt,name=missing_grant,proto3" json:"missing_grant,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *PermissionCheck) Reset() {
+	*x = PermissionCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheck) ProtoMessage() {}
+
+func (x *PermissionCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheck.ProtoReflect.Descriptor instead.
+func (*PermissionCheck) Descriptor() ([]byte, []int) {
+	return file_controller_api_resources_scopes_v1_permission_check_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PermissionCheck) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetAuthorized() bool {
+	if x != nil {
+		return x.Authorized
+	}
+	return false
+}
+
+func (x *PermissionCheck) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PermissionCheck) GetAllowingGrants() []*PermissionGrant {
+	if x != nil {
+		return x.AllowingGrants
+	}
+	return nil
+}
+
+func (x *PermissionCheck) GetDenyingGrants() []*PermissionGrant {
+	if x != nil {
+		return x.DenyingGrants
+	}
+	return nil
+}
+
+func (x *PermissionCheck) GetInapplicableGrants() []*PermissionGrant {
+	if x != nil {
+		return x.InapplicableGrants
+	}
+	return nil
+}
+
+func (x *PermissionCheck) GetEvaluatedGrants() []*PermissionGrant {
+	if x != nil {
+		return x.EvaluatedGrants
+	}
+	return nil
+}
+
+func (x *PermissionCheck) GetMissingGrant() string {
+	if x != nil {
+		return x.MissingGrant
+	}
+	return ""
+}
+
+var File_controller_api_resources_scopes_v1_permission_check_proto protoreflect.FileDescriptor
+
+var file_controller_api_resources_scopes_v1_permission_check_proto_rawDesc = []byte{
+	0x0a, 0x39, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x22,
+	0x41, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x22, 0xa8, 0x05, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x3c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x46, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x5d, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x5a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0x12, 0x5b, 0x0a, 0x0e, 0x64, 0x65, 0x6e, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x0e,
+	0x64, 0x65, 0x6e, 0x79, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x65,
+	0x0a, 0x13, 0x69, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x52, 0x13, 0x69, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x5f, 0x0a, 0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x78, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2e, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x4e, 0x5a,
+	0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68,
+	0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x73,
+	0x64, 0x6b, 0x2f, 0x70, 0x62, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x3b, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_controller_api_resources_scopes_v1_permission_check_proto_rawDescOnce sync.Once
+	file_controller_api_resources_scopes_v1_permission_check_proto_rawDescData = file_controller_api_resources_scopes_v1_permission_check_proto_rawDesc
+)
+
+func file_controller_api_resources_scopes_v1_permission_check_proto_rawDescGZIP() []byte {
+	file_controller_api_resources_scopes_v1_permission_check_proto_rawDescOnce.Do(func() {
+		file_controller_api_resources_scopes_v1_permission_check_proto_rawDescData = protoimpl.X.CompressGZIP(file_controller_api_resources_scopes_v1_permission_check_proto_rawDescData)
+	})
+	return file_controller_api_resources_scopes_v1_permission_check_proto_rawDescData
+}
+
+var file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_controller_api_resources_scopes_v1_permission_check_proto_goTypes = []interface{}{
+	(*PermissionGrant)(nil), // 0: controller.api.resources.scopes.v1.PermissionGrant
+	(*PermissionCheck)(nil), // 1: controller.api.resources.scopes.v1.PermissionCheck
+}
+var file_controller_api_resources_scopes_v1_permission_check_proto_depIdxs = []int32{
+	0, // 0: controller.api.resources.scopes.v1.PermissionCheck.allowing_grants:type_name -> controller.api.resources.scopes.v1.PermissionGrant
+	0, // 1: controller.api.resources.scopes.v1.PermissionCheck.denying_grants:type_name -> controller.api.resources.scopes.v1.PermissionGrant
+	0, // 2: controller.api.resources.scopes.v1.PermissionCheck.inapplicable_grants:type_name -> controller.api.resources.scopes.v1.PermissionGrant
+	0, // 3: controller.api.resources.scopes.v1.PermissionCheck.evaluated_grants:type_name -> controller.api.resources.scopes.v1.PermissionGrant
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_controller_api_resources_scopes_v1_permission_check_proto_init() }
+func file_controller_api_resources_scopes_v1_permission_check_proto_init() {
+	if File_controller_api_resources_scopes_v1_permission_check_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_controller_api_resources_scopes_v1_permission_check_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_controller_api_resources_scopes_v1_permission_check_proto_goTypes,
+		DependencyIndexes: file_controller_api_resources_scopes_v1_permission_check_proto_depIdxs,
+		MessageInfos:      file_controller_api_resources_scopes_v1_permission_check_proto_msgTypes,
+	}.Build()
+	File_controller_api_resources_scopes_v1_permission_check_proto = out.File
+	file_controller_api_resources_scopes_v1_permission_check_proto_rawDesc = nil
+	file_controller_api_resources_scopes_v1_permission_check_proto_goTypes = nil
+	file_controller_api_resources_scopes_v1_permission_check_proto_depIdxs = nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/boundary/internal/servers/controller"
 	wrapping "github.com/hashicorp/go-kms-wrapping"
 
+	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/ssh"
 	_ "github.com/hashicorp/boundary/internal/servers/controller/handlers/targets/tcp"
 )
 